@@ -35,6 +35,7 @@ func NewTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		newRegisterInterchainAccountCmd(),
+		newCancelInterchainAccountRegistrationCmd(),
 		newSendTxCmd(),
 	)
 
@@ -1,21 +1,29 @@
 package cli
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
@@ -27,6 +35,7 @@ const (
 	flagOrdering               = "ordering"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagMemo                   = "memo"
 )
 
 // defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
@@ -77,15 +86,58 @@ the associated capability.`),
 	return cmd
 }
 
+func newCancelInterchainAccountRegistrationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-registration [connection-id]",
+		Short: "Cancel an interchain account registration on the provided connection.",
+		Long: strings.TrimSpace(`Cancel an interchain account registration for which the counterparty chain never responded. 
+The channel created by the registration must still be in state INIT and must have been created at least 
+the minimum number of blocks ago. The channel is closed and a new registration may be submitted.`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			connectionID := args[0]
+			owner := clientCtx.GetFromAddress().String()
+
+			msg := types.NewMsgCancelInterchainAccountRegistration(connectionID, owner)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func newSendTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "send-tx [connection-id] [path/to/packet_msg.json]",
 		Short: "Send an interchain account tx on the provided connection.",
-		Long: strings.TrimSpace(`Submits pre-built packet data containing messages to be executed on the host chain and attempts to send the packet. 
-Packet data is provided as json, file or string. A timeout timestamp can be provided using the flag {packet-timeout-timestamp}. 
+		Long: strings.TrimSpace(`Submits the provided messages to be executed on the host chain and attempts to send the packet. 
+The messages are provided as json, file or string, either as pre-built packet data, as a single sdk message, as a list 
+of sdk messages or as a transaction generated with the {generate-only} flag of the host chain's binary. Sdk messages are 
+serialized into packet data using the encoding negotiated for the active channel of the interchain account, along with 
+the memo provided using the flag {memo}. A timeout timestamp can be provided using the flag {packet-timeout-timestamp}. 
 By default timeout timestamps are calculated relatively, adding {packet-timeout-timestamp} to the user's local system clock time. 
 Absolute timeout timestamp values can be used by setting the {absolute-timeouts} flag to true.
 If no timeout value is set then a default relative timeout value of 10 minutes is used.`),
+		Example: fmt.Sprintf(`%s tx interchain-accounts controller send-tx connection-0 packet-data.json --from cosmos1..
+%s tx interchain-accounts controller send-tx connection-0 '[{
+    "@type":"/cosmos.bank.v1beta1.MsgSend",
+    "from_address":"cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz",
+    "to_address":"cosmos10h9stc5v6ntgeygf5xf945njqq5h32r53uquvw",
+    "amount": [
+        {
+            "denom": "stake",
+            "amount": "1000"
+        }
+    ]
+}]' --memo memo --from cosmos1..`, version.AppName, version.AppName),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -97,18 +149,41 @@ If no timeout value is set then a default relative timeout value of 10 minutes i
 			connectionID := args[0]
 			owner := clientCtx.GetFromAddress().String()
 
-			// attempt to unmarshal ica msg data argument
-			var icaMsgData icatypes.InterchainAccountPacketData
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
 			msgContentOrFileName := args[1]
-			if err := cdc.UnmarshalJSON([]byte(msgContentOrFileName), &icaMsgData); err != nil {
+			msgBytes := []byte(msgContentOrFileName)
+			if !json.Valid(msgBytes) {
 				// check for file path if JSON input is not provided
-				contents, err := os.ReadFile(msgContentOrFileName)
+				msgBytes, err = os.ReadFile(msgContentOrFileName)
 				if err != nil {
 					return fmt.Errorf("neither JSON input nor path to .json file for packet data with messages were provided: %w", err)
 				}
+			}
+
+			// attempt to unmarshal ica msg data argument, otherwise serialize the sdk messages provided
+			var icaMsgData icatypes.InterchainAccountPacketData
+			if err := cdc.UnmarshalJSON(msgBytes, &icaMsgData); err == nil {
+				if memo != "" {
+					return errors.New("the memo flag cannot be used with pre-built packet data")
+				}
+			} else {
+				msgs, err := convertBytesIntoMsgs(cdc, msgBytes)
+				if err != nil {
+					return fmt.Errorf("error unmarshalling packet data or sdk messages: %w", err)
+				}
+
+				encoding, err := queryChannelEncoding(cmd, clientCtx, owner, connectionID)
+				if err != nil {
+					return err
+				}
 
-				if err := cdc.UnmarshalJSON(contents, &icaMsgData); err != nil {
-					return fmt.Errorf("error unmarshalling packet data with messages file: %w", err)
+				icaMsgData, err = generatePacketData(cdc, msgs, memo, encoding)
+				if err != nil {
+					return err
 				}
 			}
 
@@ -145,11 +220,126 @@ If no timeout value is set then a default relative timeout value of 10 minutes i
 
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagMemo, "", "Optional memo to be included in the interchain accounts packet data built from sdk messages.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// convertBytesIntoMsgs returns a list of sdk messages from bytes. The bytes can be in the form of a single
+// message, a json array of messages or a transaction, such as the ones generated using the generate-only flag,
+// in which case the messages of the transaction body are returned.
+func convertBytesIntoMsgs(cdc *codec.ProtoCodec, msgBytes []byte) ([]sdk.Msg, error) {
+	var rawMessages []json.RawMessage
+	if err := json.Unmarshal(msgBytes, &rawMessages); err == nil {
+		msgs := make([]sdk.Msg, len(rawMessages))
+		for i, anyJSON := range rawMessages {
+			if err := cdc.UnmarshalInterfaceJSON(anyJSON, &msgs[i]); err != nil {
+				return nil, err
+			}
+		}
+
+		return msgs, nil
+	}
+
+	var cosmosTx txtypes.Tx
+	if err := cdc.UnmarshalJSON(msgBytes, &cosmosTx); err == nil && cosmosTx.Body != nil {
+		return cosmosTx.GetMsgs(), nil
+	}
+
+	// if we fail to unmarshal a list of messages or a transaction, we assume we are just dealing with a single message.
+	// in this case we return a list of a single item.
+	var msg sdk.Msg
+	if err := cdc.UnmarshalInterfaceJSON(msgBytes, &msg); err != nil {
+		return nil, err
+	}
+
+	return []sdk.Msg{msg}, nil
+}
+
+// generatePacketData serializes the provided messages using the provided encoding into an instance of
+// InterchainAccountPacketData with the provided memo.
+func generatePacketData(cdc *codec.ProtoCodec, msgs []sdk.Msg, memo, encoding string) (icatypes.InterchainAccountPacketData, error) {
+	if len(msgs) == 0 {
+		return icatypes.InterchainAccountPacketData{}, errors.New("at least one message must be provided")
+	}
+
+	protoMessages := make([]proto.Message, len(msgs))
+	for i, msg := range msgs {
+		protoMessages[i] = msg
+	}
+
+	data, err := icatypes.SerializeCosmosTx(cdc, protoMessages, encoding)
+	if err != nil {
+		return icatypes.InterchainAccountPacketData{}, err
+	}
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	if err := icaPacketData.ValidateBasic(); err != nil {
+		return icatypes.InterchainAccountPacketData{}, err
+	}
+
+	return icaPacketData, nil
+}
+
+// queryChannelEncoding returns the tx encoding negotiated for the open channel of the interchain account
+// of the provided owner on the provided connection.
+func queryChannelEncoding(cmd *cobra.Command, clientCtx client.Context, owner, connectionID string) (string, error) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	queryClient := channeltypes.NewQueryClient(clientCtx)
+	req := &channeltypes.QueryConnectionChannelsRequest{
+		Connection: connectionID,
+		Pagination: &query.PageRequest{},
+	}
+
+	for {
+		res, err := queryClient.ConnectionChannels(cmd.Context(), req)
+		if err != nil {
+			return "", err
+		}
+
+		for _, channel := range res.Channels {
+			if channel.PortId == portID && channel.State == channeltypes.OPEN {
+				return encodingFromVersion(channel.Version)
+			}
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return "", fmt.Errorf("no open interchain account channel found for port ID %s on connection %s", portID, connectionID)
+		}
+
+		req.Pagination.Key = res.Pagination.NextKey
+	}
+}
+
+// encodingFromVersion returns the tx encoding of the interchain accounts metadata of the provided channel version.
+// The version is unwrapped if the channel is fee enabled.
+func encodingFromVersion(version string) (string, error) {
+	if feeMetadata, err := feetypes.MetadataFromVersion(version); err == nil && feeMetadata.AppVersion != "" {
+		version = feeMetadata.AppVersion
+	}
+
+	metadata, err := icatypes.MetadataFromVersion(version)
+	if err != nil {
+		return "", err
+	}
+
+	if !slices.Contains([]string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON}, metadata.Encoding) {
+		return "", fmt.Errorf("unsupported encoding type: %s", metadata.Encoding)
+	}
+
+	return metadata.Encoding, nil
+}
+
 // parseOrdering gets the channel ordering from the flags.
 func parseOrdering(cmd *cobra.Command) (channeltypes.Order, error) {
 	orderString, err := cmd.Flags().GetString(flagOrdering)
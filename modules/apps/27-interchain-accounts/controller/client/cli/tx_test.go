@@ -0,0 +1,230 @@
+package cli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+)
+
+const msgDelegateMessage = `{
+	"@type": "/cosmos.staking.v1beta1.MsgDelegate",
+	"delegator_address": "cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz",
+	"validator_address": "cosmosvaloper1qnk2n4nlkpw9xfqntladh74w6ujtulwnmxnh3k",
+	"amount": {
+		"denom": "stake",
+		"amount": "1000"
+	}
+}`
+
+const bankSendMessage = `{
+	"@type":"/cosmos.bank.v1beta1.MsgSend",
+	"from_address":"cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz",
+	"to_address":"cosmos10h9stc5v6ntgeygf5xf945njqq5h32r53uquvw",
+	"amount": [
+		{
+			"denom": "stake",
+			"amount": "1000"
+		}
+	]
+}`
+
+var (
+	multiMsg = fmt.Sprintf("[ %s, %s ]", msgDelegateMessage, bankSendMessage)
+
+	// generateOnlyTx is a transaction as generated using the generate-only flag
+	generateOnlyTx = fmt.Sprintf(`{
+	"body": {
+		"messages": [ %s, %s ],
+		"memo": "",
+		"timeout_height": "0",
+		"extension_options": [],
+		"non_critical_extension_options": []
+	},
+	"auth_info": {
+		"signer_infos": [],
+		"fee": {
+			"amount": [],
+			"gas_limit": "200000",
+			"payer": "",
+			"granter": ""
+		},
+		"tip": null
+	},
+	"signatures": []
+}`, bankSendMessage, msgDelegateMessage)
+)
+
+func TestGeneratePacketData(t *testing.T) {
+	t.Helper()
+	tests := []struct {
+		name         string
+		memo         string
+		expectedPass bool
+		message      string
+		assertionFn  func(t *testing.T, msgs []sdk.Msg)
+	}{
+		{
+			name:         "packet data generation succeeds (MsgDelegate & MsgSend)",
+			memo:         "",
+			expectedPass: true,
+			message:      multiMsg,
+			assertionFn: func(t *testing.T, msgs []sdk.Msg) {
+				t.Helper()
+				require.Len(t, msgs, 2)
+				assertMsgDelegate(t, msgs[0])
+				assertMsgBankSend(t, msgs[1])
+			},
+		},
+		{
+			name:         "packet data generation succeeds (MsgDelegate)",
+			memo:         "non-empty-memo",
+			expectedPass: true,
+			message:      msgDelegateMessage,
+			assertionFn: func(t *testing.T, msgs []sdk.Msg) {
+				t.Helper()
+				require.Len(t, msgs, 1)
+				assertMsgDelegate(t, msgs[0])
+			},
+		},
+		{
+			name:         "packet data generation succeeds (MsgSend)",
+			memo:         "non-empty-memo",
+			expectedPass: true,
+			message:      bankSendMessage,
+			assertionFn: func(t *testing.T, msgs []sdk.Msg) {
+				t.Helper()
+				require.Len(t, msgs, 1)
+				assertMsgBankSend(t, msgs[0])
+			},
+		},
+		{
+			name:         "packet data generation succeeds (generate-only transaction)",
+			memo:         "non-empty-memo",
+			expectedPass: true,
+			message:      generateOnlyTx,
+			assertionFn: func(t *testing.T, msgs []sdk.Msg) {
+				t.Helper()
+				require.Len(t, msgs, 2)
+				assertMsgBankSend(t, msgs[0])
+				assertMsgDelegate(t, msgs[1])
+			},
+		},
+		{
+			name:         "empty list of messages",
+			expectedPass: false,
+			message:      "[]",
+		},
+		{
+			name:         "invalid message string",
+			expectedPass: false,
+			message:      "<invalid-message-body>",
+		},
+	}
+
+	encodings := []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON}
+	for _, encoding := range encodings {
+		for _, tc := range tests {
+			tc := tc
+			ir := codectypes.NewInterfaceRegistry()
+			stakingtypes.RegisterInterfaces(ir)
+			banktypes.RegisterInterfaces(ir)
+
+			cdc := codec.NewProtoCodec(ir)
+
+			t.Run(fmt.Sprintf("%s with %s encoding", tc.name, encoding), func(t *testing.T) {
+				msgs, err := convertBytesIntoMsgs(cdc, []byte(tc.message))
+				if err == nil {
+					var packetData icatypes.InterchainAccountPacketData
+					packetData, err = generatePacketData(cdc, msgs, tc.memo, encoding)
+					if tc.expectedPass {
+						require.NoError(t, err)
+						require.Equal(t, icatypes.EXECUTE_TX, packetData.Type)
+						require.Equal(t, tc.memo, packetData.Memo)
+
+						messages, err := icatypes.DeserializeCosmosTx(cdc, packetData.Data, encoding)
+						require.NoError(t, err)
+
+						tc.assertionFn(t, messages)
+					}
+				}
+
+				if !tc.expectedPass {
+					require.Error(t, err)
+				}
+			})
+		}
+	}
+}
+
+func TestEncodingFromVersion(t *testing.T) {
+	icaVersion := func(encoding string) string {
+		return string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
+			Version:                icatypes.Version,
+			ControllerConnectionId: "connection-0",
+			HostConnectionId:       "connection-0",
+			Address:                "cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz",
+			Encoding:               encoding,
+			TxType:                 icatypes.TxTypeSDKMultiMsg,
+		}))
+	}
+
+	feeVersion := func(appVersion string) string {
+		return string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: appVersion}))
+	}
+
+	tests := []struct {
+		name        string
+		version     string
+		expEncoding string
+		expPass     bool
+	}{
+		{"success: proto3 encoding", icaVersion(icatypes.EncodingProtobuf), icatypes.EncodingProtobuf, true},
+		{"success: proto3json encoding", icaVersion(icatypes.EncodingProto3JSON), icatypes.EncodingProto3JSON, true},
+		{"success: fee enabled channel", feeVersion(icaVersion(icatypes.EncodingProto3JSON)), icatypes.EncodingProto3JSON, true},
+		{"failure: unsupported encoding", icaVersion("invalid-encoding"), "", false},
+		{"failure: invalid version", "ics27-1", "", false},
+		{"failure: invalid app version of fee enabled channel", feeVersion("ics27-1"), "", false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			encoding, err := encodingFromVersion(tc.version)
+			if tc.expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expEncoding, encoding)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func assertMsgBankSend(t *testing.T, msg sdk.Msg) { //nolint:thelper
+	bankSendMsg, ok := msg.(*banktypes.MsgSend)
+	require.True(t, ok)
+	require.Equal(t, "cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz", bankSendMsg.FromAddress)
+	require.Equal(t, "cosmos10h9stc5v6ntgeygf5xf945njqq5h32r53uquvw", bankSendMsg.ToAddress)
+	require.Equal(t, "stake", bankSendMsg.Amount.GetDenomByIndex(0))
+	require.Equal(t, uint64(1000), bankSendMsg.Amount[0].Amount.Uint64())
+}
+
+func assertMsgDelegate(t *testing.T, msg sdk.Msg) { //nolint:thelper
+	msgDelegate, ok := msg.(*stakingtypes.MsgDelegate)
+	require.True(t, ok)
+	require.Equal(t, "cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz", msgDelegate.DelegatorAddress)
+	require.Equal(t, "cosmosvaloper1qnk2n4nlkpw9xfqntladh74w6ujtulwnmxnh3k", msgDelegate.ValidatorAddress)
+	require.Equal(t, "stake", msgDelegate.Amount.Denom)
+	require.Equal(t, uint64(1000), msgDelegate.Amount.Amount.Uint64())
+}
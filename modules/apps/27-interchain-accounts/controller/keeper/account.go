@@ -7,6 +7,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/cosmos/ibc-go/v8/internal/logging"
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
@@ -88,5 +89,60 @@ func (k Keeper) registerInterchainAccount(ctx sdk.Context, connectionID, portID,
 		return "", errorsmod.Wrapf(ibcerrors.ErrInvalidType, "failed to convert %T message response to %T", firstMsgResponse.GetCachedValue(), &channeltypes.MsgChannelOpenInitResponse{})
 	}
 
+	k.setPendingChannel(ctx, connectionID, portID, channelOpenInitResponse.ChannelId)
+
 	return channelOpenInitResponse.ChannelId, nil
 }
+
+// CancelInterchainAccountRegistration aborts an interchain account registration for which the host chain never responded:
+// - The channel created by the registration must still be in the INIT state.
+// - At least MinCancelRegistrationBlockAge blocks, as set in the controller params, must have elapsed since the registration was initiated, in order to
+// give relayers sufficient time to progress the handshake on the host chain.
+// - The channel is closed locally and the registration bookkeeping is cleared, allowing the owner to register an
+// interchain account again, for example using different version metadata.
+//
+// The channel identifier of the cancelled registration is returned.
+func (k Keeper) CancelInterchainAccountRegistration(ctx sdk.Context, connectionID, owner string) (string, error) {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	channelID, found := k.GetPendingChannelID(ctx, connectionID, portID)
+	if !found {
+		return "", errorsmod.Wrapf(types.ErrPendingRegistrationNotFound, "portID %s on connection %s", portID, connectionID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return "", errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != channeltypes.INIT {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelState, "expected %s, got %s", channeltypes.INIT, channel.State)
+	}
+
+	initHeight, found := k.GetChannelInitHeight(ctx, portID, channelID)
+	if !found {
+		return "", errorsmod.Wrapf(types.ErrPendingRegistrationNotFound, "init height not found for port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	minBlockAge := k.GetParams(ctx).MinCancelRegistrationBlockAge
+	if blockAge := uint64(ctx.BlockHeight()) - initHeight; blockAge < minBlockAge {
+		return "", errorsmod.Wrapf(types.ErrRegistrationTooRecent, "registration initiated %d blocks ago, must be at least %d", blockAge, minBlockAge)
+	}
+
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
+	if !found {
+		return "", errorsmod.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if err := k.channelKeeper.ChanCloseInit(ctx, portID, channelID, chanCap); err != nil {
+		return "", err
+	}
+
+	k.DeleteMiddlewareEnabled(ctx, portID, connectionID)
+	k.deletePendingChannel(ctx, connectionID, portID)
+
+	return channelID, nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
@@ -118,3 +119,189 @@ func (suite *KeeperTestSuite) TestRegisterSameOwnerMultipleConnections() {
 	err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), pathAToC.EndpointA.ConnectionID, owner, string(icatypes.ModuleCdc.MustMarshalJSON(metadata)))
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestCancelInterchainAccountRegistration() {
+	var (
+		owner string
+		path  *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"fails to generate port-id",
+			func() {
+				owner = ""
+			},
+			icatypes.ErrInvalidAccountAddress,
+		},
+		{
+			"pending registration not found for owner",
+			func() {
+				owner = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			types.ErrPendingRegistrationNotFound,
+		},
+		{
+			"registration is too recent",
+			func() {
+				owner = suite.chainB.SenderAccount.GetAddress().String()
+
+				err := RegisterInterchainAccount(path.EndpointA, owner)
+				suite.Require().NoError(err)
+			},
+			types.ErrRegistrationTooRecent,
+		},
+		{
+			"registration is too recent for the minimum block age set in the params",
+			func() {
+				params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
+				params.MinCancelRegistrationBlockAge = types.DefaultMinCancelRegistrationBlockAge * 2
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			types.ErrRegistrationTooRecent,
+		},
+		{
+			"channel is not in state INIT",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.TRYOPEN })
+			},
+			channeltypes.ErrInvalidChannelState,
+		},
+		{
+			"handshake has completed",
+			func() {
+				suite.Require().NoError(path.EndpointB.ChanOpenTry())
+				suite.Require().NoError(path.EndpointA.ChanOpenAck())
+			},
+			types.ErrPendingRegistrationNotFound,
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			owner = TestOwnerAddress // must be explicitly changed
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := RegisterInterchainAccount(path.EndpointA, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.coordinator.CommitNBlocks(suite.chainA, types.DefaultMinCancelRegistrationBlockAge)
+
+			tc.malleate() // malleate mutates test data
+
+			channelID, err := suite.chainA.GetSimApp().ICAControllerKeeper.CancelInterchainAccountRegistration(suite.chainA.GetContext(), path.EndpointA.ConnectionID, owner)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(path.EndpointA.ChannelID, channelID)
+
+				channel := path.EndpointA.GetChannel()
+				suite.Require().Equal(channeltypes.CLOSED, channel.State)
+
+				_, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingChannelID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestPortID)
+				suite.Require().False(found)
+
+				_, found = suite.chainA.GetSimApp().ICAControllerKeeper.GetChannelInitHeight(suite.chainA.GetContext(), TestPortID, channelID)
+				suite.Require().False(found)
+
+				suite.Require().False(suite.chainA.GetSimApp().ICAControllerKeeper.IsMiddlewareEnabled(suite.chainA.GetContext(), TestPortID, path.EndpointA.ConnectionID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Empty(channelID)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCancelThenReregisterInterchainAccount() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	err := RegisterInterchainAccount(path.EndpointA, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	cancelledChannelID := path.EndpointA.ChannelID
+
+	// the host chain never responds to the registration
+	suite.coordinator.CommitNBlocks(suite.chainA, types.DefaultMinCancelRegistrationBlockAge)
+
+	_, err = suite.chainA.GetSimApp().ICAControllerKeeper.CancelInterchainAccountRegistration(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	// register again using different version metadata
+	metadata := icatypes.NewMetadata(icatypes.Version, path.EndpointA.ConnectionID, path.EndpointB.ConnectionID, "", icatypes.EncodingProto3JSON, icatypes.TxTypeSDKMultiMsg)
+	version := string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+
+	channelSequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestOwnerAddress, version)
+	suite.Require().NoError(err)
+
+	suite.chainA.NextBlock()
+
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+	suite.Require().NotEqual(cancelledChannelID, path.EndpointA.ChannelID)
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	activeChannelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetOpenActiveChannel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestPortID)
+	suite.Require().True(found)
+	suite.Require().Equal(path.EndpointA.ChannelID, activeChannelID)
+
+	appVersion, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetAppVersion(suite.chainA.GetContext(), TestPortID, activeChannelID)
+	suite.Require().True(found)
+
+	activeMetadata, err := icatypes.MetadataFromVersion(appVersion)
+	suite.Require().NoError(err)
+	suite.Require().Equal(icatypes.EncodingProto3JSON, activeMetadata.Encoding)
+
+	cancelledChannel, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetChannel(suite.chainA.GetContext(), TestPortID, cancelledChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CLOSED, cancelledChannel.State)
+}
+
+func (suite *KeeperTestSuite) TestRegisterInterchainAccountReplacesPendingRegistration() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	err := RegisterInterchainAccount(path.EndpointA, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	replacedChannelID := path.EndpointA.ChannelID
+
+	// register again while the first registration handshake is still in flight
+	err = RegisterInterchainAccount(path.EndpointA, TestOwnerAddress)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(replacedChannelID, path.EndpointA.ChannelID)
+
+	channelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingChannelID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestPortID)
+	suite.Require().True(found)
+	suite.Require().Equal(path.EndpointA.ChannelID, channelID)
+
+	_, found = suite.chainA.GetSimApp().ICAControllerKeeper.GetChannelInitHeight(suite.chainA.GetContext(), TestPortID, replacedChannelID)
+	suite.Require().False(found)
+
+	_, found = suite.chainA.GetSimApp().ICAControllerKeeper.GetChannelInitHeight(suite.chainA.GetContext(), TestPortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+}
@@ -52,7 +52,16 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				AccountAddress: interchainAccAddr.String(),
 			},
 		},
-		Ports: ports,
+		Ports:  ports,
+		Params: types.NewParams(false),
+		PendingRegistrations: []genesistypes.PendingRegistration{
+			{
+				ConnectionId: "connection-2",
+				PortId:       "test-port-2",
+				ChannelId:    "channel-2",
+				InitHeight:   10,
+			},
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -82,6 +91,14 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 			params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
 			suite.Require().Equal(expParams, params)
 
+			pendingChannelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingChannelID(suite.chainA.GetContext(), "connection-2", "test-port-2")
+			suite.Require().True(found)
+			suite.Require().Equal("channel-2", pendingChannelID)
+
+			initHeight, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetChannelInitHeight(suite.chainA.GetContext(), "test-port-2", "channel-2")
+			suite.Require().True(found)
+			suite.Require().Equal(uint64(10), initHeight)
+
 			for _, port := range ports {
 				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
 				suite.Require().True(store.Has(icatypes.KeyPort(port)))
@@ -103,6 +120,12 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	err := SetupICAPath(path, TestOwnerAddress)
 	suite.Require().NoError(err)
 
+	// a second registration whose handshake is in flight
+	pendingPath := NewICAPath(suite.chainA, suite.chainB)
+	pendingPath.EndpointA.ConnectionID = path.EndpointA.ConnectionID
+	err = RegisterInterchainAccount(pendingPath.EndpointA, suite.chainB.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
 	interchainAccAddr, exists := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
 	suite.Require().True(exists)
 
@@ -115,7 +138,16 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(interchainAccAddr, genesisState.InterchainAccounts[0].AccountAddress)
 	suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, genesisState.InterchainAccounts[0].PortId)
 
-	suite.Require().Equal([]string{TestPortID}, genesisState.GetPorts())
+	suite.Require().Equal([]genesistypes.PendingRegistration{
+		{
+			ConnectionId: path.EndpointA.ConnectionID,
+			PortId:       pendingPath.EndpointA.ChannelConfig.PortID,
+			ChannelId:    pendingPath.EndpointA.ChannelID,
+			InitHeight:   uint64(suite.chainA.GetContext().BlockHeight() - 1),
+		},
+	}, genesisState.GetPendingRegistrations())
+
+	suite.Require().ElementsMatch([]string{TestPortID, pendingPath.EndpointA.ChannelConfig.PortID}, genesisState.GetPorts())
 
 	expParams := types.DefaultParams()
 	suite.Require().Equal(expParams, genesisState.GetParams())
@@ -127,16 +127,26 @@ func (k Keeper) OnChanOpenAck(
 
 	k.SetActiveChannelID(ctx, metadata.ControllerConnectionId, portID, channelID)
 	k.SetInterchainAccountAddress(ctx, metadata.ControllerConnectionId, portID, metadata.Address)
+	k.deletePendingChannel(ctx, metadata.ControllerConnectionId, portID)
 
 	return nil
 }
 
-// OnChanCloseConfirm removes the active channel stored in state
-func (Keeper) OnChanCloseConfirm(
+// OnChanCloseConfirm removes the registration bookkeeping if the closed channel belongs to an in flight registration handshake
+func (k Keeper) OnChanCloseConfirm(
 	ctx sdk.Context,
 	portID,
 	channelID string,
 ) error {
+	connectionID, err := k.GetConnectionID(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	if pendingChannelID, found := k.GetPendingChannelID(ctx, connectionID, portID); found && pendingChannelID == channelID {
+		k.deletePendingChannel(ctx, connectionID, portID)
+	}
+
 	return nil
 }
 
@@ -509,6 +509,27 @@ func (suite *KeeperTestSuite) TestOnChanCloseConfirm() {
 	}
 }
 
+// TestOnChanCloseConfirmPendingRegistration tests that the registration bookkeeping of an in flight
+// registration handshake is removed when its channel is closed
+func (suite *KeeperTestSuite) TestOnChanCloseConfirmPendingRegistration() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	err := RegisterInterchainAccount(path.EndpointA, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	err = suite.chainA.GetSimApp().ICAControllerKeeper.OnChanCloseConfirm(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+
+	_, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetPendingChannelID(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().False(found)
+
+	_, found = suite.chainA.GetSimApp().ICAControllerKeeper.GetChannelInitHeight(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().False(found)
+}
+
 func (suite *KeeperTestSuite) TestOnChanUpgradeInit() {
 	const (
 		invalidVersion = "invalid-version"
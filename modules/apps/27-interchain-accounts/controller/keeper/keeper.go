@@ -212,6 +212,85 @@ func (k Keeper) IsActiveChannel(ctx sdk.Context, connectionID, portID string) bo
 	return ok
 }
 
+// GetPendingChannelID retrieves the channelID of an in flight registration handshake from the store, keyed by the provided connectionID and portID
+func (k Keeper) GetPendingChannelID(ctx sdk.Context, connectionID, portID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := icatypes.KeyPendingChannel(portID, connectionID)
+
+	if !store.Has(key) {
+		return "", false
+	}
+
+	return string(store.Get(key)), true
+}
+
+// GetChannelInitHeight retrieves the block height at which the registration handshake for the provided portID and channelID was initiated
+func (k Keeper) GetChannelInitHeight(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(icatypes.KeyChannelInitHeight(portID, channelID))
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// GetAllPendingRegistrations returns a list of all in flight interchain account registration handshakes and the block
+// heights at which they were initiated
+func (k Keeper) GetAllPendingRegistrations(ctx sdk.Context) []genesistypes.PendingRegistration {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(icatypes.PendingChannelKeyPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var pendingRegistrations []genesistypes.PendingRegistration
+	for ; iterator.Valid(); iterator.Next() {
+		keySplit := strings.Split(string(iterator.Key()), "/")
+
+		portID := keySplit[1]
+		connectionID := keySplit[2]
+		channelID := string(iterator.Value())
+
+		// the init height is always set together with the pending channel
+		initHeight, _ := k.GetChannelInitHeight(ctx, portID, channelID)
+
+		pendingRegistrations = append(pendingRegistrations, genesistypes.PendingRegistration{
+			ConnectionId: connectionID,
+			PortId:       portID,
+			ChannelId:    channelID,
+			InitHeight:   initHeight,
+		})
+	}
+
+	return pendingRegistrations
+}
+
+// setPendingChannel stores the channelID of an in flight registration handshake along with the current block height.
+// The bookkeeping of a previous registration handshake for the connectionID and portID is removed.
+func (k Keeper) setPendingChannel(ctx sdk.Context, connectionID, portID, channelID string) {
+	k.deletePendingChannel(ctx, connectionID, portID)
+	k.setPendingRegistration(ctx, connectionID, portID, channelID, uint64(ctx.BlockHeight()))
+}
+
+// setPendingRegistration stores the channelID of an in flight registration handshake along with the block height at
+// which the registration was initiated
+func (k Keeper) setPendingRegistration(ctx sdk.Context, connectionID, portID, channelID string, initHeight uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(icatypes.KeyPendingChannel(portID, connectionID), []byte(channelID))
+	store.Set(icatypes.KeyChannelInitHeight(portID, channelID), sdk.Uint64ToBigEndian(initHeight))
+}
+
+// deletePendingChannel removes the in flight registration handshake bookkeeping for the provided connectionID and portID
+func (k Keeper) deletePendingChannel(ctx sdk.Context, connectionID, portID string) {
+	channelID, found := k.GetPendingChannelID(ctx, connectionID, portID)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(icatypes.KeyPendingChannel(portID, connectionID))
+	store.Delete(icatypes.KeyChannelInitHeight(portID, channelID))
+}
+
 // GetInterchainAccountAddress retrieves the InterchainAccount address from the store associated with the provided connectionID and portID
 func (k Keeper) GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool) {
 	store := ctx.KVStore(k.storeKey)
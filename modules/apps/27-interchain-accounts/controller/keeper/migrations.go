@@ -63,3 +63,15 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	}
 	return nil
 }
+
+// MigrateMinCancelRegistrationBlockAge migrates the controller submodule's parameters by setting the minimum block age
+// for cancelling registrations, which was introduced as a parameter, to its default value.
+func (m Migrator) MigrateMinCancelRegistrationBlockAge(ctx sdk.Context) error {
+	if m.keeper != nil {
+		params := m.keeper.GetParams(ctx)
+		params.MinCancelRegistrationBlockAge = controllertypes.DefaultMinCancelRegistrationBlockAge
+		m.keeper.SetParams(ctx, params)
+		m.keeper.Logger(ctx).Info("successfully migrated ica/controller submodule minimum cancel registration block age")
+	}
+	return nil
+}
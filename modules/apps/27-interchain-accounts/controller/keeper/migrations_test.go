@@ -128,3 +128,17 @@ func (suite *KeeperTestSuite) TestMigratorMigrateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigratorMigrateMinCancelRegistrationBlockAge() {
+	suite.SetupTest()
+
+	// params stored before the minimum block age was introduced
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), icacontrollertypes.Params{ControllerEnabled: true})
+
+	migrator := icacontrollerkeeper.NewMigrator(&suite.chainA.GetSimApp().ICAControllerKeeper)
+	err := migrator.MigrateMinCancelRegistrationBlockAge(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	params := suite.chainA.GetSimApp().ICAControllerKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(icacontrollertypes.DefaultParams(), params)
+}
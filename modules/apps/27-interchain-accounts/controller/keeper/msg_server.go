@@ -53,6 +53,29 @@ func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.M
 	}, nil
 }
 
+// CancelInterchainAccountRegistration defines a rpc handler for MsgCancelInterchainAccountRegistration
+func (s msgServer) CancelInterchainAccountRegistration(goCtx context.Context, msg *types.MsgCancelInterchainAccountRegistration) (*types.MsgCancelInterchainAccountRegistrationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, err := s.Keeper.CancelInterchainAccountRegistration(ctx, msg.ConnectionId, msg.Owner)
+	if err != nil {
+		s.Logger(ctx).Error("error cancelling interchain account registration", "error", err.Error())
+		return nil, err
+	}
+
+	s.Logger(ctx).Info("successfully cancelled interchain account registration", "channel-id", channelID)
+
+	return &types.MsgCancelInterchainAccountRegistrationResponse{
+		ChannelId: channelID,
+		PortId:    portID,
+	}, nil
+}
+
 // SendTx defines a rpc handler for MsgSendTx
 func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.MsgSendTxResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -23,6 +23,11 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgRegisterInterchainAccount{}),
 			true,
 		},
+		{
+			"success: MsgCancelInterchainAccountRegistration",
+			sdk.MsgTypeURL(&types.MsgCancelInterchainAccountRegistration{}),
+			true,
+		},
 		{
 			"success: MsgSendTx",
 			sdk.MsgTypeURL(&types.MsgSendTx{}),
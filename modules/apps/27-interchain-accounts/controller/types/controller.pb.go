@@ -27,6 +27,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// controller_enabled enables or disables the controller submodule.
 	ControllerEnabled bool `protobuf:"varint,1,opt,name=controller_enabled,json=controllerEnabled,proto3" json:"controller_enabled,omitempty"`
+	// min_cancel_registration_block_age is the minimum number of blocks which must have elapsed since an interchain
+	// account registration was initiated before it may be cancelled.
+	MinCancelRegistrationBlockAge uint64 `protobuf:"varint,2,opt,name=min_cancel_registration_block_age,json=minCancelRegistrationBlockAge,proto3" json:"min_cancel_registration_block_age,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +72,13 @@ func (m *Params) GetControllerEnabled() bool {
 	return false
 }
 
+func (m *Params) GetMinCancelRegistrationBlockAge() uint64 {
+	if m != nil {
+		return m.MinCancelRegistrationBlockAge
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.controller.v1.Params")
 }
@@ -78,21 +88,24 @@ func init() {
 }
 
 var fileDescriptor_177fd0fec5eb3400 = []byte{
-	// 220 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x8f, 0xb1, 0x4e, 0xc3, 0x30,
-	0x10, 0x86, 0xe3, 0xa5, 0x42, 0xd9, 0xc8, 0xc4, 0x64, 0x21, 0x26, 0x96, 0xf8, 0xd4, 0x32, 0x94,
-	0x19, 0xc4, 0x5e, 0x31, 0xb2, 0x54, 0xf6, 0xd5, 0x6a, 0x0f, 0x39, 0xbe, 0xc8, 0xe7, 0x44, 0xe2,
-	0x2d, 0x78, 0x2c, 0xc6, 0x8e, 0x8c, 0x28, 0x79, 0x11, 0x04, 0x1d, 0x92, 0x21, 0xe3, 0xe9, 0xd3,
-	0x7d, 0xfa, 0xbf, 0xf2, 0x99, 0x1c, 0x82, 0x6d, 0xdb, 0x40, 0x68, 0x33, 0x71, 0x14, 0xa0, 0x98,
-	0x7d, 0xc2, 0x93, 0xa5, 0xb8, 0xb7, 0x88, 0xdc, 0xc5, 0x2c, 0x80, 0x1c, 0x73, 0xe2, 0x10, 0x7c,
-	0x82, 0x7e, 0x3d, 0xbb, 0x4c, 0x9b, 0x38, 0x73, 0xb5, 0x21, 0x87, 0x66, 0x2e, 0x31, 0x0b, 0x12,
-	0x33, 0x7b, 0xeb, 0xd7, 0x77, 0xdb, 0x72, 0xb5, 0xb3, 0xc9, 0x36, 0x52, 0xd5, 0x65, 0x35, 0xa1,
-	0xbd, 0x8f, 0xd6, 0x05, 0x7f, 0xb8, 0x51, 0xb7, 0xea, 0xfe, 0xea, 0xf5, 0x7a, 0x22, 0x2f, 0x17,
-	0xf0, 0xf4, 0xfe, 0x35, 0x68, 0x75, 0x1e, 0xb4, 0xfa, 0x19, 0xb4, 0xfa, 0x1c, 0x75, 0x71, 0x1e,
-	0x75, 0xf1, 0x3d, 0xea, 0xe2, 0x6d, 0x77, 0xa4, 0x7c, 0xea, 0x9c, 0x41, 0x6e, 0x00, 0x59, 0x1a,
-	0x16, 0x20, 0x87, 0xf5, 0x91, 0xa1, 0x7f, 0x84, 0x86, 0x0f, 0x5d, 0xf0, 0xf2, 0xd7, 0x2a, 0xb0,
-	0xd9, 0xd6, 0xd3, 0xc2, 0x7a, 0x29, 0x33, 0x7f, 0xb4, 0x5e, 0xdc, 0xea, 0xbf, 0xef, 0xe1, 0x37,
-	0x00, 0x00, 0xff, 0xff, 0x7d, 0x5b, 0xa9, 0x45, 0x26, 0x01, 0x00, 0x00,
+	// 266 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x63, 0x84, 0x2a, 0x94, 0x8d, 0x4c, 0x5d, 0xb0, 0x0a, 0x53, 0x97, 0xc4, 0x6a, 0x19,
+	0x60, 0xa5, 0x15, 0x12, 0x63, 0x95, 0x91, 0xc5, 0xb2, 0xaf, 0x56, 0x6a, 0xb0, 0x7d, 0x91, 0xed,
+	0x44, 0x62, 0xe4, 0x0d, 0x78, 0x2c, 0xc6, 0x8e, 0x8c, 0x28, 0x79, 0x11, 0xd4, 0x30, 0x24, 0x43,
+	0xc7, 0xd3, 0xff, 0xdd, 0x7f, 0xba, 0x2f, 0xdd, 0x6a, 0x09, 0x4c, 0xd4, 0xb5, 0xd1, 0x20, 0xa2,
+	0x46, 0x17, 0x98, 0x76, 0x51, 0x79, 0x38, 0x08, 0xed, 0xb8, 0x00, 0xc0, 0xc6, 0xc5, 0xc0, 0x00,
+	0x5d, 0xf4, 0x68, 0x8c, 0xf2, 0xac, 0x5d, 0x4d, 0xa6, 0xa2, 0xf6, 0x18, 0x31, 0x5b, 0x6b, 0x09,
+	0xc5, 0xb4, 0xa4, 0x38, 0x53, 0x52, 0x4c, 0xd6, 0xda, 0xd5, 0xdd, 0x27, 0x49, 0x67, 0x3b, 0xe1,
+	0x85, 0x0d, 0x59, 0x9e, 0x66, 0x63, 0xc6, 0x95, 0x13, 0xd2, 0xa8, 0xfd, 0x9c, 0x2c, 0xc8, 0xf2,
+	0xaa, 0xbc, 0x1e, 0x93, 0xe7, 0xff, 0x20, 0x7b, 0x49, 0x6f, 0xad, 0x76, 0x1c, 0x84, 0x03, 0x65,
+	0xb8, 0x57, 0x95, 0x0e, 0xd1, 0x0f, 0x77, 0xb9, 0x34, 0x08, 0xef, 0x5c, 0x54, 0x6a, 0x7e, 0xb1,
+	0x20, 0xcb, 0xcb, 0xf2, 0xc6, 0x6a, 0xb7, 0x1d, 0xb8, 0x72, 0x82, 0x6d, 0x4e, 0xd4, 0x53, 0xa5,
+	0x36, 0x6f, 0xdf, 0x1d, 0x25, 0xc7, 0x8e, 0x92, 0xdf, 0x8e, 0x92, 0xaf, 0x9e, 0x26, 0xc7, 0x9e,
+	0x26, 0x3f, 0x3d, 0x4d, 0x5e, 0x77, 0x95, 0x8e, 0x87, 0x46, 0x16, 0x80, 0x96, 0x01, 0x06, 0x8b,
+	0x81, 0x69, 0x09, 0x79, 0x85, 0xac, 0x7d, 0x64, 0x16, 0xf7, 0x8d, 0x51, 0xe1, 0xa4, 0x2d, 0xb0,
+	0xf5, 0x43, 0x3e, 0x3e, 0x9b, 0x9f, 0x33, 0x16, 0x3f, 0x6a, 0x15, 0xe4, 0x6c, 0x50, 0x75, 0xff,
+	0x37, 0x00, 0x00, 0x1b, 0xf4, 0xd7, 0x71, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -115,6 +128,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinCancelRegistrationBlockAge != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.MinCancelRegistrationBlockAge))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ControllerEnabled {
 		i--
 		if m.ControllerEnabled {
@@ -148,6 +166,9 @@ func (m *Params) Size() (n int) {
 	if m.ControllerEnabled {
 		n += 2
 	}
+	if m.MinCancelRegistrationBlockAge != 0 {
+		n += 1 + sovController(uint64(m.MinCancelRegistrationBlockAge))
+	}
 	return n
 }
 
@@ -206,6 +227,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ControllerEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCancelRegistrationBlockAge", wireType)
+			}
+			m.MinCancelRegistrationBlockAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinCancelRegistrationBlockAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipController(dAtA[iNdEx:])
@@ -7,4 +7,6 @@ import (
 // ICA Controller sentinel errors
 var (
 	ErrControllerSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "controller submodule is disabled")
+	ErrPendingRegistrationNotFound = errorsmod.Register(SubModuleName, 3, "pending interchain account registration not found")
+	ErrRegistrationTooRecent       = errorsmod.Register(SubModuleName, 4, "interchain account registration is too recent to be cancelled")
 )
@@ -18,10 +18,12 @@ const MaximumOwnerLength = 2048 // maximum length of the owner in bytes (value c
 
 var (
 	_ sdk.Msg = (*MsgRegisterInterchainAccount)(nil)
+	_ sdk.Msg = (*MsgCancelInterchainAccountRegistration)(nil)
 	_ sdk.Msg = (*MsgSendTx)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRegisterInterchainAccount)(nil)
+	_ sdk.HasValidateBasic = (*MsgCancelInterchainAccountRegistration)(nil)
 	_ sdk.HasValidateBasic = (*MsgSendTx)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 )
@@ -42,7 +44,7 @@ func (msg MsgRegisterInterchainAccount) ValidateBasic() error {
 		return errorsmod.Wrap(err, "invalid connection ID")
 	}
 
-	if !slices.Contains([]channeltypes.Order{channeltypes.ORDERED, channeltypes.UNORDERED}, msg.Ordering) {
+	if !slices.Contains([]channeltypes.Order{channeltypes.ORDERED, channeltypes.UNORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT}, msg.Ordering) {
 		return errorsmod.Wrap(channeltypes.ErrInvalidChannelOrdering, msg.Ordering.String())
 	}
 
@@ -57,6 +59,31 @@ func (msg MsgRegisterInterchainAccount) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgCancelInterchainAccountRegistration creates a new instance of MsgCancelInterchainAccountRegistration
+func NewMsgCancelInterchainAccountRegistration(connectionID, owner string) *MsgCancelInterchainAccountRegistration {
+	return &MsgCancelInterchainAccountRegistration{
+		ConnectionId: connectionID,
+		Owner:        owner,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCancelInterchainAccountRegistration) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return errorsmod.Wrap(err, "invalid connection ID")
+	}
+
+	if strings.TrimSpace(msg.Owner) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "owner address cannot be empty")
+	}
+
+	if len(msg.Owner) > MaximumOwnerLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "owner address must not exceed %d bytes", MaximumOwnerLength)
+	}
+
+	return nil
+}
+
 // NewMsgSendTx creates a new instance of MsgSendTx
 func NewMsgSendTx(owner, connectionID string, relativeTimeoutTimestamp uint64, packetData icatypes.InterchainAccountPacketData) *MsgSendTx {
 	return &MsgSendTx{
@@ -51,6 +51,13 @@ func TestMsgRegisterInterchainAccountValidateBasic(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"success: with ordered allow timeout channel",
+			func() {
+				msg.Ordering = channeltypes.ORDERED_ALLOW_TIMEOUT
+			},
+			true,
+		},
 		{
 			"connection id is invalid",
 			func() {
@@ -113,6 +120,69 @@ func TestMsgRegisterInterchainAccountGetSigners(t *testing.T) {
 	require.Equal(t, expSigner.Bytes(), signers[0])
 }
 
+func TestMsgCancelInterchainAccountRegistrationValidateBasic(t *testing.T) {
+	var msg *types.MsgCancelInterchainAccountRegistration
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"connection id is invalid",
+			func() {
+				msg.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"owner address is empty",
+			func() {
+				msg.Owner = ""
+			},
+			false,
+		},
+		{
+			"owner address is too long",
+			func() {
+				msg.Owner = ibctesting.GenerateString(types.MaximumOwnerLength + 1)
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		i, tc := i, tc
+
+		msg = types.NewMsgCancelInterchainAccountRegistration(ibctesting.FirstConnectionID, ibctesting.TestAccAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestMsgCancelInterchainAccountRegistrationGetSigners(t *testing.T) {
+	expSigner, err := sdk.AccAddressFromBech32(ibctesting.TestAccAddress)
+	require.NoError(t, err)
+
+	msg := types.NewMsgCancelInterchainAccountRegistration(ibctesting.FirstConnectionID, ibctesting.TestAccAddress)
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(ica.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, expSigner.Bytes(), signers[0])
+}
+
 func TestMsgSendTxValidateBasic(t *testing.T) {
 	var msg *types.MsgSendTx
 
@@ -3,12 +3,19 @@ package types
 const (
 	// DefaultControllerEnabled is the default value for the controller param (set to true)
 	DefaultControllerEnabled = true
+	// DefaultMinCancelRegistrationBlockAge is the default minimum number of blocks which must have elapsed since an
+	// interchain account registration was initiated before it may be cancelled. This gives relayers sufficient time to
+	// complete the handshake on the host chain and prevents the controller from abandoning a channel which has
+	// progressed to TRYOPEN.
+	DefaultMinCancelRegistrationBlockAge = 100
 )
 
 // NewParams creates a new parameter configuration for the controller submodule
+// with the default minimum block age for cancelling registrations.
 func NewParams(enableController bool) Params {
 	return Params{
-		ControllerEnabled: enableController,
+		ControllerEnabled:             enableController,
+		MinCancelRegistrationBlockAge: DefaultMinCancelRegistrationBlockAge,
 	}
 }
 
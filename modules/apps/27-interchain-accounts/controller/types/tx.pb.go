@@ -111,6 +111,90 @@ func (m *MsgRegisterInterchainAccountResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRegisterInterchainAccountResponse proto.InternalMessageInfo
 
+// MsgCancelInterchainAccountRegistration defines the payload for Msg/CancelInterchainAccountRegistration
+type MsgCancelInterchainAccountRegistration struct {
+	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	ConnectionId string `protobuf:"bytes,2,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (m *MsgCancelInterchainAccountRegistration) Reset() {
+	*m = MsgCancelInterchainAccountRegistration{}
+}
+func (m *MsgCancelInterchainAccountRegistration) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelInterchainAccountRegistration) ProtoMessage()    {}
+func (*MsgCancelInterchainAccountRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{2}
+}
+func (m *MsgCancelInterchainAccountRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelInterchainAccountRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelInterchainAccountRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelInterchainAccountRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelInterchainAccountRegistration.Merge(m, src)
+}
+func (m *MsgCancelInterchainAccountRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelInterchainAccountRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelInterchainAccountRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelInterchainAccountRegistration proto.InternalMessageInfo
+
+// MsgCancelInterchainAccountRegistrationResponse defines the response for Msg/CancelInterchainAccountRegistration
+type MsgCancelInterchainAccountRegistrationResponse struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	PortId    string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+}
+
+func (m *MsgCancelInterchainAccountRegistrationResponse) Reset() {
+	*m = MsgCancelInterchainAccountRegistrationResponse{}
+}
+func (m *MsgCancelInterchainAccountRegistrationResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgCancelInterchainAccountRegistrationResponse) ProtoMessage() {}
+func (*MsgCancelInterchainAccountRegistrationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7def041328c84a30, []int{3}
+}
+func (m *MsgCancelInterchainAccountRegistrationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelInterchainAccountRegistrationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelInterchainAccountRegistrationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelInterchainAccountRegistrationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelInterchainAccountRegistrationResponse.Merge(m, src)
+}
+func (m *MsgCancelInterchainAccountRegistrationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelInterchainAccountRegistrationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelInterchainAccountRegistrationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelInterchainAccountRegistrationResponse proto.InternalMessageInfo
+
 // MsgSendTx defines the payload for Msg/SendTx
 type MsgSendTx struct {
 	Owner        string                             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -125,7 +209,7 @@ func (m *MsgSendTx) Reset()         { *m = MsgSendTx{} }
 func (m *MsgSendTx) String() string { return proto.CompactTextString(m) }
 func (*MsgSendTx) ProtoMessage()    {}
 func (*MsgSendTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7def041328c84a30, []int{2}
+	return fileDescriptor_7def041328c84a30, []int{4}
 }
 func (m *MsgSendTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,7 +247,7 @@ func (m *MsgSendTxResponse) Reset()         { *m = MsgSendTxResponse{} }
 func (m *MsgSendTxResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendTxResponse) ProtoMessage()    {}
 func (*MsgSendTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7def041328c84a30, []int{3}
+	return fileDescriptor_7def041328c84a30, []int{5}
 }
 func (m *MsgSendTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +290,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7def041328c84a30, []int{4}
+	return fileDescriptor_7def041328c84a30, []int{6}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -243,7 +327,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7def041328c84a30, []int{5}
+	return fileDescriptor_7def041328c84a30, []int{7}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,6 +359,8 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*MsgRegisterInterchainAccount)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccount")
 	proto.RegisterType((*MsgRegisterInterchainAccountResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgRegisterInterchainAccountResponse")
+	proto.RegisterType((*MsgCancelInterchainAccountRegistration)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgCancelInterchainAccountRegistration")
+	proto.RegisterType((*MsgCancelInterchainAccountRegistrationResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgCancelInterchainAccountRegistrationResponse")
 	proto.RegisterType((*MsgSendTx)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgSendTx")
 	proto.RegisterType((*MsgSendTxResponse)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgSendTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.interchain_accounts.controller.v1.MsgUpdateParams")
@@ -286,49 +372,52 @@ func init() {
 }
 
 var fileDescriptor_7def041328c84a30 = []byte{
-	// 671 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0x13, 0x4d,
-	0x1c, 0xee, 0xbe, 0x94, 0x02, 0x03, 0x2f, 0xbc, 0xef, 0x86, 0x48, 0xd9, 0x68, 0xc1, 0xea, 0x01,
-	0x49, 0x98, 0x49, 0xeb, 0xdf, 0xd4, 0x78, 0x10, 0xf0, 0xd0, 0x98, 0xc6, 0x66, 0xc5, 0x84, 0x78,
-	0x69, 0xa6, 0xb3, 0x93, 0x61, 0xa4, 0x3b, 0xb3, 0xce, 0x4c, 0x57, 0xbc, 0x19, 0x4f, 0xc6, 0x83,
-	0xf1, 0xe0, 0x07, 0xe0, 0x23, 0x70, 0xf7, 0x03, 0xc8, 0x91, 0xa3, 0x27, 0x63, 0xe0, 0xc0, 0xcd,
-	0xcf, 0x60, 0xf6, 0x4f, 0xb7, 0x28, 0x48, 0xb0, 0x70, 0xdb, 0xdf, 0x6f, 0xe6, 0x79, 0x7e, 0xcf,
-	0xf3, 0xcc, 0xec, 0x80, 0xfb, 0xbc, 0x4d, 0x10, 0x0e, 0x82, 0x0e, 0x27, 0xd8, 0x70, 0x29, 0x34,
-	0xe2, 0xc2, 0x50, 0x45, 0x36, 0x30, 0x17, 0x2d, 0x4c, 0x88, 0xec, 0x0a, 0xa3, 0x11, 0x91, 0xc2,
-	0x28, 0xd9, 0xe9, 0x50, 0x85, 0xc2, 0x0a, 0x32, 0x5b, 0x30, 0x50, 0xd2, 0x48, 0xbb, 0xca, 0xdb,
-	0x04, 0x1e, 0x05, 0xc3, 0x13, 0xc0, 0xb0, 0x0f, 0x86, 0x61, 0xc5, 0x99, 0x66, 0x92, 0xc9, 0x18,
-	0x8e, 0xa2, 0xaf, 0x84, 0xc9, 0xb9, 0x75, 0x26, 0x19, 0x61, 0x05, 0x05, 0x98, 0x6c, 0x52, 0x93,
-	0xa2, 0x56, 0x06, 0x10, 0x7f, 0x44, 0x4d, 0x42, 0x32, 0x43, 0xa4, 0xf6, 0xa5, 0x46, 0xbe, 0x66,
-	0xd1, 0xba, 0xaf, 0x59, 0xba, 0x70, 0x35, 0x62, 0x27, 0x52, 0x51, 0x44, 0x36, 0xb0, 0x10, 0xb4,
-	0x13, 0xc3, 0x93, 0xcf, 0x64, 0x4b, 0xf9, 0xb3, 0x05, 0x2e, 0x37, 0x34, 0x73, 0x29, 0xe3, 0xda,
-	0x50, 0x55, 0xcf, 0xa6, 0x3f, 0x4c, 0x86, 0xdb, 0xd3, 0x60, 0x58, 0xbe, 0x12, 0x54, 0x15, 0xad,
-	0x79, 0x6b, 0x61, 0xcc, 0x4d, 0x0a, 0xfb, 0x1a, 0xf8, 0x97, 0x48, 0x21, 0x28, 0x89, 0x44, 0xb7,
-	0xb8, 0x57, 0xfc, 0x27, 0x5e, 0x9d, 0xe8, 0x37, 0xeb, 0x9e, 0x5d, 0x04, 0x23, 0x21, 0x55, 0x9a,
-	0x4b, 0x51, 0x1c, 0x8a, 0x97, 0x7b, 0xa5, 0x7d, 0x07, 0x8c, 0x4a, 0xe5, 0x51, 0xc5, 0x05, 0x2b,
-	0xe6, 0xe7, 0xad, 0x85, 0xc9, 0xaa, 0x03, 0xa3, 0x93, 0x88, 0xb4, 0xc2, 0x9e, 0xc0, 0xb0, 0x02,
-	0x9f, 0x44, 0x9b, 0xdc, 0x6c, 0x6f, 0x6d, 0xf2, 0xdd, 0xf6, 0x5c, 0xee, 0xed, 0xe1, 0xce, 0x62,
-	0x22, 0xa3, 0xec, 0x81, 0xeb, 0xa7, 0x89, 0x77, 0xa9, 0x0e, 0xa4, 0xd0, 0xd4, 0xbe, 0x02, 0x40,
-	0xca, 0x1a, 0x69, 0x4d, 0x9c, 0x8c, 0xa5, 0x9d, 0xba, 0x67, 0xcf, 0x80, 0x91, 0x40, 0x2a, 0xd3,
-	0xf7, 0x51, 0x88, 0xca, 0xba, 0x57, 0xcb, 0x47, 0xf3, 0xca, 0x3f, 0x2c, 0x30, 0xd6, 0xd0, 0xec,
-	0x29, 0x15, 0xde, 0xda, 0xd6, 0x79, 0x02, 0xd9, 0x04, 0xe3, 0xc9, 0xe9, 0xb7, 0x3c, 0x6c, 0x70,
-	0x1c, 0xca, 0x78, 0x75, 0x15, 0x9e, 0xe9, 0x0e, 0x86, 0x15, 0x78, 0xcc, 0x5f, 0x33, 0x26, 0x5b,
-	0xc5, 0x06, 0x2f, 0xe7, 0x77, 0xbf, 0xcd, 0xe5, 0x5c, 0x10, 0x64, 0x1d, 0xfb, 0x06, 0xf8, 0x4f,
-	0xd1, 0x0e, 0x36, 0x3c, 0xa4, 0x2d, 0xc3, 0x7d, 0x2a, 0xbb, 0x26, 0xce, 0x3a, 0xef, 0x4e, 0xf5,
-	0xfa, 0x6b, 0x49, 0xfb, 0x58, 0xac, 0xb7, 0xc1, 0xff, 0x99, 0xdf, 0x2c, 0x43, 0x07, 0x8c, 0x6a,
-	0xfa, 0xb2, 0x4b, 0x05, 0xa1, 0xb1, 0xf5, 0xbc, 0x9b, 0xd5, 0x69, 0x4e, 0x9f, 0x2c, 0x30, 0xd5,
-	0xd0, 0xec, 0x59, 0xe0, 0x61, 0x43, 0x9b, 0x58, 0x61, 0x5f, 0xdb, 0x97, 0x40, 0x41, 0x73, 0xd6,
-	0x8f, 0x2b, 0xad, 0xec, 0x75, 0x50, 0x08, 0xe2, 0x1d, 0x71, 0x50, 0xe3, 0xd5, 0x1a, 0xfc, 0xfb,
-	0x3f, 0x11, 0x26, 0x33, 0x52, 0xef, 0x29, 0x5f, 0x6d, 0xaa, 0x67, 0x26, 0x1d, 0x55, 0x9e, 0x05,
-	0x33, 0xbf, 0xa9, 0xea, 0x79, 0xaa, 0xbe, 0xcf, 0x83, 0xa1, 0x86, 0x66, 0xf6, 0x17, 0x0b, 0xcc,
-	0xfe, 0xf9, 0x17, 0x68, 0x0e, 0xa2, 0xed, 0xb4, 0x7b, 0xe9, 0xac, 0x5f, 0x34, 0x63, 0x76, 0x4a,
-	0x1f, 0x2c, 0x50, 0x48, 0x2f, 0xea, 0x83, 0x01, 0x87, 0x24, 0x70, 0xe7, 0xd1, 0xb9, 0xe0, 0x99,
-	0xa0, 0x6d, 0x0b, 0x4c, 0xfc, 0x72, 0x23, 0x56, 0x06, 0xe4, 0x3d, 0x4a, 0xe2, 0x3c, 0xbe, 0x00,
-	0x92, 0x9e, 0x44, 0x67, 0xf8, 0xcd, 0xe1, 0xce, 0xa2, 0xb5, 0xfc, 0x62, 0x77, 0xbf, 0x64, 0xed,
-	0xed, 0x97, 0xac, 0xef, 0xfb, 0x25, 0xeb, 0xe3, 0x41, 0x29, 0xb7, 0x77, 0x50, 0xca, 0x7d, 0x3d,
-	0x28, 0xe5, 0x9e, 0x37, 0x19, 0x37, 0x1b, 0xdd, 0x36, 0x24, 0xd2, 0x47, 0xe9, 0x5b, 0xcb, 0xdb,
-	0x64, 0x89, 0x49, 0x14, 0xde, 0x43, 0xbe, 0xf4, 0xba, 0x1d, 0xaa, 0xa3, 0x57, 0x5c, 0xa3, 0xea,
-	0xdd, 0xa5, 0xbe, 0x8e, 0xa5, 0x93, 0x1e, 0x70, 0xf3, 0x3a, 0xa0, 0xba, 0x5d, 0x88, 0x5f, 0xdf,
-	0x9b, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xee, 0x6a, 0x51, 0xfa, 0xbd, 0x06, 0x00, 0x00,
+	// 714 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4d, 0x4f, 0x13, 0x4f,
+	0x18, 0xef, 0xfc, 0x29, 0x05, 0x06, 0xfe, 0xa0, 0x1b, 0x22, 0x65, 0xa3, 0x05, 0x8b, 0x31, 0x48,
+	0xc2, 0x6c, 0x5a, 0x5f, 0x53, 0xe3, 0x41, 0xc0, 0x43, 0x63, 0x1a, 0x9b, 0x8a, 0x09, 0xe1, 0xd2,
+	0x4c, 0x67, 0x27, 0xc3, 0x48, 0x3b, 0xb3, 0xce, 0x4c, 0x57, 0xbc, 0x19, 0x4f, 0x9e, 0x8c, 0x07,
+	0x3f, 0x00, 0x1f, 0x81, 0xbb, 0x1f, 0x40, 0x8e, 0x1c, 0x3d, 0x19, 0x03, 0x31, 0xdc, 0x3c, 0xf8,
+	0x09, 0xcc, 0xbe, 0x74, 0x8b, 0x82, 0xa4, 0x16, 0xbc, 0xed, 0x33, 0x33, 0xcf, 0xef, 0xe5, 0x79,
+	0xe6, 0xd9, 0x81, 0xf7, 0x79, 0x83, 0x38, 0xd8, 0xf3, 0x9a, 0x9c, 0x60, 0xc3, 0xa5, 0xd0, 0x0e,
+	0x17, 0x86, 0x2a, 0xb2, 0x81, 0xb9, 0xa8, 0x63, 0x42, 0x64, 0x5b, 0x18, 0xed, 0x10, 0x29, 0x8c,
+	0x92, 0xcd, 0x26, 0x55, 0x8e, 0x5f, 0x70, 0xcc, 0x16, 0xf2, 0x94, 0x34, 0xd2, 0x2a, 0xf2, 0x06,
+	0x41, 0x47, 0x93, 0xd1, 0x09, 0xc9, 0xa8, 0x9b, 0x8c, 0xfc, 0x82, 0x3d, 0xc9, 0x24, 0x93, 0x61,
+	0xba, 0x13, 0x7c, 0x45, 0x48, 0xf6, 0xad, 0x9e, 0x64, 0xf8, 0x05, 0xc7, 0xc3, 0x64, 0x93, 0x9a,
+	0x38, 0x6b, 0xb9, 0x0f, 0xf1, 0xdd, 0x28, 0x06, 0x99, 0x22, 0x52, 0xb7, 0xa4, 0x76, 0x5a, 0x9a,
+	0x05, 0xfb, 0x2d, 0xcd, 0xe2, 0x8d, 0xab, 0x01, 0x3a, 0x91, 0x8a, 0x3a, 0x64, 0x03, 0x0b, 0x41,
+	0x9b, 0x61, 0x7a, 0xf4, 0x19, 0x1d, 0xc9, 0x7f, 0x04, 0xf0, 0x72, 0x45, 0xb3, 0x1a, 0x65, 0x5c,
+	0x1b, 0xaa, 0xca, 0x09, 0xfb, 0xc3, 0x88, 0xdc, 0x9a, 0x84, 0x83, 0xf2, 0xa5, 0xa0, 0x2a, 0x0b,
+	0x66, 0xc1, 0xfc, 0x48, 0x2d, 0x0a, 0xac, 0x39, 0xf8, 0x3f, 0x91, 0x42, 0x50, 0x12, 0x88, 0xae,
+	0x73, 0x37, 0xfb, 0x5f, 0xb8, 0x3b, 0xd6, 0x5d, 0x2c, 0xbb, 0x56, 0x16, 0x0e, 0xf9, 0x54, 0x69,
+	0x2e, 0x45, 0x76, 0x20, 0xdc, 0xee, 0x84, 0xd6, 0x1d, 0x38, 0x2c, 0x95, 0x4b, 0x15, 0x17, 0x2c,
+	0x9b, 0x9e, 0x05, 0xf3, 0xe3, 0x45, 0x1b, 0x05, 0x9d, 0x08, 0xb4, 0xa2, 0x8e, 0x40, 0xbf, 0x80,
+	0x9e, 0x04, 0x87, 0x6a, 0xc9, 0xd9, 0xd2, 0xf8, 0xdb, 0xed, 0x99, 0xd4, 0x9b, 0xc3, 0x9d, 0x85,
+	0x48, 0x46, 0xde, 0x85, 0xd7, 0x4e, 0x13, 0x5f, 0xa3, 0xda, 0x93, 0x42, 0x53, 0xeb, 0x0a, 0x84,
+	0x31, 0x6a, 0xa0, 0x35, 0x72, 0x32, 0x12, 0xaf, 0x94, 0x5d, 0x6b, 0x0a, 0x0e, 0x79, 0x52, 0x99,
+	0xae, 0x8f, 0x4c, 0x10, 0x96, 0xdd, 0x52, 0x3a, 0xe0, 0xcb, 0x6b, 0x78, 0xbd, 0xa2, 0xd9, 0x32,
+	0x16, 0x84, 0x36, 0x4f, 0xe0, 0x08, 0xc8, 0x55, 0xd8, 0xbd, 0x33, 0x14, 0xeb, 0x98, 0x35, 0x01,
+	0x51, 0x6f, 0xa4, 0xe7, 0x64, 0xf2, 0x3b, 0x80, 0x23, 0x15, 0xcd, 0x9e, 0x52, 0xe1, 0xae, 0x6e,
+	0x9d, 0xa5, 0xeb, 0x9b, 0x70, 0x34, 0xba, 0xe2, 0x75, 0x17, 0x1b, 0x1c, 0x76, 0x7e, 0xb4, 0xb8,
+	0x82, 0x7a, 0x1a, 0x34, 0xbf, 0x80, 0x8e, 0x79, 0xad, 0x86, 0x60, 0x2b, 0xd8, 0xe0, 0xa5, 0xf4,
+	0xee, 0x97, 0x99, 0x54, 0x0d, 0x7a, 0xc9, 0x8a, 0x75, 0x03, 0x5e, 0x50, 0xb4, 0x89, 0x0d, 0xf7,
+	0x69, 0xdd, 0xf0, 0x16, 0x95, 0x6d, 0x13, 0x5e, 0xa8, 0x74, 0x6d, 0xa2, 0xb3, 0xbe, 0x1a, 0x2d,
+	0x1f, 0x2b, 0xf0, 0x6d, 0x78, 0x31, 0xf1, 0x9b, 0xd4, 0xd0, 0x86, 0xc3, 0x9a, 0xbe, 0x68, 0x53,
+	0x41, 0x68, 0x68, 0x3d, 0x5d, 0x4b, 0xe2, 0xb8, 0x4e, 0x1f, 0x00, 0x9c, 0xa8, 0x68, 0xf6, 0xcc,
+	0x73, 0xb1, 0xa1, 0x55, 0xac, 0x70, 0x4b, 0x5b, 0x97, 0x60, 0x46, 0x73, 0xd6, 0x2d, 0x57, 0x1c,
+	0x59, 0x6b, 0x30, 0xe3, 0x85, 0x27, 0xc2, 0x42, 0x8d, 0x16, 0x4b, 0xe8, 0xef, 0x7f, 0x37, 0x28,
+	0xe2, 0x88, 0xbd, 0xc7, 0x78, 0xa5, 0x89, 0x8e, 0x99, 0x98, 0x2a, 0x3f, 0x0d, 0xa7, 0x7e, 0x53,
+	0xd5, 0xf1, 0x54, 0xfc, 0x31, 0x08, 0x07, 0x2a, 0x9a, 0x59, 0x9f, 0x00, 0x9c, 0xfe, 0xf3, 0x9c,
+	0x57, 0xfb, 0xd1, 0x76, 0xda, 0xf0, 0xd9, 0x6b, 0xe7, 0x8d, 0x98, 0x74, 0xe9, 0x1b, 0x80, 0x73,
+	0xbd, 0x8c, 0xe3, 0x7a, 0x9f, 0x0a, 0x7a, 0xc0, 0xb6, 0x1b, 0xff, 0x0e, 0x3b, 0xf1, 0xf9, 0x0e,
+	0xc0, 0x4c, 0x3c, 0x90, 0x0f, 0xfa, 0xa4, 0x8b, 0xd2, 0xed, 0x47, 0x67, 0x4a, 0x4f, 0x04, 0x6d,
+	0x03, 0x38, 0xf6, 0xcb, 0xcd, 0x5f, 0xee, 0x13, 0xf7, 0x28, 0x88, 0xfd, 0xf8, 0x1c, 0x40, 0x3a,
+	0x12, 0xed, 0xc1, 0xd7, 0x87, 0x3b, 0x0b, 0x60, 0xe9, 0xf9, 0xee, 0x7e, 0x0e, 0xec, 0xed, 0xe7,
+	0xc0, 0xd7, 0xfd, 0x1c, 0x78, 0x7f, 0x90, 0x4b, 0xed, 0x1d, 0xe4, 0x52, 0x9f, 0x0f, 0x72, 0xa9,
+	0xf5, 0x2a, 0xe3, 0x66, 0xa3, 0xdd, 0x40, 0x44, 0xb6, 0x9c, 0xf8, 0xe1, 0xe4, 0x0d, 0xb2, 0xc8,
+	0xa4, 0xe3, 0xdf, 0x73, 0x5a, 0xd2, 0x6d, 0x37, 0xa9, 0x0e, 0x9e, 0x64, 0xed, 0x14, 0xef, 0x2e,
+	0x76, 0x75, 0x2c, 0x9e, 0xf4, 0x1a, 0x9b, 0x57, 0x1e, 0xd5, 0x8d, 0x4c, 0xf8, 0x94, 0xde, 0xfc,
+	0x39, 0x00, 0xcc, 0x6a, 0x77, 0x61, 0x8a, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -345,6 +434,8 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount.
 	RegisterInterchainAccount(ctx context.Context, in *MsgRegisterInterchainAccount, opts ...grpc.CallOption) (*MsgRegisterInterchainAccountResponse, error)
+	// CancelInterchainAccountRegistration defines a rpc handler for MsgCancelInterchainAccountRegistration.
+	CancelInterchainAccountRegistration(ctx context.Context, in *MsgCancelInterchainAccountRegistration, opts ...grpc.CallOption) (*MsgCancelInterchainAccountRegistrationResponse, error)
 	// SendTx defines a rpc handler for MsgSendTx.
 	SendTx(ctx context.Context, in *MsgSendTx, opts ...grpc.CallOption) (*MsgSendTxResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
@@ -368,6 +459,15 @@ func (c *msgClient) RegisterInterchainAccount(ctx context.Context, in *MsgRegist
 	return out, nil
 }
 
+func (c *msgClient) CancelInterchainAccountRegistration(ctx context.Context, in *MsgCancelInterchainAccountRegistration, opts ...grpc.CallOption) (*MsgCancelInterchainAccountRegistrationResponse, error) {
+	out := new(MsgCancelInterchainAccountRegistrationResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/CancelInterchainAccountRegistration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) SendTx(ctx context.Context, in *MsgSendTx, opts ...grpc.CallOption) (*MsgSendTxResponse, error) {
 	out := new(MsgSendTxResponse)
 	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.controller.v1.Msg/SendTx", in, out, opts...)
@@ -390,6 +490,8 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount.
 	RegisterInterchainAccount(context.Context, *MsgRegisterInterchainAccount) (*MsgRegisterInterchainAccountResponse, error)
+	// CancelInterchainAccountRegistration defines a rpc handler for MsgCancelInterchainAccountRegistration.
+	CancelInterchainAccountRegistration(context.Context, *MsgCancelInterchainAccountRegistration) (*MsgCancelInterchainAccountRegistrationResponse, error)
 	// SendTx defines a rpc handler for MsgSendTx.
 	SendTx(context.Context, *MsgSendTx) (*MsgSendTxResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
@@ -403,6 +505,9 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) RegisterInterchainAccount(ctx context.Context, req *MsgRegisterInterchainAccount) (*MsgRegisterInterchainAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterInterchainAccount not implemented")
 }
+func (*UnimplementedMsgServer) CancelInterchainAccountRegistration(ctx context.Context, req *MsgCancelInterchainAccountRegistration) (*MsgCancelInterchainAccountRegistrationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelInterchainAccountRegistration not implemented")
+}
 func (*UnimplementedMsgServer) SendTx(ctx context.Context, req *MsgSendTx) (*MsgSendTxResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendTx not implemented")
 }
@@ -432,6 +537,24 @@ func _Msg_RegisterInterchainAccount_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelInterchainAccountRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelInterchainAccountRegistration)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelInterchainAccountRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.controller.v1.Msg/CancelInterchainAccountRegistration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelInterchainAccountRegistration(ctx, req.(*MsgCancelInterchainAccountRegistration))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_SendTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSendTx)
 	if err := dec(in); err != nil {
@@ -476,6 +599,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterInterchainAccount",
 			Handler:    _Msg_RegisterInterchainAccount_Handler,
 		},
+		{
+			MethodName: "CancelInterchainAccountRegistration",
+			Handler:    _Msg_CancelInterchainAccountRegistration_Handler,
+		},
 		{
 			MethodName: "SendTx",
 			Handler:    _Msg_SendTx_Handler,
@@ -575,6 +702,80 @@ func (m *MsgRegisterInterchainAccountResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelInterchainAccountRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelInterchainAccountRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelInterchainAccountRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelInterchainAccountRegistrationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelInterchainAccountRegistrationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelInterchainAccountRegistrationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSendTx) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -770,6 +971,40 @@ func (m *MsgRegisterInterchainAccountResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelInterchainAccountRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelInterchainAccountRegistrationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgSendTx) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1113,6 +1348,234 @@ func (m *MsgRegisterInterchainAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelInterchainAccountRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelInterchainAccountRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelInterchainAccountRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelInterchainAccountRegistrationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelInterchainAccountRegistrationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelInterchainAccountRegistrationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgSendTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	controllertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	hosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
@@ -40,12 +42,13 @@ func DefaultControllerGenesis() ControllerGenesisState {
 }
 
 // NewControllerGenesisState creates a returns a new ControllerGenesisState instance
-func NewControllerGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, ports []string, controllerParams controllertypes.Params) ControllerGenesisState {
+func NewControllerGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, ports []string, controllerParams controllertypes.Params, pendingRegistrations []PendingRegistration) ControllerGenesisState {
 	return ControllerGenesisState{
-		ActiveChannels:     channels,
-		InterchainAccounts: accounts,
-		Ports:              ports,
-		Params:             controllerParams,
+		ActiveChannels:       channels,
+		InterchainAccounts:   accounts,
+		Ports:                ports,
+		Params:               controllerParams,
+		PendingRegistrations: pendingRegistrations,
 	}
 }
 
@@ -77,6 +80,20 @@ func (gs ControllerGenesisState) Validate() error {
 		}
 	}
 
+	for _, registration := range gs.PendingRegistrations {
+		if err := host.ConnectionIdentifierValidator(registration.ConnectionId); err != nil {
+			return err
+		}
+
+		if err := host.PortIdentifierValidator(registration.PortId); err != nil {
+			return err
+		}
+
+		if err := host.ChannelIdentifierValidator(registration.ChannelId); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -89,12 +106,14 @@ func DefaultHostGenesis() HostGenesisState {
 }
 
 // NewHostGenesisState creates a returns a new HostGenesisState instance
-func NewHostGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, port string, hostParams hosttypes.Params) HostGenesisState {
+func NewHostGenesisState(channels []ActiveChannel, accounts []RegisteredInterchainAccount, port string, hostParams hosttypes.Params, pendingPackets []hosttypes.PendingPacket, executionResults []hosttypes.PacketExecutionResult) HostGenesisState {
 	return HostGenesisState{
 		ActiveChannels:     channels,
 		InterchainAccounts: accounts,
 		Port:               port,
 		Params:             hostParams,
+		PendingPackets:     pendingPackets,
+		ExecutionResults:   executionResults,
 	}
 }
 
@@ -124,5 +143,25 @@ func (gs HostGenesisState) Validate() error {
 		return err
 	}
 
+	for _, pendingPacket := range gs.PendingPackets {
+		if err := pendingPacket.Packet.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if pendingPacket.ExecuteAfterHeight == 0 {
+			return fmt.Errorf("execute after height of pending packet with sequence %d on channel %s cannot be zero", pendingPacket.Packet.Sequence, pendingPacket.Packet.DestinationChannel)
+		}
+	}
+
+	for _, executionResult := range gs.ExecutionResults {
+		if err := executionResult.PacketId.Validate(); err != nil {
+			return err
+		}
+
+		if executionResult.ExpiryHeight == 0 {
+			return fmt.Errorf("expiry height of execution result with sequence %d on channel %s cannot be zero", executionResult.PacketId.Sequence, executionResult.PacketId.ChannelId)
+		}
+	}
+
 	return gs.Params.Validate()
 }
@@ -80,10 +80,11 @@ func (m *GenesisState) GetHostGenesisState() HostGenesisState {
 
 // ControllerGenesisState defines the interchain accounts controller genesis state
 type ControllerGenesisState struct {
-	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels"`
-	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts"`
-	Ports              []string                      `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
-	Params             types.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	ActiveChannels       []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels"`
+	InterchainAccounts   []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts"`
+	Ports                []string                      `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
+	Params               types.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	PendingRegistrations []PendingRegistration         `protobuf:"bytes,5,rep,name=pending_registrations,json=pendingRegistrations,proto3" json:"pending_registrations"`
 }
 
 func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{} }
@@ -147,12 +148,21 @@ func (m *ControllerGenesisState) GetParams() types.Params {
 	return types.Params{}
 }
 
+func (m *ControllerGenesisState) GetPendingRegistrations() []PendingRegistration {
+	if m != nil {
+		return m.PendingRegistrations
+	}
+	return nil
+}
+
 // HostGenesisState defines the interchain accounts host genesis state
 type HostGenesisState struct {
-	ActiveChannels     []ActiveChannel               `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels"`
-	InterchainAccounts []RegisteredInterchainAccount `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts"`
-	Port               string                        `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
-	Params             types1.Params                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	ActiveChannels     []ActiveChannel                `protobuf:"bytes,1,rep,name=active_channels,json=activeChannels,proto3" json:"active_channels"`
+	InterchainAccounts []RegisteredInterchainAccount  `protobuf:"bytes,2,rep,name=interchain_accounts,json=interchainAccounts,proto3" json:"interchain_accounts"`
+	Port               string                         `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	Params             types1.Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
+	PendingPackets     []types1.PendingPacket         `protobuf:"bytes,5,rep,name=pending_packets,json=pendingPackets,proto3" json:"pending_packets"`
+	ExecutionResults   []types1.PacketExecutionResult `protobuf:"bytes,6,rep,name=execution_results,json=executionResults,proto3" json:"execution_results"`
 }
 
 func (m *HostGenesisState) Reset()         { *m = HostGenesisState{} }
@@ -216,6 +226,20 @@ func (m *HostGenesisState) GetParams() types1.Params {
 	return types1.Params{}
 }
 
+func (m *HostGenesisState) GetPendingPackets() []types1.PendingPacket {
+	if m != nil {
+		return m.PendingPackets
+	}
+	return nil
+}
+
+func (m *HostGenesisState) GetExecutionResults() []types1.PacketExecutionResult {
+	if m != nil {
+		return m.ExecutionResults
+	}
+	return nil
+}
+
 // ActiveChannel contains a connection ID, port ID and associated active channel ID, as well as a boolean flag to
 // indicate if the channel is middleware enabled
 type ActiveChannel struct {
@@ -286,6 +310,76 @@ func (m *ActiveChannel) GetIsMiddlewareEnabled() bool {
 	return false
 }
 
+// PendingRegistration contains a connection ID, port ID and associated channel ID of an interchain account
+// registration handshake which is in flight, as well as the block height at which the registration was initiated
+type PendingRegistration struct {
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	PortId       string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId    string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	InitHeight   uint64 `protobuf:"varint,4,opt,name=init_height,json=initHeight,proto3" json:"init_height,omitempty"`
+}
+
+func (m *PendingRegistration) Reset()         { *m = PendingRegistration{} }
+func (m *PendingRegistration) String() string { return proto.CompactTextString(m) }
+func (*PendingRegistration) ProtoMessage()    {}
+func (*PendingRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4aa48c8e29a1947, []int{4}
+}
+func (m *PendingRegistration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRegistration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRegistration.Merge(m, src)
+}
+func (m *PendingRegistration) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRegistration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRegistration proto.InternalMessageInfo
+
+func (m *PendingRegistration) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *PendingRegistration) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PendingRegistration) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *PendingRegistration) GetInitHeight() uint64 {
+	if m != nil {
+		return m.InitHeight
+	}
+	return 0
+}
+
 // RegisteredInterchainAccount contains a connection ID, port ID and associated interchain account address
 type RegisteredInterchainAccount struct {
 	ConnectionId   string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
@@ -297,7 +391,7 @@ func (m *RegisteredInterchainAccount) Reset()         { *m = RegisteredInterchai
 func (m *RegisteredInterchainAccount) String() string { return proto.CompactTextString(m) }
 func (*RegisteredInterchainAccount) ProtoMessage()    {}
 func (*RegisteredInterchainAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4aa48c8e29a1947, []int{4}
+	return fileDescriptor_d4aa48c8e29a1947, []int{5}
 }
 func (m *RegisteredInterchainAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -352,6 +446,7 @@ func init() {
 	proto.RegisterType((*ControllerGenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.ControllerGenesisState")
 	proto.RegisterType((*HostGenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.HostGenesisState")
 	proto.RegisterType((*ActiveChannel)(nil), "ibc.applications.interchain_accounts.genesis.v1.ActiveChannel")
+	proto.RegisterType((*PendingRegistration)(nil), "ibc.applications.interchain_accounts.genesis.v1.PendingRegistration")
 	proto.RegisterType((*RegisteredInterchainAccount)(nil), "ibc.applications.interchain_accounts.genesis.v1.RegisteredInterchainAccount")
 }
 
@@ -360,44 +455,52 @@ func init() {
 }
 
 var fileDescriptor_d4aa48c8e29a1947 = []byte{
-	// 590 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x95, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x9b, 0xb4, 0x56, 0x3b, 0xfb, 0xc3, 0x65, 0x76, 0x5d, 0xc3, 0x8a, 0xb1, 0xd4, 0x83,
-	0xbd, 0x34, 0xa1, 0x55, 0x58, 0x11, 0x14, 0xba, 0x45, 0xd6, 0x82, 0x0b, 0x12, 0x2f, 0xe2, 0x25,
-	0x4c, 0x27, 0x43, 0x3a, 0x90, 0x64, 0x42, 0xde, 0xb4, 0xe2, 0x59, 0xc1, 0xa3, 0xfe, 0x09, 0xfe,
-	0x39, 0x7b, 0xdc, 0xa3, 0x27, 0x91, 0xf6, 0x0f, 0xf0, 0x2f, 0x10, 0x64, 0x26, 0xd9, 0xb6, 0xd6,
-	0x2a, 0x2d, 0x1e, 0x3d, 0x65, 0xe6, 0x7d, 0xf3, 0xbe, 0xef, 0x93, 0xf7, 0x92, 0x0c, 0x7a, 0xcc,
-	0x07, 0xd4, 0x25, 0x69, 0x1a, 0x71, 0x4a, 0x24, 0x17, 0x09, 0xb8, 0x3c, 0x91, 0x2c, 0xa3, 0x43,
-	0xc2, 0x13, 0x9f, 0x50, 0x2a, 0x46, 0x89, 0x04, 0x37, 0x64, 0x09, 0x03, 0x0e, 0xee, 0xb8, 0x7d,
-	0xb9, 0x74, 0xd2, 0x4c, 0x48, 0x81, 0x5d, 0x3e, 0xa0, 0xce, 0x62, 0xba, 0xb3, 0x22, 0xdd, 0xb9,
-	0xcc, 0x19, 0xb7, 0x8f, 0x0e, 0x42, 0x11, 0x0a, 0x9d, 0xeb, 0xaa, 0x55, 0x6e, 0x73, 0xd4, 0x5b,
-	0x8b, 0x82, 0x8a, 0x44, 0x66, 0x22, 0x8a, 0x58, 0xa6, 0x40, 0xe6, 0xbb, 0xc2, 0xe4, 0x78, 0x2d,
-	0x93, 0xa1, 0x00, 0xa9, 0xd2, 0xd5, 0x35, 0x4f, 0x6c, 0x7c, 0x34, 0xd1, 0xf6, 0x69, 0x8e, 0xf8,
-	0x52, 0x12, 0xc9, 0xf0, 0x07, 0x03, 0x59, 0x73, 0x7b, 0xbf, 0xc0, 0xf7, 0x41, 0x89, 0x96, 0x51,
-	0x37, 0x9a, 0x5b, 0x9d, 0x53, 0x67, 0xc3, 0x27, 0x77, 0x7a, 0x33, 0xc3, 0xc5, 0x5a, 0x27, 0x95,
-	0xf3, 0xaf, 0x77, 0x4a, 0xde, 0x21, 0x5d, 0xa9, 0xe2, 0x11, 0xc2, 0x0a, 0x74, 0x09, 0xc1, 0xd4,
-	0x08, 0xdd, 0x8d, 0x11, 0x9e, 0x09, 0x90, 0x2b, 0x8a, 0xef, 0x0d, 0x97, 0xe2, 0x8d, 0x1f, 0x26,
-	0x3a, 0x5c, 0xcd, 0x8b, 0x63, 0x74, 0x9d, 0x50, 0xc9, 0xc7, 0xcc, 0xa7, 0x43, 0x92, 0x24, 0x2c,
-	0x02, 0xcb, 0xa8, 0x97, 0x9b, 0x5b, 0x9d, 0x27, 0x1b, 0xe3, 0x74, 0xb5, 0x4f, 0x2f, 0xb7, 0x29,
-	0x58, 0x76, 0xc9, 0x62, 0x10, 0xf0, 0x3b, 0x03, 0xed, 0xaf, 0xb0, 0xb1, 0x4c, 0x5d, 0xf3, 0xf9,
-	0xc6, 0x35, 0x3d, 0x16, 0x72, 0x90, 0x2c, 0x63, 0x41, 0x7f, 0x76, 0x63, 0x37, 0xbf, 0xaf, 0x20,
-	0xc0, 0x7c, 0x59, 0x00, 0x7c, 0x80, 0xae, 0xa4, 0x22, 0x93, 0x60, 0x95, 0xeb, 0xe5, 0x66, 0xcd,
-	0xcb, 0x37, 0xf8, 0x15, 0xaa, 0xa6, 0x24, 0x23, 0x31, 0x58, 0x15, 0x3d, 0x90, 0x47, 0xeb, 0xd1,
-	0x2c, 0xbc, 0xb8, 0xe3, 0xb6, 0xf3, 0x42, 0x3b, 0x14, 0xb5, 0x0b, 0xbf, 0xc6, 0x77, 0x13, 0xed,
-	0x2d, 0x0f, 0xeb, 0xff, 0xec, 0x3c, 0x46, 0x15, 0xd5, 0x6c, 0xab, 0x5c, 0x37, 0x9a, 0x35, 0x4f,
-	0xaf, 0xb1, 0xb7, 0xd4, 0xf7, 0x07, 0xeb, 0xb1, 0xe8, 0x2f, 0xfe, 0x4f, 0x1d, 0xff, 0x6c, 0xa0,
-	0x9d, 0x5f, 0xba, 0x82, 0xef, 0xa2, 0x1d, 0x2a, 0x92, 0x84, 0x51, 0xe5, 0xe8, 0xf3, 0x40, 0x7f,
-	0xf8, 0x35, 0x6f, 0x7b, 0x1e, 0xec, 0x07, 0xf8, 0x26, 0xba, 0xaa, 0x90, 0x94, 0x6c, 0x6a, 0xb9,
-	0xaa, 0xb6, 0xfd, 0x00, 0xdf, 0x46, 0xa8, 0x98, 0x92, 0xd2, 0x72, 0xfa, 0x5a, 0x11, 0xe9, 0x07,
-	0xb8, 0x83, 0x6e, 0x70, 0xf0, 0x63, 0x1e, 0x04, 0x11, 0x7b, 0x43, 0x32, 0xe6, 0xb3, 0x84, 0x0c,
-	0x22, 0x16, 0xe8, 0x27, 0xba, 0xe6, 0xed, 0x73, 0x38, 0x9b, 0x69, 0x4f, 0x73, 0xa9, 0xf1, 0xde,
-	0x40, 0xb7, 0xfe, 0xd2, 0xc4, 0x7f, 0x04, 0xbe, 0xa7, 0xde, 0x2e, 0x6d, 0xe4, 0x93, 0x20, 0xc8,
-	0x18, 0x40, 0x41, 0xbd, 0x5b, 0x84, 0xbb, 0x79, 0xf4, 0x24, 0x3c, 0x9f, 0xd8, 0xc6, 0xc5, 0xc4,
-	0x36, 0xbe, 0x4d, 0x6c, 0xe3, 0xd3, 0xd4, 0x2e, 0x5d, 0x4c, 0xed, 0xd2, 0x97, 0xa9, 0x5d, 0x7a,
-	0x7d, 0x16, 0x72, 0x39, 0x1c, 0x0d, 0x1c, 0x2a, 0x62, 0x97, 0x0a, 0x88, 0x05, 0xa8, 0xe3, 0xa1,
-	0x15, 0x0a, 0x77, 0xfc, 0xd0, 0x8d, 0x45, 0x30, 0x8a, 0x18, 0xa8, 0x1f, 0x34, 0xb8, 0x9d, 0xe3,
-	0xd6, 0x7c, 0x42, 0xad, 0xdf, 0x8e, 0x19, 0xf9, 0x36, 0x65, 0x30, 0xa8, 0xea, 0xbf, 0xf3, 0xfd,
-	0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xca, 0x57, 0x5a, 0x11, 0xa3, 0x06, 0x00, 0x00,
+	// 715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x96, 0xcf, 0x6f, 0xd3, 0x3c,
+	0x18, 0xc7, 0x9b, 0xb6, 0xeb, 0xfb, 0xd6, 0xfb, 0xf9, 0x7a, 0x3f, 0xde, 0x68, 0x88, 0xae, 0x2a,
+	0x07, 0x7a, 0x59, 0xa2, 0x15, 0xa4, 0x21, 0x10, 0x48, 0x5d, 0x99, 0xb6, 0x4a, 0x4c, 0x9a, 0xc2,
+	0x05, 0x71, 0x89, 0x5c, 0xc7, 0x4a, 0x0c, 0xa9, 0x1d, 0xc5, 0x6e, 0x81, 0x13, 0x07, 0x90, 0x38,
+	0x82, 0xc4, 0x3f, 0xc0, 0x9f, 0xb3, 0xe3, 0x24, 0x2e, 0x9c, 0x10, 0xda, 0xfe, 0x0f, 0x84, 0xec,
+	0x24, 0x6b, 0xd7, 0x15, 0xd4, 0x08, 0x71, 0xe2, 0x14, 0xfb, 0x79, 0xf2, 0x7c, 0x9e, 0xaf, 0xf3,
+	0x75, 0x9c, 0x80, 0xfb, 0xb4, 0x87, 0x6d, 0x14, 0x45, 0x21, 0xc5, 0x48, 0x52, 0xce, 0x84, 0x4d,
+	0x99, 0x24, 0x31, 0x0e, 0x10, 0x65, 0x2e, 0xc2, 0x98, 0x0f, 0x98, 0x14, 0xb6, 0x4f, 0x18, 0x11,
+	0x54, 0xd8, 0xc3, 0x9d, 0x6c, 0x68, 0x45, 0x31, 0x97, 0x1c, 0xda, 0xb4, 0x87, 0xad, 0xf1, 0x72,
+	0x6b, 0x4a, 0xb9, 0x95, 0xd5, 0x0c, 0x77, 0x36, 0xd7, 0x7c, 0xee, 0x73, 0x5d, 0x6b, 0xab, 0x51,
+	0x82, 0xd9, 0xec, 0xcc, 0xa4, 0x02, 0x73, 0x26, 0x63, 0x1e, 0x86, 0x24, 0x56, 0x42, 0x46, 0xb3,
+	0x14, 0xb2, 0x3b, 0x13, 0x24, 0xe0, 0x42, 0xaa, 0x72, 0x75, 0x4d, 0x0a, 0x1b, 0xef, 0x8b, 0x60,
+	0xe1, 0x20, 0x91, 0xf8, 0x58, 0x22, 0x49, 0xe0, 0x3b, 0x03, 0x98, 0x23, 0xbc, 0x9b, 0xca, 0x77,
+	0x85, 0x4a, 0x9a, 0x46, 0xdd, 0x68, 0xce, 0xb7, 0x0e, 0xac, 0x9c, 0x2b, 0xb7, 0x3a, 0x17, 0xc0,
+	0xf1, 0x5e, 0x7b, 0xe5, 0x93, 0xaf, 0x5b, 0x05, 0x67, 0x03, 0x4f, 0xcd, 0xc2, 0x01, 0x80, 0x4a,
+	0xe8, 0x84, 0x84, 0xa2, 0x96, 0xd0, 0xce, 0x2d, 0xe1, 0x90, 0x0b, 0x39, 0xa5, 0xf9, 0x4a, 0x30,
+	0x11, 0x6f, 0x7c, 0x2f, 0x81, 0x8d, 0xe9, 0x7a, 0x61, 0x1f, 0x2c, 0x23, 0x2c, 0xe9, 0x90, 0xb8,
+	0x38, 0x40, 0x8c, 0x91, 0x50, 0x98, 0x46, 0xbd, 0xd4, 0x9c, 0x6f, 0x3d, 0xc8, 0x2d, 0xa7, 0xad,
+	0x39, 0x9d, 0x04, 0x93, 0x6a, 0x59, 0x42, 0xe3, 0x41, 0x01, 0xdf, 0x18, 0x60, 0x75, 0x0a, 0xc6,
+	0x2c, 0xea, 0x9e, 0x8f, 0x72, 0xf7, 0x74, 0x88, 0x4f, 0x85, 0x24, 0x31, 0xf1, 0xba, 0x17, 0x37,
+	0xb6, 0x93, 0xfb, 0x52, 0x05, 0x90, 0x4e, 0x26, 0x04, 0x5c, 0x03, 0x73, 0x11, 0x8f, 0xa5, 0x30,
+	0x4b, 0xf5, 0x52, 0xb3, 0xea, 0x24, 0x13, 0xf8, 0x04, 0x54, 0x22, 0x14, 0xa3, 0xbe, 0x30, 0xcb,
+	0xda, 0x90, 0xbb, 0xb3, 0xa9, 0x19, 0xdb, 0xb8, 0xc3, 0x1d, 0xeb, 0x58, 0x13, 0xd2, 0xde, 0x29,
+	0x0f, 0xbe, 0x06, 0xeb, 0x11, 0x61, 0x1e, 0x65, 0xbe, 0x1b, 0x6b, 0xc1, 0x71, 0xc2, 0x33, 0xe7,
+	0xf4, 0xb2, 0x1f, 0xe6, 0x5e, 0xf6, 0x71, 0x42, 0x73, 0xc6, 0x60, 0x69, 0xcb, 0xb5, 0xe8, 0x6a,
+	0x4a, 0x34, 0x3e, 0x97, 0xc1, 0xca, 0xe4, 0x6e, 0xf9, 0x3b, 0xad, 0x87, 0xa0, 0xac, 0xdc, 0x36,
+	0x4b, 0x75, 0xa3, 0x59, 0x75, 0xf4, 0x18, 0x3a, 0x13, 0xc6, 0xdf, 0x9e, 0x4d, 0x8b, 0x3e, 0x72,
+	0x7e, 0x66, 0xf9, 0x33, 0xb0, 0x9c, 0x59, 0x1e, 0x21, 0xfc, 0x9c, 0xc8, 0xcc, 0xec, 0x7b, 0x39,
+	0xe1, 0x09, 0xe4, 0x58, 0x33, 0xb2, 0x27, 0x1b, 0x8d, 0x07, 0x05, 0x1c, 0x82, 0xff, 0xc8, 0x4b,
+	0x82, 0x07, 0x8a, 0xe6, 0xc6, 0x44, 0x0c, 0x42, 0x29, 0xcc, 0x8a, 0xee, 0xd6, 0xc9, 0xbb, 0x14,
+	0x45, 0xdc, 0xcf, 0x60, 0x8e, 0x66, 0x65, 0xc7, 0x0a, 0xb9, 0x1c, 0x16, 0x8d, 0x4f, 0x06, 0x58,
+	0xbc, 0xe4, 0x3c, 0xbc, 0x01, 0x16, 0x31, 0x67, 0x8c, 0x60, 0x2d, 0x85, 0x7a, 0xfa, 0x74, 0xad,
+	0x3a, 0x0b, 0xa3, 0x60, 0xd7, 0x83, 0xff, 0x83, 0x7f, 0xd4, 0x63, 0x57, 0xe9, 0xa2, 0x4e, 0x57,
+	0xd4, 0xb4, 0xeb, 0xc1, 0xeb, 0x00, 0xa4, 0x3b, 0x51, 0xe5, 0x12, 0x87, 0xaa, 0x69, 0xa4, 0xeb,
+	0xc1, 0x16, 0x58, 0xa7, 0xc2, 0xed, 0x53, 0xcf, 0x0b, 0xc9, 0x0b, 0x14, 0x13, 0x97, 0x30, 0xd4,
+	0x0b, 0x89, 0xa7, 0x5d, 0xfb, 0xd7, 0x59, 0xa5, 0xe2, 0xe8, 0x22, 0xb7, 0x9f, 0xa4, 0x1a, 0x1f,
+	0x0d, 0xb0, 0x3a, 0xe5, 0x65, 0xf9, 0xb3, 0x42, 0xb7, 0xc0, 0x3c, 0x65, 0x54, 0xba, 0x01, 0xa1,
+	0x7e, 0x20, 0xb5, 0xbc, 0xb2, 0x03, 0x54, 0xe8, 0x50, 0x47, 0x1a, 0x6f, 0x0d, 0x70, 0xed, 0x17,
+	0xdb, 0xf7, 0x37, 0xd5, 0xdd, 0x54, 0xef, 0xb5, 0x06, 0xb9, 0xc8, 0xf3, 0x62, 0x22, 0x44, 0x2a,
+	0x71, 0x29, 0x0d, 0xb7, 0x93, 0xe8, 0x9e, 0x7f, 0x72, 0x56, 0x33, 0x4e, 0xcf, 0x6a, 0xc6, 0xb7,
+	0xb3, 0x9a, 0xf1, 0xe1, 0xbc, 0x56, 0x38, 0x3d, 0xaf, 0x15, 0xbe, 0x9c, 0xd7, 0x0a, 0x4f, 0x8f,
+	0x7c, 0x2a, 0x83, 0x41, 0xcf, 0xc2, 0xbc, 0x6f, 0x63, 0x2e, 0xfa, 0x5c, 0xa8, 0x3f, 0x83, 0x6d,
+	0x9f, 0xdb, 0xc3, 0x3b, 0x76, 0x9f, 0x7b, 0x83, 0x90, 0x08, 0xf5, 0x6d, 0x16, 0x76, 0x6b, 0x77,
+	0x7b, 0xb4, 0xa1, 0xb6, 0xaf, 0xfc, 0x61, 0xc8, 0x57, 0x11, 0x11, 0xbd, 0x8a, 0xfe, 0x30, 0xdf,
+	0xfa, 0x31, 0x00, 0x47, 0x9a, 0x4b, 0x24, 0x9e, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -463,6 +566,20 @@ func (m *ControllerGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingRegistrations) > 0 {
+		for iNdEx := len(m.PendingRegistrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRegistrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -533,6 +650,34 @@ func (m *HostGenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExecutionResults) > 0 {
+		for iNdEx := len(m.ExecutionResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PendingPackets) > 0 {
+		for iNdEx := len(m.PendingPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -635,6 +780,55 @@ func (m *ActiveChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingRegistration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRegistration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRegistration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InitHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.InitHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RegisteredInterchainAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -729,6 +923,12 @@ func (m *ControllerGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingRegistrations) > 0 {
+		for _, e := range m.PendingRegistrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -756,6 +956,18 @@ func (m *HostGenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PendingPackets) > 0 {
+		for _, e := range m.PendingPackets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExecutionResults) > 0 {
+		for _, e := range m.ExecutionResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -783,6 +995,30 @@ func (m *ActiveChannel) Size() (n int) {
 	return n
 }
 
+func (m *PendingRegistration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.InitHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.InitHeight))
+	}
+	return n
+}
+
 func (m *RegisteredInterchainAccount) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1088,6 +1324,40 @@ func (m *ControllerGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRegistrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRegistrations = append(m.PendingRegistrations, PendingRegistration{})
+			if err := m.PendingRegistrations[len(m.PendingRegistrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1271,6 +1541,74 @@ func (m *HostGenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPackets = append(m.PendingPackets, types1.PendingPacket{})
+			if err := m.PendingPackets[len(m.PendingPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionResults = append(m.ExecutionResults, types1.PacketExecutionResult{})
+			if err := m.ExecutionResults[len(m.ExecutionResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1458,6 +1796,171 @@ func (m *ActiveChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PendingRegistration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRegistration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRegistration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitHeight", wireType)
+			}
+			m.InitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RegisteredInterchainAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,6 +9,8 @@ import (
 	genesistypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/genesis/types"
 	hosttypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -106,7 +108,7 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -120,7 +122,7 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -141,7 +143,7 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -162,7 +164,7 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{}, controllertypes.DefaultParams(), nil)
 			},
 			false,
 		},
@@ -183,7 +185,39 @@ func (suite *GenesisTypesTestSuite) TestValidateControllerGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{"invalid|port"}, controllertypes.DefaultParams())
+				genesisState = genesistypes.NewControllerGenesisState(activeChannels, registeredAccounts, []string{"invalid|port"}, controllertypes.DefaultParams(), nil)
+			},
+			false,
+		},
+		{
+			"failed to validate pending registration - invalid connection identifier",
+			func() {
+				pendingRegistrations := []genesistypes.PendingRegistration{
+					{
+						ConnectionId: "invalid|connection",
+						PortId:       TestPortID,
+						ChannelId:    ibctesting.FirstChannelID,
+						InitHeight:   1,
+					},
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, []string{}, controllertypes.DefaultParams(), pendingRegistrations)
+			},
+			false,
+		},
+		{
+			"failed to validate pending registration - invalid channel identifier",
+			func() {
+				pendingRegistrations := []genesistypes.PendingRegistration{
+					{
+						ConnectionId: ibctesting.FirstConnectionID,
+						PortId:       TestPortID,
+						ChannelId:    "invalid|channel",
+						InitHeight:   1,
+					},
+				}
+
+				genesisState = genesistypes.NewControllerGenesisState(nil, nil, []string{}, controllertypes.DefaultParams(), pendingRegistrations)
 			},
 			false,
 		},
@@ -231,7 +265,7 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams(), nil, nil)
 			},
 			false,
 		},
@@ -245,7 +279,7 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams(), nil, nil)
 			},
 			false,
 		},
@@ -266,7 +300,7 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.HostPortID, hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.HostPortID, hosttypes.DefaultParams(), nil, nil)
 			},
 			false,
 		},
@@ -287,7 +321,7 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.HostPortID, hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, icatypes.HostPortID, hosttypes.DefaultParams(), nil, nil)
 			},
 			false,
 		},
@@ -308,7 +342,65 @@ func (suite *GenesisTypesTestSuite) TestValidateHostGenesisState() {
 					},
 				}
 
-				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, "invalid|port", hosttypes.DefaultParams())
+				genesisState = genesistypes.NewHostGenesisState(activeChannels, registeredAccounts, "invalid|port", hosttypes.DefaultParams(), nil, nil)
+			},
+			false,
+		},
+		{
+			"failed to validate pending packets - invalid packet",
+			func() {
+				pendingPackets := []hosttypes.PendingPacket{
+					{
+						Packet:             channeltypes.Packet{},
+						ExecuteAfterHeight: 100,
+					},
+				}
+
+				genesisState = genesistypes.NewHostGenesisState([]genesistypes.ActiveChannel{}, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams(), pendingPackets, nil)
+			},
+			false,
+		},
+		{
+			"failed to validate pending packets - zero execute after height",
+			func() {
+				pendingPackets := []hosttypes.PendingPacket{
+					{
+						Packet:             channeltypes.NewPacket([]byte("data"), 1, TestPortID, ibctesting.FirstChannelID, icatypes.HostPortID, ibctesting.FirstChannelID, clienttypes.NewHeight(0, 100), 0),
+						ExecuteAfterHeight: 0,
+					},
+				}
+
+				genesisState = genesistypes.NewHostGenesisState([]genesistypes.ActiveChannel{}, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams(), pendingPackets, nil)
+			},
+			false,
+		},
+		{
+			"failed to validate execution results - invalid packet identifier",
+			func() {
+				executionResults := []hosttypes.PacketExecutionResult{
+					{
+						PacketId:     channeltypes.NewPacketID(icatypes.HostPortID, "invalid|channel", 1),
+						Result:       []byte("result"),
+						ExpiryHeight: 100,
+					},
+				}
+
+				genesisState = genesistypes.NewHostGenesisState([]genesistypes.ActiveChannel{}, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams(), nil, executionResults)
+			},
+			false,
+		},
+		{
+			"failed to validate execution results - zero expiry height",
+			func() {
+				executionResults := []hosttypes.PacketExecutionResult{
+					{
+						PacketId:     channeltypes.NewPacketID(icatypes.HostPortID, ibctesting.FirstChannelID, 1),
+						Result:       []byte("result"),
+						ExpiryHeight: 0,
+					},
+				}
+
+				genesisState = genesistypes.NewHostGenesisState([]genesistypes.ActiveChannel{}, []genesistypes.RegisteredInterchainAccount{}, icatypes.HostPortID, hosttypes.DefaultParams(), nil, executionResults)
 			},
 			false,
 		},
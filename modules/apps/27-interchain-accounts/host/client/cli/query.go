@@ -98,3 +98,79 @@ func GetCmdPacketEvents() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdPacketExecutionResult returns the command handler for the host packet execution result querying.
+func GetCmdPacketExecutionResult() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "packet-execution-result [channel-id] [sequence]",
+		Short:   "Query the full execution result of a packet whose acknowledgement has been truncated",
+		Long:    "Query the full transaction result of a packet executed by the interchain-accounts host submodule whose acknowledgement has been truncated",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query interchain-accounts host packet-execution-result channel-0 100", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			seq, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PacketExecutionResult(cmd.Context(), &types.QueryPacketExecutionResultRequest{
+				PortId:    icatypes.HostPortID,
+				ChannelId: args[0],
+				Sequence:  seq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdInterchainAccountExecutions returns the command handler for the interchain account execution log querying.
+func GetCmdInterchainAccountExecutions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "executions [address]",
+		Short:   "Query the execution log of an interchain account",
+		Long:    "Query the transactions executed by an interchain account, as recorded in its execution log by the interchain-accounts host submodule",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query interchain-accounts host executions cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.InterchainAccountExecutions(cmd.Context(), &types.QueryInterchainAccountExecutionsRequest{
+				Address:    args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "executions")
+
+	return cmd
+}
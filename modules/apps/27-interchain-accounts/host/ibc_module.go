@@ -119,13 +119,25 @@ func (im IBCModule) OnRecvPacket(
 	if !im.keeper.GetParams(ctx).HostEnabled {
 		im.keeper.Logger(ctx).Info("host submodule is disabled")
 		keeper.EmitHostDisabledEvent(ctx, packet)
-		return channeltypes.NewErrorAcknowledgement(types.ErrHostSubModuleDisabled)
+		return channeltypes.NewErrorAcknowledgementWithCode(types.ErrHostSubModuleDisabled)
+	}
+
+	deferred, err := im.keeper.DeferPacketExecution(ctx, packet)
+	if deferred {
+		im.keeper.Logger(ctx).Info("deferred packet execution", "sequence", packet.Sequence)
+
+		// NOTE: acknowledgement will be written asynchronously once the packet is executed in BeginBlock.
+		return nil
+	}
+
+	var txResponse []byte
+	if err == nil {
+		txResponse, err = im.keeper.OnRecvPacket(ctx, packet)
 	}
 
-	txResponse, err := im.keeper.OnRecvPacket(ctx, packet)
 	ack := channeltypes.NewResultAcknowledgement(txResponse)
 	if err != nil {
-		ack = channeltypes.NewErrorAcknowledgement(err)
+		ack = channeltypes.NewErrorAcknowledgementWithCode(err)
 		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", err.Error(), packet.Sequence))
 	} else {
 		im.keeper.Logger(ctx).Info("successfully handled packet", "sequence", packet.Sequence)
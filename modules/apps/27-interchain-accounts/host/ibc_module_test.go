@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/gogoproto/proto"
 	testifysuite "github.com/stretchr/testify/suite"
 
+	errorsmod "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -14,6 +15,8 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	controllerkeeper "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/keeper"
+	controllertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
 	icahost "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host"
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
@@ -114,6 +117,37 @@ func SetupICAPath(path *ibctesting.Path, owner string) error {
 	return path.EndpointB.ChanOpenConfirm()
 }
 
+// SetupUnorderedICAPath registers an interchain account over an UNORDERED channel using MsgRegisterInterchainAccount
+// and completes the channel handshake.
+func SetupUnorderedICAPath(path *ibctesting.Path, owner string) error {
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+
+	msgServer := controllerkeeper.NewMsgServerImpl(&path.EndpointA.Chain.GetSimApp().ICAControllerKeeper)
+	msg := controllertypes.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, owner, path.EndpointA.ChannelConfig.Version, channeltypes.UNORDERED)
+	res, err := msgServer.RegisterInterchainAccount(path.EndpointA.Chain.GetContext(), msg)
+	if err != nil {
+		return err
+	}
+
+	// commit state changes for proof verification
+	path.EndpointA.Chain.NextBlock()
+
+	// update port/channel ids
+	path.EndpointA.ChannelID = res.ChannelId
+	path.EndpointA.ChannelConfig.PortID = res.PortId
+
+	if err := path.EndpointB.ChanOpenTry(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointA.ChanOpenAck(); err != nil {
+		return err
+	}
+
+	return path.EndpointB.ChanOpenConfirm()
+}
+
 // Test initiating a ChanOpenInit using the host chain instead of the controller chain
 // ChainA is the controller chain. ChainB is the host chain
 func (suite *InterchainAccountsTestSuite) TestChanOpenInit() {
@@ -401,15 +435,17 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 		malleate      func()
 		expAckSuccess bool
 		eventErrorMsg string
+		expAckError   *errorsmod.Error
 	}{
 		{
-			"success", func() {}, true, "",
+			"success", func() {}, true, "", nil,
 		},
 		{
 			"host submodule disabled", func() {
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
 			}, false,
 			types.ErrHostSubModuleDisabled.Error(),
+			types.ErrHostSubModuleDisabled,
 		},
 		{
 			"success with ICA auth module callback failure", func() {
@@ -420,12 +456,14 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 				}
 			}, true,
 			"failed OnRecvPacket mock callback",
+			nil,
 		},
 		{
 			"ICA OnRecvPacket fails - cannot unmarshal packet data", func() {
 				packetData = []byte("invalid data")
 			}, false,
 			"cannot unmarshal ICS-27 interchain account packet data: unknown data type",
+			icatypes.ErrUnknownDataType,
 		},
 	}
 
@@ -516,6 +554,11 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 
 			} else {
 				suite.Require().False(ack.Success())
+				suite.Require().Equal(channeltypes.NewErrorAcknowledgementWithCode(tc.expAckError), ack)
+
+				errAck, err := channeltypes.ParseErrorAcknowledgement(ack.(channeltypes.Acknowledgement))
+				suite.Require().NoError(err)
+				suite.Require().True(errAck.IsError(tc.expAckError))
 
 				expectedAttributes = append(expectedAttributes, sdk.NewAttribute(icatypes.AttributeKeyAckError, tc.eventErrorMsg))
 				expectedEvents := sdk.Events{
@@ -893,3 +936,176 @@ func (suite *InterchainAccountsTestSuite) TestPacketDataUnmarshalerInterface() {
 	suite.Require().Error(err)
 	suite.Require().Nil(packetData)
 }
+
+// TestDeferredPacketExecution tests that packets providing an execute after height in the memo are executed and
+// acknowledged in the BeginBlock of that height, and that packets exceeding the pending packet queue are
+// acknowledged with an error.
+func (suite *InterchainAccountsTestSuite) TestDeferredPacketExecution() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	err := SetupUnorderedICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	var (
+		startingBal = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000)))
+		tokenAmt    = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(5000)))
+	)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, startingBal)
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      tokenAmt,
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+	params.MaxPendingPackets = 1
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	executeAfterHeight := uint64(suite.chainB.GetContext().BlockHeight()) + 20
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: fmt.Sprintf(`{"%s": "%d"}`, icatypes.ExecuteAfterHeightMemoKey, executeAfterHeight),
+	}
+
+	sendAndRecvPacket := func() channeltypes.Packet {
+		sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), nil, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, icaPacketData, ^uint64(0))
+		suite.Require().NoError(err)
+
+		// commit the packet commitment for proof verification
+		suite.chainA.NextBlock()
+		err = path.EndpointB.UpdateClient()
+		suite.Require().NoError(err)
+
+		packet := channeltypes.NewPacket(icaPacketData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+		err = path.EndpointB.RecvPacket(packet)
+		suite.Require().NoError(err)
+
+		return packet
+	}
+
+	getAckCommitment := func(packet channeltypes.Packet) ([]byte, bool) {
+		return suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	}
+
+	// the first packet is queued and not yet executed
+	deferredPacket := sendAndRecvPacket()
+
+	_, found = getAckCommitment(deferredPacket)
+	suite.Require().False(found)
+	suite.Require().Equal(uint64(1), suite.chainB.GetSimApp().ICAHostKeeper.GetPendingPacketCount(suite.chainB.GetContext()))
+	suite.assertBalance(icaAddr, startingBal)
+
+	// the second packet exceeds the pending packet queue and is acknowledged with an error
+	rejectedPacket := sendAndRecvPacket()
+
+	ackCommitment, found := getAckCommitment(rejectedPacket)
+	suite.Require().True(found)
+	expAck := channeltypes.NewErrorAcknowledgementWithCode(types.ErrMaxPendingPackets)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck.Acknowledgement()), ackCommitment)
+	suite.Require().Equal(uint64(1), suite.chainB.GetSimApp().ICAHostKeeper.GetPendingPacketCount(suite.chainB.GetContext()))
+
+	// the deferred packet is executed once the execute after height is reached
+	for uint64(suite.chainB.GetContext().BlockHeight()) <= executeAfterHeight {
+		_, found = getAckCommitment(deferredPacket)
+		suite.Require().False(found, "packet executed before the execute after height")
+
+		suite.coordinator.CommitBlock(suite.chainB)
+	}
+
+	_, found = getAckCommitment(deferredPacket)
+	suite.Require().True(found)
+	suite.Require().Zero(suite.chainB.GetSimApp().ICAHostKeeper.GetPendingPacketCount(suite.chainB.GetContext()))
+	suite.assertBalance(icaAddr, startingBal.Sub(tokenAmt...))
+}
+
+// TestDeferredPacketExecutionOrderedChannel tests that packets received on an ORDERED channel are not deferred, so
+// that the packets of the channel are executed in order: a packet providing an execute after height is acknowledged
+// with an error and the following packet is executed when it is received.
+func (suite *InterchainAccountsTestSuite) TestDeferredPacketExecutionOrderedChannel() {
+	path := NewICAPath(suite.chainA, suite.chainB)
+	path.SetupConnections()
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	var (
+		startingBal = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100000)))
+		tokenAmt    = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(5000)))
+	)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, startingBal)
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), path.EndpointB.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+	suite.Require().NoError(err)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      tokenAmt,
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	sendAndRecvPacket := func(memo string) channeltypes.Packet {
+		icaPacketData := icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+			Memo: memo,
+		}
+
+		sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), nil, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, icaPacketData, ^uint64(0))
+		suite.Require().NoError(err)
+
+		// commit the packet commitment for proof verification
+		suite.chainA.NextBlock()
+		err = path.EndpointB.UpdateClient()
+		suite.Require().NoError(err)
+
+		packet := channeltypes.NewPacket(icaPacketData.GetBytes(), sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), ^uint64(0))
+		err = path.EndpointB.RecvPacket(packet)
+		suite.Require().NoError(err)
+
+		return packet
+	}
+
+	getAckCommitment := func(packet channeltypes.Packet) []byte {
+		ackCommitment, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+		suite.Require().True(found)
+
+		return ackCommitment
+	}
+
+	// the packet requesting deferred execution is acknowledged with an error instead of being queued
+	executeAfterHeight := uint64(suite.chainB.GetContext().BlockHeight()) + 20
+	rejectedPacket := sendAndRecvPacket(fmt.Sprintf(`{"%s": "%d"}`, icatypes.ExecuteAfterHeightMemoKey, executeAfterHeight))
+
+	expAck := channeltypes.NewErrorAcknowledgementWithCode(types.ErrDeferredExecutionOrdered)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck.Acknowledgement()), getAckCommitment(rejectedPacket))
+	suite.Require().Zero(suite.chainB.GetSimApp().ICAHostKeeper.GetPendingPacketCount(suite.chainB.GetContext()))
+	suite.assertBalance(icaAddr, startingBal)
+
+	// the next packet of the channel is executed when it is received
+	executedPacket := sendAndRecvPacket("")
+
+	suite.Require().NotEqual(channeltypes.CommitAcknowledgement(expAck.Acknowledgement()), getAckCommitment(executedPacket))
+	suite.Require().Zero(suite.chainB.GetSimApp().ICAHostKeeper.GetPendingPacketCount(suite.chainB.GetContext()))
+	suite.assertBalance(icaAddr, startingBal.Sub(tokenAmt...))
+}
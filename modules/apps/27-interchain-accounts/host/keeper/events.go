@@ -32,6 +32,48 @@ func EmitAcknowledgementEvent(ctx sdk.Context, packet channeltypes.Packet, ack e
 	)
 }
 
+// EmitPacketDeferredEvent emits an event signalling that the execution of a packet has been deferred until the
+// provided execute after height.
+func EmitPacketDeferredEvent(ctx sdk.Context, packet channeltypes.Packet, executeAfterHeight uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypePacketDeferred,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, packet.GetDestChannel()),
+			sdk.NewAttribute(icatypes.AttributeKeyPacketSequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(icatypes.AttributeKeyExecuteAfterHeight, fmt.Sprintf("%d", executeAfterHeight)),
+		),
+	)
+}
+
+// EmitPendingPacketDroppedEvent emits an event signalling that a pending packet has been removed from the queue
+// without being acknowledged, including the reason.
+func EmitPendingPacketDroppedEvent(ctx sdk.Context, packet channeltypes.Packet, err error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypePendingPacketDropped,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, packet.GetDestChannel()),
+			sdk.NewAttribute(icatypes.AttributeKeyPacketSequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(icatypes.AttributeKeyAckError, err.Error()),
+		),
+	)
+}
+
+// EmitAckTruncatedEvent emits an event signalling that the acknowledgement of a packet has been truncated and its
+// full execution result is stored until the provided expiry height.
+func EmitAckTruncatedEvent(ctx sdk.Context, packet channeltypes.Packet, expiryHeight uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypeAckTruncated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, packet.GetDestChannel()),
+			sdk.NewAttribute(icatypes.AttributeKeyPacketSequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(icatypes.AttributeKeyExpiryHeight, fmt.Sprintf("%d", expiryHeight)),
+		),
+	)
+}
+
 // EmitHostDisabledEvent emits an event signalling that the host submodule is disabled.
 func EmitHostDisabledEvent(ctx sdk.Context, packet channeltypes.Packet) {
 	ctx.EventManager().EmitEvent(
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+)
+
+// logExecution appends an entry recording the execution of the provided messages to the execution log of the
+// provided interchain account if the execution log is enabled. The oldest entries of the account are pruned once
+// the maximum number of execution log entries is exceeded.
+func (k Keeper) logExecution(ctx sdk.Context, address string, msgs []sdk.Msg, success bool) {
+	params := k.GetParams(ctx)
+	if !params.EnableExecutionLog {
+		return
+	}
+
+	msgTypeURLs := make([]string, len(msgs))
+	for i, msg := range msgs {
+		msgTypeURLs[i] = sdk.MsgTypeURL(msg)
+	}
+
+	sequence := k.getNextExecutionLogSequence(ctx, address)
+	entry := types.ExecutionLogEntry{
+		Sequence:    sequence,
+		Height:      uint64(ctx.BlockHeight()),
+		MsgTypeUrls: msgTypeURLs,
+		Success:     success,
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyExecutionLogEntry(address, sequence), k.cdc.MustMarshal(&entry))
+	k.setNextExecutionLogSequence(ctx, address, sequence+1)
+
+	k.pruneExecutionLog(ctx, address, sequence+1, params.MaxExecutionLogEntries)
+}
+
+// pruneExecutionLog deletes the entries of the execution log of the provided interchain account which exceed the
+// maximum number of entries, starting from the oldest entry.
+func (k Keeper) pruneExecutionLog(ctx sdk.Context, address string, nextSequence, maxEntries uint64) {
+	if nextSequence <= maxEntries {
+		return
+	}
+
+	var prunedKeys [][]byte
+	k.IterateExecutionLog(ctx, address, func(entry types.ExecutionLogEntry) bool {
+		if entry.Sequence >= nextSequence-maxEntries {
+			return true
+		}
+
+		prunedKeys = append(prunedKeys, types.KeyExecutionLogEntry(address, entry.Sequence))
+		return false
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range prunedKeys {
+		store.Delete(key)
+	}
+}
+
+// IterateExecutionLog iterates over the execution log entries of the provided interchain account in ascending order
+// of sequence and calls the provided callback for each of them, until stop=true is returned.
+func (k Keeper) IterateExecutionLog(ctx sdk.Context, address string, cb func(entry types.ExecutionLogEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyExecutionLogPrefix(address))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.ExecutionLogEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+// GetExecutionLog returns the execution log entries of the provided interchain account in ascending order of sequence.
+func (k Keeper) GetExecutionLog(ctx sdk.Context, address string) []types.ExecutionLogEntry {
+	var entries []types.ExecutionLogEntry
+	k.IterateExecutionLog(ctx, address, func(entry types.ExecutionLogEntry) bool {
+		entries = append(entries, entry)
+		return false
+	})
+
+	return entries
+}
+
+// getNextExecutionLogSequence returns the sequence of the next entry of the execution log of the provided
+// interchain account.
+func (k Keeper) getNextExecutionLogSequence(ctx sdk.Context, address string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyExecutionLogSequence(address))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setNextExecutionLogSequence stores the sequence of the next entry of the execution log of the provided
+// interchain account.
+func (k Keeper) setNextExecutionLogSequence(ctx sdk.Context, address string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyExecutionLogSequence(address), sdk.Uint64ToBigEndian(sequence))
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestExecutionLog() {
+	var (
+		path          *ibctesting.Path
+		params        types.Params
+		numPackets    uint64
+		expExecutions []types.ExecutionLogEntry
+	)
+
+	msgTypeURLs := []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expSuccess bool
+	}{
+		{
+			"execution log disabled by default",
+			func() {
+				params = types.DefaultParams()
+				expExecutions = nil
+			},
+			true,
+		},
+		{
+			"success: executions are logged",
+			func() {
+				for seq := uint64(0); seq < numPackets; seq++ {
+					expExecutions = append(expExecutions, types.ExecutionLogEntry{Sequence: seq, MsgTypeUrls: msgTypeURLs, Success: true})
+				}
+			},
+			true,
+		},
+		{
+			"success: failed executions are logged",
+			func() {
+				// the interchain account is not funded, so the bank send fails
+				numPackets = 1
+				expExecutions = []types.ExecutionLogEntry{{Sequence: 0, MsgTypeUrls: msgTypeURLs, Success: false}}
+			},
+			false,
+		},
+		{
+			"success: oldest entries are pruned at the maximum number of entries",
+			func() {
+				params.MaxExecutionLogEntries = 2
+				expExecutions = []types.ExecutionLogEntry{
+					{Sequence: 1, MsgTypeUrls: msgTypeURLs, Success: true},
+					{Sequence: 2, MsgTypeUrls: msgTypeURLs, Success: true},
+				}
+			},
+			true,
+		},
+		{
+			"success: maximum number of entries reached without pruning",
+			func() {
+				params.MaxExecutionLogEntries = numPackets
+				for seq := uint64(0); seq < numPackets; seq++ {
+					expExecutions = append(expExecutions, types.ExecutionLogEntry{Sequence: seq, MsgTypeUrls: msgTypeURLs, Success: true})
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			params = types.DefaultParams()
+			params.EnableExecutionLog = true
+			numPackets = 3
+			expExecutions = nil
+
+			tc.malleate()
+
+			if tc.expSuccess {
+				suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10000))))
+			}
+
+			hostKeeper := suite.chainB.GetSimApp().ICAHostKeeper
+			hostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			ctx := suite.chainB.GetContext()
+			for seq := uint64(1); seq <= numPackets; seq++ {
+				_, err := hostKeeper.OnRecvPacket(ctx, suite.newPendingPacketTestPacket(path, seq, ""))
+				suite.Require().Equal(tc.expSuccess, err == nil)
+			}
+
+			for i := range expExecutions {
+				expExecutions[i].Height = uint64(ctx.BlockHeight())
+			}
+
+			interchainAccountAddr, found := hostKeeper.GetInterchainAccountAddress(ctx, ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+			suite.Require().Equal(expExecutions, hostKeeper.GetExecutionLog(ctx, interchainAccountAddr))
+
+			res, err := hostKeeper.InterchainAccountExecutions(ctx, &types.QueryInterchainAccountExecutionsRequest{
+				Address:    interchainAccountAddr,
+				Pagination: &query.PageRequest{CountTotal: true},
+			})
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(len(expExecutions)), res.Pagination.Total)
+			suite.Require().Equal(len(expExecutions), len(res.Executions))
+			if len(expExecutions) > 0 {
+				suite.Require().Equal(expExecutions, res.Executions)
+			}
+		})
+	}
+}
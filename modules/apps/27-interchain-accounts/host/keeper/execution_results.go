@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// truncateTxResponse returns the provided transaction result if its size does not exceed the maximum acknowledgement
+// data size. Otherwise, the message responses of the transaction result are replaced by truncated message responses
+// holding the SHA-256 hash of their value, and the full transaction result is stored for the execution result retention
+// period.
+func (k Keeper) truncateTxResponse(ctx sdk.Context, packet channeltypes.Packet, txMsgData *sdk.TxMsgData, txResponse []byte) ([]byte, error) {
+	params := k.GetParams(ctx)
+	if params.MaxAckDataSize == 0 || uint64(len(txResponse)) <= params.MaxAckDataSize {
+		return txResponse, nil
+	}
+
+	truncatedTxMsgData := &sdk.TxMsgData{
+		MsgResponses: make([]*codectypes.Any, len(txMsgData.MsgResponses)),
+	}
+
+	for i, msgResponse := range txMsgData.MsgResponses {
+		hash := sha256.Sum256(msgResponse.Value)
+		truncatedMsgResponse, err := codectypes.NewAnyWithValue(&types.TruncatedMsgResponse{
+			TypeUrl: msgResponse.TypeUrl,
+			Hash:    hash[:],
+		})
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to pack truncated msg response")
+		}
+
+		truncatedTxMsgData.MsgResponses[i] = truncatedMsgResponse
+	}
+
+	truncatedTxResponse, err := proto.Marshal(truncatedTxMsgData)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to marshal truncated tx data")
+	}
+
+	executionResult := types.PacketExecutionResult{
+		PacketId:     channeltypes.NewPacketID(packet.DestinationPort, packet.DestinationChannel, packet.Sequence),
+		Result:       txResponse,
+		ExpiryHeight: uint64(ctx.BlockHeight()) + params.ExecutionResultRetentionPeriod,
+	}
+	k.setExecutionResult(ctx, executionResult)
+
+	EmitAckTruncatedEvent(ctx, packet, executionResult.ExpiryHeight)
+
+	return truncatedTxResponse, nil
+}
+
+// GetExecutionResult returns the full transaction result of the packet with the provided identifier on the host chain
+// if its acknowledgement has been truncated and the execution result has not been pruned.
+func (k Keeper) GetExecutionResult(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PacketExecutionResult, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyExecutionResult(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.PacketExecutionResult{}, false
+	}
+
+	var executionResult types.PacketExecutionResult
+	k.cdc.MustUnmarshal(bz, &executionResult)
+
+	return executionResult, true
+}
+
+// PruneExpiredExecutionResults deletes the execution results whose expiry height has been reached. It is called in
+// EndBlock.
+func (k Keeper) PruneExpiredExecutionResults(ctx sdk.Context) {
+	var expiredResults []types.PacketExecutionResult
+	k.IterateExecutionResultsByExpiry(ctx, func(executionResult types.PacketExecutionResult) bool {
+		if executionResult.ExpiryHeight > uint64(ctx.BlockHeight()) {
+			return true
+		}
+
+		expiredResults = append(expiredResults, executionResult)
+		return false
+	})
+
+	for _, executionResult := range expiredResults {
+		k.deleteExecutionResult(ctx, executionResult)
+	}
+}
+
+// IterateExecutionResultsByExpiry iterates over the execution results in ascending order of their expiry height
+// and calls the provided callback for each of them, until stop=true is returned.
+func (k Keeper) IterateExecutionResultsByExpiry(ctx sdk.Context, cb func(executionResult types.PacketExecutionResult) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(fmt.Sprintf("%s/", types.ExecutionResultExpiryKeyPrefix)))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		var executionResult types.PacketExecutionResult
+		k.cdc.MustUnmarshal(store.Get(iterator.Value()), &executionResult)
+
+		if cb(executionResult) {
+			break
+		}
+	}
+}
+
+// GetAllExecutionResults returns all the stored execution results in ascending order of their expiry height.
+func (k Keeper) GetAllExecutionResults(ctx sdk.Context) []types.PacketExecutionResult {
+	var executionResults []types.PacketExecutionResult
+	k.IterateExecutionResultsByExpiry(ctx, func(executionResult types.PacketExecutionResult) bool {
+		executionResults = append(executionResults, executionResult)
+		return false
+	})
+
+	return executionResults
+}
+
+// setExecutionResult stores the provided execution result and indexes it by expiry height.
+func (k Keeper) setExecutionResult(ctx sdk.Context, executionResult types.PacketExecutionResult) {
+	store := ctx.KVStore(k.storeKey)
+	packetID := executionResult.PacketId
+	key := types.KeyExecutionResult(packetID.PortId, packetID.ChannelId, packetID.Sequence)
+
+	store.Set(key, k.cdc.MustMarshal(&executionResult))
+	store.Set(types.KeyExecutionResultExpiry(executionResult.ExpiryHeight, packetID.PortId, packetID.ChannelId, packetID.Sequence), key)
+}
+
+// deleteExecutionResult deletes the provided execution result and its expiry height index.
+func (k Keeper) deleteExecutionResult(ctx sdk.Context, executionResult types.PacketExecutionResult) {
+	store := ctx.KVStore(k.storeKey)
+	packetID := executionResult.PacketId
+
+	store.Delete(types.KeyExecutionResult(packetID.PortId, packetID.ChannelId, packetID.Sequence))
+	store.Delete(types.KeyExecutionResultExpiry(executionResult.ExpiryHeight, packetID.PortId, packetID.ChannelId, packetID.Sequence))
+}
@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestOnRecvPacketTruncatesAck() {
+	var maxAckDataSize uint64
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expTruncated bool
+	}{
+		{
+			"success: acknowledgement truncated",
+			func() {},
+			true,
+		},
+		{
+			"success: transaction result does not exceed the maximum acknowledgement data size",
+			func() {
+				maxAckDataSize = 1024
+			},
+			false,
+		},
+		{
+			"success: truncation disabled",
+			func() {
+				maxAckDataSize = 0
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10000))))
+
+			maxAckDataSize = 1
+
+			tc.malleate()
+
+			hostKeeper := suite.chainB.GetSimApp().ICAHostKeeper
+			params := hostKeeper.GetParams(suite.chainB.GetContext())
+			params.MaxAckDataSize = maxAckDataSize
+			hostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := suite.newPendingPacketTestPacket(path, 1, "")
+
+			ctx := suite.chainB.GetContext()
+			txResponse, err := hostKeeper.OnRecvPacket(ctx, packet)
+			suite.Require().NoError(err)
+
+			var txMsgData sdk.TxMsgData
+			suite.Require().NoError(proto.Unmarshal(txResponse, &txMsgData))
+			suite.Require().Len(txMsgData.MsgResponses, 1)
+
+			executionResult, found := hostKeeper.GetExecutionResult(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+			suite.Require().Equal(tc.expTruncated, found)
+
+			if !tc.expTruncated {
+				suite.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSendResponse{}), txMsgData.MsgResponses[0].TypeUrl)
+				return
+			}
+
+			suite.Require().Greater(uint64(len(executionResult.Result)), maxAckDataSize)
+			suite.Require().Equal(channeltypes.NewPacketID(packet.DestinationPort, packet.DestinationChannel, packet.Sequence), executionResult.PacketId)
+			suite.Require().Equal(uint64(ctx.BlockHeight())+params.ExecutionResultRetentionPeriod, executionResult.ExpiryHeight)
+
+			var fullTxMsgData sdk.TxMsgData
+			suite.Require().NoError(proto.Unmarshal(executionResult.Result, &fullTxMsgData))
+			suite.Require().Len(fullTxMsgData.MsgResponses, 1)
+			suite.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSendResponse{}), fullTxMsgData.MsgResponses[0].TypeUrl)
+
+			// the message response returned in the acknowledgement holds the hash of the full message response
+			suite.Require().Equal(sdk.MsgTypeURL(&types.TruncatedMsgResponse{}), txMsgData.MsgResponses[0].TypeUrl)
+
+			var truncatedMsgResponse types.TruncatedMsgResponse
+			suite.Require().NoError(proto.Unmarshal(txMsgData.MsgResponses[0].Value, &truncatedMsgResponse))
+
+			hash := sha256.Sum256(fullTxMsgData.MsgResponses[0].Value)
+			suite.Require().Equal(fullTxMsgData.MsgResponses[0].TypeUrl, truncatedMsgResponse.TypeUrl)
+			suite.Require().Equal(hash[:], truncatedMsgResponse.Hash)
+
+			res, err := hostKeeper.PacketExecutionResult(ctx, &types.QueryPacketExecutionResultRequest{
+				PortId:    packet.DestinationPort,
+				ChannelId: packet.DestinationChannel,
+				Sequence:  packet.Sequence,
+			})
+			suite.Require().NoError(err)
+			suite.Require().Equal(executionResult, *res.Result)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneExpiredExecutionResults() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+	path.SetupConnections()
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10000))))
+
+	hostKeeper := suite.chainB.GetSimApp().ICAHostKeeper
+	params := hostKeeper.GetParams(suite.chainB.GetContext())
+	params.MaxAckDataSize = 1
+	params.ExecutionResultRetentionPeriod = 10
+	hostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	height := suite.chainB.GetContext().BlockHeight()
+	packets := []channeltypes.Packet{
+		suite.newPendingPacketTestPacket(path, 1, ""),
+		suite.newPendingPacketTestPacket(path, 2, ""),
+	}
+
+	for i, packet := range packets {
+		_, err := hostKeeper.OnRecvPacket(suite.chainB.GetContext().WithBlockHeight(height+int64(i)), packet)
+		suite.Require().NoError(err)
+	}
+
+	suite.Require().Len(hostKeeper.GetAllExecutionResults(suite.chainB.GetContext()), len(packets))
+
+	hasExecutionResult := func(packet channeltypes.Packet) bool {
+		_, found := hostKeeper.GetExecutionResult(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+		return found
+	}
+
+	// execution results are retained until their expiry height is reached
+	hostKeeper.PruneExpiredExecutionResults(suite.chainB.GetContext().WithBlockHeight(height + 9))
+	suite.Require().True(hasExecutionResult(packets[0]))
+	suite.Require().True(hasExecutionResult(packets[1]))
+
+	hostKeeper.PruneExpiredExecutionResults(suite.chainB.GetContext().WithBlockHeight(height + 10))
+	suite.Require().False(hasExecutionResult(packets[0]))
+	suite.Require().True(hasExecutionResult(packets[1]))
+
+	hostKeeper.PruneExpiredExecutionResults(suite.chainB.GetContext().WithBlockHeight(height + 11))
+	suite.Require().Empty(hostKeeper.GetAllExecutionResults(suite.chainB.GetContext()))
+
+	_, err = hostKeeper.PacketExecutionResult(suite.chainB.GetContext(), &types.QueryPacketExecutionResultRequest{
+		PortId:    packets[0].DestinationPort,
+		ChannelId: packets[0].DestinationChannel,
+		Sequence:  packets[0].Sequence,
+	})
+	suite.Require().ErrorContains(err, types.ErrExecutionResultNotFound.Error())
+
+	_, err = hostKeeper.PacketExecutionResult(suite.chainB.GetContext(), &types.QueryPacketExecutionResultRequest{
+		PortId:    ibctesting.InvalidID,
+		ChannelId: packets[0].DestinationChannel,
+		Sequence:  packets[0].Sequence,
+	})
+	suite.Require().Error(err)
+}
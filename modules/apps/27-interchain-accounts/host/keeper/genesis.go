@@ -37,14 +37,25 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, state genesistypes.HostGenesisS
 		panic(fmt.Errorf("could not set ica host params at genesis: %v", err))
 	}
 	keeper.SetParams(ctx, state.Params)
+
+	for _, pendingPacket := range state.PendingPackets {
+		keeper.setPendingPacket(ctx, pendingPacket)
+	}
+
+	for _, executionResult := range state.ExecutionResults {
+		keeper.setExecutionResult(ctx, executionResult)
+	}
 }
 
-// ExportGenesis returns the interchain accounts host exported genesis
+// ExportGenesis returns the interchain accounts host exported genesis. The execution logs of interchain accounts
+// are not exported.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) genesistypes.HostGenesisState {
 	return genesistypes.NewHostGenesisState(
 		keeper.GetAllActiveChannels(ctx),
 		keeper.GetAllInterchainAccounts(ctx),
 		icatypes.HostPortID,
 		keeper.GetParams(ctx),
+		keeper.GetAllPendingPackets(ctx),
+		keeper.GetAllExecutionResults(ctx),
 	)
 }
@@ -5,6 +5,8 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
@@ -26,7 +28,21 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				AccountAddress: interchainAccAddr.String(),
 			},
 		},
-		Port: icatypes.HostPortID,
+		Port:   icatypes.HostPortID,
+		Params: types.DefaultParams(),
+		PendingPackets: []types.PendingPacket{
+			{
+				Packet:             channeltypes.NewPacket([]byte("data"), 1, TestPortID, ibctesting.FirstChannelID, icatypes.HostPortID, ibctesting.FirstChannelID, clienttypes.NewHeight(0, 100), 0),
+				ExecuteAfterHeight: 100,
+			},
+		},
+		ExecutionResults: []types.PacketExecutionResult{
+			{
+				PacketId:     channeltypes.NewPacketID(icatypes.HostPortID, ibctesting.FirstChannelID, 1),
+				Result:       []byte("result"),
+				ExpiryHeight: 1000,
+			},
+		},
 	}
 
 	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICAHostKeeper, genesisState)
@@ -43,6 +59,10 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	params := suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
 
+	suite.Require().Equal(genesisState.PendingPackets, suite.chainA.GetSimApp().ICAHostKeeper.GetAllPendingPackets(suite.chainA.GetContext()))
+	suite.Require().Equal(uint64(1), suite.chainA.GetSimApp().ICAHostKeeper.GetPendingPacketCount(suite.chainA.GetContext()))
+	suite.Require().Equal(genesisState.ExecutionResults, suite.chainA.GetSimApp().ICAHostKeeper.GetAllExecutionResults(suite.chainA.GetContext()))
+
 	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
 	suite.Require().True(store.Has(icatypes.KeyPort(icatypes.HostPortID)))
 
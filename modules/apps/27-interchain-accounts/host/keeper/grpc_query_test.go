@@ -10,3 +10,21 @@ func (suite *KeeperTestSuite) TestQueryParams() {
 	res, _ := suite.chainA.GetSimApp().ICAHostKeeper.Params(ctx, &types.QueryParamsRequest{})
 	suite.Require().Equal(&expParams, res.Params)
 }
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccountExecutions() {
+	ctx := suite.chainA.GetContext()
+
+	res, err := suite.chainA.GetSimApp().ICAHostKeeper.InterchainAccountExecutions(ctx, &types.QueryInterchainAccountExecutionsRequest{
+		Address: suite.chainA.SenderAccount.GetAddress().String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Executions)
+
+	_, err = suite.chainA.GetSimApp().ICAHostKeeper.InterchainAccountExecutions(ctx, &types.QueryInterchainAccountExecutionsRequest{
+		Address: "invalid-address",
+	})
+	suite.Require().Error(err)
+
+	_, err = suite.chainA.GetSimApp().ICAHostKeeper.InterchainAccountExecutions(ctx, nil)
+	suite.Require().Error(err)
+}
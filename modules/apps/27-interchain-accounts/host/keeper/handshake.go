@@ -123,12 +123,15 @@ func (k Keeper) OnChanOpenConfirm(
 	return nil
 }
 
-// OnChanCloseConfirm removes the active channel stored in state
-func (Keeper) OnChanCloseConfirm(
+// OnChanCloseConfirm removes the pending packets received on the channel, as their acknowledgements can no
+// longer be written.
+func (k Keeper) OnChanCloseConfirm(
 	ctx sdk.Context,
 	portID,
 	channelID string,
 ) error {
+	k.deletePendingPacketsForChannel(ctx, portID, channelID)
+
 	return nil
 }
 
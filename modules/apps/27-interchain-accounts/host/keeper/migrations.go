@@ -33,3 +33,18 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	}
 	return nil
 }
+
+// MigratePendingPacketParams migrates the host submodule's parameters by setting the pending packet gas limit,
+// maximum number of pending packets executed per block and maximum execute after delay, which were introduced as
+// parameters, to their default values.
+func (m Migrator) MigratePendingPacketParams(ctx sdk.Context) error {
+	if m.keeper != nil {
+		params := m.keeper.GetParams(ctx)
+		params.MaxPendingPacketGas = types.DefaultMaxPendingPacketGas
+		params.MaxPendingPacketsPerBlock = types.DefaultMaxPendingPacketsPerBlock
+		params.MaxExecuteAfterDelay = types.DefaultMaxExecuteAfterDelay
+		m.keeper.SetParams(ctx, params)
+		m.keeper.Logger(ctx).Info("successfully migrated ica/host submodule pending packet params")
+	}
+	return nil
+}
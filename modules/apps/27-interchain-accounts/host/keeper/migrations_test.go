@@ -63,3 +63,19 @@ func (suite *KeeperTestSuite) TestMigratorMigrateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigratorMigratePendingPacketParams() {
+	suite.SetupTest()
+
+	// params stored before the pending packet limits were introduced
+	params := icahosttypes.DefaultParams()
+	params.MaxPendingPacketGas, params.MaxPendingPacketsPerBlock, params.MaxExecuteAfterDelay = 0, 0, 0
+	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	migrator := icahostkeeper.NewMigrator(&suite.chainA.GetSimApp().ICAHostKeeper)
+	err := migrator.MigratePendingPacketParams(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	params = suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(icahosttypes.DefaultParams(), params)
+}
@@ -0,0 +1,242 @@
+package keeper
+
+import (
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// DeferPacketExecution queues the packet for execution at a later block height if the memo of its packet data
+// contains an execute after height greater than the current block height. It returns true if the packet has
+// been queued, in which case the packet is executed and its acknowledgement is written asynchronously in the
+// BeginBlock of the execute after height, or of a later block if the maximum number of pending packets executed
+// per block is reached. An error is returned if the execute after height is invalid or exceeds the maximum execute
+// after delay, if the maximum number of pending packets has been reached, or if the packet was received on a channel
+// which is not UNORDERED, as later packets of an ordered channel would otherwise be executed before the deferred one.
+func (k Keeper) DeferPacketExecution(ctx sdk.Context, packet channeltypes.Packet) (bool, error) {
+	var data icatypes.InterchainAccountPacketData
+	if err := data.UnmarshalJSON(packet.GetData()); err != nil || data.Type != icatypes.EXECUTE_TX {
+		// invalid packet data is rejected when the packet is executed
+		return false, nil
+	}
+
+	executeAfterHeight, err := data.GetExecuteAfterHeight()
+	if err != nil {
+		return false, err
+	}
+
+	if executeAfterHeight <= uint64(ctx.BlockHeight()) {
+		return false, nil
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found {
+		return false, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", packet.DestinationPort, packet.DestinationChannel)
+	}
+
+	if channel.Ordering != channeltypes.UNORDERED {
+		return false, errorsmod.Wrapf(types.ErrDeferredExecutionOrdered, "expected %s channel, got %s", channeltypes.UNORDERED, channel.Ordering)
+	}
+
+	params := k.GetParams(ctx)
+	if maxPendingPackets := params.MaxPendingPackets; k.GetPendingPacketCount(ctx) >= maxPendingPackets {
+		return false, errorsmod.Wrapf(types.ErrMaxPendingPackets, "cannot defer execution to height %d, maximum number of pending packets is %d", executeAfterHeight, maxPendingPackets)
+	}
+
+	if delay := executeAfterHeight - uint64(ctx.BlockHeight()); delay > params.MaxExecuteAfterDelay {
+		return false, errorsmod.Wrapf(icatypes.ErrInvalidExecuteAfterHeight, "cannot defer execution by %d blocks, maximum delay is %d blocks", delay, params.MaxExecuteAfterDelay)
+	}
+
+	k.setPendingPacket(ctx, types.PendingPacket{Packet: packet, ExecuteAfterHeight: executeAfterHeight})
+
+	EmitPacketDeferredEvent(ctx, packet, executeAfterHeight)
+
+	return true, nil
+}
+
+// ExecutePendingPackets executes the pending packets whose execute after height has been reached and writes
+// their acknowledgements, up to the maximum number of pending packets executed per block. It is called in BeginBlock.
+func (k Keeper) ExecutePendingPackets(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	var executablePackets []types.PendingPacket
+	k.IteratePendingPackets(ctx, func(pendingPacket types.PendingPacket) bool {
+		if pendingPacket.ExecuteAfterHeight > uint64(ctx.BlockHeight()) || uint64(len(executablePackets)) >= params.MaxPendingPacketsPerBlock {
+			return true
+		}
+
+		executablePackets = append(executablePackets, pendingPacket)
+		return false
+	})
+
+	for _, pendingPacket := range executablePackets {
+		k.deletePendingPacket(ctx, pendingPacket)
+		k.executePendingPacket(ctx, pendingPacket.Packet, params.MaxPendingPacketGas)
+	}
+}
+
+// executePendingPacket executes the provided packet with the provided gas limit and writes its acknowledgement.
+// The packet is executed using a cached context, the state changes are discarded if the acknowledgement cannot be
+// written. Packets whose channel is no longer open are dropped without being executed.
+func (k Keeper) executePendingPacket(ctx sdk.Context, packet channeltypes.Packet, gasLimit uint64) {
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found || !slices.Contains([]channeltypes.State{channeltypes.OPEN, channeltypes.FLUSHING, channeltypes.FLUSHCOMPLETE}, channel.State) {
+		k.dropPendingPacket(ctx, packet, errorsmod.Wrapf(types.ErrPendingPacketChannelClosed, "port ID (%s) channel ID (%s)", packet.DestinationPort, packet.DestinationChannel))
+		return
+	}
+
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(packet.DestinationPort, packet.DestinationChannel))
+	if !found {
+		k.dropPendingPacket(ctx, packet, errorsmod.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "port ID (%s) channel ID (%s)", packet.DestinationPort, packet.DestinationChannel))
+		return
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	var (
+		txResponse []byte
+		err        error
+	)
+	if k.GetParams(cacheCtx).HostEnabled {
+		txResponse, err = k.executePacketWithRecovery(cacheCtx.WithGasMeter(storetypes.NewGasMeter(gasLimit)), packet)
+	} else {
+		err = types.ErrHostSubModuleDisabled
+	}
+
+	ack := channeltypes.NewResultAcknowledgement(txResponse)
+	if err != nil {
+		ack = channeltypes.NewErrorAcknowledgementWithCode(err)
+		k.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", err.Error(), packet.Sequence))
+	} else {
+		k.Logger(ctx).Info("successfully handled pending packet", "sequence", packet.Sequence)
+	}
+
+	if err := k.ics4Wrapper.WriteAcknowledgement(cacheCtx, chanCap, packet, ack); err != nil {
+		k.dropPendingPacket(ctx, packet, err)
+		return
+	}
+
+	EmitAcknowledgementEvent(cacheCtx, packet, ack, err)
+
+	writeFn()
+}
+
+// executePacketWithRecovery executes the provided packet, recovering panics raised by the executed messages as
+// BeginBlock does not recover panics. A panic, including running out of gas, fails the execution of the packet
+// which is acknowledged with an error.
+func (k Keeper) executePacketWithRecovery(ctx sdk.Context, packet channeltypes.Packet) (txResponse []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if outOfGas, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "pending packet execution ran out of gas in %s, gas limit is %d", outOfGas.Descriptor, ctx.GasMeter().Limit())
+				return
+			}
+
+			err = errorsmod.Wrapf(sdkerrors.ErrPanic, "pending packet execution panicked: %v", r)
+		}
+	}()
+
+	return k.OnRecvPacket(ctx, packet)
+}
+
+// dropPendingPacket logs and emits an event for a pending packet which is removed from the queue without
+// writing an acknowledgement.
+func (k Keeper) dropPendingPacket(ctx sdk.Context, packet channeltypes.Packet, err error) {
+	k.Logger(ctx).Error("dropped pending packet", "port-id", packet.DestinationPort, "channel-id", packet.DestinationChannel, "sequence", packet.Sequence, "error", err.Error())
+	EmitPendingPacketDroppedEvent(ctx, packet, err)
+}
+
+// deletePendingPacketsForChannel removes all pending packets received on the provided channel from the queue.
+func (k Keeper) deletePendingPacketsForChannel(ctx sdk.Context, portID, channelID string) {
+	var channelPackets []types.PendingPacket
+	k.IteratePendingPackets(ctx, func(pendingPacket types.PendingPacket) bool {
+		if pendingPacket.Packet.DestinationPort == portID && pendingPacket.Packet.DestinationChannel == channelID {
+			channelPackets = append(channelPackets, pendingPacket)
+		}
+
+		return false
+	})
+
+	for _, pendingPacket := range channelPackets {
+		k.deletePendingPacket(ctx, pendingPacket)
+		k.dropPendingPacket(ctx, pendingPacket.Packet, errorsmod.Wrapf(types.ErrPendingPacketChannelClosed, "port ID (%s) channel ID (%s)", portID, channelID))
+	}
+}
+
+// IteratePendingPackets iterates over the pending packets in ascending order of their execute after height
+// and calls the provided callback for each of them, until stop=true is returned.
+func (k Keeper) IteratePendingPackets(ctx sdk.Context, cb func(pendingPacket types.PendingPacket) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(fmt.Sprintf("%s/", types.PendingPacketKeyPrefix)))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingPacket types.PendingPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingPacket)
+
+		if cb(pendingPacket) {
+			break
+		}
+	}
+}
+
+// GetAllPendingPackets returns all the pending packets in ascending order of their execute after height.
+func (k Keeper) GetAllPendingPackets(ctx sdk.Context) []types.PendingPacket {
+	var pendingPackets []types.PendingPacket
+	k.IteratePendingPackets(ctx, func(pendingPacket types.PendingPacket) bool {
+		pendingPackets = append(pendingPackets, pendingPacket)
+		return false
+	})
+
+	return pendingPackets
+}
+
+// GetPendingPacketCount returns the number of pending packets.
+func (k Keeper) GetPendingPacketCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.PendingPacketCountKey))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setPendingPacketCount stores the number of pending packets.
+func (k Keeper) setPendingPacketCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte(types.PendingPacketCountKey), sdk.Uint64ToBigEndian(count))
+}
+
+// setPendingPacket stores the provided pending packet and increments the number of pending packets.
+func (k Keeper) setPendingPacket(ctx sdk.Context, pendingPacket types.PendingPacket) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyPendingPacket(pendingPacket.ExecuteAfterHeight, pendingPacket.Packet.DestinationChannel, pendingPacket.Packet.Sequence)
+	if !store.Has(key) {
+		k.setPendingPacketCount(ctx, k.GetPendingPacketCount(ctx)+1)
+	}
+
+	store.Set(key, k.cdc.MustMarshal(&pendingPacket))
+}
+
+// deletePendingPacket deletes the provided pending packet and decrements the number of pending packets.
+func (k Keeper) deletePendingPacket(ctx sdk.Context, pendingPacket types.PendingPacket) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyPendingPacket(pendingPacket.ExecuteAfterHeight, pendingPacket.Packet.DestinationChannel, pendingPacket.Packet.Sequence)
+	if !store.Has(key) {
+		return
+	}
+
+	store.Delete(key)
+	k.setPendingPacketCount(ctx, k.GetPendingPacketCount(ctx)-1)
+}
@@ -0,0 +1,308 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// newPendingPacketTestPacket returns a packet on the provided path executing a bank send from the interchain
+// account with the provided memo.
+func (suite *KeeperTestSuite) newPendingPacketTestPacket(path *ibctesting.Path, sequence uint64, memo string) channeltypes.Packet {
+	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	msg := &banktypes.MsgSend{
+		FromAddress: interchainAccountAddr,
+		ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msg}, icatypes.EncodingProtobuf)
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	return channeltypes.NewPacket(
+		icaPacketData.GetBytes(),
+		sequence,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		suite.chainB.GetTimeoutHeight(),
+		0,
+	)
+}
+
+func (suite *KeeperTestSuite) TestDeferPacketExecution() {
+	var (
+		path   *ibctesting.Path
+		packet channeltypes.Packet
+	)
+
+	executeAfterHeightMemo := func(height interface{}) string {
+		return fmt.Sprintf(`{"%s": %v}`, icatypes.ExecuteAfterHeightMemoKey, height)
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expDeferred bool
+		expErr      error
+	}{
+		{
+			"success: execution deferred",
+			func() {},
+			true,
+			nil,
+		},
+		{
+			"success: not deferred without execute after height",
+			func() {
+				packet = suite.newPendingPacketTestPacket(path, 1, "")
+			},
+			false,
+			nil,
+		},
+		{
+			"success: not deferred once execute after height is reached",
+			func() {
+				packet = suite.newPendingPacketTestPacket(path, 1, executeAfterHeightMemo(suite.chainB.GetContext().BlockHeight()))
+			},
+			false,
+			nil,
+		},
+		{
+			"success: not deferred with invalid packet data",
+			func() {
+				packet.Data = []byte("invalid packet data")
+			},
+			false,
+			nil,
+		},
+		{
+			"failure: invalid execute after height",
+			func() {
+				packet = suite.newPendingPacketTestPacket(path, 1, executeAfterHeightMemo(`"height"`))
+			},
+			false,
+			icatypes.ErrInvalidExecuteAfterHeight,
+		},
+		{
+			"failure: maximum number of pending packets reached",
+			func() {
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.MaxPendingPackets = 1
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+				deferred, err := suite.chainB.GetSimApp().ICAHostKeeper.DeferPacketExecution(suite.chainB.GetContext(), suite.newPendingPacketTestPacket(path, 2, executeAfterHeightMemo(1000)))
+				suite.Require().True(deferred)
+				suite.Require().NoError(err)
+			},
+			false,
+			types.ErrMaxPendingPackets,
+		},
+		{
+			"failure: execute after delay exceeds maximum",
+			func() {
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.MaxExecuteAfterDelay = 1000 - uint64(suite.chainB.GetContext().BlockHeight()) - 1
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+			icatypes.ErrInvalidExecuteAfterHeight,
+		},
+		{
+			"failure: ordered channel",
+			func() {
+				path.EndpointB.UpdateChannel(func(channel *channeltypes.Channel) { channel.Ordering = channeltypes.ORDERED })
+			},
+			false,
+			types.ErrDeferredExecutionOrdered,
+		},
+		{
+			"failure: deferred execution disabled",
+			func() {
+				params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+				params.MaxPendingPackets = 0
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+			types.ErrMaxPendingPackets,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+			path.SetupConnections()
+
+			err := SetupUnorderedICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			packet = suite.newPendingPacketTestPacket(path, 1, executeAfterHeightMemo(1000))
+
+			tc.malleate()
+
+			pendingPackets := suite.chainB.GetSimApp().ICAHostKeeper.GetAllPendingPackets(suite.chainB.GetContext())
+
+			deferred, err := suite.chainB.GetSimApp().ICAHostKeeper.DeferPacketExecution(suite.chainB.GetContext(), packet)
+			suite.Require().ErrorIs(err, tc.expErr)
+			suite.Require().Equal(tc.expDeferred, deferred)
+
+			if tc.expDeferred {
+				pendingPackets = append(pendingPackets, types.PendingPacket{Packet: packet, ExecuteAfterHeight: 1000})
+			}
+
+			suite.Require().Equal(pendingPackets, suite.chainB.GetSimApp().ICAHostKeeper.GetAllPendingPackets(suite.chainB.GetContext()))
+			suite.Require().Equal(uint64(len(pendingPackets)), suite.chainB.GetSimApp().ICAHostKeeper.GetPendingPacketCount(suite.chainB.GetContext()))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestExecutePendingPackets() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+	path.SetupConnections()
+
+	err := SetupUnorderedICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10000))))
+
+	height := uint64(suite.chainB.GetContext().BlockHeight())
+	packets := []channeltypes.Packet{
+		suite.newPendingPacketTestPacket(path, 1, fmt.Sprintf(`{"%s": "%d"}`, icatypes.ExecuteAfterHeightMemoKey, height+1)),
+		suite.newPendingPacketTestPacket(path, 2, fmt.Sprintf(`{"%s": "%d"}`, icatypes.ExecuteAfterHeightMemoKey, height+10)),
+	}
+
+	for _, packet := range packets {
+		deferred, err := suite.chainB.GetSimApp().ICAHostKeeper.DeferPacketExecution(suite.chainB.GetContext(), packet)
+		suite.Require().NoError(err)
+		suite.Require().True(deferred)
+	}
+
+	hasAck := func(packet channeltypes.Packet) bool {
+		_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+		return found
+	}
+
+	// only the packet whose execute after height has been reached is executed
+	suite.chainB.GetSimApp().ICAHostKeeper.ExecutePendingPackets(suite.chainB.GetContext().WithBlockHeight(int64(height + 1)))
+
+	suite.Require().True(hasAck(packets[0]))
+	suite.Require().False(hasAck(packets[1]))
+	suite.Require().Equal(uint64(1), suite.chainB.GetSimApp().ICAHostKeeper.GetPendingPacketCount(suite.chainB.GetContext()))
+
+	// pending packets of a closed channel are dropped without writing an acknowledgement
+	path.EndpointB.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+
+	suite.chainB.GetSimApp().ICAHostKeeper.ExecutePendingPackets(suite.chainB.GetContext().WithBlockHeight(int64(height + 10)))
+
+	suite.Require().False(hasAck(packets[1]))
+	suite.Require().Zero(suite.chainB.GetSimApp().ICAHostKeeper.GetPendingPacketCount(suite.chainB.GetContext()))
+}
+
+func (suite *KeeperTestSuite) TestExecutePendingPacketsLimits() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+	path.SetupConnections()
+
+	err := SetupUnorderedICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(10000))))
+
+	params := suite.chainB.GetSimApp().ICAHostKeeper.GetParams(suite.chainB.GetContext())
+	params.MaxPendingPacketsPerBlock = 2
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	height := uint64(suite.chainB.GetContext().BlockHeight())
+	var packets []channeltypes.Packet
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		packet := suite.newPendingPacketTestPacket(path, sequence, fmt.Sprintf(`{"%s": "%d"}`, icatypes.ExecuteAfterHeightMemoKey, height+1))
+		deferred, err := suite.chainB.GetSimApp().ICAHostKeeper.DeferPacketExecution(suite.chainB.GetContext(), packet)
+		suite.Require().NoError(err)
+		suite.Require().True(deferred)
+
+		packets = append(packets, packet)
+	}
+
+	getAck := func(packet channeltypes.Packet) ([]byte, bool) {
+		return suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+	}
+
+	// only the maximum number of pending packets per block is executed
+	suite.chainB.GetSimApp().ICAHostKeeper.ExecutePendingPackets(suite.chainB.GetContext().WithBlockHeight(int64(height + 1)))
+
+	for _, packet := range packets[:2] {
+		ack, found := getAck(packet)
+		suite.Require().True(found)
+		suite.Require().NotEqual(channeltypes.CommitAcknowledgement(channeltypes.NewErrorAcknowledgementWithCode(sdkerrors.ErrOutOfGas).Acknowledgement()), ack)
+	}
+
+	_, found := getAck(packets[2])
+	suite.Require().False(found)
+	suite.Require().Equal(uint64(1), suite.chainB.GetSimApp().ICAHostKeeper.GetPendingPacketCount(suite.chainB.GetContext()))
+
+	// a packet exceeding the gas limit is acknowledged with an error instead of panicking
+	params.MaxPendingPacketGas = 1
+	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	suite.Require().NotPanics(func() {
+		suite.chainB.GetSimApp().ICAHostKeeper.ExecutePendingPackets(suite.chainB.GetContext().WithBlockHeight(int64(height + 2)))
+	})
+
+	ack, found := getAck(packets[2])
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(channeltypes.NewErrorAcknowledgementWithCode(sdkerrors.ErrOutOfGas).Acknowledgement()), ack)
+	suite.Require().Zero(suite.chainB.GetSimApp().ICAHostKeeper.GetPendingPacketCount(suite.chainB.GetContext()))
+
+	// the transfer of the packet exceeding the gas limit is not executed
+	suite.Require().Equal(balance, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+}
+
+func (suite *KeeperTestSuite) TestOnChanCloseConfirmDeletesPendingPackets() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB, icatypes.EncodingProtobuf)
+	path.SetupConnections()
+
+	err := SetupUnorderedICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	packet := suite.newPendingPacketTestPacket(path, 1, fmt.Sprintf(`{"%s": "1000"}`, icatypes.ExecuteAfterHeightMemoKey))
+	deferred, err := suite.chainB.GetSimApp().ICAHostKeeper.DeferPacketExecution(suite.chainB.GetContext(), packet)
+	suite.Require().NoError(err)
+	suite.Require().True(deferred)
+
+	err = suite.chainB.GetSimApp().ICAHostKeeper.OnChanCloseConfirm(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().NoError(err)
+
+	suite.Require().Empty(suite.chainB.GetSimApp().ICAHostKeeper.GetAllPendingPackets(suite.chainB.GetContext()))
+	suite.Require().Zero(suite.chainB.GetSimApp().ICAHostKeeper.GetPendingPacketCount(suite.chainB.GetContext()))
+}
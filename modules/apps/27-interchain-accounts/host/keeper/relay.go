@@ -1,17 +1,22 @@
 package keeper
 
 import (
+	"strconv"
+
 	"github.com/cosmos/gogoproto/proto"
+	metrics "github.com/hashicorp/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	coretypes "github.com/cosmos/ibc-go/v8/modules/core/types"
 )
 
 // OnRecvPacket handles a given interchain accounts packet on a destination host chain.
@@ -36,7 +41,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 			return nil, errorsmod.Wrapf(err, "failed to deserialize interchain account transaction")
 		}
 
-		txResponse, err := k.executeTx(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, msgs)
+		txResponse, err := k.executeTx(ctx, packet, msgs)
 		if err != nil {
 			return nil, errorsmod.Wrapf(err, "failed to execute interchain account transaction")
 		}
@@ -50,13 +55,31 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 // If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
 // into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
 // execution of the transaction is atomic, all state changes are reverted if a single message fails.
-func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel string, msgs []sdk.Msg) ([]byte, error) {
-	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
+// If the transaction result exceeds the maximum acknowledgement data size, a truncated result is returned.
+// The execution is recorded in the execution log of the interchain account if the execution log is enabled.
+func (k Keeper) executeTx(ctx sdk.Context, packet channeltypes.Packet, msgs []sdk.Msg) (txResponse []byte, err error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
 	if !found {
 		return nil, channeltypes.ErrChannelNotFound
 	}
 
-	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], sourcePort); err != nil {
+	defer func() {
+		success := err == nil
+		if address, found := k.GetInterchainAccountAddress(ctx, channel.ConnectionHops[0], packet.SourcePort); found {
+			k.logExecution(ctx, address, msgs, success)
+		}
+
+		telemetry.IncrCounterWithLabels(
+			[]string{"ibc", icatypes.ModuleName, "host", "execute_tx"},
+			1,
+			[]metrics.Label{
+				telemetry.NewLabel(coretypes.LabelDestinationChannel, packet.DestinationChannel),
+				telemetry.NewLabel(coretypes.LabelSuccess, strconv.FormatBool(success)),
+			},
+		)
+	}()
+
+	if err := k.authenticateTx(ctx, msgs, channel.ConnectionHops[0], packet.SourcePort); err != nil {
 		return nil, err
 	}
 
@@ -84,12 +107,12 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 
 	writeCache()
 
-	txResponse, err := proto.Marshal(txMsgData)
+	txResponse, err = proto.Marshal(txMsgData)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "failed to marshal tx data")
 	}
 
-	return txResponse, nil
+	return k.truncateTxResponse(ctx, packet, txMsgData, txResponse)
 }
 
 // authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
@@ -143,10 +166,16 @@ func (k Keeper) executeMsg(ctx sdk.Context, msg sdk.Msg) (*codectypes.Any, error
 	ctx.EventManager().EmitEvents(res.GetEvents())
 
 	// Each individual sdk.Result has exactly one Msg response. We aggregate here.
+	// The type URL of the response is always set, including for empty responses, so that controllers can decode
+	// the response of each executed message.
 	msgResponse := res.MsgResponses[0]
 	if msgResponse == nil {
 		return nil, errorsmod.Wrapf(ibcerrors.ErrLogic, "got nil Msg response for msg %s", sdk.MsgTypeURL(msg))
 	}
 
+	if msgResponse.TypeUrl == "" {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrLogic, "got Msg response without type URL for msg %s", sdk.MsgTypeURL(msg))
+	}
+
 	return msgResponse, nil
 }
@@ -578,6 +578,90 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvPacketMsgResponsesWithRequests() {
+	for _, encoding := range []string{icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON} {
+		encoding := encoding
+
+		suite.Run(encoding, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB, encoding)
+			path.SetupConnections()
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			// populate the gov keeper in advance with an active proposal
+			proposal, err := govv1.NewProposal([]sdk.Msg{}, govtypes.DefaultStartingProposalID, suite.chainB.GetContext().BlockTime(), suite.chainB.GetContext().BlockTime(), "test proposal", "title", "description", sdk.AccAddress(interchainAccountAddr), false)
+			suite.Require().NoError(err)
+
+			err = suite.chainB.GetSimApp().GovKeeper.SetProposal(suite.chainB.GetContext(), proposal)
+			suite.Require().NoError(err)
+			err = suite.chainB.GetSimApp().GovKeeper.ActivateVotingPeriod(suite.chainB.GetContext(), proposal)
+			suite.Require().NoError(err)
+
+			msgSend := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+			}
+
+			msgVote := &govtypes.MsgVote{
+				ProposalId: govtypes.DefaultStartingProposalID,
+				Voter:      interchainAccountAddr,
+				Option:     govtypes.OptionYes,
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []proto.Message{msgSend, msgVote}, encoding)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msgSend), sdk.MsgTypeURL(msgVote)})
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				suite.chainB.GetTimeoutHeight(),
+				0,
+			)
+
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+			suite.Require().NoError(err)
+
+			// the controller attributes each response to the message it sent
+			msgs, err := icatypes.DeserializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), data, encoding)
+			suite.Require().NoError(err)
+
+			responses, err := icatypes.ParseTxMsgDataWithRequests(txResponse, msgs)
+			suite.Require().NoError(err)
+			suite.Require().Len(responses, 2)
+
+			suite.Require().Equal(sdk.MsgTypeURL(msgSend), responses[0].RequestTypeURL)
+			suite.Require().Equal(sdk.MsgTypeURL(&banktypes.MsgSendResponse{}), responses[0].Response.TypeUrl)
+			suite.Require().NoError(proto.Unmarshal(responses[0].Response.Value, &banktypes.MsgSendResponse{}))
+
+			// the type URL of the empty vote response is set
+			suite.Require().Equal(sdk.MsgTypeURL(msgVote), responses[1].RequestTypeURL)
+			suite.Require().Equal(sdk.MsgTypeURL(&govtypes.MsgVoteResponse{}), responses[1].Response.TypeUrl)
+			suite.Require().Empty(responses[1].Response.Value)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestJSONOnRecvPacket() {
 	var (
 		path       *ibctesting.Path
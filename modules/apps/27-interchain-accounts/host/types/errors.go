@@ -6,5 +6,9 @@ import (
 
 // ICA Host sentinel errors
 var (
-	ErrHostSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrHostSubModuleDisabled      = errorsmod.Register(SubModuleName, 2, "host submodule is disabled")
+	ErrMaxPendingPackets          = errorsmod.Register(SubModuleName, 3, "maximum number of pending packets reached")
+	ErrPendingPacketChannelClosed = errorsmod.Register(SubModuleName, 4, "channel of pending packet is closed")
+	ErrExecutionResultNotFound    = errorsmod.Register(SubModuleName, 5, "packet execution result not found")
+	ErrDeferredExecutionOrdered   = errorsmod.Register(SubModuleName, 6, "packet execution cannot be deferred on an ordered channel")
 )
@@ -5,7 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -29,6 +31,32 @@ type Params struct {
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty"`
 	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty"`
+	// max_pending_packets defines the maximum number of received packets which may be queued for execution at a
+	// later block height. Deferred execution is disabled if set to zero.
+	MaxPendingPackets uint64 `protobuf:"varint,3,opt,name=max_pending_packets,json=maxPendingPackets,proto3" json:"max_pending_packets,omitempty"`
+	// max_ack_data_size defines the maximum size in bytes of the transaction result returned in the acknowledgement
+	// of an executed packet. If the result exceeds it, the message responses returned in the acknowledgement are
+	// replaced by truncated message responses and the full result is stored by the host for the execution result
+	// retention period. Truncation is disabled if set to zero.
+	MaxAckDataSize uint64 `protobuf:"varint,4,opt,name=max_ack_data_size,json=maxAckDataSize,proto3" json:"max_ack_data_size,omitempty"`
+	// execution_result_retention_period defines the number of blocks for which the full result of a packet whose
+	// acknowledgement has been truncated is stored by the host.
+	ExecutionResultRetentionPeriod uint64 `protobuf:"varint,5,opt,name=execution_result_retention_period,json=executionResultRetentionPeriod,proto3" json:"execution_result_retention_period,omitempty"`
+	// enable_execution_log enables recording the transactions executed by each interchain account in a bounded
+	// execution log per account.
+	EnableExecutionLog bool `protobuf:"varint,6,opt,name=enable_execution_log,json=enableExecutionLog,proto3" json:"enable_execution_log,omitempty"`
+	// max_execution_log_entries defines the maximum number of entries kept in the execution log of an interchain
+	// account. The oldest entries are pruned once the maximum is exceeded.
+	MaxExecutionLogEntries uint64 `protobuf:"varint,7,opt,name=max_execution_log_entries,json=maxExecutionLogEntries,proto3" json:"max_execution_log_entries,omitempty"`
+	// max_pending_packet_gas defines the gas limit for the execution of each pending packet. A packet exceeding it
+	// is acknowledged with an error.
+	MaxPendingPacketGas uint64 `protobuf:"varint,8,opt,name=max_pending_packet_gas,json=maxPendingPacketGas,proto3" json:"max_pending_packet_gas,omitempty"`
+	// max_pending_packets_per_block defines the maximum number of pending packets executed in a block. The remaining
+	// packets whose execute after height has been reached are executed in the following blocks.
+	MaxPendingPacketsPerBlock uint64 `protobuf:"varint,9,opt,name=max_pending_packets_per_block,json=maxPendingPacketsPerBlock,proto3" json:"max_pending_packets_per_block,omitempty"`
+	// max_execute_after_delay defines the maximum number of blocks by which the execute after height of a packet
+	// may exceed the block height at which the packet is received.
+	MaxExecuteAfterDelay uint64 `protobuf:"varint,10,opt,name=max_execute_after_delay,json=maxExecuteAfterDelay,proto3" json:"max_execute_after_delay,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -78,6 +106,314 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetMaxPendingPackets() uint64 {
+	if m != nil {
+		return m.MaxPendingPackets
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAckDataSize() uint64 {
+	if m != nil {
+		return m.MaxAckDataSize
+	}
+	return 0
+}
+
+func (m *Params) GetExecutionResultRetentionPeriod() uint64 {
+	if m != nil {
+		return m.ExecutionResultRetentionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetEnableExecutionLog() bool {
+	if m != nil {
+		return m.EnableExecutionLog
+	}
+	return false
+}
+
+func (m *Params) GetMaxExecutionLogEntries() uint64 {
+	if m != nil {
+		return m.MaxExecutionLogEntries
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPendingPacketGas() uint64 {
+	if m != nil {
+		return m.MaxPendingPacketGas
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPendingPacketsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPendingPacketsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxExecuteAfterDelay() uint64 {
+	if m != nil {
+		return m.MaxExecuteAfterDelay
+	}
+	return 0
+}
+
+// PendingPacket defines a received interchain accounts packet whose execution is deferred until the host block
+// height reaches the execute after height provided in the packet memo.
+type PendingPacket struct {
+	// the received packet
+	Packet types.Packet `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	// the block height at or after which the packet is executed
+	ExecuteAfterHeight uint64 `protobuf:"varint,2,opt,name=execute_after_height,json=executeAfterHeight,proto3" json:"execute_after_height,omitempty"`
+}
+
+func (m *PendingPacket) Reset()         { *m = PendingPacket{} }
+func (m *PendingPacket) String() string { return proto.CompactTextString(m) }
+func (*PendingPacket) ProtoMessage()    {}
+func (*PendingPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *PendingPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPacket.Merge(m, src)
+}
+func (m *PendingPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingPacket proto.InternalMessageInfo
+
+func (m *PendingPacket) GetPacket() types.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return types.Packet{}
+}
+
+func (m *PendingPacket) GetExecuteAfterHeight() uint64 {
+	if m != nil {
+		return m.ExecuteAfterHeight
+	}
+	return 0
+}
+
+// TruncatedMsgResponse replaces a message response in the acknowledgement of a packet whose transaction result
+// exceeds the maximum acknowledgement data size. The full message response may be queried from the host until
+// the execution result of the packet is pruned.
+type TruncatedMsgResponse struct {
+	// type URL of the message response
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl,proto3" json:"type_url,omitempty"`
+	// SHA-256 hash of the value of the message response
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *TruncatedMsgResponse) Reset()         { *m = TruncatedMsgResponse{} }
+func (m *TruncatedMsgResponse) String() string { return proto.CompactTextString(m) }
+func (*TruncatedMsgResponse) ProtoMessage()    {}
+func (*TruncatedMsgResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{2}
+}
+func (m *TruncatedMsgResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TruncatedMsgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TruncatedMsgResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TruncatedMsgResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TruncatedMsgResponse.Merge(m, src)
+}
+func (m *TruncatedMsgResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TruncatedMsgResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TruncatedMsgResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TruncatedMsgResponse proto.InternalMessageInfo
+
+func (m *TruncatedMsgResponse) GetTypeUrl() string {
+	if m != nil {
+		return m.TypeUrl
+	}
+	return ""
+}
+
+func (m *TruncatedMsgResponse) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+// PacketExecutionResult defines the full transaction result of an executed packet whose acknowledgement has been
+// truncated.
+type PacketExecutionResult struct {
+	// identifier of the executed packet on the host chain
+	PacketId types.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+	// proto encoded transaction result, as it would have been returned in the acknowledgement
+	Result []byte `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	// the block height at which the execution result is pruned
+	ExpiryHeight uint64 `protobuf:"varint,3,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *PacketExecutionResult) Reset()         { *m = PacketExecutionResult{} }
+func (m *PacketExecutionResult) String() string { return proto.CompactTextString(m) }
+func (*PacketExecutionResult) ProtoMessage()    {}
+func (*PacketExecutionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{3}
+}
+func (m *PacketExecutionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketExecutionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketExecutionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketExecutionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketExecutionResult.Merge(m, src)
+}
+func (m *PacketExecutionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketExecutionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketExecutionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketExecutionResult proto.InternalMessageInfo
+
+func (m *PacketExecutionResult) GetPacketId() types.PacketId {
+	if m != nil {
+		return m.PacketId
+	}
+	return types.PacketId{}
+}
+
+func (m *PacketExecutionResult) GetResult() []byte {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *PacketExecutionResult) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// ExecutionLogEntry defines a transaction executed by an interchain account, as recorded in the execution log of
+// the account.
+type ExecutionLogEntry struct {
+	// sequence of the entry in the execution log of the interchain account
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the block height at which the transaction was executed
+	Height uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// type URLs of the messages of the transaction
+	MsgTypeUrls []string `protobuf:"bytes,3,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// whether all messages of the transaction were executed successfully
+	Success bool `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (m *ExecutionLogEntry) Reset()         { *m = ExecutionLogEntry{} }
+func (m *ExecutionLogEntry) String() string { return proto.CompactTextString(m) }
+func (*ExecutionLogEntry) ProtoMessage()    {}
+func (*ExecutionLogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{4}
+}
+func (m *ExecutionLogEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionLogEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionLogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionLogEntry.Merge(m, src)
+}
+func (m *ExecutionLogEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionLogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionLogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionLogEntry proto.InternalMessageInfo
+
+func (m *ExecutionLogEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ExecutionLogEntry) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ExecutionLogEntry) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *ExecutionLogEntry) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
 // QueryRequest defines the parameters for a particular query request
 // by an interchain account.
 type QueryRequest struct {
@@ -93,7 +429,7 @@ func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
 func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRequest) ProtoMessage()    {}
 func (*QueryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_48e202774f13d08e, []int{1}
+	return fileDescriptor_48e202774f13d08e, []int{5}
 }
 func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -138,6 +474,10 @@ func (m *QueryRequest) GetData() []byte {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*PendingPacket)(nil), "ibc.applications.interchain_accounts.host.v1.PendingPacket")
+	proto.RegisterType((*TruncatedMsgResponse)(nil), "ibc.applications.interchain_accounts.host.v1.TruncatedMsgResponse")
+	proto.RegisterType((*PacketExecutionResult)(nil), "ibc.applications.interchain_accounts.host.v1.PacketExecutionResult")
+	proto.RegisterType((*ExecutionLogEntry)(nil), "ibc.applications.interchain_accounts.host.v1.ExecutionLogEntry")
 	proto.RegisterType((*QueryRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryRequest")
 }
 
@@ -146,25 +486,54 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 284 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0xd0, 0x31, 0x4b, 0xfc, 0x30,
-	0x18, 0x06, 0xf0, 0xcb, 0xfd, 0xff, 0x1c, 0x5e, 0x3c, 0x1d, 0x3a, 0xdd, 0x14, 0xce, 0x03, 0xa1,
-	0x83, 0x6d, 0x38, 0x05, 0xcf, 0x59, 0x70, 0x11, 0x04, 0xcd, 0xe8, 0x52, 0xde, 0xa4, 0xa1, 0x0d,
-	0xb4, 0x4d, 0xed, 0x9b, 0x56, 0xee, 0x5b, 0xf8, 0xb1, 0x1c, 0x6f, 0x74, 0x94, 0xf6, 0x8b, 0x48,
-	0xa3, 0xa0, 0x82, 0x53, 0x1e, 0x7e, 0xf0, 0x04, 0xde, 0x87, 0x6e, 0x8d, 0x54, 0x1c, 0xea, 0xba,
-	0x30, 0x0a, 0x9c, 0xb1, 0x15, 0x72, 0x53, 0x39, 0xdd, 0xa8, 0x1c, 0x4c, 0x95, 0x80, 0x52, 0xb6,
-	0xad, 0x1c, 0xf2, 0xdc, 0xa2, 0xe3, 0xdd, 0xc6, 0xbf, 0x71, 0xdd, 0x58, 0x67, 0x83, 0x33, 0x23,
-	0x55, 0xfc, 0xb3, 0x18, 0xff, 0x51, 0x8c, 0x7d, 0xa1, 0xdb, 0xac, 0x05, 0x9d, 0xdd, 0x43, 0x03,
-	0x25, 0x06, 0x27, 0x74, 0x31, 0x62, 0xa2, 0x2b, 0x90, 0x85, 0x4e, 0x97, 0x64, 0x45, 0xc2, 0x03,
-	0x71, 0x38, 0xda, 0xcd, 0x27, 0x05, 0xa7, 0xf4, 0x18, 0x8a, 0xc2, 0x3e, 0x27, 0xa5, 0x46, 0x84,
-	0x4c, 0xe3, 0x72, 0xba, 0xfa, 0x17, 0xce, 0xc5, 0x91, 0xd7, 0xbb, 0x2f, 0x5c, 0x5f, 0xd2, 0xc5,
-	0x43, 0xab, 0x9b, 0x9d, 0xd0, 0x4f, 0xad, 0x46, 0x17, 0x04, 0xf4, 0x7f, 0x0d, 0x2e, 0xf7, 0x3f,
-	0xce, 0x85, 0xcf, 0xa3, 0xa5, 0xe0, 0x60, 0x39, 0x5d, 0x91, 0x70, 0x21, 0x7c, 0xbe, 0x4e, 0x5f,
-	0x7b, 0x46, 0xf6, 0x3d, 0x23, 0xef, 0x3d, 0x23, 0x2f, 0x03, 0x9b, 0xec, 0x07, 0x36, 0x79, 0x1b,
-	0xd8, 0xe4, 0xf1, 0x36, 0x33, 0x2e, 0x6f, 0x65, 0xac, 0x6c, 0xc9, 0x95, 0xc5, 0xd2, 0x22, 0x37,
-	0x52, 0x45, 0x99, 0xe5, 0xdd, 0x15, 0x2f, 0x6d, 0xda, 0x16, 0x1a, 0xc7, 0xb1, 0x90, 0x9f, 0x6f,
-	0xa3, 0xef, 0x73, 0xa3, 0xdf, 0x3b, 0xb9, 0x5d, 0xad, 0x51, 0xce, 0xfc, 0x4c, 0x17, 0x1f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x56, 0xc6, 0xd2, 0x5a, 0x61, 0x01, 0x00, 0x00,
+	// 751 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcf, 0x4f, 0xe3, 0x46,
+	0x14, 0x8e, 0x21, 0x0d, 0xc9, 0x90, 0x20, 0x31, 0xa4, 0xd4, 0x50, 0x91, 0x42, 0xaa, 0x4a, 0x54,
+	0x2a, 0x76, 0x01, 0xb5, 0x94, 0x1b, 0x20, 0xa2, 0x96, 0xaa, 0x48, 0xa9, 0x4b, 0x2f, 0x7b, 0x19,
+	0x4d, 0xc6, 0x6f, 0xed, 0x51, 0x6c, 0x8f, 0xd7, 0x33, 0xce, 0x26, 0x68, 0xaf, 0x7b, 0x5f, 0x69,
+	0xa5, 0xfd, 0x9b, 0x38, 0x72, 0xdc, 0xd3, 0x6a, 0x05, 0xff, 0xc8, 0x6a, 0xc6, 0x0e, 0x10, 0x40,
+	0x7b, 0xf2, 0xbc, 0x1f, 0xdf, 0xfb, 0xde, 0xfb, 0xfc, 0x66, 0xd0, 0x01, 0x1f, 0x30, 0x97, 0xa6,
+	0x69, 0xc4, 0x19, 0x55, 0x5c, 0x24, 0xd2, 0xe5, 0x89, 0x82, 0x8c, 0x85, 0x94, 0x27, 0x84, 0x32,
+	0x26, 0xf2, 0x44, 0x49, 0x37, 0x14, 0x52, 0xb9, 0xa3, 0x5d, 0xf3, 0x75, 0xd2, 0x4c, 0x28, 0x81,
+	0x7f, 0xe1, 0x03, 0xe6, 0x3c, 0x04, 0x3a, 0xcf, 0x00, 0x1d, 0x03, 0x18, 0xed, 0xae, 0xb7, 0x03,
+	0x11, 0x08, 0x03, 0x74, 0xf5, 0xa9, 0xa8, 0xb1, 0xbe, 0xa5, 0xc9, 0x99, 0xc8, 0xc0, 0x65, 0x21,
+	0x4d, 0x12, 0x88, 0x34, 0x47, 0x79, 0x2c, 0x52, 0xba, 0xef, 0xab, 0xa8, 0xd6, 0xa7, 0x19, 0x8d,
+	0x25, 0xde, 0x42, 0x4d, 0x5d, 0x8e, 0x40, 0x42, 0x07, 0x11, 0xf8, 0xb6, 0xb5, 0x69, 0x6d, 0xd7,
+	0xbd, 0x45, 0xed, 0xeb, 0x15, 0x2e, 0xfc, 0x13, 0x5a, 0xa2, 0x51, 0x24, 0x5e, 0x93, 0x18, 0xa4,
+	0xa4, 0x01, 0x48, 0x7b, 0x6e, 0x73, 0x7e, 0xbb, 0xe1, 0xb5, 0x8c, 0xf7, 0xbc, 0x74, 0x62, 0x07,
+	0xad, 0xc4, 0x74, 0x4c, 0x52, 0x48, 0x7c, 0x9e, 0x04, 0x24, 0xa5, 0x6c, 0x08, 0x4a, 0xda, 0xf3,
+	0x9b, 0xd6, 0x76, 0xd5, 0x5b, 0x8e, 0xe9, 0xb8, 0x5f, 0x44, 0xfa, 0x45, 0x00, 0xff, 0x8c, 0xb4,
+	0x93, 0x50, 0x36, 0x24, 0x3e, 0x55, 0x94, 0x48, 0x7e, 0x09, 0x76, 0xd5, 0x64, 0x2f, 0xc5, 0x74,
+	0x7c, 0xcc, 0x86, 0xa7, 0x54, 0xd1, 0xff, 0xf8, 0x25, 0xe0, 0x33, 0xb4, 0x05, 0x63, 0x60, 0xb9,
+	0x96, 0x84, 0x64, 0x20, 0xf3, 0x48, 0x91, 0x0c, 0x14, 0x24, 0xc6, 0x91, 0x42, 0xc6, 0x85, 0x6f,
+	0x7f, 0x63, 0xa0, 0x9d, 0xbb, 0x44, 0xcf, 0xe4, 0x79, 0xd3, 0xb4, 0xbe, 0xc9, 0xc2, 0xbf, 0xa2,
+	0x76, 0x31, 0x2a, 0xb9, 0xaf, 0x18, 0x89, 0xc0, 0xae, 0x99, 0xb9, 0x71, 0x11, 0xeb, 0x4d, 0x43,
+	0xff, 0x88, 0x00, 0x1f, 0xa2, 0x35, 0xdd, 0xe7, 0x4c, 0x3a, 0x81, 0x44, 0x65, 0x1c, 0xa4, 0xbd,
+	0x60, 0x48, 0x57, 0x63, 0x3a, 0x7e, 0x88, 0xe9, 0x15, 0x51, 0xbc, 0x8f, 0x56, 0x9f, 0x4a, 0x42,
+	0x02, 0x2a, 0xed, 0xba, 0xc1, 0xad, 0x3c, 0x56, 0xe5, 0x4f, 0x2a, 0xf1, 0x11, 0xda, 0x78, 0x46,
+	0x47, 0x3d, 0x25, 0x19, 0x44, 0x82, 0x0d, 0xed, 0x86, 0xc1, 0xae, 0x3d, 0x51, 0xb4, 0x0f, 0xd9,
+	0x89, 0x4e, 0xc0, 0xbf, 0xa1, 0xef, 0xee, 0x3b, 0x06, 0x42, 0x5f, 0x2a, 0xc8, 0x88, 0x0f, 0x11,
+	0x9d, 0xd8, 0xc8, 0x60, 0xdb, 0x77, 0xfd, 0xc2, 0xb1, 0x0e, 0x9e, 0xea, 0x58, 0xf7, 0x0d, 0x6a,
+	0xcd, 0x14, 0xc4, 0x87, 0xa8, 0x56, 0xb0, 0x9b, 0xad, 0x58, 0xdc, 0xfb, 0xde, 0xd1, 0xeb, 0xa9,
+	0x57, 0xcb, 0x99, 0xee, 0xd3, 0x68, 0xd7, 0x29, 0x92, 0x4f, 0xaa, 0x57, 0x9f, 0x7e, 0xa8, 0x78,
+	0x25, 0xc0, 0xc8, 0x3c, 0x43, 0x1f, 0x02, 0x0f, 0x42, 0x65, 0xcf, 0x19, 0x7e, 0x0c, 0x0f, 0xc8,
+	0xff, 0x32, 0x91, 0x6e, 0x0f, 0xb5, 0x2f, 0xb2, 0x3c, 0x61, 0x54, 0x81, 0x7f, 0x2e, 0x03, 0x0f,
+	0x64, 0x2a, 0x12, 0x09, 0x78, 0x0d, 0xd5, 0xd5, 0x24, 0x05, 0x92, 0x67, 0x91, 0x69, 0xa3, 0xe1,
+	0x2d, 0x68, 0xfb, 0xff, 0x2c, 0xc2, 0x18, 0x55, 0x43, 0x2a, 0x43, 0x53, 0xb4, 0xe9, 0x99, 0x73,
+	0xf7, 0x83, 0x85, 0xbe, 0x2d, 0x3a, 0xea, 0xcd, 0x2e, 0x02, 0x3e, 0x42, 0x8d, 0xf2, 0x07, 0x70,
+	0xbf, 0x1c, 0x68, 0xe3, 0x2b, 0x03, 0x9d, 0xf9, 0xe5, 0x48, 0xf5, 0xb4, 0xb4, 0xf1, 0x2a, 0xaa,
+	0x15, 0xcb, 0x57, 0x32, 0x96, 0x16, 0xfe, 0x11, 0xb5, 0x60, 0x9c, 0xf2, 0x6c, 0x32, 0x9d, 0xb2,
+	0xd8, 0xf9, 0x66, 0xe1, 0x2c, 0xe7, 0x7b, 0x6b, 0xa1, 0xe5, 0xc7, 0x3b, 0x32, 0xc1, 0xeb, 0xa8,
+	0x2e, 0xe1, 0x55, 0x0e, 0x09, 0x03, 0xd3, 0x53, 0xd5, 0xbb, 0xb3, 0x35, 0xdd, 0x8c, 0x6a, 0xa5,
+	0x85, 0xbb, 0xa8, 0x15, 0xcb, 0x80, 0x4c, 0x55, 0xd1, 0x57, 0x4c, 0x5f, 0xc7, 0xc5, 0x58, 0x06,
+	0x17, 0x85, 0x32, 0x12, 0xdb, 0x68, 0x41, 0xe6, 0x8c, 0x81, 0x94, 0xe6, 0x4a, 0xd5, 0xbd, 0xa9,
+	0xd9, 0xfd, 0x1d, 0x35, 0xff, 0xcd, 0x21, 0x9b, 0x78, 0x9a, 0x46, 0x2a, 0x2d, 0x62, 0x4a, 0x55,
+	0x58, 0x6a, 0x6b, 0xce, 0xda, 0xa7, 0xaf, 0xe4, 0x54, 0x58, 0x7d, 0x3e, 0xf1, 0xaf, 0x6e, 0x3a,
+	0xd6, 0xf5, 0x4d, 0xc7, 0xfa, 0x7c, 0xd3, 0xb1, 0xde, 0xdd, 0x76, 0x2a, 0xd7, 0xb7, 0x9d, 0xca,
+	0xc7, 0xdb, 0x4e, 0xe5, 0xc5, 0xdf, 0x01, 0x57, 0x61, 0x3e, 0x70, 0x98, 0x88, 0x5d, 0x26, 0x64,
+	0x2c, 0xa4, 0xcb, 0x07, 0x6c, 0x27, 0x10, 0xee, 0xe8, 0x0f, 0x37, 0x16, 0x7e, 0x1e, 0x81, 0xd4,
+	0xaf, 0xa1, 0x74, 0xf7, 0x0e, 0x76, 0xee, 0xdf, 0xb3, 0x9d, 0xd9, 0x87, 0x50, 0x8f, 0x23, 0x07,
+	0x35, 0xf3, 0x40, 0xed, 0x7f, 0x19, 0x00, 0x0a, 0x6c, 0x95, 0xec, 0x42, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -187,6 +556,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxExecuteAfterDelay != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxExecuteAfterDelay))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MaxPendingPacketsPerBlock != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxPendingPacketsPerBlock))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxPendingPacketGas != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxPendingPacketGas))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxExecutionLogEntries != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxExecutionLogEntries))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EnableExecutionLog {
+		i--
+		if m.EnableExecutionLog {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ExecutionResultRetentionPeriod != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.ExecutionResultRetentionPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxAckDataSize != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxAckDataSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxPendingPackets != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxPendingPackets))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -209,7 +623,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
+func (m *PendingPacket) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -219,70 +633,339 @@ func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingPacket) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PendingPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintHost(dAtA, i, uint64(len(m.Data)))
+	if m.ExecuteAfterHeight != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.ExecuteAfterHeight))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Path) > 0 {
-		i -= len(m.Path)
-		copy(dAtA[i:], m.Path)
-		i = encodeVarintHost(dAtA, i, uint64(len(m.Path)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHost(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
-	offset -= sovHost(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TruncatedMsgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TruncatedMsgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TruncatedMsgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.HostEnabled {
-		n += 2
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.AllowMessages) > 0 {
-		for _, s := range m.AllowMessages {
-			l = len(s)
-			n += 1 + l + sovHost(uint64(l))
-		}
+	if len(m.TypeUrl) > 0 {
+		i -= len(m.TypeUrl)
+		copy(dAtA[i:], m.TypeUrl)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.TypeUrl)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PacketExecutionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Path)
-	if l > 0 {
+	return dAtA[:n], nil
+}
+
+func (m *PacketExecutionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketExecutionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Result) > 0 {
+		i -= len(m.Result)
+		copy(dAtA[i:], m.Result)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Result)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHost(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionLogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionLogEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionLogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HostEnabled {
+		n += 2
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.MaxPendingPackets != 0 {
+		n += 1 + sovHost(uint64(m.MaxPendingPackets))
+	}
+	if m.MaxAckDataSize != 0 {
+		n += 1 + sovHost(uint64(m.MaxAckDataSize))
+	}
+	if m.ExecutionResultRetentionPeriod != 0 {
+		n += 1 + sovHost(uint64(m.ExecutionResultRetentionPeriod))
+	}
+	if m.EnableExecutionLog {
+		n += 2
+	}
+	if m.MaxExecutionLogEntries != 0 {
+		n += 1 + sovHost(uint64(m.MaxExecutionLogEntries))
+	}
+	if m.MaxPendingPacketGas != 0 {
+		n += 1 + sovHost(uint64(m.MaxPendingPacketGas))
+	}
+	if m.MaxPendingPacketsPerBlock != 0 {
+		n += 1 + sovHost(uint64(m.MaxPendingPacketsPerBlock))
+	}
+	if m.MaxExecuteAfterDelay != 0 {
+		n += 1 + sovHost(uint64(m.MaxExecuteAfterDelay))
+	}
+	return n
+}
+
+func (m *PendingPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovHost(uint64(l))
+	if m.ExecuteAfterHeight != 0 {
+		n += 1 + sovHost(uint64(m.ExecuteAfterHeight))
+	}
+	return n
+}
+
+func (m *TruncatedMsgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TypeUrl)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketExecutionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovHost(uint64(l))
+	l = len(m.Result)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovHost(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *ExecutionLogEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovHost(uint64(m.Sequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovHost(uint64(m.Height))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.Success {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
 		n += 1 + l + sovHost(uint64(l))
 	}
 	l = len(m.Data)
@@ -379,6 +1062,653 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingPackets", wireType)
+			}
+			m.MaxPendingPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAckDataSize", wireType)
+			}
+			m.MaxAckDataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAckDataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionResultRetentionPeriod", wireType)
+			}
+			m.ExecutionResultRetentionPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionResultRetentionPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableExecutionLog", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableExecutionLog = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecutionLogEntries", wireType)
+			}
+			m.MaxExecutionLogEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecutionLogEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingPacketGas", wireType)
+			}
+			m.MaxPendingPacketGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingPacketGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingPacketsPerBlock", wireType)
+			}
+			m.MaxPendingPacketsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPendingPacketsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExecuteAfterDelay", wireType)
+			}
+			m.MaxExecuteAfterDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExecuteAfterDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteAfterHeight", wireType)
+			}
+			m.ExecuteAfterHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteAfterHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TruncatedMsgResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TruncatedMsgResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TruncatedMsgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketExecutionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketExecutionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Result = append(m.Result[:0], dAtA[iNdEx:postIndex]...)
+			if m.Result == nil {
+				m.Result = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionLogEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionLogEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionLogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -16,8 +18,62 @@ const (
 
 	// AllowAllHostMsgs holds the string key that allows all message types on interchain accounts host module
 	AllowAllHostMsgs = "*"
+
+	// PendingPacketKeyPrefix defines the key prefix used to store packets queued for deferred execution
+	PendingPacketKeyPrefix = "pendingPacket"
+
+	// PendingPacketCountKey is the key used to store the number of packets queued for deferred execution
+	PendingPacketCountKey = "pendingPacketCount"
+
+	// ExecutionResultKeyPrefix defines the key prefix used to store the full results of packets whose
+	// acknowledgement has been truncated
+	ExecutionResultKeyPrefix = "executionResult"
+
+	// ExecutionResultExpiryKeyPrefix defines the key prefix used to index execution results by expiry height
+	ExecutionResultExpiryKeyPrefix = "executionResultExpiry"
+
+	// ExecutionLogKeyPrefix defines the key prefix used to store the execution log entries of interchain accounts
+	ExecutionLogKeyPrefix = "executionLog"
+
+	// ExecutionLogSequenceKeyPrefix defines the key prefix used to store the next execution log sequence of
+	// interchain accounts
+	ExecutionLogSequenceKeyPrefix = "executionLogSequence"
 )
 
+// KeyPendingPacket creates and returns a new key used for pending packet store operations.
+// The execute after height is zero padded so that pending packets are iterated in ascending order of height.
+func KeyPendingPacket(executeAfterHeight uint64, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%020d/%s/%d", PendingPacketKeyPrefix, executeAfterHeight, channelID, sequence))
+}
+
+// KeyExecutionResult creates and returns a new key used for execution result store operations.
+func KeyExecutionResult(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", ExecutionResultKeyPrefix, portID, channelID, sequence))
+}
+
+// KeyExecutionResultExpiry creates and returns a new key used to index an execution result by expiry height.
+// The expiry height is zero padded so that execution results are iterated in ascending order of expiry height.
+func KeyExecutionResultExpiry(expiryHeight uint64, portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%020d/%s/%s/%d", ExecutionResultExpiryKeyPrefix, expiryHeight, portID, channelID, sequence))
+}
+
+// KeyExecutionLogPrefix returns the key prefix of the execution log entries of the provided interchain account.
+func KeyExecutionLogPrefix(address string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", ExecutionLogKeyPrefix, address))
+}
+
+// KeyExecutionLogEntry creates and returns a new key used for execution log entry store operations.
+// The sequence is zero padded so that the entries of an interchain account are iterated in ascending order of sequence.
+func KeyExecutionLogEntry(address string, sequence uint64) []byte {
+	return append(KeyExecutionLogPrefix(address), []byte(fmt.Sprintf("%020d", sequence))...)
+}
+
+// KeyExecutionLogSequence creates and returns a new key used to store the next execution log sequence of the provided
+// interchain account.
+func KeyExecutionLogSequence(address string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", ExecutionLogSequenceKeyPrefix, address))
+}
+
 // ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
 func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
 	// check that wildcard * option for allowing all message types is the only string in the array, if so, return true
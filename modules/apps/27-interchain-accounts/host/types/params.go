@@ -11,13 +11,35 @@ const (
 	DefaultHostEnabled = true
 	// Maximum length of the allowlist
 	MaxAllowListLength = 500
+	// DefaultMaxPendingPackets is the default maximum number of packets queued for deferred execution
+	DefaultMaxPendingPackets = 100
+	// DefaultExecutionResultRetentionPeriod is the default number of blocks for which the full result of a
+	// packet whose acknowledgement has been truncated is stored
+	DefaultExecutionResultRetentionPeriod = 10000
+	// DefaultMaxExecutionLogEntries is the default maximum number of entries kept in the execution log of an
+	// interchain account
+	DefaultMaxExecutionLogEntries = 100
+	// DefaultMaxPendingPacketGas is the default gas limit for the execution of each pending packet
+	DefaultMaxPendingPacketGas = 1_000_000
+	// DefaultMaxPendingPacketsPerBlock is the default maximum number of pending packets executed in a block
+	DefaultMaxPendingPacketsPerBlock = 10
+	// DefaultMaxExecuteAfterDelay is the default maximum number of blocks by which the execution of a packet may be deferred
+	DefaultMaxExecuteAfterDelay = 100_000
 )
 
 // NewParams creates a new parameter configuration for the host submodule
+// with the default pending packet limits, execution result retention period and maximum number
+// of execution log entries. Acknowledgement truncation and the execution log are disabled.
 func NewParams(enableHost bool, allowMsgs []string) Params {
 	return Params{
-		HostEnabled:   enableHost,
-		AllowMessages: allowMsgs,
+		HostEnabled:                    enableHost,
+		AllowMessages:                  allowMsgs,
+		MaxPendingPackets:              DefaultMaxPendingPackets,
+		ExecutionResultRetentionPeriod: DefaultExecutionResultRetentionPeriod,
+		MaxExecutionLogEntries:         DefaultMaxExecutionLogEntries,
+		MaxPendingPacketGas:            DefaultMaxPendingPacketGas,
+		MaxPendingPacketsPerBlock:      DefaultMaxPendingPacketsPerBlock,
+		MaxExecuteAfterDelay:           DefaultMaxExecuteAfterDelay,
 	}
 }
 
@@ -28,6 +50,28 @@ func DefaultParams() Params {
 
 // Validate validates all host submodule parameters
 func (p Params) Validate() error {
+	if p.MaxAckDataSize != 0 && p.ExecutionResultRetentionPeriod == 0 {
+		return fmt.Errorf("execution result retention period must be positive when the maximum acknowledgement data size is set")
+	}
+
+	if p.EnableExecutionLog && p.MaxExecutionLogEntries == 0 {
+		return fmt.Errorf("maximum number of execution log entries must be positive when the execution log is enabled")
+	}
+
+	// the pending packet limits are required even if deferred execution is disabled, as packets may have been
+	// queued before it was disabled
+	if p.MaxPendingPacketGas == 0 {
+		return fmt.Errorf("maximum pending packet gas must be positive")
+	}
+
+	if p.MaxPendingPacketsPerBlock == 0 {
+		return fmt.Errorf("maximum number of pending packets per block must be positive")
+	}
+
+	if p.MaxExecuteAfterDelay == 0 {
+		return fmt.Errorf("maximum execute after delay must be positive")
+	}
+
 	return validateAllowlist(p.AllowMessages)
 }
 
@@ -15,4 +15,37 @@ func TestValidateParams(t *testing.T) {
 	require.Error(t, types.NewParams(true, []string{" "}).Validate())
 	require.Error(t, types.NewParams(true, []string{"*", "/cosmos.bank.v1beta1.MsgSend"}).Validate())
 	require.Error(t, types.NewParams(true, make([]string, types.MaxAllowListLength+1)).Validate())
+
+	params := types.DefaultParams()
+	params.MaxAckDataSize = 1024
+	require.NoError(t, params.Validate())
+
+	params.ExecutionResultRetentionPeriod = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	require.False(t, params.EnableExecutionLog)
+	params.EnableExecutionLog = true
+	require.NoError(t, params.Validate())
+
+	params.MaxExecutionLogEntries = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxPendingPacketGas = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxPendingPacketsPerBlock = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.MaxExecuteAfterDelay = 0
+	require.Error(t, params.Validate())
+
+	// the pending packet limits are required if deferred execution is disabled
+	params = types.DefaultParams()
+	params.MaxPendingPackets = 0
+	params.MaxPendingPacketsPerBlock = 0
+	require.Error(t, params.Validate())
 }
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -111,9 +113,237 @@ func (m *QueryParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryPacketExecutionResultRequest is the request type for the Query/PacketExecutionResult RPC method.
+type QueryPacketExecutionResultRequest struct {
+	// port unique identifier on the host chain
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel unique identifier on the host chain
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// packet sequence
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *QueryPacketExecutionResultRequest) Reset()         { *m = QueryPacketExecutionResultRequest{} }
+func (m *QueryPacketExecutionResultRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketExecutionResultRequest) ProtoMessage()    {}
+func (*QueryPacketExecutionResultRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{2}
+}
+func (m *QueryPacketExecutionResultRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketExecutionResultRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketExecutionResultRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketExecutionResultRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketExecutionResultRequest.Merge(m, src)
+}
+func (m *QueryPacketExecutionResultRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketExecutionResultRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketExecutionResultRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketExecutionResultRequest proto.InternalMessageInfo
+
+func (m *QueryPacketExecutionResultRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryPacketExecutionResultRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryPacketExecutionResultRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+// QueryPacketExecutionResultResponse is the response type for the Query/PacketExecutionResult RPC method.
+type QueryPacketExecutionResultResponse struct {
+	// full transaction result of the packet
+	Result *PacketExecutionResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *QueryPacketExecutionResultResponse) Reset()         { *m = QueryPacketExecutionResultResponse{} }
+func (m *QueryPacketExecutionResultResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPacketExecutionResultResponse) ProtoMessage()    {}
+func (*QueryPacketExecutionResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{3}
+}
+func (m *QueryPacketExecutionResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPacketExecutionResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPacketExecutionResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPacketExecutionResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPacketExecutionResultResponse.Merge(m, src)
+}
+func (m *QueryPacketExecutionResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPacketExecutionResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPacketExecutionResultResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPacketExecutionResultResponse proto.InternalMessageInfo
+
+func (m *QueryPacketExecutionResultResponse) GetResult() *PacketExecutionResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+// QueryInterchainAccountExecutionsRequest is the request type for the Query/InterchainAccountExecutions RPC method.
+type QueryInterchainAccountExecutionsRequest struct {
+	// address of the interchain account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInterchainAccountExecutionsRequest) Reset() {
+	*m = QueryInterchainAccountExecutionsRequest{}
+}
+func (m *QueryInterchainAccountExecutionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountExecutionsRequest) ProtoMessage()    {}
+func (*QueryInterchainAccountExecutionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{4}
+}
+func (m *QueryInterchainAccountExecutionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountExecutionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountExecutionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountExecutionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountExecutionsRequest.Merge(m, src)
+}
+func (m *QueryInterchainAccountExecutionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountExecutionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountExecutionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountExecutionsRequest proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountExecutionsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryInterchainAccountExecutionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInterchainAccountExecutionsResponse is the response type for the Query/InterchainAccountExecutions RPC method.
+type QueryInterchainAccountExecutionsResponse struct {
+	// execution log entries of the interchain account, in ascending order of sequence
+	Executions []ExecutionLogEntry `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryInterchainAccountExecutionsResponse) Reset() {
+	*m = QueryInterchainAccountExecutionsResponse{}
+}
+func (m *QueryInterchainAccountExecutionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInterchainAccountExecutionsResponse) ProtoMessage()    {}
+func (*QueryInterchainAccountExecutionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e6b7e23fc90c353a, []int{5}
+}
+func (m *QueryInterchainAccountExecutionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInterchainAccountExecutionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInterchainAccountExecutionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInterchainAccountExecutionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInterchainAccountExecutionsResponse.Merge(m, src)
+}
+func (m *QueryInterchainAccountExecutionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInterchainAccountExecutionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInterchainAccountExecutionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInterchainAccountExecutionsResponse proto.InternalMessageInfo
+
+func (m *QueryInterchainAccountExecutionsResponse) GetExecutions() []ExecutionLogEntry {
+	if m != nil {
+		return m.Executions
+	}
+	return nil
+}
+
+func (m *QueryInterchainAccountExecutionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryPacketExecutionResultRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryPacketExecutionResultRequest")
+	proto.RegisterType((*QueryPacketExecutionResultResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryPacketExecutionResultResponse")
+	proto.RegisterType((*QueryInterchainAccountExecutionsRequest)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInterchainAccountExecutionsRequest")
+	proto.RegisterType((*QueryInterchainAccountExecutionsResponse)(nil), "ibc.applications.interchain_accounts.host.v1.QueryInterchainAccountExecutionsResponse")
 }
 
 func init() {
@@ -121,27 +351,49 @@ func init() {
 }
 
 var fileDescriptor_e6b7e23fc90c353a = []byte{
-	// 312 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0x31, 0x4b, 0x33, 0x31,
-	0x18, 0xc7, 0x9b, 0x17, 0xde, 0x0e, 0x71, 0x8b, 0x0e, 0x52, 0x24, 0x48, 0x27, 0x87, 0x36, 0xa1,
-	0xb5, 0xd0, 0x8e, 0xea, 0x28, 0x0e, 0xea, 0xe8, 0x22, 0xb9, 0x34, 0x5c, 0x03, 0xbd, 0x3c, 0xe9,
-	0x25, 0x77, 0xd0, 0xd5, 0x4f, 0x20, 0xf8, 0x91, 0x5c, 0x5c, 0x84, 0x82, 0x8b, 0xa3, 0xdc, 0xf9,
-	0x41, 0xe4, 0x72, 0x07, 0x5a, 0x14, 0xe1, 0x70, 0x7d, 0xc2, 0xef, 0xf7, 0x7f, 0xfe, 0x4f, 0xf0,
-	0x4c, 0x47, 0x92, 0x0b, 0x6b, 0x97, 0x5a, 0x0a, 0xaf, 0xc1, 0x38, 0xae, 0x8d, 0x57, 0xa9, 0x5c,
-	0x08, 0x6d, 0x6e, 0x85, 0x94, 0x90, 0x19, 0xef, 0xf8, 0x02, 0x9c, 0xe7, 0xf9, 0x88, 0xaf, 0x32,
-	0x95, 0xae, 0x99, 0x4d, 0xc1, 0x03, 0x19, 0xe8, 0x48, 0xb2, 0xaf, 0x24, 0xfb, 0x81, 0x64, 0x15,
-	0xc9, 0xf2, 0x51, 0xef, 0x20, 0x06, 0x88, 0x97, 0x8a, 0x0b, 0xab, 0xb9, 0x30, 0x06, 0x7c, 0xc3,
-	0x04, 0x57, 0x6f, 0xda, 0x6a, 0x8b, 0xe0, 0x0c, 0x60, 0x7f, 0x0f, 0x93, 0xab, 0x6a, 0xa7, 0x4b,
-	0x91, 0x8a, 0xc4, 0x5d, 0xab, 0x55, 0xa6, 0x9c, 0xef, 0x4b, 0xbc, 0xbb, 0x35, 0x75, 0x16, 0x8c,
-	0x53, 0xe4, 0x02, 0x77, 0x6d, 0x98, 0xec, 0xa3, 0x43, 0x74, 0xb4, 0x33, 0x9e, 0xb0, 0x36, 0x15,
-	0x58, 0x63, 0x6b, 0x1c, 0xe3, 0x67, 0x84, 0xff, 0x87, 0x14, 0xf2, 0x88, 0x70, 0xb7, 0x7e, 0x24,
-	0x27, 0xed, 0x94, 0xdf, 0x77, 0xef, 0x9d, 0xfe, 0xc1, 0x50, 0xf7, 0xec, 0x4f, 0xee, 0x5e, 0xde,
-	0x1f, 0xfe, 0x31, 0x32, 0xe0, 0xcd, 0x59, 0x7f, 0x3f, 0x67, 0xdd, 0xe7, 0x6c, 0xfe, 0x54, 0x50,
-	0xb4, 0x29, 0x28, 0x7a, 0x2b, 0x28, 0xba, 0x2f, 0x69, 0x67, 0x53, 0xd2, 0xce, 0x6b, 0x49, 0x3b,
-	0x37, 0xe7, 0xb1, 0xf6, 0x8b, 0x2c, 0x62, 0x12, 0x12, 0x2e, 0xc1, 0x25, 0xe0, 0x2a, 0xf1, 0x30,
-	0x06, 0x9e, 0xcf, 0x78, 0x02, 0xf3, 0x6c, 0xa9, 0x5c, 0x1d, 0x33, 0x9e, 0x0e, 0x3f, 0x93, 0x86,
-	0xdb, 0x49, 0x7e, 0x6d, 0x95, 0x8b, 0xba, 0xe1, 0xdf, 0x8e, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xbc, 0x5f, 0x87, 0x7c, 0x78, 0x02, 0x00, 0x00,
+	// 660 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x41, 0x4f, 0x13, 0x41,
+	0x18, 0xed, 0x16, 0x2c, 0x32, 0xdc, 0x46, 0x8c, 0x4d, 0xd5, 0x15, 0xf7, 0x20, 0x8d, 0x81, 0x99,
+	0xb4, 0x92, 0xc0, 0x4d, 0x81, 0xa0, 0x41, 0x49, 0xc4, 0x4d, 0xf4, 0xa0, 0x07, 0x32, 0x3b, 0x3b,
+	0xd9, 0xae, 0xb6, 0x33, 0xcb, 0xce, 0x6c, 0x95, 0x34, 0x1c, 0xf4, 0xea, 0xc5, 0xc4, 0x8b, 0xfe,
+	0x07, 0xff, 0x85, 0x17, 0x8e, 0x24, 0x5e, 0x8c, 0x07, 0x63, 0xc0, 0x1f, 0x62, 0x76, 0x66, 0x58,
+	0x4a, 0xac, 0x40, 0x91, 0x5b, 0xf7, 0x9b, 0xfd, 0xde, 0xf7, 0xde, 0xd7, 0xf7, 0x66, 0xc1, 0x42,
+	0x1c, 0x50, 0x4c, 0x92, 0xa4, 0x1d, 0x53, 0xa2, 0x62, 0xc1, 0x25, 0x8e, 0xb9, 0x62, 0x29, 0x6d,
+	0x91, 0x98, 0x6f, 0x10, 0x4a, 0x45, 0xc6, 0x95, 0xc4, 0x2d, 0x21, 0x15, 0xee, 0x36, 0xf0, 0x66,
+	0xc6, 0xd2, 0x2d, 0x94, 0xa4, 0x42, 0x09, 0x38, 0x13, 0x07, 0x14, 0xf5, 0x77, 0xa2, 0x01, 0x9d,
+	0x28, 0xef, 0x44, 0xdd, 0x46, 0x6d, 0x32, 0x12, 0x91, 0xd0, 0x8d, 0x38, 0xff, 0x65, 0x30, 0x6a,
+	0xd7, 0x22, 0x21, 0xa2, 0x36, 0xc3, 0x24, 0x89, 0x31, 0xe1, 0x5c, 0x28, 0x8b, 0x64, 0x4e, 0x6f,
+	0x53, 0x21, 0x3b, 0x42, 0xe2, 0x80, 0x48, 0x66, 0x46, 0xe3, 0x6e, 0x23, 0x60, 0x8a, 0x34, 0x70,
+	0x42, 0xa2, 0x98, 0xeb, 0x97, 0xed, 0xbb, 0xf3, 0x43, 0xe9, 0xd0, 0xac, 0x74, 0xa3, 0x37, 0x09,
+	0xe0, 0x93, 0x1c, 0x7a, 0x9d, 0xa4, 0xa4, 0x23, 0x7d, 0xb6, 0x99, 0x31, 0xa9, 0x3c, 0x0a, 0x2e,
+	0x1d, 0xa9, 0xca, 0x44, 0x70, 0xc9, 0xe0, 0x1a, 0xa8, 0x24, 0xba, 0x52, 0x75, 0xa6, 0x9c, 0xfa,
+	0x44, 0x73, 0x0e, 0x0d, 0xb3, 0x04, 0x64, 0xd1, 0x2c, 0x86, 0xf7, 0x1a, 0xdc, 0xb4, 0x43, 0xe8,
+	0x2b, 0xa6, 0x56, 0xde, 0x30, 0x9a, 0xe5, 0x18, 0x3e, 0x93, 0x59, 0x5b, 0x59, 0x26, 0xf0, 0x0a,
+	0x18, 0x4b, 0x44, 0xaa, 0x36, 0xe2, 0x50, 0xcf, 0x1c, 0xf7, 0x2b, 0xf9, 0xe3, 0x6a, 0x08, 0xaf,
+	0x03, 0x40, 0x5b, 0x84, 0x73, 0xd6, 0xce, 0xcf, 0xca, 0xfa, 0x6c, 0xdc, 0x56, 0x56, 0x43, 0x58,
+	0x03, 0x17, 0x65, 0x0e, 0xc1, 0x29, 0xab, 0x8e, 0x4c, 0x39, 0xf5, 0x51, 0xbf, 0x78, 0xf6, 0xde,
+	0x3a, 0xc0, 0x3b, 0x6e, 0xb2, 0x55, 0xfb, 0x02, 0x54, 0x52, 0x5d, 0xb1, 0x6a, 0x97, 0x87, 0x55,
+	0x3b, 0x08, 0xdc, 0x42, 0x7a, 0xef, 0x1d, 0x30, 0xad, 0x39, 0xac, 0x16, 0x10, 0x8b, 0x06, 0xa1,
+	0xe8, 0x38, 0xf8, 0x37, 0x60, 0x15, 0x8c, 0x91, 0x30, 0x4c, 0x99, 0x94, 0x76, 0x07, 0x07, 0x8f,
+	0xf0, 0x3e, 0x00, 0x87, 0x56, 0xd0, 0x4b, 0x98, 0x68, 0xde, 0x42, 0xc6, 0x37, 0x28, 0xf7, 0x0d,
+	0x32, 0x96, 0xb5, 0xbe, 0x41, 0xeb, 0x24, 0x62, 0x16, 0xd5, 0xef, 0xeb, 0xf4, 0x7e, 0x38, 0xa0,
+	0x7e, 0x32, 0x1b, 0xbb, 0x17, 0x06, 0x00, 0x2b, 0xaa, 0x55, 0x67, 0x6a, 0xa4, 0x3e, 0xd1, 0xbc,
+	0x3b, 0xdc, 0x6e, 0x0a, 0xd4, 0x35, 0x11, 0xad, 0x70, 0x95, 0x6e, 0x2d, 0x8d, 0xee, 0xfc, 0xbc,
+	0x51, 0xf2, 0xfb, 0x80, 0xe1, 0x83, 0x01, 0xda, 0xa6, 0x4f, 0xd4, 0x66, 0x38, 0xf6, 0x8b, 0x6b,
+	0x7e, 0xaa, 0x80, 0x0b, 0x5a, 0x1c, 0xfc, 0xea, 0x80, 0x8a, 0x31, 0x21, 0xbc, 0x37, 0x1c, 0xe1,
+	0xbf, 0x33, 0x52, 0x5b, 0xfc, 0x0f, 0x04, 0xc3, 0xd2, 0x9b, 0x7b, 0xf7, 0xed, 0xf7, 0xc7, 0x32,
+	0x82, 0x33, 0xd8, 0xc6, 0xf7, 0xf8, 0xd8, 0x9a, 0xdc, 0xc0, 0x2f, 0x65, 0x70, 0x79, 0xa0, 0xb9,
+	0xe0, 0xe3, 0x33, 0x51, 0xfa, 0x77, 0xfa, 0x6a, 0xeb, 0xe7, 0x07, 0x68, 0x25, 0x27, 0x5a, 0xf2,
+	0x4b, 0xd8, 0x3a, 0x9d, 0x64, 0x1b, 0x68, 0x89, 0x7b, 0x87, 0x61, 0xdf, 0xc6, 0xf9, 0x15, 0x20,
+	0x71, 0xcf, 0x5e, 0x0c, 0xdb, 0xb8, 0x70, 0xcd, 0x86, 0x49, 0x97, 0xc4, 0xbd, 0x83, 0xb0, 0x6f,
+	0xc3, 0xcf, 0x65, 0x70, 0xf5, 0x18, 0x5b, 0xc3, 0xa7, 0x67, 0xd0, 0x78, 0x72, 0x68, 0x6b, 0xcf,
+	0xce, 0x1b, 0xd6, 0x2e, 0xf0, 0x91, 0x5e, 0xe0, 0x0a, 0x5c, 0x3e, 0xdd, 0x02, 0x8b, 0x42, 0xcf,
+	0xde, 0x19, 0x7d, 0xdb, 0x92, 0x4b, 0xe1, 0xce, 0x9e, 0xeb, 0xec, 0xee, 0xb9, 0xce, 0xaf, 0x3d,
+	0xd7, 0xf9, 0xb0, 0xef, 0x96, 0x76, 0xf7, 0xdd, 0xd2, 0xf7, 0x7d, 0xb7, 0xf4, 0xfc, 0x61, 0x14,
+	0xab, 0x56, 0x16, 0x20, 0x2a, 0x3a, 0xd8, 0x7e, 0x87, 0xe2, 0x80, 0xce, 0x46, 0x02, 0x77, 0x17,
+	0x70, 0x47, 0x84, 0x59, 0x9b, 0x49, 0x33, 0xbd, 0x39, 0x3f, 0x7b, 0x48, 0x60, 0xf6, 0x28, 0x01,
+	0xb5, 0x95, 0x30, 0x19, 0x54, 0xf4, 0xa7, 0xe6, 0xce, 0x9f, 0x01, 0x00, 0x09, 0xb1, 0xd7, 0xc9,
+	0x6d, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +410,11 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Params queries all parameters of the ICA host submodule.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// PacketExecutionResult queries the full transaction result of an executed packet whose acknowledgement has been
+	// truncated.
+	PacketExecutionResult(ctx context.Context, in *QueryPacketExecutionResultRequest, opts ...grpc.CallOption) (*QueryPacketExecutionResultResponse, error)
+	// InterchainAccountExecutions queries the execution log of an interchain account.
+	InterchainAccountExecutions(ctx context.Context, in *QueryInterchainAccountExecutionsRequest, opts ...grpc.CallOption) (*QueryInterchainAccountExecutionsResponse, error)
 }
 
 type queryClient struct {
@@ -177,10 +434,33 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) PacketExecutionResult(ctx context.Context, in *QueryPacketExecutionResultRequest, opts ...grpc.CallOption) (*QueryPacketExecutionResultResponse, error) {
+	out := new(QueryPacketExecutionResultResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/PacketExecutionResult", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InterchainAccountExecutions(ctx context.Context, in *QueryInterchainAccountExecutionsRequest, opts ...grpc.CallOption) (*QueryInterchainAccountExecutionsResponse, error) {
+	out := new(QueryInterchainAccountExecutionsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.interchain_accounts.host.v1.Query/InterchainAccountExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries all parameters of the ICA host submodule.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// PacketExecutionResult queries the full transaction result of an executed packet whose acknowledgement has been
+	// truncated.
+	PacketExecutionResult(context.Context, *QueryPacketExecutionResultRequest) (*QueryPacketExecutionResultResponse, error)
+	// InterchainAccountExecutions queries the execution log of an interchain account.
+	InterchainAccountExecutions(context.Context, *QueryInterchainAccountExecutionsRequest) (*QueryInterchainAccountExecutionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -190,6 +470,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) PacketExecutionResult(ctx context.Context, req *QueryPacketExecutionResultRequest) (*QueryPacketExecutionResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PacketExecutionResult not implemented")
+}
+func (*UnimplementedQueryServer) InterchainAccountExecutions(ctx context.Context, req *QueryInterchainAccountExecutionsRequest) (*QueryInterchainAccountExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InterchainAccountExecutions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -213,6 +499,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PacketExecutionResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPacketExecutionResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PacketExecutionResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/PacketExecutionResult",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PacketExecutionResult(ctx, req.(*QueryPacketExecutionResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InterchainAccountExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInterchainAccountExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InterchainAccountExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.interchain_accounts.host.v1.Query/InterchainAccountExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InterchainAccountExecutions(ctx, req.(*QueryInterchainAccountExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.interchain_accounts.host.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -221,6 +543,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "PacketExecutionResult",
+			Handler:    _Query_PacketExecutionResult_Handler,
+		},
+		{
+			MethodName: "InterchainAccountExecutions",
+			Handler:    _Query_InterchainAccountExecutions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/interchain_accounts/host/v1/query.proto",
@@ -284,55 +614,292 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPacketExecutionResultRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryPacketExecutionResultRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketExecutionResultRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPacketExecutionResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketExecutionResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketExecutionResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryInterchainAccountExecutionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryInterchainAccountExecutionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
+
+func (m *QueryInterchainAccountExecutionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInterchainAccountExecutionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInterchainAccountExecutionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInterchainAccountExecutionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Executions) > 0 {
+		for iNdEx := len(m.Executions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Executions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPacketExecutionResultRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryPacketExecutionResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountExecutionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInterchainAccountExecutionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Executions) > 0 {
+		for _, e := range m.Executions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
@@ -459,6 +1026,463 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPacketExecutionResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketExecutionResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketExecutionResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketExecutionResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketExecutionResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketExecutionResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &PacketExecutionResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountExecutionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountExecutionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountExecutionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInterchainAccountExecutionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInterchainAccountExecutionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInterchainAccountExecutionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executions = append(m.Executions, ExecutionLogEntry{})
+			if err := m.Executions[len(m.Executions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -39,6 +39,8 @@ var (
 	_ module.HasServices         = (*AppModule)(nil)
 	_ module.HasProposalMsgs     = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
+	_ appmodule.HasBeginBlocker  = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker    = (*AppModule)(nil)
 
 	_ porttypes.IBCModule = (*host.IBCModule)(nil)
 )
@@ -147,6 +149,14 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	}); err != nil {
 		panic(fmt.Errorf("failed to migrate interchainaccounts app from version 2 to 3 (self-managed params migration): %v", err))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 3, controllerMigrator.MigrateMinCancelRegistrationBlockAge); err != nil {
+		panic(fmt.Errorf("failed to migrate interchainaccounts app from version 3 to 4 (controller minimum cancel registration block age param migration): %v", err))
+	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 4, hostMigrator.MigratePendingPacketParams); err != nil {
+		panic(fmt.Errorf("failed to migrate interchainaccounts app from version 4 to 5 (host pending packet params migration): %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the interchain accounts module.
@@ -185,7 +195,25 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 3 }
+func (AppModule) ConsensusVersion() uint64 { return 5 }
+
+// BeginBlock executes the packets queued by the host submodule whose execute after height has been reached.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	if am.hostKeeper != nil {
+		am.hostKeeper.ExecutePendingPackets(sdk.UnwrapSDKContext(ctx))
+	}
+
+	return nil
+}
+
+// EndBlock prunes the execution results stored by the host submodule whose expiry height has been reached.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	if am.hostKeeper != nil {
+		am.hostKeeper.PruneExpiredExecutionResults(sdk.UnwrapSDKContext(ctx))
+	}
+
+	return nil
+}
 
 // AppModuleSimulation functions
 
@@ -44,10 +44,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { hostEnabled = RandomEnabled(r) },
 	)
 
-	hostParams := hosttypes.Params{
-		HostEnabled:   hostEnabled,
-		AllowMessages: []string{"*"}, // allow all messages
-	}
+	hostParams := hosttypes.NewParams(hostEnabled, []string{"*"}) // allow all messages
 
 	hostGenesisState := genesistypes.HostGenesisState{
 		ActiveChannels:     nil,
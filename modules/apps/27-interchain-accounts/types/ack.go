@@ -0,0 +1,48 @@
+package types
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgResponseWithRequest contains the response of a message executed by an interchain account on the host chain
+// together with the type URL of the executed message.
+type MsgResponseWithRequest struct {
+	// type URL of the executed message
+	RequestTypeURL string
+	// response of the executed message
+	Response *codectypes.Any
+}
+
+// ParseTxMsgDataWithRequests unmarshals the result of a successful acknowledgement of an EXECUTE_TX packet into
+// sdk.TxMsgData and pairs each message response with the message it was returned for. The provided messages must be
+// the messages of the packet data in the order they were sent, as returned by DeserializeCosmosTx.
+func ParseTxMsgDataWithRequests(ackResult []byte, msgs []sdk.Msg) ([]MsgResponseWithRequest, error) {
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(ackResult, &txMsgData); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidMsgResponses, "cannot unmarshal tx msg data: %s", err)
+	}
+
+	if len(txMsgData.MsgResponses) != len(msgs) {
+		return nil, errorsmod.Wrapf(ErrInvalidMsgResponses, "expected %d message responses, got %d", len(msgs), len(txMsgData.MsgResponses))
+	}
+
+	responses := make([]MsgResponseWithRequest, len(msgs))
+	for i, msg := range msgs {
+		msgResponse := txMsgData.MsgResponses[i]
+		if msgResponse == nil || msgResponse.TypeUrl == "" {
+			return nil, errorsmod.Wrapf(ErrInvalidMsgResponses, "missing response type URL for message %d of type %s", i, sdk.MsgTypeURL(msg))
+		}
+
+		responses[i] = MsgResponseWithRequest{
+			RequestTypeURL: sdk.MsgTypeURL(msg),
+			Response:       msgResponse,
+		}
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,106 @@
+package types_test
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+)
+
+func (suite *TypesTestSuite) TestParseTxMsgDataWithRequests() {
+	var (
+		ackResult []byte
+		msgs      []sdk.Msg
+	)
+
+	sendResponse, err := codectypes.NewAnyWithValue(&banktypes.MsgSendResponse{})
+	suite.Require().NoError(err)
+	voteResponse, err := codectypes.NewAnyWithValue(&govtypes.MsgVoteResponse{})
+	suite.Require().NoError(err)
+
+	// marshalTxMsgData returns the tx msg data containing the provided message responses
+	marshalTxMsgData := func(msgResponses ...*codectypes.Any) []byte {
+		bz, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: msgResponses})
+		suite.Require().NoError(err)
+		return bz
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: single message",
+			func() {
+				ackResult = marshalTxMsgData(sendResponse)
+				msgs = msgs[:1]
+			},
+			nil,
+		},
+		{
+			"failure: cannot unmarshal tx msg data",
+			func() {
+				ackResult = []byte("invalid tx msg data")
+			},
+			types.ErrInvalidMsgResponses,
+		},
+		{
+			"failure: fewer responses than messages",
+			func() {
+				ackResult = marshalTxMsgData(sendResponse)
+			},
+			types.ErrInvalidMsgResponses,
+		},
+		{
+			"failure: more responses than messages",
+			func() {
+				msgs = msgs[:1]
+			},
+			types.ErrInvalidMsgResponses,
+		},
+		{
+			"failure: response without type URL",
+			func() {
+				ackResult = marshalTxMsgData(sendResponse, &codectypes.Any{})
+			},
+			types.ErrInvalidMsgResponses,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			ackResult = marshalTxMsgData(sendResponse, voteResponse)
+			msgs = []sdk.Msg{&banktypes.MsgSend{}, &govtypes.MsgVote{}}
+
+			tc.malleate()
+
+			responses, err := types.ParseTxMsgDataWithRequests(ackResult, msgs)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Len(responses, len(msgs))
+
+				expResponseTypeURLs := []string{sdk.MsgTypeURL(&banktypes.MsgSendResponse{}), sdk.MsgTypeURL(&govtypes.MsgVoteResponse{})}
+				for i, response := range responses {
+					suite.Require().Equal(sdk.MsgTypeURL(msgs[i]), response.RequestTypeURL)
+					suite.Require().Equal(expResponseTypeURLs[i], response.Response.TypeUrl)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(responses)
+			}
+		})
+	}
+}
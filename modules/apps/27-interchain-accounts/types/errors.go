@@ -23,4 +23,6 @@ var (
 	ErrInvalidTimeoutTimestamp     = errorsmod.Register(ModuleName, 17, "timeout timestamp must be in the future")
 	ErrInvalidCodec                = errorsmod.Register(ModuleName, 18, "codec is not supported")
 	ErrInvalidAccountReopening     = errorsmod.Register(ModuleName, 19, "invalid account reopening")
+	ErrInvalidMsgResponses         = errorsmod.Register(ModuleName, 20, "invalid message responses")
+	ErrInvalidExecuteAfterHeight   = errorsmod.Register(ModuleName, 21, "invalid execute after height")
 )
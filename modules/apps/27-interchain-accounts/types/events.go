@@ -2,10 +2,16 @@ package types
 
 // ICS27 Interchain Accounts events
 const (
-	EventTypePacket = "ics27_packet"
+	EventTypePacket               = "ics27_packet"
+	EventTypePacketDeferred       = "ics27_packet_deferred"
+	EventTypePendingPacketDropped = "ics27_pending_packet_dropped"
+	EventTypeAckTruncated         = "ics27_ack_truncated"
 
 	AttributeKeyAckError            = "error"
 	AttributeKeyHostChannelID       = "host_channel_id"
 	AttributeKeyControllerChannelID = "controller_channel_id"
 	AttributeKeyAckSuccess          = "success"
+	AttributeKeyPacketSequence      = "packet_sequence"
+	AttributeKeyExecuteAfterHeight  = "execute_after_height"
+	AttributeKeyExpiryHeight        = "expiry_height"
 )
@@ -25,6 +25,10 @@ const (
 
 	// hostAccountKey is the key used when generating a module address for the host submodule
 	hostAccountsKey = "icahost-accounts"
+
+	// ExecuteAfterHeightMemoKey holds the packet memo key under which the host block height may be provided,
+	// before which the host must not execute the messages of the packet
+	ExecuteAfterHeightMemoKey = "execute_after_height"
 )
 
 var (
@@ -40,6 +44,12 @@ var (
 	// IsMiddlewareEnabledPrefix defines the key prefix used to store a flag for legacy API callback routing via ibc middleware
 	IsMiddlewareEnabledPrefix = "isMiddlewareEnabled"
 
+	// PendingChannelKeyPrefix defines the key prefix used to store channels with an in flight registration handshake
+	PendingChannelKeyPrefix = "pendingChannel"
+
+	// ChannelInitHeightKeyPrefix defines the key prefix used to store the block height at which a registration handshake was initiated
+	ChannelInitHeightKeyPrefix = "channelInitHeight"
+
 	// MiddlewareEnabled is the value used to signal that controller middleware is enabled
 	MiddlewareEnabled = []byte{0x01}
 
@@ -66,3 +76,13 @@ func KeyPort(portID string) []byte {
 func KeyIsMiddlewareEnabled(portID, connectionID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", IsMiddlewareEnabledPrefix, portID, connectionID))
 }
+
+// KeyPendingChannel creates and returns a new key used for pending channel store operations
+func KeyPendingChannel(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", PendingChannelKeyPrefix, portID, connectionID))
+}
+
+// KeyChannelInitHeight creates and returns a new key used for channel init height store operations
+func KeyChannelInitHeight(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", ChannelInitHeightKeyPrefix, portID, channelID))
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	"encoding/json"
+	"math"
+	"strconv"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -35,6 +37,10 @@ func (iapd InterchainAccountPacketData) ValidateBasic() error {
 		return errorsmod.Wrapf(ErrInvalidOutgoingData, "packet data memo cannot be greater than %d characters", MaxMemoCharLength)
 	}
 
+	if _, err := iapd.GetExecuteAfterHeight(); err != nil {
+		return errorsmod.Wrap(ErrInvalidOutgoingData, err.Error())
+	}
+
 	return nil
 }
 
@@ -76,6 +82,32 @@ func (InterchainAccountPacketData) GetPacketSender(sourcePortID string) string {
 	return icaOwner
 }
 
+// GetExecuteAfterHeight returns the host block height provided under the ExecuteAfterHeightMemoKey of the memo,
+// as a decimal string or a JSON number. The host defers the execution of the packet until its block height is
+// greater than or equal to the returned height. Zero is returned if the memo does not contain the key.
+func (iapd InterchainAccountPacketData) GetExecuteAfterHeight() (uint64, error) {
+	switch height := iapd.GetCustomPacketData(ExecuteAfterHeightMemoKey).(type) {
+	case nil:
+		return 0, nil
+	case string:
+		executeAfterHeight, err := strconv.ParseUint(height, 10, 64)
+		if err != nil {
+			return 0, errorsmod.Wrapf(ErrInvalidExecuteAfterHeight, "failed to parse %s: %v", ExecuteAfterHeightMemoKey, err)
+		}
+
+		return executeAfterHeight, nil
+	case float64:
+		// JSON numbers are only accepted if they can be represented exactly
+		if height < 0 || height != math.Trunc(height) || height > 1<<53 {
+			return 0, errorsmod.Wrapf(ErrInvalidExecuteAfterHeight, "%s must be a non-negative integer, got %v", ExecuteAfterHeightMemoKey, height)
+		}
+
+		return uint64(height), nil
+	default:
+		return 0, errorsmod.Wrapf(ErrInvalidExecuteAfterHeight, "expected %s to be a string or number, got %T", ExecuteAfterHeightMemoKey, height)
+	}
+}
+
 // GetCustomPacketData interprets the memo field of the packet data as a JSON object
 // and returns the value associated with the given key.
 // If the key is missing or the memo is not properly formatted, then nil is returned.
@@ -66,6 +66,24 @@ func (suite *TypesTestSuite) TestValidateBasic() {
 			},
 			false,
 		},
+		{
+			"success, valid execute after height",
+			types.InterchainAccountPacketData{
+				Type: types.EXECUTE_TX,
+				Data: []byte("data"),
+				Memo: `{"execute_after_height": "100"}`,
+			},
+			true,
+		},
+		{
+			"invalid execute after height",
+			types.InterchainAccountPacketData{
+				Type: types.EXECUTE_TX,
+				Data: []byte("data"),
+				Memo: `{"execute_after_height": "-1"}`,
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -115,6 +133,41 @@ func (suite *TypesTestSuite) TestGetPacketSender() {
 	}
 }
 
+func (suite *TypesTestSuite) TestGetExecuteAfterHeight() {
+	testCases := []struct {
+		name      string
+		memo      string
+		expHeight uint64
+		expErr    error
+	}{
+		{"success: empty memo", "", 0, nil},
+		{"success: non-json memo", "memo", 0, nil},
+		{"success: no execute after height in memo", `{"src_callback": {"address": "address"}}`, 0, nil},
+		{"success: height as string", `{"execute_after_height": "100"}`, 100, nil},
+		{"success: height as number", `{"execute_after_height": 100}`, 100, nil},
+		{"failure: negative height", `{"execute_after_height": -1}`, 0, types.ErrInvalidExecuteAfterHeight},
+		{"failure: fractional height", `{"execute_after_height": 1.5}`, 0, types.ErrInvalidExecuteAfterHeight},
+		{"failure: invalid height string", `{"execute_after_height": "height"}`, 0, types.ErrInvalidExecuteAfterHeight},
+		{"failure: height is an object", `{"execute_after_height": {"height": "100"}}`, 0, types.ErrInvalidExecuteAfterHeight},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			packetData := types.InterchainAccountPacketData{
+				Type: types.EXECUTE_TX,
+				Data: []byte("data"),
+				Memo: tc.memo,
+			}
+
+			height, err := packetData.GetExecuteAfterHeight()
+			suite.Require().ErrorIs(err, tc.expErr)
+			suite.Require().Equal(tc.expHeight, height)
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestPacketDataProvider() {
 	expCallbackAddr := ibctesting.TestAccAddress
 
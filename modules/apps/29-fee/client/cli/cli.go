@@ -23,9 +23,18 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdTotalTimeoutFees(),
 		GetCmdIncentivizedPacketsForChannel(),
 		GetCmdPayee(),
+		GetCmdDenomPayee(),
+		GetCmdRefundAddressOverride(),
 		GetCmdCounterpartyPayee(),
+		GetCmdCounterpartyPayeesForRelayer(),
 		GetCmdFeeEnabledChannel(),
 		GetCmdFeeEnabledChannels(),
+		GetCmdAllowedRelayers(),
+		GetCmdAcceptedFeeDenoms(),
+		GetCmdChannelFeeStats(),
+		GetCmdDistributedFeesInRange(),
+		GetCmdFeeModuleLockStatus(),
+		GetCmdParams(),
 	)
 
 	return queryCmd
@@ -43,8 +52,11 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewRegisterPayeeCmd(),
+		NewRegisterDenomPayeeCmd(),
+		NewRegisterRefundOverrideCmd(),
 		NewRegisterCounterpartyPayeeCmd(),
 		NewPayPacketFeeAsyncTxCmd(),
+		NewIncentivizeTxCmd(),
 	)
 
 	return txCmd
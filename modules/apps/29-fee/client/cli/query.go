@@ -15,6 +15,10 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
 
+const (
+	flagDetailed = "detailed"
+)
+
 // GetCmdIncentivizedPacket returns the unrelayed incentivized packet for a given packetID
 func GetCmdIncentivizedPacket() *cobra.Command {
 	cmd := &cobra.Command{
@@ -280,6 +284,85 @@ func GetCmdPayee() *cobra.Command {
 	return cmd
 }
 
+// GetCmdDenomPayee returns the command handler for the Query/DenomPayee rpc.
+func GetCmdDenomPayee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-payee [channel-id] [relayer] [denom]",
+		Short:   "Query the relayer payee address for a fee denomination on a given channel",
+		Long:    "Query the relayer payee address for a fee denomination on a given channel",
+		Args:    cobra.ExactArgs(3),
+		Example: fmt.Sprintf("%s query ibc-fee denom-payee channel-5 cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs stake", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[1]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomPayeeRequest{
+				ChannelId: args[0],
+				Relayer:   args[1],
+				Denom:     args[2],
+			}
+
+			res, err := queryClient.DenomPayee(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRefundAddressOverride returns the command handler for the Query/RefundAddressOverride rpc.
+func GetCmdRefundAddressOverride() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "refund-override [channel-id] [refund-address]",
+		Short:   "Query the refund address override of a refund address on a given channel",
+		Long:    "Query the address to which the fees escrowed with a refund address on a given channel are refunded",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee refund-override channel-5 cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[1]); err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRefundAddressOverrideRequest{
+				ChannelId:     args[0],
+				RefundAddress: args[1],
+			}
+
+			res, err := queryClient.RefundAddressOverride(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdCounterpartyPayee returns the command handler for the Query/CounterpartyPayee rpc.
 func GetCmdCounterpartyPayee() *cobra.Command {
 	cmd := &cobra.Command{
@@ -319,6 +402,51 @@ func GetCmdCounterpartyPayee() *cobra.Command {
 	return cmd
 }
 
+// GetCmdCounterpartyPayeesForRelayer returns the command handler for the Query/CounterpartyPayeesForRelayer rpc.
+func GetCmdCounterpartyPayeesForRelayer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "counterparty-payees [relayer]",
+		Short:   "Query the counterparty payees registered by a relayer on each channel",
+		Long:    "Query the counterparty payees registered by a relayer on each channel",
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf("%s query ibc-fee counterparty-payees cosmos1layxcsmyye0dc0har9sdfzwckaz8sjwlfsj8zs", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := sdk.AccAddressFromBech32(args[0]); err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCounterpartyPayeesForRelayerRequest{
+				Relayer:    args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.CounterpartyPayeesForRelayer(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "counterparty payees")
+
+	return cmd
+}
+
 // GetCmdFeeEnabledChannels returns the command handler for the Query/FeeEnabledChannels rpc.
 func GetCmdFeeEnabledChannels() *cobra.Command {
 	cmd := &cobra.Command{
@@ -326,7 +454,7 @@ func GetCmdFeeEnabledChannels() *cobra.Command {
 		Short:   "Query the ibc-fee enabled channels",
 		Long:    "Query the ibc-fee enabled channels",
 		Args:    cobra.NoArgs,
-		Example: fmt.Sprintf("%s query ibc-fee channels", version.AppName),
+		Example: fmt.Sprintf("%s query ibc-fee channels --detailed", version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -345,6 +473,20 @@ func GetCmdFeeEnabledChannels() *cobra.Command {
 
 			queryClient := types.NewQueryClient(clientCtx)
 
+			detailed, err := cmd.Flags().GetBool(flagDetailed)
+			if err != nil {
+				return err
+			}
+
+			if detailed {
+				res, err := queryClient.FeeEnabledChannelsDetailed(cmd.Context(), req)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
 			res, err := queryClient.FeeEnabledChannels(cmd.Context(), req)
 			if err != nil {
 				return err
@@ -354,6 +496,7 @@ func GetCmdFeeEnabledChannels() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(flagDetailed, false, "Include the counterparty, state and connection hops of each channel")
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "channels")
 
@@ -437,3 +580,215 @@ func GetCmdIncentivizedPacketsForChannel() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdAcceptedFeeDenoms returns the command handler for the Query/AcceptedFeeDenoms rpc.
+func GetCmdAcceptedFeeDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accepted-fee-denoms",
+		Short:   "Query the denominations accepted as packet fees",
+		Long:    "Query the denominations accepted as packet fees. An empty list indicates fees may be paid in any denomination",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee accepted-fee-denoms", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AcceptedFeeDenoms(cmd.Context(), &types.QueryAcceptedFeeDenomsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAllowedRelayers returns the command handler for the Query/AllowedRelayers rpc.
+func GetCmdAllowedRelayers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowed-relayers [port-id] [channel-id]",
+		Short:   "Query the relayers allowed to be paid fees on a channel",
+		Long:    "Query the relayers allowed to be paid fees on a channel. An empty list indicates any relayer may be paid fees",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee allowed-relayers transfer channel-6", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAllowedRelayersRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllowedRelayers(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdChannelFeeStats returns the command handler for the Query/ChannelFeeStats rpc.
+func GetCmdChannelFeeStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-stats [port-id] [channel-id]",
+		Short:   "Query the aggregate fee statistics of a channel",
+		Long:    "Query the total fees escrowed, distributed and refunded on a channel along with the number of incentivized packets",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee channel-stats transfer channel-6", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelFeeStatsRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ChannelFeeStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDistributedFeesInRange returns the command handler for the Query/DistributedFeesInRange rpc.
+func GetCmdDistributedFeesInRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "distributed-fees [start-height] [end-height]",
+		Short:   "Query the total fees distributed to each payee in a block height range",
+		Long:    "Query the total fees distributed to each payee in the inclusive block height range. Only block heights within the distributed fee retention period are accounted for",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-fee distributed-fees 1000 2000", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			endHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDistributedFeesInRangeRequest{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				Pagination:  pageReq,
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DistributedFeesInRange(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "distributed fees")
+
+	return cmd
+}
+
+// GetCmdFeeModuleLockStatus returns the command handler for the Query/FeeModuleLockStatus rpc.
+func GetCmdFeeModuleLockStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lock-status",
+		Short:   "Query whether the fee module is locked",
+		Long:    "Query whether the fee module is locked along with the packet and fee shortfall which caused it to be locked",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee lock-status", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeModuleLockStatus(cmd.Context(), &types.QueryFeeModuleLockStatusRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdParams returns the command handler for the fee module parameters query.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current ibc-fee parameters",
+		Long:    "Query the current ibc-fee parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-fee params", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,26 +1,37 @@
 package cli
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
 
 const (
-	flagRecvFee    = "recv-fee"
-	flagAckFee     = "ack-fee"
-	flagTimeoutFee = "timeout-fee"
+	flagRecvFee       = "recv-fee"
+	flagAckFee        = "ack-fee"
+	flagTimeoutFee    = "timeout-fee"
+	flagPacketIndex   = "packet-index"
+	flagBypassPayee   = "bypass-payee"
+	flagOnlyOnSuccess = "only-on-success"
 )
 
 // NewRegisterPayeeCmd returns the command to create a MsgRegisterPayee
@@ -48,6 +59,56 @@ func NewRegisterPayeeCmd() *cobra.Command {
 	return cmd
 }
 
+// NewRegisterDenomPayeeCmd returns the command to create a MsgRegisterDenomPayee
+func NewRegisterDenomPayeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-denom-payee [port-id] [channel-id] [relayer] [payee] [denom]",
+		Short:   "Register a payee for a fee denomination on a given channel.",
+		Long:    strings.TrimSpace(`Register a payee address on a given channel to which the fees of the given denomination are paid out.`),
+		Example: fmt.Sprintf("%s tx ibc-fee register-denom-payee transfer channel-0 cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh cosmos153lf4zntqt33a4v0sm5cytrxyqn78q7kz8j8x5 stake", version.AppName),
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterDenomPayee(args[0], args[1], args[2], args[3], args[4])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRegisterRefundOverrideCmd returns the command to create a MsgRegisterRefundOverride
+func NewRegisterRefundOverrideCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "register-refund-override [port-id] [channel-id] [refund-address] [override-address]",
+		Short:   "Register an address to which fees escrowed with a refund address are refunded on a given channel.",
+		Long:    strings.TrimSpace(`Register an address on a given channel to which the fees escrowed with the refund address are refunded instead of the refund address. The transaction must be signed by the refund address.`),
+		Example: fmt.Sprintf("%s tx ibc-fee register-refund-override transfer channel-0 cosmos1rsp837a4kvtgp2m4uqzdge0zzu6efqgucm0qdh cosmos153lf4zntqt33a4v0sm5cytrxyqn78q7kz8j8x5", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterRefundOverride(args[0], args[1], args[2], args[3])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // NewRegisterCounterpartyPayeeCmd returns the command to create a MsgRegisterCounterpartyPayee
 func NewRegisterCounterpartyPayeeCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -98,43 +159,93 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 
 			packetID := channeltypes.NewPacketID(args[0], args[1], seq)
 
-			recvFeeStr, err := cmd.Flags().GetString(flagRecvFee)
+			fee, err := parseFee(cmd)
 			if err != nil {
 				return err
 			}
 
-			recvFee, err := sdk.ParseCoinsNormalized(recvFeeStr)
+			packetFee := types.NewPacketFee(fee, sender, relayers)
+			packetFee.BypassPayee, err = cmd.Flags().GetBool(flagBypassPayee)
 			if err != nil {
 				return err
 			}
 
-			ackFeeStr, err := cmd.Flags().GetString(flagAckFee)
+			packetFee.OnlyOnSuccess, err = cmd.Flags().GetBool(flagOnlyOnSuccess)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPayPacketFeeAsync(packetID, packetFee)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagRecvFee, "", "Fee paid to a relayer for relaying a packet receive.")
+	cmd.Flags().String(flagAckFee, "", "Fee paid to a relayer for relaying a packet acknowledgement.")
+	cmd.Flags().String(flagTimeoutFee, "", "Fee paid to a relayer for relaying a packet timeout.")
+	cmd.Flags().Bool(flagBypassPayee, false, "Pay the fees to the relayers directly rather than to the payees registered by the relayers.")
+	cmd.Flags().Bool(flagOnlyOnSuccess, false, "Refund the receive fee if the packet is not successfully processed by the receiving application.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewIncentivizeTxCmd returns the command to create a MsgPayPacketFeeAsync for a packet sent by an existing transaction
+func NewIncentivizeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "incentivize-tx [tx-hash]",
+		Short: "Pay a fee to incentivize an IBC packet sent by an existing transaction",
+		Long: strings.TrimSpace(`Pay a fee to incentivize an IBC packet sent by an existing transaction.
+The packet is identified by the send_packet events of the transaction with the provided hash. If the transaction sent
+more than one packet, the packet to incentivize must be selected by its index in the transaction using the {packet-index} flag.`),
+		Example: fmt.Sprintf("%s tx ibc-fee incentivize-tx 5A2C1A6B7E4F3D0C9B8A7F6E5D4C3B2A1F0E9D8C7B6A5F4E3D2C1B0A9F8E7D6C --recv-fee 10stake --ack-fee 10stake --timeout-fee 10stake", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			ackFee, err := sdk.ParseCoinsNormalized(ackFeeStr)
+			// NOTE: specifying non-nil relayers is currently unsupported
+			var relayers []string
+
+			packetIndex := -1
+			if cmd.Flags().Changed(flagPacketIndex) {
+				index, err := cmd.Flags().GetUint(flagPacketIndex)
+				if err != nil {
+					return err
+				}
+
+				packetIndex = int(index)
+			}
+
+			fee, err := parseFee(cmd)
 			if err != nil {
 				return err
 			}
 
-			timeoutFeeStr, err := cmd.Flags().GetString(flagTimeoutFee)
+			queryClient := packetTxQueryClient{
+				ServiceClient:      txtypes.NewServiceClient(clientCtx),
+				channelQueryClient: channeltypes.NewQueryClient(clientCtx),
+			}
+
+			packetID, err := queryPacketToIncentivize(cmd.Context(), queryClient, args[0], packetIndex)
 			if err != nil {
 				return err
 			}
 
-			timeoutFee, err := sdk.ParseCoinsNormalized(timeoutFeeStr)
+			packetFee := types.NewPacketFee(fee, clientCtx.GetFromAddress().String(), relayers)
+			packetFee.BypassPayee, err = cmd.Flags().GetBool(flagBypassPayee)
 			if err != nil {
 				return err
 			}
 
-			fee := types.Fee{
-				RecvFee:    recvFee,
-				AckFee:     ackFee,
-				TimeoutFee: timeoutFee,
+			packetFee.OnlyOnSuccess, err = cmd.Flags().GetBool(flagOnlyOnSuccess)
+			if err != nil {
+				return err
 			}
 
-			packetFee := types.NewPacketFee(fee, sender, relayers)
 			msg := types.NewMsgPayPacketFeeAsync(packetID, packetFee)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -144,7 +255,160 @@ func NewPayPacketFeeAsyncTxCmd() *cobra.Command {
 	cmd.Flags().String(flagRecvFee, "", "Fee paid to a relayer for relaying a packet receive.")
 	cmd.Flags().String(flagAckFee, "", "Fee paid to a relayer for relaying a packet acknowledgement.")
 	cmd.Flags().String(flagTimeoutFee, "", "Fee paid to a relayer for relaying a packet timeout.")
+	cmd.Flags().Bool(flagBypassPayee, false, "Pay the fees to the relayers directly rather than to the payees registered by the relayers.")
+	cmd.Flags().Bool(flagOnlyOnSuccess, false, "Refund the receive fee if the packet is not successfully processed by the receiving application.")
+	cmd.Flags().Uint(flagPacketIndex, 0, "Index of the packet to incentivize among the packets sent by the transaction. Required if the transaction sent more than one packet.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseFee returns the fee provided with the recv, ack and timeout fee flags.
+func parseFee(cmd *cobra.Command) (types.Fee, error) {
+	recvFeeStr, err := cmd.Flags().GetString(flagRecvFee)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	recvFee, err := sdk.ParseCoinsNormalized(recvFeeStr)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	ackFeeStr, err := cmd.Flags().GetString(flagAckFee)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	ackFee, err := sdk.ParseCoinsNormalized(ackFeeStr)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	timeoutFeeStr, err := cmd.Flags().GetString(flagTimeoutFee)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	timeoutFee, err := sdk.ParseCoinsNormalized(timeoutFeeStr)
+	if err != nil {
+		return types.Fee{}, err
+	}
+
+	return types.Fee{
+		RecvFee:    recvFee,
+		AckFee:     ackFee,
+		TimeoutFee: timeoutFee,
+	}, nil
+}
+
+// packetTxQuerier defines the subset of the tx service and 04-channel gRPC query clients
+// used to look up the packets sent by a transaction.
+type packetTxQuerier interface {
+	GetTx(ctx context.Context, in *txtypes.GetTxRequest, opts ...grpc.CallOption) (*txtypes.GetTxResponse, error)
+	PacketCommitment(ctx context.Context, in *channeltypes.QueryPacketCommitmentRequest, opts ...grpc.CallOption) (*channeltypes.QueryPacketCommitmentResponse, error)
+}
+
+// packetTxQueryClient implements packetTxQuerier using the tx service and 04-channel gRPC query clients.
+type packetTxQueryClient struct {
+	txtypes.ServiceClient
+	channelQueryClient channeltypes.QueryClient
+}
+
+// PacketCommitment implements packetTxQuerier.
+func (q packetTxQueryClient) PacketCommitment(ctx context.Context, in *channeltypes.QueryPacketCommitmentRequest, opts ...grpc.CallOption) (*channeltypes.QueryPacketCommitmentResponse, error) {
+	return q.channelQueryClient.PacketCommitment(ctx, in, opts...)
+}
+
+// queryPacketToIncentivize queries the transaction with the provided hash and returns the identifier of the
+// packet at packetIndex among the packets sent by the transaction. A negative packetIndex selects the only
+// packet sent by the transaction. An error is returned if the packet commitment no longer exists, as fees
+// can only be paid for packets which have not yet been acknowledged or timed out.
+func queryPacketToIncentivize(ctx context.Context, queryClient packetTxQuerier, txHash string, packetIndex int) (channeltypes.PacketId, error) {
+	res, err := queryClient.GetTx(ctx, &txtypes.GetTxRequest{Hash: txHash})
+	if err != nil {
+		return channeltypes.PacketId{}, fmt.Errorf("failed to query transaction %s: %w", txHash, err)
+	}
+
+	if res.TxResponse == nil {
+		return channeltypes.PacketId{}, fmt.Errorf("transaction %s not found", txHash)
+	}
+
+	packetIDs, err := parseSendPacketEvents(res.TxResponse.Events)
+	if err != nil {
+		return channeltypes.PacketId{}, err
+	}
+
+	var packetID channeltypes.PacketId
+	switch {
+	case len(packetIDs) == 0:
+		return channeltypes.PacketId{}, fmt.Errorf("transaction %s did not send any packets", txHash)
+	case packetIndex < 0 && len(packetIDs) > 1:
+		packets := make([]string, len(packetIDs))
+		for i, id := range packetIDs {
+			packets[i] = fmt.Sprintf("%d: %s", i, formatPacketID(id))
+		}
+
+		return channeltypes.PacketId{}, fmt.Errorf("transaction %s sent %d packets, select the packet to incentivize using the --%s flag: %s", txHash, len(packetIDs), flagPacketIndex, strings.Join(packets, ", "))
+	case packetIndex < 0:
+		packetID = packetIDs[0]
+	case packetIndex >= len(packetIDs):
+		return channeltypes.PacketId{}, fmt.Errorf("packet index %d out of range: transaction %s sent %d packets", packetIndex, txHash, len(packetIDs))
+	default:
+		packetID = packetIDs[packetIndex]
+	}
+
+	_, err = queryClient.PacketCommitment(ctx, &channeltypes.QueryPacketCommitmentRequest{
+		PortId:    packetID.PortId,
+		ChannelId: packetID.ChannelId,
+		Sequence:  packetID.Sequence,
+	})
+	if status.Code(err) == codes.NotFound {
+		return channeltypes.PacketId{}, fmt.Errorf("packet %s can no longer be incentivized: the packet commitment was not found, the packet has likely already been acknowledged or timed out", formatPacketID(packetID))
+	}
+	if err != nil {
+		return channeltypes.PacketId{}, err
+	}
+
+	return packetID, nil
+}
+
+// parseSendPacketEvents returns the identifiers of the packets sent in the order of the provided send_packet events.
+func parseSendPacketEvents(events []abci.Event) ([]channeltypes.PacketId, error) {
+	var packetIDs []channeltypes.PacketId
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+
+		var portID, channelID, sequence string
+		for _, attr := range event.Attributes {
+			switch attr.Key {
+			case channeltypes.AttributeKeySrcPort:
+				portID = attr.Value
+			case channeltypes.AttributeKeySrcChannel:
+				channelID = attr.Value
+			case channeltypes.AttributeKeySequence:
+				sequence = attr.Value
+			}
+		}
+
+		if portID == "" || channelID == "" || sequence == "" {
+			return nil, errors.New("send_packet event is missing the packet source port, source channel or sequence")
+		}
+
+		seq, err := strconv.ParseUint(sequence, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid packet sequence %s in send_packet event: %w", sequence, err)
+		}
+
+		packetIDs = append(packetIDs, channeltypes.NewPacketID(portID, channelID, seq))
+	}
+
+	return packetIDs, nil
+}
+
+// formatPacketID returns a human readable representation of the provided packet identifier.
+func formatPacketID(packetID channeltypes.PacketId) string {
+	return fmt.Sprintf("%s/%s/%d", packetID.PortId, packetID.ChannelId, packetID.Sequence)
+}
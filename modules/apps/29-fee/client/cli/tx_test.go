@@ -0,0 +1,187 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+const txHash = "5A2C1A6B7E4F3D0C9B8A7F6E5D4C3B2A1F0E9D8C7B6A5F4E3D2C1B0A9F8E7D6C"
+
+var _ packetTxQuerier = (*mockPacketTxQuerier)(nil)
+
+// mockPacketTxQuerier returns the configured events for the transaction with hash txHash
+// and a packet commitment for every packet in commitments.
+type mockPacketTxQuerier struct {
+	events      []abci.Event
+	commitments map[channeltypes.PacketId]bool
+	err         error
+}
+
+func (m mockPacketTxQuerier) GetTx(_ context.Context, in *txtypes.GetTxRequest, _ ...grpc.CallOption) (*txtypes.GetTxResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	if in.Hash != txHash {
+		return nil, status.Error(codes.NotFound, "tx not found")
+	}
+
+	return &txtypes.GetTxResponse{TxResponse: &sdk.TxResponse{TxHash: txHash, Events: m.events}}, nil
+}
+
+func (m mockPacketTxQuerier) PacketCommitment(_ context.Context, in *channeltypes.QueryPacketCommitmentRequest, _ ...grpc.CallOption) (*channeltypes.QueryPacketCommitmentResponse, error) {
+	if !m.commitments[channeltypes.NewPacketID(in.PortId, in.ChannelId, in.Sequence)] {
+		return nil, status.Error(codes.NotFound, "packet commitment hash not found")
+	}
+
+	return &channeltypes.QueryPacketCommitmentResponse{Commitment: []byte("commitment")}, nil
+}
+
+func newSendPacketEvent(portID, channelID, sequence string) abci.Event {
+	return abci.Event{
+		Type: channeltypes.EventTypeSendPacket,
+		Attributes: []abci.EventAttribute{
+			{Key: channeltypes.AttributeKeySequence, Value: sequence},
+			{Key: channeltypes.AttributeKeySrcPort, Value: portID},
+			{Key: channeltypes.AttributeKeySrcChannel, Value: channelID},
+			{Key: channeltypes.AttributeKeyDstPort, Value: "transfer"},
+			{Key: channeltypes.AttributeKeyDstChannel, Value: "channel-7"},
+		},
+	}
+}
+
+func TestQueryPacketToIncentivize(t *testing.T) {
+	firstPacket := channeltypes.NewPacketID("transfer", "channel-0", 1)
+	secondPacket := channeltypes.NewPacketID("transfer", "channel-1", 5)
+
+	transferEvent := abci.Event{Type: "ibc_transfer", Attributes: []abci.EventAttribute{{Key: "sender", Value: "sender"}}}
+	singlePacketEvents := []abci.Event{transferEvent, newSendPacketEvent("transfer", "channel-0", "1")}
+	multiPacketEvents := []abci.Event{newSendPacketEvent("transfer", "channel-0", "1"), transferEvent, newSendPacketEvent("transfer", "channel-1", "5")}
+	allCommitments := map[channeltypes.PacketId]bool{firstPacket: true, secondPacket: true}
+
+	testCases := []struct {
+		name        string
+		querier     mockPacketTxQuerier
+		txHash      string
+		packetIndex int
+		expPacketID channeltypes.PacketId
+		expErr      string
+	}{
+		{
+			"success: single packet",
+			mockPacketTxQuerier{events: singlePacketEvents, commitments: allCommitments},
+			txHash,
+			-1,
+			firstPacket,
+			"",
+		},
+		{
+			"success: single packet selected by index",
+			mockPacketTxQuerier{events: singlePacketEvents, commitments: allCommitments},
+			txHash,
+			0,
+			firstPacket,
+			"",
+		},
+		{
+			"success: multiple packets selected by index",
+			mockPacketTxQuerier{events: multiPacketEvents, commitments: allCommitments},
+			txHash,
+			1,
+			secondPacket,
+			"",
+		},
+		{
+			"failure: multiple packets without index",
+			mockPacketTxQuerier{events: multiPacketEvents, commitments: allCommitments},
+			txHash,
+			-1,
+			channeltypes.PacketId{},
+			"sent 2 packets, select the packet to incentivize using the --packet-index flag: 0: transfer/channel-0/1, 1: transfer/channel-1/5",
+		},
+		{
+			"failure: packet index out of range",
+			mockPacketTxQuerier{events: multiPacketEvents, commitments: allCommitments},
+			txHash,
+			2,
+			channeltypes.PacketId{},
+			"packet index 2 out of range",
+		},
+		{
+			"failure: transaction did not send any packets",
+			mockPacketTxQuerier{events: []abci.Event{transferEvent}, commitments: allCommitments},
+			txHash,
+			-1,
+			channeltypes.PacketId{},
+			"did not send any packets",
+		},
+		{
+			"failure: packet commitment no longer exists",
+			mockPacketTxQuerier{events: multiPacketEvents, commitments: map[channeltypes.PacketId]bool{firstPacket: true}},
+			txHash,
+			1,
+			channeltypes.PacketId{},
+			"packet transfer/channel-1/5 can no longer be incentivized",
+		},
+		{
+			"failure: invalid sequence in send_packet event",
+			mockPacketTxQuerier{events: []abci.Event{newSendPacketEvent("transfer", "channel-0", "one")}, commitments: allCommitments},
+			txHash,
+			-1,
+			channeltypes.PacketId{},
+			"invalid packet sequence",
+		},
+		{
+			"failure: send_packet event without source channel",
+			mockPacketTxQuerier{events: []abci.Event{newSendPacketEvent("transfer", "", "1")}, commitments: allCommitments},
+			txHash,
+			-1,
+			channeltypes.PacketId{},
+			"send_packet event is missing",
+		},
+		{
+			"failure: transaction not found",
+			mockPacketTxQuerier{events: singlePacketEvents, commitments: allCommitments},
+			"ABCD",
+			-1,
+			channeltypes.PacketId{},
+			"failed to query transaction ABCD",
+		},
+		{
+			"failure: tx query fails",
+			mockPacketTxQuerier{err: errors.New("connection refused")},
+			txHash,
+			-1,
+			channeltypes.PacketId{},
+			"connection refused",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			packetID, err := queryPacketToIncentivize(context.Background(), tc.querier, tc.txHash, tc.packetIndex)
+
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, tc.expPacketID, packetID)
+			} else {
+				require.ErrorContains(t, err, tc.expErr)
+			}
+		})
+	}
+}
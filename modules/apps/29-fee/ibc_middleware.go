@@ -48,7 +48,10 @@ func (im IBCMiddleware) OnChanOpenInit(
 	counterparty channeltypes.Counterparty,
 	version string,
 ) (string, error) {
-	var versionMetadata types.Metadata
+	var (
+		versionMetadata   types.Metadata
+		counterpartyPayee *types.MetadataWithCounterpartyPayee
+	)
 
 	if strings.TrimSpace(version) == "" {
 		// default version
@@ -56,22 +59,37 @@ func (im IBCMiddleware) OnChanOpenInit(
 			FeeVersion: types.Version,
 			AppVersion: "",
 		}
-	} else {
-		metadata, err := types.MetadataFromVersion(version)
-		if err != nil {
-			// Since it is valid for fee version to not be specified, the above middleware version may be for a middleware
-			// lower down in the stack. Thus, if it is not a fee version we pass the entire version string onto the underlying
-			// application.
-			return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID,
-				chanCap, counterparty, version)
-		}
+	} else if metadata, err := types.MetadataFromVersion(version); err == nil {
 		versionMetadata = metadata
+	} else if metadata, err := types.MetadataWithCounterpartyPayeeFromVersion(version); err == nil {
+		// a counterparty payee signed by the relayer may be embedded in the version to be registered for the relayer when the
+		// channel is initialised. Only the fee and app versions are used as the channel version.
+		versionMetadata = metadata.Metadata()
+		if metadata.HasCounterpartyPayee() {
+			counterpartyPayee = &metadata
+		}
+	} else {
+		// Since it is valid for fee version to not be specified, the above middleware version may be for a middleware
+		// lower down in the stack. Thus, if it is not a fee version we pass the entire version string onto the underlying
+		// application.
+		return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID,
+			chanCap, counterparty, version)
 	}
 
 	if versionMetadata.FeeVersion != types.Version {
 		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, versionMetadata.FeeVersion)
 	}
 
+	if counterpartyPayee != nil {
+		if err := counterpartyPayee.ValidateCounterpartyPayee(); err != nil {
+			return "", err
+		}
+
+		if err := im.keeper.VerifyCounterpartyPayeeSignature(ctx, portID, channelID, *counterpartyPayee); err != nil {
+			return "", err
+		}
+	}
+
 	appVersion, err := im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, chanCap, counterparty, versionMetadata.AppVersion)
 	if err != nil {
 		return "", err
@@ -85,6 +103,10 @@ func (im IBCMiddleware) OnChanOpenInit(
 
 	im.keeper.SetFeeEnabled(ctx, portID, channelID)
 
+	if counterpartyPayee != nil {
+		im.keeper.RegisterCounterpartyPayeeAddress(ctx, counterpartyPayee.Relayer, counterpartyPayee.CounterpartyPayee, channelID)
+	}
+
 	// call underlying app's OnChanOpenInit callback with the appVersion
 	return string(versionBytes), nil
 }
@@ -104,6 +126,12 @@ func (im IBCMiddleware) OnChanOpenTry(
 ) (string, error) {
 	versionMetadata, err := types.MetadataFromVersion(counterpartyVersion)
 	if err != nil {
+		// a counterparty payee is only registered on the chain initialising the channel and is never part of the
+		// counterparty version, thus a counterparty version with an embedded counterparty payee is rejected.
+		if metadata, err := types.MetadataWithCounterpartyPayeeFromVersion(counterpartyVersion); err == nil && metadata.HasCounterpartyPayee() {
+			return "", errorsmod.Wrap(types.ErrInvalidVersion, "counterparty payee must not be embedded in the counterparty version")
+		}
+
 		// Since it is valid for fee version to not be specified, the above middleware version may be for a middleware
 		// lower down in the stack. Thus, if it is not a fee version we pass the entire version string onto the underlying
 		// application.
@@ -273,17 +301,14 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return im.app.OnAcknowledgementPacket(ctx, packet, ack.AppAcknowledgement, relayer)
 	}
 
-	payee, found := im.keeper.GetPayeeAddress(ctx, relayer.String(), packet.SourceChannel)
-	if !found {
-		payee = relayer.String()
-	}
-
-	payeeAddr, err := sdk.AccAddressFromBech32(payee)
-	if err != nil {
-		return errorsmod.Wrapf(err, "failed to create sdk.Address from payee: %s", payee)
+	// the payees registered by the relayer are resolved during fee distribution
+	if payee, found := im.keeper.GetPayeeAddress(ctx, relayer.String(), packet.SourceChannel); found {
+		if _, err := sdk.AccAddressFromBech32(payee); err != nil {
+			return errorsmod.Wrapf(err, "failed to create sdk.Address from payee: %s", payee)
+		}
 	}
 
-	im.keeper.DistributePacketFeesOnAcknowledgement(ctx, ack.ForwardRelayerAddress, payeeAddr, feesInEscrow.PacketFees, packetID)
+	im.keeper.DistributePacketFeesOnAcknowledgement(ctx, ack.ForwardRelayerAddress, relayer, feesInEscrow.PacketFees, packetID, ack.UnderlyingAppSuccess)
 
 	// call underlying callback
 	return im.app.OnAcknowledgementPacket(ctx, packet, ack.AppAcknowledgement, relayer)
@@ -312,17 +337,14 @@ func (im IBCMiddleware) OnTimeoutPacket(
 		return im.app.OnTimeoutPacket(ctx, packet, relayer)
 	}
 
-	payee, found := im.keeper.GetPayeeAddress(ctx, relayer.String(), packet.SourceChannel)
-	if !found {
-		payee = relayer.String()
-	}
-
-	payeeAddr, err := sdk.AccAddressFromBech32(payee)
-	if err != nil {
-		return errorsmod.Wrapf(err, "failed to create sdk.Address from payee: %s", payee)
+	// the payees registered by the relayer are resolved during fee distribution
+	if payee, found := im.keeper.GetPayeeAddress(ctx, relayer.String(), packet.SourceChannel); found {
+		if _, err := sdk.AccAddressFromBech32(payee); err != nil {
+			return errorsmod.Wrapf(err, "failed to create sdk.Address from payee: %s", payee)
+		}
 	}
 
-	im.keeper.DistributePacketFeesOnTimeout(ctx, payeeAddr, feesInEscrow.PacketFees, packetID)
+	im.keeper.DistributePacketFeesOnTimeout(ctx, relayer, feesInEscrow.PacketFees, packetID)
 
 	// call underlying callback
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
@@ -472,6 +494,8 @@ func (im IBCMiddleware) GetAppVersion(ctx sdk.Context, portID, channelID string)
 // UnmarshalPacketData attempts to use the underlying app to unmarshal the packet data.
 // If the underlying app does not support the PacketDataUnmarshaler interface, an error is returned.
 // This function implements the optional PacketDataUnmarshaler interface required for ADR 008 support.
+// Only the packet data is passed down the stack, the channel version and any fee metadata it contains are
+// never seen by the underlying app.
 func (im IBCMiddleware) UnmarshalPacketData(bz []byte) (interface{}, error) {
 	unmarshaler, ok := im.app.(porttypes.PacketDataUnmarshaler)
 	if !ok {
@@ -71,6 +71,30 @@ func (suite *FeeTestSuite) TestOnChanOpenInit() {
 			true,
 			true,
 		},
+		{
+			"invalid embedded relayer address",
+			string(types.ModuleCdc.MustMarshalJSON(&types.MetadataWithCounterpartyPayee{FeeVersion: types.Version, AppVersion: ibcmock.Version, Relayer: ibctesting.InvalidID, CounterpartyPayee: ibctesting.TestAccAddress, Signature: []byte("signature")})),
+			false,
+			false,
+		},
+		{
+			"empty embedded counterparty payee",
+			string(types.ModuleCdc.MustMarshalJSON(&types.MetadataWithCounterpartyPayee{FeeVersion: types.Version, AppVersion: ibcmock.Version, Relayer: ibctesting.TestAccAddress, Signature: []byte("signature")})),
+			false,
+			false,
+		},
+		{
+			"embedded counterparty payee not signed",
+			string(types.ModuleCdc.MustMarshalJSON(&types.MetadataWithCounterpartyPayee{FeeVersion: types.Version, AppVersion: ibcmock.Version, Relayer: ibctesting.TestAccAddress, CounterpartyPayee: ibctesting.TestAccAddress})),
+			false,
+			false,
+		},
+		{
+			"embedded counterparty payee signed by relayer without account",
+			string(types.ModuleCdc.MustMarshalJSON(&types.MetadataWithCounterpartyPayee{FeeVersion: types.Version, AppVersion: ibcmock.Version, Relayer: ibctesting.TestAccAddress, CounterpartyPayee: ibctesting.TestAccAddress, Signature: []byte("signature")})),
+			false,
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -136,6 +160,10 @@ func (suite *FeeTestSuite) TestOnChanOpenInit() {
 				suite.Require().Error(err, "error not returned for version: %s", tc.version)
 				suite.Require().Equal("", version)
 			}
+
+			// a counterparty payee embedded in the fee version without a valid relayer signature is never registered
+			_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainA.GetContext(), ibctesting.TestAccAddress, suite.path.EndpointA.ChannelID)
+			suite.Require().False(found)
 		})
 	}
 }
@@ -167,6 +195,11 @@ func (suite *FeeTestSuite) TestOnChanOpenTry() {
 			string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{FeeVersion: types.Version, AppVersion: "invalid-mock-version"})),
 			false,
 		},
+		{
+			"counterparty payee embedded in counterparty version",
+			string(types.ModuleCdc.MustMarshalJSON(&types.MetadataWithCounterpartyPayee{FeeVersion: types.Version, AppVersion: ibcmock.Version, Relayer: ibctesting.TestAccAddress, CounterpartyPayee: ibctesting.TestAccAddress, Signature: []byte("signature")})),
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -227,6 +260,136 @@ func (suite *FeeTestSuite) TestOnChanOpenTry() {
 	}
 }
 
+// TestChanOpenEmbeddedCounterpartyPayee tests that a counterparty payee embedded in the fee version is registered
+// for the relayer when the channel is initialised only if signed by the relayer, while the negotiated channel version is unaffected.
+func (suite *FeeTestSuite) TestChanOpenEmbeddedCounterpartyPayee() {
+	var (
+		metadata  types.MetadataWithCounterpartyPayee
+		signBytes []byte
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expErr      error
+		expRegister bool
+	}{
+		{
+			"success: counterparty payee signed by relayer",
+			func() {},
+			nil,
+			true,
+		},
+		{
+			"success: no counterparty payee embedded in fee version",
+			func() {
+				metadata.Relayer = ""
+				metadata.CounterpartyPayee = ""
+				metadata.Signature = nil
+			},
+			nil,
+			false,
+		},
+		{
+			"failure: signature is empty",
+			func() {
+				metadata.Signature = nil
+			},
+			types.ErrInvalidPayeeSignature,
+			false,
+		},
+		{
+			"failure: counterparty payee signed by another account",
+			func() {
+				signature, err := suite.chainA.SenderAccounts[1].SenderPrivKey.Sign(signBytes)
+				suite.Require().NoError(err)
+
+				metadata.Signature = signature
+			},
+			types.ErrInvalidPayeeSignature,
+			false,
+		},
+		{
+			"failure: signature over a different counterparty payee",
+			func() {
+				metadata.CounterpartyPayee = ibctesting.TestAccAddress
+			},
+			types.ErrInvalidPayeeSignature,
+			false,
+		},
+		{
+			"failure: signature for a different chain",
+			func() {
+				bz := types.CounterpartyPayeeSignBytes(suite.chainB.ChainID, suite.path.EndpointA.ChannelConfig.PortID, ibctesting.FirstChannelID, metadata.Relayer, metadata.CounterpartyPayee)
+				signature, err := suite.chainA.SenderPrivKey.Sign(bz)
+				suite.Require().NoError(err)
+
+				metadata.Signature = signature
+			},
+			types.ErrInvalidPayeeSignature,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.SetupConnections()
+
+			relayer := suite.chainA.SenderAccount.GetAddress().String()
+			counterpartyPayee := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String()
+
+			signBytes = types.CounterpartyPayeeSignBytes(suite.chainA.ChainID, suite.path.EndpointA.ChannelConfig.PortID, ibctesting.FirstChannelID, relayer, counterpartyPayee)
+			signature, err := suite.chainA.SenderPrivKey.Sign(signBytes)
+			suite.Require().NoError(err)
+
+			metadata = types.MetadataWithCounterpartyPayee{
+				FeeVersion:        types.Version,
+				AppVersion:        ibcmock.Version,
+				Relayer:           relayer,
+				CounterpartyPayee: counterpartyPayee,
+				Signature:         signature,
+			}
+
+			tc.malleate()
+
+			suite.path.EndpointA.ChannelConfig.Version = string(types.ModuleCdc.MustMarshalJSON(&metadata))
+			err = suite.path.EndpointA.ChanOpenInit()
+
+			if tc.expErr != nil {
+				suite.Require().ErrorContains(err, tc.expErr.Error())
+
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainA.GetContext(), relayer, ibctesting.FirstChannelID)
+				suite.Require().False(found)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NoError(suite.path.EndpointB.ChanOpenTry())
+			suite.Require().NoError(suite.path.EndpointA.ChanOpenAck())
+			suite.Require().NoError(suite.path.EndpointB.ChanOpenConfirm())
+
+			expVersion := string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{FeeVersion: types.Version, AppVersion: ibcmock.Version}))
+			suite.Require().Equal(expVersion, suite.path.EndpointA.GetChannel().Version)
+			suite.Require().Equal(expVersion, suite.path.EndpointB.GetChannel().Version)
+
+			suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID))
+			suite.Require().True(suite.chainB.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID))
+
+			registeredPayee, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainA.GetContext(), relayer, suite.path.EndpointA.ChannelID)
+			suite.Require().Equal(tc.expRegister, found)
+			if tc.expRegister {
+				suite.Require().Equal(counterpartyPayee, registeredPayee)
+			}
+
+			_, found = suite.chainB.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainB.GetContext(), relayer, suite.path.EndpointB.ChannelID)
+			suite.Require().False(found)
+		})
+	}
+}
+
 // Tests OnChanOpenAck on ChainA
 func (suite *FeeTestSuite) TestOnChanOpenAck() {
 	testCases := []struct {
@@ -664,6 +827,55 @@ func (suite *FeeTestSuite) TestOnAcknowledgementPacket() {
 				suite.Require().Equal(expRefundAccBalance, sdk.NewCoins(refundAccBalance))
 			},
 		},
+		{
+			"success: recv fee refunded on failed underlying app acknowledgement when paid only on success",
+			func() {
+				packetFee.OnlyOnSuccess = true
+
+				ack = types.NewIncentivizedAcknowledgement(relayerAddr.String(), ibcmock.MockFailAcknowledgement.Acknowledgement(), false).Acknowledgement()
+
+				// retrieve the relayer acc balance and add the expected ack fees
+				relayerAccBalance := sdk.NewCoins(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayerAddr, sdk.DefaultBondDenom))
+				expPayeeAccBalance = relayerAccBalance.Add(packetFee.Fee.AckFee...)
+			},
+			true,
+			func() {
+				// assert that the packet fees have been distributed
+				found := suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().False(found)
+
+				relayerAccBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayerAddr, sdk.DefaultBondDenom)
+				suite.Require().Equal(expPayeeAccBalance, sdk.NewCoins(relayerAccBalance))
+
+				// expect the recv fee to be refunded
+				expRefundAccBalance = initialRefundAccBal.Add(packetFee.Fee.RecvFee...)
+				refundAccBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddr, sdk.DefaultBondDenom)
+				suite.Require().Equal(expRefundAccBalance, sdk.NewCoins(refundAccBalance))
+			},
+		},
+		{
+			"success: recv fee paid on failed underlying app acknowledgement when not paid only on success",
+			func() {
+				ack = types.NewIncentivizedAcknowledgement(relayerAddr.String(), ibcmock.MockFailAcknowledgement.Acknowledgement(), false).Acknowledgement()
+
+				// retrieve the relayer acc balance and add the expected recv and ack fees
+				relayerAccBalance := sdk.NewCoins(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayerAddr, sdk.DefaultBondDenom))
+				expPayeeAccBalance = relayerAccBalance.Add(packetFee.Fee.RecvFee...).Add(packetFee.Fee.AckFee...)
+			},
+			true,
+			func() {
+				// assert that the packet fees have been distributed
+				found := suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().False(found)
+
+				relayerAccBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), relayerAddr, sdk.DefaultBondDenom)
+				suite.Require().Equal(expPayeeAccBalance, sdk.NewCoins(relayerAccBalance))
+
+				// expect zero refunds
+				refundAccBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAddr, sdk.DefaultBondDenom)
+				suite.Require().Equal(initialRefundAccBal, sdk.NewCoins(refundAccBalance))
+			},
+		},
 		{
 			"success: with registered payee address",
 			func() {
@@ -760,13 +972,16 @@ func (suite *FeeTestSuite) TestOnAcknowledgementPacket() {
 			},
 		},
 		{
-			"fail: fee distribution fails and fee module is locked when escrow account does not have sufficient funds",
+			"success: fee is kept in escrow and fee module is not locked when escrow account does not have sufficient funds",
 			func() {
 				escrowAmount = sdk.NewCoins()
 			},
 			true,
 			func() {
-				suite.Require().Equal(true, suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+				suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+
+				found := suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().True(found)
 			},
 		},
 		{
@@ -982,13 +1197,16 @@ func (suite *FeeTestSuite) TestOnTimeoutPacket() {
 			func() {},
 		},
 		{
-			"fee distribution fails and fee module is locked when escrow account does not have sufficient funds",
+			"success: fee is kept in escrow and fee module is not locked when escrow account does not have sufficient funds",
 			func() {
 				escrowAmount = sdk.NewCoins()
 			},
 			true,
 			func() {
-				suite.Require().Equal(true, suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+				suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+
+				found := suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().True(found)
 			},
 		},
 		{
@@ -2,14 +2,22 @@ package keeper
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
 // escrowPacketFee sends the packet fee to the 29-fee module account to hold in escrow
@@ -25,28 +33,62 @@ func (k Keeper) escrowPacketFee(ctx sdk.Context, packetID channeltypes.PacketId,
 		return errorsmod.Wrapf(types.ErrRefundAccNotFound, "account with address: %s not found", packetFee.RefundAddress)
 	}
 
-	coins := packetFee.Fee.Total()
-	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, coins); err != nil {
-		return err
-	}
-
 	// multiple fees may be escrowed for a single packet, firstly create a slice containing the new fee
 	// retrieve any previous fees stored in escrow for the packet and append them to the list
 	fees := []types.PacketFee{packetFee}
-	if feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID); found {
+	feesInEscrow, found := k.GetFeesInEscrow(ctx, packetID)
+	if found {
 		fees = append(fees, feesInEscrow.PacketFees...)
 	}
 
+	if err := k.GetParams(ctx).ValidatePacketFeesCount(len(fees)); err != nil {
+		return err
+	}
+
+	coins := packetFee.Fee.Total()
+	if err := k.checkSpendableFees(ctx, refundAcc, coins); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, refundAddr, types.ModuleName, coins); err != nil {
+		return err
+	}
+
 	packetFees := types.NewPacketFees(fees)
 	k.SetFeesInEscrow(ctx, packetID, packetFees)
+	k.recordFeeEscrowed(ctx, packetID, coins, !found)
 
 	emitIncentivizedPacketEvent(ctx, packetID, packetFees)
 
 	return nil
 }
 
+// checkSpendableFees returns an error if the spendable balance of the account cannot cover the fees to be escrowed.
+// The coins of a vesting account which have not vested yet are not spendable and are included in the error.
+func (k Keeper) checkSpendableFees(ctx sdk.Context, acc sdk.AccountI, fees sdk.Coins) error {
+	spendable := k.bankKeeper.SpendableCoins(ctx, acc.GetAddress())
+	if spendable.IsAllGTE(fees) {
+		return nil
+	}
+
+	if vestingAcc, ok := acc.(vestingexported.VestingAccount); ok {
+		return errorsmod.Wrapf(
+			types.ErrInsufficientSpendableFees, "spendable balance %s of vesting account %s cannot cover fees %s, locked coins: %s",
+			spendable, acc.GetAddress(), fees, vestingAcc.LockedCoins(ctx.BlockTime()),
+		)
+	}
+
+	return errorsmod.Wrapf(types.ErrInsufficientSpendableFees, "spendable balance %s of account %s cannot cover fees %s", spendable, acc.GetAddress(), fees)
+}
+
 // DistributePacketFeesOnAcknowledgement pays all the acknowledgement & receive fees for a given packetID while refunding the timeout fees to the refund account.
-func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRelayer string, reverseRelayer sdk.AccAddress, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
+// Each PacketFee is distributed independently: fees which cannot be covered by the escrow account balance are kept in escrow
+// while the remaining fees are distributed. The fee module is only locked if the distribution of a fee covered by the escrow
+// account fails due to insufficient funds. The acknowledgement fees are paid out to the payees registered by the reverse relayer.
+// Fees are refunded to the refund address override registered for the refund address on the packet channel, if any.
+// The underlyingAppSuccess flag indicates whether the acknowledgement of the underlying application indicates success, the
+// receive fees of packet fees which are only paid on success are refunded if it does not.
+func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRelayer string, reverseRelayer sdk.AccAddress, packetFees []types.PacketFee, packetID channeltypes.PacketId, underlyingAppSuccess bool) {
 	// cache context before trying to distribute fees
 	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
 	cacheCtx, writeFn := ctx.CacheContext()
@@ -54,110 +96,325 @@ func (k Keeper) DistributePacketFeesOnAcknowledgement(ctx sdk.Context, forwardRe
 	// forward relayer address will be empty if conversion fails
 	forwardAddr, _ := sdk.AccAddressFromBech32(forwardRelayer)
 
+	var (
+		undistributedFees []types.PacketFee
+		distributions     []feeDistribution
+	)
 	for _, packetFee := range packetFees {
 		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
-			// if the escrow account does not have sufficient funds then there must exist a severe bug
-			// the fee module should be locked until manual intervention fixes the issue
-			// a locked fee module will simply skip fee logic, all channels will temporarily function as
-			// fee disabled channels
-			// NOTE: we use the uncached context to lock the fee module so that the state changes from
-			// locking the fee module are persisted
-			k.lockFeeModule(ctx)
-			return
+			// the escrow account cannot cover this fee, it remains in escrow so that other fees can still be distributed
+			undistributedFees = append(undistributedFees, packetFee)
+			continue
 		}
 
-		// check if refundAcc address works
-		refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+		// check if refundAcc address works, the refund address override registered for it is used if one exists
+		refundAddr, err := k.getRefundAddress(cacheCtx, packetID.ChannelId, packetFee)
 		if err != nil {
 			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnAcknowledgement(cacheCtx, refundAddr, forwardAddr, reverseRelayer, packetFee)
+		if err := k.distributePacketFeeOnAcknowledgement(cacheCtx, packetID, refundAddr, forwardAddr, reverseRelayer, packetFee, underlyingAppSuccess, &distributions); err != nil {
+			// if the escrow account covered the fee but the distribution failed due to insufficient funds then there must
+			// exist a severe bug, the fee module should be locked until manual intervention fixes the issue
+			// a locked fee module will simply skip fee logic, all channels will temporarily function as
+			// fee disabled channels
+			// NOTE: we use the uncached context to lock the fee module so that the state changes from
+			// locking the fee module are persisted
+			k.lockFeeModule(ctx, packetID, packetFee.Fee.Total())
+			return
+		}
 	}
 
 	// write the cache
 	writeFn()
 
-	// removes the fees from the store as fees are now paid
-	k.DeleteFeesInEscrow(ctx, packetID)
+	// removes the fees from the store as fees are now paid, keeping only the fees which could not be distributed
+	k.setUndistributedFeesInEscrow(ctx, packetID, undistributedFees)
+
+	k.afterFeesDistributed(ctx, packetID, distributions)
 }
 
 // distributePacketFeeOnAcknowledgement pays the receive fee for a given packetID while refunding the timeout fee to the refund account associated with the Fee.
 // If there was no forward relayer or the associated forward relayer address is blocked, the receive fee is refunded.
-func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, refundAddr, forwardRelayer, reverseRelayer sdk.AccAddress, packetFee types.PacketFee) {
-	// distribute fee to valid forward relayer address otherwise refund the fee
-	if !forwardRelayer.Empty() && !k.bankKeeper.BlockedAddr(forwardRelayer) {
-		// distribute fee for forward relaying
-		k.distributeFee(ctx, forwardRelayer, refundAddr, packetFee.Fee.RecvFee)
-	} else {
-		// refund onRecv fee as forward relayer is not valid address
-		k.distributeFee(ctx, refundAddr, refundAddr, packetFee.Fee.RecvFee)
+// If the packet fee is only paid on success and the underlying application acknowledgement indicates failure, the receive fee is refunded.
+// If the payee resolved for the forward or reverse relayer is not allowed to be paid fees on the channel, the associated fee is refunded.
+// The fees paid to a relayer are routed to the payees registered by the relayer, see distributeFeeToRelayer.
+// An error is returned if the escrow account has insufficient funds to distribute the fee.
+func (k Keeper) distributePacketFeeOnAcknowledgement(ctx sdk.Context, packetID channeltypes.PacketId, refundAddr, forwardRelayer, reverseRelayer sdk.AccAddress, packetFee types.PacketFee, underlyingAppSuccess bool, distributions *[]feeDistribution) error {
+	// distribute fee to valid and allowed forward relayer address otherwise refund the fee
+	// the fee is also refunded if it is only paid on success and the underlying application failed to process the packet
+	recvFeePayable := underlyingAppSuccess || !packetFee.OnlyOnSuccess
+	if recvFeePayable && !forwardRelayer.Empty() && !k.bankKeeper.BlockedAddr(forwardRelayer) {
+		// the forward relayer address is the counterparty payee of the forward relayer, only payees registered
+		// for specific fee denominations are applied to it
+		if err := k.distributeFeeToRelayer(ctx, packetID, packetFee, forwardRelayer, forwardRelayer, refundAddr, packetFee.Fee.RecvFee, types.FeeTypeRecv, distributions); err != nil {
+			return err
+		}
+	} else if err := k.distributeFee(ctx, packetID, refundAddr, refundAddr, packetFee.Fee.RecvFee, types.FeeTypeRecv, distributions); err != nil {
+		// refund onRecv fee if forward relayer is not valid address or the fee is not payable
+		return err
 	}
 
-	// distribute fee for reverse relaying
-	k.distributeFee(ctx, reverseRelayer, refundAddr, packetFee.Fee.AckFee)
+	// distribute fee to the payees of the reverse relayer otherwise refund the fee
+	if !reverseRelayer.Empty() {
+		// distribute fee for reverse relaying
+		if err := k.distributeFeeToRelayer(ctx, packetID, packetFee, reverseRelayer, k.getPayeeAddress(ctx, reverseRelayer, packetID.ChannelId), refundAddr, packetFee.Fee.AckFee, types.FeeTypeAck, distributions); err != nil {
+			return err
+		}
+	} else if err := k.distributeFee(ctx, packetID, refundAddr, refundAddr, packetFee.Fee.AckFee, types.FeeTypeAck, distributions); err != nil {
+		return err
+	}
 
 	// refund unused amount from the escrowed fee
 	refundCoins := packetFee.Fee.Total().Sub(packetFee.Fee.RecvFee...).Sub(packetFee.Fee.AckFee...)
-	k.distributeFee(ctx, refundAddr, refundAddr, refundCoins)
+	return k.distributeFee(ctx, packetID, refundAddr, refundAddr, refundCoins, types.FeeTypeRefund, distributions)
 }
 
 // DistributePacketFeesOnTimeout pays all the timeout fees for a given packetID while refunding the acknowledgement & receive fees to the refund account.
+// Each PacketFee is distributed independently, see DistributePacketFeesOnAcknowledgement for more information.
+// The timeout fees are paid out to the payees registered by the timeout relayer.
 func (k Keeper) DistributePacketFeesOnTimeout(ctx sdk.Context, timeoutRelayer sdk.AccAddress, packetFees []types.PacketFee, packetID channeltypes.PacketId) {
 	// cache context before trying to distribute fees
 	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
 	cacheCtx, writeFn := ctx.CacheContext()
 
+	var (
+		undistributedFees []types.PacketFee
+		distributions     []feeDistribution
+	)
 	for _, packetFee := range packetFees {
 		if !k.EscrowAccountHasBalance(cacheCtx, packetFee.Fee.Total()) {
-			// if the escrow account does not have sufficient funds then there must exist a severe bug
-			// the fee module should be locked until manual intervention fixes the issue
-			// a locked fee module will simply skip fee logic, all channels will temporarily function as
-			// fee disabled channels
-			// NOTE: we use the uncached context to lock the fee module so that the state changes from
-			// locking the fee module are persisted
-			k.lockFeeModule(ctx)
-			return
+			// the escrow account cannot cover this fee, it remains in escrow so that other fees can still be distributed
+			undistributedFees = append(undistributedFees, packetFee)
+			continue
 		}
 
-		// check if refundAcc address works
-		refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
+		// check if refundAcc address works, the refund address override registered for it is used if one exists
+		refundAddr, err := k.getRefundAddress(cacheCtx, packetID.ChannelId, packetFee)
 		if err != nil {
 			panic(fmt.Errorf("could not parse refundAcc %s to sdk.AccAddress", packetFee.RefundAddress))
 		}
 
-		k.distributePacketFeeOnTimeout(cacheCtx, refundAddr, timeoutRelayer, packetFee)
+		if err := k.distributePacketFeeOnTimeout(cacheCtx, packetID, refundAddr, timeoutRelayer, packetFee, &distributions); err != nil {
+			// if the escrow account covered the fee but the distribution failed due to insufficient funds then there must
+			// exist a severe bug, the fee module should be locked until manual intervention fixes the issue
+			// NOTE: we use the uncached context to lock the fee module so that the state changes from
+			// locking the fee module are persisted
+			k.lockFeeModule(ctx, packetID, packetFee.Fee.Total())
+			return
+		}
 	}
 
 	// write the cache
 	writeFn()
 
-	// removing the fee from the store as the fee is now paid
-	k.DeleteFeesInEscrow(ctx, packetID)
+	// removing the fee from the store as the fee is now paid, keeping only the fees which could not be distributed
+	k.setUndistributedFeesInEscrow(ctx, packetID, undistributedFees)
+
+	k.afterFeesDistributed(ctx, packetID, distributions)
 }
 
 // distributePacketFeeOnTimeout pays the timeout fee to the timeout relayer and refunds the acknowledgement & receive fee.
-func (k Keeper) distributePacketFeeOnTimeout(ctx sdk.Context, refundAddr, timeoutRelayer sdk.AccAddress, packetFee types.PacketFee) {
-	// distribute fee for timeout relaying
-	k.distributeFee(ctx, timeoutRelayer, refundAddr, packetFee.Fee.TimeoutFee)
+// If the payee resolved for the timeout relayer is not allowed to be paid fees on the channel, the timeout fee is refunded.
+// The timeout fee is routed to the payees registered by the timeout relayer, see distributeFeeToRelayer.
+// An error is returned if the escrow account has insufficient funds to distribute the fee.
+func (k Keeper) distributePacketFeeOnTimeout(ctx sdk.Context, packetID channeltypes.PacketId, refundAddr, timeoutRelayer sdk.AccAddress, packetFee types.PacketFee, distributions *[]feeDistribution) error {
+	// distribute fee for timeout relaying to the payees of the timeout relayer
+	if err := k.distributeFeeToRelayer(ctx, packetID, packetFee, timeoutRelayer, k.getPayeeAddress(ctx, timeoutRelayer, packetID.ChannelId), refundAddr, packetFee.Fee.TimeoutFee, types.FeeTypeTimeout, distributions); err != nil {
+		return err
+	}
 
 	// refund unused amount from the escrowed fee
 	refundCoins := packetFee.Fee.Total().Sub(packetFee.Fee.TimeoutFee...)
-	k.distributeFee(ctx, refundAddr, refundAddr, refundCoins)
+	return k.distributeFee(ctx, packetID, refundAddr, refundAddr, refundCoins, types.FeeTypeRefund, distributions)
+}
+
+// setUndistributedFeesInEscrow stores the provided fees in escrow for the given packetID.
+// If no fees are provided the fees in escrow are deleted. Otherwise an undistributed fees event is emitted so
+// that the leftover fees can be reconciled using RefundUndistributedFees once the escrow account is topped up.
+func (k Keeper) setUndistributedFeesInEscrow(ctx sdk.Context, packetID channeltypes.PacketId, undistributedFees []types.PacketFee) {
+	if len(undistributedFees) == 0 {
+		k.DeleteFeesInEscrow(ctx, packetID)
+		return
+	}
+
+	var total sdk.Coins
+	for _, packetFee := range undistributedFees {
+		total = total.Add(packetFee.Fee.Total()...)
+	}
+
+	k.Logger(ctx).Error("fees left in escrow after packet lifecycle completed", "packet-id", packetID.String(), "fee", total.String())
+	emitUndistributedFeesEvent(ctx, packetID, total)
+
+	k.SetFeesInEscrow(ctx, packetID, types.NewPacketFees(undistributedFees))
+}
+
+// RefundUndistributedFees refunds the fees left in escrow for a packet whose acknowledgement or timeout has
+// already been processed. Such fees are left behind when the escrow account could not cover them at the time
+// of distribution and are otherwise only refunded on channel closure. Every fee must be covered by the escrow
+// account, otherwise no fee is refunded. Fees which cannot be sent to their refund address remain in escrow.
+// An error is returned if the fee module is locked, if no fees are escrowed for the packet, if the packet
+// commitment still exists or if the escrow account has insufficient balance.
+func (k Keeper) RefundUndistributedFees(ctx sdk.Context, packetID channeltypes.PacketId) error {
+	if k.IsLocked(ctx) {
+		return types.ErrFeeModuleLocked
+	}
+
+	packetFees, found := k.GetFeesInEscrow(ctx, packetID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrFeeNotFound, "no fees escrowed for packet %s", packetID.String())
+	}
+
+	if commitment := k.channelKeeper.GetPacketCommitment(ctx, packetID.PortId, packetID.ChannelId, packetID.Sequence); len(commitment) != 0 {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "packet %s has not been acknowledged or timed out", packetID.String())
+	}
+
+	var total sdk.Coins
+	for _, packetFee := range packetFees.PacketFees {
+		total = total.Add(packetFee.Fee.Total()...)
+	}
+
+	if !k.EscrowAccountHasBalance(ctx, total) {
+		return errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "escrow account cannot cover fees %s for packet %s", total, packetID.String())
+	}
+
+	// cache context before trying to refund fees so that a failed refund does not leave partial state changes
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	var unRefundedFees []types.PacketFee
+	for _, packetFee := range packetFees.PacketFees {
+		refundAddr, err := k.getRefundAddress(cacheCtx, packetID.ChannelId, packetFee)
+		if err == nil {
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, refundAddr, packetFee.Fee.Total())
+		}
+
+		if err != nil {
+			k.Logger(ctx).Error("failed to refund undistributed fee", "packet-id", packetID.String(), "refund-address", packetFee.RefundAddress, "error", err.Error())
+			unRefundedFees = append(unRefundedFees, packetFee)
+			continue
+		}
+
+		k.recordFeeRefunded(cacheCtx, packetID, packetFee.Fee.Total())
+	}
+
+	if len(unRefundedFees) > 0 {
+		k.SetFeesInEscrow(cacheCtx, packetID, types.NewPacketFees(unRefundedFees))
+	} else {
+		k.DeleteFeesInEscrow(cacheCtx, packetID)
+	}
+
+	writeFn()
+
+	return nil
+}
+
+// getPayeeAddress returns the payee address registered by the relayer on the given channel.
+// The relayer address is returned if no valid payee address is registered.
+func (k Keeper) getPayeeAddress(ctx sdk.Context, relayer sdk.AccAddress, channelID string) sdk.AccAddress {
+	payee, found := k.GetPayeeAddress(ctx, relayer.String(), channelID)
+	if !found {
+		return relayer
+	}
+
+	payeeAddr, err := sdk.AccAddressFromBech32(payee)
+	if err != nil {
+		return relayer
+	}
+
+	return payeeAddr
+}
+
+// getRefundAddress returns the address to which the fees of the packet fee are refunded on the given channel.
+// The refund address override registered for the refund address of the packet fee is returned if one exists.
+func (k Keeper) getRefundAddress(ctx sdk.Context, channelID string, packetFee types.PacketFee) (sdk.AccAddress, error) {
+	if overrideAddr, found := k.GetRefundAddressOverride(ctx, packetFee.RefundAddress, channelID); found {
+		return sdk.AccAddressFromBech32(overrideAddr)
+	}
+
+	return sdk.AccAddressFromBech32(packetFee.RefundAddress)
+}
+
+// distributeFeeToRelayer distributes the fee paid to the given relayer. The fee is paid to the relayer directly if the
+// packet fee bypasses the payees, otherwise it is routed to the payees registered by the relayer, see distributeFeeToPayees.
+// The counterparty payee registered by the forward relayer on the counterparty chain is resolved before the forward
+// relayer address is relayed back, thus it cannot be bypassed.
+// The allowed relayers of the channel restrict the addresses which are paid the fee, and are therefore checked against
+// the resolved payees rather than the relayer: fees resolved to a payee which is not allowed are refunded.
+func (k Keeper) distributeFeeToRelayer(ctx sdk.Context, packetID channeltypes.PacketId, packetFee types.PacketFee, relayer, defaultPayee, refundAccAddress sdk.AccAddress, fee sdk.Coins, feeType types.FeeType, distributions *[]feeDistribution) error {
+	if packetFee.BypassPayee {
+		if !k.IsRelayerAllowed(ctx, packetID.PortId, packetID.ChannelId, relayer) {
+			relayer = refundAccAddress
+		}
+
+		return k.distributeFee(ctx, packetID, relayer, refundAccAddress, fee, feeType, distributions)
+	}
+
+	return k.distributeFeeToPayees(ctx, packetID, relayer, defaultPayee, refundAccAddress, fee, feeType, distributions)
+}
+
+// distributeFeeToPayees distributes the fee paid to the given relayer. Each coin of the fee is distributed to the payee
+// registered by the relayer for the coin denomination on the packet channel, falling back to the provided default payee.
+// Coins resolved to a payee which is not allowed to be paid fees on the channel are refunded.
+// The full fee is always distributed, see distributeFee for the handling of failed distributions.
+func (k Keeper) distributeFeeToPayees(ctx sdk.Context, packetID channeltypes.PacketId, relayer, defaultPayee, refundAccAddress sdk.AccAddress, fee sdk.Coins, feeType types.FeeType, distributions *[]feeDistribution) error {
+	var (
+		payees []sdk.AccAddress
+		fees   = make(map[string]sdk.Coins)
+	)
+
+	for _, coin := range fee {
+		payee := defaultPayee
+		if denomPayee, found := k.GetPayeeAddressForDenom(ctx, relayer.String(), packetID.ChannelId, coin.Denom); found {
+			if payeeAddr, err := sdk.AccAddressFromBech32(denomPayee); err == nil {
+				payee = payeeAddr
+			}
+		}
+
+		if !k.IsRelayerAllowed(ctx, packetID.PortId, packetID.ChannelId, payee) {
+			payee = refundAccAddress
+		}
+
+		// payees are kept in order of first appearance to ensure deterministic distribution
+		if _, ok := fees[payee.String()]; !ok {
+			payees = append(payees, payee)
+		}
+		fees[payee.String()] = fees[payee.String()].Add(coin)
+	}
+
+	if len(payees) == 0 {
+		return k.distributeFee(ctx, packetID, defaultPayee, refundAccAddress, fee, feeType, distributions)
+	}
+
+	for _, payee := range payees {
+		if err := k.distributeFee(ctx, packetID, payee, refundAccAddress, fees[payee.String()], feeType, distributions); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // distributeFee will attempt to distribute the escrowed fee to the receiver address.
 // If the distribution fails for any reason (such as the receiving address being blocked),
-// the state changes will be discarded.
-func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins) {
+// the state changes will be discarded. An error is only returned if the escrow account has insufficient funds
+// to distribute the fee, as this implies the presence of a severe bug.
+// A fee sent to the refund address is recorded as refunded in the channel fee statistics, otherwise it is recorded as distributed
+// and added to the distributed fee record of the receiver at the current block height.
+// A successful distribution is appended to the provided distributions, the fee hooks are called for them once the
+// distribution of the packet fees has been committed, see afterFeesDistributed.
+func (k Keeper) distributeFee(ctx sdk.Context, packetID channeltypes.PacketId, receiver, refundAccAddress sdk.AccAddress, fee sdk.Coins, feeType types.FeeType, distributions *[]feeDistribution) error {
 	// cache context before trying to distribute fees
 	cacheCtx, writeFn := ctx.CacheContext()
 
 	err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, receiver, fee)
 	if err != nil {
+		if errors.Is(err, sdkerrors.ErrInsufficientFunds) {
+			return err
+		}
+
 		if bytes.Equal(receiver, refundAccAddress) {
 			k.Logger(ctx).Error("error distributing fee", "receiver address", receiver, "fee", fee)
-			return // if sending to the refund address already failed, then return (no-op)
+			return nil // if sending to the refund address already failed, then return (no-op)
 		}
 
 		// if an error is returned from x/bank and the receiver is not the refundAccAddress
@@ -165,24 +422,81 @@ func (k Keeper) distributeFee(ctx sdk.Context, receiver, refundAccAddress sdk.Ac
 		err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, refundAccAddress, fee)
 		if err != nil {
 			k.Logger(ctx).Error("error refunding fee to the original sender", "refund address", refundAccAddress, "fee", fee)
-			return // if sending to the refund address fails, no-op
+			return nil // if sending to the refund address fails, no-op
 		}
 
 		emitDistributeFeeEvent(ctx, refundAccAddress.String(), fee)
+		k.recordFeeRefunded(cacheCtx, packetID, fee)
+		*distributions = append(*distributions, feeDistribution{payee: refundAccAddress, fee: fee, feeType: feeType})
 	} else {
 		emitDistributeFeeEvent(ctx, receiver.String(), fee)
+		if bytes.Equal(receiver, refundAccAddress) {
+			k.recordFeeRefunded(cacheCtx, packetID, fee)
+		} else {
+			k.recordFeeDistributed(cacheCtx, packetID, fee)
+			k.recordFeeDistributedToPayee(cacheCtx, receiver, fee)
+		}
+		*distributions = append(*distributions, feeDistribution{payee: receiver, fee: fee, feeType: feeType})
 	}
 
 	// write the cache
 	writeFn()
+
+	return nil
+}
+
+// feeDistribution is a fee successfully sent from the escrow account to the payee
+type feeDistribution struct {
+	payee   sdk.AccAddress
+	fee     sdk.Coins
+	feeType types.FeeType
+}
+
+// afterFeesDistributed calls the AfterFeeDistributed fee hook for each of the provided distributions. It must be called
+// with the context the distributions were written to, so that the state changes made by the hooks are persisted along
+// with the distributions and are not discarded with a cached distribution context.
+func (k Keeper) afterFeesDistributed(ctx sdk.Context, packetID channeltypes.PacketId, distributions []feeDistribution) {
+	for _, distribution := range distributions {
+		k.afterFeeDistributed(ctx, packetID, distribution.payee, distribution.fee, distribution.feeType)
+	}
+}
+
+// afterFeeDistributed calls the AfterFeeDistributed fee hook if hooks are set and the distributed fee is non-zero.
+// The hook is executed using a cached context which is written to the provided context once the hook returns. If the
+// hook panics the panic is recovered and logged and the state changes made by the hook are discarded. Out of gas panics
+// are not recovered.
+func (k Keeper) afterFeeDistributed(ctx sdk.Context, packetID channeltypes.PacketId, payee sdk.AccAddress, fee sdk.Coins, feeType types.FeeType) {
+	if k.hooks == nil || fee.IsZero() {
+		return
+	}
+
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+				panic(r)
+			}
+
+			k.Logger(ctx).Error("fee hook panicked", "packet-id", packetID.String(), "payee", payee.String(), "fee", fee.String(), "fee-type", feeType, "panic", r)
+		}
+	}()
+
+	k.hooks.AfterFeeDistributed(cacheCtx, packetID, payee, fee, feeType)
+
+	writeFn()
 }
 
 // RefundFeesOnChannelClosure will refund all fees associated with the given port and channel identifiers.
+// Fees are refunded to the refund address override registered for the refund address on the channel, if any.
 // If the escrow account runs out of balance then fee module will become locked as this implies the presence
 // of a severe bug. When the fee module is locked, no fee distributions will be performed.
+// Fees which cannot be refunded remain in escrow unless the SweepInvalidRefunds param is enabled, in which
+// case they are swept to the configured refund sink or to the community pool.
 // Please see ADR 004 for more information.
 func (k Keeper) RefundFeesOnChannelClosure(ctx sdk.Context, portID, channelID string) error {
 	identifiedPacketFees := k.GetIdentifiedPacketFeesForChannel(ctx, portID, channelID)
+	params := k.GetParams(ctx)
 
 	// cache context before trying to distribute fees
 	// if the escrow account has insufficient balance then we want to avoid partially distributing fees
@@ -199,23 +513,34 @@ func (k Keeper) RefundFeesOnChannelClosure(ctx sdk.Context, portID, channelID st
 				// fee disabled channels
 				// NOTE: we use the uncached context to lock the fee module so that the state changes from
 				// locking the fee module are persisted
-				k.lockFeeModule(ctx)
+				k.lockFeeModule(ctx, identifiedPacketFee.PacketId, packetFee.Fee.Total())
 
 				// return a nil error so state changes are committed but distribution stops
 				return nil
 			}
 
-			refundAddr, err := sdk.AccAddressFromBech32(packetFee.RefundAddress)
-			if err != nil {
-				unRefundedFees = append(unRefundedFees, packetFee)
-				continue
+			refundAddr, err := k.getRefundAddress(cacheCtx, channelID, packetFee)
+			if err == nil {
+				// refund all fees to refund address or its registered override
+				err = k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, refundAddr, packetFee.Fee.Total())
 			}
 
-			// refund all fees to refund address
-			if err = k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, refundAddr, packetFee.Fee.Total()); err != nil {
+			if err != nil {
+				if params.SweepInvalidRefunds {
+					sweepErr := k.sweepRefund(cacheCtx, params, identifiedPacketFee.PacketId, packetFee)
+					if sweepErr == nil {
+						k.recordFeeRefunded(cacheCtx, identifiedPacketFee.PacketId, packetFee.Fee.Total())
+						continue
+					}
+
+					k.Logger(ctx).Error("failed to sweep refund", "packet-id", identifiedPacketFee.PacketId.String(), "refund-address", packetFee.RefundAddress, "error", sweepErr.Error())
+				}
+
 				unRefundedFees = append(unRefundedFees, packetFee)
 				continue
 			}
+
+			k.recordFeeRefunded(cacheCtx, identifiedPacketFee.PacketId, packetFee.Fee.Total())
 		}
 
 		if len(unRefundedFees) > 0 {
@@ -232,3 +557,192 @@ func (k Keeper) RefundFeesOnChannelClosure(ctx sdk.Context, portID, channelID st
 
 	return nil
 }
+
+// refundFeesOnClientExpiry will refund all fees associated with the given port and channel identifiers once the
+// client of the channel has expired. Packets sent on a channel with an expired client can neither be relayed nor
+// timed out, which would otherwise leave their fees in escrow. The fees are refunded and removed from escrow
+// following the same rules as RefundFeesOnChannelClosure.
+// An error is returned if the client keeper is not set or if the client of the channel is not expired.
+func (k Keeper) refundFeesOnClientExpiry(ctx sdk.Context, portID, channelID string) error {
+	if k.clientKeeper == nil {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "client keeper is not configured")
+	}
+
+	clientID, _, err := k.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return err
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != ibcexported.Expired {
+		return errorsmod.Wrapf(types.ErrClientNotExpired, "client (%s) of port ID (%s) channel ID (%s) has status %s", clientID, portID, channelID, status)
+	}
+
+	return k.RefundFeesOnChannelClosure(ctx, portID, channelID)
+}
+
+// sweepRefund sends the total fee of a packet fee which could not be refunded to the refund sink configured in
+// the given params. If no refund sink is configured the fee is sent to the community pool.
+func (k Keeper) sweepRefund(ctx sdk.Context, params types.Params, packetID channeltypes.PacketId, packetFee types.PacketFee) error {
+	// cache context so that a failed sweep does not leave partial state changes behind
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	var receiver string
+	if params.RefundSink != "" {
+		sinkAddr, err := sdk.AccAddressFromBech32(params.RefundSink)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, sinkAddr, packetFee.Fee.Total()); err != nil {
+			return err
+		}
+
+		receiver = params.RefundSink
+	} else {
+		if k.distrKeeper == nil {
+			return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "refund sink is not set and distribution keeper is not configured")
+		}
+
+		if err := k.distrKeeper.FundCommunityPool(cacheCtx, packetFee.Fee.Total(), k.GetFeeModuleAddress()); err != nil {
+			return err
+		}
+
+		receiver = k.authKeeper.GetModuleAddress(distrtypes.ModuleName).String()
+	}
+
+	writeFn()
+
+	emitSweepRefundEvent(ctx, packetID, packetFee.RefundAddress, receiver, packetFee.Fee.Total())
+
+	return nil
+}
+
+// convertEscrowedFees converts the escrowed fees in fromDenom of all packets on the given channel to toDenom at the
+// given rate, truncating the converted amounts. The converted fees are sent from the escrow account to the pool module
+// account, which funds the escrow account with the fees in toDenom. The total converted and funded amounts are returned.
+func (k Keeper) convertEscrowedFees(ctx sdk.Context, portID, channelID, fromDenom, toDenom string, rate sdkmath.LegacyDec, pool string) (sdk.Coin, sdk.Coin, error) {
+	converted, funded := sdk.NewCoin(fromDenom, sdkmath.ZeroInt()), sdk.NewCoin(toDenom, sdkmath.ZeroInt())
+
+	convertCoins := func(coins sdk.Coins) (sdk.Coins, error) {
+		amount := coins.AmountOf(fromDenom)
+		if amount.IsZero() {
+			return coins, nil
+		}
+
+		convertedAmount := rate.MulInt(amount).TruncateInt()
+		if !convertedAmount.IsPositive() {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "fee of %s%s converts to zero %s at rate %s", amount, fromDenom, toDenom, rate)
+		}
+
+		return coins.Sub(sdk.NewCoin(fromDenom, amount)).Add(sdk.NewCoin(toDenom, convertedAmount)), nil
+	}
+
+	for _, identifiedPacketFees := range k.GetIdentifiedPacketFeesForChannel(ctx, portID, channelID) {
+		var (
+			packetFees       []types.PacketFee
+			originalFees     sdk.Coins
+			convertedFees    sdk.Coins
+			hasConvertedFees bool
+		)
+
+		for _, packetFee := range identifiedPacketFees.PacketFees {
+			fee := packetFee.Fee
+			if fee.Total().AmountOf(fromDenom).IsZero() {
+				packetFees = append(packetFees, packetFee)
+				continue
+			}
+
+			recvFee, err := convertCoins(fee.RecvFee)
+			if err != nil {
+				return sdk.Coin{}, sdk.Coin{}, err
+			}
+
+			ackFee, err := convertCoins(fee.AckFee)
+			if err != nil {
+				return sdk.Coin{}, sdk.Coin{}, err
+			}
+
+			timeoutFee, err := convertCoins(fee.TimeoutFee)
+			if err != nil {
+				return sdk.Coin{}, sdk.Coin{}, err
+			}
+
+			convertedFee := types.NewFee(recvFee, ackFee, timeoutFee)
+			convertedPacketFee := types.NewPacketFee(convertedFee, packetFee.RefundAddress, packetFee.Relayers)
+			convertedPacketFee.BypassPayee = packetFee.BypassPayee
+			convertedPacketFee.OnlyOnSuccess = packetFee.OnlyOnSuccess
+			packetFees = append(packetFees, convertedPacketFee)
+
+			// the escrowed amount of a fee is its total, which is exchanged in full with the pool
+			converted = converted.AddAmount(fee.Total().AmountOf(fromDenom))
+			funded = funded.AddAmount(convertedFee.Total().AmountOf(toDenom).Sub(fee.Total().AmountOf(toDenom)))
+
+			originalFees = originalFees.Add(fee.Total()...)
+			convertedFees = convertedFees.Add(convertedFee.Total()...)
+			hasConvertedFees = true
+		}
+
+		if !hasConvertedFees {
+			continue
+		}
+
+		k.SetFeesInEscrow(ctx, identifiedPacketFees.PacketId, types.NewPacketFees(packetFees))
+
+		emitConvertEscrowedFeeEvent(ctx, identifiedPacketFees.PacketId, originalFees, convertedFees)
+	}
+
+	if converted.IsZero() {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrNoFeesToConvert, "no fees escrowed in %s for port ID %s and channel ID %s", fromDenom, portID, channelID)
+	}
+
+	if !k.EscrowAccountHasBalance(ctx, sdk.NewCoins(converted)) {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "escrow account cannot cover the converted fees %s", converted)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, pool, types.ModuleName, sdk.NewCoins(funded)); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(err, "failed to fund converted fees %s from pool %s", funded, pool)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, pool, sdk.NewCoins(converted)); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	k.recordFeeConverted(ctx, portID, channelID, converted, funded)
+
+	return converted, funded, nil
+}
+
+// EstimateBacklogIncentive returns the total coins which must be escrowed in order to raise the fees of every packet
+// with fees in escrow on the given channel to the target fee, along with the top up fee required for each packet
+// whose escrowed fees fall short of the target. Each top up fee may be paid using MsgPayPacketFeeAsync.
+func (k Keeper) EstimateBacklogIncentive(ctx sdk.Context, portID, channelID string, targetFee types.Fee) (sdk.Coins, []types.PacketFeeTopUp, error) {
+	if err := targetFee.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		total  sdk.Coins
+		topUps []types.PacketFeeTopUp
+	)
+
+	for _, identifiedFees := range k.GetIdentifiedPacketFeesForChannel(ctx, portID, channelID) {
+		var escrowed types.Fee
+		for _, packetFee := range identifiedFees.PacketFees {
+			escrowed = types.NewFee(
+				escrowed.RecvFee.Add(packetFee.Fee.RecvFee...),
+				escrowed.AckFee.Add(packetFee.Fee.AckFee...),
+				escrowed.TimeoutFee.Add(packetFee.Fee.TimeoutFee...),
+			)
+		}
+
+		topUpFee := escrowed.TopUpTo(targetFee)
+		if topUpFee.Total().IsZero() {
+			continue
+		}
+
+		total = total.Add(topUpFee.Total()...)
+		topUps = append(topUps, types.PacketFeeTopUp{PacketID: identifiedFees.PacketId, Fee: topUpFee})
+	}
+
+	return total, topUps, nil
+}
@@ -5,25 +5,32 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	"github.com/cosmos/ibc-go/v8/testing/mock"
 )
 
 func (suite *KeeperTestSuite) TestDistributeFee() {
 	var (
-		forwardRelayer    string
-		forwardRelayerBal sdk.Coin
-		reverseRelayer    sdk.AccAddress
-		reverseRelayerBal sdk.Coin
-		refundAcc         sdk.AccAddress
-		refundAccBal      sdk.Coin
-		packetFee         types.PacketFee
-		packetFees        []types.PacketFee
-		fee               types.Fee
+		forwardRelayer       string
+		forwardRelayerBal    sdk.Coin
+		reverseRelayer       sdk.AccAddress
+		reverseRelayerBal    sdk.Coin
+		refundAcc            sdk.AccAddress
+		refundAccBal         sdk.Coin
+		packetFee            types.PacketFee
+		packetFees           []types.PacketFee
+		fee                  types.Fee
+		underlyingAppSuccess bool
+		payee                sdk.AccAddress
 	)
 
 	testCases := []struct {
@@ -103,6 +110,220 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(0)), balance)
 			},
 		},
+		{
+			"success: registered payees are bypassed",
+			func() {
+				payee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), reverseRelayer.String(), payee.String(), suite.path.EndpointA.ChannelID)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddressForDenom(suite.chainA.GetContext(), forwardRelayer, payee.String(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFee.BypassPayee = true
+				packetFees = []types.PacketFee{packetFee, packetFee}
+			},
+			func() {
+				// check if the reverse relayer is paid rather than its payee
+				expectedReverseAccBal := reverseRelayerBal.Add(defaultAckFee[0]).Add(defaultAckFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedReverseAccBal, balance)
+
+				// check if the forward relayer is paid rather than its denom payee
+				forward, err := sdk.AccAddressFromBech32(forwardRelayer)
+				suite.Require().NoError(err)
+
+				expectedForwardAccBal := forwardRelayerBal.Add(defaultRecvFee[0]).Add(defaultRecvFee[0])
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forward, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedForwardAccBal, balance)
+
+				// check the payee is not paid
+				payee, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), reverseRelayer.String(), suite.path.EndpointA.ChannelID)
+				suite.Require().True(found)
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sdk.MustAccAddressFromBech32(payee), sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+			},
+		},
+		{
+			"success: recv fee refunded on failed underlying app acknowledgement when paid only on success",
+			func() {
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFee.OnlyOnSuccess = true
+				packetFees = []types.PacketFee{packetFee, packetFee}
+
+				underlyingAppSuccess = false
+			},
+			func() {
+				// check if the reverse relayer is paid
+				expectedReverseAccBal := reverseRelayerBal.Add(defaultAckFee[0]).Add(defaultAckFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedReverseAccBal, balance)
+
+				// check if the forward relayer is not paid
+				forward, err := sdk.AccAddressFromBech32(forwardRelayer)
+				suite.Require().NoError(err)
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forward, sdk.DefaultBondDenom)
+				suite.Require().Equal(forwardRelayerBal, balance)
+
+				// check if the refund acc has been refunded the recvFee
+				expectedRefundAccBal := refundAccBal.Add(defaultRecvFee[0]).Add(defaultRecvFee[0])
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedRefundAccBal, balance)
+
+				// check the module acc wallet is now empty
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(0)), balance)
+			},
+		},
+		{
+			"success: recv fee paid on successful underlying app acknowledgement when paid only on success",
+			func() {
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFee.OnlyOnSuccess = true
+				packetFees = []types.PacketFee{packetFee, packetFee}
+			},
+			func() {
+				// check if the forward relayer is paid
+				forward, err := sdk.AccAddressFromBech32(forwardRelayer)
+				suite.Require().NoError(err)
+
+				expectedForwardAccBal := forwardRelayerBal.Add(defaultRecvFee[0]).Add(defaultRecvFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forward, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedForwardAccBal, balance)
+
+				// check if the refund amount is zero
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(refundAccBal, balance)
+			},
+		},
+		{
+			"success: recv fee paid on failed underlying app acknowledgement when not paid only on success",
+			func() {
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+
+				underlyingAppSuccess = false
+			},
+			func() {
+				// check if the forward relayer is paid
+				forward, err := sdk.AccAddressFromBech32(forwardRelayer)
+				suite.Require().NoError(err)
+
+				expectedForwardAccBal := forwardRelayerBal.Add(defaultRecvFee[0]).Add(defaultRecvFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forward, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedForwardAccBal, balance)
+
+				// check if the refund amount is zero
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(refundAccBal, balance)
+			},
+		},
+		{
+			"success: forward and reverse relayers are allowed",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAllowedRelayers(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, []string{forwardRelayer, reverseRelayer.String()})
+
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+			},
+			func() {
+				// check if the reverse relayer is paid
+				expectedReverseAccBal := reverseRelayerBal.Add(defaultAckFee[0]).Add(defaultAckFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedReverseAccBal, balance)
+
+				// check if the forward relayer is paid
+				forward, err := sdk.AccAddressFromBech32(forwardRelayer)
+				suite.Require().NoError(err)
+
+				expectedForwardAccBal := forwardRelayerBal.Add(defaultRecvFee[0]).Add(defaultRecvFee[0])
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forward, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedForwardAccBal, balance)
+
+				// check if the refund amount is zero
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(refundAccBal, balance)
+			},
+		},
+		{
+			"success: forward and reverse relayers are not allowed, recv and ack fees are refunded",
+			func() {
+				allowedRelayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAllowedRelayers(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, []string{allowedRelayer})
+
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+			},
+			func() {
+				// check if the reverse relayer is not paid
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(reverseRelayerBal, balance)
+
+				// check if the forward relayer is not paid
+				forward, err := sdk.AccAddressFromBech32(forwardRelayer)
+				suite.Require().NoError(err)
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forward, sdk.DefaultBondDenom)
+				suite.Require().Equal(forwardRelayerBal, balance)
+
+				// check if the refund acc has been refunded all the fees
+				expectedRefundAccBal := sdk.Coins{refundAccBal}.Add(packetFee.Fee.Total()...).Add(packetFee.Fee.Total()...)[0]
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedRefundAccBal, balance)
+
+				// check the module acc wallet is now empty
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(0)), balance)
+			},
+		},
+		{
+			"success: reverse relayer is not allowed, ack fee is paid to its allowed payee",
+			func() {
+				payee = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), reverseRelayer.String(), payee.String(), suite.path.EndpointA.ChannelID)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAllowedRelayers(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, []string{forwardRelayer, payee.String()})
+
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+			},
+			func() {
+				// check if the payee of the reverse relayer is paid
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payee, sdk.DefaultBondDenom)
+				suite.Require().Equal(defaultAckFee[0].Add(defaultAckFee[0]), balance)
+
+				// check if the reverse relayer is not paid
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(reverseRelayerBal, balance)
+
+				// check if the refund amount is zero
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(refundAccBal, balance)
+			},
+		},
+		{
+			"success: payee of allowed reverse relayer is not allowed, ack fee is refunded",
+			func() {
+				payee = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), reverseRelayer.String(), payee.String(), suite.path.EndpointA.ChannelID)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAllowedRelayers(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, []string{forwardRelayer, reverseRelayer.String()})
+
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+			},
+			func() {
+				// check if neither the payee nor the reverse relayer are paid
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payee, sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(reverseRelayerBal, balance)
+
+				// check if the refund acc has been refunded the ack fees
+				expectedRefundAccBal := refundAccBal.Add(defaultAckFee[0]).Add(defaultAckFee[0])
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedRefundAccBal, balance)
+			},
+		},
 		{
 			"success: refund account is module account",
 			func() {
@@ -127,7 +348,7 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 			},
 		},
 		{
-			"escrow account out of balance, fee module becomes locked - no distribution", func() {
+			"escrow account out of balance for one fee: covered fees are distributed, remaining fee stays in escrow", func() {
 				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
 				packetFees = []types.PacketFee{packetFee, packetFee}
 
@@ -137,13 +358,66 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 			func() {
 				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
 
-				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
-				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+				suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
 
-				// check if the module acc contains all the fees
-				expectedModuleAccBal := packetFee.Fee.Total().Add(packetFee.Fee.Total()...)
-				balance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress())
-				suite.Require().Equal(expectedModuleAccBal, balance)
+				// check that only the uncovered fee remains in escrow
+				feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().True(found)
+				suite.Require().Len(feesInEscrow.PacketFees, 1)
+				suite.Require().Equal(packetFee.Fee, feesInEscrow.PacketFees[0].Fee)
+
+				// check if the reverse relayer is paid for the covered fees
+				expectedReverseAccBal := reverseRelayerBal.Add(defaultAckFee[0]).Add(defaultAckFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedReverseAccBal, balance)
+
+				// check the module acc wallet is now empty
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(0)), balance)
+			},
+		},
+		{
+			"escrow account covers one of two fees from different payers: covered fee is distributed, remaining fee stays in escrow", func() {
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+
+				// the second payer escrows a fee which exceeds the remaining escrow account balance
+				largeFee := types.NewFee(
+					defaultRecvFee.MulInt(sdkmath.NewInt(2)),
+					defaultAckFee.MulInt(sdkmath.NewInt(2)),
+					defaultTimeoutFee.MulInt(sdkmath.NewInt(2)),
+				)
+				largePacketFee := types.NewPacketFee(largeFee, suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), []string{})
+
+				packetFees = []types.PacketFee{packetFee, largePacketFee}
+			},
+			func() {
+				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+
+				suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+
+				// check that only the uncovered fee remains in escrow
+				feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().True(found)
+				suite.Require().Len(feesInEscrow.PacketFees, 1)
+				suite.Require().Equal(packetFees[1].Fee, feesInEscrow.PacketFees[0].Fee)
+				suite.Require().Equal(packetFees[1].RefundAddress, feesInEscrow.PacketFees[0].RefundAddress)
+
+				// check if the relayers are paid for the covered fee only
+				expectedReverseAccBal := reverseRelayerBal.Add(defaultAckFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedReverseAccBal, balance)
+
+				forward, err := sdk.AccAddressFromBech32(forwardRelayer)
+				suite.Require().NoError(err)
+
+				expectedForwardAccBal := forwardRelayerBal.Add(defaultRecvFee[0])
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), forward, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedForwardAccBal, balance)
+
+				// check the module acc wallet still holds the remaining balance
+				expectedModuleAccBal := packetFee.Fee.Total()
+				moduleBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress())
+				suite.Require().Equal(expectedModuleAccBal, moduleBalance)
 			},
 		},
 		{
@@ -227,6 +501,7 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 
 			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
 			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			underlyingAppSuccess = true
 
 			tc.malleate()
 
@@ -241,7 +516,129 @@ func (suite *KeeperTestSuite) TestDistributeFee() {
 			reverseRelayerBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), reverseRelayer, sdk.DefaultBondDenom)
 			refundAccBal = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
 
-			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), forwardRelayer, reverseRelayer, packetFees, packetID)
+			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), forwardRelayer, reverseRelayer, packetFees, packetID, underlyingAppSuccess)
+			tc.expResult()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributePacketFeesToDenomPayees() {
+	var (
+		forwardRelayer sdk.AccAddress
+		reverseRelayer sdk.AccAddress
+		refundAcc      sdk.AccAddress
+		payee          sdk.AccAddress
+		denomPayee     sdk.AccAddress
+		fee            types.Fee
+		packetID       channeltypes.PacketId
+	)
+
+	// each fee consists of a coin of the default bond denomination and a coin of a second denomination
+	denom2 := "denom2"
+	defaultCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+	denom2Coins := sdk.NewCoins(sdk.NewCoin(denom2, sdkmath.NewInt(200)))
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expResult func()
+	}{
+		{
+			"success: acknowledgement fees routed to denom payee and payee",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), reverseRelayer.String(), payee.String(), packetID.ChannelId)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddressForDenom(suite.chainA.GetContext(), reverseRelayer.String(), denomPayee.String(), packetID.ChannelId, denom2)
+
+				packetFees := []types.PacketFee{types.NewPacketFee(fee, refundAcc.String(), nil)}
+				suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), forwardRelayer.String(), reverseRelayer, packetFees, packetID, true)
+			},
+			func() {
+				suite.Require().Equal(fee.RecvFee, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), forwardRelayer))
+				suite.Require().Equal(defaultCoins, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), payee))
+				suite.Require().Equal(denom2Coins, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), denomPayee))
+				suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), reverseRelayer).IsZero())
+			},
+		},
+		{
+			"success: receive fees of forward relayer routed to denom payee",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddressForDenom(suite.chainA.GetContext(), forwardRelayer.String(), denomPayee.String(), packetID.ChannelId, sdk.DefaultBondDenom)
+
+				packetFees := []types.PacketFee{types.NewPacketFee(fee, refundAcc.String(), nil)}
+				suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), forwardRelayer.String(), reverseRelayer, packetFees, packetID, true)
+			},
+			func() {
+				suite.Require().Equal(denom2Coins, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), forwardRelayer))
+				suite.Require().Equal(defaultCoins, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), denomPayee))
+				suite.Require().Equal(fee.AckFee, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), reverseRelayer))
+			},
+		},
+		{
+			"success: timeout fees routed to denom payees",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddressForDenom(suite.chainA.GetContext(), reverseRelayer.String(), payee.String(), packetID.ChannelId, sdk.DefaultBondDenom)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddressForDenom(suite.chainA.GetContext(), reverseRelayer.String(), denomPayee.String(), packetID.ChannelId, denom2)
+
+				packetFees := []types.PacketFee{types.NewPacketFee(fee, refundAcc.String(), nil)}
+				suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(suite.chainA.GetContext(), reverseRelayer, packetFees, packetID)
+			},
+			func() {
+				suite.Require().Equal(defaultCoins, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), payee))
+				suite.Require().Equal(denom2Coins, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), denomPayee))
+				suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), reverseRelayer).IsZero())
+			},
+		},
+		{
+			"success: blocked denom payee is refunded",
+			func() {
+				blockedAddr := suite.chainA.GetSimApp().AccountKeeper.GetModuleAccount(suite.chainA.GetContext(), transfertypes.ModuleName).GetAddress()
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddressForDenom(suite.chainA.GetContext(), reverseRelayer.String(), blockedAddr.String(), packetID.ChannelId, denom2)
+
+				packetFees := []types.PacketFee{types.NewPacketFee(fee, refundAcc.String(), nil)}
+				suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(suite.chainA.GetContext(), reverseRelayer, packetFees, packetID)
+			},
+			func() {
+				suite.Require().Equal(defaultCoins, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), reverseRelayer))
+
+				// the denom2 timeout fee and the unused amount of the escrowed fee are refunded
+				expRefund := fee.Total().Sub(fee.TimeoutFee...).Add(denom2Coins...)
+				suite.Require().Equal(expRefund, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), refundAcc))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()  // reset
+			suite.path.Setup() // setup channel
+
+			forwardRelayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			reverseRelayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			refundAcc = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			payee = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			denomPayee = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+			packetID = channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+
+			coins := defaultCoins.Add(denom2Coins...)
+			fee = types.NewFee(coins, coins, coins)
+
+			// mint the fees into the escrow account
+			err := suite.chainA.GetSimApp().MintKeeper.MintCoins(suite.chainA.GetContext(), fee.Total())
+			suite.Require().NoError(err)
+			err = suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToModule(suite.chainA.GetContext(), minttypes.ModuleName, types.ModuleName, fee.Total())
+			suite.Require().NoError(err)
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, refundAcc.String(), nil)}))
+
+			tc.malleate()
+
+			// the full fee is always distributed
+			suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress()).IsZero())
+			suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+
 			tc.expResult()
 		})
 	}
@@ -256,6 +653,7 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 		fee               types.Fee
 		packetFee         types.PacketFee
 		packetFees        []types.PacketFee
+		payee             sdk.AccAddress
 	)
 
 	testCases := []struct {
@@ -308,20 +706,137 @@ func (suite *KeeperTestSuite) TestDistributePacketFeesOnTimeout() {
 			},
 		},
 		{
-			"escrow account out of balance, fee module becomes locked - no distribution", func() {
+			"success: refund is sent to the registered refund address override",
+			func() {
+				override := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetRefundAddressOverride(suite.chainA.GetContext(), refundAcc.String(), override.String(), suite.path.EndpointA.ChannelID)
+
+				// set the recv + ack fee to be greater than timeout fee so that the refund amount is non-zero
+				fee.RecvFee = fee.RecvFee.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)))
+				packetFee = types.NewPacketFee(fee, refundAcc.String(), []string{})
+				packetFees = []types.PacketFee{packetFee, packetFee}
+			},
+			func() {
+				// check the refund account is not refunded
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(refundAccBal, balance)
+
+				// check the override address receives the refund
+				override, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRefundAddressOverride(suite.chainA.GetContext(), refundAcc.String(), suite.path.EndpointA.ChannelID)
+				suite.Require().True(found)
+
+				refundCoins := fee.Total().Sub(defaultTimeoutFee[0]).MulInt(sdkmath.NewInt(2))
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sdk.MustAccAddressFromBech32(override), sdk.DefaultBondDenom)
+				suite.Require().Equal(refundCoins[0], balance)
+			},
+		},
+		{
+			"success: registered payee is bypassed",
+			func() {
+				payee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), timeoutRelayer.String(), payee.String(), suite.path.EndpointA.ChannelID)
+
+				packetFee.BypassPayee = true
+				packetFees = []types.PacketFee{packetFee, packetFee}
+			},
+			func() {
+				// check if the timeout relayer is paid rather than its payee
+				expectedTimeoutAccBal := timeoutRelayerBal.Add(defaultTimeoutFee[0]).Add(defaultTimeoutFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), timeoutRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedTimeoutAccBal, balance)
+
+				// check the payee is not paid
+				payee, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), timeoutRelayer.String(), suite.path.EndpointA.ChannelID)
+				suite.Require().True(found)
+
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sdk.MustAccAddressFromBech32(payee), sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+			},
+		},
+		{
+			"escrow account out of balance for one fee: covered fees are distributed, remaining fee stays in escrow", func() {
 				// pass in an extra packet fee
 				packetFees = append(packetFees, packetFee)
 			},
 			func() {
 				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
 
-				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
-				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), packetID))
+				suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
 
-				// check if the module acc contains all the fees
-				expectedModuleAccBal := packetFee.Fee.Total().Add(packetFee.Fee.Total()...)
-				balance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress())
-				suite.Require().Equal(expectedModuleAccBal, balance)
+				// check that only the uncovered fee remains in escrow
+				feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+				suite.Require().True(found)
+				suite.Require().Len(feesInEscrow.PacketFees, 1)
+				suite.Require().Equal(packetFee.Fee, feesInEscrow.PacketFees[0].Fee)
+
+				// check if the timeout relayer is paid for the covered fees
+				expectedTimeoutAccBal := timeoutRelayerBal.Add(defaultTimeoutFee[0]).Add(defaultTimeoutFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), timeoutRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedTimeoutAccBal, balance)
+			},
+		},
+		{
+			"success: timeout relayer is allowed",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAllowedRelayers(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, []string{timeoutRelayer.String()})
+			},
+			func() {
+				// check if the timeout relayer is paid
+				expectedTimeoutAccBal := timeoutRelayerBal.Add(defaultTimeoutFee[0]).Add(defaultTimeoutFee[0])
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), timeoutRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedTimeoutAccBal, balance)
+			},
+		},
+		{
+			"timeout relayer is not allowed: timeout fee returned to sender",
+			func() {
+				allowedRelayer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAllowedRelayers(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, []string{allowedRelayer})
+			},
+			func() {
+				// check if the timeout relayer is not paid
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), timeoutRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(timeoutRelayerBal, balance)
+
+				// check if the refund acc has been refunded all the fees
+				expectedRefundAccBal := sdk.Coins{refundAccBal}.Add(packetFee.Fee.Total()...).Add(packetFee.Fee.Total()...)[0]
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedRefundAccBal, balance)
+			},
+		},
+		{
+			"success: timeout relayer is not allowed, timeout fee is paid to its allowed payee",
+			func() {
+				payee = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), timeoutRelayer.String(), payee.String(), suite.path.EndpointA.ChannelID)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAllowedRelayers(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, []string{payee.String()})
+			},
+			func() {
+				// check if the payee of the timeout relayer is paid
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payee, sdk.DefaultBondDenom)
+				suite.Require().Equal(defaultTimeoutFee[0].Add(defaultTimeoutFee[0]), balance)
+
+				// check if the timeout relayer is not paid
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), timeoutRelayer, sdk.DefaultBondDenom)
+				suite.Require().Equal(timeoutRelayerBal, balance)
+			},
+		},
+		{
+			"payee of allowed timeout relayer is not allowed: timeout fee returned to sender",
+			func() {
+				payee = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(suite.chainA.GetContext(), timeoutRelayer.String(), payee.String(), suite.path.EndpointA.ChannelID)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAllowedRelayers(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, []string{timeoutRelayer.String()})
+			},
+			func() {
+				// check if the payee is not paid
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), payee, sdk.DefaultBondDenom)
+				suite.Require().True(balance.IsZero())
+
+				// check if the refund acc has been refunded all the fees
+				expectedRefundAccBal := sdk.Coins{refundAccBal}.Add(packetFee.Fee.Total()...).Add(packetFee.Fee.Total()...)[0]
+				balance = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(expectedRefundAccBal, balance)
 			},
 		},
 		{
@@ -446,6 +961,23 @@ func (suite *KeeperTestSuite) TestRefundFeesOnChannelClosure() {
 				expRefundBal = expRefundBal.Sub(fee.Total()...)
 			}, false,
 		},
+		{
+			"success with refund address override", func() {
+				override := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetRefundAddressOverride(suite.chainA.GetContext(), refundAcc.String(), override.String(), suite.path.EndpointA.ChannelID)
+
+				// store the fee in state & update escrow account balance
+				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, uint64(1))
+				packetFees := types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, refundAcc.String(), nil)})
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, packetFees)
+
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAcc, types.ModuleName, fee.Total())
+				suite.Require().NoError(err)
+
+				// the refund is sent to the override address rather than the refund account
+				expRefundBal = expRefundBal.Sub(fee.Total()...)
+			}, false,
+		},
 		{
 			"escrow account empty, module should become locked", func() {
 				// store the fee in state without updating escrow account balance
@@ -566,6 +1098,12 @@ func (suite *KeeperTestSuite) TestRefundFeesOnChannelClosure() {
 				// refund account and escrow account balances should remain unchanged
 				suite.Require().Equal(originalRefundBal, refundBal)
 				suite.Require().Equal(originalEscrowBal, escrowBal)
+
+				// the packet and fee which could not be refunded should be recorded as the lock reason
+				reason, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetLockReason(suite.chainA.GetContext())
+				suite.Require().True(found)
+				suite.Require().Equal(suite.path.EndpointA.ChannelID, reason.PacketId.ChannelId)
+				suite.Require().Equal(fee.Total(), reason.Shortfall)
 			} else {
 				suite.Require().Equal(expEscrowBal, escrowBal) // escrow balance should be empty
 				suite.Require().Equal(expRefundBal, refundBal) // all packets should have been refunded
@@ -573,3 +1111,576 @@ func (suite *KeeperTestSuite) TestRefundFeesOnChannelClosure() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestRefundFeesOnChannelClosureSweepInvalidRefunds() {
+	var (
+		params       types.Params
+		refundAddr   string
+		sinkAddr     sdk.AccAddress
+		expSweepAddr string
+	)
+
+	testCases := []struct {
+		name             string
+		malleate         func()
+		expRefund        bool
+		expSweep         bool
+		expEscrow        bool
+		expCommunityPool bool
+	}{
+		{
+			"valid refund address is refunded", func() {}, true, false, false, false,
+		},
+		{
+			"invalid refund address is swept to the refund sink", func() {
+				refundAddr = "invalid refund address"
+			}, false, true, false, false,
+		},
+		{
+			"blocked refund address is swept to the refund sink", func() {
+				refundAddr = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(transfertypes.ModuleName).String()
+			}, false, true, false, false,
+		},
+		{
+			"invalid refund address is swept to the community pool", func() {
+				refundAddr = "invalid refund address"
+				params.RefundSink = ""
+				expSweepAddr = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String()
+			}, false, true, false, true,
+		},
+		{
+			"invalid refund address remains in escrow when sweeping is disabled", func() {
+				refundAddr = "invalid refund address"
+				params.SweepInvalidRefunds = false
+			}, false, false, true, false,
+		},
+		{
+			"invalid refund address remains in escrow when the refund sink is blocked", func() {
+				refundAddr = "invalid refund address"
+				params.RefundSink = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(transfertypes.ModuleName).String()
+			}, false, false, true, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()  // reset
+			suite.path.Setup() // setup channel
+
+			sinkAddr = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			refundAddr = suite.chainA.SenderAccount.GetAddress().String()
+			params = types.NewParams(true, sinkAddr.String())
+			expSweepAddr = sinkAddr.String()
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+			bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+			feeKeeper.SetParams(ctx, params)
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+			packetFees := types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, refundAddr, nil)})
+			feeKeeper.SetFeesInEscrow(ctx, packetID, packetFees)
+
+			err := bankKeeper.SendCoinsFromAccountToModule(ctx, suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total())
+			suite.Require().NoError(err)
+
+			senderBalBefore := bankKeeper.GetAllBalances(ctx, suite.chainA.SenderAccount.GetAddress())
+			sinkBalBefore := bankKeeper.GetAllBalances(ctx, sinkAddr)
+			feePoolBefore, err := suite.chainA.GetSimApp().DistrKeeper.FeePool.Get(ctx)
+			suite.Require().NoError(err)
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			err = feeKeeper.RefundFeesOnChannelClosure(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			suite.Require().NoError(err)
+			suite.Require().False(feeKeeper.IsLocked(ctx))
+
+			escrowBal := bankKeeper.GetAllBalances(ctx, feeKeeper.GetFeeModuleAddress())
+			_, found := feeKeeper.GetFeesInEscrow(ctx, packetID)
+			if tc.expEscrow {
+				suite.Require().True(found)
+				suite.Require().Equal(fee.Total(), escrowBal)
+			} else {
+				suite.Require().False(found)
+				suite.Require().True(escrowBal.IsZero())
+			}
+
+			if tc.expRefund {
+				suite.Require().Equal(senderBalBefore.Add(fee.Total()...), bankKeeper.GetAllBalances(ctx, suite.chainA.SenderAccount.GetAddress()))
+			}
+
+			if tc.expSweep && !tc.expCommunityPool {
+				suite.Require().Equal(sinkBalBefore.Add(fee.Total()...), bankKeeper.GetAllBalances(ctx, sinkAddr))
+			} else {
+				suite.Require().Equal(sinkBalBefore, bankKeeper.GetAllBalances(ctx, sinkAddr))
+			}
+
+			feePoolAfter, err := suite.chainA.GetSimApp().DistrKeeper.FeePool.Get(ctx)
+			suite.Require().NoError(err)
+			if tc.expCommunityPool {
+				suite.Require().Equal(feePoolBefore.CommunityPool.Add(sdk.NewDecCoinsFromCoins(fee.Total()...)...), feePoolAfter.CommunityPool)
+			} else {
+				suite.Require().Equal(feePoolBefore.CommunityPool, feePoolAfter.CommunityPool)
+			}
+
+			expEvent := sdk.NewEvent(
+				types.EventTypeSweepRefund,
+				sdk.NewAttribute(types.AttributeKeyPacketID, packetID.String()),
+				sdk.NewAttribute(types.AttributeKeyRefundAddress, refundAddr),
+				sdk.NewAttribute(types.AttributeKeyReceiver, expSweepAddr),
+				sdk.NewAttribute(types.AttributeKeyFee, fee.Total().String()),
+			)
+
+			var sweepEvents []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeSweepRefund {
+					sweepEvents = append(sweepEvents, event)
+				}
+			}
+
+			if tc.expSweep {
+				suite.Require().Equal([]sdk.Event{expEvent}, sweepEvents)
+			} else {
+				suite.Require().Empty(sweepEvents)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundUndistributedFees() {
+	var (
+		packetID   channeltypes.PacketId
+		topUp      sdk.Coins
+		refundAddr sdk.AccAddress
+	)
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	largeFee := types.NewFee(
+		defaultRecvFee.MulInt(sdkmath.NewInt(2)),
+		defaultAckFee.MulInt(sdkmath.NewInt(2)),
+		defaultTimeoutFee.MulInt(sdkmath.NewInt(2)),
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"failure: fee module is locked", func() {
+				lockFeeModule(suite.chainA)
+			}, types.ErrFeeModuleLocked,
+		},
+		{
+			"failure: no fees escrowed for packet", func() {
+				packetID.Sequence = 2
+			}, types.ErrFeeNotFound,
+		},
+		{
+			"failure: packet commitment exists", func() {
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), packetID.PortId, packetID.ChannelId, packetID.Sequence, []byte("commitment"))
+			}, ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: escrow account has insufficient balance", func() {
+				topUp = sdk.NewCoins()
+			}, ibcerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()  // reset
+			suite.path.Setup() // setup channel
+
+			ctx := suite.chainA.GetContext()
+			feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+			bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+			packetID = channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+			refundAddr = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			forwardRelayer := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress().String()
+			reverseRelayer := suite.chainA.SenderAccounts[3].SenderAccount.GetAddress()
+
+			// the escrow account only covers the first fee, the second fee exceeds the remaining balance
+			packetFees := []types.PacketFee{
+				types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil),
+				types.NewPacketFee(largeFee, refundAddr.String(), nil),
+			}
+			feeKeeper.SetFeesInEscrow(ctx, packetID, types.NewPacketFees(packetFees))
+
+			err := bankKeeper.SendCoinsFromAccountToModule(ctx, suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total().Add(fee.Total()...))
+			suite.Require().NoError(err)
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			feeKeeper.DistributePacketFeesOnAcknowledgement(ctx, forwardRelayer, reverseRelayer, packetFees, packetID, true)
+			suite.Require().False(feeKeeper.IsLocked(ctx))
+
+			// the uncovered fee is left in escrow after the acknowledgement and an event is emitted for it
+			feesInEscrow, found := feeKeeper.GetFeesInEscrow(ctx, packetID)
+			suite.Require().True(found)
+			suite.Require().Equal([]types.PacketFee{packetFees[1]}, feesInEscrow.PacketFees)
+
+			expEvents := sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeUndistributedFees,
+					sdk.NewAttribute(channeltypes.AttributeKeyPortID, packetID.PortId),
+					sdk.NewAttribute(channeltypes.AttributeKeyChannelID, packetID.ChannelId),
+					sdk.NewAttribute(channeltypes.AttributeKeySequence, "1"),
+					sdk.NewAttribute(types.AttributeKeyFee, largeFee.Total().String()),
+				),
+			}.ToABCIEvents()
+
+			expEvents = sdk.MarkEventsToIndex(expEvents, map[string]struct{}{})
+			ibctesting.AssertEvents(&suite.Suite, expEvents, ctx.EventManager().Events().ToABCIEvents())
+
+			// top up the escrow account so that it covers the remaining fee
+			topUp = largeFee.Total().Sub(fee.Total()...)
+
+			tc.malleate()
+
+			err = bankKeeper.SendCoinsFromAccountToModule(ctx, suite.chainA.SenderAccount.GetAddress(), types.ModuleName, topUp)
+			suite.Require().NoError(err)
+
+			refundBalBefore := bankKeeper.GetAllBalances(ctx, refundAddr)
+
+			err = feeKeeper.RefundUndistributedFees(ctx, packetID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				_, found := feeKeeper.GetFeesInEscrow(ctx, packetID)
+				suite.Require().False(found)
+
+				suite.Require().Equal(refundBalBefore.Add(largeFee.Total()...), bankKeeper.GetAllBalances(ctx, refundAddr))
+				suite.Require().True(bankKeeper.GetAllBalances(ctx, feeKeeper.GetFeeModuleAddress()).IsZero())
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+
+				suite.Require().Equal(refundBalBefore, bankKeeper.GetAllBalances(ctx, refundAddr))
+			}
+		})
+	}
+}
+
+var _ types.FeeHooks = (*recordingFeeHooks)(nil)
+
+// recordedFeeDistribution is a fee distribution observed by the recordingFeeHooks
+type recordedFeeDistribution struct {
+	packetID channeltypes.PacketId
+	payee    sdk.AccAddress
+	coins    sdk.Coins
+	feeType  types.FeeType
+}
+
+// recordingFeeHooks records all fee distributions and optionally panics after recording
+type recordingFeeHooks struct {
+	distributions []recordedFeeDistribution
+	malleate      func(ctx sdk.Context)
+}
+
+func (h *recordingFeeHooks) AfterFeeDistributed(ctx sdk.Context, packetID channeltypes.PacketId, payee sdk.AccAddress, coins sdk.Coins, feeType types.FeeType) {
+	h.distributions = append(h.distributions, recordedFeeDistribution{packetID, payee, coins, feeType})
+
+	if h.malleate != nil {
+		h.malleate(ctx)
+	}
+}
+
+func (suite *KeeperTestSuite) TestEstimateBacklogIncentive() {
+	var (
+		targetFee types.Fee
+		expTotal  sdk.Coins
+		expTopUps []types.PacketFeeTopUp
+	)
+
+	refundAddr := suite.chainA.SenderAccount.GetAddress().String()
+
+	newPacketID := func(channelID string, sequence uint64) channeltypes.PacketId {
+		return channeltypes.NewPacketID(ibctesting.MockFeePort, channelID, sequence)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: only under-funded packets require a top up",
+			func() {
+				expTotal = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(250)))
+				expTopUps = []types.PacketFeeTopUp{
+					{
+						PacketID: newPacketID(ibctesting.FirstChannelID, 2),
+						Fee: types.NewFee(
+							sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))),
+							nil,
+							sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(200))),
+						),
+					},
+					{
+						PacketID: newPacketID(ibctesting.FirstChannelID, 4),
+						Fee:      types.NewFee(nil, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))), nil),
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"success: target fee in a different denom requires a top up for every packet",
+			func() {
+				targetFee = types.NewFee(sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(10))), nil, nil)
+
+				topUpFee := types.NewFee(sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(10))), nil, nil)
+				expTotal = sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(40)))
+				expTopUps = nil
+				for sequence := uint64(1); sequence <= 4; sequence++ {
+					expTopUps = append(expTopUps, types.PacketFeeTopUp{PacketID: newPacketID(ibctesting.FirstChannelID, sequence), Fee: topUpFee})
+				}
+			},
+			nil,
+		},
+		{
+			"success: all packets meet the target fee",
+			func() {
+				targetFee = types.NewFee(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))), nil, nil)
+
+				expTotal = nil
+				expTopUps = nil
+			},
+			nil,
+		},
+		{
+			"invalid target fee",
+			func() {
+				targetFee = types.NewFee(nil, nil, nil)
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup()
+
+			targetFee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+			ctx := suite.chainA.GetContext()
+			feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+
+			// the first packet is funded exactly to the target fee
+			feeKeeper.SetFeesInEscrow(ctx, newPacketID(ibctesting.FirstChannelID, 1), types.NewPacketFees([]types.PacketFee{
+				types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), refundAddr, nil),
+			}))
+
+			// the second packet is under-funded on its receive and timeout fees
+			feeKeeper.SetFeesInEscrow(ctx, newPacketID(ibctesting.FirstChannelID, 2), types.NewPacketFees([]types.PacketFee{
+				types.NewPacketFee(types.NewFee(
+					sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))),
+					defaultAckFee,
+					sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+				), refundAddr, nil),
+			}))
+
+			// the third packet is over-funded by two packet fees
+			feeKeeper.SetFeesInEscrow(ctx, newPacketID(ibctesting.FirstChannelID, 3), types.NewPacketFees([]types.PacketFee{
+				types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), refundAddr, nil),
+				types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), refundAddr, nil),
+			}))
+
+			// the fourth packet meets the target receive and timeout fees only when its packet fees are summed
+			feeKeeper.SetFeesInEscrow(ctx, newPacketID(ibctesting.FirstChannelID, 4), types.NewPacketFees([]types.PacketFee{
+				types.NewPacketFee(types.NewFee(
+					sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(60))),
+					sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))),
+					defaultTimeoutFee,
+				), refundAddr, nil),
+				types.NewPacketFee(types.NewFee(
+					sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(40))),
+					sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))),
+					nil,
+				), refundAddr, nil),
+			}))
+
+			// under-funded packets on other channels are ignored
+			feeKeeper.SetFeesInEscrow(ctx, newPacketID("channel-1", 1), types.NewPacketFees([]types.PacketFee{
+				types.NewPacketFee(types.NewFee(nil, nil, defaultTimeoutFee), refundAddr, nil),
+			}))
+
+			tc.malleate()
+
+			total, topUps, err := feeKeeper.EstimateBacklogIncentive(ctx, ibctesting.MockFeePort, ibctesting.FirstChannelID, targetFee)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expTotal, total)
+				suite.Require().Equal(expTopUps, topUps)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFeeHooks() {
+	var (
+		hooks          *recordingFeeHooks
+		fee            types.Fee
+		packetID       channeltypes.PacketId
+		forwardRelayer sdk.AccAddress
+		reverseRelayer sdk.AccAddress
+		refundAcc      sdk.AccAddress
+		escrowCoins    sdk.Coins
+		distribute     func()
+		expHookWrites  bool
+	)
+
+	testCases := []struct {
+		name             string
+		malleate         func()
+		expDistributions func() []recordedFeeDistribution
+	}{
+		{
+			"success: recv and ack fees distributed on acknowledgement",
+			func() {},
+			func() []recordedFeeDistribution {
+				return []recordedFeeDistribution{
+					{packetID, forwardRelayer, defaultRecvFee, types.FeeTypeRecv},
+					{packetID, reverseRelayer, defaultAckFee, types.FeeTypeAck},
+				}
+			},
+		},
+		{
+			"success: unused fee is refunded on acknowledgement",
+			func() {
+				fee.TimeoutFee = fee.Total().Add(defaultRecvFee...)
+			},
+			func() []recordedFeeDistribution {
+				return []recordedFeeDistribution{
+					{packetID, forwardRelayer, defaultRecvFee, types.FeeTypeRecv},
+					{packetID, reverseRelayer, defaultAckFee, types.FeeTypeAck},
+					{packetID, refundAcc, fee.Total().Sub(defaultRecvFee...).Sub(defaultAckFee...), types.FeeTypeRefund},
+				}
+			},
+		},
+		{
+			"success: blocked forward relayer, recv fee paid to refund address",
+			func() {
+				forwardRelayer = suite.chainA.GetSimApp().AccountKeeper.GetModuleAccount(suite.chainA.GetContext(), transfertypes.ModuleName).GetAddress()
+			},
+			func() []recordedFeeDistribution {
+				return []recordedFeeDistribution{
+					{packetID, refundAcc, defaultRecvFee, types.FeeTypeRecv},
+					{packetID, reverseRelayer, defaultAckFee, types.FeeTypeAck},
+				}
+			},
+		},
+		{
+			"success: timeout fee distributed on timeout",
+			func() {
+				distribute = func() {
+					suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(suite.chainA.GetContext(), reverseRelayer, []types.PacketFee{types.NewPacketFee(fee, refundAcc.String(), nil)}, packetID)
+				}
+			},
+			func() []recordedFeeDistribution {
+				return []recordedFeeDistribution{
+					{packetID, reverseRelayer, defaultTimeoutFee, types.FeeTypeTimeout},
+				}
+			},
+		},
+		{
+			"success: state changes of the hooks are persisted",
+			func() {
+				hooks.malleate = func(ctx sdk.Context) {
+					suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEnabled(ctx, ibctesting.MockFeePort, ibctesting.InvalidID)
+				}
+				expHookWrites = true
+			},
+			func() []recordedFeeDistribution {
+				return []recordedFeeDistribution{
+					{packetID, forwardRelayer, defaultRecvFee, types.FeeTypeRecv},
+					{packetID, reverseRelayer, defaultAckFee, types.FeeTypeAck},
+				}
+			},
+		},
+		{
+			"success: panicking hook does not abort distribution and its state changes are discarded",
+			func() {
+				hooks.malleate = func(ctx sdk.Context) {
+					suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEnabled(ctx, ibctesting.MockFeePort, ibctesting.InvalidID)
+					panic("hook panic")
+				}
+			},
+			func() []recordedFeeDistribution {
+				return []recordedFeeDistribution{
+					{packetID, forwardRelayer, defaultRecvFee, types.FeeTypeRecv},
+					{packetID, reverseRelayer, defaultAckFee, types.FeeTypeAck},
+				}
+			},
+		},
+		{
+			"no hooks invoked: escrow account has insufficient balance",
+			func() {
+				escrowCoins = fee.Total()
+				fee.RecvFee = fee.RecvFee.Add(defaultRecvFee...)
+			},
+			func() []recordedFeeDistribution {
+				return nil
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()  // reset
+			suite.path.Setup() // setup channel
+
+			hooks = &recordingFeeHooks{}
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetHooks(hooks)
+
+			forwardRelayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			reverseRelayer = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			refundAcc = suite.chainA.SenderAccount.GetAddress()
+
+			packetID = channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			escrowCoins = nil
+			expHookWrites = false
+
+			distribute = func() {
+				packetFees := []types.PacketFee{types.NewPacketFee(fee, refundAcc.String(), nil)}
+				suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), forwardRelayer.String(), reverseRelayer, packetFees, packetID, true)
+			}
+
+			tc.malleate()
+
+			// escrow the packet fee
+			if escrowCoins == nil {
+				escrowCoins = fee.Total()
+			}
+
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAcc, types.ModuleName, escrowCoins)
+			suite.Require().NoError(err)
+
+			distribute()
+
+			suite.Require().Equal(tc.expDistributions(), hooks.distributions)
+			suite.Require().Equal(expHookWrites, suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.InvalidID))
+		})
+	}
+}
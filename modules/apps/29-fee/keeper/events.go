@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -60,6 +61,41 @@ func emitRegisterPayeeEvent(ctx sdk.Context, relayer, payee, channelID string) {
 	})
 }
 
+// emitRegisterDenomPayeeEvent emits an event containing information of a registered payee for a relayer on a particular channel
+// and fee denomination
+func emitRegisterDenomPayeeEvent(ctx sdk.Context, relayer, payee, channelID, denom string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterDenomPayee,
+			sdk.NewAttribute(types.AttributeKeyRelayer, relayer),
+			sdk.NewAttribute(types.AttributeKeyPayee, payee),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitRegisterRefundOverrideEvent emits an event containing information of a refund address override registered for a
+// refund address on a particular channel
+func emitRegisterRefundOverrideEvent(ctx sdk.Context, refundAddr, overrideAddr, channelID string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRegisterRefundOverride,
+			sdk.NewAttribute(types.AttributeKeyRefundAddress, refundAddr),
+			sdk.NewAttribute(types.AttributeKeyOverrideAddress, overrideAddr),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
 // emitRegisterCounterpartyPayeeEvent emits an event containing information of a registered counterparty payee for a relayer on a particular channel
 func emitRegisterCounterpartyPayeeEvent(ctx sdk.Context, relayer, counterpartyPayee, channelID string) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -90,3 +126,69 @@ func emitDistributeFeeEvent(ctx sdk.Context, receiver string, fee sdk.Coins) {
 		),
 	})
 }
+
+// emitSweepRefundEvent emits an event containing a refund which could not be returned to its refund address
+// and was swept to the given receiver instead
+func emitSweepRefundEvent(ctx sdk.Context, packetID channeltypes.PacketId, refundAddr, receiver string, fee sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSweepRefund,
+			sdk.NewAttribute(types.AttributeKeyPacketID, packetID.String()),
+			sdk.NewAttribute(types.AttributeKeyRefundAddress, refundAddr),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitUndistributedFeesEvent emits an event containing the total fees which remain in escrow for a packet after
+// its acknowledgement or timeout because the escrow account could not cover them
+func emitUndistributedFeesEvent(ctx sdk.Context, packetID channeltypes.PacketId, fee sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUndistributedFees,
+			sdk.NewAttribute(channeltypes.AttributeKeyPortID, packetID.PortId),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, packetID.ChannelId),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprint(packetID.Sequence)),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitConvertEscrowedFeeEvent emits an event containing the original and the converted fees escrowed for a packet
+func emitConvertEscrowedFeeEvent(ctx sdk.Context, packetID channeltypes.PacketId, originalFee, fee sdk.Coins) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConvertEscrowedFee,
+			sdk.NewAttribute(types.AttributeKeyPacketID, packetID.String()),
+			sdk.NewAttribute(types.AttributeKeyOriginalFee, originalFee.String()),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// emitSetAcceptedFeeDenomsEvent emits an event containing the denominations now accepted as packet fees
+func emitSetAcceptedFeeDenomsEvent(ctx sdk.Context, denoms []string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAcceptedFeeDenoms,
+			sdk.NewAttribute(types.AttributeKeyDenoms, strings.Join(denoms, ",")),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
@@ -122,9 +122,9 @@ func (suite *KeeperTestSuite) TestDistributeFeeEvent() {
 	suite.Require().NotNil(res)
 
 	// parse the packet from result events and recv packet on chainB
-	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
-	suite.Require().NoError(err)
-	suite.Require().NotNil(packet)
+	sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+	suite.Require().Len(sendPacketEvents, 1)
+	packet := sendPacketEvents[0].Packet
 
 	err = path.EndpointB.UpdateClient()
 	suite.Require().NoError(err)
@@ -133,10 +133,14 @@ func (suite *KeeperTestSuite) TestDistributeFeeEvent() {
 	suite.Require().NoError(err)
 	suite.Require().NotNil(res)
 
-	// parse the acknowledgement from result events and acknowledge packet on chainA
-	ack, err := ibctesting.ParseAckFromEvents(res.Events)
-	suite.Require().NoError(err)
-	suite.Require().NotNil(ack)
+	// assert the acknowledgement written for the packet and acknowledge packet on chainA
+	appAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+	ack := types.NewIncentivizedAcknowledgement("", appAck, true).Acknowledgement() // no counterparty payee is registered on chainB
+	ibctesting.AssertTypedEvent(suite.T(), res.Events, &channeltypes.EventWriteAcknowledgement{
+		Packet:          packet,
+		Acknowledgement: ack,
+		ConnectionId:    path.EndpointB.ConnectionID,
+	})
 
 	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 	proof, proofHeight := path.EndpointA.Counterparty.QueryProof(packetKey)
@@ -4,9 +4,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
 
 // LegacyTotal is a wrapper for the legacyTotal function for testing.
 func LegacyTotal(f types.Fee) sdk.Coins {
 	return legacyTotal(f)
 }
+
+// LockFeeModule is a wrapper for the lockFeeModule function for testing.
+func (k Keeper) LockFeeModule(ctx sdk.Context, packetID channeltypes.PacketId, shortfall sdk.Coins) {
+	k.lockFeeModule(ctx, packetID, shortfall)
+}
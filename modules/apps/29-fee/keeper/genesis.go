@@ -8,6 +8,8 @@ import (
 
 // InitGenesis initializes the fee middleware application state from a provided genesis state
 func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	k.SetParams(ctx, state.Params)
+
 	for _, identifiedFees := range state.IdentifiedFees {
 		k.SetFeesInEscrow(ctx, identifiedFees.PacketId, types.NewPacketFees(identifiedFees.PacketFees))
 	}
@@ -16,6 +18,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 		k.SetPayeeAddress(ctx, registeredPayee.Relayer, registeredPayee.Payee, registeredPayee.ChannelId)
 	}
 
+	for _, registeredDenomPayee := range state.RegisteredDenomPayees {
+		k.SetPayeeAddressForDenom(ctx, registeredDenomPayee.Relayer, registeredDenomPayee.Payee, registeredDenomPayee.ChannelId, registeredDenomPayee.Denom)
+	}
+
+	for _, refundOverride := range state.RefundAddressOverrides {
+		k.SetRefundAddressOverride(ctx, refundOverride.RefundAddress, refundOverride.OverrideAddress, refundOverride.ChannelId)
+	}
+
 	for _, registeredCounterpartyPayee := range state.RegisteredCounterpartyPayees {
 		k.SetCounterpartyPayeeAddress(ctx, registeredCounterpartyPayee.Relayer, registeredCounterpartyPayee.CounterpartyPayee, registeredCounterpartyPayee.ChannelId)
 	}
@@ -27,15 +37,50 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, enabledChan := range state.FeeEnabledChannels {
 		k.SetFeeEnabled(ctx, enabledChan.PortId, enabledChan.ChannelId)
 	}
+
+	for _, allowedRelayers := range state.AllowedRelayers {
+		k.SetAllowedRelayers(ctx, allowedRelayers.PortId, allowedRelayers.ChannelId, allowedRelayers.Relayers)
+	}
+
+	for _, stats := range state.ChannelFeeStats {
+		k.SetChannelFeeStats(ctx, stats)
+	}
+
+	for _, record := range state.DistributedFeeRecords {
+		k.SetDistributedFeeRecord(ctx, record)
+	}
+
+	k.SetAcceptedFeeDenomRegistry(ctx, state.AcceptedFeeDenoms)
+
+	// the fee module remains locked across a chain export and restart
+	if state.Locked {
+		k.setLocked(ctx, state.LockReason)
+	}
 }
 
 // ExportGenesis returns the fee middleware application exported genesis
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	return &types.GenesisState{
-		IdentifiedFees:               k.GetAllIdentifiedPacketFees(ctx),
+	identifiedFees := k.GetAllIdentifiedPacketFees(ctx)
+	genesisState := &types.GenesisState{
+		IdentifiedFees:               identifiedFees,
 		FeeEnabledChannels:           k.GetAllFeeEnabledChannels(ctx),
 		RegisteredPayees:             k.GetAllPayees(ctx),
 		RegisteredCounterpartyPayees: k.GetAllCounterpartyPayees(ctx),
 		ForwardRelayers:              k.GetAllForwardRelayerAddresses(ctx),
+		AllowedRelayers:              k.GetAllAllowedRelayers(ctx),
+		RegisteredDenomPayees:        k.GetAllDenomPayees(ctx),
+		Params:                       k.GetParams(ctx),
+		ChannelFeeStats:              k.GetAllChannelFeeStats(ctx),
+		DistributedFeeRecords:        k.GetAllDistributedFeeRecords(ctx),
+		Locked:                       k.IsLocked(ctx),
+		AcceptedFeeDenoms:            k.GetAcceptedFeeDenoms(ctx),
+		TotalEscrowed:                types.TotalEscrowedFees(identifiedFees),
+		RefundAddressOverrides:       k.GetAllRefundAddressOverrides(ctx),
+	}
+
+	if reason, found := k.GetLockReason(ctx); found {
+		genesisState.LockReason = &reason
 	}
+
+	return genesisState
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
@@ -42,6 +44,42 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 				ChannelId:         ibctesting.FirstChannelID,
 			},
 		},
+		AllowedRelayers: []types.AllowedRelayers{
+			{
+				PortId:    ibctesting.MockFeePort,
+				ChannelId: ibctesting.FirstChannelID,
+				Relayers:  []string{suite.chainA.SenderAccount.GetAddress().String()},
+			},
+		},
+		RegisteredDenomPayees: []types.RegisteredDenomPayee{
+			{
+				Relayer:   suite.chainA.SenderAccount.GetAddress().String(),
+				Payee:     suite.chainB.SenderAccount.GetAddress().String(),
+				ChannelId: ibctesting.FirstChannelID,
+				Denom:     sdk.DefaultBondDenom,
+			},
+		},
+		Params: types.NewParams(true, suite.chainB.SenderAccount.GetAddress().String()),
+		ChannelFeeStats: []types.ChannelFeeStats{
+			{
+				PortId:              ibctesting.MockFeePort,
+				ChannelId:           ibctesting.FirstChannelID,
+				TotalEscrowed:       types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee).Total(),
+				TotalDistributed:    defaultRecvFee,
+				IncentivizedPackets: 1,
+			},
+		},
+		DistributedFeeRecords: []types.DistributedFeeRecord{
+			types.NewDistributedFeeRecord(10, suite.chainB.SenderAccount.GetAddress().String(), defaultRecvFee),
+		},
+		AcceptedFeeDenoms: []string{"atom", sdk.DefaultBondDenom},
+		RefundAddressOverrides: []types.RefundAddressOverride{
+			{
+				ChannelId:       ibctesting.FirstChannelID,
+				RefundAddress:   suite.chainA.SenderAccount.GetAddress().String(),
+				OverrideAddress: suite.chainB.SenderAccount.GetAddress().String(),
+			},
+		},
 	}
 
 	suite.chainA.GetSimApp().IBCFeeKeeper.InitGenesis(suite.chainA.GetContext(), genesisState)
@@ -60,10 +98,39 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	suite.Require().True(found)
 	suite.Require().Equal(genesisState.RegisteredPayees[0].Payee, payeeAddr)
 
+	// check denom payee addresses
+	denomPayeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddressForDenom(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.RegisteredDenomPayees[0].Payee, denomPayeeAddr)
+
 	// check relayers
 	counterpartyPayeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
 	suite.Require().True(found)
 	suite.Require().Equal(genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee, counterpartyPayeeAddr)
+
+	// check allowed relayers
+	allowedRelayers, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllowedRelayers(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.AllowedRelayers[0].Relayers, allowedRelayers)
+
+	// check channel fee stats
+	suite.Require().Equal(genesisState.ChannelFeeStats[0], suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelFeeStats(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID))
+
+	// check distributed fee records
+	record, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetDistributedFeeRecord(suite.chainA.GetContext(), 10, suite.chainB.SenderAccount.GetAddress().String())
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.DistributedFeeRecords[0], record)
+
+	// check accepted fee denoms
+	suite.Require().Equal(genesisState.AcceptedFeeDenoms, suite.chainA.GetSimApp().IBCFeeKeeper.GetAcceptedFeeDenoms(suite.chainA.GetContext()))
+
+	// check refund address overrides
+	overrideAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRefundAddressOverride(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.RefundAddressOverrides[0].OverrideAddress, overrideAddr)
+
+	// check params
+	suite.Require().Equal(genesisState.Params, suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext()))
 }
 
 func (suite *KeeperTestSuite) TestExportGenesis() {
@@ -86,6 +153,15 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 		ibctesting.FirstChannelID,
 	)
 
+	// set denom payee address
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddressForDenom(
+		suite.chainA.GetContext(),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		ibctesting.FirstChannelID,
+		sdk.DefaultBondDenom,
+	)
+
 	// set counterparty payee address
 	suite.chainA.GetSimApp().IBCFeeKeeper.SetCounterpartyPayeeAddress(
 		suite.chainA.GetContext(),
@@ -97,6 +173,39 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	// set forward relayer address
 	suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerAddressForAsyncAck(suite.chainA.GetContext(), packetID, suite.chainA.SenderAccount.GetAddress().String())
 
+	// set allowed relayers
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetAllowedRelayers(
+		suite.chainA.GetContext(),
+		ibctesting.MockFeePort,
+		ibctesting.FirstChannelID,
+		[]string{suite.chainA.SenderAccount.GetAddress().String()},
+	)
+
+	// set channel fee stats
+	stats := types.NewChannelFeeStats(ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	stats.TotalEscrowed = fee.Total()
+	stats.IncentivizedPackets = 1
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetChannelFeeStats(suite.chainA.GetContext(), stats)
+
+	// set distributed fee record
+	record := types.NewDistributedFeeRecord(10, suite.chainB.SenderAccount.GetAddress().String(), defaultRecvFee)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetDistributedFeeRecord(suite.chainA.GetContext(), record)
+
+	// set accepted fee denoms
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetAcceptedFeeDenomRegistry(suite.chainA.GetContext(), []string{sdk.DefaultBondDenom, "atom"})
+
+	// set refund address override
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetRefundAddressOverride(
+		suite.chainA.GetContext(),
+		refundAcc.String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		ibctesting.FirstChannelID,
+	)
+
+	// set params
+	params := types.NewParams(true, suite.chainB.SenderAccount.GetAddress().String())
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+
 	// export genesis
 	genesisState := suite.chainA.GetSimApp().IBCFeeKeeper.ExportGenesis(suite.chainA.GetContext())
 
@@ -119,8 +228,110 @@ func (suite *KeeperTestSuite) TestExportGenesis() {
 	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress().String(), genesisState.RegisteredPayees[0].Payee)
 	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.RegisteredPayees[0].ChannelId)
 
+	// check denom payee addresses
+	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), genesisState.RegisteredDenomPayees[0].Relayer)
+	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress().String(), genesisState.RegisteredDenomPayees[0].Payee)
+	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.RegisteredDenomPayees[0].ChannelId)
+	suite.Require().Equal(sdk.DefaultBondDenom, genesisState.RegisteredDenomPayees[0].Denom)
+
 	// check registered counterparty payee addresses
 	suite.Require().Equal(suite.chainA.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].Relayer)
 	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress().String(), genesisState.RegisteredCounterpartyPayees[0].CounterpartyPayee)
 	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.RegisteredCounterpartyPayees[0].ChannelId)
+
+	// check allowed relayers
+	suite.Require().Equal(ibctesting.MockFeePort, genesisState.AllowedRelayers[0].PortId)
+	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.AllowedRelayers[0].ChannelId)
+	suite.Require().Equal([]string{suite.chainA.SenderAccount.GetAddress().String()}, genesisState.AllowedRelayers[0].Relayers)
+
+	// check channel fee stats
+	suite.Require().Len(genesisState.ChannelFeeStats, 1)
+	suite.Require().Equal(ibctesting.MockFeePort, genesisState.ChannelFeeStats[0].PortId)
+	suite.Require().Equal(ibctesting.FirstChannelID, genesisState.ChannelFeeStats[0].ChannelId)
+	suite.Require().Equal(fee.Total(), genesisState.ChannelFeeStats[0].TotalEscrowed)
+	suite.Require().Equal(uint64(1), genesisState.ChannelFeeStats[0].IncentivizedPackets)
+
+	// check distributed fee records
+	suite.Require().Equal([]types.DistributedFeeRecord{record}, genesisState.DistributedFeeRecords)
+
+	// check accepted fee denoms are exported in sorted order
+	suite.Require().Equal([]string{"atom", sdk.DefaultBondDenom}, genesisState.AcceptedFeeDenoms)
+
+	// check refund address overrides
+	suite.Require().Equal([]types.RefundAddressOverride{
+		{
+			ChannelId:       ibctesting.FirstChannelID,
+			RefundAddress:   refundAcc.String(),
+			OverrideAddress: suite.chainB.SenderAccount.GetAddress().String(),
+		},
+	}, genesisState.RefundAddressOverrides)
+
+	// check params
+	suite.Require().Equal(params, genesisState.Params)
+}
+
+func (suite *KeeperTestSuite) TestExportInitGenesisPreservesLock() {
+	packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name      string
+		lock      func()
+		expLocked bool
+		expReason *types.FeeModuleLockReason
+	}{
+		{
+			"fee module not locked",
+			func() {},
+			false,
+			nil,
+		},
+		{
+			"fee module locked with lock reason",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.LockFeeModule(suite.chainA.GetContext(), packetID, defaultRecvFee)
+			},
+			true,
+			&types.FeeModuleLockReason{PacketId: packetID, Shortfall: defaultRecvFee},
+		},
+		{
+			"fee module locked without lock reason",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{packetFee}))
+
+			tc.lock()
+
+			genesisState := suite.chainA.GetSimApp().IBCFeeKeeper.ExportGenesis(suite.chainA.GetContext())
+			suite.Require().NoError(genesisState.Validate())
+			suite.Require().Equal(tc.expLocked, genesisState.Locked)
+			suite.Require().Equal(tc.expReason, genesisState.LockReason)
+			suite.Require().Equal(fee.Total(), genesisState.TotalEscrowed)
+
+			// re-initialize the exported state on a chain with a fresh fee module state
+			suite.chainB.GetSimApp().IBCFeeKeeper.InitGenesis(suite.chainB.GetContext(), *genesisState)
+			suite.Require().Equal(tc.expLocked, suite.chainB.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainB.GetContext()))
+
+			reason, found := suite.chainB.GetSimApp().IBCFeeKeeper.GetLockReason(suite.chainB.GetContext())
+			suite.Require().Equal(tc.expReason != nil, found)
+			if tc.expReason != nil {
+				suite.Require().Equal(*tc.expReason, reason)
+			}
+
+			suite.Require().Equal(genesisState, suite.chainB.GetSimApp().IBCFeeKeeper.ExportGenesis(suite.chainB.GetContext()))
+		})
+	}
 }
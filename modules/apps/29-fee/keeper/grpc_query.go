@@ -210,6 +210,44 @@ func (k Keeper) Payee(goCtx context.Context, req *types.QueryPayeeRequest) (*typ
 	}, nil
 }
 
+// DenomPayee implements the Query/DenomPayee gRPC method and returns the registered payee address to which packet fees
+// of the requested denomination are paid out
+func (k Keeper) DenomPayee(goCtx context.Context, req *types.QueryDenomPayeeRequest) (*types.QueryDenomPayeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payeeAddr, found := k.GetPayeeAddressForDenom(ctx, req.Relayer, req.ChannelId, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "payee address not found for address: %s on channel: %s for denom: %s", req.Relayer, req.ChannelId, req.Denom)
+	}
+
+	return &types.QueryDenomPayeeResponse{
+		PayeeAddress: payeeAddr,
+	}, nil
+}
+
+// RefundAddressOverride implements the Query/RefundAddressOverride gRPC method and returns the address registered on
+// the requested channel to which the fees escrowed with the requested refund address are refunded
+func (k Keeper) RefundAddressOverride(goCtx context.Context, req *types.QueryRefundAddressOverrideRequest) (*types.QueryRefundAddressOverrideResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	overrideAddr, found := k.GetRefundAddressOverride(ctx, req.RefundAddress, req.ChannelId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "refund address override not found for address: %s on channel: %s", req.RefundAddress, req.ChannelId)
+	}
+
+	return &types.QueryRefundAddressOverrideResponse{
+		OverrideAddress: overrideAddr,
+	}, nil
+}
+
 // CounterpartyPayee implements the Query/CounterpartyPayee gRPC method and returns the registered counterparty payee address for forward relaying
 func (k Keeper) CounterpartyPayee(goCtx context.Context, req *types.QueryCounterpartyPayeeRequest) (*types.QueryCounterpartyPayeeResponse, error) {
 	if req == nil {
@@ -228,6 +266,40 @@ func (k Keeper) CounterpartyPayee(goCtx context.Context, req *types.QueryCounter
 	}, nil
 }
 
+// CounterpartyPayeesForRelayer implements the Query/CounterpartyPayeesForRelayer gRPC method and returns the
+// counterparty payee addresses registered by the relayer on each channel
+func (k Keeper) CounterpartyPayeesForRelayer(goCtx context.Context, req *types.QueryCounterpartyPayeesForRelayerRequest) (*types.QueryCounterpartyPayeesForRelayerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(req.Relayer); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid relayer address %s: %s", req.Relayer, err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var counterpartyPayees []types.RegisteredCounterpartyPayee
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyCounterpartyPayeesForRelayerPrefix(req.Relayer))
+	pagination, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		counterpartyPayees = append(counterpartyPayees, types.RegisteredCounterpartyPayee{
+			Relayer:           req.Relayer,
+			ChannelId:         string(key),
+			CounterpartyPayee: string(value),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryCounterpartyPayeesForRelayerResponse{
+		CounterpartyPayees: counterpartyPayees,
+		Pagination:         pagination,
+	}, nil
+}
+
 // FeeEnabledChannels implements the Query/FeeEnabledChannels gRPC method and returns a list of fee enabled channels
 func (k Keeper) FeeEnabledChannels(goCtx context.Context, req *types.QueryFeeEnabledChannelsRequest) (*types.QueryFeeEnabledChannelsResponse, error) {
 	if req == nil {
@@ -263,6 +335,48 @@ func (k Keeper) FeeEnabledChannels(goCtx context.Context, req *types.QueryFeeEna
 	}, nil
 }
 
+// FeeEnabledChannelsDetailed implements the Query/FeeEnabledChannelsDetailed gRPC method and returns a list of fee enabled
+// channels together with the counterparty, state and connection hops of each channel
+func (k Keeper) FeeEnabledChannelsDetailed(goCtx context.Context, req *types.QueryFeeEnabledChannelsRequest) (*types.QueryFeeEnabledChannelsDetailedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx).WithBlockHeight(int64(req.QueryHeight))
+
+	var feeEnabledChannels []types.FeeEnabledChannelDetails
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.FeeEnabledKeyPrefix))
+	pagination, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		portID, channelID, err := types.ParseKeyFeeEnabled(types.FeeEnabledKeyPrefix + string(key))
+		if err != nil {
+			return err
+		}
+
+		feeEnabledChannel := types.FeeEnabledChannelDetails{
+			PortId:    portID,
+			ChannelId: channelID,
+		}
+
+		if channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID); found {
+			feeEnabledChannel.Counterparty = channel.Counterparty
+			feeEnabledChannel.State = channel.State
+			feeEnabledChannel.ConnectionHops = channel.ConnectionHops
+		}
+
+		feeEnabledChannels = append(feeEnabledChannels, feeEnabledChannel)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &types.QueryFeeEnabledChannelsDetailedResponse{
+		FeeEnabledChannels: feeEnabledChannels,
+		Pagination:         pagination,
+	}, nil
+}
+
 // FeeEnabledChannel implements the Query/FeeEnabledChannel gRPC method and returns true if the provided
 // port and channel identifiers belong to a fee enabled channel
 func (k Keeper) FeeEnabledChannel(goCtx context.Context, req *types.QueryFeeEnabledChannelRequest) (*types.QueryFeeEnabledChannelResponse, error) {
@@ -289,3 +403,128 @@ func (k Keeper) FeeEnabledChannel(goCtx context.Context, req *types.QueryFeeEnab
 		FeeEnabled: isFeeEnabled,
 	}, nil
 }
+
+// AllowedRelayers implements the Query/AllowedRelayers gRPC method and returns the list of relayers which are allowed
+// to be paid fees on the provided channel. An empty list indicates that any relayer may be paid fees.
+func (k Keeper) AllowedRelayers(goCtx context.Context, req *types.QueryAllowedRelayersRequest) (*types.QueryAllowedRelayersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	relayers, _ := k.GetAllowedRelayers(ctx, req.PortId, req.ChannelId)
+
+	return &types.QueryAllowedRelayersResponse{
+		Relayers: relayers,
+	}, nil
+}
+
+// AcceptedFeeDenoms implements the Query/AcceptedFeeDenoms gRPC method and returns the list of denominations
+// which may be used to pay packet fees. An empty list indicates that fees may be paid in any denomination.
+func (k Keeper) AcceptedFeeDenoms(goCtx context.Context, req *types.QueryAcceptedFeeDenomsRequest) (*types.QueryAcceptedFeeDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryAcceptedFeeDenomsResponse{
+		Denoms: k.GetAcceptedFeeDenoms(ctx),
+	}, nil
+}
+
+// ChannelFeeStats implements the Query/ChannelFeeStats gRPC method and returns the aggregate fee statistics
+// for the provided port and channel identifiers
+func (k Keeper) ChannelFeeStats(goCtx context.Context, req *types.QueryChannelFeeStatsRequest) (*types.QueryChannelFeeStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryChannelFeeStatsResponse{
+		Stats: k.GetChannelFeeStats(ctx, req.PortId, req.ChannelId),
+	}, nil
+}
+
+// DistributedFeesInRange implements the Query/DistributedFeesInRange gRPC method and returns the total fees distributed
+// to each payee in the inclusive block height range [start_height, end_height]
+func (k Keeper) DistributedFeesInRange(goCtx context.Context, req *types.QueryDistributedFeesInRangeRequest) (*types.QueryDistributedFeesInRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.StartHeight > req.EndHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "start height %d cannot be greater than end height %d", req.StartHeight, req.EndHeight)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var payeeFees []types.PayeeFees
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.DistributedFeePayeePrefix+"/"))
+	pagination, err := query.FilteredPaginate(store, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+		payee := string(key)
+
+		totalFees := k.GetPayeeDistributedFeesInRange(ctx, payee, req.StartHeight, req.EndHeight)
+		if totalFees.IsZero() {
+			return false, nil
+		}
+
+		if accumulate {
+			payeeFees = append(payeeFees, types.PayeeFees{Payee: payee, TotalFees: totalFees})
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryDistributedFeesInRangeResponse{
+		PayeeFees:  payeeFees,
+		Pagination: pagination,
+	}, nil
+}
+
+// FeeModuleLockStatus implements the Query/FeeModuleLockStatus gRPC method and returns whether the fee module
+// is locked along with the reason it was locked
+func (k Keeper) FeeModuleLockStatus(goCtx context.Context, req *types.QueryFeeModuleLockStatusRequest) (*types.QueryFeeModuleLockStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	res := &types.QueryFeeModuleLockStatusResponse{
+		Locked: k.IsLocked(ctx),
+	}
+
+	if reason, found := k.GetLockReason(ctx); found {
+		res.Reason = &reason
+	}
+
+	return res, nil
+}
+
+// Params implements the Query/Params gRPC method and returns the fee module parameters
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: &params,
+	}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"sort"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -571,6 +572,159 @@ func (suite *KeeperTestSuite) TestQueryPayee() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryDenomPayee() {
+	var req *types.QueryDenomPayeeRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"payee address not found: invalid channel",
+			func() {
+				req.ChannelId = "invalid-channel-id"
+			},
+			false,
+		},
+		{
+			"payee address not found: invalid relayer address",
+			func() {
+				req.Relayer = "invalid-addr"
+			},
+			false,
+		},
+		{
+			"payee address not found: no payee registered for denom",
+			func() {
+				req.Denom = "denom2"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			pk := secp256k1.GenPrivKey().PubKey()
+			expPayeeAddr := sdk.AccAddress(pk.Address())
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddressForDenom(
+				suite.chainA.GetContext(),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				expPayeeAddr.String(),
+				suite.path.EndpointA.ChannelID,
+				sdk.DefaultBondDenom,
+			)
+
+			req = &types.QueryDenomPayeeRequest{
+				ChannelId: suite.path.EndpointA.ChannelID,
+				Relayer:   suite.chainA.SenderAccount.GetAddress().String(),
+				Denom:     sdk.DefaultBondDenom,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.DenomPayee(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPayeeAddr.String(), res.PayeeAddress)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRefundAddressOverride() {
+	var req *types.QueryRefundAddressOverrideRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"override address not found: invalid channel",
+			func() {
+				req.ChannelId = "invalid-channel-id"
+			},
+			false,
+		},
+		{
+			"override address not found: no override registered for refund address",
+			func() {
+				req.RefundAddress = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			pk := secp256k1.GenPrivKey().PubKey()
+			expOverrideAddr := sdk.AccAddress(pk.Address())
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetRefundAddressOverride(
+				suite.chainA.GetContext(),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				expOverrideAddr.String(),
+				suite.path.EndpointA.ChannelID,
+			)
+
+			req = &types.QueryRefundAddressOverrideRequest{
+				ChannelId:     suite.path.EndpointA.ChannelID,
+				RefundAddress: suite.chainA.SenderAccount.GetAddress().String(),
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RefundAddressOverride(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expOverrideAddr.String(), res.OverrideAddress)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryCounterpartyPayee() {
 	var req *types.QueryCounterpartyPayeeRequest
 
@@ -643,6 +797,112 @@ func (suite *KeeperTestSuite) TestQueryCounterpartyPayee() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryCounterpartyPayeesForRelayer() {
+	var (
+		req                   *types.QueryCounterpartyPayeesForRelayerRequest
+		expCounterpartyPayees []types.RegisteredCounterpartyPayee
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with pagination",
+			func() {
+				req.Pagination = &query.PageRequest{
+					Limit:      2,
+					CountTotal: false,
+				}
+
+				expCounterpartyPayees = expCounterpartyPayees[:2]
+			},
+			true,
+		},
+		{
+			"success: no counterparty payees registered by relayer",
+			func() {
+				req.Relayer = suite.chainB.SenderAccount.GetAddress().String()
+
+				expCounterpartyPayees = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid relayer address",
+			func() {
+				req.Relayer = "invalid-addr"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			relayer := suite.chainA.SenderAccount.GetAddress().String()
+
+			expCounterpartyPayees = nil
+			for i := 0; i < 3; i++ {
+				channelID := fmt.Sprintf("channel-%d", i)
+				counterpartyPayee := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetCounterpartyPayeeAddress(suite.chainA.GetContext(), relayer, counterpartyPayee, channelID)
+
+				expCounterpartyPayees = append(expCounterpartyPayees, types.RegisteredCounterpartyPayee{
+					ChannelId:         channelID,
+					Relayer:           relayer,
+					CounterpartyPayee: counterpartyPayee,
+				})
+			}
+
+			// register a counterparty payee for a different relayer which must not be returned
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetCounterpartyPayeeAddress(
+				suite.chainA.GetContext(),
+				suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(),
+				sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+				ibctesting.FirstChannelID,
+			)
+
+			req = &types.QueryCounterpartyPayeesForRelayerRequest{
+				Relayer: relayer,
+				Pagination: &query.PageRequest{
+					Limit:      5,
+					CountTotal: false,
+				},
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.CounterpartyPayeesForRelayer(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expCounterpartyPayees, res.CounterpartyPayees)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryFeeEnabledChannels() {
 	var (
 		req                   *types.QueryFeeEnabledChannelsRequest
@@ -759,13 +1019,23 @@ func (suite *KeeperTestSuite) TestQueryFeeEnabledChannels() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
+func (suite *KeeperTestSuite) TestQueryFeeEnabledChannelsDetailed() {
 	var (
-		req        *types.QueryFeeEnabledChannelRequest
-		expEnabled bool
-		path       *ibctesting.Path
+		req                   *types.QueryFeeEnabledChannelsRequest
+		expFeeEnabledChannels []types.FeeEnabledChannelDetails
 	)
 
+	// channelDetails returns the expected details of the fee enabled channel on chainA of the provided path
+	channelDetails := func(path *ibctesting.Path) types.FeeEnabledChannelDetails {
+		return types.FeeEnabledChannelDetails{
+			PortId:         path.EndpointA.ChannelConfig.PortID,
+			ChannelId:      path.EndpointA.ChannelID,
+			Counterparty:   channeltypes.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID),
+			State:          channeltypes.OPEN,
+			ConnectionHops: []string{path.EndpointA.ConnectionID},
+		}
+	}
+
 	testCases := []struct {
 		name     string
 		malleate func()
@@ -780,40 +1050,164 @@ func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
 			"empty request",
 			func() {
 				req = nil
-				expEnabled = false
 			},
 			false,
 		},
 		{
-			"fee not enabled on channel",
+			"success: empty pagination",
 			func() {
-				expEnabled = false
-				path = ibctesting.NewPath(suite.chainA, suite.chainB)
-				path.Setup()
+				req = &types.QueryFeeEnabledChannelsRequest{}
+			},
+			true,
+		},
+		{
+			"success: fee enabled and plain channels",
+			func() {
+				suite.pathAToC.Setup()
 
-				req = &types.QueryFeeEnabledChannelRequest{
-					PortId:    path.EndpointA.ChannelConfig.PortID,
-					ChannelId: path.EndpointA.ChannelID,
-				}
+				// channel without fees enabled is not returned
+				plainPath := ibctesting.NewTransferPath(suite.chainA, suite.chainC)
+				plainPath.Setup()
+
+				expFeeEnabledChannels = append(expFeeEnabledChannels, channelDetails(suite.pathAToC))
 			},
 			true,
 		},
 		{
-			"channel not found",
+			"success: fee enabled channel which does not exist has empty details",
 			func() {
-				req.ChannelId = ibctesting.InvalidID
+				channelID := channeltypes.FormatChannelIdentifier(9)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEnabled(suite.chainA.GetContext(), ibctesting.MockFeePort, channelID)
+
+				expFeeEnabledChannels = append(expFeeEnabledChannels, types.FeeEnabledChannelDetails{
+					PortId:    ibctesting.MockFeePort,
+					ChannelId: channelID,
+				})
+
+				suite.chainA.NextBlock()
 			},
-			false,
+			true,
 		},
 		{
-			"invalid ID",
+			"success: pagination with multiple fee enabled channels",
 			func() {
-				req = &types.QueryFeeEnabledChannelRequest{
-					PortId:    "",
-					ChannelId: "test-channel-id",
+				// start at index 1, as channel-0 is already added to expFeeEnabledChannels below
+				for i := 1; i < 10; i++ {
+					channelID := channeltypes.FormatChannelIdentifier(uint64(i))
+					suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEnabled(suite.chainA.GetContext(), ibctesting.MockFeePort, channelID)
+
+					if i < 5 { // add only the first 5 channels, as our default pagination limit is 5
+						expFeeEnabledChannels = append(expFeeEnabledChannels, types.FeeEnabledChannelDetails{
+							PortId:    ibctesting.MockFeePort,
+							ChannelId: channelID,
+						})
+					}
 				}
+
+				suite.chainA.NextBlock()
 			},
-			false,
+			true,
+		},
+		{
+			"empty response",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+				expFeeEnabledChannels = nil
+
+				suite.chainA.NextBlock()
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.path.Setup()
+
+			expFeeEnabledChannels = []types.FeeEnabledChannelDetails{channelDetails(suite.path)}
+
+			req = &types.QueryFeeEnabledChannelsRequest{
+				Pagination: &query.PageRequest{
+					Limit:      5,
+					CountTotal: false,
+				},
+				QueryHeight: 0,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.FeeEnabledChannelsDetailed(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expFeeEnabledChannels, res.FeeEnabledChannels)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
+	var (
+		req        *types.QueryFeeEnabledChannelRequest
+		expEnabled bool
+		path       *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+				expEnabled = false
+			},
+			false,
+		},
+		{
+			"fee not enabled on channel",
+			func() {
+				expEnabled = false
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				req = &types.QueryFeeEnabledChannelRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			true,
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"invalid ID",
+			func() {
+				req = &types.QueryFeeEnabledChannelRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
 		},
 	}
 
@@ -846,3 +1240,418 @@ func (suite *KeeperTestSuite) TestQueryFeeEnabledChannel() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryAllowedRelayers() {
+	var (
+		req         *types.QueryAllowedRelayersRequest
+		expRelayers []string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: no restriction set",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAllowedRelayers(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, nil)
+				expRelayers = nil
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			expRelayers = []string{suite.chainA.SenderAccount.GetAddress().String()}
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetAllowedRelayers(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, expRelayers)
+
+			req = &types.QueryAllowedRelayersRequest{
+				PortId:    ibctesting.MockFeePort,
+				ChannelId: ibctesting.FirstChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.AllowedRelayers(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRelayers, res.Relayers)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryAcceptedFeeDenoms() {
+	var (
+		req       *types.QueryAcceptedFeeDenomsRequest
+		expDenoms []string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				expDenoms = []string{"atom", sdk.DefaultBondDenom}
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAcceptedFeeDenomRegistry(suite.chainA.GetContext(), expDenoms)
+			},
+			true,
+		},
+		{
+			"success: any denom accepted",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			expDenoms = []string{}
+			req = &types.QueryAcceptedFeeDenomsRequest{}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.AcceptedFeeDenoms(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expDenoms, res.Denoms)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelFeeStats() {
+	var (
+		req      *types.QueryChannelFeeStatsRequest
+		expStats types.ChannelFeeStats
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: no fees recorded for channel",
+			func() {
+				req.ChannelId = "channel-100"
+				expStats = types.NewChannelFeeStats(ibctesting.MockFeePort, "channel-100")
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req.ChannelId = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			expStats = types.NewChannelFeeStats(ibctesting.MockFeePort, ibctesting.FirstChannelID)
+			expStats.TotalEscrowed = defaultRecvFee.Add(defaultAckFee...)
+			expStats.TotalDistributed = defaultRecvFee
+			expStats.TotalRefunded = defaultAckFee
+			expStats.IncentivizedPackets = 1
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetChannelFeeStats(suite.chainA.GetContext(), expStats)
+
+			req = &types.QueryChannelFeeStatsRequest{
+				PortId:    ibctesting.MockFeePort,
+				ChannelId: ibctesting.FirstChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.ChannelFeeStats(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expStats, res.Stats)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDistributedFeesInRange() {
+	var (
+		req          *types.QueryDistributedFeesInRangeRequest
+		expPayeeFees []types.PayeeFees
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: single block height",
+			func() {
+				req.StartHeight = 10
+				req.EndHeight = 10
+				expPayeeFees = []types.PayeeFees{{Payee: suite.chainA.SenderAccount.GetAddress().String(), TotalFees: defaultRecvFee}}
+			},
+			true,
+		},
+		{
+			"success: no fees distributed in range",
+			func() {
+				req.StartHeight = 12
+				req.EndHeight = 20
+				expPayeeFees = nil
+			},
+			true,
+		},
+		{
+			"success: with pagination",
+			func() {
+				payees := []string{suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()}
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetDistributedFeeRecord(suite.chainA.GetContext(), types.NewDistributedFeeRecord(10, payees[1], defaultTimeoutFee))
+
+				// the payees are paginated in ascending order of address
+				sort.Strings(payees)
+				req.Pagination = &query.PageRequest{
+					Key:   []byte(payees[1]),
+					Limit: 1,
+				}
+
+				totalFees := defaultTimeoutFee
+				if payees[1] == suite.chainA.SenderAccount.GetAddress().String() {
+					totalFees = defaultRecvFee.Add(defaultAckFee...)
+				}
+
+				expPayeeFees = []types.PayeeFees{{Payee: payees[1], TotalFees: totalFees}}
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"start height greater than end height",
+			func() {
+				req.StartHeight = 11
+				req.EndHeight = 10
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			payee := suite.chainA.SenderAccount.GetAddress().String()
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetDistributedFeeRecord(suite.chainA.GetContext(), types.NewDistributedFeeRecord(10, payee, defaultRecvFee))
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetDistributedFeeRecord(suite.chainA.GetContext(), types.NewDistributedFeeRecord(11, payee, defaultAckFee))
+
+			expPayeeFees = []types.PayeeFees{{Payee: payee, TotalFees: defaultRecvFee.Add(defaultAckFee...)}}
+			req = &types.QueryDistributedFeesInRangeRequest{
+				StartHeight: 1,
+				EndHeight:   11,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.DistributedFeesInRange(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPayeeFees, res.PayeeFees)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryFeeModuleLockStatus() {
+	var (
+		req       *types.QueryFeeModuleLockStatusRequest
+		expLocked bool
+		expReason *types.FeeModuleLockReason
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: fee module is not locked",
+			func() {},
+			true,
+		},
+		{
+			"success: fee module is locked with reason",
+			func() {
+				packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+				fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+				// store a fee in escrow without funding the escrow account so that refunding the fees locks the fee module
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{
+					types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil),
+				}))
+
+				err := suite.chainA.GetSimApp().IBCFeeKeeper.RefundFeesOnChannelClosure(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+				suite.Require().NoError(err)
+
+				expLocked = true
+				expReason = &types.FeeModuleLockReason{PacketId: packetID, Shortfall: fee.Total()}
+			},
+			true,
+		},
+		{
+			"success: fee module is locked without reason",
+			func() {
+				lockFeeModule(suite.chainA)
+
+				expLocked = true
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			expLocked = false
+			expReason = nil
+			req = &types.QueryFeeModuleLockStatusRequest{}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.FeeModuleLockStatus(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expLocked, res.Locked)
+				suite.Require().Equal(expReason, res.Reason)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := suite.chainA.GetContext()
+
+	res, err := suite.chainA.GetSimApp().IBCFeeKeeper.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), *res.Params)
+
+	expParams := types.NewParams(true, suite.chainA.SenderAccount.GetAddress().String())
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(ctx, expParams)
+
+	res, err = suite.chainA.GetSimApp().IBCFeeKeeper.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expParams, *res.Params)
+
+	_, err = suite.chainA.GetSimApp().IBCFeeKeeper.Params(ctx, nil)
+	suite.Require().Error(err)
+}
@@ -1,6 +1,11 @@
 package keeper
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	storetypes "cosmossdk.io/store/types"
 
@@ -31,6 +36,14 @@ type Keeper struct {
 	channelKeeper types.ChannelKeeper
 	portKeeper    types.PortKeeper
 	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
+	clientKeeper  types.ClientKeeper
+
+	hooks types.FeeHooks
+
+	// the address capable of executing privileged messages such as MsgUpdateAllowedRelayers.
+	// Typically, this should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a new 29-fee Keeper instance
@@ -38,7 +51,12 @@ func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey,
 	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper types.ChannelKeeper,
 	portKeeper types.PortKeeper, authKeeper types.AccountKeeper, bankKeeper types.BankKeeper,
+	authority string,
 ) Keeper {
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
 	return Keeper{
 		cdc:           cdc,
 		storeKey:      key,
@@ -47,6 +65,7 @@ func NewKeeper(
 		portKeeper:    portKeeper,
 		authKeeper:    authKeeper,
 		bankKeeper:    bankKeeper,
+		authority:     authority,
 	}
 }
 
@@ -57,11 +76,61 @@ func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = wrapper
 }
 
+// WithDistributionKeeper sets the distribution keeper used to sweep refunds which cannot be returned
+// to their refund address into the community pool. This function may be used after the keepers creation
+// and must be called before the keeper is passed to the IBC middleware.
+func (k *Keeper) WithDistributionKeeper(distrKeeper types.DistributionKeeper) {
+	k.distrKeeper = distrKeeper
+}
+
+// WithClientKeeper sets the client keeper used to verify that the client of a channel has expired before
+// its escrowed fees are refunded through MsgRefundFeesOnClientExpiry. This function may be used after the keepers
+// creation and must be called before the keeper is passed to the IBC middleware.
+func (k *Keeper) WithClientKeeper(clientKeeper types.ClientKeeper) {
+	k.clientKeeper = clientKeeper
+}
+
+// SetHooks sets the fee hooks which are called when fees are distributed. Multiple hooks may be
+// registered using types.NewMultiFeeHooks. This function must be called before the keeper is passed
+// to the IBC middleware and panics if the hooks have already been set.
+func (k *Keeper) SetHooks(hooks types.FeeHooks) {
+	if k.hooks != nil {
+		panic(errors.New("cannot set fee hooks twice"))
+	}
+
+	k.hooks = hooks
+}
+
 // GetICS4Wrapper returns the ICS4Wrapper.
 func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k.ics4Wrapper
 }
 
+// GetParams returns the current fee module parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.ParamsKey))
+	if bz == nil { // only panic on unset params and not on empty params
+		panic(errors.New("fee params are not set in store"))
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the fee module parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set([]byte(types.ParamsKey), bz)
+}
+
+// GetAuthority returns the 29-fee module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // Logger returns a module-specific logger.
 func (Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
@@ -83,6 +152,11 @@ func (k Keeper) HasChannel(ctx sdk.Context, portID, channelID string) bool {
 	return k.channelKeeper.HasChannel(ctx, portID, channelID)
 }
 
+// GetChannelClientState wraps IBC ChannelKeeper's GetChannelClientState function
+func (k Keeper) GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, ibcexported.ClientState, error) {
+	return k.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+}
+
 // GetPacketCommitment wraps IBC ChannelKeeper's GetPacketCommitment function
 func (k Keeper) GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte {
 	return k.channelKeeper.GetPacketCommitment(ctx, portID, channelID, sequence)
@@ -110,11 +184,44 @@ func (k Keeper) EscrowAccountHasBalance(ctx sdk.Context, coins sdk.Coins) bool {
 }
 
 // lockFeeModule sets a flag to determine if fee handling logic should run for the given channel
-// identified by channel and port identifiers.
+// identified by channel and port identifiers. The packet identifier and the fee which could not be
+// covered by the escrow account are stored as the lock reason.
 // Please see ADR 004 for more information.
-func (k Keeper) lockFeeModule(ctx sdk.Context) {
+func (k Keeper) lockFeeModule(ctx sdk.Context, packetID channeltypes.PacketId, shortfall sdk.Coins) {
+	k.setLocked(ctx, &types.FeeModuleLockReason{PacketId: packetID, Shortfall: shortfall})
+
+	k.Logger(ctx).Error("fee module locked", "packet-id", packetID.String(), "shortfall", shortfall.String())
+}
+
+// setLocked sets the flag locking the fee module and stores the provided lock reason, if any.
+func (k Keeper) setLocked(ctx sdk.Context, reason *types.FeeModuleLockReason) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.KeyLocked(), []byte{1})
+
+	if reason != nil {
+		store.Set(types.KeyLockReason(), k.cdc.MustMarshal(reason))
+	}
+}
+
+// unlockFeeModule removes the flag locking the fee module along with the stored lock reason.
+func (k Keeper) unlockFeeModule(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyLocked())
+	store.Delete(types.KeyLockReason())
+}
+
+// GetLockReason returns the reason the fee module was locked, if it has been stored.
+func (k Keeper) GetLockReason(ctx sdk.Context) (types.FeeModuleLockReason, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyLockReason())
+	if len(bz) == 0 {
+		return types.FeeModuleLockReason{}, false
+	}
+
+	var reason types.FeeModuleLockReason
+	k.cdc.MustUnmarshal(bz, &reason)
+
+	return reason, true
 }
 
 // IsLocked indicates if the fee module is locked
@@ -210,6 +317,97 @@ func (k Keeper) GetAllPayees(ctx sdk.Context) []types.RegisteredPayee {
 	return registeredPayees
 }
 
+// GetPayeeAddressForDenom retrieves the fee payee address stored in state for the provided fee denomination given the
+// provided channel identifier and relayer address
+func (k Keeper) GetPayeeAddressForDenom(ctx sdk.Context, relayerAddr, channelID, denom string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyDenomPayee(relayerAddr, channelID, denom)
+
+	if !store.Has(key) {
+		return "", false
+	}
+
+	return string(store.Get(key)), true
+}
+
+// SetPayeeAddressForDenom stores the fee payee address for the provided fee denomination in state keyed by the provided
+// channel identifier and relayer address
+func (k Keeper) SetPayeeAddressForDenom(ctx sdk.Context, relayerAddr, payeeAddr, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyDenomPayee(relayerAddr, channelID, denom), []byte(payeeAddr))
+}
+
+// GetAllDenomPayees returns all registered payee addresses for specific fee denominations
+func (k Keeper) GetAllDenomPayees(ctx sdk.Context) []types.RegisteredDenomPayee {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.DenomPayeeKeyPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var registeredDenomPayees []types.RegisteredDenomPayee
+	for ; iterator.Valid(); iterator.Next() {
+		relayerAddr, channelID, denom, err := types.ParseKeyDenomPayee(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		denomPayee := types.RegisteredDenomPayee{
+			Relayer:   relayerAddr,
+			Payee:     string(iterator.Value()),
+			ChannelId: channelID,
+			Denom:     denom,
+		}
+
+		registeredDenomPayees = append(registeredDenomPayees, denomPayee)
+	}
+
+	return registeredDenomPayees
+}
+
+// GetRefundAddressOverride retrieves the address stored in state to which the fees escrowed with the provided refund
+// address on the provided channel are refunded
+func (k Keeper) GetRefundAddressOverride(ctx sdk.Context, refundAddr, channelID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyRefundOverride(refundAddr, channelID)
+
+	if !store.Has(key) {
+		return "", false
+	}
+
+	return string(store.Get(key)), true
+}
+
+// SetRefundAddressOverride stores the address to which the fees escrowed with the provided refund address are refunded
+// in state keyed by the refund address and channel identifier
+func (k Keeper) SetRefundAddressOverride(ctx sdk.Context, refundAddr, overrideAddr, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyRefundOverride(refundAddr, channelID), []byte(overrideAddr))
+}
+
+// GetAllRefundAddressOverrides returns all registered refund address overrides
+func (k Keeper) GetAllRefundAddressOverrides(ctx sdk.Context) []types.RefundAddressOverride {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.RefundOverrideKeyPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var refundOverrides []types.RefundAddressOverride
+	for ; iterator.Valid(); iterator.Next() {
+		refundAddr, channelID, err := types.ParseKeyRefundOverride(string(iterator.Key()))
+		if err != nil {
+			panic(err)
+		}
+
+		refundOverride := types.RefundAddressOverride{
+			ChannelId:       channelID,
+			RefundAddress:   refundAddr,
+			OverrideAddress: string(iterator.Value()),
+		}
+
+		refundOverrides = append(refundOverrides, refundOverride)
+	}
+
+	return refundOverrides
+}
+
 // SetCounterpartyPayeeAddress maps the destination chain counterparty payee address to the source relayer address
 // The receiving chain must store the mapping from: address -> counterpartyPayeeAddress for the given channel
 func (k Keeper) SetCounterpartyPayeeAddress(ctx sdk.Context, address, counterpartyAddress, channelID string) {
@@ -217,6 +415,36 @@ func (k Keeper) SetCounterpartyPayeeAddress(ctx sdk.Context, address, counterpar
 	store.Set(types.KeyCounterpartyPayee(address, channelID), []byte(counterpartyAddress))
 }
 
+// RegisterCounterpartyPayeeAddress stores the counterparty payee address for the relayer on the given channel and emits an event.
+func (k Keeper) RegisterCounterpartyPayeeAddress(ctx sdk.Context, relayer, counterpartyPayee, channelID string) {
+	k.SetCounterpartyPayeeAddress(ctx, relayer, counterpartyPayee, channelID)
+
+	k.Logger(ctx).Info("registering counterparty payee for relayer", "relayer", relayer, "counterparty payee", counterpartyPayee, "channel", channelID)
+
+	emitRegisterCounterpartyPayeeEvent(ctx, relayer, counterpartyPayee, channelID)
+}
+
+// VerifyCounterpartyPayeeSignature verifies that the relayer embedded in the given metadata signed the counterparty payee
+// sign bytes for the given port and channel on this chain, using the public key of the relayer account.
+func (k Keeper) VerifyCounterpartyPayeeSignature(ctx sdk.Context, portID, channelID string, metadata types.MetadataWithCounterpartyPayee) error {
+	relayer, err := sdk.AccAddressFromBech32(metadata.Relayer)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to create sdk.AccAddress from relayer address")
+	}
+
+	account := k.authKeeper.GetAccount(ctx, relayer)
+	if account == nil || account.GetPubKey() == nil {
+		return errorsmod.Wrapf(types.ErrInvalidPayeeSignature, "public key not found for relayer %s", metadata.Relayer)
+	}
+
+	signBytes := types.CounterpartyPayeeSignBytes(ctx.ChainID(), portID, channelID, metadata.Relayer, metadata.CounterpartyPayee)
+	if !account.GetPubKey().VerifySignature(signBytes, metadata.Signature) {
+		return errorsmod.Wrapf(types.ErrInvalidPayeeSignature, "signature verification failed for relayer %s", metadata.Relayer)
+	}
+
+	return nil
+}
+
 // GetCounterpartyPayeeAddress gets the counterparty payee address given a destination relayer address
 func (k Keeper) GetCounterpartyPayeeAddress(ctx sdk.Context, address, channelID string) (string, bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -255,6 +483,131 @@ func (k Keeper) GetAllCounterpartyPayees(ctx sdk.Context) []types.RegisteredCoun
 	return registeredCounterpartyPayees
 }
 
+// SetAllowedRelayers stores the relayers which are allowed to be paid fees on the given channel.
+// If the provided list of relayers is empty, any restriction on the channel is removed.
+func (k Keeper) SetAllowedRelayers(ctx sdk.Context, portID, channelID string, relayers []string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyAllowedRelayers(portID, channelID)
+
+	if len(relayers) == 0 {
+		store.Delete(key)
+		return
+	}
+
+	allowedRelayers := types.NewAllowedRelayers(portID, channelID, relayers)
+	store.Set(key, k.cdc.MustMarshal(&allowedRelayers))
+}
+
+// GetAllowedRelayers returns the relayers which are allowed to be paid fees on the given channel.
+// A false boolean is returned if no restriction has been set for the channel.
+func (k Keeper) GetAllowedRelayers(ctx sdk.Context, portID, channelID string) ([]string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyAllowedRelayers(portID, channelID))
+	if len(bz) == 0 {
+		return nil, false
+	}
+
+	var allowedRelayers types.AllowedRelayers
+	k.cdc.MustUnmarshal(bz, &allowedRelayers)
+
+	return allowedRelayers.Relayers, true
+}
+
+// GetAllAllowedRelayers returns the allowed relayers for all channels which restrict the relayers to be paid fees
+func (k Keeper) GetAllAllowedRelayers(ctx sdk.Context) []types.AllowedRelayers {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.AllowedRelayersPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var allowedRelayers []types.AllowedRelayers
+	for ; iterator.Valid(); iterator.Next() {
+		var channelAllowedRelayers types.AllowedRelayers
+		k.cdc.MustUnmarshal(iterator.Value(), &channelAllowedRelayers)
+
+		allowedRelayers = append(allowedRelayers, channelAllowedRelayers)
+	}
+
+	return allowedRelayers
+}
+
+// IsRelayerAllowed returns true if the provided relayer is allowed to be paid fees on the given channel.
+// Any relayer is allowed if no restriction has been set for the channel.
+func (k Keeper) IsRelayerAllowed(ctx sdk.Context, portID, channelID string, relayer sdk.AccAddress) bool {
+	allowedRelayers, found := k.GetAllowedRelayers(ctx, portID, channelID)
+	if !found {
+		return true
+	}
+
+	for _, allowedRelayer := range allowedRelayers {
+		if allowedRelayer == relayer.String() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetAcceptedFeeDenomRegistry replaces the registry of denominations accepted as packet fees with the provided denominations.
+// If the provided list of denominations is empty, fees may be paid in any denomination.
+func (k Keeper) SetAcceptedFeeDenomRegistry(ctx sdk.Context, denoms []string) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, denom := range k.GetAcceptedFeeDenoms(ctx) {
+		store.Delete(types.KeyAcceptedFeeDenom(denom))
+	}
+
+	for _, denom := range denoms {
+		store.Set(types.KeyAcceptedFeeDenom(denom), []byte{1})
+	}
+}
+
+// GetAcceptedFeeDenoms returns the denominations accepted as packet fees, sorted lexicographically.
+// An empty list is returned if fees may be paid in any denomination.
+func (k Keeper) GetAcceptedFeeDenoms(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := []byte(fmt.Sprintf("%s/", types.AcceptedFeeDenomPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	denoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()[len(prefix):]))
+	}
+
+	return denoms
+}
+
+// IsFeeDenomAccepted returns true if the provided denomination may be used to pay packet fees.
+// Any denomination is accepted if the accepted fee denominations registry is empty.
+func (k Keeper) IsFeeDenomAccepted(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(types.KeyAcceptedFeeDenom(denom)) {
+		return true
+	}
+
+	return !k.hasAcceptedFeeDenoms(ctx)
+}
+
+// hasAcceptedFeeDenoms returns true if the accepted fee denominations registry contains any denomination
+func (k Keeper) hasAcceptedFeeDenoms(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(fmt.Sprintf("%s/", types.AcceptedFeeDenomPrefix)))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	return iterator.Valid()
+}
+
+// validateFeeDenomsAccepted returns an error if any denomination of the provided fee is not accepted as a packet fee.
+func (k Keeper) validateFeeDenomsAccepted(ctx sdk.Context, fee types.Fee) error {
+	for _, coin := range fee.Total() {
+		if !k.IsFeeDenomAccepted(ctx, coin.Denom) {
+			return errorsmod.Wrapf(types.ErrFeeDenomNotAccepted, "denom %s is not in the accepted fee denominations %s", coin.Denom, k.GetAcceptedFeeDenoms(ctx))
+		}
+	}
+
+	return nil
+}
+
 // SetRelayerAddressForAsyncAck sets the forward relayer address during OnRecvPacket in case of async acknowledgement
 func (k Keeper) SetRelayerAddressForAsyncAck(ctx sdk.Context, packetID channeltypes.PacketId, address string) {
 	store := ctx.KVStore(k.storeKey)
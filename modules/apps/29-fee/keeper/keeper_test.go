@@ -271,6 +271,67 @@ func (suite *KeeperTestSuite) TestGetAllPayees() {
 	suite.Require().ElementsMatch(expectedPayees, registeredPayees)
 }
 
+func (suite *KeeperTestSuite) TestGetAllDenomPayees() {
+	var expectedDenomPayees []types.RegisteredDenomPayee
+
+	denoms := []string{sdk.DefaultBondDenom, "denom2", "transfer/channel-1/uatom"}
+	for i, denom := range denoms {
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddressForDenom(
+			suite.chainA.GetContext(),
+			suite.chainA.SenderAccount.GetAddress().String(),
+			suite.chainB.SenderAccounts[i].SenderAccount.GetAddress().String(),
+			ibctesting.FirstChannelID,
+			denom,
+		)
+
+		registeredDenomPayee := types.RegisteredDenomPayee{
+			Relayer:   suite.chainA.SenderAccount.GetAddress().String(),
+			Payee:     suite.chainB.SenderAccounts[i].SenderAccount.GetAddress().String(),
+			ChannelId: ibctesting.FirstChannelID,
+			Denom:     denom,
+		}
+
+		expectedDenomPayees = append(expectedDenomPayees, registeredDenomPayee)
+	}
+
+	// payees registered without a denomination are not returned
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(
+		suite.chainA.GetContext(),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		ibctesting.FirstChannelID,
+	)
+
+	registeredDenomPayees := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllDenomPayees(suite.chainA.GetContext())
+	suite.Require().Len(registeredDenomPayees, len(expectedDenomPayees))
+	suite.Require().ElementsMatch(expectedDenomPayees, registeredDenomPayees)
+}
+
+func (suite *KeeperTestSuite) TestGetAllRefundAddressOverrides() {
+	var expectedOverrides []types.RefundAddressOverride
+
+	for i := 0; i < 3; i++ {
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetRefundAddressOverride(
+			suite.chainA.GetContext(),
+			suite.chainA.SenderAccounts[i].SenderAccount.GetAddress().String(),
+			suite.chainB.SenderAccounts[i].SenderAccount.GetAddress().String(),
+			ibctesting.FirstChannelID,
+		)
+
+		refundOverride := types.RefundAddressOverride{
+			ChannelId:       ibctesting.FirstChannelID,
+			RefundAddress:   suite.chainA.SenderAccounts[i].SenderAccount.GetAddress().String(),
+			OverrideAddress: suite.chainB.SenderAccounts[i].SenderAccount.GetAddress().String(),
+		}
+
+		expectedOverrides = append(expectedOverrides, refundOverride)
+	}
+
+	refundOverrides := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllRefundAddressOverrides(suite.chainA.GetContext())
+	suite.Require().Len(refundOverrides, len(expectedOverrides))
+	suite.Require().ElementsMatch(expectedOverrides, refundOverrides)
+}
+
 func (suite *KeeperTestSuite) TestGetAllCounterpartyPayees() {
 	relayerAddr := suite.chainA.SenderAccount.GetAddress().String()
 	counterpartyPayee := suite.chainB.SenderAccount.GetAddress().String()
@@ -290,6 +351,48 @@ func (suite *KeeperTestSuite) TestGetAllCounterpartyPayees() {
 	suite.Require().Equal(counterpartyPayeeAddr, expectedCounterpartyPayee)
 }
 
+func (suite *KeeperTestSuite) TestGetSetAllowedRelayers() {
+	relayer := suite.chainA.SenderAccount.GetAddress()
+	otherRelayer := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	// any relayer is allowed when no restriction is set
+	_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllowedRelayers(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	suite.Require().False(found)
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsRelayerAllowed(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, otherRelayer))
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetAllowedRelayers(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, []string{relayer.String()})
+
+	allowedRelayers, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllowedRelayers(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal([]string{relayer.String()}, allowedRelayers)
+
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsRelayerAllowed(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, relayer))
+	suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsRelayerAllowed(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, otherRelayer))
+
+	// restriction is not applied to other channels
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsRelayerAllowed(suite.chainA.GetContext(), ibctesting.MockFeePort, "channel-1", otherRelayer))
+
+	// setting an empty list removes the restriction
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetAllowedRelayers(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, []string{})
+
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetAllowedRelayers(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	suite.Require().False(found)
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsRelayerAllowed(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, otherRelayer))
+}
+
+func (suite *KeeperTestSuite) TestGetAllAllowedRelayers() {
+	relayer := suite.chainA.SenderAccount.GetAddress().String()
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetAllowedRelayers(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID, []string{relayer})
+
+	expectedAllowedRelayers := []types.AllowedRelayers{
+		types.NewAllowedRelayers(ibctesting.MockFeePort, ibctesting.FirstChannelID, []string{relayer}),
+	}
+
+	allowedRelayers := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllAllowedRelayers(suite.chainA.GetContext())
+	suite.Require().Equal(expectedAllowedRelayers, allowedRelayers)
+}
+
 func (suite *KeeperTestSuite) TestWithICS4Wrapper() {
 	suite.SetupTest()
 
@@ -309,3 +412,17 @@ func (suite *KeeperTestSuite) TestWithICS4Wrapper() {
 	_, isChannelKeeper := ics4Wrapper.(*channelkeeper.Keeper)
 	suite.Require().False(isChannelKeeper)
 }
+
+func (suite *KeeperTestSuite) TestSetHooks() {
+	suite.SetupTest()
+
+	hooks := types.NewMultiFeeHooks(&recordingFeeHooks{}, &recordingFeeHooks{})
+	suite.Require().NotPanics(func() {
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetHooks(hooks)
+	})
+
+	// hooks cannot be set twice
+	suite.Require().Panics(func() {
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetHooks(hooks)
+	})
+}
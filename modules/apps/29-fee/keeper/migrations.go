@@ -38,13 +38,49 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 				return err
 			}
 
-			m.keeper.distributeFee(ctx, refundAddr, refundAddr, refundCoins)
+			m.refundLegacyFee(ctx, refundAddr, refundCoins)
 		}
 	}
 
 	return nil
 }
 
+// refundLegacyFee attempts to refund the given fee to the refund address as the fee distribution of ConsensusVersion 1
+// did. If the refund fails, the state changes are discarded and the error is logged. Fee statistics are not recorded
+// and fee hooks are not called, as neither existed at ConsensusVersion 1.
+func (m Migrator) refundLegacyFee(ctx sdk.Context, refundAddr sdk.AccAddress, fee sdk.Coins) {
+	// cache context before trying to refund the fee
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	if err := m.keeper.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, refundAddr, fee); err != nil {
+		m.keeper.Logger(ctx).Error("error refunding fee to the original sender", "refund address", refundAddr, "fee", fee)
+		return // if sending to the refund address fails, no-op
+	}
+
+	emitDistributeFeeEvent(ctx, refundAddr.String(), fee)
+
+	// write the cache
+	writeFn()
+}
+
+// Migrate2to3 migrates ibc-fee module from ConsensusVersion 2 to 3
+// by setting the default fee module parameters and initializing the fee statistics
+// of each channel from the fees currently held in escrow.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.SetParams(ctx, types.DefaultParams())
+
+	for _, identifiedPacketFees := range m.keeper.GetAllIdentifiedPacketFees(ctx) {
+		var total sdk.Coins
+		for _, packetFee := range identifiedPacketFees.PacketFees {
+			total = total.Add(packetFee.Fee.Total()...)
+		}
+
+		m.keeper.recordFeeEscrowed(ctx, identifiedPacketFees.PacketId, total, true)
+	}
+
+	return nil
+}
+
 // legacyTotal returns the legacy total amount for a given Fee
 // The total amount is the RecvFee + AckFee + TimeoutFee
 func legacyTotal(f types.Fee) sdk.Coins {
@@ -4,6 +4,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/keeper"
@@ -152,6 +153,49 @@ func (suite *KeeperTestSuite) TestMigrate1to2() {
 				suite.Require().Equal(initModuleAccBal.Sub(unusedFee)[0], moduleAccBal)
 			},
 		},
+		{
+			"success: refund to a blocked address fails and the fees are kept in escrow",
+			func() {
+				blockedAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+				packetFees = []types.PacketFee{types.NewPacketFee(fee, blockedAddr.String(), nil)}
+			},
+			func(err error) {
+				suite.Require().NoError(err)
+
+				// module account balance should not change
+				moduleAccBal := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(suite.chainA.GetContext(), moduleAcc)
+				suite.Require().Equal(initModuleAccBal, moduleAccBal)
+
+				// fee statistics are not recorded by the migration
+				stats := suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelFeeStats(suite.chainA.GetContext(), packetID.PortId, packetID.ChannelId)
+				suite.Require().True(stats.TotalRefunded.Empty())
+			},
+		},
+		{
+			"success: refund with insufficient funds in escrow is skipped",
+			func() {
+				packetFees = []types.PacketFee{packetFee}
+
+				// the fees of the second and third packets are stored without being escrowed, the escrow account
+				// only covers the refunds of the first two packets
+				for _, seq := range []uint64{2, 3} {
+					packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, seq)
+					suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees(packetFees))
+				}
+			},
+			func(err error) {
+				suite.Require().NoError(err)
+
+				unusedFee := keeper.LegacyTotal(fee).Sub(packetFee.Fee.Total()...).MulInt(sdkmath.NewInt(2))[0]
+				// refund account balance should increase by the refunds of two packets
+				refundAccBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), refundAcc, sdk.DefaultBondDenom)
+				suite.Require().Equal(initRefundAccBal.Add(unusedFee)[0], refundAccBal)
+
+				// module account balance should be depleted
+				moduleAccBal := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), moduleAcc, sdk.DefaultBondDenom)
+				suite.Require().True(moduleAccBal.IsZero())
+			},
+		},
 		{
 			"failure: invalid refund address",
 			func() {
@@ -199,3 +243,39 @@ func (suite *KeeperTestSuite) TestMigrate1to2() {
 		tc.assert(err)
 	}
 }
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	suite.SetupTest()
+	suite.path.Setup()
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	refundAddr := suite.chainA.SenderAccount.GetAddress().String()
+	portID, channelID := suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID
+
+	// remove the params set at genesis and store escrowed fees directly to mimic a chain prior to the migration
+	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.ModuleName))
+	store.Delete([]byte(types.ParamsKey))
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(
+		suite.chainA.GetContext(),
+		channeltypes.NewPacketID(portID, channelID, 1),
+		types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, refundAddr, nil), types.NewPacketFee(fee, refundAddr, nil)}),
+	)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(
+		suite.chainA.GetContext(),
+		channeltypes.NewPacketID(portID, channelID, 2),
+		types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, refundAddr, nil)}),
+	)
+
+	migrator := keeper.NewMigrator(suite.chainA.GetSimApp().IBCFeeKeeper)
+	err := migrator.Migrate2to3(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(types.DefaultParams(), suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext()))
+
+	stats := suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelFeeStats(suite.chainA.GetContext(), portID, channelID)
+	suite.Require().Equal(fee.Total().MulInt(sdkmath.NewInt(3)), stats.TotalEscrowed)
+	suite.Require().True(stats.TotalDistributed.Empty())
+	suite.Require().True(stats.TotalRefunded.Empty())
+	suite.Require().Equal(uint64(2), stats.IncentivizedPackets)
+}
@@ -49,6 +49,76 @@ func (k Keeper) RegisterPayee(goCtx context.Context, msg *types.MsgRegisterPayee
 	return &types.MsgRegisterPayeeResponse{}, nil
 }
 
+// RegisterDenomPayee defines a rpc handler method for MsgRegisterDenomPayee
+// RegisterDenomPayee is called by the relayer on each channelEnd and allows them to set an optional payee
+// to which the fees of a single denomination are paid out. The denomination payee takes precedence over the payee
+// registered with RegisterPayee for reverse and timeout relaying and over the relayer address for forward relaying.
+// This function may be called more than once by a relayer, in which case, the latest payee is always used.
+func (k Keeper) RegisterDenomPayee(goCtx context.Context, msg *types.MsgRegisterDenomPayee) (*types.MsgRegisterDenomPayeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	payee, err := sdk.AccAddressFromBech32(msg.Payee)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(payee) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not authorized to be a payee", payee)
+	}
+
+	// only register payee address if the channel exists and is fee enabled
+	if _, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId); !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	if !k.IsFeeEnabled(ctx, msg.PortId, msg.ChannelId) {
+		return nil, types.ErrFeeNotEnabled
+	}
+
+	k.SetPayeeAddressForDenom(ctx, msg.Relayer, msg.Payee, msg.ChannelId, msg.Denom)
+
+	k.Logger(ctx).Info("registering denom payee address for relayer", "relayer", msg.Relayer, "payee", msg.Payee, "channel", msg.ChannelId, "denom", msg.Denom)
+
+	emitRegisterDenomPayeeEvent(ctx, msg.Relayer, msg.Payee, msg.ChannelId, msg.Denom)
+
+	return &types.MsgRegisterDenomPayeeResponse{}, nil
+}
+
+// RegisterRefundOverride defines a rpc handler method for MsgRegisterRefundOverride
+// RegisterRefundOverride is called by the refund address of packet fees and allows it to set an optional address on a
+// channel to which the fees escrowed with the refund address are refunded instead, e.g. when fees are escrowed from an
+// omnibus account. The override applies to fees refunded on acknowledgement, timeout and channel closure.
+// This function may be called more than once by a refund address, in which case, the latest override is always used.
+func (k Keeper) RegisterRefundOverride(goCtx context.Context, msg *types.MsgRegisterRefundOverride) (*types.MsgRegisterRefundOverrideResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	overrideAddr, err := sdk.AccAddressFromBech32(msg.OverrideAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(overrideAddr) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not authorized to receive refunds", overrideAddr)
+	}
+
+	// only register the override if the channel exists and is fee enabled
+	if _, found := k.channelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId); !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	if !k.IsFeeEnabled(ctx, msg.PortId, msg.ChannelId) {
+		return nil, types.ErrFeeNotEnabled
+	}
+
+	k.SetRefundAddressOverride(ctx, msg.RefundAddress, msg.OverrideAddress, msg.ChannelId)
+
+	k.Logger(ctx).Info("registering refund address override", "refund-address", msg.RefundAddress, "override-address", msg.OverrideAddress, "channel", msg.ChannelId)
+
+	emitRegisterRefundOverrideEvent(ctx, msg.RefundAddress, msg.OverrideAddress, msg.ChannelId)
+
+	return &types.MsgRegisterRefundOverrideResponse{}, nil
+}
+
 // RegisterCounterpartyPayee defines a rpc handler method for MsgRegisterCounterpartyPayee
 // RegisterCounterpartyPayee is called by the relayer on each channelEnd and allows them to specify the counterparty
 // payee address before relaying. This ensures they will be properly compensated for forward relaying since
@@ -66,11 +136,7 @@ func (k Keeper) RegisterCounterpartyPayee(goCtx context.Context, msg *types.MsgR
 		return nil, types.ErrFeeNotEnabled
 	}
 
-	k.SetCounterpartyPayeeAddress(ctx, msg.Relayer, msg.CounterpartyPayee, msg.ChannelId)
-
-	k.Logger(ctx).Info("registering counterparty payee for relayer", "relayer", msg.Relayer, "counterparty payee", msg.CounterpartyPayee, "channel", msg.ChannelId)
-
-	emitRegisterCounterpartyPayeeEvent(ctx, msg.Relayer, msg.CounterpartyPayee, msg.ChannelId)
+	k.RegisterCounterpartyPayeeAddress(ctx, msg.Relayer, msg.CounterpartyPayee, msg.ChannelId)
 
 	return &types.MsgRegisterCounterpartyPayeeResponse{}, nil
 }
@@ -98,6 +164,10 @@ func (k Keeper) PayPacketFee(goCtx context.Context, msg *types.MsgPayPacketFee)
 		return nil, err
 	}
 
+	if err := k.validateFeeDenomsAccepted(ctx, msg.Fee); err != nil {
+		return nil, err
+	}
+
 	if k.bankKeeper.BlockedAddr(refundAcc) {
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to escrow fees", refundAcc)
 	}
@@ -110,6 +180,8 @@ func (k Keeper) PayPacketFee(goCtx context.Context, msg *types.MsgPayPacketFee)
 
 	packetID := channeltypes.NewPacketID(msg.SourcePortId, msg.SourceChannelId, sequence)
 	packetFee := types.NewPacketFee(msg.Fee, msg.Signer, msg.Relayers)
+	packetFee.BypassPayee = msg.BypassPayee
+	packetFee.OnlyOnSuccess = msg.OnlyOnSuccess
 
 	if err := k.escrowPacketFee(ctx, packetID, packetFee); err != nil {
 		return nil, err
@@ -143,6 +215,10 @@ func (k Keeper) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacket
 		return nil, err
 	}
 
+	if err := k.validateFeeDenomsAccepted(ctx, msg.PacketFee.Fee); err != nil {
+		return nil, err
+	}
+
 	if k.bankKeeper.BlockedAddr(refundAcc) {
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to escrow fees", refundAcc)
 	}
@@ -168,3 +244,138 @@ func (k Keeper) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacket
 
 	return &types.MsgPayPacketFeeAsyncResponse{}, nil
 }
+
+// UpdateAllowedRelayers defines a rpc handler method for MsgUpdateAllowedRelayers
+// UpdateAllowedRelayers sets the relayers which are allowed to be paid fees on the provided channel. Fees which would
+// otherwise be paid to a relayer which is not allowed are refunded to the refund address. An empty list of relayers
+// removes any restriction on the channel.
+func (k Keeper) UpdateAllowedRelayers(goCtx context.Context, msg *types.MsgUpdateAllowedRelayers) (*types.MsgUpdateAllowedRelayersResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.SetAllowedRelayers(ctx, msg.PortId, msg.ChannelId, msg.Relayers)
+
+	k.Logger(ctx).Info("updated allowed relayers", "port", msg.PortId, "channel", msg.ChannelId, "relayers", msg.Relayers)
+
+	return &types.MsgUpdateAllowedRelayersResponse{}, nil
+}
+
+// SetAcceptedFeeDenoms defines a rpc handler method for MsgSetAcceptedFeeDenoms
+// SetAcceptedFeeDenoms replaces the registry of denominations which may be used to pay packet fees.
+// Setting an empty list of denominations allows fees to be paid in any denomination.
+func (k Keeper) SetAcceptedFeeDenoms(goCtx context.Context, msg *types.MsgSetAcceptedFeeDenoms) (*types.MsgSetAcceptedFeeDenomsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.SetAcceptedFeeDenomRegistry(ctx, msg.Denoms)
+
+	emitSetAcceptedFeeDenomsEvent(ctx, msg.Denoms)
+
+	k.Logger(ctx).Info("set accepted fee denoms", "denoms", msg.Denoms)
+
+	return &types.MsgSetAcceptedFeeDenomsResponse{}, nil
+}
+
+// UnlockFeeModule defines a rpc handler method for MsgUnlockFeeModule
+// UnlockFeeModule clears the fee module lock. The escrow account must hold sufficient funds to cover the
+// fee which caused the fee module to be locked.
+func (k Keeper) UnlockFeeModule(goCtx context.Context, msg *types.MsgUnlockFeeModule) (*types.MsgUnlockFeeModuleResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleNotLocked
+	}
+
+	if reason, found := k.GetLockReason(ctx); found && !k.EscrowAccountHasBalance(ctx, reason.Shortfall) {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "escrow account cannot cover the shortfall %s for packet %s", reason.Shortfall, reason.PacketId.String())
+	}
+
+	k.unlockFeeModule(ctx)
+
+	k.Logger(ctx).Info("fee module unlocked")
+
+	return &types.MsgUnlockFeeModuleResponse{}, nil
+}
+
+// UpdateParams defines a rpc handler method for MsgUpdateParams. Updates the 29-fee module's parameters.
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	k.Logger(ctx).Info("updated fee params", "sweep-invalid-refunds", msg.Params.SweepInvalidRefunds, "refund-sink", msg.Params.RefundSink)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// ConvertEscrowedFees defines a rpc handler method for MsgConvertEscrowedFees. Converts the fees escrowed for packets
+// on a channel from one denomination to another at the provided rate, funded by the provided pool module account.
+func (k Keeper) ConvertEscrowedFees(goCtx context.Context, msg *types.MsgConvertEscrowedFees) (*types.MsgConvertEscrowedFeesResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	rate, err := msg.GetConversionRate()
+	if err != nil {
+		return nil, err
+	}
+
+	if k.authKeeper.GetModuleAddress(msg.Pool) == nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "pool module account %s does not exist", msg.Pool)
+	}
+
+	// escrowed fees may only be converted to a denomination which is accepted as a packet fee
+	if !k.IsFeeDenomAccepted(ctx, msg.ToDenom) {
+		return nil, errorsmod.Wrapf(types.ErrFeeDenomNotAccepted, "cannot convert escrowed fees to denom %s", msg.ToDenom)
+	}
+
+	converted, funded, err := k.convertEscrowedFees(ctx, msg.PortId, msg.ChannelId, msg.FromDenom, msg.ToDenom, rate, msg.Pool)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("converted escrowed fees", "port-id", msg.PortId, "channel-id", msg.ChannelId, "converted", converted.String(), "funded", funded.String(), "pool", msg.Pool)
+
+	return &types.MsgConvertEscrowedFeesResponse{}, nil
+}
+
+// RefundFeesOnClientExpiry defines a rpc handler method for MsgRefundFeesOnClientExpiry. Refunds all fees escrowed for
+// packets on a channel once the client of the channel has expired.
+func (k Keeper) RefundFeesOnClientExpiry(goCtx context.Context, msg *types.MsgRefundFeesOnClientExpiry) (*types.MsgRefundFeesOnClientExpiryResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.IsLocked(ctx) {
+		return nil, types.ErrFeeModuleLocked
+	}
+
+	if err := k.refundFeesOnClientExpiry(ctx, msg.PortId, msg.ChannelId); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("refunded escrowed fees on client expiry", "port-id", msg.PortId, "channel-id", msg.ChannelId)
+
+	return &types.MsgRefundFeesOnClientExpiryResponse{}, nil
+}
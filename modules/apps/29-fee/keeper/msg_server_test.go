@@ -2,16 +2,27 @@ package keeper_test
 
 import (
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
 )
@@ -108,6 +119,202 @@ func (suite *KeeperTestSuite) TestRegisterPayee() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRegisterDenomPayee() {
+	var msg *types.MsgRegisterDenomPayee
+
+	testCases := []struct {
+		name     string
+		expPass  bool
+		malleate func()
+	}{
+		{
+			"success",
+			true,
+			func() {},
+		},
+		{
+			"success: denom containing path separators",
+			true,
+			func() {
+				msg.Denom = "transfer/channel-1/uatom"
+			},
+		},
+		{
+			"channel does not exist",
+			false,
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+		},
+		{
+			"channel is not fee enabled",
+			false,
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			},
+		},
+		{
+			"given payee is not an sdk address",
+			false,
+			func() {
+				msg.Payee = "invalid-addr"
+			},
+		},
+		{
+			"payee is a blocked address",
+			false,
+			func() {
+				msg.Payee = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(transfertypes.ModuleName).String()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.SetupTest()
+		suite.path.Setup()
+
+		msg = types.NewMsgRegisterDenomPayee(
+			suite.path.EndpointA.ChannelConfig.PortID,
+			suite.path.EndpointA.ChannelID,
+			suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String(),
+			suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(),
+			sdk.DefaultBondDenom,
+		)
+
+		tc.malleate()
+
+		ctx := suite.chainA.GetContext()
+		res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RegisterDenomPayee(ctx, msg)
+
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().NotNil(res)
+
+			payeeAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddressForDenom(
+				suite.chainA.GetContext(),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.path.EndpointA.ChannelID,
+				msg.Denom,
+			)
+
+			suite.Require().True(found)
+			suite.Require().Equal(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), payeeAddr)
+
+			// the payee is only registered for the given denomination
+			_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress().String(), suite.path.EndpointA.ChannelID)
+			suite.Require().False(found)
+
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeRegisterDenomPayee,
+					sdk.NewAttribute(types.AttributeKeyRelayer, suite.chainA.SenderAccount.GetAddress().String()),
+					sdk.NewAttribute(types.AttributeKeyPayee, payeeAddr),
+					sdk.NewAttribute(types.AttributeKeyChannelID, suite.path.EndpointA.ChannelID),
+					sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+				),
+			}.ToABCIEvents()
+
+			expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+			ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterRefundOverride() {
+	var msg *types.MsgRegisterRefundOverride
+
+	testCases := []struct {
+		name     string
+		expPass  bool
+		malleate func()
+	}{
+		{
+			"success",
+			true,
+			func() {},
+		},
+		{
+			"channel does not exist",
+			false,
+			func() {
+				msg.ChannelId = "channel-100"
+			},
+		},
+		{
+			"channel is not fee enabled",
+			false,
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			},
+		},
+		{
+			"given override address is not an sdk address",
+			false,
+			func() {
+				msg.OverrideAddress = "invalid-addr"
+			},
+		},
+		{
+			"override address is a blocked address",
+			false,
+			func() {
+				msg.OverrideAddress = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(transfertypes.ModuleName).String()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.SetupTest()
+		suite.path.Setup()
+
+		msg = types.NewMsgRegisterRefundOverride(
+			suite.path.EndpointA.ChannelConfig.PortID,
+			suite.path.EndpointA.ChannelID,
+			suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String(),
+			suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(),
+		)
+
+		tc.malleate()
+
+		ctx := suite.chainA.GetContext()
+		res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RegisterRefundOverride(ctx, msg)
+
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+			suite.Require().NotNil(res)
+
+			overrideAddr, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRefundAddressOverride(
+				suite.chainA.GetContext(),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.path.EndpointA.ChannelID,
+			)
+
+			suite.Require().True(found)
+			suite.Require().Equal(suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), overrideAddr)
+
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					types.EventTypeRegisterRefundOverride,
+					sdk.NewAttribute(types.AttributeKeyRefundAddress, suite.chainA.SenderAccount.GetAddress().String()),
+					sdk.NewAttribute(types.AttributeKeyOverrideAddress, overrideAddr),
+					sdk.NewAttribute(types.AttributeKeyChannelID, suite.path.EndpointA.ChannelID),
+				),
+			}.ToABCIEvents()
+
+			expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+			ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
 func (suite *KeeperTestSuite) TestRegisterCounterpartyPayee() {
 	var (
 		msg                  *types.MsgRegisterCounterpartyPayee
@@ -237,6 +444,22 @@ func (suite *KeeperTestSuite) TestPayPacketFee() {
 			},
 			true,
 		},
+		{
+			"success with bypass payee",
+			func() {
+				msg.BypassPayee = true
+				expFeesInEscrow[0].BypassPayee = true
+			},
+			true,
+		},
+		{
+			"success with only on success",
+			func() {
+				msg.OnlyOnSuccess = true
+				expFeesInEscrow[0].OnlyOnSuccess = true
+			},
+			true,
+		},
 		{
 			"bank send enabled for fee denom",
 			func() {
@@ -249,6 +472,13 @@ func (suite *KeeperTestSuite) TestPayPacketFee() {
 			},
 			true,
 		},
+		{
+			"success: fee denom is in accepted fee denoms",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAcceptedFeeDenomRegistry(suite.chainA.GetContext(), []string{sdk.DefaultBondDenom, "atom"})
+			},
+			true,
+		},
 		{
 			"refund account is module account",
 			func() {
@@ -308,6 +538,13 @@ func (suite *KeeperTestSuite) TestPayPacketFee() {
 			},
 			false,
 		},
+		{
+			"fee denom is not in accepted fee denoms",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAcceptedFeeDenomRegistry(suite.chainA.GetContext(), []string{"atom"})
+			},
+			false,
+		},
 		{
 			"acknowledgement fee balance not found",
 			func() {
@@ -393,6 +630,92 @@ func (suite *KeeperTestSuite) TestPayPacketFee() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestPayPacketFeeVestingAccount() {
+	var (
+		fee        types.Fee
+		vestingAcc sdk.AccAddress
+	)
+
+	fundAccount := func(addr sdk.AccAddress, coins sdk.Coins) {
+		err := suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), minttypes.ModuleName, coins)
+		suite.Require().NoError(err)
+		err = suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), minttypes.ModuleName, addr, coins)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: spendable balance covers fees",
+			func() {
+				fundAccount(vestingAcc, fee.Total())
+			},
+			nil,
+		},
+		{
+			"success: vesting coins have vested",
+			func() {
+				suite.coordinator.IncrementTimeBy(2 * time.Hour)
+			},
+			nil,
+		},
+		{
+			"failure: fees cannot be covered by unvested coins",
+			func() {},
+			types.ErrInsufficientSpendableFees,
+		},
+		{
+			"failure: spendable balance partially covers fees",
+			func() {
+				fundAccount(vestingAcc, defaultRecvFee)
+			},
+			types.ErrInsufficientSpendableFees,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup() // setup channel
+
+			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+			// create a delayed vesting account holding the fee total as unvested coins
+			vestingAcc = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			baseAcc := authtypes.NewBaseAccountWithAddress(vestingAcc)
+			endTime := suite.chainA.GetContext().BlockTime().Add(time.Hour).Unix()
+			acc, err := vestingtypes.NewDelayedVestingAccount(baseAcc, fee.Total(), endTime)
+			suite.Require().NoError(err)
+
+			accountKeeper := suite.chainA.GetSimApp().AccountKeeper
+			accountKeeper.SetAccount(suite.chainA.GetContext(), accountKeeper.NewAccount(suite.chainA.GetContext(), acc))
+			fundAccount(vestingAcc, fee.Total())
+
+			msg := types.NewMsgPayPacketFee(fee, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, vestingAcc.String(), nil)
+
+			tc.malleate()
+
+			_, err = suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFee(suite.chainA.GetContext(), msg)
+
+			escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(fee.Total().AmountOf(sdk.DefaultBondDenom), escrowBalance.Amount)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal(sdkmath.ZeroInt(), escrowBalance.Amount)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 	var (
 		packet           channeltypes.Packet
@@ -442,31 +765,60 @@ func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 			true,
 		},
 		{
-			"fee module is locked",
-			func() {
-				lockFeeModule(suite.chainA)
-			},
-			false,
-		},
-		{
-			"fee module disabled on channel",
+			"success: fee denom is in accepted fee denoms",
 			func() {
-				msg.PacketId.PortId = "invalid-port"
-				msg.PacketId.ChannelId = "invalid-channel"
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAcceptedFeeDenomRegistry(suite.chainA.GetContext(), []string{sdk.DefaultBondDenom, "atom"})
 			},
-			false,
+			true,
 		},
 		{
-			"channel does not exist",
+			"success: packet fees at maximum packet fees per packet",
 			func() {
-				msg.PacketId.ChannelId = "channel-100"
+				fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
 
-				// to test this functionality, we must set the fee to enabled for this non existent channel
-				// NOTE: the channel doesn't exist in 04-channel keeper, but we will add a mapping within ics29 anyways
-				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEnabled(suite.chainA.GetContext(), msg.PacketId.PortId, msg.PacketId.ChannelId)
-			},
-			false,
-		},
+				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+				packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+				feesInEscrow := types.NewPacketFees([]types.PacketFee{packetFee})
+
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, feesInEscrow)
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total())
+				suite.Require().NoError(err)
+
+				params := suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext())
+				params.MaxPacketFeesPerPacket = 2
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				expEscrowBalance = expEscrowBalance.Add(fee.Total()...)
+				expFeesInEscrow = append(expFeesInEscrow, packetFee)
+			},
+			true,
+		},
+		{
+			"fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			false,
+		},
+		{
+			"fee module disabled on channel",
+			func() {
+				msg.PacketId.PortId = "invalid-port"
+				msg.PacketId.ChannelId = "invalid-channel"
+			},
+			false,
+		},
+		{
+			"channel does not exist",
+			func() {
+				msg.PacketId.ChannelId = "channel-100"
+
+				// to test this functionality, we must set the fee to enabled for this non existent channel
+				// NOTE: the channel doesn't exist in 04-channel keeper, but we will add a mapping within ics29 anyways
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeeEnabled(suite.chainA.GetContext(), msg.PacketId.PortId, msg.PacketId.ChannelId)
+			},
+			false,
+		},
 		{
 			"packet not sent",
 			func() {
@@ -538,6 +890,26 @@ func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 			},
 			false,
 		},
+		{
+			"fee denom is not in accepted fee denoms",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAcceptedFeeDenomRegistry(suite.chainA.GetContext(), []string{"atom"})
+			},
+			false,
+		},
+		{
+			"packet fees exceed maximum packet fees per packet",
+			func() {
+				fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+				packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), msg.PacketId, types.NewPacketFees([]types.PacketFee{packetFee}))
+
+				params := suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(suite.chainA.GetContext())
+				params.MaxPacketFeesPerPacket = 1
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			false,
+		},
 		{
 			"acknowledgement fee balance not found",
 			func() {
@@ -605,3 +977,615 @@ func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateAllowedRelayers() {
+	var msg *types.MsgUpdateAllowedRelayers
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: empty list of relayers removes restriction",
+			func() {
+				msg.Relayers = []string{}
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg = types.NewMsgUpdateAllowedRelayers(
+				suite.chainA.GetSimApp().IBCFeeKeeper.GetAuthority(),
+				ibctesting.MockFeePort,
+				ibctesting.FirstChannelID,
+				[]string{suite.chainA.SenderAccount.GetAddress().String()},
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.UpdateAllowedRelayers(ctx, msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				allowedRelayers, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetAllowedRelayers(ctx, ibctesting.MockFeePort, ibctesting.FirstChannelID)
+				suite.Require().Equal(len(msg.Relayers) != 0, found)
+				if found {
+					suite.Require().Equal(msg.Relayers, allowedRelayers)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetAcceptedFeeDenoms() {
+	var msg *types.MsgSetAcceptedFeeDenoms
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: existing accepted fee denoms are replaced",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAcceptedFeeDenomRegistry(suite.chainA.GetContext(), []string{"atom", "uosmo"})
+			},
+			nil,
+		},
+		{
+			"success: empty list of denoms accepts any denom",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAcceptedFeeDenomRegistry(suite.chainA.GetContext(), []string{"atom"})
+				msg.Denoms = []string{}
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg = types.NewMsgSetAcceptedFeeDenoms(
+				suite.chainA.GetSimApp().IBCFeeKeeper.GetAuthority(),
+				[]string{sdk.DefaultBondDenom, "atom"},
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.SetAcceptedFeeDenoms(ctx, msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				acceptedFeeDenoms := suite.chainA.GetSimApp().IBCFeeKeeper.GetAcceptedFeeDenoms(ctx)
+				suite.Require().ElementsMatch(msg.Denoms, acceptedFeeDenoms)
+
+				for _, denom := range []string{sdk.DefaultBondDenom, "atom", "uosmo"} {
+					expAccepted := len(msg.Denoms) == 0 || slices.Contains(msg.Denoms, denom)
+					suite.Require().Equal(expAccepted, suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeDenomAccepted(ctx, denom))
+				}
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						types.EventTypeSetAcceptedFeeDenoms,
+						sdk.NewAttribute(types.AttributeKeyDenoms, strings.Join(msg.Denoms, ",")),
+					),
+				}.ToABCIEvents()
+
+				expectedEvents = sdk.MarkEventsToIndex(expectedEvents, map[string]struct{}{})
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUnlockFeeModule() {
+	var (
+		msg *types.MsgUnlockFeeModule
+		fee types.Fee
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: no lock reason recorded",
+			func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.ModuleName))
+				store.Delete(types.KeyLockReason())
+
+				// remove the funds resolving the shortfall to show they are not required without a lock reason
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), types.ModuleName, suite.chainA.SenderAccount.GetAddress(), fee.Total())
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: fee module is not locked",
+			func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.ModuleName))
+				store.Delete(types.KeyLocked())
+			},
+			types.ErrFeeModuleNotLocked,
+		},
+		{
+			"failure: shortfall has not been resolved",
+			func() {
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), types.ModuleName, suite.chainA.SenderAccount.GetAddress(), fee.Total())
+				suite.Require().NoError(err)
+			},
+			ibcerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// lock the fee module by refunding a fee which is not covered by the escrow account
+			packetID := channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{
+				types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil),
+			}))
+
+			err := suite.chainA.GetSimApp().IBCFeeKeeper.RefundFeesOnChannelClosure(suite.chainA.GetContext(), ibctesting.MockFeePort, ibctesting.FirstChannelID)
+			suite.Require().NoError(err)
+			suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+
+			// resolve the shortfall by funding the escrow account
+			err = suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total())
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgUnlockFeeModule(suite.chainA.GetSimApp().IBCFeeKeeper.GetAuthority())
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.UnlockFeeModule(ctx, msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(ctx))
+				_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetLockReason(ctx)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	validAuthority := suite.chainA.GetSimApp().IBCFeeKeeper.GetAuthority()
+	params := types.NewParams(true, suite.chainA.SenderAccount.GetAddress().String())
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgUpdateParams(validAuthority, params),
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			types.NewMsgUpdateParams(suite.chainA.SenderAccount.GetAddress().String(), params),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.UpdateParams(ctx, tc.msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(tc.msg.Params, suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(ctx))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				suite.Require().Equal(types.DefaultParams(), suite.chainA.GetSimApp().IBCFeeKeeper.GetParams(ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvertEscrowedFees() {
+	const (
+		toDenom    = "ufoo"
+		otherDenom = "ubar"
+	)
+
+	var (
+		msg         *types.MsgConvertEscrowedFees
+		fee         types.Fee
+		otherFee    types.Fee
+		packetID    channeltypes.PacketId
+		packetIDTwo channeltypes.PacketId
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: fee module is locked",
+			func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.ModuleName))
+				store.Set(types.KeyLocked(), []byte{1})
+			},
+			types.ErrFeeModuleLocked,
+		},
+		{
+			"failure: pool module account does not exist",
+			func() {
+				msg.Pool = "unknown"
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"success: converted denom is accepted as a fee",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAcceptedFeeDenomRegistry(suite.chainA.GetContext(), []string{sdk.DefaultBondDenom, toDenom})
+			},
+			nil,
+		},
+		{
+			"failure: converted denom is not accepted as a fee",
+			func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetAcceptedFeeDenomRegistry(suite.chainA.GetContext(), []string{sdk.DefaultBondDenom})
+			},
+			types.ErrFeeDenomNotAccepted,
+		},
+		{
+			"failure: no fees escrowed in denom",
+			func() {
+				msg.FromDenom = "uunknown"
+			},
+			types.ErrNoFeesToConvert,
+		},
+		{
+			"failure: no fees escrowed on channel",
+			func() {
+				msg.ChannelId = ibctesting.InvalidID
+			},
+			types.ErrNoFeesToConvert,
+		},
+		{
+			"failure: converted fee truncates to zero",
+			func() {
+				msg.Rate = "0.001"
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: pool cannot fund converted fees",
+			func() {
+				msg.Rate = "100"
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+		{
+			"failure: escrow account cannot cover converted fees",
+			func() {
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), types.ModuleName, suite.chainA.SenderAccount.GetAddress(), fee.Total())
+				suite.Require().NoError(err)
+			},
+			ibcerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			refundAddr := suite.chainA.SenderAccount.GetAddress().String()
+			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			otherFee = types.NewFee(sdk.NewCoins(sdk.NewCoin(otherDenom, sdkmath.NewInt(50))), nil, nil)
+
+			// escrow a fee for the first packet and a fee in the converted denom as well as a fee in another denom for the second packet
+			packetID = channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+			packetIDTwo = channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 2)
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{
+				types.NewPacketFee(fee, refundAddr, nil),
+			}))
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetIDTwo, types.NewPacketFees([]types.PacketFee{
+				types.NewPacketFee(fee, refundAddr, nil),
+				types.NewPacketFee(otherFee, refundAddr, nil),
+			}))
+
+			escrowed := fee.Total().MulInt(sdkmath.NewInt(2)).Add(otherFee.Total()...)
+			err := suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), minttypes.ModuleName, escrowed)
+			suite.Require().NoError(err)
+			err = suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToModule(suite.chainA.GetContext(), minttypes.ModuleName, types.ModuleName, escrowed)
+			suite.Require().NoError(err)
+
+			// fund the pool with the denom the fees are converted to
+			poolFunds := sdk.NewCoins(sdk.NewCoin(toDenom, sdkmath.NewInt(10_000)))
+			err = suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), minttypes.ModuleName, poolFunds)
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgConvertEscrowedFees(
+				ibctesting.MockFeePort, ibctesting.FirstChannelID, sdk.DefaultBondDenom, toDenom, "1.5",
+				minttypes.ModuleName, suite.chainA.GetSimApp().IBCFeeKeeper.GetAuthority(),
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.ConvertEscrowedFees(ctx, msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				expFee := types.NewFee(
+					sdk.NewCoins(sdk.NewCoin(toDenom, sdkmath.NewInt(150))),
+					sdk.NewCoins(sdk.NewCoin(toDenom, sdkmath.NewInt(300))),
+					sdk.NewCoins(sdk.NewCoin(toDenom, sdkmath.NewInt(450))),
+				)
+
+				packetFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewPacketFees([]types.PacketFee{types.NewPacketFee(expFee, refundAddr, nil)}), packetFees)
+
+				packetFees, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(ctx, packetIDTwo)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewPacketFees([]types.PacketFee{
+					types.NewPacketFee(expFee, refundAddr, nil),
+					types.NewPacketFee(otherFee, refundAddr, nil),
+				}), packetFees)
+
+				// the escrow account balance matches the total of the fees in escrow
+				var totalEscrowed sdk.Coins
+				for _, identifiedPacketFees := range suite.chainA.GetSimApp().IBCFeeKeeper.GetAllIdentifiedPacketFees(ctx) {
+					for _, packetFee := range identifiedPacketFees.PacketFees {
+						totalEscrowed = totalEscrowed.Add(packetFee.Fee.Total()...)
+					}
+				}
+
+				escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress())
+				suite.Require().Equal(totalEscrowed.String(), escrowBalance.String())
+				suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(toDenom, sdkmath.NewInt(900)), sdk.NewCoin(otherDenom, sdkmath.NewInt(50))).String(), escrowBalance.String())
+
+				// the pool received the converted fees and funded the fees in the converted denom
+				poolAddr := suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+				suite.Require().Equal(sdkmath.NewInt(600).String(), suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, poolAddr, sdk.DefaultBondDenom).Amount.String())
+				suite.Require().Equal(sdkmath.NewInt(9100).String(), suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, poolAddr, toDenom).Amount.String())
+
+				// the converted fees are accounted for as refunded and the funded fees as escrowed
+				stats := suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelFeeStats(ctx, ibctesting.MockFeePort, ibctesting.FirstChannelID)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(600))), stats.TotalRefunded)
+				suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(toDenom, sdkmath.NewInt(900))), stats.TotalEscrowed)
+
+				var convertEvents []sdk.Event
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.EventTypeConvertEscrowedFee {
+						convertEvents = append(convertEvents, event)
+					}
+				}
+				suite.Require().Len(convertEvents, 2)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundFeesOnClientExpiry() {
+	var (
+		msg          *types.MsgRefundFeesOnClientExpiry
+		feeKeeper    keeper.Keeper
+		expireClient bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: fee module is locked",
+			func() {
+				lockFeeModule(suite.chainA)
+			},
+			types.ErrFeeModuleLocked,
+		},
+		{
+			"failure: client keeper is not configured",
+			func() {
+				feeKeeper.WithClientKeeper(nil)
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				msg.ChannelId = ibctesting.InvalidID
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+		{
+			"failure: client is not expired",
+			func() {
+				expireClient = false
+			},
+			types.ErrClientNotExpired,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup()
+
+			refundAcc := suite.chainA.SenderAccount.GetAddress()
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+			// escrow fees for packets on the channel and for a packet on a different channel
+			for i := 1; i < 6; i++ {
+				packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, uint64(i))
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), packetID, types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, refundAcc.String(), nil)}))
+
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAcc, types.ModuleName, fee.Total())
+				suite.Require().NoError(err)
+			}
+
+			otherPacketID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID, 1)
+			otherPacketFees := types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, refundAcc.String(), nil)})
+			suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), otherPacketID, otherPacketFees)
+
+			err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), refundAcc, types.ModuleName, fee.Total())
+			suite.Require().NoError(err)
+
+			feeKeeper = suite.chainA.GetSimApp().IBCFeeKeeper
+			expireClient = true
+			msg = types.NewMsgRefundFeesOnClientExpiry(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, feeKeeper.GetAuthority())
+
+			tc.malleate()
+
+			if expireClient {
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+				suite.Require().Equal(exported.Expired, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), suite.path.EndpointA.ClientID))
+			}
+
+			ctx := suite.chainA.GetContext()
+			refundBal := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAcc)
+			escrowBal := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, feeKeeper.GetFeeModuleAddress())
+
+			res, err := feeKeeper.RefundFeesOnClientExpiry(ctx, msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				// the fees escrowed on the channel are refunded and cleared from escrow
+				refunded := fee.Total().MulInt(sdkmath.NewInt(5))
+				suite.Require().Empty(feeKeeper.GetIdentifiedPacketFeesForChannel(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID))
+				suite.Require().Equal(refundBal.Add(refunded...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAcc))
+				suite.Require().Equal(escrowBal.Sub(refunded...), suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, feeKeeper.GetFeeModuleAddress()))
+
+				// the fees escrowed on other channels remain in escrow
+				packetFees, found := feeKeeper.GetFeesInEscrow(ctx, otherPacketID)
+				suite.Require().True(found)
+				suite.Require().Equal(otherPacketFees, packetFees)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+
+				// no fees are refunded
+				suite.Require().Len(feeKeeper.GetIdentifiedPacketFeesForChannel(ctx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID), 5)
+				suite.Require().Equal(refundBal, suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, refundAcc))
+			}
+		})
+	}
+}
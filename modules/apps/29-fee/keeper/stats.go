@@ -0,0 +1,309 @@
+package keeper
+
+import (
+	"sort"
+	"strconv"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// GetChannelFeeStats returns the aggregate fee statistics of the given channel. Zero statistics are returned if no
+// fees have been escrowed, distributed or refunded on the channel.
+func (k Keeper) GetChannelFeeStats(ctx sdk.Context, portID, channelID string) types.ChannelFeeStats {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyChannelFeeStats(portID, channelID))
+	if len(bz) == 0 {
+		return types.NewChannelFeeStats(portID, channelID)
+	}
+
+	var stats types.ChannelFeeStats
+	k.cdc.MustUnmarshal(bz, &stats)
+
+	return stats
+}
+
+// SetChannelFeeStats stores the aggregate fee statistics of a channel
+func (k Keeper) SetChannelFeeStats(ctx sdk.Context, stats types.ChannelFeeStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyChannelFeeStats(stats.PortId, stats.ChannelId), k.cdc.MustMarshal(&stats))
+}
+
+// GetAllChannelFeeStats returns the aggregate fee statistics of all channels
+func (k Keeper) GetAllChannelFeeStats(ctx sdk.Context) []types.ChannelFeeStats {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.ChannelFeeStatsPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var channelFeeStats []types.ChannelFeeStats
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.ChannelFeeStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+
+		channelFeeStats = append(channelFeeStats, stats)
+	}
+
+	return channelFeeStats
+}
+
+// recordFeeEscrowed adds the escrowed fee to the fee statistics of the packet channel. The incentivized packets
+// counter is incremented if the fee is the first fee escrowed for the packet.
+func (k Keeper) recordFeeEscrowed(ctx sdk.Context, packetID channeltypes.PacketId, fee sdk.Coins, firstFee bool) {
+	stats := k.GetChannelFeeStats(ctx, packetID.PortId, packetID.ChannelId)
+	stats.TotalEscrowed = stats.TotalEscrowed.Add(fee...)
+	if firstFee {
+		stats.IncentivizedPackets++
+	}
+
+	k.SetChannelFeeStats(ctx, stats)
+}
+
+// recordFeeDistributed adds the fee paid to a relayer or its payee to the fee statistics of the packet channel
+func (k Keeper) recordFeeDistributed(ctx sdk.Context, packetID channeltypes.PacketId, fee sdk.Coins) {
+	if fee.IsZero() {
+		return
+	}
+
+	stats := k.GetChannelFeeStats(ctx, packetID.PortId, packetID.ChannelId)
+	stats.TotalDistributed = stats.TotalDistributed.Add(fee...)
+	k.SetChannelFeeStats(ctx, stats)
+}
+
+// recordFeeRefunded adds the fee returned to a refund address, or swept on channel closure, to the fee statistics
+// of the packet channel
+func (k Keeper) recordFeeRefunded(ctx sdk.Context, packetID channeltypes.PacketId, fee sdk.Coins) {
+	if fee.IsZero() {
+		return
+	}
+
+	stats := k.GetChannelFeeStats(ctx, packetID.PortId, packetID.ChannelId)
+	stats.TotalRefunded = stats.TotalRefunded.Add(fee...)
+	k.SetChannelFeeStats(ctx, stats)
+}
+
+// recordFeeConverted records the conversion of escrowed fees on the given channel in its fee statistics. The converted
+// fees are returned to the pool and accounted for as refunded, while the fees funded by the pool are accounted for
+// as escrowed.
+func (k Keeper) recordFeeConverted(ctx sdk.Context, portID, channelID string, converted, funded sdk.Coin) {
+	stats := k.GetChannelFeeStats(ctx, portID, channelID)
+	stats.TotalRefunded = stats.TotalRefunded.Add(converted)
+	stats.TotalEscrowed = stats.TotalEscrowed.Add(funded)
+	k.SetChannelFeeStats(ctx, stats)
+}
+
+// recordFeeDistributedToPayee adds the fee paid to the given payee to the distributed fee record of the payee at the
+// current block height. Nothing is recorded if the distributed fee retention period is set to zero.
+func (k Keeper) recordFeeDistributedToPayee(ctx sdk.Context, payee sdk.AccAddress, fee sdk.Coins) {
+	if fee.IsZero() || k.GetParams(ctx).DistributedFeeRetentionPeriod == 0 {
+		return
+	}
+
+	height := uint64(ctx.BlockHeight())
+	record, found := k.GetDistributedFeeRecord(ctx, height, payee.String())
+	if !found {
+		record = types.NewDistributedFeeRecord(height, payee.String(), sdk.NewCoins())
+	}
+
+	record.Fee = record.Fee.Add(fee...)
+	k.SetDistributedFeeRecord(ctx, record)
+}
+
+// GetDistributedFeeRecord returns the record of the fees distributed to the given payee at the given block height
+func (k Keeper) GetDistributedFeeRecord(ctx sdk.Context, height uint64, payee string) (types.DistributedFeeRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyDistributedFeeRecord(height, payee))
+	if len(bz) == 0 {
+		return types.DistributedFeeRecord{}, false
+	}
+
+	var record types.DistributedFeeRecord
+	k.cdc.MustUnmarshal(bz, &record)
+
+	return record, true
+}
+
+// SetDistributedFeeRecord stores the record of the fees distributed to a payee at a block height and indexes it
+// by payee
+func (k Keeper) SetDistributedFeeRecord(ctx sdk.Context, record types.DistributedFeeRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyDistributedFeeRecord(record.Height, record.Payee), k.cdc.MustMarshal(&record))
+	k.setDistributedFeePayeeRecordIndex(ctx, record.Payee, record.Height)
+}
+
+// setDistributedFeePayeeRecordIndex indexes the record of the fees distributed to a payee at a block height by payee
+func (k Keeper) setDistributedFeePayeeRecordIndex(ctx sdk.Context, payee string, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyDistributedFeePayee(payee), []byte{1})
+	store.Set(types.KeyDistributedFeePayeeRecord(payee, height), []byte{1})
+}
+
+// deleteDistributedFeeRecord deletes the record of the fees distributed to a payee at a block height along with its
+// index entry. The payee is removed from the set of payees once its last record is deleted.
+func (k Keeper) deleteDistributedFeeRecord(ctx sdk.Context, record types.DistributedFeeRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyDistributedFeeRecord(record.Height, record.Payee))
+	store.Delete(types.KeyDistributedFeePayeeRecord(record.Payee, record.Height))
+
+	if !k.hasDistributedFeePayeeRecords(ctx, record.Payee) {
+		store.Delete(types.KeyDistributedFeePayee(record.Payee))
+	}
+}
+
+// hasDistributedFeePayeeRecords returns true if any distributed fee record of the given payee is indexed
+func (k Keeper) hasDistributedFeePayeeRecords(ctx sdk.Context, payee string) bool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyDistributedFeePayeeRecordPrefix(payee))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	return iterator.Valid()
+}
+
+// IterateDistributedFeeRecords iterates over the distributed fee records of the block heights in the inclusive range
+// [startHeight, endHeight] in ascending order of height and calls the provided callback for each of them, until
+// stop=true is returned.
+func (k Keeper) IterateDistributedFeeRecords(ctx sdk.Context, startHeight, endHeight uint64, cb func(record types.DistributedFeeRecord) (stop bool)) {
+	if startHeight > endHeight {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	var end []byte
+	if endHeight < ^uint64(0) {
+		end = types.KeyDistributedFeeRecordHeightPrefix(endHeight + 1)
+	} else {
+		end = storetypes.PrefixEndBytes([]byte(types.DistributedFeeRecordPrefix + "/"))
+	}
+
+	iterator := store.Iterator(types.KeyDistributedFeeRecordHeightPrefix(startHeight), end)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.DistributedFeeRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetAllDistributedFeeRecords returns all the stored distributed fee records in ascending order of height
+func (k Keeper) GetAllDistributedFeeRecords(ctx sdk.Context) []types.DistributedFeeRecord {
+	var records []types.DistributedFeeRecord
+	k.IterateDistributedFeeRecords(ctx, 0, ^uint64(0), func(record types.DistributedFeeRecord) bool {
+		records = append(records, record)
+		return false
+	})
+
+	return records
+}
+
+// GetDistributedFeesInRange returns the total fees distributed to each payee in the inclusive block height range
+// [startHeight, endHeight], sorted by payee address. Only block heights within the distributed fee retention period
+// are accounted for, as older records are pruned.
+func (k Keeper) GetDistributedFeesInRange(ctx sdk.Context, startHeight, endHeight uint64) []types.PayeeFees {
+	totals := make(map[string]sdk.Coins)
+	k.IterateDistributedFeeRecords(ctx, startHeight, endHeight, func(record types.DistributedFeeRecord) bool {
+		totals[record.Payee] = totals[record.Payee].Add(record.Fee...)
+		return false
+	})
+
+	payeeFees := make([]types.PayeeFees, 0, len(totals))
+	for payee, totalFees := range totals {
+		payeeFees = append(payeeFees, types.PayeeFees{Payee: payee, TotalFees: totalFees})
+	}
+
+	sort.Slice(payeeFees, func(i, j int) bool {
+		return payeeFees[i].Payee < payeeFees[j].Payee
+	})
+
+	return payeeFees
+}
+
+// GetPayeeDistributedFeesInRange returns the total fees distributed to the given payee in the inclusive block height
+// range [startHeight, endHeight]. Only the records of the payee are iterated using the payee index.
+func (k Keeper) GetPayeeDistributedFeesInRange(ctx sdk.Context, payee string, startHeight, endHeight uint64) sdk.Coins {
+	if startHeight > endHeight {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	var end []byte
+	if endHeight < ^uint64(0) {
+		end = types.KeyDistributedFeePayeeRecord(payee, endHeight+1)
+	} else {
+		end = storetypes.PrefixEndBytes(types.KeyDistributedFeePayeeRecordPrefix(payee))
+	}
+
+	iterator := store.Iterator(types.KeyDistributedFeePayeeRecord(payee, startHeight), end)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var total sdk.Coins
+	for ; iterator.Valid(); iterator.Next() {
+		height, err := strconv.ParseUint(string(iterator.Key()[len(types.KeyDistributedFeePayeeRecordPrefix(payee)):]), 10, 64)
+		if err != nil {
+			panic(err)
+		}
+
+		record, found := k.GetDistributedFeeRecord(ctx, height, payee)
+		if !found {
+			continue
+		}
+
+		total = total.Add(record.Fee...)
+	}
+
+	return total
+}
+
+// PruneDistributedFeeRecords deletes up to MaxDistributedFeeRecordsPrunedPerBlock distributed fee records which are
+// older than the distributed fee retention period, resuming at the height of the last record pruned in a previous
+// block. All records are deleted if the retention period is set to zero. It is called in EndBlock.
+func (k Keeper) PruneDistributedFeeRecords(ctx sdk.Context) {
+	retentionPeriod := k.GetParams(ctx).DistributedFeeRetentionPeriod
+	height := uint64(ctx.BlockHeight())
+
+	var expiredRecords []types.DistributedFeeRecord
+	k.IterateDistributedFeeRecords(ctx, k.getDistributedFeeRecordPruneCursor(ctx), ^uint64(0), func(record types.DistributedFeeRecord) bool {
+		if len(expiredRecords) == types.MaxDistributedFeeRecordsPrunedPerBlock {
+			return true
+		}
+
+		if retentionPeriod != 0 && record.Height+retentionPeriod > height {
+			return true
+		}
+
+		expiredRecords = append(expiredRecords, record)
+		return false
+	})
+
+	for _, record := range expiredRecords {
+		k.deleteDistributedFeeRecord(ctx, record)
+	}
+
+	if len(expiredRecords) != 0 {
+		k.setDistributedFeeRecordPruneCursor(ctx, expiredRecords[len(expiredRecords)-1].Height)
+	}
+}
+
+// getDistributedFeeRecordPruneCursor returns the height of the last distributed fee record pruned. All records at
+// lower heights have been pruned, as records are pruned in ascending order of height.
+func (k Keeper) getDistributedFeeRecordPruneCursor(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.DistributedFeeRecordPruneCursorKey))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setDistributedFeeRecordPruneCursor stores the height of the last distributed fee record pruned
+func (k Keeper) setDistributedFeeRecordPruneCursor(ctx sdk.Context, height uint64) {
+	ctx.KVStore(k.storeKey).Set([]byte(types.DistributedFeeRecordPruneCursorKey), sdk.Uint64ToBigEndian(height))
+}
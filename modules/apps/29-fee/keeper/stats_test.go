@@ -0,0 +1,262 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+)
+
+func (suite *KeeperTestSuite) TestChannelFeeStats() {
+	var (
+		packet channeltypes.Packet
+		fee    types.Fee
+	)
+
+	testCases := []struct {
+		name             string
+		relay            func()
+		expDistributed   func() sdk.Coins
+		expRefunded      func() sdk.Coins
+		expInEscrowAfter bool
+	}{
+		{
+			"packet acknowledged",
+			func() {
+				err := suite.path.RelayPacket(packet)
+				suite.Require().NoError(err)
+			},
+			// no counterparty payee is registered, the receive fees are refunded
+			func() sdk.Coins { return fee.AckFee.Add(fee.AckFee...) },
+			func() sdk.Coins { return fee.RecvFee.Add(fee.RecvFee...) },
+			false,
+		},
+		{
+			"packet timed out",
+			func() {
+				suite.coordinator.CommitNBlocks(suite.chainB, 20)
+
+				err := suite.path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				err = suite.path.EndpointA.TimeoutPacket(packet)
+				suite.Require().NoError(err)
+			},
+			func() sdk.Coins { return fee.TimeoutFee.Add(fee.TimeoutFee...) },
+			// the escrowed total exceeding the timeout fee is refunded
+			func() sdk.Coins {
+				refund := fee.Total().Sub(fee.TimeoutFee...)
+				return refund.Add(refund...)
+			},
+			false,
+		},
+		{
+			"packet not relayed",
+			func() {},
+			func() sdk.Coins { return sdk.NewCoins() },
+			func() sdk.Coins { return sdk.NewCoins() },
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.path.Setup()
+
+			fee = types.NewFee(defaultRecvFee, defaultAckFee, defaultRecvFee)
+
+			// the payers must differ from the relayers for fees to be recorded as distributed
+			for _, payer := range []sdk.AccAddress{suite.chainA.SenderAccounts[1].SenderAccount.GetAddress(), suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()} {
+				msg := types.NewMsgPayPacketFee(fee, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, payer.String(), nil)
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFee(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+			}
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			timeoutHeight.RevisionHeight += 10
+			sequence, err := suite.path.EndpointA.SendPacket(timeoutHeight, 0, ibcmock.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet = channeltypes.NewPacket(ibcmock.MockPacketData, sequence, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, timeoutHeight, 0)
+
+			tc.relay()
+
+			stats := suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelFeeStats(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			suite.Require().Equal(suite.path.EndpointA.ChannelConfig.PortID, stats.PortId)
+			suite.Require().Equal(suite.path.EndpointA.ChannelID, stats.ChannelId)
+			suite.Require().Equal(fee.Total().Add(fee.Total()...), stats.TotalEscrowed)
+			suite.Require().True(tc.expDistributed().Equal(stats.TotalDistributed), "expected distributed %s, got %s", tc.expDistributed(), stats.TotalDistributed)
+			suite.Require().True(tc.expRefunded().Equal(stats.TotalRefunded), "expected refunded %s, got %s", tc.expRefunded(), stats.TotalRefunded)
+			suite.Require().Equal(uint64(1), stats.IncentivizedPackets, "multiple fees for a single packet must be counted once")
+
+			_, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), channeltypes.NewPacketID(packet.SourcePort, packet.SourceChannel, packet.Sequence))
+			suite.Require().Equal(tc.expInEscrowAfter, found)
+
+			// the fee statistics of other channels are not affected
+			otherStats := suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelFeeStats(suite.chainA.GetContext(), ibctesting.MockFeePort, "channel-100")
+			suite.Require().Equal(types.NewChannelFeeStats(ibctesting.MockFeePort, "channel-100"), otherStats)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestChannelFeeStatsOnChannelClosure() {
+	suite.path.Setup()
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, 1)
+	msg := types.NewMsgPayPacketFee(fee, packetID.PortId, packetID.ChannelId, suite.chainA.SenderAccount.GetAddress().String(), nil)
+	_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFee(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+
+	err = suite.chainA.GetSimApp().IBCFeeKeeper.RefundFeesOnChannelClosure(suite.chainA.GetContext(), packetID.PortId, packetID.ChannelId)
+	suite.Require().NoError(err)
+
+	stats := suite.chainA.GetSimApp().IBCFeeKeeper.GetChannelFeeStats(suite.chainA.GetContext(), packetID.PortId, packetID.ChannelId)
+	suite.Require().Equal(fee.Total(), stats.TotalEscrowed)
+	suite.Require().Equal(fee.Total(), stats.TotalRefunded)
+	suite.Require().True(stats.TotalDistributed.Empty())
+	suite.Require().Equal(uint64(1), stats.IncentivizedPackets)
+}
+
+func (suite *KeeperTestSuite) TestDistributedFeesInRange() {
+	suite.path.Setup()
+
+	feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+	refundAcc := suite.chainA.SenderAccount.GetAddress()
+	relayerA := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	relayerB := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	height := uint64(suite.chainA.GetContext().BlockHeight())
+
+	// relayer A is paid a timeout fee in each of the next three blocks, relayer B in the last two of them
+	distributions := []struct {
+		height  uint64
+		relayer sdk.AccAddress
+	}{
+		{height + 1, relayerA},
+		{height + 2, relayerA},
+		{height + 2, relayerB},
+		{height + 3, relayerA},
+		{height + 3, relayerB},
+	}
+
+	for i, distribution := range distributions {
+		packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, uint64(i+1))
+		packetFees := []types.PacketFee{types.NewPacketFee(fee, refundAcc.String(), nil)}
+
+		ctx := suite.chainA.GetContext().WithBlockHeight(int64(distribution.height))
+		feeKeeper.SetFeesInEscrow(ctx, packetID, types.NewPacketFees(packetFees))
+		err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(ctx, refundAcc, types.ModuleName, fee.Total())
+		suite.Require().NoError(err)
+
+		feeKeeper.DistributePacketFeesOnTimeout(ctx, distribution.relayer, packetFees, packetID)
+	}
+
+	ctx := suite.chainA.GetContext()
+
+	// fees refunded to the refund address are not recorded
+	suite.Require().ElementsMatch([]types.PayeeFees{
+		{Payee: relayerA.String(), TotalFees: defaultTimeoutFee.MulInt(sdkmath.NewInt(2))},
+		{Payee: relayerB.String(), TotalFees: defaultTimeoutFee.MulInt(sdkmath.NewInt(2))},
+	}, feeKeeper.GetDistributedFeesInRange(ctx, height+2, height+3))
+
+	suite.Require().Equal([]types.PayeeFees{
+		{Payee: relayerA.String(), TotalFees: defaultTimeoutFee},
+	}, feeKeeper.GetDistributedFeesInRange(ctx, height+1, height+1))
+
+	payeeFees := feeKeeper.GetDistributedFeesInRange(ctx, 0, height+100)
+	suite.Require().ElementsMatch([]types.PayeeFees{
+		{Payee: relayerA.String(), TotalFees: defaultTimeoutFee.MulInt(sdkmath.NewInt(3))},
+		{Payee: relayerB.String(), TotalFees: defaultTimeoutFee.MulInt(sdkmath.NewInt(2))},
+	}, payeeFees)
+	suite.Require().Less(payeeFees[0].Payee, payeeFees[1].Payee, "payee fees must be sorted by payee")
+
+	suite.Require().Empty(feeKeeper.GetDistributedFeesInRange(ctx, height+4, height+100))
+
+	// the payee index sums the records of a single payee
+	suite.Require().Equal(defaultTimeoutFee.MulInt(sdkmath.NewInt(2)), feeKeeper.GetPayeeDistributedFeesInRange(ctx, relayerA.String(), height+2, height+100))
+	suite.Require().Empty(feeKeeper.GetPayeeDistributedFeesInRange(ctx, relayerB.String(), height+1, height+1))
+
+	// records are retained until the retention period has passed
+	params := feeKeeper.GetParams(ctx)
+	params.DistributedFeeRetentionPeriod = 2
+	feeKeeper.SetParams(ctx, params)
+
+	feeKeeper.PruneDistributedFeeRecords(ctx.WithBlockHeight(int64(height + 4)))
+
+	records := feeKeeper.GetAllDistributedFeeRecords(ctx)
+	suite.Require().Len(records, 2)
+	for _, record := range records {
+		suite.Require().Equal(height+3, record.Height)
+	}
+
+	suite.Require().Empty(feeKeeper.GetDistributedFeesInRange(ctx, height+1, height+2))
+
+	// disabling the retention period stops recording distributed fees and prunes all existing records
+	params.DistributedFeeRetentionPeriod = 0
+	feeKeeper.SetParams(ctx, params)
+
+	packetID := channeltypes.NewPacketID(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, uint64(len(distributions)+1))
+	packetFees := []types.PacketFee{types.NewPacketFee(fee, refundAcc.String(), nil)}
+	feeKeeper.SetFeesInEscrow(ctx, packetID, types.NewPacketFees(packetFees))
+	err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(ctx, refundAcc, types.ModuleName, fee.Total())
+	suite.Require().NoError(err)
+
+	feeKeeper.DistributePacketFeesOnTimeout(ctx.WithBlockHeight(int64(height+4)), relayerA, packetFees, packetID)
+	suite.Require().Empty(feeKeeper.GetDistributedFeesInRange(ctx, height+4, height+4))
+
+	feeKeeper.PruneDistributedFeeRecords(ctx.WithBlockHeight(int64(height + 4)))
+	suite.Require().Empty(feeKeeper.GetAllDistributedFeeRecords(ctx))
+
+	// the payee index is pruned along with the records
+	store := ctx.KVStore(suite.chainA.GetSimApp().GetKey(types.ModuleName))
+	for _, relayer := range []sdk.AccAddress{relayerA, relayerB} {
+		suite.Require().False(store.Has(types.KeyDistributedFeePayee(relayer.String())))
+		suite.Require().Empty(feeKeeper.GetPayeeDistributedFeesInRange(ctx, relayer.String(), 0, height+100))
+	}
+}
+
+// TestPruneDistributedFeeRecordsPerBlock asserts that at most MaxDistributedFeeRecordsPrunedPerBlock distributed fee
+// records are pruned per block, resuming at the height of the last record pruned in the previous block.
+func (suite *KeeperTestSuite) TestPruneDistributedFeeRecordsPerBlock() {
+	feeKeeper := suite.chainA.GetSimApp().IBCFeeKeeper
+	ctx := suite.chainA.GetContext()
+	payee := suite.chainA.SenderAccount.GetAddress().String()
+
+	params := feeKeeper.GetParams(ctx)
+	params.DistributedFeeRetentionPeriod = 10
+	feeKeeper.SetParams(ctx, params)
+
+	// store one more expired record than may be pruned in a single block, followed by a record within the retention period
+	numExpired := types.MaxDistributedFeeRecordsPrunedPerBlock + 1
+	for height := uint64(1); height <= uint64(numExpired+1); height++ {
+		feeKeeper.SetDistributedFeeRecord(ctx, types.NewDistributedFeeRecord(height, payee, defaultRecvFee))
+	}
+
+	ctx = ctx.WithBlockHeight(int64(numExpired + 10))
+
+	feeKeeper.PruneDistributedFeeRecords(ctx)
+
+	records := feeKeeper.GetAllDistributedFeeRecords(ctx)
+	suite.Require().Len(records, 2)
+	suite.Require().Equal(uint64(numExpired), records[0].Height)
+
+	feeKeeper.PruneDistributedFeeRecords(ctx)
+
+	records = feeKeeper.GetAllDistributedFeeRecords(ctx)
+	suite.Require().Len(records, 1)
+	suite.Require().Equal(uint64(numExpired+1), records[0].Height)
+
+	// records within the retention period are kept
+	feeKeeper.PruneDistributedFeeRecords(ctx)
+	suite.Require().Len(feeKeeper.GetAllDistributedFeeRecords(ctx), 1)
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -38,8 +40,9 @@ func (suite *FeeTestSuite) TestFeeTransfer() {
 	// after incentivizing the packets
 	originalChainASenderAccountBalance := sdk.NewCoins(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), ibctesting.TestCoin.Denom))
 
-	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
-	suite.Require().NoError(err)
+	sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+	suite.Require().NotEmpty(sendPacketEvents)
+	packet := sendPacketEvents[0].Packet
 
 	// register counterparty address on chainB
 	// relayerAddress is address of sender account on chainB, but we will use it on chainA
@@ -69,6 +72,66 @@ func (suite *FeeTestSuite) TestFeeTransfer() {
 		sdk.NewCoins(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), ibctesting.TestCoin.Denom)).Sub(originalChainASenderAccountBalance[0]))
 }
 
+// Integration test to ensure the fees escrowed for a packet are excluded from an ics20 transfer of the entire balance
+func (suite *FeeTestSuite) TestFeeTransferEntireBalance() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	feeTransferVersion := string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{FeeVersion: types.Version, AppVersion: transfertypes.Version}))
+	path.EndpointA.ChannelConfig.Version = feeTransferVersion
+	path.EndpointB.ChannelConfig.Version = feeTransferVersion
+	path.EndpointA.ChannelConfig.PortID = transfertypes.PortID
+	path.EndpointB.ChannelConfig.PortID = transfertypes.PortID
+
+	path.Setup()
+
+	sender := suite.chainA.SenderAccount.GetAddress()
+	escrowAddress := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	feeModuleAddress := suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	originalBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+	fee := types.Fee{
+		RecvFee:    defaultRecvFee,
+		AckFee:     defaultAckFee,
+		TimeoutFee: defaultTimeoutFee,
+	}
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, transfertypes.UnboundedSpendLimit())
+	msgs := []sdk.Msg{
+		types.NewMsgPayPacketFee(fee, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sender.String(), nil),
+		transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender.String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 100), 0, ""),
+	}
+	res, err := suite.chainA.SendMsgs(msgs...)
+	suite.Require().NoError(err) // message committed
+
+	// the fee reservation is excluded from the amount transferred and nothing remains with the sender
+	escrowedFees := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), feeModuleAddress, sdk.DefaultBondDenom)
+	suite.Require().Equal(fee.Total().AmountOf(sdk.DefaultBondDenom), escrowedFees.Amount)
+
+	escrowedTokens := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom)
+	suite.Require().Equal(originalBalance.Sub(escrowedFees), escrowedTokens)
+
+	suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom).IsZero())
+
+	sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+	suite.Require().NotEmpty(sendPacketEvents)
+	packet := sendPacketEvents[0].Packet
+
+	var packetData transfertypes.FungibleTokenPacketData
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData))
+	suite.Require().Equal(escrowedTokens.Amount.String(), packetData.Amount)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err) // relay committed
+
+	// the fees are distributed without any dust lost or double counted
+	suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), feeModuleAddress, sdk.DefaultBondDenom).IsZero())
+
+	distributedFees := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom).Add(
+		suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom),
+	)
+	suite.Require().Equal(originalBalance, escrowedTokens.Add(distributedFees))
+}
+
 func (suite *FeeTestSuite) TestTransferFeeUpgrade() {
 	var path *ibctesting.Path
 
@@ -148,8 +211,9 @@ func (suite *FeeTestSuite) TestTransferFeeUpgrade() {
 				escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), feeEscrowAddr, sdk.DefaultBondDenom)
 				suite.Require().Equal(escrowBalance.Amount, fee.Total().AmountOf(sdk.DefaultBondDenom))
 
-				packet, err := ibctesting.ParsePacketFromEvents(res.Events)
-				suite.Require().NoError(err)
+				sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+				suite.Require().NotEmpty(sendPacketEvents)
+				packet := sendPacketEvents[0].Packet
 
 				err = path.RelayPacket(packet)
 				suite.Require().NoError(err) // relay committed
@@ -179,3 +243,76 @@ func (suite *FeeTestSuite) TestOnesidedFeeMiddlewareTransferHandshake() {
 	suite.Require().Equal(path.EndpointA.ChannelConfig.Version, transfertypes.Version)
 	suite.Require().Equal(path.EndpointB.ChannelConfig.Version, transfertypes.Version)
 }
+
+// Integration test to ensure fees remain escrowed for an ics20 packet which cannot be received due to a frozen client
+func (suite *FeeTestSuite) TestFeeTransferFrozenClient() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	feeTransferVersion := string(types.ModuleCdc.MustMarshalJSON(&types.Metadata{FeeVersion: types.Version, AppVersion: transfertypes.Version}))
+	path.EndpointA.ChannelConfig.Version = feeTransferVersion
+	path.EndpointB.ChannelConfig.Version = feeTransferVersion
+	path.EndpointA.ChannelConfig.PortID = transfertypes.PortID
+	path.EndpointB.ChannelConfig.PortID = transfertypes.PortID
+
+	path.Setup()
+
+	fee := types.Fee{
+		RecvFee:    defaultRecvFee,
+		AckFee:     defaultAckFee,
+		TimeoutFee: defaultTimeoutFee,
+	}
+
+	msgs := []sdk.Msg{
+		types.NewMsgPayPacketFee(fee, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String(), nil),
+		transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.TestCoin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, ""),
+	}
+	res, err := suite.chainA.SendMsgs(msgs...)
+	suite.Require().NoError(err) // message committed
+
+	sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+	suite.Require().NotEmpty(sendPacketEvents)
+	packet := sendPacketEvents[0].Packet
+
+	packetID := channeltypes.NewPacketID(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	expFeesInEscrow := types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String(), nil)})
+
+	// freeze the client tracking chainA on chainB, the packet cannot be received
+	err = path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.SubmitMisbehaviour()
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.RecvPacket(packet)
+	suite.Require().ErrorContains(err, clienttypes.ErrClientNotActive.Error())
+
+	feesInEscrow, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+	suite.Require().True(found)
+	suite.Require().Equal(expFeesInEscrow, feesInEscrow)
+
+	// freeze the client tracking chainB on chainA after the packet timed out, the packet can still be timed out
+	// using a proof below the misbehaviour height, which distributes the escrowed fees
+	suite.coordinator.CommitNBlocks(suite.chainB, uint64(suite.chainB.GetTimeoutHeight().RevisionHeight))
+
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	proof, proofHeight := path.EndpointB.QueryProof(host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+
+	// the misbehaviour trusts a height above the proof height
+	suite.coordinator.CommitBlock(suite.chainB)
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.SubmitMisbehaviour()
+	suite.Require().NoError(err)
+
+	nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().True(found)
+
+	timeoutMsg := channeltypes.NewMsgTimeout(packet, nextSeqRecv, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+	_, err = suite.chainA.SendMsgs(timeoutMsg)
+	suite.Require().NoError(err)
+
+	_, found = suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+	suite.Require().False(found)
+}
@@ -14,7 +14,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgPayPacketFee{}, "cosmos-sdk/MsgPayPacketFee")
 	legacy.RegisterAminoMsg(cdc, &MsgPayPacketFeeAsync{}, "cosmos-sdk/MsgPayPacketFeeAsync")
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterPayee{}, "cosmos-sdk/MsgRegisterPayee")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterDenomPayee{}, "cosmos-sdk/MsgRegisterDenomPayee")
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterCounterpartyPayee{}, "cosmos-sdk/MsgRegisterCounterpartyPayee")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterRefundOverride{}, "cosmos-sdk/MsgRegisterRefundOverride")
 }
 
 // RegisterInterfaces register the 29-fee module interfaces to protobuf
@@ -25,7 +27,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgPayPacketFee{},
 		&MsgPayPacketFeeAsync{},
 		&MsgRegisterPayee{},
+		&MsgRegisterDenomPayee{},
 		&MsgRegisterCounterpartyPayee{},
+		&MsgUpdateAllowedRelayers{},
+		&MsgUnlockFeeModule{},
+		&MsgUpdateParams{},
+		&MsgConvertEscrowedFees{},
+		&MsgRefundFeesOnClientExpiry{},
+		&MsgSetAcceptedFeeDenoms{},
+		&MsgRegisterRefundOverride{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
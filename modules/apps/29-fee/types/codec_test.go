@@ -33,11 +33,41 @@ func TestCodecTypeRegistration(t *testing.T) {
 			sdk.MsgTypeURL(&types.MsgRegisterPayee{}),
 			true,
 		},
+		{
+			"success: MsgRegisterDenomPayee",
+			sdk.MsgTypeURL(&types.MsgRegisterDenomPayee{}),
+			true,
+		},
+		{
+			"success: MsgRegisterRefundOverride",
+			sdk.MsgTypeURL(&types.MsgRegisterRefundOverride{}),
+			true,
+		},
 		{
 			"success: MsgRegisterCounterpartyPayee",
 			sdk.MsgTypeURL(&types.MsgRegisterCounterpartyPayee{}),
 			true,
 		},
+		{
+			"success: MsgUnlockFeeModule",
+			sdk.MsgTypeURL(&types.MsgUnlockFeeModule{}),
+			true,
+		},
+		{
+			"success: MsgUpdateParams",
+			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
+			true,
+		},
+		{
+			"success: MsgConvertEscrowedFees",
+			sdk.MsgTypeURL(&types.MsgConvertEscrowedFees{}),
+			true,
+		},
+		{
+			"success: MsgSetAcceptedFeeDenoms",
+			sdk.MsgTypeURL(&types.MsgSetAcceptedFeeDenoms{}),
+			true,
+		},
 		{
 			"type not registered on codec",
 			"ibc.invalid.MsgTypeURL",
@@ -17,4 +17,11 @@ var (
 	ErrRelayerNotFoundForAsyncAck    = errorsmod.Register(ModuleName, 10, "relayer address must be stored for async WriteAcknowledgement")
 	ErrFeeModuleLocked               = errorsmod.Register(ModuleName, 11, "the fee module is currently locked, a severe bug has been detected")
 	ErrUnsupportedAction             = errorsmod.Register(ModuleName, 12, "unsupported action")
+	ErrFeeModuleNotLocked            = errorsmod.Register(ModuleName, 13, "the fee module is not locked")
+	ErrNoFeesToConvert               = errorsmod.Register(ModuleName, 14, "no escrowed fees to convert")
+	ErrClientNotExpired              = errorsmod.Register(ModuleName, 15, "client is not expired")
+	ErrFeeDenomNotAccepted           = errorsmod.Register(ModuleName, 16, "fee denomination is not accepted")
+	ErrTooManyPacketFees             = errorsmod.Register(ModuleName, 17, "too many packet fees")
+	ErrInsufficientSpendableFees     = errorsmod.Register(ModuleName, 18, "insufficient spendable balance to escrow fees")
+	ErrInvalidPayeeSignature         = errorsmod.Register(ModuleName, 19, "invalid relayer signature over counterparty payee")
 )
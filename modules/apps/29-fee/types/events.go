@@ -4,8 +4,14 @@ package types
 const (
 	EventTypeIncentivizedPacket        = "incentivized_ibc_packet"
 	EventTypeRegisterPayee             = "register_payee"
+	EventTypeRegisterDenomPayee        = "register_denom_payee"
 	EventTypeRegisterCounterpartyPayee = "register_counterparty_payee"
 	EventTypeDistributeFee             = "distribute_fee"
+	EventTypeSweepRefund               = "sweep_refund"
+	EventTypeConvertEscrowedFee        = "convert_escrowed_fee"
+	EventTypeSetAcceptedFeeDenoms      = "set_accepted_fee_denoms"
+	EventTypeRegisterRefundOverride    = "register_refund_override"
+	EventTypeUndistributedFees         = "undistributed_fees"
 
 	AttributeKeyRecvFee           = "recv_fee"
 	AttributeKeyAckFee            = "ack_fee"
@@ -16,4 +22,10 @@ const (
 	AttributeKeyCounterpartyPayee = "counterparty_payee"
 	AttributeKeyReceiver          = "receiver"
 	AttributeKeyFee               = "fee"
+	AttributeKeyDenom             = "denom"
+	AttributeKeyDenoms            = "denoms"
+	AttributeKeyPacketID          = "packet_id"
+	AttributeKeyRefundAddress     = "refund_address"
+	AttributeKeyOriginalFee       = "original_fee"
+	AttributeKeyOverrideAddress   = "override_address"
 )
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -8,6 +9,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 )
 
@@ -35,6 +37,13 @@ func (p PacketFee) Validate() error {
 	return p.Fee.Validate()
 }
 
+// PacketFeeTopUp defines the additional fee which must be paid for a packet in order for the
+// fees escrowed for it to meet a target fee
+type PacketFeeTopUp struct {
+	PacketID channeltypes.PacketId
+	Fee      Fee
+}
+
 // NewPacketFees creates and returns a new PacketFees struct including a list of type PacketFee
 func NewPacketFees(packetFees []PacketFee) PacketFees {
 	return PacketFees{
@@ -61,14 +70,63 @@ func NewFee(recvFee, ackFee, timeoutFee sdk.Coins) Fee {
 
 // Total returns the total amount for a given Fee.
 // The total amount is the Max(RecvFee + AckFee, TimeoutFee),
-// This is because either the packet is received and acknowledged or it timeouts
+// This is because either the packet is received and acknowledged or it timeouts.
+// The returned coins are sorted and combined denomwise, even if the coins of a fee are not.
 func (f Fee) Total() sdk.Coins {
+	// normalize sorts the coins and combines coins of the same denomination
+	normalize := func(coins sdk.Coins) sdk.Coins {
+		normalized := sdk.NewCoins()
+		for _, coin := range coins {
+			normalized = normalized.Add(coin)
+		}
+
+		return normalized
+	}
+
 	// maximum returns the denomwise maximum of two sets of coins
-	return f.RecvFee.Add(f.AckFee...).Max(f.TimeoutFee)
+	return normalize(f.RecvFee).Add(normalize(f.AckFee)...).Max(normalize(f.TimeoutFee))
+}
+
+// TopUpTo returns the Fee which must be added to f in order for each of its receive, acknowledgement and
+// timeout fees to be at least the corresponding fee of the target, denomwise. Fees which already meet the
+// target are left empty.
+func (f Fee) TopUpTo(target Fee) Fee {
+	// shortfall returns the denomwise amounts by which the current coins fall short of the target coins
+	shortfall := func(current, target sdk.Coins) sdk.Coins {
+		var coins sdk.Coins
+		for _, coin := range target {
+			if amount := coin.Amount.Sub(current.AmountOf(coin.Denom)); amount.IsPositive() {
+				coins = coins.Add(sdk.NewCoin(coin.Denom, amount))
+			}
+		}
+
+		return coins
+	}
+
+	return NewFee(
+		shortfall(f.RecvFee, target.RecvFee),
+		shortfall(f.AckFee, target.AckFee),
+		shortfall(f.TimeoutFee, target.TimeoutFee),
+	)
 }
 
 // Validate asserts that each Fee is valid and all three Fees are not empty or zero
 func (f Fee) Validate() error {
+	// fees are totalled and distributed denomwise, reject coins which are not sorted by denomination or
+	// which repeat a denomination
+	for _, fee := range []struct {
+		name  string
+		coins sdk.Coins
+	}{
+		{"recv fee", f.RecvFee},
+		{"ack fee", f.AckFee},
+		{"timeout fee", f.TimeoutFee},
+	} {
+		if err := validateDenomOrder(fee.coins); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "%s: %s", fee.name, err)
+		}
+	}
+
 	var errFees []string
 	if !f.AckFee.IsValid() {
 		errFees = append(errFees, "ack fee invalid")
@@ -91,3 +149,68 @@ func (f Fee) Validate() error {
 
 	return nil
 }
+
+// validateDenomOrder returns an error if the denominations of the coins are not in strictly ascending order
+func validateDenomOrder(coins sdk.Coins) error {
+	for i := 1; i < len(coins); i++ {
+		if coins[i-1].Denom >= coins[i].Denom {
+			return fmt.Errorf("denomination %s must be sorted strictly after %s", coins[i].Denom, coins[i-1].Denom)
+		}
+	}
+
+	return nil
+}
+
+// NewChannelFeeStats creates a new ChannelFeeStats instance for the given port and channel identifiers with all
+// statistics set to zero
+func NewChannelFeeStats(portID, channelID string) ChannelFeeStats {
+	return ChannelFeeStats{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs basic stateless validation of the ChannelFeeStats
+func (s ChannelFeeStats) Validate() error {
+	if err := host.PortIdentifierValidator(s.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if err := host.ChannelIdentifierValidator(s.ChannelId); err != nil {
+		return errorsmod.Wrap(err, "invalid channel ID")
+	}
+
+	for _, coins := range []sdk.Coins{s.TotalEscrowed, s.TotalDistributed, s.TotalRefunded} {
+		if err := coins.Validate(); err != nil {
+			return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// NewDistributedFeeRecord creates a new DistributedFeeRecord instance
+func NewDistributedFeeRecord(height uint64, payee string, fee sdk.Coins) DistributedFeeRecord {
+	return DistributedFeeRecord{
+		Height: height,
+		Payee:  payee,
+		Fee:    fee,
+	}
+}
+
+// Validate performs basic stateless validation of the DistributedFeeRecord
+func (r DistributedFeeRecord) Validate() error {
+	if r.Height == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "distributed fee record height cannot be zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Payee); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "invalid payee address: %v", err)
+	}
+
+	if err := r.Fee.Validate(); err != nil {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, err.Error())
+	}
+
+	return nil
+}
@@ -100,6 +100,10 @@ type PacketFee struct {
 	RefundAddress string `protobuf:"bytes,2,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
 	// optional list of relayers permitted to receive fees
 	Relayers []string `protobuf:"bytes,3,rep,name=relayers,proto3" json:"relayers,omitempty"`
+	// if true, the fees are paid to the relayers directly rather than to the payees registered by the relayers
+	BypassPayee bool `protobuf:"varint,4,opt,name=bypass_payee,json=bypassPayee,proto3" json:"bypass_payee,omitempty"`
+	// if true, the recv fee is refunded if the acknowledgement of the underlying application indicates failure
+	OnlyOnSuccess bool `protobuf:"varint,5,opt,name=only_on_success,json=onlyOnSuccess,proto3" json:"only_on_success,omitempty"`
 }
 
 func (m *PacketFee) Reset()         { *m = PacketFee{} }
@@ -156,6 +160,20 @@ func (m *PacketFee) GetRelayers() []string {
 	return nil
 }
 
+func (m *PacketFee) GetBypassPayee() bool {
+	if m != nil {
+		return m.BypassPayee
+	}
+	return false
+}
+
+func (m *PacketFee) GetOnlyOnSuccess() bool {
+	if m != nil {
+		return m.OnlyOnSuccess
+	}
+	return false
+}
+
 // PacketFees contains a list of type PacketFee
 type PacketFees struct {
 	// list of packet fees
@@ -257,50 +275,421 @@ func (m *IdentifiedPacketFees) GetPacketFees() []PacketFee {
 	return nil
 }
 
+// FeeModuleLockReason records why the fee module was locked
+type FeeModuleLockReason struct {
+	// unique packet identifier of the packet whose fee could not be distributed
+	PacketId types1.PacketId `protobuf:"bytes,1,opt,name=packet_id,json=packetId,proto3" json:"packet_id"`
+	// the fee which could not be covered by the escrow account
+	Shortfall github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=shortfall,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"shortfall"`
+}
+
+func (m *FeeModuleLockReason) Reset()         { *m = FeeModuleLockReason{} }
+func (m *FeeModuleLockReason) String() string { return proto.CompactTextString(m) }
+func (*FeeModuleLockReason) ProtoMessage()    {}
+func (*FeeModuleLockReason) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{4}
+}
+func (m *FeeModuleLockReason) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeModuleLockReason) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeModuleLockReason.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeModuleLockReason) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeModuleLockReason.Merge(m, src)
+}
+func (m *FeeModuleLockReason) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeModuleLockReason) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeModuleLockReason.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeModuleLockReason proto.InternalMessageInfo
+
+func (m *FeeModuleLockReason) GetPacketId() types1.PacketId {
+	if m != nil {
+		return m.PacketId
+	}
+	return types1.PacketId{}
+}
+
+func (m *FeeModuleLockReason) GetShortfall() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Shortfall
+	}
+	return nil
+}
+
+// ChannelFeeStats contains the aggregate fee statistics of a channel
+type ChannelFeeStats struct {
+	// unique port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// unique channel identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the total fees escrowed for packets sent on the channel
+	TotalEscrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_escrowed,json=totalEscrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed"`
+	// the total fees distributed to relayers or their payees
+	TotalDistributed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_distributed,json=totalDistributed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_distributed"`
+	// the total fees returned to the refund addresses, swept on channel closure, or returned to the pool on conversion
+	TotalRefunded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_refunded,json=totalRefunded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_refunded"`
+	// the number of packets sent on the channel which have been incentivized
+	IncentivizedPackets uint64 `protobuf:"varint,6,opt,name=incentivized_packets,json=incentivizedPackets,proto3" json:"incentivized_packets,omitempty"`
+}
+
+func (m *ChannelFeeStats) Reset()         { *m = ChannelFeeStats{} }
+func (m *ChannelFeeStats) String() string { return proto.CompactTextString(m) }
+func (*ChannelFeeStats) ProtoMessage()    {}
+func (*ChannelFeeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{5}
+}
+func (m *ChannelFeeStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelFeeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelFeeStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelFeeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFeeStats.Merge(m, src)
+}
+func (m *ChannelFeeStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelFeeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFeeStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelFeeStats proto.InternalMessageInfo
+
+func (m *ChannelFeeStats) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelFeeStats) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelFeeStats) GetTotalEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalEscrowed
+	}
+	return nil
+}
+
+func (m *ChannelFeeStats) GetTotalDistributed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalDistributed
+	}
+	return nil
+}
+
+func (m *ChannelFeeStats) GetTotalRefunded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalRefunded
+	}
+	return nil
+}
+
+func (m *ChannelFeeStats) GetIncentivizedPackets() uint64 {
+	if m != nil {
+		return m.IncentivizedPackets
+	}
+	return 0
+}
+
+// Params defines the set of ICS29 fee middleware parameters.
+type Params struct {
+	// sweep_invalid_refunds enables sweeping fees which cannot be refunded to their refund address on channel
+	// closure, e.g. because the refund address is invalid or blocked, to the refund sink instead of keeping
+	// them in escrow.
+	SweepInvalidRefunds bool `protobuf:"varint,1,opt,name=sweep_invalid_refunds,json=sweepInvalidRefunds,proto3" json:"sweep_invalid_refunds,omitempty"`
+	// refund_sink is the address receiving the swept fees. If empty, the swept fees fund the community pool.
+	RefundSink string `protobuf:"bytes,2,opt,name=refund_sink,json=refundSink,proto3" json:"refund_sink,omitempty"`
+	// distributed_fee_retention_period is the number of blocks for which the records of the fees distributed to
+	// payees are kept. Distributed fees are not recorded if set to zero.
+	DistributedFeeRetentionPeriod uint64 `protobuf:"varint,3,opt,name=distributed_fee_retention_period,json=distributedFeeRetentionPeriod,proto3" json:"distributed_fee_retention_period,omitempty"`
+	// max_packet_fees_per_packet is the maximum number of packet fees which may be escrowed for a single packet.
+	// The number of packet fees is not limited if set to zero.
+	MaxPacketFeesPerPacket uint64 `protobuf:"varint,4,opt,name=max_packet_fees_per_packet,json=maxPacketFeesPerPacket,proto3" json:"max_packet_fees_per_packet,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{6}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetSweepInvalidRefunds() bool {
+	if m != nil {
+		return m.SweepInvalidRefunds
+	}
+	return false
+}
+
+func (m *Params) GetRefundSink() string {
+	if m != nil {
+		return m.RefundSink
+	}
+	return ""
+}
+
+func (m *Params) GetDistributedFeeRetentionPeriod() uint64 {
+	if m != nil {
+		return m.DistributedFeeRetentionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPacketFeesPerPacket() uint64 {
+	if m != nil {
+		return m.MaxPacketFeesPerPacket
+	}
+	return 0
+}
+
+// DistributedFeeRecord defines the total fees distributed to a payee in a block
+type DistributedFeeRecord struct {
+	// the block height at which the fees were distributed
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// the address the fees were paid to
+	Payee string `protobuf:"bytes,2,opt,name=payee,proto3" json:"payee,omitempty"`
+	// the total fees distributed to the payee in the block
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *DistributedFeeRecord) Reset()         { *m = DistributedFeeRecord{} }
+func (m *DistributedFeeRecord) String() string { return proto.CompactTextString(m) }
+func (*DistributedFeeRecord) ProtoMessage()    {}
+func (*DistributedFeeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{7}
+}
+func (m *DistributedFeeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributedFeeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributedFeeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributedFeeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributedFeeRecord.Merge(m, src)
+}
+func (m *DistributedFeeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributedFeeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributedFeeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributedFeeRecord proto.InternalMessageInfo
+
+func (m *DistributedFeeRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DistributedFeeRecord) GetPayee() string {
+	if m != nil {
+		return m.Payee
+	}
+	return ""
+}
+
+func (m *DistributedFeeRecord) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
+	}
+	return nil
+}
+
+// PayeeFees defines the total fees distributed to a payee
+type PayeeFees struct {
+	// the address the fees were paid to
+	Payee string `protobuf:"bytes,1,opt,name=payee,proto3" json:"payee,omitempty"`
+	// the total fees distributed to the payee
+	TotalFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_fees,json=totalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_fees"`
+}
+
+func (m *PayeeFees) Reset()         { *m = PayeeFees{} }
+func (m *PayeeFees) String() string { return proto.CompactTextString(m) }
+func (*PayeeFees) ProtoMessage()    {}
+func (*PayeeFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3319f1af2a53e5, []int{8}
+}
+func (m *PayeeFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayeeFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayeeFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayeeFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayeeFees.Merge(m, src)
+}
+func (m *PayeeFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayeeFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayeeFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayeeFees proto.InternalMessageInfo
+
+func (m *PayeeFees) GetPayee() string {
+	if m != nil {
+		return m.Payee
+	}
+	return ""
+}
+
+func (m *PayeeFees) GetTotalFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Fee)(nil), "ibc.applications.fee.v1.Fee")
 	proto.RegisterType((*PacketFee)(nil), "ibc.applications.fee.v1.PacketFee")
 	proto.RegisterType((*PacketFees)(nil), "ibc.applications.fee.v1.PacketFees")
 	proto.RegisterType((*IdentifiedPacketFees)(nil), "ibc.applications.fee.v1.IdentifiedPacketFees")
+	proto.RegisterType((*FeeModuleLockReason)(nil), "ibc.applications.fee.v1.FeeModuleLockReason")
+	proto.RegisterType((*ChannelFeeStats)(nil), "ibc.applications.fee.v1.ChannelFeeStats")
+	proto.RegisterType((*Params)(nil), "ibc.applications.fee.v1.Params")
+	proto.RegisterType((*DistributedFeeRecord)(nil), "ibc.applications.fee.v1.DistributedFeeRecord")
+	proto.RegisterType((*PayeeFees)(nil), "ibc.applications.fee.v1.PayeeFees")
 }
 
 func init() { proto.RegisterFile("ibc/applications/fee/v1/fee.proto", fileDescriptor_cb3319f1af2a53e5) }
 
 var fileDescriptor_cb3319f1af2a53e5 = []byte{
-	// 518 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0xe3, 0x04, 0xb5, 0xcd, 0x05, 0x90, 0x30, 0x95, 0x5a, 0x2a, 0x70, 0x8b, 0x25, 0xa4,
-	0xa8, 0x52, 0xee, 0x94, 0x00, 0x12, 0x30, 0xd1, 0x20, 0x45, 0xca, 0x04, 0xca, 0x82, 0xc4, 0x12,
-	0x9d, 0xcf, 0x2f, 0xee, 0x29, 0xb6, 0xcf, 0xf2, 0xd9, 0x41, 0x19, 0x58, 0xf8, 0x04, 0xac, 0xb0,
-	0xb2, 0x31, 0xf5, 0x63, 0x74, 0xec, 0xc8, 0x04, 0x28, 0x19, 0xfa, 0x05, 0xf8, 0x00, 0xe8, 0x9d,
-	0x0f, 0xab, 0x2a, 0xea, 0xc2, 0xd0, 0xc5, 0x77, 0xef, 0xde, 0xbb, 0xf7, 0xfb, 0xdf, 0xbb, 0xe7,
-	0x23, 0x0f, 0x65, 0x20, 0x18, 0xcf, 0xb2, 0x58, 0x0a, 0x5e, 0x48, 0x95, 0x6a, 0x36, 0x03, 0x60,
-	0x8b, 0x3e, 0x0e, 0x34, 0xcb, 0x55, 0xa1, 0xdc, 0x1d, 0x19, 0x08, 0x7a, 0x31, 0x84, 0xa2, 0x6f,
-	0xd1, 0xdf, 0xbb, 0xc3, 0x13, 0x99, 0x2a, 0x66, 0xbe, 0x55, 0xec, 0x9e, 0x27, 0x94, 0x4e, 0x94,
-	0x66, 0x01, 0xd7, 0x98, 0x25, 0x80, 0x82, 0xf7, 0x99, 0x50, 0x32, 0xb5, 0xfe, 0xed, 0x48, 0x45,
-	0xca, 0x4c, 0x19, 0xce, 0xec, 0xaa, 0x11, 0x21, 0x54, 0x0e, 0x4c, 0x1c, 0xf3, 0x34, 0x85, 0x18,
-	0x05, 0xd8, 0xa9, 0x0d, 0xd9, 0xb1, 0x89, 0x13, 0x1d, 0xa1, 0x33, 0xd1, 0x51, 0xe5, 0xf0, 0x7f,
-	0x37, 0x49, 0x6b, 0x04, 0xe0, 0xbe, 0x27, 0x5b, 0x39, 0x88, 0xc5, 0x74, 0x06, 0xb0, 0xeb, 0x1c,
-	0xb4, 0xba, 0x9d, 0xc1, 0x3d, 0x5a, 0xed, 0xa1, 0x28, 0x86, 0x5a, 0x31, 0xf4, 0x95, 0x92, 0xe9,
-	0xf0, 0xe8, 0xf4, 0xc7, 0x7e, 0xe3, 0xdb, 0xcf, 0xfd, 0x6e, 0x24, 0x8b, 0xe3, 0x32, 0xa0, 0x42,
-	0x25, 0xcc, 0x02, 0xaa, 0xa1, 0xa7, 0xc3, 0x39, 0x2b, 0x96, 0x19, 0x68, 0xb3, 0x41, 0x7f, 0x39,
-	0x3f, 0x39, 0xbc, 0x19, 0x43, 0xc4, 0xc5, 0x72, 0x8a, 0xc7, 0xd1, 0x93, 0x4d, 0xa4, 0x21, 0xb8,
-	0x24, 0x9b, 0x5c, 0xcc, 0x0d, 0xb7, 0x79, 0x0d, 0xdc, 0x0d, 0x2e, 0xe6, 0x88, 0xfd, 0x40, 0x3a,
-	0x85, 0x4c, 0x40, 0x95, 0x85, 0x41, 0xb7, 0xae, 0x01, 0x4d, 0x2c, 0x70, 0x04, 0xe0, 0x7f, 0x76,
-	0x48, 0xfb, 0x0d, 0x17, 0x73, 0x40, 0xcb, 0x7d, 0x42, 0x5a, 0x55, 0xdd, 0x9d, 0x6e, 0x67, 0x70,
-	0x9f, 0x5e, 0xd1, 0x30, 0x74, 0x04, 0x30, 0xbc, 0x81, 0x3a, 0x26, 0x18, 0xee, 0x3e, 0x22, 0xb7,
-	0x73, 0x98, 0x95, 0x69, 0x38, 0xe5, 0x61, 0x98, 0x83, 0xd6, 0xbb, 0xcd, 0x03, 0xa7, 0xdb, 0x9e,
-	0xdc, 0xaa, 0x56, 0x8f, 0xaa, 0x45, 0x77, 0x0f, 0x6f, 0x36, 0xe6, 0x4b, 0xc8, 0xb5, 0x39, 0x66,
-	0x7b, 0x52, 0xdb, 0x2f, 0xee, 0x7e, 0x3c, 0x3f, 0x39, 0xbc, 0x94, 0xc5, 0x7f, 0x4b, 0x48, 0x2d,
-	0x4d, 0xbb, 0x63, 0xd2, 0xc9, 0x8c, 0x85, 0x75, 0xd2, 0xb6, 0x37, 0xfc, 0x2b, 0x35, 0xd6, 0x3b,
-	0xad, 0x52, 0x92, 0xd5, 0xa9, 0xfc, 0xaf, 0x0e, 0xd9, 0x1e, 0x87, 0x90, 0x16, 0x72, 0x26, 0x21,
-	0xbc, 0xc0, 0x78, 0x49, 0xda, 0x96, 0x21, 0x43, 0x5b, 0x85, 0x07, 0x86, 0x80, 0x4d, 0x4d, 0xff,
-	0x76, 0x72, 0x9d, 0x7d, 0x1c, 0xda, 0xe4, 0x5b, 0x99, 0xb5, 0x2f, 0xab, 0x6c, 0xfe, 0xbf, 0xca,
-	0xe1, 0xeb, 0xd3, 0x95, 0xe7, 0x9c, 0xad, 0x3c, 0xe7, 0xd7, 0xca, 0x73, 0x3e, 0xad, 0xbd, 0xc6,
-	0xd9, 0xda, 0x6b, 0x7c, 0x5f, 0x7b, 0x8d, 0x77, 0x4f, 0xff, 0xbd, 0x7b, 0x19, 0x88, 0x5e, 0xa4,
-	0xd8, 0xe2, 0x19, 0x4b, 0x54, 0x58, 0xc6, 0xa0, 0xf1, 0x31, 0xd0, 0x6c, 0xf0, 0xbc, 0x87, 0xef,
-	0x80, 0x69, 0x87, 0x60, 0xc3, 0xfc, 0x69, 0x8f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x53,
-	0x6e, 0x63, 0x2c, 0x04, 0x00, 0x00,
+	// 912 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x26, 0xae, 0x13, 0x3f, 0x37, 0x2d, 0x9d, 0x98, 0xc6, 0x44, 0xc4, 0x71, 0x2d, 0x81,
+	0xac, 0x4a, 0xd9, 0x55, 0x02, 0x48, 0xd0, 0x13, 0x4d, 0x8b, 0x91, 0x25, 0x50, 0xad, 0xcd, 0x01,
+	0x89, 0xcb, 0x6a, 0x3c, 0xfb, 0x6c, 0x8f, 0xbc, 0x3b, 0xb3, 0xda, 0x59, 0x3b, 0x75, 0x11, 0x17,
+	0x38, 0x71, 0xe3, 0xc0, 0x89, 0x03, 0x17, 0x6e, 0x9c, 0x7a, 0xe0, 0x43, 0xf4, 0x58, 0x89, 0x0b,
+	0x27, 0x40, 0x09, 0x52, 0xbf, 0x00, 0x1f, 0x00, 0xcd, 0x9f, 0x3a, 0xab, 0xa0, 0x9e, 0x00, 0x5f,
+	0xbc, 0xfb, 0xfe, 0xcc, 0xfb, 0xfd, 0xde, 0xdb, 0xf7, 0x9e, 0x07, 0xee, 0xf0, 0x21, 0x0b, 0x68,
+	0x96, 0x25, 0x9c, 0xd1, 0x82, 0x4b, 0xa1, 0x82, 0x11, 0x62, 0x30, 0x3f, 0xd2, 0x0f, 0x3f, 0xcb,
+	0x65, 0x21, 0xc9, 0x2e, 0x1f, 0x32, 0xbf, 0xec, 0xe2, 0x6b, 0xdb, 0xfc, 0x68, 0xef, 0x16, 0x4d,
+	0xb9, 0x90, 0x81, 0xf9, 0xb5, 0xbe, 0x7b, 0x2d, 0x26, 0x55, 0x2a, 0x55, 0x30, 0xa4, 0x4a, 0x47,
+	0x19, 0x62, 0x41, 0x8f, 0x02, 0x26, 0xb9, 0x70, 0xf6, 0xc6, 0x58, 0x8e, 0xa5, 0x79, 0x0d, 0xf4,
+	0x9b, 0xd3, 0x1a, 0x12, 0x4c, 0xe6, 0x18, 0xb0, 0x09, 0x15, 0x02, 0x13, 0x4d, 0xc0, 0xbd, 0x3a,
+	0x97, 0x5d, 0x17, 0x38, 0x55, 0x63, 0x6d, 0x4c, 0xd5, 0xd8, 0x1a, 0x3a, 0x7f, 0xad, 0xc3, 0x46,
+	0x0f, 0x91, 0x9c, 0xc1, 0x56, 0x8e, 0x6c, 0x1e, 0x8d, 0x10, 0x9b, 0x5e, 0x7b, 0xa3, 0x5b, 0x3f,
+	0x7e, 0xc3, 0xb7, 0x67, 0x7c, 0x4d, 0xc6, 0x77, 0x64, 0xfc, 0x07, 0x92, 0x8b, 0x93, 0xfb, 0xcf,
+	0x7e, 0x3b, 0x58, 0xfb, 0xe9, 0xf7, 0x83, 0xee, 0x98, 0x17, 0x93, 0xd9, 0xd0, 0x67, 0x32, 0x0d,
+	0x1c, 0x80, 0x7d, 0x1c, 0xaa, 0x78, 0x1a, 0x14, 0x8b, 0x0c, 0x95, 0x39, 0xa0, 0xbe, 0x7f, 0xf1,
+	0xf4, 0xee, 0xf5, 0x04, 0xc7, 0x94, 0x2d, 0x22, 0x9d, 0x8e, 0x0a, 0x37, 0x35, 0x9a, 0x06, 0x9e,
+	0xc1, 0x26, 0x65, 0x53, 0x83, 0xbb, 0xbe, 0x02, 0xdc, 0x2a, 0x65, 0x53, 0x0d, 0xfb, 0x25, 0xd4,
+	0x0b, 0x9e, 0xa2, 0x9c, 0x15, 0x06, 0x7a, 0x63, 0x05, 0xd0, 0xe0, 0x00, 0x7b, 0x88, 0x9d, 0x3f,
+	0x3d, 0xa8, 0x0d, 0x28, 0x9b, 0xa2, 0x96, 0xc8, 0xbb, 0xb0, 0x61, 0xeb, 0xee, 0x75, 0xeb, 0xc7,
+	0x6f, 0xfa, 0xaf, 0x68, 0x18, 0xbf, 0x87, 0x78, 0x52, 0xd1, 0x3c, 0x42, 0xed, 0x4e, 0xde, 0x82,
+	0x1b, 0x39, 0x8e, 0x66, 0x22, 0x8e, 0x68, 0x1c, 0xe7, 0xa8, 0x54, 0x73, 0xbd, 0xed, 0x75, 0x6b,
+	0xe1, 0xb6, 0xd5, 0xde, 0xb7, 0x4a, 0xb2, 0xa7, 0xbf, 0x6c, 0x42, 0x17, 0x98, 0x2b, 0x93, 0x66,
+	0x2d, 0x5c, 0xca, 0xe4, 0x0e, 0x5c, 0x1f, 0x2e, 0x32, 0xaa, 0x54, 0x94, 0xd1, 0x05, 0x62, 0xb3,
+	0xd2, 0xf6, 0xba, 0x5b, 0x61, 0xdd, 0xea, 0x06, 0x5a, 0x45, 0xde, 0x86, 0x9b, 0x52, 0x24, 0x8b,
+	0x48, 0x8a, 0x48, 0xcd, 0x18, 0xd3, 0x30, 0xd7, 0x8c, 0xd7, 0xb6, 0x56, 0x3f, 0x12, 0xa7, 0x56,
+	0x79, 0x6f, 0xe7, 0xab, 0x17, 0x4f, 0xef, 0x5e, 0x21, 0xd4, 0xf9, 0x0c, 0x60, 0x99, 0xa5, 0x22,
+	0x7d, 0xa8, 0x67, 0x46, 0xd2, 0x25, 0x57, 0xae, 0xcd, 0x3a, 0xaf, 0x4c, 0x77, 0x79, 0xd2, 0x25,
+	0x0d, 0xd9, 0x32, 0x54, 0xe7, 0x47, 0x0f, 0x1a, 0xfd, 0x18, 0x45, 0xc1, 0x47, 0x1c, 0xe3, 0x12,
+	0xc6, 0x87, 0x50, 0x73, 0x18, 0x3c, 0x76, 0x05, 0xdd, 0x37, 0x08, 0x7a, 0x3e, 0xfc, 0x97, 0x43,
+	0xb1, 0x8c, 0xde, 0x8f, 0x5d, 0xf0, 0xad, 0xcc, 0xc9, 0x57, 0x59, 0xae, 0xff, 0x0b, 0x96, 0xbf,
+	0x78, 0xb0, 0xd3, 0x43, 0xfc, 0x54, 0xc6, 0xb3, 0x04, 0x3f, 0x91, 0x6c, 0x1a, 0x22, 0x55, 0x52,
+	0xfc, 0x07, 0x24, 0x9f, 0x40, 0x4d, 0x4d, 0x64, 0x5e, 0x8c, 0x68, 0x92, 0xac, 0x64, 0x6e, 0x2e,
+	0xe1, 0x3a, 0xdf, 0x55, 0xe0, 0xe6, 0x03, 0xcb, 0xb1, 0x87, 0x78, 0x5a, 0xd0, 0x42, 0x91, 0x5d,
+	0xd8, 0xcc, 0x64, 0xbe, 0xcc, 0xa7, 0x16, 0x56, 0xb5, 0xd8, 0x8f, 0xc9, 0x3e, 0x80, 0xcb, 0x47,
+	0xdb, 0x6c, 0x83, 0xd6, 0x9c, 0xa6, 0x1f, 0x93, 0xaf, 0x3d, 0xb8, 0x51, 0xc8, 0x82, 0x26, 0x11,
+	0x2a, 0x96, 0xcb, 0x33, 0x8c, 0x57, 0x32, 0x8a, 0xdb, 0x06, 0xf3, 0x23, 0x07, 0x49, 0xbe, 0xf1,
+	0xe0, 0x96, 0x65, 0x11, 0x73, 0x55, 0xe4, 0x7c, 0x38, 0x2b, 0x30, 0x6e, 0x56, 0x56, 0x40, 0xe4,
+	0x35, 0x03, 0xfb, 0xf0, 0x12, 0xb5, 0x54, 0x11, 0x3b, 0x4b, 0x18, 0x37, 0xaf, 0xad, 0xac, 0x22,
+	0xa1, 0x83, 0x24, 0x47, 0xd0, 0xe0, 0x82, 0xe9, 0xf9, 0x9a, 0xf3, 0x27, 0x18, 0x47, 0xb6, 0xf1,
+	0x54, 0xb3, 0xda, 0xf6, 0xba, 0x95, 0x70, 0xa7, 0x6c, 0xb3, 0x3d, 0xaa, 0x3a, 0xe7, 0x1e, 0x54,
+	0x07, 0x34, 0xa7, 0xa9, 0x22, 0xc7, 0xf0, 0xba, 0x3a, 0x43, 0xcc, 0x22, 0x2e, 0xe6, 0x34, 0xe1,
+	0xb1, 0x4b, 0x45, 0x99, 0xde, 0xd8, 0x0a, 0x77, 0x8c, 0xb1, 0x6f, 0x6d, 0x16, 0x52, 0x91, 0x03,
+	0xa8, 0xbb, 0xe5, 0xa1, 0xb8, 0x98, 0xba, 0x4e, 0x01, 0xab, 0x3a, 0xe5, 0x62, 0x4a, 0x3e, 0x86,
+	0x76, 0xe9, 0xeb, 0xe8, 0xe1, 0x8c, 0x72, 0x2c, 0x34, 0x0d, 0x29, 0xa2, 0x0c, 0x73, 0x2e, 0x75,
+	0xef, 0x68, 0x7a, 0xfb, 0x25, 0xbf, 0x1e, 0x62, 0xf8, 0xd2, 0x6b, 0x60, 0x9c, 0xc8, 0x3d, 0xd8,
+	0x4b, 0xe9, 0xe3, 0xa8, 0x34, 0xe4, 0xfa, 0xb8, 0x93, 0xcd, 0x0a, 0xac, 0x84, 0xb7, 0x53, 0xfa,
+	0xf8, 0x72, 0xab, 0x0c, 0x30, 0xb7, 0x42, 0xe7, 0x67, 0x0f, 0x1a, 0x0f, 0xaf, 0x44, 0x67, 0x32,
+	0x8f, 0xc9, 0x6d, 0xa8, 0x4e, 0x90, 0x8f, 0x27, 0x85, 0xc9, 0xb1, 0x12, 0x3a, 0x89, 0x34, 0xe0,
+	0x9a, 0x5d, 0xad, 0x36, 0x21, 0x2b, 0x10, 0x61, 0x17, 0xfe, 0x2a, 0x5a, 0x5d, 0x03, 0x75, 0x7e,
+	0x30, 0x7f, 0x37, 0x0b, 0x44, 0xb3, 0x23, 0x97, 0x9c, 0xbc, 0x32, 0xa7, 0x2f, 0x00, 0x6c, 0xdf,
+	0x95, 0xd6, 0xde, 0xff, 0xbc, 0x53, 0x0c, 0x9e, 0xa6, 0x74, 0xf2, 0xe8, 0xd9, 0x79, 0xcb, 0x7b,
+	0x7e, 0xde, 0xf2, 0xfe, 0x38, 0x6f, 0x79, 0xdf, 0x5e, 0xb4, 0xd6, 0x9e, 0x5f, 0xb4, 0xd6, 0x7e,
+	0xbd, 0x68, 0xad, 0x7d, 0xfe, 0xde, 0x3f, 0xe3, 0xf3, 0x21, 0x3b, 0x1c, 0xcb, 0x60, 0xfe, 0x7e,
+	0x90, 0x9a, 0xcd, 0xaa, 0xf4, 0x0d, 0x4c, 0x05, 0xc7, 0x1f, 0x1c, 0xea, 0xcb, 0x97, 0x81, 0x1c,
+	0x56, 0xcd, 0xf5, 0xe6, 0x9d, 0xbf, 0x07, 0x00, 0x82, 0x41, 0xd4, 0x85, 0xa1, 0x09, 0x00, 0x00,
 }
 
 func (m *Fee) Marshal() (dAtA []byte, err error) {
@@ -388,6 +777,26 @@ func (m *PacketFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OnlyOnSuccess {
+		i--
+		if m.OnlyOnSuccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BypassPayee {
+		i--
+		if m.BypassPayee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Relayers) > 0 {
 		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Relayers[iNdEx])
@@ -501,38 +910,312 @@ func (m *IdentifiedPacketFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFee(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *FeeModuleLockReason) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Fee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *FeeModuleLockReason) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeModuleLockReason) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RecvFee) > 0 {
-		for _, e := range m.RecvFee {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
+	if len(m.Shortfall) > 0 {
+		for iNdEx := len(m.Shortfall) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shortfall[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.AckFee) > 0 {
-		for _, e := range m.AckFee {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
+	{
+		size, err := m.PacketId.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintFee(dAtA, i, uint64(size))
 	}
-	if len(m.TimeoutFee) > 0 {
-		for _, e := range m.TimeoutFee {
-			l = e.Size()
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelFeeStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelFeeStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelFeeStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncentivizedPackets != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.IncentivizedPackets))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TotalRefunded) > 0 {
+		for iNdEx := len(m.TotalRefunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRefunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TotalDistributed) > 0 {
+		for iNdEx := len(m.TotalDistributed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDistributed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TotalEscrowed) > 0 {
+		for iNdEx := len(m.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalEscrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPacketFeesPerPacket != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.MaxPacketFeesPerPacket))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DistributedFeeRetentionPeriod != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.DistributedFeeRetentionPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RefundSink) > 0 {
+		i -= len(m.RefundSink)
+		copy(dAtA[i:], m.RefundSink)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.RefundSink)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SweepInvalidRefunds {
+		i--
+		if m.SweepInvalidRefunds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributedFeeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributedFeeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributedFeeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintFee(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayeeFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayeeFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayeeFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalFees) > 0 {
+		for iNdEx := len(m.TotalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Payee) > 0 {
+		i -= len(m.Payee)
+		copy(dAtA[i:], m.Payee)
+		i = encodeVarintFee(dAtA, i, uint64(len(m.Payee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Fee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecvFee) > 0 {
+		for _, e := range m.RecvFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.AckFee) > 0 {
+		for _, e := range m.AckFee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.TimeoutFee) > 0 {
+		for _, e := range m.TimeoutFee {
+			l = e.Size()
 			n += 1 + l + sovFee(uint64(l))
 		}
 	}
@@ -557,6 +1240,12 @@ func (m *PacketFee) Size() (n int) {
 			n += 1 + l + sovFee(uint64(l))
 		}
 	}
+	if m.BypassPayee {
+		n += 2
+	}
+	if m.OnlyOnSuccess {
+		n += 2
+	}
 	return n
 }
 
@@ -572,33 +1261,808 @@ func (m *PacketFees) Size() (n int) {
 			n += 1 + l + sovFee(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *IdentifiedPacketFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovFee(uint64(l))
+	if len(m.PacketFees) > 0 {
+		for _, e := range m.PacketFees {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeModuleLockReason) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PacketId.Size()
+	n += 1 + l + sovFee(uint64(l))
+	if len(m.Shortfall) > 0 {
+		for _, e := range m.Shortfall {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelFeeStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if len(m.TotalEscrowed) > 0 {
+		for _, e := range m.TotalEscrowed {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.TotalDistributed) > 0 {
+		for _, e := range m.TotalDistributed {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if len(m.TotalRefunded) > 0 {
+		for _, e := range m.TotalRefunded {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	if m.IncentivizedPackets != 0 {
+		n += 1 + sovFee(uint64(m.IncentivizedPackets))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SweepInvalidRefunds {
+		n += 2
+	}
+	l = len(m.RefundSink)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if m.DistributedFeeRetentionPeriod != 0 {
+		n += 1 + sovFee(uint64(m.DistributedFeeRetentionPeriod))
+	}
+	if m.MaxPacketFeesPerPacket != 0 {
+		n += 1 + sovFee(uint64(m.MaxPacketFeesPerPacket))
+	}
+	return n
+}
+
+func (m *DistributedFeeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFee(uint64(m.Height))
+	}
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PayeeFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Payee)
+	if l > 0 {
+		n += 1 + l + sovFee(uint64(l))
+	}
+	if len(m.TotalFees) > 0 {
+		for _, e := range m.TotalFees {
+			l = e.Size()
+			n += 1 + l + sovFee(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFee(x uint64) (n int) {
+	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Fee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvFee = append(m.RecvFee, types.Coin{})
+			if err := m.RecvFee[len(m.RecvFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckFee = append(m.AckFee, types.Coin{})
+			if err := m.AckFee[len(m.AckFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeoutFee = append(m.TimeoutFee, types.Coin{})
+			if err := m.TimeoutFee[len(m.TimeoutFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BypassPayee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BypassPayee = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnlyOnSuccess", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OnlyOnSuccess = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketFees = append(m.PacketFees, PacketFee{})
+			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentifiedPacketFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentifiedPacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketFees = append(m.PacketFees, PacketFee{})
+			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeModuleLockReason) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeModuleLockReason: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeModuleLockReason: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shortfall", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shortfall = append(m.Shortfall, types.Coin{})
+			if err := m.Shortfall[len(m.Shortfall)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *IdentifiedPacketFees) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.PacketId.Size()
-	n += 1 + l + sovFee(uint64(l))
-	if len(m.PacketFees) > 0 {
-		for _, e := range m.PacketFees {
-			l = e.Size()
-			n += 1 + l + sovFee(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovFee(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFee(x uint64) (n int) {
-	return sovFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Fee) Unmarshal(dAtA []byte) error {
+func (m *ChannelFeeStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -621,15 +2085,79 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Fee: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelFeeStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Fee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelFeeStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecvFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEscrowed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -656,14 +2184,14 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecvFee = append(m.RecvFee, types.Coin{})
-			if err := m.RecvFee[len(m.RecvFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalEscrowed = append(m.TotalEscrowed, types.Coin{})
+			if err := m.TotalEscrowed[len(m.TotalEscrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AckFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDistributed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -690,14 +2218,14 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AckFee = append(m.AckFee, types.Coin{})
-			if err := m.AckFee[len(m.AckFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalDistributed = append(m.TotalDistributed, types.Coin{})
+			if err := m.TotalDistributed[len(m.TotalDistributed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRefunded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -724,11 +2252,30 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimeoutFee = append(m.TimeoutFee, types.Coin{})
-			if err := m.TimeoutFee[len(m.TimeoutFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalRefunded = append(m.TotalRefunded, types.Coin{})
+			if err := m.TotalRefunded[len(m.TotalRefunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivizedPackets", wireType)
+			}
+			m.IncentivizedPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IncentivizedPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFee(dAtA[iNdEx:])
@@ -750,7 +2297,7 @@ func (m *Fee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketFee) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -773,17 +2320,17 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SweepInvalidRefunds", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -793,28 +2340,15 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthFee
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthFee
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.SweepInvalidRefunds = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundSink", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -842,13 +2376,13 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			m.RefundSink = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedFeeRetentionPeriod", wireType)
 			}
-			var stringLen uint64
+			m.DistributedFeeRetentionPeriod = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -858,24 +2392,30 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DistributedFeeRetentionPeriod |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFee
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFee
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketFeesPerPacket", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MaxPacketFeesPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketFeesPerPacket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFee(dAtA[iNdEx:])
@@ -897,7 +2437,7 @@ func (m *PacketFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketFees) Unmarshal(dAtA []byte) error {
+func (m *DistributedFeeRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -920,15 +2460,66 @@ func (m *PacketFees) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketFees: wiretype end group for non-group")
+			return fmt.Errorf("proto: DistributedFeeRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DistributedFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFee
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -955,8 +2546,8 @@ func (m *PacketFees) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketFees = append(m.PacketFees, PacketFee{})
-			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -981,7 +2572,7 @@ func (m *PacketFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
+func (m *PayeeFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1004,17 +2595,17 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IdentifiedPacketFees: wiretype end group for non-group")
+			return fmt.Errorf("proto: PayeeFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IdentifiedPacketFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PayeeFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFee
@@ -1024,28 +2615,27 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFee
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFee
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PacketId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Payee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1072,8 +2662,8 @@ func (m *IdentifiedPacketFees) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketFees = append(m.PacketFees, PacketFee{})
-			if err := m.PacketFees[len(m.PacketFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalFees = append(m.TotalFees, types.Coin{})
+			if err := m.TotalFees[len(m.TotalFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
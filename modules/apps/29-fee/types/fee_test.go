@@ -110,6 +110,62 @@ func TestFeeTotal(t *testing.T) {
 				sdk.NewCoin("denom5", sdkmath.NewInt(300)),
 			),
 		},
+		{
+			"success: overlapping denoms across fees",
+			func() {
+				fee = types.NewFee(
+					sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(100)), sdk.NewCoin("denom", sdkmath.NewInt(100))),
+					sdk.NewCoins(sdk.NewCoin("denom", sdkmath.NewInt(50)), sdk.NewCoin("osmo", sdkmath.NewInt(100))),
+					sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(200)), sdk.NewCoin("denom", sdkmath.NewInt(100))),
+				)
+			},
+			sdk.NewCoins(
+				sdk.NewCoin("atom", sdkmath.NewInt(200)),
+				sdk.NewCoin("denom", sdkmath.NewInt(150)),
+				sdk.NewCoin("osmo", sdkmath.NewInt(100)),
+			),
+		},
+		{
+			"success: disjoint denoms across fees",
+			func() {
+				fee = types.NewFee(
+					sdk.NewCoins(sdk.NewCoin("denom", sdkmath.NewInt(100))),
+					sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(200))),
+					sdk.NewCoins(sdk.NewCoin("osmo", sdkmath.NewInt(300))),
+				)
+			},
+			sdk.NewCoins(
+				sdk.NewCoin("atom", sdkmath.NewInt(200)),
+				sdk.NewCoin("denom", sdkmath.NewInt(100)),
+				sdk.NewCoin("osmo", sdkmath.NewInt(300)),
+			),
+		},
+		{
+			"success: unsorted and repeated denoms are normalized",
+			func() {
+				fee = types.NewFee(
+					sdk.Coins{sdk.NewCoin("denom", sdkmath.NewInt(100)), sdk.NewCoin("atom", sdkmath.NewInt(100)), sdk.NewCoin("denom", sdkmath.NewInt(50))},
+					sdk.Coins{sdk.NewCoin("osmo", sdkmath.NewInt(100)), sdk.NewCoin("denom", sdkmath.NewInt(50))},
+					sdk.Coins{sdk.NewCoin("osmo", sdkmath.NewInt(300)), sdk.NewCoin("atom", sdkmath.NewInt(50)), sdk.NewCoin("atom", sdkmath.NewInt(100))},
+				)
+			},
+			sdk.NewCoins(
+				sdk.NewCoin("atom", sdkmath.NewInt(150)),
+				sdk.NewCoin("denom", sdkmath.NewInt(200)),
+				sdk.NewCoin("osmo", sdkmath.NewInt(300)),
+			),
+		},
+		{
+			"success: zero coins are dropped",
+			func() {
+				fee = types.NewFee(
+					sdk.Coins{sdk.NewCoin("denom", sdkmath.NewInt(100)), sdk.NewCoin("atom", sdkmath.ZeroInt())},
+					sdk.Coins{},
+					sdk.Coins{sdk.NewCoin("osmo", sdkmath.ZeroInt())},
+				)
+			},
+			sdk.NewCoins(sdk.NewCoin("denom", sdkmath.NewInt(100))),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -125,6 +181,65 @@ func TestFeeTotal(t *testing.T) {
 	}
 }
 
+func TestFeeTopUpTo(t *testing.T) {
+	var target types.Fee
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expTopUp types.Fee
+	}{
+		{
+			"fee meets the target",
+			func() {},
+			types.NewFee(nil, nil, nil),
+		},
+		{
+			"fee exceeds the target",
+			func() {
+				target = types.NewFee(nil, defaultRecvFee, nil)
+			},
+			types.NewFee(nil, nil, nil),
+		},
+		{
+			"fee falls short of the target",
+			func() {
+				target = types.NewFee(defaultTimeoutFee, defaultAckFee, defaultRecvFee)
+			},
+			types.NewFee(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(200))), nil, nil),
+		},
+		{
+			"target in a different denom",
+			func() {
+				target = types.NewFee(
+					sdk.NewCoins(defaultRecvFee[0], sdk.NewCoin("denom", sdkmath.NewInt(100))),
+					nil,
+					sdk.NewCoins(sdk.NewCoin("denom", sdkmath.NewInt(300))),
+				)
+			},
+			types.NewFee(
+				sdk.NewCoins(sdk.NewCoin("denom", sdkmath.NewInt(100))),
+				nil,
+				sdk.NewCoins(sdk.NewCoin("denom", sdkmath.NewInt(300))),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			target = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+			tc.malleate() // malleate mutates test data
+
+			require.Equal(t, tc.expTopUp, fee.TopUpTo(target))
+		})
+	}
+}
+
 func TestPacketFeeValidation(t *testing.T) {
 	var packetFee types.PacketFee
 
@@ -200,6 +315,37 @@ func TestPacketFeeValidation(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"should pass with overlapping denoms across fees",
+			func() {
+				packetFee.Fee.RecvFee = packetFee.Fee.RecvFee.Add(sdk.NewCoin("atom", sdkmath.NewInt(100)))
+				packetFee.Fee.TimeoutFee = packetFee.Fee.TimeoutFee.Add(sdk.NewCoin("atom", sdkmath.NewInt(100)))
+			},
+			true,
+		},
+		{
+			"should pass with disjoint denoms across fees",
+			func() {
+				packetFee.Fee.RecvFee = sdk.NewCoins(sdk.NewCoin("atom", sdkmath.NewInt(100)))
+				packetFee.Fee.AckFee = sdk.NewCoins(sdk.NewCoin("denom", sdkmath.NewInt(100)))
+				packetFee.Fee.TimeoutFee = sdk.NewCoins(sdk.NewCoin("osmo", sdkmath.NewInt(100)))
+			},
+			true,
+		},
+		{
+			"should fail with unsorted denoms",
+			func() {
+				packetFee.Fee.RecvFee = sdk.Coins{sdk.NewCoin("osmo", sdkmath.NewInt(100)), sdk.NewCoin("atom", sdkmath.NewInt(100))}
+			},
+			false,
+		},
+		{
+			"should fail with repeated denom",
+			func() {
+				packetFee.Fee.TimeoutFee = sdk.Coins{sdk.NewCoin("atom", sdkmath.NewInt(100)), sdk.NewCoin("atom", sdkmath.NewInt(100))}
+			},
+			false,
+		},
 		{
 			"should fail with non empty Relayers",
 			func() {
@@ -18,6 +18,15 @@ func NewGenesisState(
 	registeredPayees []RegisteredPayee,
 	registeredCounterpartyPayees []RegisteredCounterpartyPayee,
 	forwardRelayers []ForwardRelayerAddress,
+	allowedRelayers []AllowedRelayers,
+	registeredDenomPayees []RegisteredDenomPayee,
+	params Params,
+	channelFeeStats []ChannelFeeStats,
+	distributedFeeRecords []DistributedFeeRecord,
+	locked bool,
+	lockReason *FeeModuleLockReason,
+	acceptedFeeDenoms []string,
+	refundAddressOverrides []RefundAddressOverride,
 ) *GenesisState {
 	return &GenesisState{
 		IdentifiedFees:               identifiedFees,
@@ -25,6 +34,16 @@ func NewGenesisState(
 		RegisteredPayees:             registeredPayees,
 		RegisteredCounterpartyPayees: registeredCounterpartyPayees,
 		ForwardRelayers:              forwardRelayers,
+		AllowedRelayers:              allowedRelayers,
+		RegisteredDenomPayees:        registeredDenomPayees,
+		Params:                       params,
+		ChannelFeeStats:              channelFeeStats,
+		DistributedFeeRecords:        distributedFeeRecords,
+		Locked:                       locked,
+		LockReason:                   lockReason,
+		AcceptedFeeDenoms:            acceptedFeeDenoms,
+		TotalEscrowed:                TotalEscrowedFees(identifiedFees),
+		RefundAddressOverrides:       refundAddressOverrides,
 	}
 }
 
@@ -36,6 +55,13 @@ func DefaultGenesisState() *GenesisState {
 		FeeEnabledChannels:           []FeeEnabledChannel{},
 		RegisteredPayees:             []RegisteredPayee{},
 		RegisteredCounterpartyPayees: []RegisteredCounterpartyPayee{},
+		AllowedRelayers:              []AllowedRelayers{},
+		RegisteredDenomPayees:        []RegisteredDenomPayee{},
+		Params:                       DefaultParams(),
+		ChannelFeeStats:              []ChannelFeeStats{},
+		DistributedFeeRecords:        []DistributedFeeRecord{},
+		AcceptedFeeDenoms:            []string{},
+		RefundAddressOverrides:       []RefundAddressOverride{},
 	}
 }
 
@@ -43,16 +69,51 @@ func DefaultGenesisState() *GenesisState {
 // failure.
 func (gs GenesisState) Validate() error {
 	// Validate IdentifiedPacketFees
+	seenPackets := make(map[string]bool)
 	for _, identifiedFees := range gs.IdentifiedFees {
 		if err := identifiedFees.PacketId.Validate(); err != nil {
 			return err
 		}
 
+		key := identifiedFees.PacketId.String()
+		if seenPackets[key] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate identified fees for packet %s", key)
+		}
+		seenPackets[key] = true
+
 		for _, packetFee := range identifiedFees.PacketFees {
 			if err := packetFee.Validate(); err != nil {
 				return err
 			}
 		}
+
+		if err := gs.Params.ValidatePacketFeesCount(len(identifiedFees.PacketFees)); err != nil {
+			return errorsmod.Wrapf(err, "invalid identified fees for packet %s", key)
+		}
+	}
+
+	// Validate TotalEscrowed, which may be omitted
+	if err := gs.TotalEscrowed.Validate(); err != nil {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if !gs.TotalEscrowed.Empty() && !gs.TotalEscrowed.Equal(TotalEscrowedFees(gs.IdentifiedFees)) {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "total escrowed %s does not match the sum of the identified fees %s", gs.TotalEscrowed, TotalEscrowedFees(gs.IdentifiedFees))
+	}
+
+	// Validate LockReason
+	if gs.LockReason != nil {
+		if !gs.Locked {
+			return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "lock reason must not be set if the fee module is not locked")
+		}
+
+		if err := gs.LockReason.PacketId.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid lock reason")
+		}
+
+		if err := gs.LockReason.Shortfall.Validate(); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid lock reason shortfall: %s", err)
+		}
 	}
 
 	// Validate FeeEnabledChannels
@@ -66,6 +127,7 @@ func (gs GenesisState) Validate() error {
 	}
 
 	// Validate RegisteredPayees
+	seenPayees := make(map[string]bool)
 	for _, registeredPayee := range gs.RegisteredPayees {
 		if registeredPayee.Relayer == registeredPayee.Payee {
 			return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "relayer address and payee address must not be equal")
@@ -82,9 +144,57 @@ func (gs GenesisState) Validate() error {
 		if err := host.ChannelIdentifierValidator(registeredPayee.ChannelId); err != nil {
 			return errorsmod.Wrapf(err, "invalid channel identifier: %s", registeredPayee.ChannelId)
 		}
+
+		key := string(KeyPayee(registeredPayee.Relayer, registeredPayee.ChannelId))
+		if seenPayees[key] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate payee registration for relayer %s on channel %s", registeredPayee.Relayer, registeredPayee.ChannelId)
+		}
+		seenPayees[key] = true
+	}
+
+	// Validate RegisteredDenomPayees
+	for _, registeredDenomPayee := range gs.RegisteredDenomPayees {
+		if _, err := sdk.AccAddressFromBech32(registeredDenomPayee.Relayer); err != nil {
+			return errorsmod.Wrap(err, "failed to convert relayer address into sdk.AccAddress")
+		}
+
+		if _, err := sdk.AccAddressFromBech32(registeredDenomPayee.Payee); err != nil {
+			return errorsmod.Wrap(err, "failed to convert payee address into sdk.AccAddress")
+		}
+
+		if err := host.ChannelIdentifierValidator(registeredDenomPayee.ChannelId); err != nil {
+			return errorsmod.Wrapf(err, "invalid channel identifier: %s", registeredDenomPayee.ChannelId)
+		}
+
+		if err := sdk.ValidateDenom(registeredDenomPayee.Denom); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid fee denomination %s: %s", registeredDenomPayee.Denom, err)
+		}
+	}
+
+	// Validate RefundAddressOverrides
+	seenRefundOverrides := make(map[string]bool)
+	for _, refundOverride := range gs.RefundAddressOverrides {
+		if _, err := sdk.AccAddressFromBech32(refundOverride.RefundAddress); err != nil {
+			return errorsmod.Wrap(err, "failed to convert refund address into sdk.AccAddress")
+		}
+
+		if _, err := sdk.AccAddressFromBech32(refundOverride.OverrideAddress); err != nil {
+			return errorsmod.Wrap(err, "failed to convert override address into sdk.AccAddress")
+		}
+
+		if err := host.ChannelIdentifierValidator(refundOverride.ChannelId); err != nil {
+			return errorsmod.Wrapf(err, "invalid channel identifier: %s", refundOverride.ChannelId)
+		}
+
+		key := string(KeyRefundOverride(refundOverride.RefundAddress, refundOverride.ChannelId))
+		if seenRefundOverrides[key] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate refund address override for refund address %s on channel %s", refundOverride.RefundAddress, refundOverride.ChannelId)
+		}
+		seenRefundOverrides[key] = true
 	}
 
 	// Validate RegisteredCounterpartyPayees
+	seenCounterpartyPayees := make(map[string]bool)
 	for _, registeredCounterpartyPayee := range gs.RegisteredCounterpartyPayees {
 		if _, err := sdk.AccAddressFromBech32(registeredCounterpartyPayee.Relayer); err != nil {
 			return errorsmod.Wrap(err, "failed to convert relayer address into sdk.AccAddress")
@@ -97,6 +207,12 @@ func (gs GenesisState) Validate() error {
 		if err := host.ChannelIdentifierValidator(registeredCounterpartyPayee.ChannelId); err != nil {
 			return errorsmod.Wrapf(err, "invalid channel identifier: %s", registeredCounterpartyPayee.ChannelId)
 		}
+
+		key := string(KeyCounterpartyPayee(registeredCounterpartyPayee.Relayer, registeredCounterpartyPayee.ChannelId))
+		if seenCounterpartyPayees[key] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate counterparty payee registration for relayer %s on channel %s", registeredCounterpartyPayee.Relayer, registeredCounterpartyPayee.ChannelId)
+		}
+		seenCounterpartyPayees[key] = true
 	}
 
 	// Validate ForwardRelayers
@@ -110,5 +226,121 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Validate AllowedRelayers
+	seenChannels := make(map[string]bool)
+	for _, allowedRelayers := range gs.AllowedRelayers {
+		if err := allowedRelayers.Validate(); err != nil {
+			return err
+		}
+
+		if len(allowedRelayers.Relayers) == 0 {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "allowed relayers for port ID %s and channel ID %s must not be empty", allowedRelayers.PortId, allowedRelayers.ChannelId)
+		}
+
+		key := string(KeyAllowedRelayers(allowedRelayers.PortId, allowedRelayers.ChannelId))
+		if seenChannels[key] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate allowed relayers for port ID %s and channel ID %s", allowedRelayers.PortId, allowedRelayers.ChannelId)
+		}
+		seenChannels[key] = true
+	}
+
+	// Validate ChannelFeeStats
+	seenStats := make(map[string]bool)
+	for _, stats := range gs.ChannelFeeStats {
+		if err := stats.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyChannelFeeStats(stats.PortId, stats.ChannelId))
+		if seenStats[key] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate fee statistics for port ID %s and channel ID %s", stats.PortId, stats.ChannelId)
+		}
+		seenStats[key] = true
+	}
+
+	// Validate DistributedFeeRecords
+	seenRecords := make(map[string]bool)
+	for _, record := range gs.DistributedFeeRecords {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyDistributedFeeRecord(record.Height, record.Payee))
+		if seenRecords[key] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate distributed fee record for payee %s at height %d", record.Payee, record.Height)
+		}
+		seenRecords[key] = true
+	}
+
+	// Validate AcceptedFeeDenoms
+	if err := ValidateAcceptedFeeDenoms(gs.AcceptedFeeDenoms); err != nil {
+		return err
+	}
+
+	return gs.Params.Validate()
+}
+
+// ValidateAcceptedFeeDenoms checks that each of the provided denominations is valid and that no denomination is repeated.
+// An empty list is valid and indicates that fees may be paid in any denomination.
+func ValidateAcceptedFeeDenoms(denoms []string) error {
+	seenDenoms := make(map[string]bool)
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid accepted fee denomination %s: %v", denom, err)
+		}
+
+		if seenDenoms[denom] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate accepted fee denomination %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	return nil
+}
+
+// TotalEscrowedFees returns the sum of the fees held in escrow for the provided identified packet fees
+func TotalEscrowedFees(identifiedFees []IdentifiedPacketFees) sdk.Coins {
+	total := sdk.NewCoins()
+	for _, identifiedFee := range identifiedFees {
+		for _, packetFee := range identifiedFee.PacketFees {
+			total = total.Add(packetFee.Fee.Total()...)
+		}
+	}
+
+	return total
+}
+
+// NewAllowedRelayers creates a new AllowedRelayers instance for the given port and channel identifiers
+func NewAllowedRelayers(portID, channelID string, relayers []string) AllowedRelayers {
+	return AllowedRelayers{
+		PortId:    portID,
+		ChannelId: channelID,
+		Relayers:  relayers,
+	}
+}
+
+// Validate performs basic stateless validation of the AllowedRelayers. The identifiers must be valid
+// and each relayer must be a valid, unique address.
+func (ar AllowedRelayers) Validate() error {
+	if err := host.PortIdentifierValidator(ar.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if err := host.ChannelIdentifierValidator(ar.ChannelId); err != nil {
+		return errorsmod.Wrap(err, "invalid channel ID")
+	}
+
+	seenRelayers := make(map[string]bool)
+	for _, relayer := range ar.Relayers {
+		if _, err := sdk.AccAddressFromBech32(relayer); err != nil {
+			return errorsmod.Wrap(err, "failed to convert relayer address into sdk.AccAddress")
+		}
+
+		if seenRelayers[relayer] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "duplicate relayer address: %s", relayer)
+		}
+		seenRelayers[relayer] = true
+	}
+
 	return nil
 }
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cometbft/cometbft/crypto/secp256k1"
@@ -67,6 +69,73 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid identified fees: duplicate packet ID",
+			func() {
+				genState.IdentifiedFees = append(genState.IdentifiedFees, genState.IdentifiedFees[0])
+			},
+			false,
+		},
+		{
+			"success - total escrowed matching identified fees",
+			func() {
+				genState.TotalEscrowed = types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee).Total()
+			},
+			true,
+		},
+		{
+			"invalid total escrowed: does not match identified fees",
+			func() {
+				genState.TotalEscrowed = defaultRecvFee
+			},
+			false,
+		},
+		{
+			"invalid total escrowed: invalid coins",
+			func() {
+				genState.TotalEscrowed = sdk.Coins{sdk.Coin{Denom: "", Amount: sdkmath.NewInt(100)}}
+			},
+			false,
+		},
+		{
+			"success - locked with lock reason",
+			func() {
+				genState.Locked = true
+				genState.LockReason = &types.FeeModuleLockReason{
+					PacketId:  channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1),
+					Shortfall: defaultRecvFee,
+				}
+			},
+			true,
+		},
+		{
+			"success - locked without lock reason",
+			func() {
+				genState.Locked = true
+			},
+			true,
+		},
+		{
+			"invalid lock reason: fee module not locked",
+			func() {
+				genState.LockReason = &types.FeeModuleLockReason{
+					PacketId:  channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1),
+					Shortfall: defaultRecvFee,
+				}
+			},
+			false,
+		},
+		{
+			"invalid lock reason: invalid packet ID",
+			func() {
+				genState.Locked = true
+				genState.LockReason = &types.FeeModuleLockReason{
+					PacketId:  channeltypes.NewPacketID(ibctesting.MockFeePort, ibctesting.FirstChannelID, 0),
+					Shortfall: defaultRecvFee,
+				}
+			},
+			false,
+		},
 		{
 			"invalid fee enabled channel: invalid port ID",
 			func() {
@@ -102,6 +171,80 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid registered payee: duplicate relayer and channel",
+			func() {
+				duplicate := genState.RegisteredPayees[0]
+				duplicate.Payee = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+				genState.RegisteredPayees = append(genState.RegisteredPayees, duplicate)
+			},
+			false,
+		},
+		{
+			"success - registered payees of a relayer on different channels",
+			func() {
+				other := genState.RegisteredPayees[0]
+				other.ChannelId = "channel-1"
+				genState.RegisteredPayees = append(genState.RegisteredPayees, other)
+			},
+			true,
+		},
+		{
+			"invalid registered denom payee: invalid relayer address",
+			func() {
+				genState.RegisteredDenomPayees[0].Relayer = ""
+			},
+			false,
+		},
+		{
+			"invalid registered denom payee: invalid payee address",
+			func() {
+				genState.RegisteredDenomPayees[0].Payee = ""
+			},
+			false,
+		},
+		{
+			"invalid registered denom payee: invalid channel ID",
+			func() {
+				genState.RegisteredDenomPayees[0].ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid registered denom payee: invalid denom",
+			func() {
+				genState.RegisteredDenomPayees[0].Denom = ""
+			},
+			false,
+		},
+		{
+			"invalid refund address override: invalid refund address",
+			func() {
+				genState.RefundAddressOverrides[0].RefundAddress = ""
+			},
+			false,
+		},
+		{
+			"invalid refund address override: invalid override address",
+			func() {
+				genState.RefundAddressOverrides[0].OverrideAddress = ""
+			},
+			false,
+		},
+		{
+			"invalid refund address override: invalid channel ID",
+			func() {
+				genState.RefundAddressOverrides[0].ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid refund address override: duplicate override",
+			func() {
+				genState.RefundAddressOverrides = append(genState.RefundAddressOverrides, genState.RefundAddressOverrides[0])
+			},
+			false,
+		},
 		{
 			"invalid registered counterparty payees: invalid relayer address",
 			func() {
@@ -116,6 +259,15 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid registered counterparty payees: duplicate relayer and channel",
+			func() {
+				duplicate := genState.RegisteredCounterpartyPayees[0]
+				duplicate.CounterpartyPayee = "other-counterparty-payee"
+				genState.RegisteredCounterpartyPayees = append(genState.RegisteredCounterpartyPayees, duplicate)
+			},
+			false,
+		},
 		{
 			"invalid forward relayer address: invalid forward address",
 			func() {
@@ -130,6 +282,119 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"invalid allowed relayers: invalid port ID",
+			func() {
+				genState.AllowedRelayers[0].PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid allowed relayers: invalid relayer address",
+			func() {
+				genState.AllowedRelayers[0].Relayers = []string{"invalid-address"}
+			},
+			false,
+		},
+		{
+			"invalid allowed relayers: empty relayers",
+			func() {
+				genState.AllowedRelayers[0].Relayers = []string{}
+			},
+			false,
+		},
+		{
+			"invalid allowed relayers: duplicate channel",
+			func() {
+				genState.AllowedRelayers = append(genState.AllowedRelayers, genState.AllowedRelayers[0])
+			},
+			false,
+		},
+		{
+			"invalid channel fee stats: invalid channel ID",
+			func() {
+				genState.ChannelFeeStats[0].ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel fee stats: invalid coins",
+			func() {
+				genState.ChannelFeeStats[0].TotalRefunded = sdk.Coins{sdk.Coin{Denom: "", Amount: sdkmath.NewInt(100)}}
+			},
+			false,
+		},
+		{
+			"invalid channel fee stats: duplicate channel",
+			func() {
+				genState.ChannelFeeStats = append(genState.ChannelFeeStats, genState.ChannelFeeStats[0])
+			},
+			false,
+		},
+		{
+			"invalid distributed fee records: zero height",
+			func() {
+				genState.DistributedFeeRecords[0].Height = 0
+			},
+			false,
+		},
+		{
+			"invalid distributed fee records: invalid payee address",
+			func() {
+				genState.DistributedFeeRecords[0].Payee = "invalid-address"
+			},
+			false,
+		},
+		{
+			"invalid distributed fee records: invalid coins",
+			func() {
+				genState.DistributedFeeRecords[0].Fee = sdk.Coins{sdk.Coin{Denom: "", Amount: sdkmath.NewInt(100)}}
+			},
+			false,
+		},
+		{
+			"invalid distributed fee records: duplicate record",
+			func() {
+				genState.DistributedFeeRecords = append(genState.DistributedFeeRecords, genState.DistributedFeeRecords[0])
+			},
+			false,
+		},
+		{
+			"invalid accepted fee denoms: invalid denom",
+			func() {
+				genState.AcceptedFeeDenoms = []string{""}
+			},
+			false,
+		},
+		{
+			"invalid accepted fee denoms: duplicate denom",
+			func() {
+				genState.AcceptedFeeDenoms = append(genState.AcceptedFeeDenoms, sdk.DefaultBondDenom)
+			},
+			false,
+		},
+		{
+			"valid genesis: packet fees at maximum packet fees per packet",
+			func() {
+				genState.Params.MaxPacketFeesPerPacket = 1
+			},
+			true,
+		},
+		{
+			"invalid genesis: packet fees exceed maximum packet fees per packet",
+			func() {
+				genState.Params.MaxPacketFeesPerPacket = 1
+				genState.IdentifiedFees[0].PacketFees = append(genState.IdentifiedFees[0].PacketFees, genState.IdentifiedFees[0].PacketFees[0])
+			},
+			false,
+		},
+		{
+			"invalid params: invalid refund sink",
+			func() {
+				genState.Params = types.NewParams(true, "invalid-address")
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -168,6 +433,37 @@ func TestValidateGenesis(t *testing.T) {
 					ChannelId: ibctesting.FirstChannelID,
 				},
 			},
+			AllowedRelayers: []types.AllowedRelayers{
+				types.NewAllowedRelayers(ibctesting.MockFeePort, ibctesting.FirstChannelID, []string{defaultAccAddress}),
+			},
+			RegisteredDenomPayees: []types.RegisteredDenomPayee{
+				{
+					Relayer:   defaultAccAddress,
+					Payee:     sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+					ChannelId: ibctesting.FirstChannelID,
+					Denom:     sdk.DefaultBondDenom,
+				},
+			},
+			RefundAddressOverrides: []types.RefundAddressOverride{
+				{
+					ChannelId:       ibctesting.FirstChannelID,
+					RefundAddress:   defaultAccAddress,
+					OverrideAddress: sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String(),
+				},
+			},
+			ChannelFeeStats: []types.ChannelFeeStats{
+				{
+					PortId:              ibctesting.MockFeePort,
+					ChannelId:           ibctesting.FirstChannelID,
+					TotalEscrowed:       defaultRecvFee,
+					IncentivizedPackets: 1,
+				},
+			},
+			DistributedFeeRecords: []types.DistributedFeeRecord{
+				types.NewDistributedFeeRecord(10, defaultAccAddress, defaultRecvFee),
+				types.NewDistributedFeeRecord(11, defaultAccAddress, defaultRecvFee),
+			},
+			AcceptedFeeDenoms: []string{sdk.DefaultBondDenom},
 		}
 
 		tc.malleate()
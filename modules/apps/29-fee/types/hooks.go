@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// FeeType defines the portion of an escrowed packet fee which is distributed
+type FeeType string
+
+const (
+	// FeeTypeRecv is the fee paid for relaying the packet to the counterparty chain
+	FeeTypeRecv FeeType = "recv_fee"
+	// FeeTypeAck is the fee paid for relaying the acknowledgement back to the source chain
+	FeeTypeAck FeeType = "ack_fee"
+	// FeeTypeTimeout is the fee paid for relaying the timeout back to the source chain
+	FeeTypeTimeout FeeType = "timeout_fee"
+	// FeeTypeRefund is the unused amount of the escrowed fee which is refunded to the refund address
+	FeeTypeRefund FeeType = "refund"
+)
+
+// FeeHooks defines the hooks which are called by the 29-fee module when fees are distributed.
+// Hooks cannot abort the distribution of fees: a panicking hook is recovered and its state changes discarded.
+type FeeHooks interface {
+	// AfterFeeDistributed is called after the provided coins have been successfully sent from the escrow
+	// account to the payee. The payee is the refund address if the fee could not be paid to the relayer.
+	AfterFeeDistributed(ctx sdk.Context, packetID channeltypes.PacketId, payee sdk.AccAddress, coins sdk.Coins, feeType FeeType)
+}
+
+var _ FeeHooks = MultiFeeHooks{}
+
+// MultiFeeHooks combines multiple fee hooks, all hook functions are run in array sequence
+type MultiFeeHooks []FeeHooks
+
+// NewMultiFeeHooks returns a new MultiFeeHooks instance
+func NewMultiFeeHooks(hooks ...FeeHooks) MultiFeeHooks {
+	return hooks
+}
+
+// AfterFeeDistributed implements FeeHooks
+func (h MultiFeeHooks) AfterFeeDistributed(ctx sdk.Context, packetID channeltypes.PacketId, payee sdk.AccAddress, coins sdk.Coins, feeType FeeType) {
+	for _, hook := range h {
+		hook.AfterFeeDistributed(ctx, packetID, payee, coins, feeType)
+	}
+}
@@ -32,6 +32,12 @@ const (
 	// PayeeKeyPrefix is the key prefix for the fee payee address stored in state
 	PayeeKeyPrefix = "payee"
 
+	// DenomPayeeKeyPrefix is the key prefix for the fee payee address of a specific fee denomination stored in state
+	DenomPayeeKeyPrefix = "denomPayee"
+
+	// RefundOverrideKeyPrefix is the key prefix for the refund address override mapping
+	RefundOverrideKeyPrefix = "refundOverride"
+
 	// CounterpartyPayeeKeyPrefix is the key prefix for the counterparty payee address mapping
 	CounterpartyPayeeKeyPrefix = "counterpartyPayee"
 
@@ -40,6 +46,34 @@ const (
 
 	// ForwardRelayerPrefix is the key prefix for forward relayer addresses stored in state for async acknowledgements
 	ForwardRelayerPrefix = "forwardRelayer"
+
+	// AllowedRelayersPrefix is the key prefix for the relayers allowed to be paid fees on a channel
+	AllowedRelayersPrefix = "allowedRelayers"
+
+	// ChannelFeeStatsPrefix is the key prefix for the aggregate fee statistics of a channel
+	ChannelFeeStatsPrefix = "channelFeeStats"
+
+	// DistributedFeeRecordPrefix is the key prefix for the records of the fees distributed to payees per block
+	DistributedFeeRecordPrefix = "distributedFeeRecord"
+
+	// DistributedFeePayeePrefix is the key prefix for the set of payees with distributed fee records
+	DistributedFeePayeePrefix = "distributedFeePayee"
+
+	// DistributedFeePayeeRecordPrefix is the key prefix for the index of the distributed fee records by payee
+	DistributedFeePayeeRecordPrefix = "distributedFeePayeeRecord"
+
+	// DistributedFeeRecordPruneCursorKey is the key under which the height of the last distributed fee record pruned
+	// is stored
+	DistributedFeeRecordPruneCursorKey = "distributedFeeRecordPruneCursor"
+
+	// MaxDistributedFeeRecordsPrunedPerBlock is the maximum number of distributed fee records pruned in a block
+	MaxDistributedFeeRecordsPrunedPerBlock = 100
+
+	// AcceptedFeeDenomPrefix is the key prefix for the denominations accepted as packet fees
+	AcceptedFeeDenomPrefix = "acceptedFeeDenom"
+
+	// ParamsKey defines the key to store the params in store
+	ParamsKey = "params"
 )
 
 // KeyLocked returns the key used to lock and unlock the fee module. This key is used
@@ -48,6 +82,11 @@ func KeyLocked() []byte {
 	return []byte("locked")
 }
 
+// KeyLockReason returns the key used to store the reason the fee module was locked.
+func KeyLockReason() []byte {
+	return []byte("lockReason")
+}
+
 // KeyFeeEnabled returns the key that stores a flag to determine if fee logic should
 // be enabled for the given port and channel identifiers.
 func KeyFeeEnabled(portID, channelID string) []byte {
@@ -91,11 +130,60 @@ func ParseKeyPayeeAddress(key string) (relayerAddr, channelID string, err error)
 	return keySplit[1], keySplit[2], nil
 }
 
+// KeyDenomPayee returns the key for relayer address -> payee address mapping of a specific fee denomination
+func KeyDenomPayee(relayerAddr, channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", DenomPayeeKeyPrefix, relayerAddr, channelID, denom))
+}
+
+// ParseKeyDenomPayee returns the registered relayer address, channelID and fee denomination used to store the payee address.
+// The denomination may contain the key separator and is therefore always the remainder of the key.
+func ParseKeyDenomPayee(key string) (relayerAddr, channelID, denom string, err error) {
+	keySplit := strings.SplitN(key, "/", 4)
+	if len(keySplit) != 4 {
+		return "", "", "", errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 4, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != DenomPayeeKeyPrefix {
+		return "", "", "", errorsmod.Wrapf(ibcerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", DenomPayeeKeyPrefix, keySplit[0])
+	}
+
+	return keySplit[1], keySplit[2], keySplit[3], nil
+}
+
+// KeyRefundOverride returns the key for refund address -> refund address override mapping
+func KeyRefundOverride(refundAddr, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", RefundOverrideKeyPrefix, refundAddr, channelID))
+}
+
+// ParseKeyRefundOverride returns the refund address and channelID used to store the refund address override
+func ParseKeyRefundOverride(key string) (refundAddr, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 {
+		return "", "", errorsmod.Wrapf(
+			ibcerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	if keySplit[0] != RefundOverrideKeyPrefix {
+		return "", "", errorsmod.Wrapf(ibcerrors.ErrLogic, "key prefix is incorrect: expected %s, got %s", RefundOverrideKeyPrefix, keySplit[0])
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
 // KeyCounterpartyPayee returns the key for relayer address -> counterparty payee address mapping
 func KeyCounterpartyPayee(address, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", CounterpartyPayeeKeyPrefix, address, channelID))
 }
 
+// KeyCounterpartyPayeesForRelayerPrefix returns the key prefix under which all counterparty payee addresses
+// registered by the given relayer address are stored
+func KeyCounterpartyPayeesForRelayerPrefix(address string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", CounterpartyPayeeKeyPrefix, address))
+}
+
 // ParseKeyCounterpartyPayee returns the registered relayer address and channelID used to store the counterparty payee address
 func ParseKeyCounterpartyPayee(key string) (address string, channelID string, error error) {
 	keySplit := strings.Split(key, "/")
@@ -131,6 +219,50 @@ func ParseKeyRelayerAddressForAsyncAck(key string) (channeltypes.PacketId, error
 	return packetID, nil
 }
 
+// KeyAllowedRelayers returns the key for the relayers allowed to be paid fees on the given port and channel identifiers
+func KeyAllowedRelayers(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", AllowedRelayersPrefix, portID, channelID))
+}
+
+// KeyAcceptedFeeDenom returns the key for the given denomination in the accepted fee denominations registry
+func KeyAcceptedFeeDenom(denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", AcceptedFeeDenomPrefix, denom))
+}
+
+// KeyChannelFeeStats returns the key for the aggregate fee statistics of the given port and channel identifiers
+func KeyChannelFeeStats(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", ChannelFeeStatsPrefix, portID, channelID))
+}
+
+// KeyDistributedFeeRecordHeightPrefix returns the key prefix for the records of the fees distributed at the given
+// block height. The height is zero padded so that records are iterated in ascending order of height.
+func KeyDistributedFeeRecordHeightPrefix(height uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%020d/", DistributedFeeRecordPrefix, height))
+}
+
+// KeyDistributedFeeRecord returns the key for the record of the fees distributed to the given payee at the given
+// block height
+func KeyDistributedFeeRecord(height uint64, payee string) []byte {
+	return append(KeyDistributedFeeRecordHeightPrefix(height), []byte(payee)...)
+}
+
+// KeyDistributedFeePayee returns the key marking that the given payee has distributed fee records
+func KeyDistributedFeePayee(payee string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", DistributedFeePayeePrefix, payee))
+}
+
+// KeyDistributedFeePayeeRecordPrefix returns the key prefix for the index of the distributed fee records of the given
+// payee
+func KeyDistributedFeePayeeRecordPrefix(payee string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/", DistributedFeePayeeRecordPrefix, payee))
+}
+
+// KeyDistributedFeePayeeRecord returns the index key for the record of the fees distributed to the given payee at the
+// given block height. The height is zero padded so that the records of a payee are iterated in ascending order of height.
+func KeyDistributedFeePayeeRecord(payee string, height uint64) []byte {
+	return append(KeyDistributedFeePayeeRecordPrefix(payee), []byte(fmt.Sprintf("%020d", height))...)
+}
+
 // KeyFeesInEscrow returns the key for escrowed fees
 func KeyFeesInEscrow(packetID channeltypes.PacketId) []byte {
 	return []byte(fmt.Sprintf("%s/%d", KeyFeesInEscrowChannelPrefix(packetID.PortId, packetID.ChannelId), packetID.Sequence))
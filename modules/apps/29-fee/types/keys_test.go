@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"fmt"
 	"testing"
 
@@ -51,6 +52,93 @@ func TestParseKeyPayee(t *testing.T) {
 	}
 }
 
+func TestParseKeyDenomPayee(t *testing.T) {
+	testCases := []struct {
+		name     string
+		key      string
+		expDenom string
+		expPass  bool
+	}{
+		{
+			"success",
+			string(types.KeyDenomPayee("relayer-address", ibctesting.FirstChannelID, "stake")),
+			"stake",
+			true,
+		},
+		{
+			"success: denom containing key separator",
+			string(types.KeyDenomPayee("relayer-address", ibctesting.FirstChannelID, "transfer/channel-1/uatom")),
+			"transfer/channel-1/uatom",
+			true,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			fmt.Sprintf("%s/%s/%s", types.DenomPayeeKeyPrefix, "relayer-address", ibctesting.FirstChannelID),
+			"",
+			false,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			string(types.KeyPayee("relayer-address", ibctesting.FirstChannelID)) + "/stake",
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		address, channelID, denom, err := types.ParseKeyDenomPayee(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, "relayer-address", address)
+			require.Equal(t, ibctesting.FirstChannelID, channelID)
+			require.Equal(t, tc.expDenom, denom)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestParseKeyRefundOverride(t *testing.T) {
+	testCases := []struct {
+		name    string
+		key     string
+		expPass bool
+	}{
+		{
+			"success",
+			string(types.KeyRefundOverride("refund-address", ibctesting.FirstChannelID)),
+			true,
+		},
+		{
+			"incorrect key - key split has incorrect length",
+			fmt.Sprintf("%s/%s/%s/%s", types.RefundOverrideKeyPrefix, "refund-address", ibctesting.FirstChannelID, "extra"),
+			false,
+		},
+		{
+			"incorrect key - key prefix is incorrect",
+			string(types.KeyPayee("refund-address", ibctesting.FirstChannelID)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		refundAddr, channelID, err := types.ParseKeyRefundOverride(tc.key)
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, "refund-address", refundAddr)
+			require.Equal(t, ibctesting.FirstChannelID, channelID)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
 func TestKeyCounterpartyPayee(t *testing.T) {
 	var (
 		relayerAddress = "relayer_address"
@@ -180,6 +268,17 @@ func TestParseKeyForwardRelayerAddress(t *testing.T) {
 	}
 }
 
+func TestKeyCounterpartyPayeesForRelayerPrefix(t *testing.T) {
+	var (
+		relayerAddress = "relayer_address"
+		channelID      = "channel-0"
+	)
+
+	prefix := types.KeyCounterpartyPayeesForRelayerPrefix(relayerAddress)
+	require.Equal(t, string(prefix), fmt.Sprintf("%s/%s/", types.CounterpartyPayeeKeyPrefix, relayerAddress))
+	require.True(t, bytes.HasPrefix(types.KeyCounterpartyPayee(relayerAddress, channelID), prefix))
+}
+
 func TestParseKeyCounterpartyPayee(t *testing.T) {
 	relayerAddress := "relayer_address"
 
@@ -1,6 +1,14 @@
 package types
 
-import errorsmod "cosmossdk.io/errors"
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
 
 // MetadataFromVersion attempts to parse the given string into a fee version Metadata,
 // an error is returned if it fails to do so.
@@ -13,3 +21,55 @@ func MetadataFromVersion(version string) (Metadata, error) {
 
 	return metadata, nil
 }
+
+// MetadataWithCounterpartyPayeeFromVersion attempts to parse the given string into a fee version metadata with an
+// embedded relayer and counterparty payee, an error is returned if it fails to do so.
+func MetadataWithCounterpartyPayeeFromVersion(version string) (MetadataWithCounterpartyPayee, error) {
+	var metadata MetadataWithCounterpartyPayee
+	err := ModuleCdc.UnmarshalJSON([]byte(version), &metadata)
+	if err != nil {
+		return MetadataWithCounterpartyPayee{}, errorsmod.Wrapf(ErrInvalidVersion, "failed to unmarshal metadata with counterparty payee from version: %s", version)
+	}
+
+	return metadata, nil
+}
+
+// CounterpartyPayeeSignBytes returns the bytes a relayer must sign to register a counterparty payee embedded in the
+// fee version when initialising the channel identified by the given chain, port and channel identifiers.
+func CounterpartyPayeeSignBytes(chainID, portID, channelID, relayer, counterpartyPayee string) []byte {
+	return []byte(strings.Join([]string{ModuleName, chainID, portID, channelID, relayer, counterpartyPayee}, "/"))
+}
+
+// Metadata returns the fee version Metadata without the embedded relayer and counterparty payee.
+func (m MetadataWithCounterpartyPayee) Metadata() Metadata {
+	return Metadata{
+		FeeVersion: m.FeeVersion,
+		AppVersion: m.AppVersion,
+	}
+}
+
+// HasCounterpartyPayee returns true if a relayer or counterparty payee is embedded in the metadata.
+func (m MetadataWithCounterpartyPayee) HasCounterpartyPayee() bool {
+	return m.Relayer != "" || m.CounterpartyPayee != ""
+}
+
+// ValidateCounterpartyPayee validates the relayer and counterparty payee embedded in the metadata.
+func (m MetadataWithCounterpartyPayee) ValidateCounterpartyPayee() error {
+	if _, err := sdk.AccAddressFromBech32(m.Relayer); err != nil {
+		return errorsmod.Wrap(err, "failed to create sdk.AccAddress from relayer address")
+	}
+
+	if strings.TrimSpace(m.CounterpartyPayee) == "" {
+		return ErrCounterpartyPayeeEmpty
+	}
+
+	if len(m.CounterpartyPayee) > MaximumCounterpartyPayeeLength {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "counterparty payee address must not exceed %d bytes", MaximumCounterpartyPayeeLength)
+	}
+
+	if len(m.Signature) == 0 {
+		return errorsmod.Wrap(ErrInvalidPayeeSignature, "signature must not be empty")
+	}
+
+	return nil
+}
@@ -78,8 +78,93 @@ func (m *Metadata) GetAppVersion() string {
 	return ""
 }
 
+// MetadataWithCounterpartyPayee defines the ICS29 channel specific metadata with an embedded relayer and counterparty payee,
+// which may be provided as the channel version when initialising a channel. The counterparty payee is registered for the relayer
+// by the fee middleware if the relayer signed it, and only the Metadata fields are used as the channel version.
+type MetadataWithCounterpartyPayee struct {
+	// fee_version defines the ICS29 fee version
+	FeeVersion string `protobuf:"bytes,1,opt,name=fee_version,json=feeVersion,proto3" json:"fee_version,omitempty"`
+	// app_version defines the underlying application version, which may or may not be a JSON encoded bytestring
+	AppVersion string `protobuf:"bytes,2,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	// the relayer address for which the counterparty payee is registered
+	Relayer string `protobuf:"bytes,3,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	// the counterparty payee address
+	CounterpartyPayee string `protobuf:"bytes,4,opt,name=counterparty_payee,json=counterpartyPayee,proto3" json:"counterparty_payee,omitempty"`
+	// the relayer signature over the counterparty payee sign bytes
+	Signature []byte `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MetadataWithCounterpartyPayee) Reset()         { *m = MetadataWithCounterpartyPayee{} }
+func (m *MetadataWithCounterpartyPayee) String() string { return proto.CompactTextString(m) }
+func (*MetadataWithCounterpartyPayee) ProtoMessage()    {}
+func (*MetadataWithCounterpartyPayee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03d0f000eda681ce, []int{1}
+}
+func (m *MetadataWithCounterpartyPayee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MetadataWithCounterpartyPayee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MetadataWithCounterpartyPayee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MetadataWithCounterpartyPayee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MetadataWithCounterpartyPayee.Merge(m, src)
+}
+func (m *MetadataWithCounterpartyPayee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MetadataWithCounterpartyPayee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MetadataWithCounterpartyPayee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MetadataWithCounterpartyPayee proto.InternalMessageInfo
+
+func (m *MetadataWithCounterpartyPayee) GetFeeVersion() string {
+	if m != nil {
+		return m.FeeVersion
+	}
+	return ""
+}
+
+func (m *MetadataWithCounterpartyPayee) GetAppVersion() string {
+	if m != nil {
+		return m.AppVersion
+	}
+	return ""
+}
+
+func (m *MetadataWithCounterpartyPayee) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *MetadataWithCounterpartyPayee) GetCounterpartyPayee() string {
+	if m != nil {
+		return m.CounterpartyPayee
+	}
+	return ""
+}
+
+func (m *MetadataWithCounterpartyPayee) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Metadata)(nil), "ibc.applications.fee.v1.Metadata")
+	proto.RegisterType((*MetadataWithCounterpartyPayee)(nil), "ibc.applications.fee.v1.MetadataWithCounterpartyPayee")
 }
 
 func init() {
@@ -87,21 +172,26 @@ func init() {
 }
 
 var fileDescriptor_03d0f000eda681ce = []byte{
-	// 214 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcb, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0x4f, 0x4b, 0x4d,
-	0xd5, 0x2f, 0x33, 0xd4, 0xcf, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x12, 0xcf, 0x4c, 0x4a, 0xd6, 0x43, 0x56, 0xa7, 0x97, 0x96, 0x9a, 0xaa, 0x57,
-	0x66, 0xa8, 0xe4, 0xc3, 0xc5, 0xe1, 0x0b, 0x55, 0x2a, 0x24, 0xcf, 0xc5, 0x9d, 0x96, 0x9a, 0x1a,
-	0x5f, 0x96, 0x5a, 0x54, 0x9c, 0x99, 0x9f, 0x27, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0xc4, 0x95,
-	0x96, 0x9a, 0x1a, 0x06, 0x11, 0x01, 0x29, 0x48, 0x2c, 0x28, 0x80, 0x2b, 0x60, 0x82, 0x28, 0x48,
-	0x2c, 0x28, 0x80, 0x2a, 0x70, 0xf2, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
-	0x28, 0xd3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xfd, 0xe4, 0xfc, 0xe2,
-	0xdc, 0xfc, 0x62, 0xfd, 0xcc, 0xa4, 0x64, 0xdd, 0xf4, 0x7c, 0xfd, 0x32, 0x0b, 0xfd, 0xdc, 0xfc,
-	0x94, 0xd2, 0x9c, 0xd4, 0x62, 0x90, 0x47, 0x8a, 0xf5, 0x8d, 0x2c, 0x75, 0x41, 0x7e, 0x28, 0xa9,
-	0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x3b, 0xdf, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x6a,
-	0x8c, 0x45, 0xe8, 0x00, 0x00, 0x00,
+	// 290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0xd1, 0xbf, 0x4e, 0xeb, 0x30,
+	0x18, 0x05, 0xf0, 0xfa, 0x5e, 0xfe, 0xd5, 0xb0, 0x90, 0x85, 0x0c, 0x60, 0xaa, 0x0e, 0xa8, 0x4b,
+	0x62, 0x15, 0x84, 0x04, 0x2b, 0xac, 0x20, 0x50, 0x07, 0x90, 0x58, 0xaa, 0x2f, 0xee, 0x97, 0xd4,
+	0x52, 0x12, 0x5b, 0xb6, 0x13, 0x29, 0x6f, 0xc1, 0x63, 0x75, 0xec, 0xc8, 0x88, 0x92, 0x17, 0x41,
+	0x49, 0x5b, 0xa8, 0xc4, 0xc8, 0xe8, 0xe3, 0x9f, 0x7c, 0x2c, 0x1d, 0x7a, 0x21, 0x23, 0xc1, 0x41,
+	0xeb, 0x54, 0x0a, 0x70, 0x52, 0xe5, 0x96, 0xc7, 0x88, 0xbc, 0x1c, 0xf3, 0x0c, 0x1d, 0xcc, 0xc0,
+	0x41, 0xa8, 0x8d, 0x72, 0xca, 0x3b, 0x91, 0x91, 0x08, 0xb7, 0x5d, 0x18, 0x23, 0x86, 0xe5, 0x78,
+	0xf8, 0x40, 0x0f, 0x1e, 0xd7, 0xd4, 0x3b, 0xa7, 0x87, 0x31, 0xe2, 0xb4, 0x44, 0x63, 0xa5, 0xca,
+	0x7d, 0x32, 0x20, 0xa3, 0xfe, 0x84, 0xc6, 0x88, 0x2f, 0xab, 0xa4, 0x05, 0xa0, 0xf5, 0x37, 0xf8,
+	0xb7, 0x02, 0xa0, 0xf5, 0x1a, 0x0c, 0x17, 0x84, 0x9e, 0x6d, 0x9e, 0x7b, 0x95, 0x6e, 0x7e, 0xaf,
+	0x8a, 0xdc, 0xa1, 0xd1, 0x60, 0x5c, 0xf5, 0x0c, 0x15, 0xe2, 0xdf, 0x3b, 0x3c, 0x9f, 0xee, 0x1b,
+	0x4c, 0xa1, 0x42, 0xe3, 0xff, 0xef, 0x2e, 0x37, 0x47, 0x2f, 0xa0, 0x9e, 0xd8, 0x2a, 0x9c, 0xea,
+	0xb6, 0xd1, 0xdf, 0xe9, 0xd0, 0xb1, 0xf8, 0xf5, 0x95, 0x53, 0xda, 0xb7, 0x32, 0xc9, 0xc1, 0x15,
+	0x06, 0xfd, 0xdd, 0x01, 0x19, 0x1d, 0x4d, 0x7e, 0x82, 0xbb, 0xa7, 0x45, 0xcd, 0xc8, 0xb2, 0x66,
+	0xe4, 0xb3, 0x66, 0xe4, 0xbd, 0x61, 0xbd, 0x65, 0xc3, 0x7a, 0x1f, 0x0d, 0xeb, 0xbd, 0x5d, 0x27,
+	0xd2, 0xcd, 0x8b, 0x28, 0x14, 0x2a, 0xe3, 0x42, 0xd9, 0x4c, 0x59, 0x2e, 0x23, 0x11, 0x24, 0x8a,
+	0x97, 0x37, 0x3c, 0x53, 0xb3, 0x22, 0x45, 0xdb, 0x6e, 0x62, 0xf9, 0xe5, 0x6d, 0xd0, 0xce, 0xe1,
+	0x2a, 0x8d, 0x36, 0xda, 0xeb, 0x96, 0xb8, 0xfa, 0x1a, 0x00, 0x49, 0x42, 0x8a, 0x6d, 0xb3, 0x01,
+	0x00, 0x00,
 }
 
 func (m *Metadata) Marshal() (dAtA []byte, err error) {
@@ -141,6 +231,64 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MetadataWithCounterpartyPayee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MetadataWithCounterpartyPayee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MetadataWithCounterpartyPayee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CounterpartyPayee) > 0 {
+		i -= len(m.CounterpartyPayee)
+		copy(dAtA[i:], m.CounterpartyPayee)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.CounterpartyPayee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AppVersion) > 0 {
+		i -= len(m.AppVersion)
+		copy(dAtA[i:], m.AppVersion)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.AppVersion)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeeVersion) > 0 {
+		i -= len(m.FeeVersion)
+		copy(dAtA[i:], m.FeeVersion)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.FeeVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMetadata(v)
 	base := offset
@@ -169,6 +317,35 @@ func (m *Metadata) Size() (n int) {
 	return n
 }
 
+func (m *MetadataWithCounterpartyPayee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeVersion)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	l = len(m.AppVersion)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	l = len(m.CounterpartyPayee)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	return n
+}
+
 func sovMetadata(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -289,6 +466,218 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MetadataWithCounterpartyPayee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MetadataWithCounterpartyPayee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MetadataWithCounterpartyPayee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyPayee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyPayee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMetadata(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
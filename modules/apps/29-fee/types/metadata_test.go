@@ -1,11 +1,14 @@
 package types_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
 )
 
@@ -28,3 +31,80 @@ func TestMetadataFromVersion(t *testing.T) {
 	require.ErrorIs(t, err, types.ErrInvalidVersion)
 	require.Empty(t, metadata)
 }
+
+func TestMetadataWithCounterpartyPayee(t *testing.T) {
+	testCases := []struct {
+		name     string
+		metadata types.MetadataWithCounterpartyPayee
+		expErr   error
+	}{
+		{
+			"success",
+			types.MetadataWithCounterpartyPayee{FeeVersion: types.Version, AppVersion: ibcmock.Version, Relayer: ibctesting.TestAccAddress, CounterpartyPayee: ibctesting.TestAccAddress, Signature: []byte("signature")},
+			nil,
+		},
+		{
+			"invalid relayer address",
+			types.MetadataWithCounterpartyPayee{FeeVersion: types.Version, AppVersion: ibcmock.Version, Relayer: "invalid-address", CounterpartyPayee: ibctesting.TestAccAddress, Signature: []byte("signature")},
+			errors.New("failed to create sdk.AccAddress from relayer address"),
+		},
+		{
+			"empty counterparty payee",
+			types.MetadataWithCounterpartyPayee{FeeVersion: types.Version, AppVersion: ibcmock.Version, Relayer: ibctesting.TestAccAddress, CounterpartyPayee: "  ", Signature: []byte("signature")},
+			types.ErrCounterpartyPayeeEmpty,
+		},
+		{
+			"counterparty payee too long",
+			types.MetadataWithCounterpartyPayee{FeeVersion: types.Version, AppVersion: ibcmock.Version, Relayer: ibctesting.TestAccAddress, CounterpartyPayee: ibctesting.GenerateString(types.MaximumCounterpartyPayeeLength + 1), Signature: []byte("signature")},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"empty signature",
+			types.MetadataWithCounterpartyPayee{FeeVersion: types.Version, AppVersion: ibcmock.Version, Relayer: ibctesting.TestAccAddress, CounterpartyPayee: ibctesting.TestAccAddress},
+			types.ErrInvalidPayeeSignature,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.True(t, tc.metadata.HasCounterpartyPayee())
+
+			err := tc.metadata.ValidateCounterpartyPayee()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErr.Error())
+			}
+		})
+	}
+
+	require.False(t, types.MetadataWithCounterpartyPayee{FeeVersion: types.Version, AppVersion: ibcmock.Version}.HasCounterpartyPayee())
+}
+
+func TestMetadataWithCounterpartyPayeeFromVersion(t *testing.T) {
+	testMetadata := types.MetadataWithCounterpartyPayee{
+		FeeVersion:        types.Version,
+		AppVersion:        ibcmock.Version,
+		Relayer:           ibctesting.TestAccAddress,
+		CounterpartyPayee: ibctesting.TestAccAddress,
+		Signature:         []byte("signature"),
+	}
+
+	versionBz, err := types.ModuleCdc.MarshalJSON(&testMetadata)
+	require.NoError(t, err)
+
+	metadata, err := types.MetadataWithCounterpartyPayeeFromVersion(string(versionBz))
+	require.NoError(t, err)
+	require.Equal(t, testMetadata, metadata)
+	require.Equal(t, types.Metadata{FeeVersion: types.Version, AppVersion: ibcmock.Version}, metadata.Metadata())
+
+	// the embedded counterparty payee is not part of the fee version metadata
+	_, err = types.MetadataFromVersion(string(versionBz))
+	require.ErrorIs(t, err, types.ErrInvalidVersion)
+
+	metadata, err = types.MetadataWithCounterpartyPayeeFromVersion("")
+	require.ErrorIs(t, err, types.ErrInvalidVersion)
+	require.Empty(t, metadata)
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -16,14 +17,30 @@ const MaximumCounterpartyPayeeLength = 2048 // maximum length of the counterpart
 
 var (
 	_ sdk.Msg = (*MsgRegisterPayee)(nil)
+	_ sdk.Msg = (*MsgRegisterDenomPayee)(nil)
 	_ sdk.Msg = (*MsgRegisterCounterpartyPayee)(nil)
 	_ sdk.Msg = (*MsgPayPacketFee)(nil)
 	_ sdk.Msg = (*MsgPayPacketFeeAsync)(nil)
+	_ sdk.Msg = (*MsgUpdateAllowedRelayers)(nil)
+	_ sdk.Msg = (*MsgUnlockFeeModule)(nil)
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+	_ sdk.Msg = (*MsgConvertEscrowedFees)(nil)
+	_ sdk.Msg = (*MsgRefundFeesOnClientExpiry)(nil)
+	_ sdk.Msg = (*MsgSetAcceptedFeeDenoms)(nil)
+	_ sdk.Msg = (*MsgRegisterRefundOverride)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgRegisterPayee)(nil)
+	_ sdk.HasValidateBasic = (*MsgRegisterDenomPayee)(nil)
 	_ sdk.HasValidateBasic = (*MsgRegisterCounterpartyPayee)(nil)
 	_ sdk.HasValidateBasic = (*MsgPayPacketFee)(nil)
 	_ sdk.HasValidateBasic = (*MsgPayPacketFeeAsync)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateAllowedRelayers)(nil)
+	_ sdk.HasValidateBasic = (*MsgUnlockFeeModule)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+	_ sdk.HasValidateBasic = (*MsgConvertEscrowedFees)(nil)
+	_ sdk.HasValidateBasic = (*MsgRefundFeesOnClientExpiry)(nil)
+	_ sdk.HasValidateBasic = (*MsgRegisterRefundOverride)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetAcceptedFeeDenoms)(nil)
 )
 
 // NewMsgRegisterPayee creates a new instance of MsgRegisterPayee
@@ -59,6 +76,75 @@ func (msg MsgRegisterPayee) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgRegisterDenomPayee creates a new instance of MsgRegisterDenomPayee
+func NewMsgRegisterDenomPayee(portID, channelID, relayerAddr, payeeAddr, denom string) *MsgRegisterDenomPayee {
+	return &MsgRegisterDenomPayee{
+		PortId:    portID,
+		ChannelId: channelID,
+		Relayer:   relayerAddr,
+		Payee:     payeeAddr,
+		Denom:     denom,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgRegisterDenomPayee) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Relayer)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to create sdk.AccAddress from relayer address")
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Payee)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to create sdk.AccAddress from payee address")
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid fee denomination %s: %s", msg.Denom, err)
+	}
+
+	return nil
+}
+
+// NewMsgRegisterRefundOverride creates a new instance of MsgRegisterRefundOverride
+func NewMsgRegisterRefundOverride(portID, channelID, refundAddr, overrideAddr string) *MsgRegisterRefundOverride {
+	return &MsgRegisterRefundOverride{
+		PortId:          portID,
+		ChannelId:       channelID,
+		RefundAddress:   refundAddr,
+		OverrideAddress: overrideAddr,
+	}
+}
+
+// ValidateBasic implements sdk.Msg and performs basic stateless validation
+func (msg MsgRegisterRefundOverride) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.RefundAddress); err != nil {
+		return errorsmod.Wrap(err, "failed to create sdk.AccAddress from refund address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.OverrideAddress); err != nil {
+		return errorsmod.Wrap(err, "failed to create sdk.AccAddress from override address")
+	}
+
+	return nil
+}
+
 // NewMsgRegisterCounterpartyPayee creates a new instance of MsgRegisterCounterpartyPayee
 func NewMsgRegisterCounterpartyPayee(portID, channelID, relayerAddr, counterpartyPayeeAddr string) *MsgRegisterCounterpartyPayee {
 	return &MsgRegisterCounterpartyPayee{
@@ -147,3 +233,167 @@ func (msg MsgPayPacketFeeAsync) ValidateBasic() error {
 
 	return msg.PacketFee.Validate()
 }
+
+// NewMsgUpdateAllowedRelayers creates a new instance of MsgUpdateAllowedRelayers
+func NewMsgUpdateAllowedRelayers(signer, portID, channelID string, relayers []string) *MsgUpdateAllowedRelayers {
+	return &MsgUpdateAllowedRelayers{
+		Signer:    signer,
+		PortId:    portID,
+		ChannelId: channelID,
+		Relayers:  relayers,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgUpdateAllowedRelayers fields
+func (msg MsgUpdateAllowedRelayers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return NewAllowedRelayers(msg.PortId, msg.ChannelId, msg.Relayers).Validate()
+}
+
+// NewMsgSetAcceptedFeeDenoms creates a new instance of MsgSetAcceptedFeeDenoms
+func NewMsgSetAcceptedFeeDenoms(signer string, denoms []string) *MsgSetAcceptedFeeDenoms {
+	return &MsgSetAcceptedFeeDenoms{
+		Signer: signer,
+		Denoms: denoms,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgSetAcceptedFeeDenoms fields
+func (msg MsgSetAcceptedFeeDenoms) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return ValidateAcceptedFeeDenoms(msg.Denoms)
+}
+
+// NewMsgUnlockFeeModule creates a new instance of MsgUnlockFeeModule
+func NewMsgUnlockFeeModule(signer string) *MsgUnlockFeeModule {
+	return &MsgUnlockFeeModule{
+		Signer: signer,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgUnlockFeeModule fields
+func (msg MsgUnlockFeeModule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgUpdateParams creates a new instance of MsgUpdateParams
+func NewMsgUpdateParams(signer string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Signer: signer,
+		Params: params,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgUpdateParams fields
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+// NewMsgConvertEscrowedFees creates a new instance of MsgConvertEscrowedFees
+func NewMsgConvertEscrowedFees(portID, channelID, fromDenom, toDenom, rate, pool, signer string) *MsgConvertEscrowedFees {
+	return &MsgConvertEscrowedFees{
+		PortId:    portID,
+		ChannelId: channelID,
+		FromDenom: fromDenom,
+		ToDenom:   toDenom,
+		Rate:      rate,
+		Pool:      pool,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgConvertEscrowedFees fields
+func (msg MsgConvertEscrowedFees) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(msg.FromDenom); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid denomination to convert from: %v", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.ToDenom); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid denomination to convert to: %v", err)
+	}
+
+	if msg.FromDenom == msg.ToDenom {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "denomination to convert from and to must differ, got %s", msg.FromDenom)
+	}
+
+	if _, err := msg.GetConversionRate(); err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(msg.Pool) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "pool module account name must not be empty")
+	}
+
+	if msg.Pool == ModuleName {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "pool module account must not be the %s module account", ModuleName)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// GetConversionRate parses the rate of the MsgConvertEscrowedFees and returns it as a decimal.
+// An error is returned if the rate is not a positive decimal.
+func (msg MsgConvertEscrowedFees) GetConversionRate() (sdkmath.LegacyDec, error) {
+	rate, err := sdkmath.LegacyNewDecFromStr(msg.Rate)
+	if err != nil {
+		return sdkmath.LegacyDec{}, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "invalid conversion rate %s: %v", msg.Rate, err)
+	}
+
+	if !rate.IsPositive() {
+		return sdkmath.LegacyDec{}, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "conversion rate must be positive, got %s", msg.Rate)
+	}
+
+	return rate, nil
+}
+
+// NewMsgRefundFeesOnClientExpiry creates a new instance of MsgRefundFeesOnClientExpiry
+func NewMsgRefundFeesOnClientExpiry(portID, channelID, signer string) *MsgRefundFeesOnClientExpiry {
+	return &MsgRefundFeesOnClientExpiry{
+		PortId:    portID,
+		ChannelId: channelID,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgRefundFeesOnClientExpiry fields
+func (msg MsgRefundFeesOnClientExpiry) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
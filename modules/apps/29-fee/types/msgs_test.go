@@ -97,6 +97,180 @@ func TestRegisterPayeeGetSigners(t *testing.T) {
 	require.Equal(t, accAddress.Bytes(), signers[0])
 }
 
+func TestMsgRegisterDenomPayeeValidation(t *testing.T) {
+	var msg *types.MsgRegisterDenomPayee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: denom containing path separators",
+			func() {
+				msg.Denom = "transfer/channel-1/uatom"
+			},
+			true,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid relayer address",
+			func() {
+				msg.Relayer = invalidAddress
+			},
+			false,
+		},
+		{
+			"invalid payee address",
+			func() {
+				msg.Payee = invalidAddress
+			},
+			false,
+		},
+		{
+			"empty denom",
+			func() {
+				msg.Denom = ""
+			},
+			false,
+		},
+		{
+			"invalid denom",
+			func() {
+				msg.Denom = "1denom"
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		relayerAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		payeeAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+		msg = types.NewMsgRegisterDenomPayee(ibctesting.MockPort, ibctesting.FirstChannelID, relayerAddr.String(), payeeAddr.String(), sdk.DefaultBondDenom)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestRegisterDenomPayeeGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgRegisterDenomPayee(ibctesting.MockPort, ibctesting.FirstChannelID, accAddress.String(), defaultAccAddress, sdk.DefaultBondDenom)
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, accAddress.Bytes(), signers[0])
+}
+
+func TestMsgRegisterRefundOverrideValidation(t *testing.T) {
+	var msg *types.MsgRegisterRefundOverride
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid refund address",
+			func() {
+				msg.RefundAddress = invalidAddress
+			},
+			false,
+		},
+		{
+			"invalid override address",
+			func() {
+				msg.OverrideAddress = invalidAddress
+			},
+			false,
+		},
+		{
+			"override address with foreign bech32 prefix",
+			func() {
+				msg.OverrideAddress = sdk.MustBech32ifyAddressBytes("osmo", secp256k1.GenPrivKey().PubKey().Address())
+			},
+			false,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		refundAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		overrideAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+		msg = types.NewMsgRegisterRefundOverride(ibctesting.MockPort, ibctesting.FirstChannelID, refundAddr.String(), overrideAddr.String())
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+func TestRegisterRefundOverrideGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgRegisterRefundOverride(ibctesting.MockPort, ibctesting.FirstChannelID, accAddress.String(), defaultAccAddress)
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, accAddress.Bytes(), signers[0])
+}
+
 func TestMsgRegisterCountepartyPayeeValidation(t *testing.T) {
 	var msg *types.MsgRegisterCounterpartyPayee
 
@@ -398,3 +572,409 @@ func TestPayPacketFeeAsyncGetSigners(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, refundAddr.Bytes(), signers[0])
 }
+
+func TestMsgUpdateAllowedRelayersValidation(t *testing.T) {
+	var msg *types.MsgUpdateAllowedRelayers
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with empty relayers",
+			func() {
+				msg.Relayers = []string{}
+			},
+			true,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = invalidAddress
+			},
+			false,
+		},
+		{
+			"invalid portID",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channelID",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid relayer address",
+			func() {
+				msg.Relayers = []string{invalidAddress}
+			},
+			false,
+		},
+		{
+			"duplicate relayer address",
+			func() {
+				msg.Relayers = []string{defaultAccAddress, defaultAccAddress}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		msg = types.NewMsgUpdateAllowedRelayers(defaultAccAddress, ibctesting.MockFeePort, ibctesting.FirstChannelID, []string{defaultAccAddress})
+
+		tc.malleate() // malleate mutates test data
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestUpdateAllowedRelayersGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgUpdateAllowedRelayers(accAddress.String(), ibctesting.MockFeePort, ibctesting.FirstChannelID, nil)
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, accAddress.Bytes(), signers[0])
+}
+
+func TestMsgSetAcceptedFeeDenomsValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSetAcceptedFeeDenoms
+		expPass bool
+	}{
+		{
+			"success",
+			types.NewMsgSetAcceptedFeeDenoms(defaultAccAddress, []string{sdk.DefaultBondDenom, "atom"}),
+			true,
+		},
+		{
+			"success: empty list of denoms",
+			types.NewMsgSetAcceptedFeeDenoms(defaultAccAddress, nil),
+			true,
+		},
+		{
+			"invalid signer address",
+			types.NewMsgSetAcceptedFeeDenoms(invalidAddress, []string{sdk.DefaultBondDenom}),
+			false,
+		},
+		{
+			"invalid denom",
+			types.NewMsgSetAcceptedFeeDenoms(defaultAccAddress, []string{"1atom"}),
+			false,
+		},
+		{
+			"duplicate denom",
+			types.NewMsgSetAcceptedFeeDenoms(defaultAccAddress, []string{sdk.DefaultBondDenom, sdk.DefaultBondDenom}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestSetAcceptedFeeDenomsGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgSetAcceptedFeeDenoms(accAddress.String(), nil)
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, accAddress.Bytes(), signers[0])
+}
+
+func TestMsgUnlockFeeModuleValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUnlockFeeModule
+		expPass bool
+	}{
+		{
+			"success",
+			types.NewMsgUnlockFeeModule(defaultAccAddress),
+			true,
+		},
+		{
+			"invalid signer address",
+			types.NewMsgUnlockFeeModule(invalidAddress),
+			false,
+		},
+		{
+			"empty signer address",
+			types.NewMsgUnlockFeeModule(""),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestUnlockFeeModuleGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgUnlockFeeModule(accAddress.String())
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, accAddress.Bytes(), signers[0])
+}
+
+func TestMsgUpdateParamsValidation(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgUpdateParams
+		expPass bool
+	}{
+		{
+			"success",
+			types.NewMsgUpdateParams(defaultAccAddress, types.NewParams(true, defaultAccAddress)),
+			true,
+		},
+		{
+			"success: default params",
+			types.NewMsgUpdateParams(defaultAccAddress, types.DefaultParams()),
+			true,
+		},
+		{
+			"invalid signer address",
+			types.NewMsgUpdateParams(invalidAddress, types.DefaultParams()),
+			false,
+		},
+		{
+			"invalid refund sink address",
+			types.NewMsgUpdateParams(defaultAccAddress, types.NewParams(true, invalidAddress)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgConvertEscrowedFeesValidation(t *testing.T) {
+	var msg *types.MsgConvertEscrowedFees
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success: fractional rate",
+			func() {
+				msg.Rate = "0.25"
+			},
+			true,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid denom to convert from",
+			func() {
+				msg.FromDenom = "1"
+			},
+			false,
+		},
+		{
+			"invalid denom to convert to",
+			func() {
+				msg.ToDenom = ""
+			},
+			false,
+		},
+		{
+			"denoms to convert from and to are equal",
+			func() {
+				msg.ToDenom = msg.FromDenom
+			},
+			false,
+		},
+		{
+			"invalid rate",
+			func() {
+				msg.Rate = "rate"
+			},
+			false,
+		},
+		{
+			"zero rate",
+			func() {
+				msg.Rate = "0"
+			},
+			false,
+		},
+		{
+			"negative rate",
+			func() {
+				msg.Rate = "-1.5"
+			},
+			false,
+		},
+		{
+			"empty pool",
+			func() {
+				msg.Pool = " "
+			},
+			false,
+		},
+		{
+			"pool is the fee module account",
+			func() {
+				msg.Pool = types.ModuleName
+			},
+			false,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = invalidAddress
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		msg = types.NewMsgConvertEscrowedFees(ibctesting.MockFeePort, ibctesting.FirstChannelID, sdk.DefaultBondDenom, "ufoo", "1.5", "mint", defaultAccAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgRefundFeesOnClientExpiryValidation(t *testing.T) {
+	var msg *types.MsgRefundFeesOnClientExpiry
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = ""
+			},
+			false,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = ""
+			},
+			false,
+		},
+		{
+			"invalid signer address",
+			func() {
+				msg.Signer = invalidAddress
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		msg = types.NewMsgRefundFeesOnClientExpiry(ibctesting.MockFeePort, ibctesting.FirstChannelID, defaultAccAddress)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestRefundFeesOnClientExpiryGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	msg := types.NewMsgRefundFeesOnClientExpiry(ibctesting.MockFeePort, ibctesting.FirstChannelID, accAddress.String())
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig(modulefee.AppModuleBasic{})
+	signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+	require.NoError(t, err)
+	require.Equal(t, accAddress.Bytes(), signers[0])
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+const (
+	// DefaultSweepInvalidRefunds disabled
+	DefaultSweepInvalidRefunds = false
+	// DefaultDistributedFeeRetentionPeriod is the default number of blocks for which distributed fee records are kept
+	DefaultDistributedFeeRetentionPeriod = 100000
+	// DefaultMaxPacketFeesPerPacket is the default maximum number of packet fees which may be escrowed for a single packet
+	DefaultMaxPacketFeesPerPacket = 100
+)
+
+// NewParams creates a new parameter configuration for the ibc fee module
+// with the default distributed fee retention period and maximum number of packet fees per packet.
+func NewParams(sweepInvalidRefunds bool, refundSink string) Params {
+	return Params{
+		SweepInvalidRefunds:           sweepInvalidRefunds,
+		RefundSink:                    refundSink,
+		DistributedFeeRetentionPeriod: DefaultDistributedFeeRetentionPeriod,
+		MaxPacketFeesPerPacket:        DefaultMaxPacketFeesPerPacket,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the ibc fee module
+func DefaultParams() Params {
+	return NewParams(DefaultSweepInvalidRefunds, "")
+}
+
+// Validate performs basic validation of the fee parameters.
+func (p Params) Validate() error {
+	if p.RefundSink != "" {
+		if _, err := sdk.AccAddressFromBech32(p.RefundSink); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "invalid refund sink address: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// ValidatePacketFeesCount returns an error if the provided number of packet fees exceeds the maximum number of packet
+// fees per packet. Any number of packet fees is valid if the maximum is zero.
+func (p Params) ValidatePacketFeesCount(count int) error {
+	if p.MaxPacketFeesPerPacket != 0 && uint64(count) > p.MaxPacketFeesPerPacket {
+		return errorsmod.Wrapf(ErrTooManyPacketFees, "packet fees count %d exceeds maximum of %d", count, p.MaxPacketFeesPerPacket)
+	}
+
+	return nil
+}
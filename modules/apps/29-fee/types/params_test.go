@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+func TestValidateParams(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params types.Params
+		expErr error
+	}{
+		{"default params", types.DefaultParams(), nil},
+		{"sweeping enabled without refund sink", types.NewParams(true, ""), nil},
+		{"sweeping enabled with refund sink", types.NewParams(true, defaultAccAddress), nil},
+		{"distributed fee records disabled", types.Params{DistributedFeeRetentionPeriod: 0}, nil},
+		{"invalid refund sink", types.NewParams(true, invalidAddress), ibcerrors.ErrInvalidAddress},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.params.Validate()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestValidatePacketFeesCount(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params types.Params
+		count  int
+		expErr error
+	}{
+		{"unlimited packet fees", types.Params{MaxPacketFeesPerPacket: 0}, 1000, nil},
+		{"packet fees below maximum", types.DefaultParams(), types.DefaultMaxPacketFeesPerPacket - 1, nil},
+		{"packet fees at maximum", types.DefaultParams(), types.DefaultMaxPacketFeesPerPacket, nil},
+		{"packet fees exceed maximum", types.DefaultParams(), types.DefaultMaxPacketFeesPerPacket + 1, types.ErrTooManyPacketFees},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.params.ValidatePacketFeesCount(tc.count)
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
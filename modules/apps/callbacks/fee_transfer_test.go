@@ -183,6 +183,28 @@ func (s *CallbacksTestSuite) TestIncentivizedTransferCallbacks() {
 	}
 }
 
+// TestComposedPathIncentivizedTransferDestCallback relays a fee incentivized transfer requesting a destination callback
+// over a path composed of the fee and callbacks middleware wrapping the transfer application.
+func (s *CallbacksTestSuite) TestComposedPathIncentivizedTransferDestCallback() {
+	s.setupChains()
+	s.path = ibctesting.NewComposedPath(s.chainA, s.chainB, ibctesting.StackConfig{Fee: true, Callbacks: true})
+	s.path.Setup()
+
+	// both endpoints negotiated the fee version wrapping the transfer version
+	for _, endpoint := range []*ibctesting.Endpoint{s.path.EndpointA, s.path.EndpointB} {
+		s.Require().True(GetSimApp(endpoint.Chain).IBCFeeKeeper.IsFeeEnabled(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID))
+	}
+
+	fee := feetypes.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	s.ExecutePayPacketFeeMsg(fee)
+	preRelaySenderBalance := sdk.NewCoins(GetSimApp(s.chainA).BankKeeper.GetBalance(s.chainA.GetContext(), s.chainA.SenderAccount.GetAddress(), ibctesting.TestCoin.Denom))
+	s.ExecuteTransfer(fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, simapp.SuccessContract))
+	preRelaySenderBalance = preRelaySenderBalance.Sub(ibctesting.TestCoin)
+
+	s.AssertHasExecutedExpectedCallbackWithFee(types.CallbackTypeReceivePacket, true, false, preRelaySenderBalance, fee)
+}
+
 func (s *CallbacksTestSuite) TestIncentivizedTransferTimeoutCallbacks() {
 	testCases := []struct {
 		name         string
@@ -31,6 +31,10 @@ type IBCMiddleware struct {
 
 	contractKeeper types.ContractKeeper
 
+	// channelKeeper decides on which channels callbacks are executed. If it is not set, callbacks
+	// are executed on all channels.
+	channelKeeper types.CallbackChannelKeeper
+
 	// maxCallbackGas defines the maximum amount of gas that a callback actor can ask the
 	// relayer to pay for. If a callback fails due to insufficient gas, the entire tx
 	// is reverted if the relayer hadn't provided the minimum(userDefinedGas, maxCallbackGas).
@@ -81,6 +85,19 @@ func (im *IBCMiddleware) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return im.ics4Wrapper
 }
 
+// WithCallbackChannelKeeper sets the keeper deciding on which channels callbacks are executed.
+// Packets on channels for which callbacks are disabled are passed through without extracting
+// their callback data. This function may be used after the middleware's creation and must be
+// called before the middleware is added to the IBC router.
+func (im *IBCMiddleware) WithCallbackChannelKeeper(channelKeeper types.CallbackChannelKeeper) {
+	im.channelKeeper = channelKeeper
+}
+
+// isCallbackChannel returns true if callbacks are enabled for packets on the given channel.
+func (im IBCMiddleware) isCallbackChannel(ctx sdk.Context, portID, channelID string) bool {
+	return im.channelKeeper == nil || im.channelKeeper.IsCallbackChannel(ctx, portID, channelID)
+}
+
 // SendPacket implements source callbacks for sending packets.
 // It defers to the underlying application and then calls the contract callback.
 // If the contract callback returns an error, panics, or runs out of gas, then
@@ -99,6 +116,11 @@ func (im IBCMiddleware) SendPacket(
 		return 0, err
 	}
 
+	// callbacks are skipped entirely on channels for which they are disabled
+	if !im.isCallbackChannel(ctx, sourcePort, sourceChannel) {
+		return seq, nil
+	}
+
 	callbackData, err := types.GetSourceCallbackData(im.app, data, sourcePort, ctx.GasMeter().GasRemaining(), im.maxCallbackGas)
 	// SendPacket is not blocked if the packet does not opt-in to callbacks
 	if err != nil {
@@ -137,6 +159,10 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 		return err
 	}
 
+	if !im.isCallbackChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
+		return nil
+	}
+
 	callbackData, err := types.GetSourceCallbackData(
 		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
 	)
@@ -171,6 +197,10 @@ func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Pac
 		return err
 	}
 
+	if !im.isCallbackChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel()) {
+		return nil
+	}
+
 	callbackData, err := types.GetSourceCallbackData(
 		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
 	)
@@ -207,6 +237,10 @@ func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet
 		return ack
 	}
 
+	if !im.isCallbackChannel(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
+		return ack
+	}
+
 	callbackData, err := types.GetDestCallbackData(
 		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
 	)
@@ -245,6 +279,10 @@ func (im IBCMiddleware) WriteAcknowledgement(
 		return err
 	}
 
+	if !im.isCallbackChannel(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
+		return nil
+	}
+
 	callbackData, err := types.GetDestCallbackData(
 		im.app, packet.GetData(), packet.GetSourcePort(), ctx.GasMeter().GasRemaining(), im.maxCallbackGas,
 	)
@@ -573,7 +573,7 @@ func (s *CallbacksTestSuite) TestOnRecvPacket() {
 				packet.Data = []byte("invalid packet data")
 			},
 			noExecution,
-			channeltypes.NewErrorAcknowledgement(ibcerrors.ErrInvalidType),
+			channeltypes.NewErrorAcknowledgementWithCode(ibcerrors.ErrInvalidType),
 		},
 		{
 			"success: no-op on callback data is not valid",
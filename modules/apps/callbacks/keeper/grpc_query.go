@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+)
+
+var _ types.QueryServer = (*Keeper)(nil)
+
+// Params implements the Query/Params gRPC method and returns the callbacks middleware parameters
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	return &types.QueryParamsResponse{
+		Params: params,
+	}, nil
+}
+
+// CallbackChannels implements the Query/CallbackChannels gRPC method and returns the channels on which
+// callbacks are enabled
+func (k Keeper) CallbackChannels(goCtx context.Context, req *types.QueryCallbackChannelsRequest) (*types.QueryCallbackChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var channels []types.CallbackChannel
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(types.CallbackChannelKeyPrefix))
+	pagination, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var channel types.CallbackChannel
+		if err := k.cdc.Unmarshal(value, &channel); err != nil {
+			return err
+		}
+
+		channels = append(channels, channel)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCallbackChannelsResponse{
+		Channels:           channels,
+		AllChannelsEnabled: k.GetParams(ctx).AllChannelsEnabled,
+		Pagination:         pagination,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"errors"
+	"strings"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ types.CallbackChannelKeeper = (*Keeper)(nil)
+
+// Keeper defines the callbacks middleware keeper, which manages the channels on which callbacks are executed.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	// the address capable of executing privileged messages such as MsgSetCallbackChannels.
+	// Typically, this should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new callbacks middleware Keeper instance
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, authority string) Keeper {
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
+	return Keeper{
+		cdc:       cdc,
+		storeKey:  key,
+		authority: authority,
+	}
+}
+
+// GetAuthority returns the callbacks middleware's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ibcexported.ModuleName+"-"+types.ModuleName)
+}
+
+// GetParams returns the current callbacks middleware parameters. The default parameters are returned
+// if no parameters have been set.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte(types.ParamsKey))
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the callbacks middleware parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&params)
+	store.Set([]byte(types.ParamsKey), bz)
+}
+
+// setCallbackChannels replaces the set of channels on which callbacks are enabled with the provided channels.
+func (k Keeper) setCallbackChannels(ctx sdk.Context, channels []types.CallbackChannel) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, channel := range k.GetCallbackChannels(ctx) {
+		store.Delete(types.KeyCallbackChannel(channel.PortId, channel.ChannelId))
+	}
+
+	for _, channel := range channels {
+		store.Set(types.KeyCallbackChannel(channel.PortId, channel.ChannelId), k.cdc.MustMarshal(&channel))
+	}
+}
+
+// HasCallbackChannel returns true if the given channel is in the set of callback channels.
+func (k Keeper) HasCallbackChannel(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.KeyCallbackChannel(portID, channelID))
+}
+
+// GetCallbackChannels returns the set of channels on which callbacks are enabled.
+func (k Keeper) GetCallbackChannels(ctx sdk.Context) []types.CallbackChannel {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.CallbackChannelKeyPrefix))
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	var channels []types.CallbackChannel
+	for ; iterator.Valid(); iterator.Next() {
+		var channel types.CallbackChannel
+		k.cdc.MustUnmarshal(iterator.Value(), &channel)
+
+		channels = append(channels, channel)
+	}
+
+	return channels
+}
+
+// IsCallbackChannel returns true if callbacks are enabled for packets on the given channel. Callbacks are
+// enabled on all channels unless the AllChannelsEnabled param is disabled, in which case callbacks are only
+// enabled on the set of callback channels.
+func (k Keeper) IsCallbackChannel(ctx sdk.Context, portID, channelID string) bool {
+	if k.GetParams(ctx).AllChannelsEnabled {
+		return true
+	}
+
+	return k.HasCallbackChannel(ctx, portID, channelID)
+}
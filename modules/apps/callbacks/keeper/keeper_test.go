@@ -0,0 +1,235 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/keeper"
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+type KeeperTestSuite struct {
+	testifysuite.Suite
+
+	ctx    sdk.Context
+	keeper keeper.Keeper
+
+	authority string
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	testCtx := testutil.DefaultContextWithDB(suite.T(), key, storetypes.NewTransientStoreKey("transient_test"))
+	suite.ctx = testCtx.Ctx
+
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+	suite.authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	suite.keeper = keeper.NewKeeper(encCfg.Codec, key, suite.authority)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestNewKeeper() {
+	suite.Require().Panics(func() {
+		keeper.NewKeeper(moduletestutil.MakeTestEncodingConfig().Codec, storetypes.NewKVStoreKey(types.StoreKey), " ")
+	}, "empty authority")
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	suite.Require().Equal(types.DefaultParams(), suite.keeper.GetParams(suite.ctx))
+
+	params := types.NewParams(false)
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.Require().Equal(params, suite.keeper.GetParams(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestIsCallbackChannel() {
+	channel := types.NewCallbackChannel(ibctesting.TransferPort, ibctesting.FirstChannelID)
+
+	// callbacks are enabled on all channels by default
+	suite.Require().True(suite.keeper.IsCallbackChannel(suite.ctx, channel.PortId, channel.ChannelId))
+	suite.Require().True(suite.keeper.IsCallbackChannel(suite.ctx, channel.PortId, "channel-1"))
+
+	suite.keeper.SetParams(suite.ctx, types.NewParams(false))
+	suite.Require().False(suite.keeper.IsCallbackChannel(suite.ctx, channel.PortId, channel.ChannelId))
+
+	_, err := suite.keeper.SetCallbackChannels(suite.ctx, types.NewMsgSetCallbackChannels(suite.authority, []types.CallbackChannel{channel}))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.keeper.IsCallbackChannel(suite.ctx, channel.PortId, channel.ChannelId))
+	suite.Require().False(suite.keeper.IsCallbackChannel(suite.ctx, channel.PortId, "channel-1"))
+}
+
+func (suite *KeeperTestSuite) TestSetCallbackChannels() {
+	var msg *types.MsgSetCallbackChannels
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: empty set of channels",
+			func() {
+				msg.Channels = nil
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			previous := []types.CallbackChannel{
+				types.NewCallbackChannel(ibctesting.TransferPort, "channel-7"),
+				types.NewCallbackChannel(ibctesting.MockPort, ibctesting.FirstChannelID),
+			}
+			_, err := suite.keeper.SetCallbackChannels(suite.ctx, types.NewMsgSetCallbackChannels(suite.authority, previous))
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgSetCallbackChannels(suite.authority, []types.CallbackChannel{
+				types.NewCallbackChannel(ibctesting.TransferPort, ibctesting.FirstChannelID),
+				types.NewCallbackChannel(ibctesting.TransferPort, "channel-1"),
+			})
+
+			tc.malleate()
+
+			res, err := suite.keeper.SetCallbackChannels(suite.ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				// the previous set of channels is replaced
+				suite.Require().ElementsMatch(msg.Channels, suite.keeper.GetCallbackChannels(suite.ctx))
+				for _, channel := range previous {
+					suite.Require().False(suite.keeper.HasCallbackChannel(suite.ctx, channel.PortId, channel.ChannelId))
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				suite.Require().ElementsMatch(previous, suite.keeper.GetCallbackChannels(suite.ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgUpdateParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(false)),
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.NewParams(false)),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			res, err := suite.keeper.UpdateParams(suite.ctx, tc.msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(tc.msg.Params, suite.keeper.GetParams(suite.ctx))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				suite.Require().Equal(types.DefaultParams(), suite.keeper.GetParams(suite.ctx))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	res, err := suite.keeper.Params(suite.ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQueryCallbackChannels() {
+	channels := []types.CallbackChannel{
+		types.NewCallbackChannel(ibctesting.TransferPort, ibctesting.FirstChannelID),
+		types.NewCallbackChannel(ibctesting.TransferPort, "channel-1"),
+		types.NewCallbackChannel(ibctesting.TransferPort, "channel-2"),
+	}
+
+	_, err := suite.keeper.SetCallbackChannels(suite.ctx, types.NewMsgSetCallbackChannels(suite.authority, channels))
+	suite.Require().NoError(err)
+	suite.keeper.SetParams(suite.ctx, types.NewParams(false))
+
+	_, err = suite.keeper.CallbackChannels(suite.ctx, nil)
+	suite.Require().Error(err)
+
+	res, err := suite.keeper.CallbackChannels(suite.ctx, &types.QueryCallbackChannelsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(channels[:2], res.Channels)
+	suite.Require().False(res.AllChannelsEnabled)
+	suite.Require().Equal(uint64(len(channels)), res.Pagination.Total)
+
+	res, err = suite.keeper.CallbackChannels(suite.ctx, &types.QueryCallbackChannelsRequest{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(channels[2:], res.Channels)
+	suite.Require().Nil(res.Pagination.NextKey)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	genesisState := types.NewGenesisState(types.NewParams(false), []types.CallbackChannel{
+		types.NewCallbackChannel(ibctesting.TransferPort, ibctesting.FirstChannelID),
+		types.NewCallbackChannel(ibctesting.MockPort, ibctesting.FirstChannelID),
+	})
+
+	suite.keeper.InitGenesis(suite.ctx, *genesisState)
+
+	suite.Require().Equal(genesisState.Params, suite.keeper.GetParams(suite.ctx))
+	suite.Require().True(suite.keeper.HasCallbackChannel(suite.ctx, ibctesting.TransferPort, ibctesting.FirstChannelID))
+	suite.Require().True(suite.keeper.HasCallbackChannel(suite.ctx, ibctesting.MockPort, ibctesting.FirstChannelID))
+
+	exported := suite.keeper.ExportGenesis(suite.ctx)
+	suite.Require().Equal(genesisState.Params, exported.Params)
+	suite.Require().ElementsMatch(genesisState.CallbackChannels, exported.CallbackChannels)
+}
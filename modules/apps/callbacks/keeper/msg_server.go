@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// SetCallbackChannels defines a rpc handler method for MsgSetCallbackChannels. Replaces the set of channels
+// on which callbacks are enabled.
+func (k Keeper) SetCallbackChannels(goCtx context.Context, msg *types.MsgSetCallbackChannels) (*types.MsgSetCallbackChannelsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.setCallbackChannels(ctx, msg.Channels)
+
+	k.Logger(ctx).Info("updated callback channels", "channels", len(msg.Channels))
+
+	return &types.MsgSetCallbackChannelsResponse{}, nil
+}
+
+// UpdateParams defines a rpc handler method for MsgUpdateParams. Updates the callbacks middleware's parameters.
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	k.Logger(ctx).Info("updated callbacks params", "all-channels-enabled", msg.Params.AllChannelsEnabled)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
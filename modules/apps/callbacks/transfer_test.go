@@ -112,6 +112,78 @@ func (s *CallbacksTestSuite) TestTransferCallbacks() {
 	}
 }
 
+func (s *CallbacksTestSuite) TestTransferCallbackChannels() {
+	// enableChannel adds the channel of the given endpoint to the callback channels of its chain
+	enableChannel := func(endpoint *ibctesting.Endpoint) {
+		callbacksKeeper := GetSimApp(endpoint.Chain).IBCCallbacksKeeper
+		channels := []types.CallbackChannel{types.NewCallbackChannel(endpoint.ChannelConfig.PortID, endpoint.ChannelID)}
+
+		_, err := callbacksKeeper.SetCallbackChannels(endpoint.Chain.GetContext(), types.NewMsgSetCallbackChannels(callbacksKeeper.GetAuthority(), channels))
+		s.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		transferMemo string
+		expCallback  types.CallbackType
+	}{
+		{
+			"success: dest callback ignored on disabled channel",
+			func() {},
+			fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			"none",
+		},
+		{
+			"success: source callback ignored on disabled channel",
+			func() {},
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			"none",
+		},
+		{
+			"success: dest callback on enabled channel",
+			func() {
+				enableChannel(s.path.EndpointB)
+			},
+			fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			types.CallbackTypeReceivePacket,
+		},
+		{
+			"success: source callback on enabled channel",
+			func() {
+				enableChannel(s.path.EndpointA)
+			},
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			types.CallbackTypeAcknowledgementPacket,
+		},
+		{
+			"success: dest callback ignored when only the source channel is enabled",
+			func() {
+				enableChannel(s.path.EndpointA)
+			},
+			fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, simapp.SuccessContract),
+			"none",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			s.SetupTransferTest()
+
+			// restrict callbacks to the set of callback channels on both chains
+			for _, chain := range []*ibctesting.TestChain{s.chainA, s.chainB} {
+				GetSimApp(chain).IBCCallbacksKeeper.SetParams(chain.GetContext(), types.NewParams(false))
+			}
+
+			tc.malleate()
+
+			s.ExecuteTransfer(tc.transferMemo)
+			s.AssertHasExecutedExpectedCallback(tc.expCallback, true)
+		})
+	}
+}
+
 func (s *CallbacksTestSuite) TestTransferTimeoutCallbacks() {
 	testCases := []struct {
 		name         string
@@ -81,3 +81,9 @@ type ContractKeeper interface {
 		contractAddress string,
 	) error
 }
+
+// CallbackChannelKeeper defines the expected keeper deciding on which channels callbacks are executed
+type CallbackChannelKeeper interface {
+	// IsCallbackChannel returns true if callbacks are enabled for packets on the given channel.
+	IsCallbackChannel(ctx sdk.Context, portID, channelID string) bool
+}
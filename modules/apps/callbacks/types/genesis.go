@@ -0,0 +1,37 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// NewGenesisState creates a callbacks middleware GenesisState instance.
+func NewGenesisState(params Params, callbackChannels []CallbackChannel) *GenesisState {
+	return &GenesisState{
+		Params:           params,
+		CallbackChannels: callbackChannels,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with the default params and no callback channels.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[CallbackChannel]bool)
+	for _, channel := range gs.CallbackChannels {
+		if err := channel.Validate(); err != nil {
+			return err
+		}
+
+		if seen[channel] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate callback channel: port ID (%s) channel ID (%s)", channel.PortId, channel.ChannelId)
+		}
+		seen[channel] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	var genState *types.GenesisState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: default genesis",
+			func() {
+				genState = types.DefaultGenesisState()
+			},
+			nil,
+		},
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid port identifier",
+			func() {
+				genState.CallbackChannels[0].PortId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid channel identifier",
+			func() {
+				genState.CallbackChannels[1].ChannelId = "invalid channel"
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: duplicate channel",
+			func() {
+				genState.CallbackChannels[1] = genState.CallbackChannels[0]
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			genState = types.NewGenesisState(types.NewParams(false), []types.CallbackChannel{
+				types.NewCallbackChannel(ibctesting.TransferPort, ibctesting.FirstChannelID),
+				types.NewCallbackChannel(ibctesting.TransferPort, "channel-1"),
+			})
+
+			tc.malleate()
+
+			err := genState.Validate()
+
+			if tc.expErr == nil {
+				require.NoError(t, err, tc.name)
+			} else {
+				require.ErrorIs(t, err, tc.expErr, tc.name)
+			}
+		})
+	}
+}
@@ -1,10 +1,22 @@
 package types
 
+import "fmt"
+
 type CallbackType string
 
 const (
 	ModuleName = "ibccallbacks"
 
+	// StoreKey is the store key string for the callbacks middleware. It must not share a prefix
+	// with the store key of IBC core, hence it differs from the module name.
+	StoreKey = "callbacksibc"
+
+	// ParamsKey defines the key to store the params in the callbacks middleware store
+	ParamsKey = "params"
+
+	// CallbackChannelKeyPrefix is the key prefix for the channels on which callbacks are enabled
+	CallbackChannelKeyPrefix = "callbackChannel"
+
 	CallbackTypeSendPacket            CallbackType = "send_packet"
 	CallbackTypeAcknowledgementPacket CallbackType = "acknowledgement_packet"
 	CallbackTypeTimeoutPacket         CallbackType = "timeout_packet"
@@ -29,3 +41,8 @@ const (
 	// { "{callbackKey}": { ... , "gas_limit": {stringForCallback} }
 	UserDefinedGasLimitKey = "gas_limit"
 )
+
+// KeyCallbackChannel returns the key storing whether callbacks are enabled on the given channel
+func KeyCallbackChannel(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", CallbackChannelKeyPrefix, portID, channelID))
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+var (
+	_ sdk.Msg = (*MsgSetCallbackChannels)(nil)
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+
+	_ sdk.HasValidateBasic = (*MsgSetCallbackChannels)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+)
+
+// NewCallbackChannel creates a new instance of CallbackChannel
+func NewCallbackChannel(portID, channelID string) CallbackChannel {
+	return CallbackChannel{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs a basic validation of the CallbackChannel identifiers
+func (c CallbackChannel) Validate() error {
+	if err := host.PortIdentifierValidator(c.PortId); err != nil {
+		return err
+	}
+
+	return host.ChannelIdentifierValidator(c.ChannelId)
+}
+
+// NewMsgSetCallbackChannels creates a new instance of MsgSetCallbackChannels
+func NewMsgSetCallbackChannels(signer string, channels []CallbackChannel) *MsgSetCallbackChannels {
+	return &MsgSetCallbackChannels{
+		Channels: channels,
+		Signer:   signer,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgSetCallbackChannels fields
+func (msg MsgSetCallbackChannels) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	seen := make(map[CallbackChannel]bool)
+	for _, channel := range msg.Channels {
+		if err := channel.Validate(); err != nil {
+			return err
+		}
+
+		if seen[channel] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate callback channel: port ID (%s) channel ID (%s)", channel.PortId, channel.ChannelId)
+		}
+		seen[channel] = true
+	}
+
+	return nil
+}
+
+// NewMsgUpdateParams creates a new instance of MsgUpdateParams
+func NewMsgUpdateParams(signer string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Signer: signer,
+		Params: params,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgUpdateParams fields
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
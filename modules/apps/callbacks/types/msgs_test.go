@@ -0,0 +1,135 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/cosmos/ibc-go/modules/apps/callbacks/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestMsgSetCallbackChannelsValidation(t *testing.T) {
+	var msg *types.MsgSetCallbackChannels
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: empty set of channels",
+			func() {
+				msg.Channels = nil
+			},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid-address"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid port identifier",
+			func() {
+				msg.Channels[0].PortId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid channel identifier",
+			func() {
+				msg.Channels[1].ChannelId = "invalid channel"
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: duplicate channel",
+			func() {
+				msg.Channels = append(msg.Channels, msg.Channels[0])
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgSetCallbackChannels(ibctesting.TestAccAddress, []types.CallbackChannel{
+				types.NewCallbackChannel(ibctesting.TransferPort, ibctesting.FirstChannelID),
+				types.NewCallbackChannel(ibctesting.MockPort, ibctesting.FirstChannelID),
+			})
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateParamsValidation(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.DefaultParams()),
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgUpdateParams("invalid-address", types.DefaultParams()),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestMsgsGetSigners(t *testing.T) {
+	accAddress := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	msgs := []sdk.Msg{
+		types.NewMsgSetCallbackChannels(accAddress.String(), nil),
+		types.NewMsgUpdateParams(accAddress.String(), types.DefaultParams()),
+	}
+
+	encodingCfg := moduletestutil.MakeTestEncodingConfig()
+	for _, msg := range msgs {
+		signers, _, err := encodingCfg.Codec.GetMsgV1Signers(msg)
+		require.NoError(t, err)
+		require.Equal(t, accAddress.Bytes(), signers[0])
+	}
+}
@@ -0,0 +1,16 @@
+package types
+
+// DefaultAllChannelsEnabled enables callbacks on all channels
+const DefaultAllChannelsEnabled = true
+
+// NewParams creates a new parameter configuration for the callbacks middleware
+func NewParams(allChannelsEnabled bool) Params {
+	return Params{
+		AllChannelsEnabled: allChannelsEnabled,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the callbacks middleware
+func DefaultParams() Params {
+	return NewParams(DefaultAllChannelsEnabled)
+}
@@ -17,11 +17,19 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdQueryDenomTrace(),
+		GetCmdQueryDenomResolved(),
+		GetCmdQueryDenomOrigin(),
 		GetCmdQueryDenomTraces(),
 		GetCmdParams(),
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
 		GetCmdQueryTotalEscrowForDenom(),
+		GetCmdQueryTotalEscrow(),
+		GetCmdQueryEscrowDenoms(),
+		GetCmdQueryPreviewDenom(),
+		GetCmdQueryTransferQuotas(),
+		GetCmdQueryReceiverPrefixes(),
+		GetCmdQueryTransferFee(),
 	)
 
 	return queryCmd
@@ -44,6 +44,71 @@ func GetCmdQueryDenomTrace() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryDenomResolved defines the command to query a denomination trace together with the client and
+// counterparty chain information of each hop which can be resolved locally.
+func GetCmdQueryDenomResolved() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-resolved [hash/denom]",
+		Short:   "Query the denom trace info and the resolved hops from a given trace hash or ibc denom",
+		Long:    "Query the denom trace info from a given trace hash or ibc denom, together with the client ID and counterparty chain ID of the hop terminating on this chain",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-resolved 27A6394C3F9FF9C9DCF5DFFADF9BB5FE9A37C7E92B006199894CF1824DF9AC7C", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomResolvedRequest{
+				Hash: args[0],
+			}
+
+			res, err := queryClient.DenomResolved(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDenomOrigin defines the command to query the originating hop and base denomination of a denomination.
+func GetCmdQueryDenomOrigin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-origin [denom]",
+		Short:   "Query the originating hop and base denom of an ibc denom or native denom",
+		Long:    "Query the base denom and the hop closest to the originating chain of an ibc denom. Native denoms are reported as originating on this chain",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-origin ibc/27A6394C3F9FF9C9DCF5DFFADF9BB5FE9A37C7E92B006199894CF1824DF9AC7C", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomOriginRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.DenomOrigin(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryDenomTraces defines the command to query all the denomination trace infos
 // that this chain maintains.
 func GetCmdQueryDenomTraces() *cobra.Command {
@@ -119,7 +184,7 @@ func GetCmdQueryEscrowAddress() *cobra.Command {
 		Short:   "Get the escrow address for a channel",
 		Long:    "Get the escrow address for a channel",
 		Args:    cobra.ExactArgs(2),
-		Example: fmt.Sprintf("%s query ibc-transfer escrow-address [port] [channel-id]", version.AppName),
+		Example: fmt.Sprintf("%s query ibc-transfer escrow-address [port] [channel-id] [--denom denom]", version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -127,11 +192,33 @@ func GetCmdQueryEscrowAddress() *cobra.Command {
 			}
 			port := args[0]
 			channel := args[1]
+
+			denom, err := cmd.Flags().GetString(flagDenom)
+			if err != nil {
+				return err
+			}
+
+			// the escrow class or escrow address of a denomination depends on the on-chain params
+			if denom != "" {
+				queryClient := types.NewQueryClient(clientCtx)
+				res, err := queryClient.EscrowAddress(cmd.Context(), &types.QueryEscrowAddressRequest{
+					PortId:    port,
+					ChannelId: channel,
+					Denom:     denom,
+				})
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintString(fmt.Sprintf("%s\n", res.EscrowAddress))
+			}
+
 			addr := types.GetEscrowAddress(port, channel)
 			return clientCtx.PrintString(fmt.Sprintf("%s\n", addr.String()))
 		},
 	}
 
+	cmd.Flags().String(flagDenom, "", "Denomination for which to query the escrow address of its escrow class, or its own escrow address if tokens are escrowed per denomination")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
@@ -201,3 +288,228 @@ func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryTotalEscrow defines the command to query the tracked total escrow alongside the actual escrow
+// account balances for a denom, or for all denoms if no denom is provided
+func GetCmdQueryTotalEscrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-totals [denom]",
+		Short:   "Query the tracked total escrow and the actual escrow account balances",
+		Long:    "Query the total amount of tokens in escrow tracked by the transfer module alongside the summed balance of all channel escrow accounts, for a denom or for all denoms if no denom is provided",
+		Example: fmt.Sprintf("%s query ibc-transfer escrow-totals uosmo", version.AppName),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTotalEscrowRequest{}
+			if len(args) == 1 {
+				req.Denom = args[0]
+			}
+
+			res, err := queryClient.TotalEscrow(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEscrowDenoms defines the command to query the balances held by the escrow accounts of a channel,
+// or of all transfer channels if no channel is provided
+func GetCmdQueryEscrowDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-denoms [[port-id] [channel-id]]",
+		Short:   "Query the denoms and amounts held in escrow by a channel",
+		Long:    "Query the denoms and amounts held by the escrow accounts of a channel, or of all channels bound to the transfer port if no channel is provided",
+		Example: fmt.Sprintf("%s query ibc-transfer escrow-denoms transfer channel-0", version.AppName),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 0 && len(args) != 2 {
+				return fmt.Errorf("accepts either 0 or 2 arg(s), received %d", len(args))
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryEscrowDenomsRequest{
+				Pagination: pageReq,
+			}
+
+			if len(args) == 2 {
+				req.PortId = args[0]
+				req.ChannelId = args[1]
+			}
+
+			res, err := queryClient.EscrowDenoms(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channel escrows")
+
+	return cmd
+}
+
+// GetCmdQueryPreviewDenom defines the command to query the denomination received on the counterparty chain
+// when tokens of a denomination are transferred over a given port and channel.
+func GetCmdQueryPreviewDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "preview-denom [src-port] [src-channel] [denom]",
+		Short:   "Query the denom received on the counterparty chain when transferring a denom",
+		Long:    "Query the denom received on the counterparty chain when transferring a denom over the given port and channel",
+		Example: fmt.Sprintf("%s query ibc-transfer preview-denom transfer channel-0 uatom", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPreviewDenomRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Denom:     args[2],
+			}
+
+			res, err := queryClient.PreviewDenom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTransferQuotas defines the command to query all transfer quotas and their usage in the current epoch.
+func GetCmdQueryTransferQuotas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "quotas",
+		Short:   "Query all transfer quotas",
+		Long:    "Query all transfer quotas together with their net outflow in the current epoch",
+		Example: fmt.Sprintf("%s query ibc-transfer quotas", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTransferQuotasRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.TransferQuotas(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "transfer quotas")
+
+	return cmd
+}
+
+// GetCmdQueryTransferFee defines the command to query the fee deducted from outbound transfers.
+func GetCmdQueryTransferFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-fee",
+		Short:   "Query the transfer fee",
+		Long:    "Query the fee deducted from outbound transfers and the module account collecting it",
+		Example: fmt.Sprintf("%s query ibc-transfer transfer-fee", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TransferFee(cmd.Context(), &types.QueryTransferFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryReceiverPrefixes defines the command to query the expected bech32 prefixes of receiver addresses per channel.
+func GetCmdQueryReceiverPrefixes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "receiver-prefixes",
+		Short:   "Query all receiver prefixes",
+		Long:    "Query the expected bech32 prefixes of receiver addresses of transfers per channel",
+		Example: fmt.Sprintf("%s query ibc-transfer receiver-prefixes", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryReceiverPrefixesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ReceiverPrefixes(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "receiver prefixes")
+
+	return cmd
+}
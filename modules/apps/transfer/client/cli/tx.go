@@ -1,28 +1,38 @@
 package cli
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 )
 
 const (
 	flagPacketTimeoutHeight    = "packet-timeout-height"
 	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
 	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagTimeoutBlocks          = "timeout-blocks"
 	flagMemo                   = "memo"
+	flagDenom                  = "denom"
+	flagUnsafeReceiver         = "unsafe-receiver"
+	flagRefundAddress          = "refund-address"
 )
 
 // defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
@@ -36,8 +46,9 @@ func NewTransferTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "transfer [src-port] [src-channel] [receiver] [amount]",
 		Short: "Transfer a fungible token through IBC",
-		Long: strings.TrimSpace(`Transfer a fungible token through IBC. Timeouts can be specified as absolute using the {absolute-timeouts} flag. 
-Timeout height can be set by passing in the height string in the form {revision}-{height} using the {packet-timeout-height} flag. Note, relative timeout height is not supported. 
+		Long: strings.TrimSpace(`Transfer a fungible token through IBC. Timeouts can be specified as absolute using the {absolute-timeouts} flag.
+An absolute timeout height can be set by passing in the height string in the form {revision}-{height} using the {packet-timeout-height} flag.
+A relative timeout height can be set using the {timeout-blocks} flag: the timeout height is set to the latest height of the client backing the source channel incremented by the provided number of blocks.
 Relative timeout timestamp is added to the value of the user's local system clock time using the {packet-timeout-timestamp} flag. If no timeout value is set then a default relative timeout value of 10 minutes is used.`),
 		Example: fmt.Sprintf("%s tx ibc-transfer transfer [src-port] [src-channel] [receiver] [amount]", version.AppName),
 		Args:    cobra.ExactArgs(4),
@@ -81,16 +92,39 @@ Relative timeout timestamp is added to the value of the user's local system cloc
 				return err
 			}
 
+			timeoutBlocks, err := cmd.Flags().GetUint64(flagTimeoutBlocks)
+			if err != nil {
+				return err
+			}
+
 			memo, err := cmd.Flags().GetString(flagMemo)
 			if err != nil {
 				return err
 			}
 
-			// NOTE: relative timeouts using block height are not supported.
+			unsafeReceiver, err := cmd.Flags().GetBool(flagUnsafeReceiver)
+			if err != nil {
+				return err
+			}
+
+			refundAddress, err := cmd.Flags().GetString(flagRefundAddress)
+			if err != nil {
+				return err
+			}
+
+			// NOTE: relative timeout heights are only supported using the timeout-blocks flag.
 			// if the timeouts are not absolute, CLI users rely solely on local clock time in order to calculate relative timestamps.
 			if !absoluteTimeouts {
 				if !timeoutHeight.IsZero() {
-					return errors.New("relative timeouts using block height is not supported")
+					return fmt.Errorf("relative timeouts using block height must be set with the --%s flag", flagTimeoutBlocks)
+				}
+
+				if timeoutBlocks != 0 {
+					queryClient := channeltypes.NewQueryClient(clientCtx)
+					timeoutHeight, err = queryTimeoutHeight(cmd.Context(), queryClient, clientCtx.InterfaceRegistry, srcPort, srcChannel, timeoutBlocks)
+					if err != nil {
+						return err
+					}
 				}
 
 				if timeoutTimestamp == 0 {
@@ -104,11 +138,15 @@ Relative timeout timestamp is added to the value of the user's local system cloc
 				}
 
 				timeoutTimestamp = uint64(now) + timeoutTimestamp
+			} else if timeoutBlocks != 0 {
+				return fmt.Errorf("flag --%s cannot be used with absolute timeouts", flagTimeoutBlocks)
 			}
 
 			msg := types.NewMsgTransfer(
 				srcPort, srcChannel, coin, sender, receiver, timeoutHeight, timeoutTimestamp, memo,
 			)
+			msg.UnsafeReceiver = unsafeReceiver
+			msg.RefundAddress = refundAddress
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -116,8 +154,58 @@ Relative timeout timestamp is added to the value of the user's local system cloc
 	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "Packet timeout block height in the format {revision}-{height}. The timeout is disabled when set to 0-0.")
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().Uint64(flagTimeoutBlocks, 0, "Number of blocks after the latest height of the counterparty chain known to the channel's client at which the packet times out. Cannot be used with absolute timeouts.")
 	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+	cmd.Flags().Bool(flagUnsafeReceiver, false, "Skip the check of the receiver address against the bech32 prefix expected for the source channel.")
+	cmd.Flags().String(flagRefundAddress, "", "Address receiving the refund if the transfer times out or fails, defaults to the sender. Only supported on channels using the ics20-1-structured-ack transfer version.")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// timeoutHeightQuerier defines the subset of the 04-channel gRPC query client used to compute a timeout
+// height relative to the latest height of a channel's client.
+type timeoutHeightQuerier interface {
+	ChannelClientState(ctx context.Context, in *channeltypes.QueryChannelClientStateRequest, opts ...grpc.CallOption) (*channeltypes.QueryChannelClientStateResponse, error)
+}
+
+// queryTimeoutHeight queries the client state of the provided channel in order to return a timeout height which is timeoutBlocks past the latest height of the client.
+// The revision number is parsed from the counterparty chain ID, chains which do not use the revision
+// format default to revision number 0.
+func queryTimeoutHeight(
+	ctx context.Context,
+	queryClient timeoutHeightQuerier,
+	unpacker codectypes.AnyUnpacker,
+	portID, channelID string,
+	timeoutBlocks uint64,
+) (clienttypes.Height, error) {
+	channelRes, err := queryClient.ChannelClientState(ctx, &channeltypes.QueryChannelClientStateRequest{
+		PortId:    portID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return clienttypes.ZeroHeight(), err
+	}
+
+	if channelRes.IdentifiedClientState == nil {
+		return clienttypes.ZeroHeight(), fmt.Errorf("client state not found for port ID %s and channel ID %s", portID, channelID)
+	}
+
+	clientID := channelRes.IdentifiedClientState.ClientId
+	var clientState exported.ClientState
+	if err := unpacker.UnpackAny(channelRes.IdentifiedClientState.ClientState, &clientState); err != nil {
+		return clienttypes.ZeroHeight(), err
+	}
+
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return clienttypes.ZeroHeight(), fmt.Errorf("unable to determine latest height of client %s: expected client type %s, got %s", clientID, exported.Tendermint, clientState.ClientType())
+	}
+
+	revision := clienttypes.ParseChainID(tmClientState.ChainId)
+	if revision != tmClientState.LatestHeight.GetRevisionNumber() {
+		return clienttypes.ZeroHeight(), fmt.Errorf("revision number %d parsed from chain ID %s does not match revision number of client %s latest height %s", revision, tmClientState.ChainId, clientID, tmClientState.LatestHeight)
+	}
+
+	return clienttypes.NewHeight(revision, tmClientState.LatestHeight.GetRevisionHeight()+timeoutBlocks), nil
+}
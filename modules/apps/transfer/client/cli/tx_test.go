@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+)
+
+const (
+	clientID  = "07-tendermint-0"
+	portID    = "transfer"
+	channelID = "channel-0"
+)
+
+var _ timeoutHeightQuerier = (*mockTimeoutHeightQuerier)(nil)
+
+// mockTimeoutHeightQuerier returns the configured client state for every channel.
+type mockTimeoutHeightQuerier struct {
+	clientID    string
+	clientState *codectypes.Any
+	err         error
+}
+
+func (m mockTimeoutHeightQuerier) ChannelClientState(_ context.Context, _ *channeltypes.QueryChannelClientStateRequest, _ ...grpc.CallOption) (*channeltypes.QueryChannelClientStateResponse, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	identifiedClientState := &clienttypes.IdentifiedClientState{ClientId: m.clientID, ClientState: m.clientState}
+	return &channeltypes.QueryChannelClientStateResponse{IdentifiedClientState: identifiedClientState}, nil
+}
+
+func TestQueryTimeoutHeight(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	ibctm.RegisterInterfaces(registry)
+	solomachine.RegisterInterfaces(registry)
+
+	newTendermintClientState := func(chainID string, latestHeight clienttypes.Height) *codectypes.Any {
+		clientState := ibctm.NewClientState(
+			chainID, ibctm.DefaultTrustLevel, time.Hour*24*7*2, time.Hour*24*7*3, time.Second*10,
+			latestHeight, commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"},
+		)
+
+		anyClientState, err := codectypes.NewAnyWithValue(clientState)
+		require.NoError(t, err)
+		return anyClientState
+	}
+
+	solomachineClientState, err := codectypes.NewAnyWithValue(solomachine.NewClientState(1, &solomachine.ConsensusState{}))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		querier        mockTimeoutHeightQuerier
+		expectedHeight clienttypes.Height
+		expErr         bool
+	}{
+		{
+			"success: revision chain",
+			mockTimeoutHeightQuerier{clientID: clientID, clientState: newTendermintClientState("testchain-3", clienttypes.NewHeight(3, 100))},
+			clienttypes.NewHeight(3, 150),
+			false,
+		},
+		{
+			"success: non-revision chain",
+			mockTimeoutHeightQuerier{clientID: clientID, clientState: newTendermintClientState("testchain", clienttypes.NewHeight(0, 100))},
+			clienttypes.NewHeight(0, 150),
+			false,
+		},
+		{
+			"failure: revision number mismatch between chain ID and latest height",
+			mockTimeoutHeightQuerier{clientID: clientID, clientState: newTendermintClientState("testchain-3", clienttypes.NewHeight(2, 100))},
+			clienttypes.ZeroHeight(),
+			true,
+		},
+		{
+			"failure: client type does not expose a latest height",
+			mockTimeoutHeightQuerier{clientID: clientID, clientState: solomachineClientState},
+			clienttypes.ZeroHeight(),
+			true,
+		},
+		{
+			"failure: channel client state query fails",
+			mockTimeoutHeightQuerier{err: errors.New("channel not found")},
+			clienttypes.ZeroHeight(),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			height, err := queryTimeoutHeight(context.Background(), tc.querier, registry, portID, channelID, 50)
+
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedHeight, height)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -89,8 +90,8 @@ func (im IBCModule) OnChanOpenInit(
 		version = types.Version
 	}
 
-	if version != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, version)
+	if !slices.Contains(types.SupportedVersions, version) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, version)
 	}
 
 	// Claim channel capability passed back by IBC module
@@ -121,13 +122,13 @@ func (im IBCModule) OnChanOpenTry(
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
+	if !slices.Contains(types.SupportedVersions, counterpartyVersion) {
 		// Propose the current version
 		im.keeper.Logger(ctx).Debug("invalid counterparty version, proposing current app version", "counterpartyVersion", counterpartyVersion, "version", types.Version)
 		return types.Version, nil
 	}
 
-	return types.Version, nil
+	return counterpartyVersion, nil
 }
 
 // OnChanOpenAck implements the IBCModule interface
@@ -138,8 +139,8 @@ func (IBCModule) OnChanOpenAck(
 	_ string,
 	counterpartyVersion string,
 ) error {
-	if counterpartyVersion != types.Version {
-		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected %s, got %s", types.Version, counterpartyVersion)
+	if !slices.Contains(types.SupportedVersions, counterpartyVersion) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 	return nil
 }
@@ -182,39 +183,60 @@ func (im IBCModule) OnRecvPacket(
 ) ibcexported.Acknowledgement {
 	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
 
-	var data types.FungibleTokenPacketData
-	var ackErr error
+	var (
+		data     types.FungibleTokenPacketData
+		receiver sdk.AccAddress
+		ackErr   error
+	)
 	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
 		ackErr = errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-20 transfer packet data")
 		im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
-		ack = channeltypes.NewErrorAcknowledgement(ackErr)
+		ack = im.keeper.NewErrorAcknowledgement(ctx, packet, ackErr)
 	}
 
 	// only attempt the application logic if the packet data
 	// was successfully decoded
 	if ack.Success() {
-		err := im.keeper.OnRecvPacket(ctx, packet, data)
+		receivedToken, resolvedReceiver, err := im.keeper.OnRecvPacket(ctx, packet, data)
 		if err != nil {
-			ack = channeltypes.NewErrorAcknowledgement(err)
+			ack = im.keeper.NewErrorAcknowledgement(ctx, packet, err)
 			ackErr = err
 			im.keeper.Logger(ctx).Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
 		} else {
 			im.keeper.Logger(ctx).Info("successfully handled ICS-20 packet", "sequence", packet.Sequence)
+			receiver = resolvedReceiver
+
+			// channels using the structured acknowledgement transfer version acknowledge the received denomination and amount
+			if version, found := im.keeper.GetICS4Wrapper().GetAppVersion(ctx, packet.GetDestPort(), packet.GetDestChannel()); found && version == types.VersionStructuredAck {
+				ack = channeltypes.NewResultAcknowledgement(types.NewAcknowledgementResult(receivedToken.Denom, receivedToken.Amount.String()).GetBytes())
+			}
 		}
 	}
 
+	// the trace lists the ordered hops of the denomination received on this chain, starting with the most recent hop
+	receivedTrace := types.GetReceivedDenomTrace(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+
 	eventAttributes := []sdk.Attribute{
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
 		sdk.NewAttribute(sdk.AttributeKeySender, data.Sender),
 		sdk.NewAttribute(types.AttributeKeyReceiver, data.Receiver),
 		sdk.NewAttribute(types.AttributeKeyDenom, data.Denom),
+		sdk.NewAttribute(types.AttributeKeyTrace, strings.Join(receivedTrace.Hops(), ",")),
 		sdk.NewAttribute(types.AttributeKeyAmount, data.Amount),
 		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
 		sdk.NewAttribute(types.AttributeKeyAckSuccess, fmt.Sprintf("%t", ack.Success())),
 	}
 
+	if ack.Success() {
+		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyResolvedReceiver, receiver.String()))
+	}
+
 	if ackErr != nil {
 		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyAckError, ackErr.Error()))
+
+		if reason := types.GetAckErrorReason(ackErr); reason != "" {
+			eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyAckErrorReason, reason))
+		}
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -270,10 +292,18 @@ func (im IBCModule) OnAcknowledgementPacket(
 			),
 		)
 	case *channeltypes.Acknowledgement_Error:
+		attributes := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeyAckError, resp.Error)}
+		if reason := types.ParseAckErrorReason(ack); reason != "" {
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyAckErrorReason, reason))
+		}
+		if srcSender := data.GetSourceSender(); srcSender != "" {
+			attributes = append(attributes, sdk.NewAttribute(types.AttributeKeySourceSender, srcSender))
+		}
+
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypePacket,
-				sdk.NewAttribute(types.AttributeKeyAckError, resp.Error),
+				attributes...,
 			),
 		)
 	}
@@ -296,14 +326,27 @@ func (im IBCModule) OnTimeoutPacket(
 		return err
 	}
 
+	refundReceiver, err := im.keeper.GetRefundReceiver(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyRefundReceiver, refundReceiver.String()),
+		sdk.NewAttribute(types.AttributeKeyRefundDenom, data.Denom),
+		sdk.NewAttribute(types.AttributeKeyRefundAmount, data.Amount),
+		sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+	}
+
+	if srcSender := data.GetSourceSender(); srcSender != "" {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeySourceSender, srcSender))
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTimeout,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyRefundReceiver, data.Sender),
-			sdk.NewAttribute(types.AttributeKeyRefundDenom, data.Denom),
-			sdk.NewAttribute(types.AttributeKeyRefundAmount, data.Amount),
-			sdk.NewAttribute(types.AttributeKeyMemo, data.Memo),
+			attributes...,
 		),
 	)
 
@@ -316,8 +359,8 @@ func (im IBCModule) OnChanUpgradeInit(ctx sdk.Context, portID, channelID string,
 		return "", err
 	}
 
-	if proposedVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, proposedVersion)
+	if !slices.Contains(types.SupportedVersions, proposedVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, proposedVersion)
 	}
 
 	return proposedVersion, nil
@@ -329,8 +372,8 @@ func (im IBCModule) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string,
 		return "", err
 	}
 
-	if counterpartyVersion != types.Version {
-		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, counterpartyVersion)
+	if !slices.Contains(types.SupportedVersions, counterpartyVersion) {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 
 	return counterpartyVersion, nil
@@ -338,8 +381,8 @@ func (im IBCModule) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string,
 
 // OnChanUpgradeAck implements the IBCModule interface
 func (IBCModule) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
-	if counterpartyVersion != types.Version {
-		return errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, counterpartyVersion)
+	if !slices.Contains(types.SupportedVersions, counterpartyVersion) {
+		return errorsmod.Wrapf(types.ErrInvalidVersion, "expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 
 	return nil
@@ -2,7 +2,9 @@ package transfer_test
 
 import (
 	"errors"
+	"fmt"
 	"math"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -14,6 +16,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -23,6 +26,7 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 		path         *ibctesting.Path
 		chanCap      *capabilitytypes.Capability
 		counterparty channeltypes.Counterparty
+		expVersion   string
 	)
 
 	testCases := []struct {
@@ -45,6 +49,12 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 				channel.Version = ""
 			}, nil,
 		},
+		{
+			"success: V2 version", func() {
+				channel.Version = types.VersionStructuredAck
+				expVersion = types.VersionStructuredAck
+			}, nil,
+		},
 		{
 			"max channels reached", func() {
 				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
@@ -55,6 +65,11 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 				channel.Ordering = channeltypes.ORDERED
 			}, channeltypes.ErrInvalidChannelOrdering,
 		},
+		{
+			"invalid order - ORDERED_ALLOW_TIMEOUT", func() {
+				channel.Ordering = channeltypes.ORDERED_ALLOW_TIMEOUT
+			}, channeltypes.ErrInvalidChannelOrdering,
+		},
 		{
 			"invalid port ID", func() {
 				path.EndpointA.ChannelConfig.PortID = ibctesting.MockPort
@@ -90,6 +105,7 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 				ConnectionHops: []string{path.EndpointA.ConnectionID},
 				Version:        types.Version,
 			}
+			expVersion = types.Version
 
 			var err error
 			chanCap, err = suite.chainA.App.GetScopedIBCKeeper().NewCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(ibctesting.TransferPort, path.EndpointA.ChannelID))
@@ -105,7 +121,7 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 			expPass := tc.expError == nil
 			if expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(expVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Contains(err.Error(), tc.expError.Error())
@@ -121,6 +137,7 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 		path                *ibctesting.Path
 		counterparty        channeltypes.Counterparty
 		counterpartyVersion string
+		expVersion          string
 	)
 
 	testCases := []struct {
@@ -137,6 +154,12 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 				counterpartyVersion = "version"
 			}, nil,
 		},
+		{
+			"success: V2 counterparty version", func() {
+				counterpartyVersion = types.VersionStructuredAck
+				expVersion = types.VersionStructuredAck
+			}, nil,
+		},
 		{
 			"failure: max channels reached", func() {
 				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
@@ -153,6 +176,11 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 				channel.Ordering = channeltypes.ORDERED
 			}, channeltypes.ErrInvalidChannelOrdering,
 		},
+		{
+			"failure: invalid order - ORDERED_ALLOW_TIMEOUT", func() {
+				channel.Ordering = channeltypes.ORDERED_ALLOW_TIMEOUT
+			}, channeltypes.ErrInvalidChannelOrdering,
+		},
 		{
 			"failure: invalid port ID", func() {
 				path.EndpointA.ChannelConfig.PortID = ibctesting.MockPort
@@ -179,6 +207,7 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 				Version:        types.Version,
 			}
 			counterpartyVersion = types.Version
+			expVersion = types.Version
 
 			module, _, err := suite.chainA.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainA.GetContext(), ibctesting.TransferPort)
 			suite.Require().NoError(err)
@@ -197,7 +226,7 @@ func (suite *TransferTestSuite) TestOnChanOpenTry() {
 			expPass := tc.expError == nil
 			if expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(types.Version, version)
+				suite.Require().Equal(expVersion, version)
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Contains(err.Error(), tc.expError.Error())
@@ -447,6 +476,10 @@ func (suite *TransferTestSuite) TestOnChanUpgradeAck() {
 			err := path.EndpointA.ChanUpgradeInit()
 			suite.Require().NoError(err)
 
+			// the new connection hops must be proposed by the authority of chainB
+			err = path.EndpointB.ChanUpgradeInit()
+			suite.Require().NoError(err)
+
 			err = path.EndpointB.ChanUpgradeTry()
 			suite.Require().NoError(err)
 
@@ -543,3 +576,469 @@ func (suite *TransferTestSuite) TestPacketDataUnmarshalerInterface() {
 		})
 	}
 }
+
+// receiverAliasResolver resolves the receiver alias to a fixed account and rejects any other receiver.
+type receiverAliasResolver struct {
+	alias   string
+	account sdk.AccAddress
+}
+
+func (r receiverAliasResolver) Resolve(_ sdk.Context, packetReceiver string) (sdk.AccAddress, error) {
+	if packetReceiver != r.alias {
+		return nil, errors.New("unknown alias")
+	}
+
+	return r.account, nil
+}
+
+func (suite *TransferTestSuite) TestOnRecvPacketResolvedReceiver() {
+	var receiver string
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expAck   bool
+	}{
+		{
+			"success: alias resolved to account",
+			func() {},
+			true,
+		},
+		{
+			"failure: resolver returns an error",
+			func() {
+				receiver = "alias:unknown"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			account := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+			receiver = "alias:receiver"
+
+			tc.malleate()
+
+			transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+			transferKeeper.WithReceiverResolver(receiverAliasResolver{alias: "alias:receiver", account: account})
+			module := transfer.NewIBCModule(transferKeeper)
+
+			data := types.NewFungibleTokenPacketData(ibctesting.TestCoin.Denom, ibctesting.TestCoin.Amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver, "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+
+			ctx := suite.chainB.GetContext()
+			ack := module.OnRecvPacket(ctx, packet, suite.chainB.SenderAccount.GetAddress())
+			suite.Require().Equal(tc.expAck, ack.Success())
+
+			var resolvedReceiver string
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != types.EventTypePacket {
+					continue
+				}
+				if attr, found := event.GetAttribute(types.AttributeKeyResolvedReceiver); found {
+					resolvedReceiver = attr.Value
+				}
+			}
+
+			if tc.expAck {
+				suite.Require().Equal(account.String(), resolvedReceiver)
+			} else {
+				suite.Require().Empty(resolvedReceiver)
+			}
+		})
+	}
+}
+
+// traceIDAckErrorFormatter prefixes the default error acknowledgement message with a trace ID
+// derived from the packet sequence, or returns a fixed message if set.
+type traceIDAckErrorFormatter struct {
+	message *string
+}
+
+func (f traceIDAckErrorFormatter) FormatAckError(ctx sdk.Context, packet channeltypes.Packet, err error) string {
+	if f.message != nil {
+		return *f.message
+	}
+
+	return fmt.Sprintf("trace-id %d: %s", packet.Sequence, types.DefaultAckErrorFormatter{}.FormatAckError(ctx, packet, err))
+}
+
+func (suite *TransferTestSuite) TestOnRecvPacketAckErrorFormatter() {
+	var (
+		formatter types.AckErrorFormatter
+		receiver  string
+	)
+
+	testCases := []struct {
+		name        string
+		malleate    func()
+		expAckError func(defaultMessage string) string
+	}{
+		{
+			"success: default formatter",
+			func() {
+				formatter = nil
+			},
+			func(defaultMessage string) string { return defaultMessage },
+		},
+		{
+			"success: custom formatter",
+			func() {},
+			func(defaultMessage string) string { return "trace-id 1: " + defaultMessage },
+		},
+		{
+			"success: empty message is replaced by the default message",
+			func() {
+				message := " "
+				formatter = traceIDAckErrorFormatter{message: &message}
+			},
+			func(defaultMessage string) string { return defaultMessage },
+		},
+		{
+			"success: message exceeding the maximum length is truncated",
+			func() {
+				message := strings.Repeat("a", types.MaxAckErrorLength+1)
+				formatter = traceIDAckErrorFormatter{message: &message}
+			},
+			func(string) string { return strings.Repeat("a", types.MaxAckErrorLength) },
+		},
+		{
+			"success: formatter is not used for successful acknowledgements",
+			func() {
+				receiver = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			formatter = traceIDAckErrorFormatter{}
+			receiver = "" // fails packet data validation
+
+			tc.malleate()
+
+			transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+			transferKeeper.WithAckErrorFormatter(formatter)
+			module := transfer.NewIBCModule(transferKeeper)
+
+			data := types.NewFungibleTokenPacketData(ibctesting.TestCoin.Denom, ibctesting.TestCoin.Amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver, "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+
+			ack := module.OnRecvPacket(suite.chainB.GetContext(), packet, suite.chainB.SenderAccount.GetAddress())
+			if tc.expAckError == nil {
+				suite.Require().True(ack.Success())
+				return
+			}
+
+			errorAck, ok := ack.(channeltypes.Acknowledgement)
+			suite.Require().True(ok)
+			suite.Require().False(errorAck.Success())
+			suite.Require().NoError(errorAck.ValidateBasic())
+
+			// the default message holds the codespace and code of the error
+			defaultAck := channeltypes.NewErrorAcknowledgementWithCode(ibcerrors.ErrInvalidAddress)
+			suite.Require().Equal(tc.expAckError(defaultAck.GetError()), errorAck.GetError())
+		})
+	}
+}
+
+func (suite *TransferTestSuite) TestOnRecvPacketTraceAttribute() {
+	var (
+		path  *ibctesting.Path
+		denom string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expTrace func() string
+	}{
+		{
+			"native denom of the sender chain",
+			func() {},
+			func() string {
+				return fmt.Sprintf("%s/%s", path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			},
+		},
+		{
+			"multi-hop voucher",
+			func() {
+				denom = "transfer/channel-5/transfer/channel-7/" + sdk.DefaultBondDenom
+			},
+			func() string {
+				return fmt.Sprintf("%s/%s,transfer/channel-5,transfer/channel-7", path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			denom = sdk.DefaultBondDenom
+
+			tc.malleate()
+
+			module := transfer.NewIBCModule(suite.chainB.GetSimApp().TransferKeeper)
+
+			data := types.NewFungibleTokenPacketData(denom, ibctesting.TestCoin.Amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+
+			ctx := suite.chainB.GetContext()
+			ack := module.OnRecvPacket(ctx, packet, suite.chainB.SenderAccount.GetAddress())
+			suite.Require().True(ack.Success())
+
+			var packetTrace, denomTrace string
+			for _, event := range ctx.EventManager().Events() {
+				attr, found := event.GetAttribute(types.AttributeKeyTrace)
+				if !found {
+					continue
+				}
+
+				switch event.Type {
+				case types.EventTypePacket:
+					packetTrace = attr.Value
+				case types.EventTypeDenomTrace:
+					denomTrace = attr.Value
+				}
+			}
+
+			suite.Require().Equal(tc.expTrace(), packetTrace)
+			suite.Require().Equal(tc.expTrace(), denomTrace)
+		})
+	}
+}
+
+// TestAckErrorReason tests that the enumerated reason of a failed receipt is emitted on the receiving
+// chain and obtained by the sending chain from the error acknowledgement when refunding the sender.
+func (suite *TransferTestSuite) TestAckErrorReason() {
+	var (
+		path     *ibctesting.Path
+		receiver string
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expReason string
+	}{
+		{
+			"blocked address",
+			func() {
+				receiver = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
+			},
+			types.AckErrorReasonBlockedAddress,
+		},
+		{
+			"receive disabled",
+			func() {
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, false))
+			},
+			types.AckErrorReasonReceiveDisabled,
+		},
+		{
+			"invalid receiver",
+			func() {
+				receiver = "gaia1scqhwpgsmr6vmztaa7suurfl52my6nd2kmrudl"
+			},
+			types.AckErrorReasonInvalidReceiver,
+		},
+		{
+			"denom blocked",
+			func() {
+				voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)).IBCDenom()
+				suite.chainB.GetSimApp().BankKeeper.SetSendEnabled(suite.chainB.GetContext(), voucherDenom, false)
+			},
+			types.AckErrorReasonDenomBlocked,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			receiver = suite.chainB.SenderAccount.GetAddress().String()
+
+			tc.malleate()
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.TestCoin, sender.String(), receiver, suite.chainA.GetTimeoutHeight(), 0, "")
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err) // message committed
+
+			sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+			suite.Require().NotEmpty(sendPacketEvents)
+			packet := sendPacketEvents[0].Packet
+
+			// receive the packet on chainB
+			ctx := suite.chainB.GetContext()
+			ack := transfer.NewIBCModule(suite.chainB.GetSimApp().TransferKeeper).OnRecvPacket(ctx, packet, suite.chainB.SenderAccount.GetAddress())
+			suite.Require().False(ack.Success())
+			suite.Require().Equal(tc.expReason, ackErrorReasonAttribute(ctx.EventManager().Events()))
+
+			// acknowledge the packet on chainA, refunding the sender
+			ctx = suite.chainA.GetContext()
+			balanceBefore := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+
+			err = transfer.NewIBCModule(suite.chainA.GetSimApp().TransferKeeper).OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), suite.chainA.SenderAccount.GetAddress())
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expReason, ackErrorReasonAttribute(ctx.EventManager().Events()))
+
+			balanceAfter := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+			suite.Require().Equal(balanceBefore.Add(ibctesting.TestCoin), balanceAfter)
+		})
+	}
+}
+
+// ackErrorReasonAttribute returns the value of the ack error reason attribute of the packet events.
+func ackErrorReasonAttribute(events sdk.Events) string {
+	for _, event := range events {
+		if event.Type != types.EventTypePacket {
+			continue
+		}
+
+		if attr, found := event.GetAttribute(types.AttributeKeyAckErrorReason); found {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
+
+func (suite *TransferTestSuite) TestSourceSenderPropagation() {
+	var (
+		memo                 string
+		refundToSourceSender bool
+		expSrcSender         string
+	)
+
+	srcSender := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"src_sender propagated",
+			func() {},
+		},
+		{
+			"src_sender propagated with refunds to source sender enabled",
+			func() {
+				refundToSourceSender = true
+			},
+		},
+		{
+			"no src_sender in memo",
+			func() {
+				memo = ""
+				expSrcSender = ""
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			memo = fmt.Sprintf(`{"src_sender": "%s"}`, srcSender)
+			refundToSourceSender = false
+			expSrcSender = srcSender.String()
+
+			tc.malleate()
+
+			suite.chainA.GetSimApp().TransferKeeper.WithRefundToSourceSender(refundToSourceSender)
+			transferModule := transfer.NewIBCModule(suite.chainA.GetSimApp().TransferKeeper)
+
+			// the receiver is a blocked address so that the packet is acknowledged with an error
+			sender := suite.chainA.SenderAccount.GetAddress()
+			receiver := suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.TestCoin, sender.String(), receiver, suite.chainA.GetTimeoutHeight(), 0, memo)
+
+			// send two packets: the first is acknowledged with an error and the second times out
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			ackPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+			suite.Require().NotEmpty(ackPacketEvents)
+			ackPacket := ackPacketEvents[0].Packet
+
+			res, err = suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			timeoutPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+			suite.Require().NotEmpty(timeoutPacketEvents)
+			timeoutPacket := timeoutPacketEvents[0].Packet
+
+			// the memo is propagated to the counterparty unchanged
+			ctx := suite.chainB.GetContext()
+			ack := transfer.NewIBCModule(suite.chainB.GetSimApp().TransferKeeper).OnRecvPacket(ctx, ackPacket, suite.chainB.SenderAccount.GetAddress())
+			suite.Require().False(ack.Success())
+			suite.Require().Equal(memo, packetEventAttribute(ctx.EventManager().Events(), types.EventTypePacket, types.AttributeKeyMemo))
+
+			// the src_sender is emitted when the error acknowledgement is processed
+			ctx = suite.chainA.GetContext()
+			err = transferModule.OnAcknowledgementPacket(ctx, ackPacket, ack.Acknowledgement(), suite.chainA.SenderAccount.GetAddress())
+			suite.Require().NoError(err)
+			suite.Require().Equal(expSrcSender, packetEventAttribute(ctx.EventManager().Events(), types.EventTypePacket, types.AttributeKeySourceSender))
+
+			// the src_sender is emitted on timeout, and receives the refund if refunds to the source sender are enabled
+			expRefundReceiver := sender.String()
+			if refundToSourceSender {
+				expRefundReceiver = srcSender.String()
+			}
+
+			ctx = suite.chainA.GetContext()
+			err = transferModule.OnTimeoutPacket(ctx, timeoutPacket, suite.chainA.SenderAccount.GetAddress())
+			suite.Require().NoError(err)
+			suite.Require().Equal(expSrcSender, packetEventAttribute(ctx.EventManager().Events(), types.EventTypeTimeout, types.AttributeKeySourceSender))
+			suite.Require().Equal(expRefundReceiver, packetEventAttribute(ctx.EventManager().Events(), types.EventTypeTimeout, types.AttributeKeyRefundReceiver))
+		})
+	}
+}
+
+// packetEventAttribute returns the value of the given attribute of the first event of the given type containing it.
+func packetEventAttribute(events sdk.Events, eventType, key string) string {
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+
+		if attr, found := event.GetAttribute(key); found {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// WithTrustedMesh enables the compaction of the denomination traces of tokens sent over the
+// channels of this chain participating in the provided trusted mesh (see types.TrustedMesh).
+// Compaction is disabled by default and tokens sent over channels outside of the mesh are
+// never compacted, so the denominations of the vouchers minted by chains which do not
+// participate in the mesh are unaffected. It panics if the mesh is invalid.
+func (k *Keeper) WithTrustedMesh(mesh types.TrustedMesh) {
+	if err := mesh.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.trustedMesh = mesh
+}
+
+// GetTrustedMesh returns the trusted mesh set on the keeper.
+func (k Keeper) GetTrustedMesh() types.TrustedMesh {
+	return k.trustedMesh
+}
+
+// GetCompactedDenom returns the full denomination trace of the tokens escrowed on the provided
+// channel for transfers sent with the provided compacted denomination trace.
+func (k Keeper) GetCompactedDenom(ctx sdk.Context, portID, channelID, denom string) (types.CompactedDenom, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CompactedDenomKey(portID, channelID, denom))
+	if len(bz) == 0 {
+		return types.CompactedDenom{}, false
+	}
+
+	var compactedDenom types.CompactedDenom
+	k.cdc.MustUnmarshal(bz, &compactedDenom)
+
+	return compactedDenom, true
+}
+
+// SetCompactedDenom stores the provided compacted denomination.
+func (k Keeper) SetCompactedDenom(ctx sdk.Context, compactedDenom types.CompactedDenom) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&compactedDenom)
+	store.Set(types.CompactedDenomKey(compactedDenom.PortId, compactedDenom.ChannelId, compactedDenom.Denom), bz)
+}
+
+// GetAllCompactedDenoms returns all compacted denominations stored.
+func (k Keeper) GetAllCompactedDenoms(ctx sdk.Context) []types.CompactedDenom {
+	var compactedDenoms []types.CompactedDenom
+	k.IterateCompactedDenoms(ctx, func(compactedDenom types.CompactedDenom) bool {
+		compactedDenoms = append(compactedDenoms, compactedDenom)
+		return false
+	})
+
+	return compactedDenoms
+}
+
+// IterateCompactedDenoms iterates over the compacted denominations in the store
+// and performs a callback function.
+func (k Keeper) IterateCompactedDenoms(ctx sdk.Context, cb func(compactedDenom types.CompactedDenom) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(fmt.Sprintf("%s/", types.KeyCompactedDenomPrefix)))
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var compactedDenom types.CompactedDenom
+		k.cdc.MustUnmarshal(iterator.Value(), &compactedDenom)
+		if cb(compactedDenom) {
+			break
+		}
+	}
+}
+
+// compactDenomPath returns the denomination trace to send in the packet data of a transfer of the
+// tokens with the provided full denomination trace over the provided channel. The trace is only
+// compacted if the channel participates in the trusted mesh and this chain is the source of the
+// tokens for both the full and the compacted trace, in which case the tokens are escrowed and the
+// full trace is recorded so that the escrowed tokens are released when the vouchers return or the
+// transfer is refunded. A compacted trace is only ever recorded for a single full trace per channel,
+// tokens whose trace compacts to a trace already recorded for a different full trace are sent with
+// their full trace.
+func (k Keeper) compactDenomPath(ctx sdk.Context, portID, channelID, fullDenomPath string) string {
+	if !k.trustedMesh.HasChannel(ctx.ChainID(), portID, channelID) {
+		return fullDenomPath
+	}
+
+	denom := k.trustedMesh.CompactDenomTrace(ctx.ChainID(), types.ParseDenomTrace(fullDenomPath)).GetFullDenomPath()
+	if denom == fullDenomPath {
+		return fullDenomPath
+	}
+
+	if !types.SenderChainIsSource(portID, channelID, fullDenomPath) || !types.SenderChainIsSource(portID, channelID, denom) {
+		return fullDenomPath
+	}
+
+	if compactedDenom, found := k.GetCompactedDenom(ctx, portID, channelID, denom); found {
+		if compactedDenom.FullDenomPath != fullDenomPath {
+			return fullDenomPath
+		}
+
+		return denom
+	}
+
+	k.SetCompactedDenom(ctx, types.NewCompactedDenom(portID, channelID, denom, fullDenomPath))
+
+	return denom
+}
+
+// expandDenomPath returns the denomination trace of the tokens to unescrow from the provided channel for
+// the provided amount of tokens received back, or refunded, with the provided denomination trace. If the
+// denomination trace was compacted, this is the full denomination trace recorded upon sending as long as
+// the escrowed balance of the tokens with the full trace covers the amount. Otherwise, or if the trace
+// was not compacted, the denomination trace is returned as is, since the chains of the mesh treat the
+// tokens with the full and the compacted traces as the same tokens.
+func (k Keeper) expandDenomPath(ctx sdk.Context, portID, channelID, denom string, amount sdkmath.Int) string {
+	compactedDenom, found := k.GetCompactedDenom(ctx, portID, channelID, denom)
+	if !found {
+		return denom
+	}
+
+	token := sdk.NewCoin(types.ParseDenomTrace(compactedDenom.FullDenomPath).IBCDenom(), amount)
+	escrowAddress := k.getUnescrowAddress(ctx, portID, channelID, token.Denom)
+	if !k.bankKeeper.GetBalance(ctx, escrowAddress, token.Denom).IsGTE(token) {
+		return denom
+	}
+
+	return compactedDenom.FullDenomPath
+}
@@ -0,0 +1,208 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// TestTrustedMeshCompaction tests that the redundant round-trip hop of a voucher sent within a trusted
+// mesh is collapsed from the denomination trace sent in the packet data, while the escrowed vouchers
+// are released when the tokens return or the transfer is refunded.
+func (suite *KeeperTestSuite) TestTrustedMeshCompaction() {
+	var (
+		pathAToB      *ibctesting.Path
+		pathBToA      *ibctesting.Path
+		pathAToC      *ibctesting.Path
+		sendPath      *ibctesting.Path
+		fullDenomPath string
+		voucher       sdk.Coin
+		expDenom      string
+		expCompacts   bool
+	)
+
+	amount := sdkmath.NewInt(100)
+
+	// relayTransfer transfers the provided tokens from the source endpoint to the sender account of
+	// the counterparty chain and relays the packet
+	relayTransfer := func(path *ibctesting.Path, endpoint *ibctesting.Endpoint, token sdk.Coin) {
+		msg := types.NewMsgTransfer(
+			endpoint.ChannelConfig.PortID, endpoint.ChannelID, token,
+			endpoint.Chain.SenderAccount.GetAddress().String(), endpoint.Counterparty.Chain.SenderAccount.GetAddress().String(),
+			endpoint.Counterparty.Chain.GetTimeoutHeight(), 0, "",
+		)
+		res, err := endpoint.Chain.SendMsgs(msg)
+		suite.Require().NoError(err)
+
+		sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+		suite.Require().NotEmpty(sendPacketEvents)
+		packet := sendPacketEvents[0].Packet
+		suite.Require().NoError(path.RelayPacket(packet))
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: round-trip hop collapsed on a channel within the mesh",
+			func() {},
+		},
+		{
+			"success: denomination trace sent as is on a channel outside of the mesh",
+			func() {
+				sendPath = ibctesting.NewTransferPath(suite.chainA, suite.chainC)
+				sendPath.Setup()
+
+				expDenom = fullDenomPath
+				expCompacts = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			pathAToB = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			pathAToB.Setup()
+
+			// a second channel between chainA and chainB, over which the vouchers are not unwound
+			pathBToA = ibctesting.NewTransferPath(suite.chainB, suite.chainA)
+			pathBToA.Setup()
+
+			pathAToC = ibctesting.NewTransferPath(suite.chainA, suite.chainC)
+			pathAToC.Setup()
+
+			// send native tokens of chainA to chainB and back over the second channel
+			relayTransfer(pathAToB, pathAToB.EndpointA, sdk.NewCoin(sdk.DefaultBondDenom, amount))
+			relayTransfer(pathBToA, pathBToA.EndpointA, sdk.NewCoin(types.GetTransferCoin(pathAToB.EndpointB.ChannelConfig.PortID, pathAToB.EndpointB.ChannelID, sdk.DefaultBondDenom, amount).Denom, amount))
+
+			fullDenomPath = types.GetPrefixedDenom(
+				pathBToA.EndpointB.ChannelConfig.PortID, pathBToA.EndpointB.ChannelID,
+				types.GetPrefixedDenom(pathAToB.EndpointB.ChannelConfig.PortID, pathAToB.EndpointB.ChannelID, sdk.DefaultBondDenom),
+			)
+			voucher = sdk.NewCoin(types.ParseDenomTrace(fullDenomPath).IBCDenom(), amount)
+			suite.Require().Equal(voucher, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), voucher.Denom))
+
+			sendPath = pathAToC
+			expDenom = sdk.DefaultBondDenom
+			expCompacts = true
+
+			tc.malleate()
+
+			// compaction is enabled on a copy of the keeper of chainA
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			transferKeeper.WithTrustedMesh(types.TrustedMesh{
+				{ChainID: suite.chainA.ChainID, PortID: pathBToA.EndpointB.ChannelConfig.PortID, ChannelID: pathBToA.EndpointB.ChannelID, CounterpartyChainID: suite.chainB.ChainID},
+				{ChainID: suite.chainB.ChainID, PortID: pathAToB.EndpointB.ChannelConfig.PortID, ChannelID: pathAToB.EndpointB.ChannelID, CounterpartyChainID: suite.chainA.ChainID},
+				{ChainID: suite.chainA.ChainID, PortID: pathAToC.EndpointA.ChannelConfig.PortID, ChannelID: pathAToC.EndpointA.ChannelID, CounterpartyChainID: suite.chainC.ChainID},
+			})
+
+			ctx := suite.chainA.GetContext()
+			msg := types.NewMsgTransfer(
+				sendPath.EndpointA.ChannelConfig.PortID, sendPath.EndpointA.ChannelID, voucher,
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainC.SenderAccount.GetAddress().String(),
+				suite.chainC.GetTimeoutHeight(), 0, "",
+			)
+			_, err := transferKeeper.Transfer(ctx, msg)
+			suite.Require().NoError(err)
+
+			sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](ctx.EventManager().Events().ToABCIEvents())
+			suite.Require().NotEmpty(sendPacketEvents)
+			packet := sendPacketEvents[0].Packet
+
+			var data types.FungibleTokenPacketData
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+			suite.Require().Equal(expDenom, data.Denom)
+
+			compactedDenom, found := transferKeeper.GetCompactedDenom(ctx, sendPath.EndpointA.ChannelConfig.PortID, sendPath.EndpointA.ChannelID, data.Denom)
+			suite.Require().Equal(expCompacts, found)
+			if expCompacts {
+				suite.Require().Equal(fullDenomPath, compactedDenom.FullDenomPath)
+			}
+
+			// the vouchers are escrowed, not burned
+			escrowAddress := types.GetEscrowAddress(sendPath.EndpointA.ChannelConfig.PortID, sendPath.EndpointA.ChannelID)
+			suite.Require().Equal(voucher, suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, escrowAddress, voucher.Denom))
+
+			// the refund releases the escrowed vouchers
+			cacheCtx, _ := ctx.CacheContext()
+			suite.Require().NoError(transferKeeper.OnTimeoutPacket(cacheCtx, packet, data))
+			suite.Require().Equal(voucher, suite.chainA.GetSimApp().BankKeeper.GetBalance(cacheCtx, suite.chainA.SenderAccount.GetAddress(), voucher.Denom))
+
+			// the tokens received back are the escrowed vouchers with the full denomination trace
+			recvCtx, _ := ctx.CacheContext()
+			recvData := types.NewFungibleTokenPacketData(
+				types.GetPrefixedDenom(sendPath.EndpointB.ChannelConfig.PortID, sendPath.EndpointB.ChannelID, expDenom), amount.String(),
+				suite.chainC.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), "",
+			)
+			recvPacket := channeltypes.NewPacket(recvData.GetBytes(), 1, sendPath.EndpointB.ChannelConfig.PortID, sendPath.EndpointB.ChannelID, sendPath.EndpointA.ChannelConfig.PortID, sendPath.EndpointA.ChannelID, suite.chainA.GetTimeoutHeight(), 0)
+			receivedToken, _, err := transferKeeper.OnRecvPacket(recvCtx, recvPacket, recvData)
+			suite.Require().NoError(err)
+			suite.Require().Equal(voucher, receivedToken)
+
+			// the vouchers minted on chainC have the hash of the sent denomination trace
+			suite.Require().NoError(sendPath.EndpointA.UpdateClient())
+			suite.Require().NoError(sendPath.RelayPacket(packet))
+
+			voucherC := types.GetTransferCoin(sendPath.EndpointB.ChannelConfig.PortID, sendPath.EndpointB.ChannelID, expDenom, amount)
+			suite.Require().Equal(voucherC, suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), suite.chainC.SenderAccount.GetAddress(), voucherC.Denom))
+
+			// sending the tokens back to chainA releases the escrowed vouchers
+			relayTransfer(sendPath, sendPath.EndpointB, voucherC)
+
+			suite.Require().Equal(voucher, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), voucher.Denom))
+			suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, voucher.Denom).IsZero())
+		})
+	}
+}
+
+// TestCompactedDenomReceivedWithoutEscrowedVouchers tests that tokens received back with a compacted
+// denomination trace are unescrowed with the compacted trace if the escrowed balance of the vouchers
+// with the full trace does not cover the amount.
+func (suite *KeeperTestSuite) TestCompactedDenomReceivedWithoutEscrowedVouchers() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	amount := sdkmath.NewInt(100)
+
+	// escrow native tokens of chainA on the channel to chainB
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, amount),
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0, "",
+	)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+	suite.Require().NotEmpty(sendPacketEvents)
+	packet := sendPacketEvents[0].Packet
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	// record a compaction of a trace for which no vouchers are escrowed
+	fullDenomPath := types.GetPrefixedDenom("transfer", "channel-10", types.GetPrefixedDenom("transfer", "channel-11", sdk.DefaultBondDenom))
+	suite.chainA.GetSimApp().TransferKeeper.SetCompactedDenom(suite.chainA.GetContext(), types.NewCompactedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, fullDenomPath))
+
+	balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	voucher := types.GetTransferCoin(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom, amount)
+	data := types.NewFungibleTokenPacketData(
+		types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom), voucher.Amount.String(),
+		suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), "",
+	)
+	packet = channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.GetTimeoutHeight(), 0)
+
+	receivedToken, _, err := suite.chainA.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainA.GetContext(), packet, data)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, amount), receivedToken)
+
+	suite.Require().Equal(balance.Add(sdk.NewCoin(sdk.DefaultBondDenom, amount)), suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+}
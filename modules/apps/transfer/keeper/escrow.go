@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// GetEscrowAccount returns the escrow account holding the tokens of the provided denomination escrowed
+// on the provided channel.
+func (k Keeper) GetEscrowAccount(ctx sdk.Context, portID, channelID, denom string) (types.EscrowAccount, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EscrowAccountKey(portID, channelID, denom))
+	if len(bz) == 0 {
+		return types.EscrowAccount{}, false
+	}
+
+	var escrowAccount types.EscrowAccount
+	k.cdc.MustUnmarshal(bz, &escrowAccount)
+
+	return escrowAccount, true
+}
+
+// SetEscrowAccount stores the provided escrow account.
+func (k Keeper) SetEscrowAccount(ctx sdk.Context, escrowAccount types.EscrowAccount) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&escrowAccount)
+	store.Set(types.EscrowAccountKey(escrowAccount.PortId, escrowAccount.ChannelId, escrowAccount.Denom), bz)
+}
+
+// GetAllEscrowAccounts returns all escrow accounts stored.
+func (k Keeper) GetAllEscrowAccounts(ctx sdk.Context) []types.EscrowAccount {
+	var escrowAccounts []types.EscrowAccount
+	k.IterateEscrowAccounts(ctx, func(escrowAccount types.EscrowAccount) bool {
+		escrowAccounts = append(escrowAccounts, escrowAccount)
+		return false
+	})
+
+	return escrowAccounts
+}
+
+// IterateEscrowAccounts iterates over the escrow accounts in the store
+// and performs a callback function.
+func (k Keeper) IterateEscrowAccounts(ctx sdk.Context, cb func(escrowAccount types.EscrowAccount) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(fmt.Sprintf("%s/", types.KeyEscrowAccountPrefix)))
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var escrowAccount types.EscrowAccount
+		k.cdc.MustUnmarshal(iterator.Value(), &escrowAccount)
+		if cb(escrowAccount) {
+			break
+		}
+	}
+}
+
+// getEscrowAddress returns the escrow address in which tokens of the provided denomination sent on the provided
+// channel are escrowed under the current params, and records it as the escrow account of the denomination. The
+// balance of the denomination held by the previously recorded escrow account, if it differs, is moved to the
+// escrow address, such that the escrowed tokens of a denomination are always held by a single escrow account.
+func (k Keeper) getEscrowAddress(ctx sdk.Context, portID, channelID, denom string) (sdk.AccAddress, error) {
+	escrowAddress := k.GetEscrowAddressForDenom(ctx, portID, channelID, denom)
+	if err := k.moveEscrowAccount(ctx, portID, channelID, denom, escrowAddress); err != nil {
+		return nil, err
+	}
+
+	return escrowAddress, nil
+}
+
+// getUnescrowAddress returns the escrow address from which tokens of the provided denomination received or refunded
+// on the provided channel are unescrowed. This is the recorded escrow account of the denomination, or the default
+// escrow address of the channel if no tokens of the denomination have been escrowed on the channel.
+func (k Keeper) getUnescrowAddress(ctx sdk.Context, portID, channelID, denom string) sdk.AccAddress {
+	escrowAccount, found := k.GetEscrowAccount(ctx, portID, channelID, denom)
+	if !found {
+		return types.GetEscrowAddress(portID, channelID)
+	}
+
+	return sdk.MustAccAddressFromBech32(escrowAccount.Address)
+}
+
+// moveEscrowAccount moves the balance of the provided denomination held by the recorded escrow account of the
+// denomination for the provided channel to the provided escrow address, and records the escrow address as the
+// escrow account of the denomination.
+func (k Keeper) moveEscrowAccount(ctx sdk.Context, portID, channelID, denom string, escrowAddress sdk.AccAddress) error {
+	escrowAccount, found := k.GetEscrowAccount(ctx, portID, channelID, denom)
+	if found && escrowAccount.Address == escrowAddress.String() {
+		return nil
+	}
+
+	if found {
+		previousAddress := sdk.MustAccAddressFromBech32(escrowAccount.Address)
+		if balance := k.bankKeeper.GetBalance(ctx, previousAddress, denom); balance.IsPositive() {
+			if err := k.bankKeeper.SendCoins(ctx, previousAddress, escrowAddress, sdk.NewCoins(balance)); err != nil {
+				return errorsmod.Wrapf(err, "failed to move escrowed %s of channel %s", denom, channelID)
+			}
+		}
+	}
+
+	k.SetEscrowAccount(ctx, types.NewEscrowAccount(portID, channelID, denom, escrowAddress))
+	return nil
+}
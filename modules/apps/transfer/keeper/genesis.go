@@ -35,14 +35,34 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	for _, denomEscrow := range state.TotalEscrowed {
 		k.SetTotalEscrowForDenom(ctx, denomEscrow)
 	}
+
+	for _, quota := range state.TransferQuotas {
+		k.SetQuota(ctx, quota)
+	}
+
+	for _, receiverPrefix := range state.ReceiverPrefixes {
+		k.SetChannelReceiverPrefix(ctx, receiverPrefix)
+	}
+
+	for _, compactedDenom := range state.CompactedDenoms {
+		k.SetCompactedDenom(ctx, compactedDenom)
+	}
+
+	for _, escrowAccount := range state.EscrowAccounts {
+		k.SetEscrowAccount(ctx, escrowAccount)
+	}
 }
 
-// ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
+// ExportGenesis exports ibc-transfer module's portID, denom trace info, transfer quotas, receiver prefixes, compacted denominations and escrow accounts into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:        k.GetPort(ctx),
-		DenomTraces:   k.GetAllDenomTraces(ctx),
-		Params:        k.GetParams(ctx),
-		TotalEscrowed: k.GetAllTotalEscrowed(ctx),
+		PortId:           k.GetPort(ctx),
+		DenomTraces:      k.GetAllDenomTraces(ctx),
+		Params:           k.GetParams(ctx),
+		TotalEscrowed:    k.GetAllTotalEscrowed(ctx),
+		TransferQuotas:   k.GetAllQuotas(ctx),
+		ReceiverPrefixes: k.GetAllReceiverPrefixes(ctx),
+		CompactedDenoms:  k.GetAllCompactedDenoms(ctx),
+		EscrowAccounts:   k.GetAllEscrowAccounts(ctx),
 	}
 }
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -45,11 +46,28 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(denom, amount))
 	}
 
+	quota := types.NewTransferQuota("channel-0", sdk.DefaultBondDenom, sdkmath.NewInt(100), time.Hour, suite.chainA.GetContext().BlockTime().UTC())
+	quota.NetOutflow = sdkmath.NewInt(30)
+	suite.chainA.GetSimApp().TransferKeeper.SetQuota(suite.chainA.GetContext(), quota)
+
+	receiverPrefix := types.NewReceiverPrefix("channel-0", "osmo")
+	suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiverPrefix(suite.chainA.GetContext(), receiverPrefix)
+
+	compactedDenom := types.NewCompactedDenom(types.PortID, "channel-0", sdk.DefaultBondDenom, "transfer/channel-1/transfer/channel-2/stake")
+	suite.chainA.GetSimApp().TransferKeeper.SetCompactedDenom(suite.chainA.GetContext(), compactedDenom)
+
+	escrowAccount := types.NewEscrowAccount(types.PortID, "channel-0", sdk.DefaultBondDenom, types.GetEscrowAddress(types.PortID, "channel-0"))
+	suite.chainA.GetSimApp().TransferKeeper.SetEscrowAccount(suite.chainA.GetContext(), escrowAccount)
+
 	genesis := suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
 
 	suite.Require().Equal(types.PortID, genesis.PortId)
 	suite.Require().Equal(denomTraces.Sort(), genesis.DenomTraces)
 	suite.Require().Equal(escrows.Sort(), genesis.TotalEscrowed)
+	suite.Require().Equal([]types.TransferQuota{quota}, genesis.TransferQuotas)
+	suite.Require().Equal([]types.ReceiverPrefix{receiverPrefix}, genesis.ReceiverPrefixes)
+	suite.Require().Equal([]types.CompactedDenom{compactedDenom}, genesis.CompactedDenoms)
+	suite.Require().Equal([]types.EscrowAccount{escrowAccount}, genesis.EscrowAccounts)
 
 	suite.Require().NotPanics(func() {
 		suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
@@ -59,4 +77,20 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		_, found := suite.chainA.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainA.GetContext(), denomTrace.IBCDenom())
 		suite.Require().True(found)
 	}
+
+	exportedQuota, found := suite.chainA.GetSimApp().TransferKeeper.GetQuota(suite.chainA.GetContext(), quota.ChannelId, quota.Denom)
+	suite.Require().True(found)
+	suite.Require().Equal(quota, exportedQuota)
+
+	exportedReceiverPrefix, found := suite.chainA.GetSimApp().TransferKeeper.GetChannelReceiverPrefix(suite.chainA.GetContext(), receiverPrefix.ChannelId)
+	suite.Require().True(found)
+	suite.Require().Equal(receiverPrefix, exportedReceiverPrefix)
+
+	exportedCompactedDenom, found := suite.chainA.GetSimApp().TransferKeeper.GetCompactedDenom(suite.chainA.GetContext(), compactedDenom.PortId, compactedDenom.ChannelId, compactedDenom.Denom)
+	suite.Require().True(found)
+	suite.Require().Equal(compactedDenom, exportedCompactedDenom)
+
+	exportedEscrowAccount, found := suite.chainA.GetSimApp().TransferKeeper.GetEscrowAccount(suite.chainA.GetContext(), escrowAccount.PortId, escrowAccount.ChannelId, escrowAccount.Denom)
+	suite.Require().True(found)
+	suite.Require().Equal(escrowAccount, exportedEscrowAccount)
 }
@@ -46,6 +46,123 @@ func (k Keeper) DenomTrace(c context.Context, req *types.QueryDenomTraceRequest)
 	}, nil
 }
 
+// DenomResolved implements the Query/DenomResolved gRPC method. In addition to the denomination trace, it returns
+// the client identifier and counterparty chain ID of the hop terminating on this chain. Deeper hops terminate on
+// other chains and cannot be resolved locally.
+func (k Keeper) DenomResolved(c context.Context, req *types.QueryDenomResolvedRequest) (*types.QueryDenomResolvedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := types.ParseHexHash(strings.TrimPrefix(req.Hash, "ibc/"))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid denom trace hash: %s, error: %s", hash.String(), err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denomTrace, found := k.GetDenomTrace(ctx, hash)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrTraceNotFound, req.Hash).Error(),
+		)
+	}
+
+	hops := denomTrace.Hops()
+	resolvedHops := make([]types.ResolvedHop, len(hops))
+	for i, hop := range hops {
+		portID, channelID, _ := strings.Cut(hop, "/")
+		resolvedHops[i] = types.ResolvedHop{
+			PortId:    portID,
+			ChannelId: channelID,
+		}
+	}
+
+	// only the most recent hop terminates on this chain
+	if len(resolvedHops) > 0 {
+		resolvedHops[0] = k.resolveHop(ctx, resolvedHops[0])
+	}
+
+	return &types.QueryDenomResolvedResponse{
+		DenomTrace: &denomTrace,
+		Hops:       resolvedHops,
+	}, nil
+}
+
+// DenomOrigin implements the Query/DenomOrigin gRPC method. The origin hop of an ibc denomination is the last hop
+// of its denomination trace, which is only resolved if the tokens were received directly from their originating chain.
+// Denominations without the ibc prefix are native denominations of this chain.
+func (k Keeper) DenomOrigin(c context.Context, req *types.QueryDenomOriginRequest) (*types.QueryDenomOriginResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !strings.HasPrefix(req.Denom, types.DenomPrefix+"/") {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return &types.QueryDenomOriginResponse{
+			BaseDenom: req.Denom,
+			Native:    true,
+		}, nil
+	}
+
+	hash, err := types.ParseHexHash(strings.TrimPrefix(req.Denom, types.DenomPrefix+"/"))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid denom trace hash: %s, error: %s", hash.String(), err))
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	denomTrace, found := k.GetDenomTrace(ctx, hash)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrTraceNotFound, req.Denom).Error(),
+		)
+	}
+
+	hops := denomTrace.Hops()
+	if len(hops) == 0 {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("denomination trace of %s has no hops", req.Denom))
+	}
+
+	portID, channelID, _ := strings.Cut(hops[len(hops)-1], "/")
+	originHop := types.ResolvedHop{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+
+	// only the most recent hop terminates on this chain
+	if len(hops) == 1 {
+		originHop = k.resolveHop(ctx, originHop)
+	}
+
+	return &types.QueryDenomOriginResponse{
+		BaseDenom:  denomTrace.BaseDenom,
+		OriginHop:  &originHop,
+		DenomTrace: &denomTrace,
+	}, nil
+}
+
+// resolveHop returns the given hop together with the client identifier and counterparty chain ID of its channel.
+// The hop is returned unresolved if its channel or client cannot be found.
+func (k Keeper) resolveHop(ctx sdk.Context, hop types.ResolvedHop) types.ResolvedHop {
+	clientID, clientState, err := k.channelKeeper.GetChannelClientState(ctx, hop.PortId, hop.ChannelId)
+	if err != nil {
+		return hop
+	}
+
+	hop.Resolved = true
+	hop.ClientId = clientID
+
+	if chainIDClientState, ok := clientState.(interface{ GetChainID() string }); ok {
+		hop.CounterpartyChainId = chainIDClientState.GetChainID()
+	}
+
+	return hop
+}
+
 // DenomTraces implements the Query/DenomTraces gRPC method
 func (k Keeper) DenomTraces(c context.Context, req *types.QueryDenomTracesRequest) (*types.QueryDenomTracesResponse, error) {
 	if req == nil {
@@ -119,8 +236,6 @@ func (k Keeper) EscrowAddress(c context.Context, req *types.QueryEscrowAddressRe
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	addr := types.GetEscrowAddress(req.PortId, req.ChannelId)
-
 	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
 		return nil, err
 	}
@@ -133,6 +248,11 @@ func (k Keeper) EscrowAddress(c context.Context, req *types.QueryEscrowAddressRe
 		)
 	}
 
+	addr := types.GetEscrowAddress(req.PortId, req.ChannelId)
+	if strings.TrimSpace(req.Denom) != "" {
+		addr = k.GetEscrowAddressForDenom(ctx, req.PortId, req.ChannelId, req.Denom)
+	}
+
 	return &types.QueryEscrowAddressResponse{
 		EscrowAddress: addr.String(),
 	}, nil
@@ -156,3 +276,216 @@ func (k Keeper) TotalEscrowForDenom(c context.Context, req *types.QueryTotalEscr
 		Amount: amount,
 	}, nil
 }
+
+// TotalEscrow implements the TotalEscrow gRPC method. It returns the tracked total escrow along with the actual
+// summed balance of the channel escrow accounts, for the requested denomination or for all denominations if no
+// denomination is provided. Any difference between the two amounts indicates a drift of the tracked total escrow.
+func (k Keeper) TotalEscrow(c context.Context, req *types.QueryTotalEscrowRequest) (*types.QueryTotalEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.Denom != "" {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return &types.QueryTotalEscrowResponse{
+			TotalEscrows: []types.DenomTotalEscrow{
+				{
+					Tracked: k.GetTotalEscrowForDenom(ctx, req.Denom),
+					Actual:  k.GetActualEscrowForDenom(ctx, req.Denom),
+				},
+			},
+		}, nil
+	}
+
+	tracked := k.GetAllTotalEscrowed(ctx)
+	actual := k.GetAllActualEscrowed(ctx)
+
+	// the denominations of both coin sets are sorted, the union of the denominations is therefore sorted as well
+	totalEscrows := []types.DenomTotalEscrow{}
+	for _, denom := range tracked.Add(actual...).Denoms() {
+		totalEscrows = append(totalEscrows, types.DenomTotalEscrow{
+			Tracked: sdk.NewCoin(denom, tracked.AmountOf(denom)),
+			Actual:  sdk.NewCoin(denom, actual.AmountOf(denom)),
+		})
+	}
+
+	return &types.QueryTotalEscrowResponse{
+		TotalEscrows: totalEscrows,
+	}, nil
+}
+
+// EscrowDenoms implements the EscrowDenoms gRPC method. It returns the balances held by the escrow accounts of the
+// requested channel, or of all channels bound to the transfer port if no channel is provided. Unlike TotalEscrow,
+// the balances are read from the escrow accounts rather than from the tracked total escrow.
+func (k Keeper) EscrowDenoms(c context.Context, req *types.QueryEscrowDenomsRequest) (*types.QueryEscrowDenomsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if req.ChannelId != "" {
+		if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+			return nil, err
+		}
+
+		if !k.channelKeeper.HasChannel(ctx, req.PortId, req.ChannelId) {
+			return nil, status.Error(
+				codes.NotFound,
+				errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+			)
+		}
+
+		return &types.QueryEscrowDenomsResponse{
+			ChannelEscrows: []types.ChannelEscrow{
+				{
+					PortId:    req.PortId,
+					ChannelId: req.ChannelId,
+					Balances:  k.GetChannelEscrowBalances(ctx, req.PortId, req.ChannelId),
+				},
+			},
+		}, nil
+	}
+
+	portID := k.GetPort(ctx)
+
+	channels, pageRes, err := k.channelKeeper.GetPaginatedChannelsWithPort(ctx, portID, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	channelEscrows := make([]types.ChannelEscrow, 0, len(channels))
+	for _, channel := range channels {
+		channelEscrows = append(channelEscrows, types.ChannelEscrow{
+			PortId:    portID,
+			ChannelId: channel.ChannelId,
+			Balances:  k.GetChannelEscrowBalances(ctx, portID, channel.ChannelId),
+		})
+	}
+
+	return &types.QueryEscrowDenomsResponse{
+		ChannelEscrows: channelEscrows,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// PreviewDenom implements the PreviewDenom gRPC method. It applies the same denomination prefixing logic as a
+// transfer of the given denomination over the given port and channel, returning the denomination of the tokens
+// which are received on the counterparty chain.
+func (k Keeper) PreviewDenom(c context.Context, req *types.QueryPreviewDenomRequest) (*types.QueryPreviewDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateIBCDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	channel, found := k.channelKeeper.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	// the full denomination path is sent in the packet data, see sendTransfer
+	fullDenomPath := req.Denom
+	if strings.HasPrefix(req.Denom, types.DenomPrefix+"/") {
+		var err error
+		fullDenomPath, err = k.DenomPathFromHash(ctx, req.Denom)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+	}
+
+	denomTrace := types.GetReceivedDenomTrace(req.PortId, req.ChannelId, channel.Counterparty.PortId, channel.Counterparty.ChannelId, fullDenomPath)
+
+	return &types.QueryPreviewDenomResponse{
+		FullDenom: denomTrace.GetFullDenomPath(),
+		IbcDenom:  denomTrace.IBCDenom(),
+	}, nil
+}
+
+// TransferQuotas implements the TransferQuotas gRPC method.
+func (k Keeper) TransferQuotas(c context.Context, req *types.QueryTransferQuotasRequest) (*types.QueryTransferQuotasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var quotas []types.TransferQuota
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s/", types.KeyTransferQuotaPrefix)))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var quota types.TransferQuota
+		if err := k.cdc.Unmarshal(value, &quota); err != nil {
+			return err
+		}
+
+		quotas = append(quotas, quota)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTransferQuotasResponse{
+		TransferQuotas: quotas,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// TransferFee implements the TransferFee gRPC method.
+func (k Keeper) TransferFee(c context.Context, req *types.QueryTransferFeeRequest) (*types.QueryTransferFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTransferFeeResponse{
+		TransferFee: k.GetParams(ctx).TransferFee,
+	}, nil
+}
+
+// ReceiverPrefixes implements the ReceiverPrefixes gRPC method.
+func (k Keeper) ReceiverPrefixes(c context.Context, req *types.QueryReceiverPrefixesRequest) (*types.QueryReceiverPrefixesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var receiverPrefixes []types.ReceiverPrefix
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s/", types.KeyReceiverPrefixPrefix)))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var receiverPrefix types.ReceiverPrefix
+		if err := k.cdc.Unmarshal(value, &receiverPrefix); err != nil {
+			return err
+		}
+
+		receiverPrefixes = append(receiverPrefixes, receiverPrefix)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryReceiverPrefixesResponse{
+		ReceiverPrefixes: receiverPrefixes,
+		Pagination:       pageRes,
+	}, nil
+}
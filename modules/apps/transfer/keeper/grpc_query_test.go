@@ -2,6 +2,10 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -92,6 +96,236 @@ func (suite *KeeperTestSuite) TestQueryDenomTrace() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryDenomResolved() {
+	var (
+		req      *types.QueryDenomResolvedRequest
+		path     *ibctesting.Path
+		expTrace types.DenomTrace
+		expHops  []types.ResolvedHop
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: two hop denom with the first hop resolved",
+			func() {},
+			nil,
+		},
+		{
+			"success: correct hex hash",
+			func() {
+				req.Hash = expTrace.Hash().String()
+			},
+			nil,
+		},
+		{
+			"success: first hop channel not found",
+			func() {
+				expTrace.Path = "transfer/channel-100/transfer/channel-7"
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), expTrace)
+
+				req.Hash = expTrace.IBCDenom()
+				expHops = []types.ResolvedHop{
+					{PortId: ibctesting.TransferPort, ChannelId: "channel-100"},
+					{PortId: ibctesting.TransferPort, ChannelId: "channel-7"},
+				}
+			},
+			nil,
+		},
+		{
+			"failure: invalid hash",
+			func() {
+				req.Hash = "!@#!@#!"
+			},
+			status.Error(codes.InvalidArgument, ""),
+		},
+		{
+			"failure: not found denom trace",
+			func() {
+				expTrace.Path = "transfer/channel-100/transfer/channel-7"
+				req.Hash = expTrace.IBCDenom()
+			},
+			status.Error(codes.NotFound, ""),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			expTrace = types.DenomTrace{
+				Path:      fmt.Sprintf("%s/%s/%s/%s", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.TransferPort, "channel-7"),
+				BaseDenom: "uatom",
+			}
+			suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), expTrace)
+
+			expHops = []types.ResolvedHop{
+				{
+					PortId:              path.EndpointA.ChannelConfig.PortID,
+					ChannelId:           path.EndpointA.ChannelID,
+					Resolved:            true,
+					ClientId:            path.EndpointA.ClientID,
+					CounterpartyChainId: suite.chainB.ChainID,
+				},
+				{
+					PortId:    ibctesting.TransferPort,
+					ChannelId: "channel-7",
+				},
+			}
+
+			req = &types.QueryDenomResolvedRequest{
+				Hash: expTrace.IBCDenom(),
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.DenomResolved(suite.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(&expTrace, res.DenomTrace)
+				suite.Require().Equal(expHops, res.Hops)
+			} else {
+				suite.Require().Equal(status.Code(tc.expErr), status.Code(err))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomOrigin() {
+	var (
+		req          *types.QueryDenomOriginRequest
+		path         *ibctesting.Path
+		expTrace     *types.DenomTrace
+		expOriginHop *types.ResolvedHop
+	)
+
+	testCases := []struct {
+		msg          string
+		malleate     func()
+		expBaseDenom string
+		expErr       error
+	}{
+		{
+			"success: native denom",
+			func() {
+				req.Denom = sdk.DefaultBondDenom
+				expTrace = nil
+				expOriginHop = nil
+			},
+			sdk.DefaultBondDenom,
+			nil,
+		},
+		{
+			"success: single hop denom with the origin hop resolved",
+			func() {
+				expTrace.Path = fmt.Sprintf("%s/%s", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), *expTrace)
+
+				req.Denom = expTrace.IBCDenom()
+				expOriginHop = &types.ResolvedHop{
+					PortId:              path.EndpointA.ChannelConfig.PortID,
+					ChannelId:           path.EndpointA.ChannelID,
+					Resolved:            true,
+					ClientId:            path.EndpointA.ClientID,
+					CounterpartyChainId: suite.chainB.ChainID,
+				}
+			},
+			"uatom",
+			nil,
+		},
+		{
+			"success: multi hop denom",
+			func() {},
+			"uatom",
+			nil,
+		},
+		{
+			"success: single hop denom with channel not found",
+			func() {
+				expTrace.Path = "transfer/channel-100"
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), *expTrace)
+
+				req.Denom = expTrace.IBCDenom()
+				expOriginHop = &types.ResolvedHop{PortId: ibctesting.TransferPort, ChannelId: "channel-100"}
+			},
+			"uatom",
+			nil,
+		},
+		{
+			"failure: invalid native denom",
+			func() {
+				req.Denom = "!@#!@#!"
+			},
+			"",
+			status.Error(codes.InvalidArgument, ""),
+		},
+		{
+			"failure: invalid hash",
+			func() {
+				req.Denom = "ibc/!@#!@#!"
+			},
+			"",
+			status.Error(codes.InvalidArgument, ""),
+		},
+		{
+			"failure: not found denom trace",
+			func() {
+				expTrace.Path = "transfer/channel-100/transfer/channel-7"
+				req.Denom = expTrace.IBCDenom()
+			},
+			"",
+			status.Error(codes.NotFound, ""),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			expTrace = &types.DenomTrace{
+				Path:      fmt.Sprintf("%s/%s/%s/%s/%s/%s", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.TransferPort, "channel-5", ibctesting.TransferPort, "channel-7"),
+				BaseDenom: "uatom",
+			}
+			suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), *expTrace)
+
+			// the origin hop of a multi hop denom does not terminate on this chain and is not resolved
+			expOriginHop = &types.ResolvedHop{PortId: ibctesting.TransferPort, ChannelId: "channel-7"}
+
+			req = &types.QueryDenomOriginRequest{
+				Denom: expTrace.IBCDenom(),
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.DenomOrigin(suite.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expBaseDenom, res.BaseDenom)
+				suite.Require().Equal(expTrace == nil, res.Native)
+				suite.Require().Equal(expTrace, res.DenomTrace)
+				suite.Require().Equal(expOriginHop, res.OriginHop)
+			} else {
+				suite.Require().Equal(status.Code(tc.expErr), status.Code(err))
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryDenomTraces() {
 	var (
 		req       *types.QueryDenomTracesRequest
@@ -228,7 +462,10 @@ func (suite *KeeperTestSuite) TestQueryDenomHash() {
 }
 
 func (suite *KeeperTestSuite) TestEscrowAddress() {
-	var req *types.QueryEscrowAddressRequest
+	var (
+		req         *types.QueryEscrowAddressRequest
+		escrowClass string
+	)
 
 	testCases := []struct {
 		msg      string
@@ -245,6 +482,37 @@ func (suite *KeeperTestSuite) TestEscrowAddress() {
 			},
 			true,
 		},
+		{
+			"success - denomination of escrow class",
+			func() {
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.EscrowClasses = []types.EscrowClass{{Name: "stable", DenomPatterns: []string{"ibc/*"}}}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    ibctesting.TransferPort,
+					ChannelId: ibctesting.FirstChannelID,
+					Denom:     "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+				}
+				escrowClass = "stable"
+			},
+			true,
+		},
+		{
+			"success - denomination without escrow class",
+			func() {
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.EscrowClasses = []types.EscrowClass{{Name: "stable", DenomPatterns: []string{"ibc/*"}}}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    ibctesting.TransferPort,
+					ChannelId: ibctesting.FirstChannelID,
+					Denom:     sdk.DefaultBondDenom,
+				}
+			},
+			true,
+		},
 		{
 			"failure - channel not found",
 			func() {
@@ -284,6 +552,8 @@ func (suite *KeeperTestSuite) TestEscrowAddress() {
 			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
 			path.Setup()
 
+			escrowClass = ""
+
 			tc.malleate()
 			ctx := suite.chainA.GetContext()
 
@@ -291,7 +561,7 @@ func (suite *KeeperTestSuite) TestEscrowAddress() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
-				expected := types.GetEscrowAddress(ibctesting.TransferPort, ibctesting.FirstChannelID).String()
+				expected := types.GetEscrowAddressForClass(ibctesting.TransferPort, ibctesting.FirstChannelID, escrowClass).String()
 				suite.Require().Equal(expected, res.EscrowAddress)
 			} else {
 				suite.Require().Error(err)
@@ -396,3 +666,652 @@ func (suite *KeeperTestSuite) TestTotalEscrowForDenom() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestTotalEscrow() {
+	var (
+		path            *ibctesting.Path
+		req             *types.QueryTotalEscrowRequest
+		expTotalEscrows []types.DenomTotalEscrow
+	)
+
+	amount := sdkmath.NewInt(100)
+	otherDenom := "atom"
+
+	// sendTransfer escrows the given amount of the bond denomination on chainA, updating both the tracked total
+	// escrow and the balance of the channel escrow account
+	sendTransfer := func() {
+		msg := types.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+			sdk.NewCoin(sdk.DefaultBondDenom, amount),
+			suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+			suite.chainB.GetTimeoutHeight(), 0, "",
+		)
+
+		_, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: denom with matching tracked and actual escrow",
+			func() {
+				sendTransfer()
+
+				req.Denom = sdk.DefaultBondDenom
+				expTotalEscrows = []types.DenomTotalEscrow{
+					{Tracked: sdk.NewCoin(sdk.DefaultBondDenom, amount), Actual: sdk.NewCoin(sdk.DefaultBondDenom, amount)},
+				}
+			},
+			nil,
+		},
+		{
+			"success: denom without escrow",
+			func() {
+				req.Denom = sdk.DefaultBondDenom
+				expTotalEscrows = []types.DenomTotalEscrow{
+					{Tracked: sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.ZeroInt()), Actual: sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.ZeroInt())},
+				}
+			},
+			nil,
+		},
+		{
+			"success: denom with drift between tracked and actual escrow",
+			func() {
+				sendTransfer()
+
+				// send tokens directly to the escrow account bypassing the tracked total escrow
+				escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoins(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), escrowAddress, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount)))
+				suite.Require().NoError(err)
+
+				req.Denom = sdk.DefaultBondDenom
+				expTotalEscrows = []types.DenomTotalEscrow{
+					{Tracked: sdk.NewCoin(sdk.DefaultBondDenom, amount), Actual: sdk.NewCoin(sdk.DefaultBondDenom, amount.MulRaw(2))},
+				}
+			},
+			nil,
+		},
+		{
+			"success: all denoms with matching tracked and actual escrow",
+			func() {
+				sendTransfer()
+
+				expTotalEscrows = []types.DenomTotalEscrow{
+					{Tracked: sdk.NewCoin(sdk.DefaultBondDenom, amount), Actual: sdk.NewCoin(sdk.DefaultBondDenom, amount)},
+				}
+			},
+			nil,
+		},
+		{
+			"success: all denoms without escrow",
+			func() {
+				expTotalEscrows = []types.DenomTotalEscrow{}
+			},
+			nil,
+		},
+		{
+			"success: all denoms with drift between tracked and actual escrow",
+			func() {
+				sendTransfer()
+
+				// track a total escrow which is not held by any escrow account
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(otherDenom, amount))
+
+				expTotalEscrows = []types.DenomTotalEscrow{
+					{Tracked: sdk.NewCoin(otherDenom, amount), Actual: sdk.NewCoin(otherDenom, sdkmath.ZeroInt())},
+					{Tracked: sdk.NewCoin(sdk.DefaultBondDenom, amount), Actual: sdk.NewCoin(sdk.DefaultBondDenom, amount)},
+				}
+			},
+			nil,
+		},
+		{
+			"failure: invalid denom",
+			func() {
+				req.Denom = "??𓃠🐾??"
+			},
+			status.Error(codes.InvalidArgument, "invalid denom: ??𓃠🐾??"),
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			req = &types.QueryTotalEscrowRequest{}
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.TotalEscrow(ctx, req)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.TotalEscrows, len(expTotalEscrows))
+				for i, expTotalEscrow := range expTotalEscrows {
+					suite.Require().Equal(expTotalEscrow.Tracked.String(), res.TotalEscrows[i].Tracked.String())
+					suite.Require().Equal(expTotalEscrow.Actual.String(), res.TotalEscrows[i].Actual.String())
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEscrowDenoms() {
+	var (
+		path              *ibctesting.Path
+		req               *types.QueryEscrowDenomsRequest
+		expChannelEscrows []types.ChannelEscrow
+		expNextKey        []byte
+	)
+
+	amount := sdkmath.NewInt(100)
+	otherDenom := "atom"
+
+	// escrow sends the bond denomination and mints another denomination to the escrow account of the channel on chainA
+	escrow := func(channelID string) sdk.Coins {
+		msg := types.NewMsgTransfer(
+			ibctesting.TransferPort, channelID,
+			sdk.NewCoin(sdk.DefaultBondDenom, amount),
+			suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+			suite.chainB.GetTimeoutHeight(), 0, "",
+		)
+
+		_, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err)
+
+		coins := sdk.NewCoins(sdk.NewCoin(otherDenom, amount))
+		escrowAddress := types.GetEscrowAddress(ibctesting.TransferPort, channelID)
+		err = suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), types.ModuleName, coins)
+		suite.Require().NoError(err)
+		err = suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), types.ModuleName, escrowAddress, coins)
+		suite.Require().NoError(err)
+
+		return coins.Add(sdk.NewCoin(sdk.DefaultBondDenom, amount))
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: channel with multiple denoms in escrow",
+			func() {
+				balances := escrow(path.EndpointA.ChannelID)
+
+				req.PortId = path.EndpointA.ChannelConfig.PortID
+				req.ChannelId = path.EndpointA.ChannelID
+				expChannelEscrows = []types.ChannelEscrow{
+					{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID, Balances: balances},
+				}
+			},
+			nil,
+		},
+		{
+			"success: channel without escrow",
+			func() {
+				req.PortId = path.EndpointA.ChannelConfig.PortID
+				req.ChannelId = path.EndpointA.ChannelID
+				expChannelEscrows = []types.ChannelEscrow{
+					{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID, Balances: sdk.NewCoins()},
+				}
+			},
+			nil,
+		},
+		{
+			"success: all channels",
+			func() {
+				otherPath := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+				otherPath.Setup()
+
+				balances := escrow(path.EndpointA.ChannelID)
+				expChannelEscrows = []types.ChannelEscrow{
+					{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID, Balances: balances},
+					{PortId: otherPath.EndpointA.ChannelConfig.PortID, ChannelId: otherPath.EndpointA.ChannelID, Balances: sdk.NewCoins()},
+				}
+			},
+			nil,
+		},
+		{
+			"success: all channels with limit",
+			func() {
+				otherPath := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+				otherPath.Setup()
+
+				balances := escrow(path.EndpointA.ChannelID)
+
+				req.Pagination = &query.PageRequest{Limit: 1}
+				expChannelEscrows = []types.ChannelEscrow{
+					{PortId: path.EndpointA.ChannelConfig.PortID, ChannelId: path.EndpointA.ChannelID, Balances: balances},
+				}
+				expNextKey = []byte(otherPath.EndpointA.ChannelID)
+			},
+			nil,
+		},
+		{
+			"success: all channels with key",
+			func() {
+				otherPath := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+				otherPath.Setup()
+
+				balances := escrow(otherPath.EndpointA.ChannelID)
+
+				req.Pagination = &query.PageRequest{Key: []byte(otherPath.EndpointA.ChannelID)}
+				expChannelEscrows = []types.ChannelEscrow{
+					{PortId: otherPath.EndpointA.ChannelConfig.PortID, ChannelId: otherPath.EndpointA.ChannelID, Balances: balances},
+				}
+			},
+			nil,
+		},
+		{
+			"failure: both key and offset provided",
+			func() {
+				req.Pagination = &query.PageRequest{Key: []byte(path.EndpointA.ChannelID), Offset: 1}
+			},
+			status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both"),
+		},
+		{
+			"failure: invalid port ID",
+			func() {
+				req.PortId = ""
+				req.ChannelId = path.EndpointA.ChannelID
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"failure: channel not found",
+			func() {
+				req.PortId = path.EndpointA.ChannelConfig.PortID
+				req.ChannelId = ibctesting.InvalidID
+			},
+			status.Error(codes.NotFound, fmt.Sprintf("port ID (%s) channel ID (%s): channel not found", ibctesting.TransferPort, ibctesting.InvalidID)),
+		},
+		{
+			"failure: empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			req = &types.QueryEscrowDenomsRequest{}
+			expNextKey = nil
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.EscrowDenoms(ctx, req)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.ChannelEscrows, len(expChannelEscrows))
+				for i, expChannelEscrow := range expChannelEscrows {
+					suite.Require().Equal(expChannelEscrow.PortId, res.ChannelEscrows[i].PortId)
+					suite.Require().Equal(expChannelEscrow.ChannelId, res.ChannelEscrows[i].ChannelId)
+					suite.Require().Equal(expChannelEscrow.Balances.String(), res.ChannelEscrows[i].Balances.String())
+				}
+
+				if res.Pagination != nil {
+					suite.Require().Equal(expNextKey, res.Pagination.NextKey)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPreviewDenom() {
+	var (
+		path     *ibctesting.Path
+		req      *types.QueryPreviewDenomRequest
+		expTrace types.DenomTrace
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: native denom",
+			func() {
+				expTrace = types.DenomTrace{
+					Path:      fmt.Sprintf("%s/%s", path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID),
+					BaseDenom: sdk.DefaultBondDenom,
+				}
+			},
+			nil,
+		},
+		{
+			"success: voucher forwarded to another chain",
+			func() {
+				denomTrace := types.DenomTrace{
+					Path:      "transfer/channel-5",
+					BaseDenom: "uatom",
+				}
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+
+				req.Denom = denomTrace.IBCDenom()
+				expTrace = types.DenomTrace{
+					Path:      fmt.Sprintf("%s/%s/%s", path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, denomTrace.Path),
+					BaseDenom: "uatom",
+				}
+			},
+			nil,
+		},
+		{
+			"success: voucher unwound to its origin chain",
+			func() {
+				denomTrace := types.DenomTrace{
+					Path:      fmt.Sprintf("%s/%s", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID),
+					BaseDenom: sdk.DefaultBondDenom,
+				}
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+
+				req.Denom = denomTrace.IBCDenom()
+				expTrace = types.DenomTrace{
+					BaseDenom: sdk.DefaultBondDenom,
+				}
+			},
+			nil,
+		},
+		{
+			"success: multi-hop voucher unwound by one hop",
+			func() {
+				denomTrace := types.DenomTrace{
+					Path:      fmt.Sprintf("%s/%s/transfer/channel-7", path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID),
+					BaseDenom: "uatom",
+				}
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+
+				req.Denom = denomTrace.IBCDenom()
+				expTrace = types.DenomTrace{
+					Path:      "transfer/channel-7",
+					BaseDenom: "uatom",
+				}
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				req.ChannelId = ""
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = "channel-100"
+			},
+			status.Error(codes.NotFound, fmt.Sprintf("port ID (%s) channel ID (channel-100): channel not found", ibctesting.TransferPort)),
+		},
+		{
+			"invalid denom",
+			func() {
+				req.Denom = "ibc/123"
+			},
+			status.Error(codes.InvalidArgument, "invalid denom trace hash 123: encoding/hex: odd length hex string"),
+		},
+		{
+			"denom trace not found",
+			func() {
+				req.Denom = types.DenomTrace{Path: "transfer/channel-5", BaseDenom: "uatom"}.IBCDenom()
+			},
+			status.Error(codes.NotFound, fmt.Sprintf("%s: denomination trace not found", types.DenomTrace{Path: "transfer/channel-5", BaseDenom: "uatom"}.Hash())),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			req = &types.QueryPreviewDenomRequest{
+				PortId:    path.EndpointA.ChannelConfig.PortID,
+				ChannelId: path.EndpointA.ChannelID,
+				Denom:     sdk.DefaultBondDenom,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.PreviewDenom(suite.chainA.GetContext(), req)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expTrace.GetFullDenomPath(), res.FullDenom)
+				suite.Require().Equal(expTrace.IBCDenom(), res.IbcDenom)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryTransferQuotas() {
+	var (
+		req       *types.QueryTransferQuotasRequest
+		expQuotas []types.TransferQuota
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"success: no quotas",
+			func() {
+				req = &types.QueryTransferQuotasRequest{}
+				expQuotas = nil
+			},
+			true,
+		},
+		{
+			"success: quotas with usage",
+			func() {
+				ctx := suite.chainA.GetContext()
+
+				quota := types.NewTransferQuota(ibctesting.FirstChannelID, sdk.DefaultBondDenom, sdkmath.NewInt(100), time.Hour, ctx.BlockTime())
+				quota.NetOutflow = sdkmath.NewInt(40)
+				suite.chainA.GetSimApp().TransferKeeper.SetQuota(ctx, quota)
+
+				ibcQuota := types.NewTransferQuota(ibctesting.FirstChannelID, "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", sdkmath.NewInt(10), types.DefaultQuotaEpochDuration, ctx.BlockTime())
+				suite.chainA.GetSimApp().TransferKeeper.SetQuota(ctx, ibcQuota)
+
+				req = &types.QueryTransferQuotasRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+				// quotas are ordered by channel and denomination
+				expQuotas = []types.TransferQuota{ibcQuota, quota}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.TransferQuotas(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Len(res.TransferQuotas, len(expQuotas))
+				for i, quota := range expQuotas {
+					suite.Require().Equal(quota.ChannelId, res.TransferQuotas[i].ChannelId)
+					suite.Require().Equal(quota.Denom, res.TransferQuotas[i].Denom)
+					suite.Require().Equal(quota.MaxOutflow, res.TransferQuotas[i].MaxOutflow)
+					suite.Require().Equal(quota.NetOutflow, res.TransferQuotas[i].NetOutflow)
+					suite.Require().Equal(quota.EpochDuration, res.TransferQuotas[i].EpochDuration)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryReceiverPrefixes() {
+	var (
+		req                 *types.QueryReceiverPrefixesRequest
+		expReceiverPrefixes []types.ReceiverPrefix
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"success: no receiver prefixes",
+			func() {
+				req = &types.QueryReceiverPrefixesRequest{}
+				expReceiverPrefixes = nil
+			},
+			true,
+		},
+		{
+			"success: multiple receiver prefixes",
+			func() {
+				ctx := suite.chainA.GetContext()
+
+				receiverPrefix := types.NewReceiverPrefix(ibctesting.FirstChannelID, "osmo")
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiverPrefix(ctx, receiverPrefix)
+
+				otherPrefix := types.NewReceiverPrefix("channel-1", "juno")
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiverPrefix(ctx, otherPrefix)
+
+				req = &types.QueryReceiverPrefixesRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+				// receiver prefixes are ordered by channel
+				expReceiverPrefixes = []types.ReceiverPrefix{receiverPrefix, otherPrefix}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.ReceiverPrefixes(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expReceiverPrefixes, res.ReceiverPrefixes)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryTransferFee() {
+	var expTransferFee *types.TransferFee
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+	}{
+		{
+			"success: no transfer fee",
+			func() {
+				expTransferFee = nil
+			},
+		},
+		{
+			"success: transfer fee",
+			func() {
+				expTransferFee = &types.TransferFee{FlatAmount: sdkmath.NewInt(10), Collector: "fee_collector"}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			params := suite.chainA.GetSimApp().TransferKeeper.GetParams(ctx)
+			params.TransferFee = expTransferFee
+			suite.chainA.GetSimApp().TransferKeeper.SetParams(ctx, params)
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.TransferFee(ctx, &types.QueryTransferFeeRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(expTransferFee, res.TransferFee)
+		})
+	}
+}
@@ -25,18 +25,8 @@ func AllInvariants(k *Keeper) sdk.Invariant {
 // each denom is not smaller than the amount stored in the state entry.
 func TotalEscrowPerDenomInvariants(k *Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		var actualTotalEscrowed sdk.Coins
-
 		expectedTotalEscrowed := k.GetAllTotalEscrowed(ctx)
-
-		portID := k.GetPort(ctx)
-		transferChannels := k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID)
-		for _, channel := range transferChannels {
-			escrowAddress := types.GetEscrowAddress(portID, channel.ChannelId)
-			escrowBalances := k.bankKeeper.GetAllBalances(ctx, escrowAddress)
-
-			actualTotalEscrowed = actualTotalEscrowed.Add(escrowBalances...)
-		}
+		actualTotalEscrowed := k.GetAllActualEscrowed(ctx)
 
 		// the actual escrowed amount must be greater than or equal to the expected amount for all denominations
 		if !actualTotalEscrowed.IsAllGTE(expectedTotalEscrowed) {
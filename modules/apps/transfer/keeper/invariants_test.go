@@ -11,6 +11,8 @@ import (
 )
 
 func (suite *KeeperTestSuite) TestTotalEscrowPerDenomInvariant() {
+	var path *ibctesting.Path
+
 	testCases := []struct {
 		name     string
 		malleate func()
@@ -21,6 +23,25 @@ func (suite *KeeperTestSuite) TestTotalEscrowPerDenomInvariant() {
 			func() {},
 			true,
 		},
+		{
+			"success: tokens held by the recorded escrow account of a removed escrow class",
+			func() {
+				// escrow the coin in the escrow address of an escrow class which is removed afterwards
+				transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+				params := transferKeeper.GetParams(suite.chainA.GetContext())
+				params.EscrowClasses = []types.EscrowClass{{Name: "stable", DenomPatterns: []string{sdk.DefaultBondDenom}}}
+				transferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+				msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.GetTimeoutHeight(), 0, "")
+				_, err := suite.chainA.SendMsgs(msg)
+				suite.Require().NoError(err)
+
+				params.EscrowClasses = nil
+				transferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			true,
+		},
 		{
 			"fails with broken invariant",
 			func() {
@@ -37,7 +58,7 @@ func (suite *KeeperTestSuite) TestTotalEscrowPerDenomInvariant() {
 
 		suite.Run(tc.name, func() {
 			suite.SetupTest() // reset
-			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
 			path.Setup()
 
 			amount := sdkmath.NewInt(100)
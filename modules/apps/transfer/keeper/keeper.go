@@ -3,8 +3,10 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
@@ -18,6 +20,7 @@ import (
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -39,6 +42,30 @@ type Keeper struct {
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
+
+	// refundToSourceSender determines whether refunds are sent to the source sender
+	// provided in the packet memo instead of the packet sender. Disabled by default.
+	refundToSourceSender bool
+
+	// receiverResolver resolves the receiver of received packets. Defaults to
+	// types.Bech32ReceiverResolver.
+	receiverResolver types.ReceiverResolver
+
+	// ackErrorFormatter formats the error message of the error acknowledgements written
+	// when receiving a packet fails. Defaults to types.DefaultAckErrorFormatter.
+	ackErrorFormatter types.AckErrorFormatter
+
+	// memoNamespaces holds the memo namespaces registered by the integrator. Memos of
+	// sent transfers are only checked against the registry once a namespace is registered.
+	memoNamespaces map[string]bool
+
+	// maxUnwindDepth is the maximum number of consecutive unwind hops requested in the memo of
+	// received packets. Unwinding is disabled if zero, which is the default.
+	maxUnwindDepth uint32
+
+	// trustedMesh holds the channels of the chains participating in a trusted mesh, the denomination
+	// traces of tokens sent over the channels of this chain within the mesh are compacted. Empty by default.
+	trustedMesh types.TrustedMesh
 }
 
 // NewKeeper creates a new IBC transfer Keeper instance
@@ -64,16 +91,18 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:            cdc,
-		storeKey:       key,
-		legacySubspace: legacySubspace,
-		ics4Wrapper:    ics4Wrapper,
-		channelKeeper:  channelKeeper,
-		portKeeper:     portKeeper,
-		authKeeper:     authKeeper,
-		bankKeeper:     bankKeeper,
-		scopedKeeper:   scopedKeeper,
-		authority:      authority,
+		cdc:               cdc,
+		storeKey:          key,
+		legacySubspace:    legacySubspace,
+		ics4Wrapper:       ics4Wrapper,
+		channelKeeper:     channelKeeper,
+		portKeeper:        portKeeper,
+		authKeeper:        authKeeper,
+		bankKeeper:        bankKeeper,
+		scopedKeeper:      scopedKeeper,
+		authority:         authority,
+		receiverResolver:  types.Bech32ReceiverResolver{},
+		ackErrorFormatter: types.DefaultAckErrorFormatter{},
 	}
 }
 
@@ -84,6 +113,54 @@ func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = wrapper
 }
 
+// WithRefundToSourceSender enables or disables sending refunds to the source sender
+// provided in the memo of the packet data (see types.SourceSenderMemoKey) instead of
+// the packet sender. The refund is only redirected if the source sender is a valid
+// address on this chain which is allowed to receive funds.
+func (k *Keeper) WithRefundToSourceSender(enabled bool) {
+	k.refundToSourceSender = enabled
+}
+
+// WithReceiverResolver sets the ReceiverResolver used to resolve the receiver of
+// received packets, for example to map address aliases onto a single account.
+// Passing nil restores the default types.Bech32ReceiverResolver. The resolver must be
+// set before the keeper is passed to the transfer IBC module:
+//
+//	app.TransferKeeper.WithReceiverResolver(aliasRegistry)
+//	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+func (k *Keeper) WithReceiverResolver(resolver types.ReceiverResolver) {
+	if resolver == nil {
+		resolver = types.Bech32ReceiverResolver{}
+	}
+
+	k.receiverResolver = resolver
+}
+
+// ResolveReceiver resolves the receiver provided in the packet data of a received
+// packet using the ReceiverResolver of the keeper.
+func (k Keeper) ResolveReceiver(ctx sdk.Context, packetReceiver string) (sdk.AccAddress, error) {
+	return k.receiverResolver.Resolve(ctx, packetReceiver)
+}
+
+// WithAckErrorFormatter sets the AckErrorFormatter used to format the error message of
+// the error acknowledgements written when receiving a packet fails. Passing nil restores
+// the default types.DefaultAckErrorFormatter. The formatter must be set before the keeper
+// is passed to the transfer IBC module.
+func (k *Keeper) WithAckErrorFormatter(formatter types.AckErrorFormatter) {
+	if formatter == nil {
+		formatter = types.DefaultAckErrorFormatter{}
+	}
+
+	k.ackErrorFormatter = formatter
+}
+
+// NewErrorAcknowledgement returns the error acknowledgement of a received packet which
+// failed with the provided error, holding the error message formatted by the
+// AckErrorFormatter of the keeper.
+func (k Keeper) NewErrorAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, err error) channeltypes.Acknowledgement {
+	return types.NewFormattedErrorAcknowledgement(err, k.ackErrorFormatter.FormatAckError(ctx, packet, err))
+}
+
 // GetICS4Wrapper returns the ICS4Wrapper.
 func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k.ics4Wrapper
@@ -209,7 +286,7 @@ func (k Keeper) setDenomMetadata(ctx sdk.Context, denomTrace types.DenomTrace) {
 		// Base as key path and the IBC hash is what gives this token uniqueness
 		// on the executing chain
 		Base:    denomTrace.IBCDenom(),
-		Display: denomTrace.GetFullDenomPath(),
+		Display: denomTrace.BaseDenom,
 		Name:    fmt.Sprintf("%s IBC token", denomTrace.GetFullDenomPath()),
 		Symbol:  strings.ToUpper(denomTrace.BaseDenom),
 	}
@@ -266,6 +343,102 @@ func (k Keeper) GetAllTotalEscrowed(ctx sdk.Context) sdk.Coins {
 	return escrows
 }
 
+// GetActualEscrowForDenom returns the summed balance of the given denomination held by the escrow accounts of
+// all transfer channels, including all recorded escrow accounts.
+func (k Keeper) GetActualEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin {
+	actual := sdk.NewCoin(denom, sdkmath.ZeroInt())
+	for _, escrowAddress := range k.getAllEscrowAddresses(ctx) {
+		actual = actual.Add(k.bankKeeper.GetBalance(ctx, escrowAddress, denom))
+	}
+
+	return actual
+}
+
+// GetAllActualEscrowed returns the summed balances held by the escrow accounts of all transfer channels,
+// including all recorded escrow accounts.
+func (k Keeper) GetAllActualEscrowed(ctx sdk.Context) sdk.Coins {
+	var actual sdk.Coins
+	for _, escrowAddress := range k.getAllEscrowAddresses(ctx) {
+		actual = actual.Add(k.bankKeeper.GetAllBalances(ctx, escrowAddress)...)
+	}
+
+	return actual
+}
+
+// GetChannelEscrowBalances returns the summed balances held by the escrow accounts of the provided channel,
+// including all recorded escrow accounts of the channel.
+func (k Keeper) GetChannelEscrowBalances(ctx sdk.Context, portID, channelID string) sdk.Coins {
+	escrowAddresses := newEscrowAddressSet()
+	escrowAddresses.add(types.GetEscrowAddress(portID, channelID))
+	k.IterateEscrowAccounts(ctx, func(escrowAccount types.EscrowAccount) bool {
+		if escrowAccount.PortId == portID && escrowAccount.ChannelId == channelID {
+			escrowAddresses.add(sdk.MustAccAddressFromBech32(escrowAccount.Address))
+		}
+		return false
+	})
+
+	balances := sdk.NewCoins()
+	for _, escrowAddress := range escrowAddresses.addresses {
+		balances = balances.Add(k.bankKeeper.GetAllBalances(ctx, escrowAddress)...)
+	}
+
+	return balances
+}
+
+// getAllEscrowAddresses returns the default escrow addresses of all channels bound to the transfer port along with
+// the addresses of all recorded escrow accounts, each address being returned once.
+func (k Keeper) getAllEscrowAddresses(ctx sdk.Context) []sdk.AccAddress {
+	portID := k.GetPort(ctx)
+
+	escrowAddresses := newEscrowAddressSet()
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
+		escrowAddresses.add(types.GetEscrowAddress(portID, channel.ChannelId))
+	}
+
+	k.IterateEscrowAccounts(ctx, func(escrowAccount types.EscrowAccount) bool {
+		escrowAddresses.add(sdk.MustAccAddressFromBech32(escrowAccount.Address))
+		return false
+	})
+
+	return escrowAddresses.addresses
+}
+
+// escrowAddressSet is an insertion ordered set of escrow addresses.
+type escrowAddressSet struct {
+	seen      map[string]bool
+	addresses []sdk.AccAddress
+}
+
+func newEscrowAddressSet() *escrowAddressSet {
+	return &escrowAddressSet{seen: make(map[string]bool)}
+}
+
+// add adds the provided escrow address to the set if it is not part of it yet.
+func (s *escrowAddressSet) add(escrowAddress sdk.AccAddress) {
+	if s.seen[escrowAddress.String()] {
+		return
+	}
+
+	s.seen[escrowAddress.String()] = true
+	s.addresses = append(s.addresses, escrowAddress)
+}
+
+// migrateEscrowBalances moves the balances held by the recorded escrow accounts of all channels to the escrow
+// addresses of their denominations under the provided params. It is called when escrowing per denomination is
+// enabled or disabled, such that tokens are never held by the escrow addresses of both modes at once. The total
+// escrow of each denomination is unchanged.
+func (k Keeper) migrateEscrowBalances(ctx sdk.Context, newParams types.Params) error {
+	for _, escrowAccount := range k.GetAllEscrowAccounts(ctx) {
+		newEscrowAddress := newParams.EscrowAddressForDenom(escrowAccount.PortId, escrowAccount.ChannelId, escrowAccount.Denom)
+		if err := k.moveEscrowAccount(ctx, escrowAccount.PortId, escrowAccount.ChannelId, escrowAccount.Denom, newEscrowAddress); err != nil {
+			return err
+		}
+	}
+
+	k.Logger(ctx).Info("successfully migrated escrowed balances", "escrow per denom", newParams.EscrowPerDenom)
+	return nil
+}
+
 // IterateTokensInEscrow iterates over the denomination escrows in the store
 // and performs a callback function. Denominations for which an invalid value
 // (i.e. not integer) is stored, will be skipped.
@@ -292,6 +465,48 @@ func (k Keeper) IterateTokensInEscrow(ctx sdk.Context, storeprefix []byte, cb fu
 	}
 }
 
+// SplitCoin splits the provided coin into the given number of parts. Each part receives the integer quotient of
+// the coin amount and the number of parts. The remainder is assigned deterministically by adding a single unit to
+// each of the first parts, so that the parts always sum to the provided coin and no dust is lost.
+// An event recording the remainder is emitted.
+func (k Keeper) SplitCoin(ctx sdk.Context, coin sdk.Coin, parts uint64) ([]sdk.Coin, error) {
+	if err := coin.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidAmount, err.Error())
+	}
+
+	if parts == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidAmount, "number of parts must be greater than zero")
+	}
+
+	n := sdkmath.NewIntFromUint64(parts)
+	quotient, remainder := coin.Amount.Quo(n), coin.Amount.Mod(n)
+
+	// remainder is strictly less than parts and therefore fits in a uint64
+	dust := remainder.Uint64()
+
+	split := make([]sdk.Coin, parts)
+	for i := uint64(0); i < parts; i++ {
+		amount := quotient
+		if i < dust {
+			amount = amount.AddRaw(1)
+		}
+
+		split[i] = sdk.NewCoin(coin.Denom, amount)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCoinSplit,
+			sdk.NewAttribute(types.AttributeKeyDenom, coin.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, coin.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyParts, strconv.FormatUint(parts, 10)),
+			sdk.NewAttribute(types.AttributeKeyRemainder, remainder.String()),
+		),
+	)
+
+	return split, nil
+}
+
 // AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
 	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
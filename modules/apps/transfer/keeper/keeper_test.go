@@ -290,6 +290,117 @@ func (suite *KeeperTestSuite) TestGetAllDenomEscrows() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSplitCoin() {
+	var (
+		coin  sdk.Coin
+		parts uint64
+	)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expAmounts   []int64
+		expRemainder string
+		expErr       error
+	}{
+		{
+			"success: amount divides evenly",
+			func() {},
+			[]int64{25, 25, 25, 25},
+			"0",
+			nil,
+		},
+		{
+			"success: remainder assigned to the first parts",
+			func() {
+				coin = sdk.NewInt64Coin(sdk.DefaultBondDenom, 103)
+			},
+			[]int64{26, 26, 26, 25},
+			"3",
+			nil,
+		},
+		{
+			"success: fewer units than parts",
+			func() {
+				coin = sdk.NewInt64Coin(sdk.DefaultBondDenom, 2)
+			},
+			[]int64{1, 1, 0, 0},
+			"2",
+			nil,
+		},
+		{
+			"success: single part",
+			func() {
+				parts = 1
+			},
+			[]int64{100},
+			"0",
+			nil,
+		},
+		{
+			"failure: zero parts",
+			func() {
+				parts = 0
+			},
+			nil,
+			"",
+			types.ErrInvalidAmount,
+		},
+		{
+			"failure: invalid denom",
+			func() {
+				coin = sdk.Coin{Denom: "", Amount: sdkmath.NewInt(100)}
+			},
+			nil,
+			"",
+			types.ErrInvalidAmount,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			coin = sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+			parts = 4
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			split, err := suite.chainA.GetSimApp().TransferKeeper.SplitCoin(ctx, coin, parts)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Len(split, len(tc.expAmounts))
+
+				total := sdk.NewCoin(coin.Denom, sdkmath.ZeroInt())
+				for i, part := range split {
+					expPart := sdk.NewInt64Coin(coin.Denom, tc.expAmounts[i])
+					suite.Require().True(expPart.IsEqual(part), "part %d: expected %s, got %s", i, expPart, part)
+					total = total.Add(part)
+				}
+				suite.Require().True(coin.IsEqual(total))
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						types.EventTypeCoinSplit,
+						sdk.NewAttribute(types.AttributeKeyDenom, coin.Denom),
+						sdk.NewAttribute(types.AttributeKeyAmount, coin.Amount.String()),
+						sdk.NewAttribute(types.AttributeKeyParts, fmt.Sprintf("%d", parts)),
+						sdk.NewAttribute(types.AttributeKeyRemainder, tc.expRemainder),
+					),
+				}.ToABCIEvents()
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(split)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestParams() {
 	testCases := []struct {
 		name    string
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// RegisterMemoNamespace reserves the provided top level key of JSON object memos for the
+// application or middleware using it, e.g. "forward" or "src_callback". Once a namespace is
+// registered, transfers are rejected if their memo uses a reserved namespace (see
+// types.ReservedMemoNamespaces) which is not registered. Memos which are not JSON objects, and
+// keys which are not reserved namespaces, remain free-form. The namespaces of the transfer module
+// (types.SourceSenderMemoKey and types.UnwindMemoKey) are always allowed.
+//
+// Namespaces must be registered at app wiring time, before the keeper is passed to the
+// transfer IBC module:
+//
+//	app.TransferKeeper.RegisterMemoNamespace("src_callback")
+//	app.TransferKeeper.RegisterMemoNamespace("dest_callback")
+//
+// It panics if the namespace is invalid or already registered.
+func (k *Keeper) RegisterMemoNamespace(key string) {
+	if err := types.ValidateMemoNamespace(key); err != nil {
+		panic(err)
+	}
+
+	if isTransferMemoNamespace(key) || k.memoNamespaces[key] {
+		panic(fmt.Errorf("memo namespace %s is already registered", key))
+	}
+
+	if k.memoNamespaces == nil {
+		k.memoNamespaces = make(map[string]bool)
+	}
+
+	k.memoNamespaces[key] = true
+}
+
+// GetMemoNamespaces returns the sorted memo namespaces registered on the keeper.
+func (k Keeper) GetMemoNamespaces() []string {
+	namespaces := make([]string, 0, len(k.memoNamespaces))
+	for key := range k.memoNamespaces {
+		namespaces = append(namespaces, key)
+	}
+	sort.Strings(namespaces)
+
+	return namespaces
+}
+
+// validateMemoNamespaces returns an error if memo namespaces are registered and the provided
+// memo uses a reserved namespace which is not registered.
+func (k Keeper) validateMemoNamespaces(memo string) error {
+	if len(k.memoNamespaces) == 0 {
+		return nil
+	}
+
+	for _, key := range types.MemoNamespaces(memo) {
+		if types.IsReservedMemoNamespace(key) && !k.memoNamespaces[key] {
+			return errorsmod.Wrapf(types.ErrInvalidMemo, "reserved memo namespace %s is not registered", key)
+		}
+	}
+
+	return nil
+}
+
+// isTransferMemoNamespace returns true if the provided key is a memo namespace of the transfer module.
+func isTransferMemoNamespace(key string) bool {
+	return key == types.SourceSenderMemoKey || key == types.UnwindMemoKey
+}
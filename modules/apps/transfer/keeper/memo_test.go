@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestRegisterMemoNamespace() {
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	suite.Require().Empty(transferKeeper.GetMemoNamespaces())
+
+	transferKeeper.RegisterMemoNamespace("src_callback")
+	transferKeeper.RegisterMemoNamespace("forward")
+	suite.Require().Equal([]string{"forward", "src_callback"}, transferKeeper.GetMemoNamespaces())
+
+	suite.Require().Panics(func() {
+		transferKeeper.RegisterMemoNamespace("forward")
+	}, "namespace registered twice")
+
+	suite.Require().Panics(func() {
+		transferKeeper.RegisterMemoNamespace(types.SourceSenderMemoKey)
+	}, "namespace of the transfer module registered")
+
+	suite.Require().Panics(func() {
+		transferKeeper.RegisterMemoNamespace("Forward")
+	}, "invalid namespace registered")
+}
+
+func (suite *KeeperTestSuite) TestSendTransferMemoNamespaces() {
+	var (
+		memo       string
+		namespaces []string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: registered namespace",
+			func() {
+				memo = `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1"}}`
+			},
+			nil,
+		},
+		{
+			"success: registered namespaces and transfer namespace",
+			func() {
+				memo = fmt.Sprintf(`{"forward":{},"src_callback":{},"%s":"%s"}`, types.SourceSenderMemoKey, suite.chainA.SenderAccount.GetAddress())
+			},
+			nil,
+		},
+		{
+			"success: plain text memo",
+			func() {
+				memo = "thanks for the coffee"
+			},
+			nil,
+		},
+		{
+			"success: JSON memo with free-form keys",
+			func() {
+				memo = `{"Note":"invoice 42","order id":7}`
+			},
+			nil,
+		},
+		{
+			"success: unreserved namespace is free-form",
+			func() {
+				memo = `{"forward":{},"note":"invoice 42"}`
+			},
+			nil,
+		},
+		{
+			"success: no namespaces registered",
+			func() {
+				namespaces = nil
+				memo = `{"wasm":{}}`
+			},
+			nil,
+		},
+		{
+			"failure: unregistered reserved namespace",
+			func() {
+				memo = `{"forward":{},"wasm":{"contract":"cosmos1"}}`
+			},
+			types.ErrInvalidMemo,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			memo = ""
+			namespaces = []string{"forward", "src_callback"}
+
+			tc.malleate()
+
+			// the namespaces are registered on a copy of the keeper of chainA
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			for _, namespace := range namespaces {
+				transferKeeper.RegisterMemoNamespace(namespace)
+			}
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, memo,
+			)
+
+			res, err := transferKeeper.Transfer(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -102,6 +104,59 @@ func (m Migrator) MigrateTotalEscrowForDenom(ctx sdk.Context) error {
 	return nil
 }
 
+// MigrateEscrowClasses migrates the transfer module's parameters to include escrow classes. No escrow classes
+// are configured by the migration, such that all tokens in escrow remain held by the default escrow addresses.
+func (m Migrator) MigrateEscrowClasses(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.EscrowClasses = nil
+
+	m.keeper.SetParams(ctx, params)
+	m.keeper.Logger(ctx).Info("successfully migrated transfer app params to include escrow classes")
+	return nil
+}
+
+// MigrateEscrowAccounts records the escrow account of every denomination escrowed on the channels bound to the
+// transfer port. Balances held by the default escrow address of a channel for a denomination which is escrowed in
+// the escrow address of an escrow class, or per denomination, are moved to that escrow address beforehand, such that
+// the escrowed tokens of each denomination are held by the single recorded escrow account.
+func (m Migrator) MigrateEscrowAccounts(ctx sdk.Context) error {
+	portID := m.keeper.GetPort(ctx)
+	params := m.keeper.GetParams(ctx)
+	escrowDenoms := m.keeper.GetAllTotalEscrowed(ctx).Denoms()
+
+	var numEscrowAccounts int
+	for _, channel := range m.keeper.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
+		// the escrow addresses which may hold tokens escrowed on the channel before escrow accounts were recorded
+		escrowAddresses := newEscrowAddressSet()
+		escrowAddresses.add(types.GetEscrowAddress(portID, channel.ChannelId))
+		for _, escrowClass := range params.EscrowClasses {
+			escrowAddresses.add(types.GetEscrowAddressForClass(portID, channel.ChannelId, escrowClass.Name))
+		}
+		for _, denom := range escrowDenoms {
+			escrowAddresses.add(types.GetDenomEscrowAddress(portID, channel.ChannelId, denom))
+		}
+
+		for _, escrowAddress := range escrowAddresses.addresses {
+			for _, balance := range m.keeper.bankKeeper.GetAllBalances(ctx, escrowAddress) {
+				newEscrowAddress := params.EscrowAddressForDenom(portID, channel.ChannelId, balance.Denom)
+				if !newEscrowAddress.Equals(escrowAddress) {
+					if err := m.keeper.bankKeeper.SendCoins(ctx, escrowAddress, newEscrowAddress, sdk.NewCoins(balance)); err != nil {
+						return errorsmod.Wrapf(err, "failed to migrate escrowed %s of channel %s", balance.Denom, channel.ChannelId)
+					}
+				}
+
+				if _, found := m.keeper.GetEscrowAccount(ctx, portID, channel.ChannelId, balance.Denom); !found {
+					m.keeper.SetEscrowAccount(ctx, types.NewEscrowAccount(portID, channel.ChannelId, balance.Denom, newEscrowAddress))
+					numEscrowAccounts++
+				}
+			}
+		}
+	}
+
+	m.keeper.Logger(ctx).Info("successfully recorded escrow accounts", "number of escrow accounts", numEscrowAccounts)
+	return nil
+}
+
 func equalTraces(dtA, dtB types.DenomTrace) bool {
 	return dtA.BaseDenom == dtB.BaseDenom && dtA.Path == dtB.Path
 }
@@ -48,6 +48,69 @@ func (suite *KeeperTestSuite) TestMigratorMigrateParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMigratorMigrateEscrowClasses() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	// escrow tokens in the default escrow address before the migration
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	escrowAddress := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, escrowAddress, sdk.NewCoins(coin)))
+
+	migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+	err := migrator.MigrateEscrowClasses(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(transfertypes.DefaultParams(), params)
+
+	// tokens remain in, and are escrowed to, the default escrow address
+	suite.Require().Equal(coin, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom))
+	suite.Require().Equal(escrowAddress, suite.chainA.GetSimApp().TransferKeeper.GetEscrowAddressForDenom(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom))
+}
+
+func (suite *KeeperTestSuite) TestMigratorMigrateEscrowAccounts() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+	// escrow tokens before the migration: uatom in the default escrow address and the escrow address of the
+	// escrow class it was assigned to afterwards, stake in the default escrow address only
+	atomClass := transfertypes.EscrowClass{Name: "atom", DenomPatterns: []string{"uatom"}}
+	atom := sdk.NewCoin("uatom", sdkmath.NewInt(50))
+	stake := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+	defaultEscrowAddress := transfertypes.GetEscrowAddress(portID, channelID)
+	classEscrowAddress := transfertypes.GetEscrowAddressForClass(portID, channelID, atomClass.Name)
+	suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), bankKeeper, defaultEscrowAddress, sdk.NewCoins(atom, stake)))
+	suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), bankKeeper, classEscrowAddress, sdk.NewCoins(atom)))
+
+	params := transferKeeper.GetParams(suite.chainA.GetContext())
+	params.EscrowClasses = []transfertypes.EscrowClass{atomClass}
+	transferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	migrator := transferkeeper.NewMigrator(transferKeeper)
+	err := migrator.MigrateEscrowAccounts(suite.chainA.GetContext())
+	suite.Require().NoError(err)
+
+	// the escrowed uatom is moved to the escrow address of the escrow class
+	suite.Require().Equal(atom.Add(atom), bankKeeper.GetBalance(suite.chainA.GetContext(), classEscrowAddress, atom.Denom))
+	suite.Require().True(bankKeeper.GetBalance(suite.chainA.GetContext(), defaultEscrowAddress, atom.Denom).IsZero())
+	suite.Require().Equal(stake, bankKeeper.GetBalance(suite.chainA.GetContext(), defaultEscrowAddress, stake.Denom))
+
+	suite.Require().ElementsMatch([]transfertypes.EscrowAccount{
+		transfertypes.NewEscrowAccount(portID, channelID, atom.Denom, classEscrowAddress),
+		transfertypes.NewEscrowAccount(portID, channelID, stake.Denom, defaultEscrowAddress),
+	}, transferKeeper.GetAllEscrowAccounts(suite.chainA.GetContext()))
+}
+
 func (suite *KeeperTestSuite) TestMigratorMigrateTraces() {
 	testCases := []struct {
 		msg            string
@@ -271,7 +334,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateMetadata() {
 							},
 						},
 						Base:    denomTraces[0].IBCDenom(), // ibc/EB7094899ACFB7A6F2A67DB084DEE2E9A83DEFAA5DEF92D9A9814FFD9FF673FA
-						Display: "transfer/channel-0/foo",
+						Display: "foo",
 						Name:    "transfer/channel-0/foo IBC token",
 						Symbol:  "FOO",
 					},
@@ -298,7 +361,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateMetadata() {
 							},
 						},
 						Base:    denomTraces[0].IBCDenom(), // ibc/8243B3EAA19BAB1DB3B0020B81C0C5A953E7B22C042CEE44E639A11A238BA57C
-						Display: "transfer/channel-1/transfer/channel-2/ubar",
+						Display: "ubar",
 						Name:    "transfer/channel-1/transfer/channel-2/ubar IBC token",
 						Symbol:  "UBAR",
 					},
@@ -329,7 +392,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateMetadata() {
 							},
 						},
 						Base:    denomTraces[0].IBCDenom(), // ibc/EB7094899ACFB7A6F2A67DB084DEE2E9A83DEFAA5DEF92D9A9814FFD9FF673FA
-						Display: "transfer/channel-0/foo",
+						Display: "foo",
 						Name:    "transfer/channel-0/foo IBC token",
 						Symbol:  "FOO",
 					},
@@ -342,7 +405,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateMetadata() {
 							},
 						},
 						Base:    denomTraces[1].IBCDenom(), // ibc/E1530E21F1848B6C29C9E89256D43E294976897611A61741CACBA55BE21736F5
-						Display: "transfer/channel-0/bar",
+						Display: "bar",
 						Name:    "transfer/channel-0/bar IBC token",
 						Symbol:  "BAR",
 					},
@@ -373,7 +436,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateMetadata() {
 							},
 						},
 						Base:    denomTraces[0].IBCDenom(), // ibc/EB7094899ACFB7A6F2A67DB084DEE2E9A83DEFAA5DEF92D9A9814FFD9FF673FA
-						Display: "transfer/channel-0/foo",
+						Display: "foo",
 						Name:    "transfer/channel-0/foo IBC token",
 						Symbol:  "FOO",
 					},
@@ -386,7 +449,7 @@ func (suite *KeeperTestSuite) TestMigratorMigrateMetadata() {
 							},
 						},
 						Base:    denomTraces[1].IBCDenom(), // ibc/E1530E21F1848B6C29C9E89256D43E294976897611A61741CACBA55BE21736F5
-						Display: "transfer/channel-0/bar",
+						Display: "bar",
 						Name:    "transfer/channel-0/bar IBC token",
 						Symbol:  "BAR",
 					},
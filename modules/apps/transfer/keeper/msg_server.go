@@ -34,22 +34,51 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to send funds", sender)
 	}
 
+	if !msg.UnsafeReceiver {
+		if err := k.validateReceiverPrefix(ctx, msg.SourceChannel, msg.Receiver); err != nil {
+			return nil, err
+		}
+	}
+
+	if msg.RefundAddress != "" {
+		refundAddress, err := sdk.AccAddressFromBech32(msg.RefundAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if k.bankKeeper.BlockedAddr(refundAddress) {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", refundAddress)
+		}
+	}
+
+	token := msg.Token
+
+	// if the amount is the UnboundedSpendLimit sentinel value, the entire balance of the sender is transferred.
+	// The balance is read at the time of execution, so any fees escrowed by prior messages in the same
+	// transaction (e.g. MsgPayPacketFee) have already been deducted and are never transferred.
+	if token.Amount.Equal(types.UnboundedSpendLimit()) {
+		token.Amount = k.bankKeeper.GetBalance(ctx, sender, token.Denom).Amount
+		if !token.Amount.IsPositive() {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "sender %s has no balance of %s to transfer", sender, token.Denom)
+		}
+	}
+
 	sequence, err := k.sendTransfer(
-		ctx, msg.SourcePort, msg.SourceChannel, msg.Token, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
-		msg.Memo)
+		ctx, msg.SourcePort, msg.SourceChannel, token, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
+		msg.Memo, msg.RefundAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	k.Logger(ctx).Info("IBC fungible token transfer", "token", msg.Token.Denom, "amount", msg.Token.Amount.String(), "sender", msg.Sender, "receiver", msg.Receiver)
+	k.Logger(ctx).Info("IBC fungible token transfer", "token", token.Denom, "amount", token.Amount.String(), "sender", msg.Sender, "receiver", msg.Receiver)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeTransfer,
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
 			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
-			sdk.NewAttribute(types.AttributeKeyAmount, msg.Token.Amount.String()),
-			sdk.NewAttribute(types.AttributeKeyDenom, msg.Token.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, token.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, token.Denom),
 			sdk.NewAttribute(types.AttributeKeyMemo, msg.Memo),
 		),
 		sdk.NewEvent(
@@ -62,13 +91,92 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 }
 
 // UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the ibc-transfer module's parameters.
+// Escrowed balances are moved to the escrow addresses of the new mode if escrowing per denomination is toggled.
 func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if transferFee := msg.Params.TransferFee; transferFee != nil && k.authKeeper.GetModuleAddress(transferFee.Collector) == nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTransferFee, "fee collector module account %s does not exist", transferFee.Collector)
+	}
+
+	// escrowed balances are moved to the escrow addresses of the new mode when escrowing per denomination is toggled
+	if msg.Params.EscrowPerDenom != k.GetParams(ctx).EscrowPerDenom {
+		if err := k.migrateEscrowBalances(ctx, msg.Params); err != nil {
+			return nil, err
+		}
+	}
+
 	k.SetParams(ctx, msg.Params)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetTransferQuota defines an rpc handler method for MsgSetTransferQuota. Sets or removes the transfer quota
+// of a channel and denomination. The net outflow of an existing quota within the current epoch is preserved.
+func (k Keeper) SetTransferQuota(goCtx context.Context, msg *types.MsgSetTransferQuota) (*types.MsgSetTransferQuotaResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	epochDuration := msg.EpochDuration
+	if epochDuration == 0 {
+		epochDuration = types.DefaultQuotaEpochDuration
+	}
+
+	if msg.MaxOutflow.IsZero() {
+		k.DeleteQuota(ctx, msg.ChannelId, msg.Denom)
+	} else {
+		quota, found := k.GetQuota(ctx, msg.ChannelId, msg.Denom)
+		if !found {
+			quota = types.NewTransferQuota(msg.ChannelId, msg.Denom, msg.MaxOutflow, epochDuration, ctx.BlockTime())
+		}
+
+		quota.MaxOutflow = msg.MaxOutflow
+		quota.EpochDuration = epochDuration
+		k.SetQuota(ctx, quota)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQuotaUpdated,
+			sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyMaxOutflow, msg.MaxOutflow.String()),
+			sdk.NewAttribute(types.AttributeKeyEpochDuration, epochDuration.String()),
+		),
+	)
+
+	return &types.MsgSetTransferQuotaResponse{}, nil
+}
+
+// SetReceiverPrefix defines an rpc handler method for MsgSetReceiverPrefix. Sets or removes the expected
+// bech32 prefix of receiver addresses of transfers sent over a channel.
+func (k Keeper) SetReceiverPrefix(goCtx context.Context, msg *types.MsgSetReceiverPrefix) (*types.MsgSetReceiverPrefixResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Prefix == "" {
+		k.DeleteChannelReceiverPrefix(ctx, msg.ChannelId)
+	} else {
+		k.SetChannelReceiverPrefix(ctx, types.NewReceiverPrefix(msg.ChannelId, msg.Prefix))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReceiverPrefixUpdated,
+			sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyPrefix, msg.Prefix),
+		),
+	)
+
+	return &types.MsgSetReceiverPrefixResponse{}, nil
+}
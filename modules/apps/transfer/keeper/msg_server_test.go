@@ -1,12 +1,18 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -123,6 +129,14 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 				suite.Require().NotNil(res)
 				suite.Require().NotEqual(res.Sequence, uint64(0))
 				ibctesting.AssertEvents(&suite.Suite, expectedEvents, actualEvents)
+
+				data := types.NewFungibleTokenPacketData(coin.Denom, coin.Amount.String(), msg.Sender, msg.Receiver, msg.Memo)
+				packet := channeltypes.NewPacket(data.GetBytes(), res.Sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, msg.TimeoutHeight, 0)
+				ibctesting.AssertTypedEvent(suite.T(), actualEvents, &channeltypes.EventSendPacket{
+					Packet:          packet,
+					ChannelOrdering: channeltypes.UNORDERED,
+					ConnectionId:    path.EndpointA.ConnectionID,
+				})
 			} else {
 				suite.Require().Error(err)
 				suite.Require().Nil(res)
@@ -132,6 +146,83 @@ func (suite *KeeperTestSuite) TestMsgTransfer() {
 	}
 }
 
+// TestMsgTransferEntireBalance tests Transfer rpc handler when the UnboundedSpendLimit sentinel value is used as the amount
+func (suite *KeeperTestSuite) TestMsgTransferEntireBalance() {
+	var msg *types.MsgTransfer
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: sender has no balance",
+			func() {
+				msg.Token.Denom = "nobalance"
+			},
+			ibcerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			msg = types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoin(sdk.DefaultBondDenom, types.UnboundedSpendLimit()), sender.String(), suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, // only use timeout height
+				"memo",
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, msg.Token.Denom)
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, msg.Token.Denom).IsZero())
+
+				escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().Equal(balance, suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, escrowAddress, msg.Token.Denom))
+				suite.Require().Equal(balance, suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(ctx, msg.Token.Denom))
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						types.EventTypeTransfer,
+						sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+						sdk.NewAttribute(types.AttributeKeyReceiver, suite.chainB.SenderAccount.GetAddress().String()),
+						sdk.NewAttribute(types.AttributeKeyAmount, balance.Amount.String()),
+						sdk.NewAttribute(types.AttributeKeyDenom, balance.Denom),
+						sdk.NewAttribute(types.AttributeKeyMemo, "memo"),
+					),
+				}.ToABCIEvents()
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 // TestUpdateParams tests UpdateParams rpc handler
 func (suite *KeeperTestSuite) TestUpdateParams() {
 	signer := suite.chainA.GetSimApp().TransferKeeper.GetAuthority()
@@ -145,6 +236,24 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 			types.NewMsgUpdateParams(signer, types.DefaultParams()),
 			true,
 		},
+		{
+			"success: transfer fee collected by existing module account",
+			types.NewMsgUpdateParams(signer, types.Params{
+				SendEnabled:    true,
+				ReceiveEnabled: true,
+				TransferFee:    &types.TransferFee{Bps: 50, Collector: authtypes.FeeCollectorName},
+			}),
+			true,
+		},
+		{
+			"failure: transfer fee collector module account does not exist",
+			types.NewMsgUpdateParams(signer, types.Params{
+				SendEnabled:    true,
+				ReceiveEnabled: true,
+				TransferFee:    &types.TransferFee{Bps: 50, Collector: "unknown"},
+			}),
+			false,
+		},
 		{
 			"failure: malformed signer address",
 			types.NewMsgUpdateParams(ibctesting.InvalidID, types.DefaultParams()),
@@ -180,3 +289,295 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+// TestUpdateParamsMigratesEscrowBalances tests that UpdateParams moves the escrowed balances of all channels and
+// denominations to the escrow addresses of the new mode when escrowing per denomination is toggled
+func (suite *KeeperTestSuite) TestUpdateParamsMigratesEscrowBalances() {
+	suite.SetupTest()
+
+	paths := []*ibctesting.Path{
+		ibctesting.NewTransferPath(suite.chainA, suite.chainB),
+		ibctesting.NewTransferPath(suite.chainA, suite.chainB),
+	}
+	for _, path := range paths {
+		path.Setup()
+	}
+
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	bankKeeper := suite.chainA.GetSimApp().BankKeeper
+	sender := suite.chainA.SenderAccount.GetAddress()
+
+	// fund the sender with a second denomination, escrowed in the escrow address of an escrow class
+	atomClass := types.EscrowClass{Name: "atom", DenomPatterns: []string{"uatom"}}
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)), sdk.NewCoin("uatom", sdkmath.NewInt(50)))
+	suite.Require().NoError(bankKeeper.MintCoins(suite.chainA.GetContext(), types.ModuleName, sdk.NewCoins(coins[1].Add(coins[1]))))
+	suite.Require().NoError(bankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), types.ModuleName, sender, sdk.NewCoins(coins[1].Add(coins[1]))))
+
+	params := transferKeeper.GetParams(suite.chainA.GetContext())
+	params.EscrowClasses = []types.EscrowClass{atomClass}
+	transferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	for _, path := range paths {
+		for _, coin := range coins {
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, sender.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+			_, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+		}
+	}
+
+	totalEscrowed := transferKeeper.GetAllTotalEscrowed(suite.chainA.GetContext())
+	suite.Require().Equal(coins.Add(coins...), totalEscrowed)
+
+	// requireEscrowed asserts that each path holds the coins in escrow at the escrow addresses returned by escrowAddress,
+	// with nothing left in the escrow addresses returned by emptyAddresses
+	requireEscrowed := func(escrowAddress func(path *ibctesting.Path, denom string) sdk.AccAddress, emptyAddresses func(path *ibctesting.Path) []sdk.AccAddress) {
+		ctx := suite.chainA.GetContext()
+		for _, path := range paths {
+			for _, coin := range coins {
+				suite.Require().Equal(coin, bankKeeper.GetBalance(ctx, escrowAddress(path, coin.Denom), coin.Denom))
+			}
+
+			for _, emptyAddress := range emptyAddresses(path) {
+				suite.Require().True(bankKeeper.GetAllBalances(ctx, emptyAddress).IsZero())
+			}
+		}
+
+		// the total escrow of each denomination is unchanged
+		for _, coin := range coins {
+			res, err := transferKeeper.TotalEscrowForDenom(ctx, &types.QueryTotalEscrowForDenomRequest{Denom: coin.Denom})
+			suite.Require().NoError(err)
+			suite.Require().Equal(totalEscrowed.AmountOf(coin.Denom), res.Amount.Amount)
+		}
+
+		suite.Require().Equal(totalEscrowed, transferKeeper.GetAllActualEscrowed(ctx))
+
+		_, broken := keeper.TotalEscrowPerDenomInvariants(&transferKeeper)(ctx)
+		suite.Require().False(broken)
+	}
+
+	legacyEscrowAddresses := func(path *ibctesting.Path) []sdk.AccAddress {
+		return []sdk.AccAddress{
+			types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID),
+			types.GetEscrowAddressForClass(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, atomClass.Name),
+		}
+	}
+	denomEscrowAddresses := func(path *ibctesting.Path) []sdk.AccAddress {
+		var escrowAddresses []sdk.AccAddress
+		for _, coin := range coins {
+			escrowAddresses = append(escrowAddresses, types.GetDenomEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin.Denom))
+		}
+		return escrowAddresses
+	}
+
+	// enable escrowing per denomination, escrow classes may not be configured at the same time
+	params.EscrowClasses = nil
+	params.EscrowPerDenom = true
+	_, err := transferKeeper.UpdateParams(suite.chainA.GetContext(), types.NewMsgUpdateParams(transferKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+
+	requireEscrowed(func(path *ibctesting.Path, denom string) sdk.AccAddress {
+		return types.GetDenomEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, denom)
+	}, legacyEscrowAddresses)
+
+	// updating other params does not move the escrowed balances
+	params.SendEnabled = false
+	_, err = transferKeeper.UpdateParams(suite.chainA.GetContext(), types.NewMsgUpdateParams(transferKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+
+	requireEscrowed(func(path *ibctesting.Path, denom string) sdk.AccAddress {
+		return types.GetDenomEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, denom)
+	}, legacyEscrowAddresses)
+
+	// disable escrowing per denomination, moving the balances to the escrow addresses of the new escrow classes
+	params.EscrowPerDenom = false
+	params.EscrowClasses = []types.EscrowClass{atomClass}
+	_, err = transferKeeper.UpdateParams(suite.chainA.GetContext(), types.NewMsgUpdateParams(transferKeeper.GetAuthority(), params))
+	suite.Require().NoError(err)
+
+	requireEscrowed(func(path *ibctesting.Path, denom string) sdk.AccAddress {
+		return transferKeeper.GetEscrowAddressForDenom(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, denom)
+	}, denomEscrowAddresses)
+	suite.Require().Equal(
+		coins[1],
+		bankKeeper.GetBalance(suite.chainA.GetContext(), types.GetEscrowAddressForClass(paths[0].EndpointA.ChannelConfig.PortID, paths[0].EndpointA.ChannelID, atomClass.Name), coins[1].Denom),
+	)
+}
+
+// TestSetTransferQuota tests SetTransferQuota rpc handler
+func (suite *KeeperTestSuite) TestSetTransferQuota() {
+	var msg *types.MsgSetTransferQuota
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: new quota",
+			func() {},
+			nil,
+		},
+		{
+			"success: update existing quota preserves net outflow",
+			func() {
+				quota := types.NewTransferQuota(msg.ChannelId, msg.Denom, sdkmath.NewInt(10), time.Hour, suite.chainA.GetContext().BlockTime().Add(-time.Minute))
+				quota.NetOutflow = sdkmath.NewInt(5)
+				suite.chainA.GetSimApp().TransferKeeper.SetQuota(suite.chainA.GetContext(), quota)
+			},
+			nil,
+		},
+		{
+			"success: zero max outflow removes quota",
+			func() {
+				quota := types.NewTransferQuota(msg.ChannelId, msg.Denom, sdkmath.NewInt(10), time.Hour, suite.chainA.GetContext().BlockTime())
+				suite.chainA.GetSimApp().TransferKeeper.SetQuota(suite.chainA.GetContext(), quota)
+
+				msg.MaxOutflow = sdkmath.ZeroInt()
+			},
+			nil,
+		},
+		{
+			"success: zero epoch duration defaults to one day",
+			func() {
+				msg.EpochDuration = 0
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			msg = types.NewMsgSetTransferQuota(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), ibctesting.FirstChannelID, sdk.DefaultBondDenom, sdkmath.NewInt(100), time.Hour)
+
+			tc.malleate()
+
+			existing, hasExisting := suite.chainA.GetSimApp().TransferKeeper.GetQuota(suite.chainA.GetContext(), msg.ChannelId, msg.Denom)
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.SetTransferQuota(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				quota, found := suite.chainA.GetSimApp().TransferKeeper.GetQuota(suite.chainA.GetContext(), msg.ChannelId, msg.Denom)
+				if msg.MaxOutflow.IsZero() {
+					suite.Require().False(found)
+					return
+				}
+
+				suite.Require().True(found)
+				suite.Require().Equal(msg.MaxOutflow, quota.MaxOutflow)
+
+				expEpochDuration := msg.EpochDuration
+				if expEpochDuration == 0 {
+					expEpochDuration = types.DefaultQuotaEpochDuration
+				}
+				suite.Require().Equal(expEpochDuration, quota.EpochDuration)
+
+				if hasExisting {
+					suite.Require().Equal(existing.NetOutflow, quota.NetOutflow)
+					suite.Require().True(existing.EpochStart.Equal(quota.EpochStart))
+				} else {
+					suite.Require().True(quota.NetOutflow.IsZero())
+					suite.Require().True(suite.chainA.GetContext().BlockTime().Equal(quota.EpochStart))
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// TestSetReceiverPrefix tests SetReceiverPrefix rpc handler
+func (suite *KeeperTestSuite) TestSetReceiverPrefix() {
+	var msg *types.MsgSetReceiverPrefix
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: new receiver prefix",
+			func() {},
+			nil,
+		},
+		{
+			"success: overwrite receiver prefix",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiverPrefix(suite.chainA.GetContext(), types.NewReceiverPrefix(msg.ChannelId, "juno"))
+			},
+			nil,
+		},
+		{
+			"success: empty prefix removes receiver prefix",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiverPrefix(suite.chainA.GetContext(), types.NewReceiverPrefix(msg.ChannelId, "juno"))
+
+				msg.Prefix = ""
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			msg = types.NewMsgSetReceiverPrefix(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), ibctesting.FirstChannelID, "osmo")
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().TransferKeeper.SetReceiverPrefix(ctx, msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				receiverPrefix, found := suite.chainA.GetSimApp().TransferKeeper.GetChannelReceiverPrefix(suite.chainA.GetContext(), msg.ChannelId)
+				if msg.Prefix == "" {
+					suite.Require().False(found)
+				} else {
+					suite.Require().True(found)
+					suite.Require().Equal(types.NewReceiverPrefix(msg.ChannelId, msg.Prefix), receiverPrefix)
+				}
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						types.EventTypeReceiverPrefixUpdated,
+						sdk.NewAttribute(types.AttributeKeyChannelID, msg.ChannelId),
+						sdk.NewAttribute(types.AttributeKeyPrefix, msg.Prefix),
+					),
+				}.ToABCIEvents()
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, ctx.EventManager().Events().ToABCIEvents())
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// GetQuota returns the transfer quota of the provided channel and denomination.
+func (k Keeper) GetQuota(ctx sdk.Context, channelID, denom string) (types.TransferQuota, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TransferQuotaKey(channelID, denom))
+	if len(bz) == 0 {
+		return types.TransferQuota{}, false
+	}
+
+	var quota types.TransferQuota
+	k.cdc.MustUnmarshal(bz, &quota)
+
+	return quota, true
+}
+
+// SetQuota stores the provided transfer quota.
+func (k Keeper) SetQuota(ctx sdk.Context, quota types.TransferQuota) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&quota)
+	store.Set(types.TransferQuotaKey(quota.ChannelId, quota.Denom), bz)
+}
+
+// DeleteQuota removes the transfer quota of the provided channel and denomination.
+func (k Keeper) DeleteQuota(ctx sdk.Context, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.TransferQuotaKey(channelID, denom))
+}
+
+// GetAllQuotas returns all transfer quotas stored.
+func (k Keeper) GetAllQuotas(ctx sdk.Context) []types.TransferQuota {
+	var quotas []types.TransferQuota
+	k.IterateQuotas(ctx, func(quota types.TransferQuota) bool {
+		quotas = append(quotas, quota)
+		return false
+	})
+
+	return quotas
+}
+
+// IterateQuotas iterates over the transfer quotas in the store
+// and performs a callback function.
+func (k Keeper) IterateQuotas(ctx sdk.Context, cb func(quota types.TransferQuota) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(fmt.Sprintf("%s/", types.KeyTransferQuotaPrefix)))
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var quota types.TransferQuota
+		k.cdc.MustUnmarshal(iterator.Value(), &quota)
+		if cb(quota) {
+			break
+		}
+	}
+}
+
+// ResetElapsedQuotas resets the net outflow of every transfer quota whose epoch has elapsed
+// at the current block time. The start of the new epoch is advanced by a whole number of epoch
+// durations, such that epoch boundaries do not drift with block times.
+func (k Keeper) ResetElapsedQuotas(ctx sdk.Context) {
+	var elapsed []types.TransferQuota
+	k.IterateQuotas(ctx, func(quota types.TransferQuota) bool {
+		if quota.EpochElapsed(ctx.BlockTime()) {
+			elapsed = append(elapsed, quota)
+		}
+		return false
+	})
+
+	for _, quota := range elapsed {
+		epochs := ctx.BlockTime().Sub(quota.EpochStart) / quota.EpochDuration
+		quota.EpochStart = quota.EpochStart.Add(epochs * quota.EpochDuration)
+		quota.NetOutflow = sdkmath.ZeroInt()
+		k.SetQuota(ctx, quota)
+	}
+}
+
+// consumeQuota adds the provided token to the net outflow of the quota of the provided channel.
+// An error is returned if the net outflow would exceed the maximum outflow of the quota.
+// Transfers of denominations without a quota on the channel are not limited.
+func (k Keeper) consumeQuota(ctx sdk.Context, channelID string, token sdk.Coin) error {
+	quota, found := k.GetQuota(ctx, channelID, token.Denom)
+	if !found {
+		return nil
+	}
+
+	if token.Amount.GT(quota.Remaining()) {
+		return errorsmod.Wrapf(types.ErrQuotaExceeded, "transfer of %s on channel %s exceeds remaining quota of %s%s", token, channelID, quota.Remaining(), token.Denom)
+	}
+
+	quota.NetOutflow = quota.NetOutflow.Add(token.Amount)
+	k.SetQuota(ctx, quota)
+
+	return nil
+}
+
+// creditQuota subtracts the provided token from the net outflow of the quota of the provided channel.
+// The net outflow never drops below zero, such that inflows can free up consumed quota but never raise it
+// above its maximum outflow.
+func (k Keeper) creditQuota(ctx sdk.Context, channelID string, token sdk.Coin) {
+	quota, found := k.GetQuota(ctx, channelID, token.Denom)
+	if !found {
+		return
+	}
+
+	if token.Amount.GTE(quota.NetOutflow) {
+		quota.NetOutflow = sdkmath.ZeroInt()
+	} else {
+		quota.NetOutflow = quota.NetOutflow.Sub(token.Amount)
+	}
+
+	k.SetQuota(ctx, quota)
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// TestTransferQuotaNetting tests that outflows consume the transfer quota of a channel and
+// that inflows and refunds credit the quota back, without raising it above its maximum outflow.
+func (suite *KeeperTestSuite) TestTransferQuotaNetting() {
+	suite.SetupTest()
+
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	sender := suite.chainA.SenderAccount.GetAddress()
+	channelID := path.EndpointA.ChannelID
+	escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, channelID)
+
+	transferKeeper.SetQuota(suite.chainA.GetContext(), types.NewTransferQuota(channelID, sdk.DefaultBondDenom, sdkmath.NewInt(100), time.Hour, suite.chainA.GetContext().BlockTime()))
+
+	transfer := func(amount int64) error {
+		msg := types.NewMsgTransfer(
+			path.EndpointA.ChannelConfig.PortID, channelID,
+			sdk.NewInt64Coin(sdk.DefaultBondDenom, amount),
+			sender.String(), suite.chainB.SenderAccount.GetAddress().String(),
+			suite.chainB.GetTimeoutHeight(), 0, "",
+		)
+		_, err := transferKeeper.Transfer(suite.chainA.GetContext(), msg)
+		return err
+	}
+
+	// receive tokens which were previously sent from chainA back on the quota channel
+	receive := func(amount int64) {
+		denom := types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom)
+		data := types.NewFungibleTokenPacketData(denom, sdkmath.NewInt(amount).String(), suite.chainB.SenderAccount.GetAddress().String(), sender.String(), "")
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, channelID, suite.chainA.GetTimeoutHeight(), 0)
+		_, _, err := transferKeeper.OnRecvPacket(suite.chainA.GetContext(), packet, data)
+		suite.Require().NoError(err)
+	}
+
+	assertNetOutflow := func(expected int64) {
+		quota, found := transferKeeper.GetQuota(suite.chainA.GetContext(), channelID, sdk.DefaultBondDenom)
+		suite.Require().True(found)
+		suite.Require().Equal(sdkmath.NewInt(expected), quota.NetOutflow)
+	}
+
+	suite.Require().NoError(transfer(60))
+	assertNetOutflow(60)
+
+	err := transfer(50)
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+	assertNetOutflow(60)
+
+	// inflows free up consumed quota
+	receive(40)
+	assertNetOutflow(20)
+
+	suite.Require().NoError(transfer(80))
+	assertNetOutflow(100)
+
+	err = transfer(1)
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	// refunds credit the quota back
+	data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "80", sender.String(), suite.chainB.SenderAccount.GetAddress().String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 2, path.EndpointA.ChannelConfig.PortID, channelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainB.GetTimeoutHeight(), 0)
+	suite.Require().NoError(transferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data))
+	assertNetOutflow(20)
+
+	// inflows exceeding the net outflow do not raise the quota above its maximum outflow
+	extra := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+	suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, escrow, sdk.NewCoins(extra)))
+	transferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), transferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.DefaultBondDenom).Add(extra))
+
+	receive(100)
+	assertNetOutflow(0)
+
+	err = transfer(101)
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+	suite.Require().NoError(transfer(100))
+
+	// transfers of denominations without a quota are not limited
+	transferKeeper.DeleteQuota(suite.chainA.GetContext(), channelID, sdk.DefaultBondDenom)
+	suite.Require().NoError(transfer(1000))
+}
+
+func (suite *KeeperTestSuite) TestResetElapsedQuotas() {
+	const epochDuration = 24 * time.Hour
+
+	var (
+		epochStart    time.Time
+		expEpochStart time.Time
+		expReset      bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func(blockTime time.Time)
+	}{
+		{
+			"epoch has not elapsed",
+			func(blockTime time.Time) {
+				epochStart = blockTime.Add(-epochDuration).Add(time.Nanosecond)
+				expEpochStart = epochStart
+				expReset = false
+			},
+		},
+		{
+			"epoch elapses exactly at block time",
+			func(blockTime time.Time) {
+				epochStart = blockTime.Add(-epochDuration)
+				expEpochStart = blockTime
+				expReset = true
+			},
+		},
+		{
+			"epoch elapsed during block interval",
+			func(blockTime time.Time) {
+				epochStart = blockTime.Add(-epochDuration).Add(-time.Minute)
+				expEpochStart = blockTime.Add(-time.Minute)
+				expReset = true
+			},
+		},
+		{
+			"multiple epochs elapsed",
+			func(blockTime time.Time) {
+				epochStart = blockTime.Add(-5 * epochDuration / 2)
+				expEpochStart = blockTime.Add(-epochDuration / 2)
+				expReset = true
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			ctx := suite.chainA.GetContext()
+			tc.malleate(ctx.BlockTime())
+
+			quota := types.NewTransferQuota(ibctesting.FirstChannelID, sdk.DefaultBondDenom, sdkmath.NewInt(100), epochDuration, epochStart)
+			quota.NetOutflow = sdkmath.NewInt(75)
+			suite.chainA.GetSimApp().TransferKeeper.SetQuota(ctx, quota)
+
+			suite.chainA.GetSimApp().TransferKeeper.ResetElapsedQuotas(ctx)
+
+			quota, found := suite.chainA.GetSimApp().TransferKeeper.GetQuota(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+			suite.Require().True(found)
+			suite.Require().True(expEpochStart.Equal(quota.EpochStart), "expected epoch start %s, got %s", expEpochStart, quota.EpochStart)
+
+			if expReset {
+				suite.Require().True(quota.NetOutflow.IsZero())
+			} else {
+				suite.Require().Equal(sdkmath.NewInt(75), quota.NetOutflow)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// GetChannelReceiverPrefix returns the expected bech32 prefix of receiver addresses of the provided channel.
+func (k Keeper) GetChannelReceiverPrefix(ctx sdk.Context, channelID string) (types.ReceiverPrefix, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ReceiverPrefixKey(channelID))
+	if len(bz) == 0 {
+		return types.ReceiverPrefix{}, false
+	}
+
+	var receiverPrefix types.ReceiverPrefix
+	k.cdc.MustUnmarshal(bz, &receiverPrefix)
+
+	return receiverPrefix, true
+}
+
+// SetChannelReceiverPrefix stores the provided receiver prefix.
+func (k Keeper) SetChannelReceiverPrefix(ctx sdk.Context, receiverPrefix types.ReceiverPrefix) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&receiverPrefix)
+	store.Set(types.ReceiverPrefixKey(receiverPrefix.ChannelId), bz)
+}
+
+// DeleteChannelReceiverPrefix removes the receiver prefix of the provided channel.
+func (k Keeper) DeleteChannelReceiverPrefix(ctx sdk.Context, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ReceiverPrefixKey(channelID))
+}
+
+// GetAllReceiverPrefixes returns all receiver prefixes stored.
+func (k Keeper) GetAllReceiverPrefixes(ctx sdk.Context) []types.ReceiverPrefix {
+	var receiverPrefixes []types.ReceiverPrefix
+	k.IterateReceiverPrefixes(ctx, func(receiverPrefix types.ReceiverPrefix) bool {
+		receiverPrefixes = append(receiverPrefixes, receiverPrefix)
+		return false
+	})
+
+	return receiverPrefixes
+}
+
+// IterateReceiverPrefixes iterates over the receiver prefixes in the store
+// and performs a callback function.
+func (k Keeper) IterateReceiverPrefixes(ctx sdk.Context, cb func(receiverPrefix types.ReceiverPrefix) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(fmt.Sprintf("%s/", types.KeyReceiverPrefixPrefix)))
+
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var receiverPrefix types.ReceiverPrefix
+		k.cdc.MustUnmarshal(iterator.Value(), &receiverPrefix)
+		if cb(receiverPrefix) {
+			break
+		}
+	}
+}
+
+// validateReceiverPrefix returns an error if a receiver prefix is stored for the provided channel
+// and the receiver is not a bech32 address with that prefix. Transfers over channels without a
+// receiver prefix are not checked.
+func (k Keeper) validateReceiverPrefix(ctx sdk.Context, channelID, receiver string) error {
+	receiverPrefix, found := k.GetChannelReceiverPrefix(ctx, channelID)
+	if !found {
+		return nil
+	}
+
+	prefix, err := types.Bech32Prefix(receiver)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrReceiverPrefixMismatch, "receiver %s is not a bech32 address with prefix %s expected on channel %s: %v", receiver, receiverPrefix.Prefix, channelID, err)
+	}
+
+	if prefix != receiverPrefix.Prefix {
+		return errorsmod.Wrapf(types.ErrReceiverPrefixMismatch, "receiver prefix %s does not match prefix %s expected on channel %s", prefix, receiverPrefix.Prefix, channelID)
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// TestTransferReceiverPrefix tests that transfers to receivers whose bech32 prefix does not match the
+// receiver prefix of the source channel are rejected, unless the receiver check is explicitly skipped.
+func (suite *KeeperTestSuite) TestTransferReceiverPrefix() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgTransfer
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: no receiver prefix stored for channel",
+			func() {},
+			nil,
+		},
+		{
+			"success: receiver prefix matches",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiverPrefix(suite.chainA.GetContext(), types.NewReceiverPrefix(path.EndpointA.ChannelID, sdk.GetConfig().GetBech32AccountAddrPrefix()))
+			},
+			nil,
+		},
+		{
+			"success: receiver prefix of another channel is not checked",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiverPrefix(suite.chainA.GetContext(), types.NewReceiverPrefix("channel-100", "osmo"))
+			},
+			nil,
+		},
+		{
+			"success: receiver prefix mismatch with unsafe receiver",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiverPrefix(suite.chainA.GetContext(), types.NewReceiverPrefix(path.EndpointA.ChannelID, "osmo"))
+				msg.UnsafeReceiver = true
+			},
+			nil,
+		},
+		{
+			"success: non bech32 receiver with unsafe receiver",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiverPrefix(suite.chainA.GetContext(), types.NewReceiverPrefix(path.EndpointA.ChannelID, "osmo"))
+				msg.Receiver = "0x1234"
+				msg.UnsafeReceiver = true
+			},
+			nil,
+		},
+		{
+			"failure: receiver prefix mismatch",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiverPrefix(suite.chainA.GetContext(), types.NewReceiverPrefix(path.EndpointA.ChannelID, "osmo"))
+			},
+			types.ErrReceiverPrefixMismatch,
+		},
+		{
+			"failure: receiver is not a bech32 address",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelReceiverPrefix(suite.chainA.GetContext(), types.NewReceiverPrefix(path.EndpointA.ChannelID, sdk.GetConfig().GetBech32AccountAddrPrefix()))
+				msg.Receiver = "0x1234"
+			},
+			types.ErrReceiverPrefixMismatch,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			msg = types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)),
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, "",
+			)
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// TestReceiverPrefixNotInferred tests that no receiver prefix is stored for a channel when a packet is
+// received, since the sender of a packet is chosen by the counterparty and cannot be trusted.
+func (suite *KeeperTestSuite) TestReceiverPrefixNotInferred() {
+	suite.SetupTest()
+
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	sender := sdk.MustBech32ifyAddressBytes("osmo", suite.chainB.SenderAccount.GetAddress())
+	data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "100", sender, suite.chainA.SenderAccount.GetAddress().String(), "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.GetTimeoutHeight(), 0)
+
+	_, _, err := suite.chainA.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainA.GetContext(), packet, data)
+	suite.Require().NoError(err)
+
+	_, found := suite.chainA.GetSimApp().TransferKeeper.GetChannelReceiverPrefix(suite.chainA.GetContext(), path.EndpointA.ChannelID)
+	suite.Require().False(found)
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"errors"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+const aliasReceiver = "alias:receiver"
+
+var errUnknownAlias = errors.New("unknown alias")
+
+var _ types.ReceiverResolver = (*aliasResolver)(nil)
+
+// aliasResolver resolves aliasReceiver to a fixed account and rejects any other receiver
+// with the alias prefix. All other receivers are decoded as bech32 addresses.
+type aliasResolver struct {
+	account sdk.AccAddress
+}
+
+func (r aliasResolver) Resolve(ctx sdk.Context, packetReceiver string) (sdk.AccAddress, error) {
+	if packetReceiver == aliasReceiver {
+		return r.account, nil
+	}
+	if strings.HasPrefix(packetReceiver, "alias:") {
+		return nil, errUnknownAlias
+	}
+
+	return types.Bech32ReceiverResolver{}.Resolve(ctx, packetReceiver)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketReceiverResolver() {
+	var (
+		resolver    types.ReceiverResolver
+		receiver    string
+		expReceiver sdk.AccAddress
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: alias resolved to account",
+			func() {},
+			nil,
+		},
+		{
+			"success: bech32 receiver is not aliased",
+			func() {
+				receiver = suite.chainB.SenderAccount.GetAddress().String()
+				expReceiver = suite.chainB.SenderAccount.GetAddress()
+			},
+			nil,
+		},
+		{
+			"failure: resolver returns an error",
+			func() {
+				receiver = "alias:unknown"
+			},
+			errUnknownAlias,
+		},
+		{
+			"failure: resolved account is not allowed to receive funds",
+			func() {
+				resolver = aliasResolver{account: suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName)}
+			},
+			types.ErrBlockedAddress,
+		},
+		{
+			"success: nil resolver restores the default resolver",
+			func() {
+				resolver = nil
+				receiver = suite.chainB.SenderAccount.GetAddress().String()
+				expReceiver = suite.chainB.SenderAccount.GetAddress()
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			expReceiver = suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+			resolver = aliasResolver{account: expReceiver}
+			receiver = aliasReceiver
+
+			tc.malleate()
+
+			// the resolver is set on a copy of the keeper of chainB
+			transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+			transferKeeper.WithReceiverResolver(resolver)
+
+			amount := sdkmath.NewInt(100)
+			data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver, "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom)).IBCDenom()
+
+			ctx := suite.chainB.GetContext()
+			_, resolvedReceiver, err := transferKeeper.OnRecvPacket(ctx, packet, data)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expReceiver, resolvedReceiver)
+
+				balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, expReceiver, voucherDenom)
+				suite.Require().Equal(amount, balance.Amount)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// TestTransferRefundAddress tests that a refund address provided in MsgTransfer is validated
+// and included in the packet data sent over channels using the structured acknowledgement transfer version.
+func (suite *KeeperTestSuite) TestTransferRefundAddress() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgTransfer
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: refund address provided",
+			func() {},
+			nil,
+		},
+		{
+			"success: no refund address provided",
+			func() {
+				msg.RefundAddress = ""
+			},
+			nil,
+		},
+		{
+			"failure: refund address is a blocked address",
+			func() {
+				msg.RefundAddress = suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: refund address on channel using the default transfer version",
+			func() {
+				path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				msg.SourceChannel = path.EndpointA.ChannelID
+			},
+			types.ErrInvalidVersion,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Version = types.VersionStructuredAck
+			path.EndpointB.ChannelConfig.Version = types.VersionStructuredAck
+			path.Setup()
+
+			msg = types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100)),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, "",
+			)
+			msg.RefundAddress = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				// the refund address is included in the packet data
+				sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](ctx.EventManager().Events().ToABCIEvents())
+				suite.Require().NotEmpty(sendPacketEvents)
+				packet := sendPacketEvents[0].Packet
+
+				var data types.FungibleTokenPacketData
+				suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+				suite.Require().Equal(msg.RefundAddress, data.RefundAddress)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// TestRefundToRefundAddress tests that tokens are refunded to the refund address of the packet data,
+// instead of the sender, when the transfer times out or is acknowledged with an error.
+func (suite *KeeperTestSuite) TestRefundToRefundAddress() {
+	testCases := []struct {
+		name   string
+		refund func(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error
+	}{
+		{
+			"refund on timeout",
+			func(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+				return suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(ctx, packet, data)
+			},
+		},
+		{
+			"refund on error acknowledgement",
+			func(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+				ack := channeltypes.NewErrorAcknowledgement(fmt.Errorf("failed packet transfer"))
+				return suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(ctx, packet, data, ack)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Version = types.VersionStructuredAck
+			path.EndpointB.ChannelConfig.Version = types.VersionStructuredAck
+			path.Setup()
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			refundAddress := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				coin, sender.String(), suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, "",
+			)
+			msg.RefundAddress = refundAddress.String()
+
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+			suite.Require().NotEmpty(sendPacketEvents)
+			packet := sendPacketEvents[0].Packet
+
+			var data types.FungibleTokenPacketData
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+
+			ctx := suite.chainA.GetContext()
+			preSenderCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, coin.Denom)
+			preRefundCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, refundAddress, coin.Denom)
+
+			err = tc.refund(ctx, packet, data)
+			suite.Require().NoError(err)
+
+			postSenderCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, coin.Denom)
+			postRefundCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, refundAddress, coin.Denom)
+
+			suite.Require().Equal(preSenderCoin, postSenderCoin, "refund was sent to the sender")
+			suite.Require().Equal(coin.Amount, postRefundCoin.Amount.Sub(preRefundCoin.Amount), "refund was not sent to the refund address")
+		})
+	}
+}
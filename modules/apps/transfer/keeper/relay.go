@@ -31,7 +31,9 @@ import (
 // on the sender chain and then transferred to the receiving chain though IBC
 // TAO logic. It is expected that the receiving chain, which had previously
 // sent the original denomination, will unescrow the fungible token and send
-// it to the receiving address.
+// it to the receiving address. If an outbound voucher tax is configured, the
+// tax is sent to the tax collector first and only the remainder is burned and
+// transferred.
 //
 // Another way of thinking of source and sink zones is through the token's
 // timeline. Each send to any chain other than the one it was previously
@@ -52,6 +54,12 @@ import (
 // 4. A -> C : sender chain is sink zone. Denom upon receiving: 'C/B/denom'
 // 5. C -> B : sender chain is sink zone. Denom upon receiving: 'B/denom'
 // 6. B -> A : sender chain is sink zone. Denom upon receiving: 'denom'
+//
+// If a refund address is provided, it is included in the packet data and receives the
+// refund instead of the sender if the transfer times out or fails. Refund addresses are
+// only supported on channels using the structured acknowledgement transfer version.
+//
+// If memo namespaces are registered on the keeper, the memo must only use registered namespaces.
 func (k Keeper) sendTransfer(
 	ctx sdk.Context,
 	sourcePort,
@@ -62,12 +70,23 @@ func (k Keeper) sendTransfer(
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
 	memo string,
+	refundAddress string,
 ) (uint64, error) {
 	channel, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
 		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
 
+	if err := k.validateMemoNamespaces(memo); err != nil {
+		return 0, err
+	}
+
+	if refundAddress != "" {
+		if version, found := k.ics4Wrapper.GetAppVersion(ctx, sourcePort, sourceChannel); !found || version != types.VersionStructuredAck {
+			return 0, errorsmod.Wrapf(types.ErrInvalidVersion, "refund address is only supported on channels using transfer version %s, got %s", types.VersionStructuredAck, version)
+		}
+	}
+
 	destinationPort := channel.Counterparty.PortId
 	destinationChannel := channel.Counterparty.ChannelId
 
@@ -92,6 +111,25 @@ func (k Keeper) sendTransfer(
 		}
 	}
 
+	// deduct the transfer fee from the transfer amount, only the remainder is sent
+	token, err = k.collectTransferFee(ctx, sender, token)
+	if err != nil {
+		return 0, err
+	}
+
+	// take the outbound voucher tax off vouchers sent back towards their origin chain,
+	// only the remainder is counted against the quota, burned and sent in the packet
+	if !types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath) {
+		token, err = k.collectOutboundVoucherTax(ctx, sender, token)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if err := k.consumeQuota(ctx, sourceChannel, token); err != nil {
+		return 0, err
+	}
+
 	labels := []metrics.Label{
 		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
 		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
@@ -104,8 +142,12 @@ func (k Keeper) sendTransfer(
 	if types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath) {
 		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "true"))
 
-		// obtain the escrow address for the source channel end
-		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+		// obtain the escrow address of the token for the source channel end and record it as its escrow account
+		escrowAddress, err := k.getEscrowAddress(ctx, sourcePort, sourceChannel, token.Denom)
+		if err != nil {
+			return 0, err
+		}
+
 		if err := k.escrowToken(ctx, sender, escrowAddress, token); err != nil {
 			return 0, err
 		}
@@ -130,9 +172,11 @@ func (k Keeper) sendTransfer(
 		}
 	}
 
+	// the denomination trace of tokens sent within a trusted mesh may be compacted, see WithTrustedMesh
 	packetData := types.NewFungibleTokenPacketData(
-		fullDenomPath, token.Amount.String(), sender.String(), receiver, memo,
+		k.compactDenomPath(ctx, sourcePort, sourceChannel, fullDenomPath), token.Amount.String(), sender.String(), receiver, memo,
 	)
+	packetData.RefundAddress = refundAddress
 
 	sequence, err := k.ics4Wrapper.SendPacket(ctx, channelCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData.GetBytes())
 	if err != nil {
@@ -162,27 +206,37 @@ func (k Keeper) sendTransfer(
 // sender chain is the source of minted tokens then vouchers will be minted
 // and sent to the receiving address. Otherwise if the sender chain is sending
 // back tokens this chain originally transferred to it, the tokens are
-// unescrowed and sent to the receiving address.
-func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
+// unescrowed and sent to the receiving address. The receiving address is
+// resolved from the packet receiver by the ReceiverResolver of the keeper.
+// If unwinding is enabled and the memo requests it, the received tokens are
+// sent on along the first hop of their denomination trace. Receiving fails with
+// ErrBlockedAddress if the receiver is not allowed to receive funds and with
+// ErrDenomBlocked if transfers of the received denomination are disabled in the
+// bank module. The tokens received and the resolved receiver are returned.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) (sdk.Coin, sdk.AccAddress, error) {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
-		return errorsmod.Wrapf(err, "error validating ICS-20 transfer packet data")
+		return sdk.Coin{}, nil, errorsmod.Wrapf(err, "error validating ICS-20 transfer packet data")
 	}
 
 	if !k.GetParams(ctx).ReceiveEnabled {
-		return types.ErrReceiveDisabled
+		return sdk.Coin{}, nil, types.ErrReceiveDisabled
 	}
 
-	// decode the receiver address
-	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	// resolve the receiver address
+	receiver, err := k.ResolveReceiver(ctx, data.Receiver)
 	if err != nil {
-		return errorsmod.Wrapf(err, "failed to decode receiver address: %s", data.Receiver)
+		return sdk.Coin{}, nil, err
+	}
+
+	if k.bankKeeper.BlockedAddr(receiver) {
+		return sdk.Coin{}, nil, errorsmod.Wrapf(types.ErrBlockedAddress, "%s is not allowed to receive funds", receiver)
 	}
 
 	// parse the transfer amount
 	transferAmount, ok := sdkmath.NewIntFromString(data.Amount)
 	if !ok {
-		return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", data.Amount)
+		return sdk.Coin{}, nil, errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount: %s", data.Amount)
 	}
 
 	labels := []metrics.Label{
@@ -202,7 +256,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 
 		// remove prefix added by sender chain
 		voucherPrefix := types.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
-		unprefixedDenom := data.Denom[len(voucherPrefix):]
+		unprefixedDenom := k.expandDenomPath(ctx, packet.GetDestPort(), packet.GetDestChannel(), data.Denom[len(voucherPrefix):], transferAmount)
 
 		// coin denomination used in sending from the escrow address
 		denom := unprefixedDenom
@@ -215,13 +269,19 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		}
 		token := sdk.NewCoin(denom, transferAmount)
 
-		if k.bankKeeper.BlockedAddr(receiver) {
-			return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+		if !k.bankKeeper.IsSendEnabledCoin(ctx, token) {
+			return sdk.Coin{}, nil, errorsmod.Wrapf(types.ErrDenomBlocked, "transfers of %s are disabled", token.Denom)
 		}
 
-		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		escrowAddress := k.getUnescrowAddress(ctx, packet.GetDestPort(), packet.GetDestChannel(), token.Denom)
 		if err := k.unescrowToken(ctx, escrowAddress, receiver, token); err != nil {
-			return err
+			return sdk.Coin{}, nil, err
+		}
+
+		k.creditQuota(ctx, packet.GetDestChannel(), token)
+
+		if err := k.unwindReceivedToken(ctx, data, receiver, denomTrace, token); err != nil {
+			return sdk.Coin{}, nil, err
 		}
 
 		defer func() {
@@ -242,7 +302,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			)
 		}()
 
-		return nil
+		return token, receiver, nil
 	}
 
 	// sender chain is the source, mint vouchers
@@ -253,12 +313,16 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	// construct the denomination trace from the full raw denomination
 	denomTrace := types.ParseDenomTrace(prefixedDenom)
 
+	voucherDenom := denomTrace.IBCDenom()
+	if !k.bankKeeper.IsSendEnabledCoin(ctx, sdk.NewCoin(voucherDenom, transferAmount)) {
+		return sdk.Coin{}, nil, errorsmod.Wrapf(types.ErrDenomBlocked, "transfers of %s are disabled", voucherDenom)
+	}
+
 	traceHash := denomTrace.Hash()
 	if !k.HasDenomTrace(ctx, traceHash) {
 		k.SetDenomTrace(ctx, denomTrace)
 	}
 
-	voucherDenom := denomTrace.IBCDenom()
 	if !k.bankKeeper.HasDenomMetaData(ctx, voucherDenom) {
 		k.setDenomMetadata(ctx, denomTrace)
 	}
@@ -268,6 +332,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			types.EventTypeDenomTrace,
 			sdk.NewAttribute(types.AttributeKeyTraceHash, traceHash.String()),
 			sdk.NewAttribute(types.AttributeKeyDenom, voucherDenom),
+			sdk.NewAttribute(types.AttributeKeyTrace, strings.Join(denomTrace.Hops(), ",")),
 		),
 	)
 	voucher := sdk.NewCoin(voucherDenom, transferAmount)
@@ -276,14 +341,20 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	if err := k.bankKeeper.MintCoins(
 		ctx, types.ModuleName, sdk.NewCoins(voucher),
 	); err != nil {
-		return errorsmod.Wrap(err, "failed to mint IBC tokens")
+		return sdk.Coin{}, nil, errorsmod.Wrap(err, "failed to mint IBC tokens")
 	}
 
 	// send to receiver
 	if err := k.bankKeeper.SendCoinsFromModuleToAccount(
 		ctx, types.ModuleName, receiver, sdk.NewCoins(voucher),
 	); err != nil {
-		return errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver.String())
+		return sdk.Coin{}, nil, errorsmod.Wrapf(err, "failed to send coins to receiver %s", receiver.String())
+	}
+
+	k.creditQuota(ctx, packet.GetDestChannel(), voucher)
+
+	if err := k.unwindReceivedToken(ctx, data, receiver, denomTrace, voucher); err != nil {
+		return sdk.Coin{}, nil, err
 	}
 
 	defer func() {
@@ -304,7 +375,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		)
 	}()
 
-	return nil
+	return voucher, receiver, nil
 }
 
 // OnAcknowledgementPacket responds to the success or failure of a packet
@@ -333,30 +404,36 @@ func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, dat
 // refundPacketToken will unescrow and send back the tokens back to sender
 // if the sending chain was the source chain. Otherwise, the sent tokens
 // were burnt in the original send so new tokens are minted and sent to
-// the sending address.
+// the sending address. The refunded tokens are credited back to the
+// transfer quota of the source channel.
 func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
 	// NOTE: packet data type already checked in handler.go
 
-	// parse the denomination from the full denom path
-	trace := types.ParseDenomTrace(data.Denom)
-
 	// parse the transfer amount
 	transferAmount, ok := sdkmath.NewIntFromString(data.Amount)
 	if !ok {
 		return errorsmod.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", data.Amount)
 	}
+
+	// parse the denomination from the full denom path
+	trace := types.ParseDenomTrace(k.expandDenomPath(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom, transferAmount))
 	token := sdk.NewCoin(trace.IBCDenom(), transferAmount)
 
-	// decode the sender address
-	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	// decode the refund receiver address
+	sender, err := k.GetRefundReceiver(ctx, data)
 	if err != nil {
 		return err
 	}
 
 	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
 		// unescrow tokens back to sender
-		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
-		return k.unescrowToken(ctx, escrowAddress, sender, token)
+		escrowAddress := k.getUnescrowAddress(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), token.Denom)
+		if err := k.unescrowToken(ctx, escrowAddress, sender, token); err != nil {
+			return err
+		}
+
+		k.creditQuota(ctx, packet.GetSourceChannel(), token)
+		return nil
 	}
 
 	// mint vouchers back to sender
@@ -370,9 +447,110 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 		panic(fmt.Errorf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
 	}
 
+	k.creditQuota(ctx, packet.GetSourceChannel(), token)
+
 	return nil
 }
 
+// GetRefundReceiver returns the address which receives the refund of the given packet data.
+// This is the refund address of the packet data if one was provided when sending the transfer.
+// Otherwise it is the packet sender, unless refunds to the source sender are enabled and the memo
+// contains a source sender which is a valid address on this chain that is allowed to receive funds.
+func (k Keeper) GetRefundReceiver(ctx sdk.Context, data types.FungibleTokenPacketData) (sdk.AccAddress, error) {
+	if data.RefundAddress != "" {
+		return sdk.AccAddressFromBech32(data.RefundAddress)
+	}
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	srcSender := data.GetSourceSender()
+	if !k.refundToSourceSender || srcSender == "" {
+		return sender, nil
+	}
+
+	// the source sender is only a hint, fallback to the packet sender if it cannot receive the refund
+	srcSenderAddr, err := sdk.AccAddressFromBech32(srcSender)
+	if err != nil || k.bankKeeper.BlockedAddr(srcSenderAddr) {
+		return sender, nil
+	}
+
+	return srcSenderAddr, nil
+}
+
+// collectOutboundVoucherTax sends the outbound voucher tax due on the provided voucher from the sender to the
+// tax collector and returns the remainder of the voucher. The voucher is returned unchanged if no tax is due.
+func (k Keeper) collectOutboundVoucherTax(ctx sdk.Context, sender sdk.AccAddress, voucher sdk.Coin) (sdk.Coin, error) {
+	params := k.GetParams(ctx)
+
+	tax := params.OutboundVoucherTax(voucher.Amount)
+	if !tax.IsPositive() {
+		return voucher, nil
+	}
+
+	taxCollector, err := sdk.AccAddressFromBech32(params.TaxCollector)
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidVoucherTax, "invalid tax collector address %s: %s", params.TaxCollector, err)
+	}
+
+	taxCoin := sdk.NewCoin(voucher.Denom, tax)
+	if err := k.bankKeeper.SendCoins(ctx, sender, taxCollector, sdk.NewCoins(taxCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoucherTax,
+			sdk.NewAttribute(types.AttributeKeyDenom, voucher.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, tax.String()),
+			sdk.NewAttribute(types.AttributeKeyTaxCollector, params.TaxCollector),
+		),
+	)
+
+	return voucher.Sub(taxCoin), nil
+}
+
+// collectTransferFee sends the transfer fee due on the provided token from the sender to the fee collector module
+// account and returns the remainder of the token. The token is returned unchanged if no fee is due. An error is
+// returned if the remainder of the token would be zero.
+func (k Keeper) collectTransferFee(ctx sdk.Context, sender sdk.AccAddress, token sdk.Coin) (sdk.Coin, error) {
+	transferFee := k.GetParams(ctx).TransferFee
+	if transferFee == nil {
+		return token, nil
+	}
+
+	fee := transferFee.Fee(token.Amount)
+	if !fee.IsPositive() {
+		return token, nil
+	}
+
+	if fee.GTE(token.Amount) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrTransferFeeExceedsAmount, "transfer amount %s does not exceed the transfer fee %s", token.Amount, fee)
+	}
+
+	if k.authKeeper.GetModuleAddress(transferFee.Collector) == nil {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidTransferFee, "fee collector module account %s does not exist", transferFee.Collector)
+	}
+
+	feeCoin := sdk.NewCoin(token.Denom, fee)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, transferFee.Collector, sdk.NewCoins(feeCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferFee,
+			sdk.NewAttribute(types.AttributeKeyDenom, token.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeCollector, transferFee.Collector),
+		),
+	)
+
+	return token.Sub(feeCoin), nil
+}
+
 // escrowToken will send the given token from the provided sender to the escrow address. It will also
 // update the total escrowed amount by adding the escrowed token to the current total escrow.
 func (k Keeper) escrowToken(ctx sdk.Context, sender, escrowAddress sdk.AccAddress, token sdk.Coin) error {
@@ -408,6 +586,14 @@ func (k Keeper) unescrowToken(ctx sdk.Context, escrowAddress, receiver sdk.AccAd
 	return nil
 }
 
+// GetEscrowAddressForDenom returns the escrow address of the provided channel in which tokens of the
+// provided denomination are escrowed. This is the escrow address of the denomination if tokens are escrowed per
+// denomination, otherwise it is the escrow address of the escrow class matching the denomination, or the default
+// escrow address of the channel if no escrow class matches the denomination.
+func (k Keeper) GetEscrowAddressForDenom(ctx sdk.Context, portID, channelID, denom string) sdk.AccAddress {
+	return k.GetParams(ctx).EscrowAddressForDenom(portID, channelID, denom)
+}
+
 // DenomPathFromHash returns the full denomination path prefix from an ibc denom with a hash
 // component.
 func (k Keeper) DenomPathFromHash(ctx sdk.Context, denom string) (string, error) {
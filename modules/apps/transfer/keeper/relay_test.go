@@ -7,9 +7,11 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -130,8 +132,9 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			result, err := suite.chainB.SendMsgs(transferMsg)
 			suite.Require().NoError(err) // message committed
 
-			packet, err := ibctesting.ParsePacketFromEvents(result.Events)
-			suite.Require().NoError(err)
+			sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](result.Events)
+			suite.Require().NotEmpty(sendPacketEvents)
+			packet := sendPacketEvents[0].Packet
 
 			err = path.RelayPacket(packet)
 			suite.Require().NoError(err)
@@ -214,8 +217,9 @@ func (suite *KeeperTestSuite) TestSendTransferSetsTotalEscrowAmountForSourceIBCT
 	result, err := suite.chainA.SendMsgs(transferMsg)
 	suite.Require().NoError(err) // message committed
 
-	packet, err := ibctesting.ParsePacketFromEvents(result.Events)
-	suite.Require().NoError(err)
+	sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](result.Events)
+	suite.Require().NotEmpty(sendPacketEvents)
+	packet := sendPacketEvents[0].Packet
 
 	err = path1.RelayPacket(packet)
 	suite.Require().NoError(err)
@@ -373,7 +377,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 					},
 				},
 				Base:    denomTraceOnB.IBCDenom(),
-				Display: denomTraceOnB.GetFullDenomPath(),
+				Display: denomTraceOnB.GetBaseDenom(),
 				Name:    fmt.Sprintf("%s IBC token", denomTraceOnB.GetFullDenomPath()),
 				Symbol:  strings.ToUpper(denomTraceOnB.GetBaseDenom()),
 			}
@@ -387,8 +391,9 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 				res, err := suite.chainB.SendMsgs(transferMsg)
 				suite.Require().NoError(err) // message committed
 
-				packet, err := ibctesting.ParsePacketFromEvents(res.Events)
-				suite.Require().NoError(err)
+				sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+				suite.Require().NotEmpty(sendPacketEvents)
+				packet := sendPacketEvents[0].Packet
 
 				err = path.RelayPacket(packet)
 				suite.Require().NoError(err) // relay committed
@@ -412,7 +417,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver, memo)
 			packet := channeltypes.NewPacket(data.GetBytes(), seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
 
-			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+			_, _, err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
 
 			// check total amount in escrow of received token denom on receiving chain
 			totalEscrow := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom)
@@ -521,7 +526,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacketSetsTotalEscrowAmountForSourceIBCT
 	suite.Require().Equal(sdkmath.NewInt(100), totalEscrowChainB.Amount)
 
 	// execute onRecvPacket, when chaninB receives the source token the escrow amount should decrease
-	err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+	_, _, err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
 	suite.Require().NoError(err)
 
 	// check total amount in escrow of sent token on receiving chain
@@ -529,6 +534,53 @@ func (suite *KeeperTestSuite) TestOnRecvPacketSetsTotalEscrowAmountForSourceIBCT
 	suite.Require().Equal(sdkmath.ZeroInt(), totalEscrowChainB.Amount)
 }
 
+// TestOnRecvPacketDenomMetadata tests that the denomination metadata of a voucher is set when the voucher is first
+// received, and that existing denomination metadata is never overwritten by subsequent transfers.
+func (suite *KeeperTestSuite) TestOnRecvPacketDenomMetadata() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	denomTraceOnB := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coin.Denom))
+
+	sendToChainB := func() {
+		msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+		res, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err)
+
+		sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+		suite.Require().NotEmpty(sendPacketEvents)
+		packet := sendPacketEvents[0].Packet
+
+		err = path.RelayPacket(packet)
+		suite.Require().NoError(err)
+	}
+
+	// the metadata is set on the first inbound transfer
+	sendToChainB()
+
+	denomMetadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), denomTraceOnB.IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(denomTraceOnB.IBCDenom(), denomMetadata.Base)
+	suite.Require().Equal(coin.Denom, denomMetadata.Display)
+	suite.Require().Equal(strings.ToUpper(coin.Denom), denomMetadata.Symbol)
+	suite.Require().Contains(denomMetadata.Description, denomTraceOnB.GetFullDenomPath())
+
+	// metadata updated after the first inbound transfer remains untouched by the second inbound transfer
+	denomMetadata.Description = "custom description"
+	denomMetadata.Display = denomTraceOnB.GetFullDenomPath()
+	suite.chainB.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainB.GetContext(), denomMetadata)
+
+	sendToChainB()
+
+	actualMetadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), denomTraceOnB.IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(denomMetadata, actualMetadata)
+	suite.Require().Equal(coin.Amount.MulRaw(2), suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), denomTraceOnB.IBCDenom()).Amount)
+}
+
 // TestOnAcknowledgementPacket tests that successful acknowledgement is a no-op
 // and failure acknowledment leads to refund when attempting to send from chainA
 // to chainB. If sender is source then the denomination being refunded has no
@@ -831,6 +883,94 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRefundToSourceSender() {
+	var (
+		memo                 string
+		refundToSourceSender bool
+		expRefundReceiver    sdk.AccAddress
+	)
+
+	srcSender := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success: refund sent to source sender",
+			func() {
+				expRefundReceiver = srcSender
+			},
+		},
+		{
+			"refund sent to sender: refund to source sender disabled",
+			func() {
+				refundToSourceSender = false
+			},
+		},
+		{
+			"refund sent to sender: no source sender in memo",
+			func() {
+				memo = ""
+			},
+		},
+		{
+			"refund sent to sender: invalid source sender",
+			func() {
+				memo = `{"src_sender": "invalid address"}`
+			},
+		},
+		{
+			"refund sent to sender: source sender is a blocked address",
+			func() {
+				memo = fmt.Sprintf(`{"src_sender": "%s"}`, suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			amount := sdkmath.NewInt(100)
+			memo = fmt.Sprintf(`{"src_sender": "%s"}`, srcSender)
+			refundToSourceSender = true
+			expRefundReceiver = sender
+
+			tc.malleate()
+
+			suite.chainA.GetSimApp().TransferKeeper.WithRefundToSourceSender(refundToSourceSender)
+
+			// funds the escrow account to have balance
+			escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+			suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, escrow, sdk.NewCoins(coin)))
+			suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
+
+			data := types.NewFungibleTokenPacketData(sdk.DefaultBondDenom, amount.String(), sender.String(), suite.chainB.SenderAccount.GetAddress().String(), memo)
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			refundReceiver, err := suite.chainA.GetSimApp().TransferKeeper.GetRefundReceiver(suite.chainA.GetContext(), data)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expRefundReceiver, refundReceiver)
+
+			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), expRefundReceiver, sdk.DefaultBondDenom)
+
+			err = suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+			suite.Require().NoError(err)
+
+			postCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), expRefundReceiver, sdk.DefaultBondDenom)
+			suite.Require().Equal(amount, postCoin.Amount.Sub(preCoin.Amount), "refund was not sent to the expected receiver")
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestOnTimeoutPacketSetsTotalEscrowAmountForSourceIBCToken() {
 	/*
 		Given the following flow of tokens:
@@ -913,3 +1053,446 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacketSetsTotalEscrowAmountForSourceI
 	totalEscrowChainB = suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), coin.GetDenom())
 	suite.Require().Equal(sdkmath.ZeroInt(), totalEscrowChainB.Amount)
 }
+
+func (suite *KeeperTestSuite) TestEscrowClasses() {
+	var path *ibctesting.Path
+
+	stableClass := types.EscrowClass{Name: "stable", DenomPatterns: []string{sdk.DefaultBondDenom}}
+	otherClass := types.EscrowClass{Name: "other", DenomPatterns: []string{"uatom", "ibc/*"}}
+
+	testCases := []struct {
+		name             string
+		sendClasses      []types.EscrowClass // escrow classes configured when the token is sent
+		returnClasses    []types.EscrowClass // escrow classes configured when the token is returned or refunded
+		expEscrowClass   string
+		expReturnAddress func() sdk.AccAddress
+	}{
+		{
+			"unmapped denomination uses default escrow address",
+			nil,
+			nil,
+			"",
+			func() sdk.AccAddress {
+				return types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			},
+		},
+		{
+			"unmapped denomination with escrow classes configured uses default escrow address",
+			[]types.EscrowClass{otherClass},
+			[]types.EscrowClass{otherClass},
+			"",
+			func() sdk.AccAddress {
+				return types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			},
+		},
+		{
+			"mapped denomination uses escrow address of escrow class",
+			[]types.EscrowClass{otherClass, stableClass},
+			[]types.EscrowClass{otherClass, stableClass},
+			stableClass.Name,
+			func() sdk.AccAddress {
+				return types.GetEscrowAddressForClass(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, stableClass.Name)
+			},
+		},
+		{
+			"denomination unmapped after escrow is returned from escrow address of escrow class",
+			[]types.EscrowClass{stableClass},
+			nil,
+			stableClass.Name,
+			func() sdk.AccAddress {
+				return types.GetEscrowAddressForClass(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, stableClass.Name)
+			},
+		},
+		{
+			"denomination mapped after escrow is returned from default escrow address",
+			nil,
+			[]types.EscrowClass{stableClass},
+			"",
+			func() sdk.AccAddress {
+				return types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		// sendToChainB sends coins from chainA to chainB with the escrow classes of the test case configured on chainA
+		sendToChainB := func(coin sdk.Coin) (types.FungibleTokenPacketData, channeltypes.Packet) {
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			params := transferKeeper.GetParams(suite.chainA.GetContext())
+			params.EscrowClasses = tc.sendClasses
+			transferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+			suite.Require().NotEmpty(sendPacketEvents)
+			packet := sendPacketEvents[0].Packet
+
+			var data types.FungibleTokenPacketData
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+
+			escrowAddress := types.GetEscrowAddressForClass(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, tc.expEscrowClass)
+			suite.Require().Equal(coin, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, coin.Denom))
+			suite.Require().Equal(escrowAddress, transferKeeper.GetEscrowAddressForDenom(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin.Denom))
+
+			escrowAccount, found := transferKeeper.GetEscrowAccount(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin.Denom)
+			suite.Require().True(found)
+			suite.Require().Equal(escrowAddress.String(), escrowAccount.Address)
+
+			params.EscrowClasses = tc.returnClasses
+			transferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			_, broken := keeper.TotalEscrowPerDenomInvariants(&transferKeeper)(suite.chainA.GetContext())
+			suite.Require().False(broken)
+
+			return data, packet
+		}
+
+		suite.Run(fmt.Sprintf("%s: receive", tc.name), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+			sendToChainB(coin)
+
+			// receive the token back on chainA
+			receiver := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			data := types.NewFungibleTokenPacketData(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom), coin.Amount.String(), suite.chainB.SenderAccount.GetAddress().String(), receiver.String(), "")
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.GetTimeoutHeight(), 0)
+
+			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, sdk.DefaultBondDenom)
+			_, _, err := suite.chainA.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainA.GetContext(), packet, data)
+			suite.Require().NoError(err)
+
+			postCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, sdk.DefaultBondDenom)
+			suite.Require().Equal(coin.Amount, postCoin.Amount.Sub(preCoin.Amount))
+			suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), tc.expReturnAddress(), sdk.DefaultBondDenom).IsZero())
+		})
+
+		suite.Run(fmt.Sprintf("%s: refund", tc.name), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+			data, packet := sendToChainB(coin)
+
+			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			err := suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+			suite.Require().NoError(err)
+
+			postCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			suite.Require().Equal(coin.Amount, postCoin.Amount.Sub(preCoin.Amount))
+			suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), tc.expReturnAddress(), sdk.DefaultBondDenom).IsZero())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEscrowPerDenom() {
+	var path *ibctesting.Path
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+	// sendToChainB sends coin from chainA to chainB with tokens escrowed per denomination on chainA
+	sendToChainB := func() (types.FungibleTokenPacketData, channeltypes.Packet) {
+		transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+		params := transferKeeper.GetParams(suite.chainA.GetContext())
+		params.EscrowPerDenom = true
+		transferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+		msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+		res, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err)
+
+		sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+		suite.Require().NotEmpty(sendPacketEvents)
+		packet := sendPacketEvents[0].Packet
+
+		var data types.FungibleTokenPacketData
+		suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+
+		escrowAddress := types.GetDenomEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin.Denom)
+		suite.Require().Equal(coin, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, coin.Denom))
+		suite.Require().Equal(escrowAddress, transferKeeper.GetEscrowAddressForDenom(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin.Denom))
+		suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), coin.Denom).IsZero())
+
+		res2, err := transferKeeper.TotalEscrowForDenom(suite.chainA.GetContext(), &types.QueryTotalEscrowForDenomRequest{Denom: coin.Denom})
+		suite.Require().NoError(err)
+		suite.Require().Equal(coin, res2.Amount)
+
+		_, broken := keeper.TotalEscrowPerDenomInvariants(&transferKeeper)(suite.chainA.GetContext())
+		suite.Require().False(broken)
+
+		return data, packet
+	}
+
+	// receivePacket returns the packet receiving coin back on chainA
+	receivePacket := func() (types.FungibleTokenPacketData, channeltypes.Packet) {
+		data := types.NewFungibleTokenPacketData(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coin.Denom), coin.Amount.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), "")
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.GetTimeoutHeight(), 0)
+		return data, packet
+	}
+
+	suite.Run("receive", func() {
+		suite.SetupTest() // reset
+
+		path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+		path.Setup()
+
+		sendToChainB()
+
+		receiver := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+		preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, coin.Denom)
+
+		data, packet := receivePacket()
+		_, _, err := suite.chainA.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainA.GetContext(), packet, data)
+		suite.Require().NoError(err)
+
+		postCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), receiver, coin.Denom)
+		suite.Require().Equal(coin.Amount, postCoin.Amount.Sub(preCoin.Amount))
+		suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), types.GetDenomEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin.Denom), coin.Denom).IsZero())
+		suite.Require().True(suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), coin.Denom).IsZero())
+	})
+
+	suite.Run("refund", func() {
+		suite.SetupTest() // reset
+
+		path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+		path.Setup()
+
+		data, packet := sendToChainB()
+
+		preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), coin.Denom)
+		err := suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+		suite.Require().NoError(err)
+
+		postCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), coin.Denom)
+		suite.Require().Equal(coin.Amount, postCoin.Amount.Sub(preCoin.Amount))
+		suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), types.GetDenomEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin.Denom), coin.Denom).IsZero())
+	})
+
+	suite.Run("tokens are unescrowed from the recorded escrow account after escrowing per denomination is enabled", func() {
+		suite.SetupTest() // reset
+
+		path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+		path.Setup()
+
+		// escrow the coin in the default escrow address
+		msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+		_, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err)
+
+		// enable escrowing per denomination without migrating the escrowed balances
+		transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+		params := transferKeeper.GetParams(suite.chainA.GetContext())
+		params.EscrowPerDenom = true
+		transferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+		data, packet := receivePacket()
+		_, _, err = transferKeeper.OnRecvPacket(suite.chainA.GetContext(), packet, data)
+		suite.Require().NoError(err)
+		suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), coin.Denom).IsZero())
+		suite.Require().True(transferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), coin.Denom).IsZero())
+	})
+}
+
+func (suite *KeeperTestSuite) TestTransferFee() {
+	var transferFee types.TransferFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		amount   sdkmath.Int
+		expFee   sdkmath.Int
+		expErr   error
+	}{
+		{
+			"success: flat fee",
+			func() {
+				transferFee = types.TransferFee{FlatAmount: sdkmath.NewInt(10), Collector: authtypes.FeeCollectorName}
+			},
+			sdkmath.NewInt(100),
+			sdkmath.NewInt(10),
+			nil,
+		},
+		{
+			"success: percentage fee",
+			func() {
+				transferFee = types.TransferFee{Bps: 100, Collector: authtypes.FeeCollectorName}
+			},
+			sdkmath.NewInt(1000),
+			sdkmath.NewInt(10),
+			nil,
+		},
+		{
+			"success: percentage fee rounded down to zero",
+			func() {
+				transferFee = types.TransferFee{Bps: 100, Collector: authtypes.FeeCollectorName}
+			},
+			sdkmath.NewInt(99),
+			sdkmath.ZeroInt(),
+			nil,
+		},
+		{
+			"failure: transfer too small to cover the flat fee",
+			func() {
+				transferFee = types.TransferFee{FlatAmount: sdkmath.NewInt(100), Collector: authtypes.FeeCollectorName}
+			},
+			sdkmath.NewInt(100),
+			sdkmath.ZeroInt(),
+			types.ErrTransferFeeExceedsAmount,
+		},
+		{
+			"failure: fee collector module account does not exist",
+			func() {
+				transferFee = types.TransferFee{FlatAmount: sdkmath.NewInt(10), Collector: "unknown"}
+			},
+			sdkmath.NewInt(100),
+			sdkmath.ZeroInt(),
+			types.ErrInvalidTransferFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			tc.malleate()
+
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			bankKeeper := suite.chainA.GetSimApp().BankKeeper
+			ctx := suite.chainA.GetContext()
+
+			params := transferKeeper.GetParams(ctx)
+			params.TransferFee = &transferFee
+			transferKeeper.SetParams(ctx, params)
+
+			sender := suite.chainA.SenderAccount.GetAddress()
+			collector := suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+			escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			preSenderBalance := bankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+			preCollectorBalance := bankKeeper.GetBalance(ctx, collector, sdk.DefaultBondDenom)
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				sdk.NewCoin(sdk.DefaultBondDenom, tc.amount), sender.String(), suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0, "",
+			)
+
+			res, err := transferKeeper.Transfer(ctx, msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				netAmount := tc.amount.Sub(tc.expFee)
+				suite.Require().Equal(netAmount, bankKeeper.GetBalance(ctx, escrowAddress, sdk.DefaultBondDenom).Amount)
+				suite.Require().Equal(netAmount, transferKeeper.GetTotalEscrowForDenom(ctx, sdk.DefaultBondDenom).Amount)
+				suite.Require().Equal(tc.expFee, bankKeeper.GetBalance(ctx, collector, sdk.DefaultBondDenom).Sub(preCollectorBalance).Amount)
+				suite.Require().Equal(tc.amount, preSenderBalance.Sub(bankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)).Amount)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+				suite.Require().True(bankKeeper.GetBalance(ctx, escrowAddress, sdk.DefaultBondDenom).IsZero())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOutboundVoucherTax() {
+	nativeCoin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+	testCases := []struct {
+		name          string
+		taxBps        uint32
+		voucherAmount sdkmath.Int
+		expTax        sdkmath.Int
+	}{
+		{"zero basis points preserves full amount", 0, sdkmath.NewInt(1000), sdkmath.ZeroInt()},
+		{"tax taken off voucher", 100, sdkmath.NewInt(1000), sdkmath.NewInt(10)},
+		{"tax rounded down", 150, sdkmath.NewInt(99), sdkmath.NewInt(1)},
+		{"tax rounded down to zero", 100, sdkmath.NewInt(99), sdkmath.ZeroInt()},
+		{"maximum tax", types.MaxOutboundVoucherTaxBps, sdkmath.NewInt(1000), sdkmath.NewInt(100)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// create vouchers on chainA
+			transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, tc.voucherAmount), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainA.GetTimeoutHeight(), 0, "")
+			result, err := suite.chainB.SendMsgs(transferMsg)
+			suite.Require().NoError(err)
+
+			sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](result.Events)
+			suite.Require().NotEmpty(sendPacketEvents)
+			packet := sendPacketEvents[0].Packet
+			suite.Require().NoError(path.RelayPacket(packet))
+
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+			params := transferKeeper.GetParams(suite.chainA.GetContext())
+			params.OutboundVoucherTaxBps = tc.taxBps
+			params.TaxCollector = ibctesting.TestAccAddress
+			transferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			taxCollector, err := sdk.AccAddressFromBech32(ibctesting.TestAccAddress)
+			suite.Require().NoError(err)
+
+			voucher := types.GetTransferCoin(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, tc.voucherAmount)
+			preVoucherSupply := bankKeeper.GetSupply(suite.chainA.GetContext(), voucher.Denom)
+
+			// send a native token and a voucher in the same transaction, only the voucher is taxed
+			sender, receiver := suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String()
+			result, err = suite.chainA.SendMsgs(
+				types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, nativeCoin, sender, receiver, suite.chainB.GetTimeoutHeight(), 0, ""),
+				types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, voucher, sender, receiver, suite.chainB.GetTimeoutHeight(), 0, ""),
+			)
+			suite.Require().NoError(err)
+
+			sendPacketEvents = ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](result.Events)
+			suite.Require().Len(sendPacketEvents, 2)
+			packets := []channeltypes.Packet{sendPacketEvents[0].Packet, sendPacketEvents[1].Packet}
+
+			var nativeData, voucherData types.FungibleTokenPacketData
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packets[0].GetData(), &nativeData))
+			suite.Require().NoError(types.ModuleCdc.UnmarshalJSON(packets[1].GetData(), &voucherData))
+
+			netAmount := tc.voucherAmount.Sub(tc.expTax)
+			suite.Require().Equal(nativeCoin.Amount.String(), nativeData.Amount)
+			suite.Require().Equal(netAmount.String(), voucherData.Amount)
+
+			suite.Require().Equal(tc.expTax.String(), bankKeeper.GetBalance(suite.chainA.GetContext(), taxCollector, voucher.Denom).Amount.String())
+			suite.Require().True(bankKeeper.GetBalance(suite.chainA.GetContext(), taxCollector, sdk.DefaultBondDenom).IsZero())
+			suite.Require().Equal(preVoucherSupply.Amount.Sub(netAmount).String(), bankKeeper.GetSupply(suite.chainA.GetContext(), voucher.Denom).Amount.String())
+
+			escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().Equal(nativeCoin, bankKeeper.GetBalance(suite.chainA.GetContext(), escrowAddress, sdk.DefaultBondDenom))
+
+			// the origin chain unescrows only the net amount
+			preCoin := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			suite.Require().NoError(path.RelayPacket(packets[1]))
+			postCoin := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			suite.Require().Equal(netAmount.String(), postCoin.Amount.Sub(preCoin.Amount).String())
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+)
+
+// WithMaxUnwindDepth enables the automatic unwinding of received tokens requested in the memo
+// of the packet data (see types.UnwindMemoKey) and limits the number of consecutive unwind hops
+// a memo may request. Passing zero disables unwinding, which is the default. Unwind memos are
+// ignored while unwinding is disabled.
+func (k *Keeper) WithMaxUnwindDepth(depth uint32) {
+	k.maxUnwindDepth = depth
+}
+
+// GetMaxUnwindDepth returns the maximum number of consecutive unwind hops a memo may request.
+func (k Keeper) GetMaxUnwindDepth() uint32 {
+	return k.maxUnwindDepth
+}
+
+// unwindReceivedToken sends the token just credited to the receiver back along the first hop of
+// its denomination trace if the packet memo requests it. The follow-on transfer is sent by the
+// receiver to the receiver of the unwind memo, using the next memo of the unwind memo as its memo and
+// timing out after the relative timeout of the unwind memo.
+// If the follow-on transfer fails on the counterparty or times out, the token is refunded to the
+// receiver. An error is returned if the token is native to this chain or the unwind memo requests
+// more consecutive unwind hops than allowed, which fails the receipt of the packet.
+func (k Keeper) unwindReceivedToken(ctx sdk.Context, data types.FungibleTokenPacketData, receiver sdk.AccAddress, denomTrace types.DenomTrace, token sdk.Coin) error {
+	if k.maxUnwindDepth == 0 {
+		return nil
+	}
+
+	unwindMemo, err := data.GetUnwindMemo()
+	if err != nil || unwindMemo == nil {
+		return err
+	}
+
+	depth, err := unwindMemo.UnwindDepth(k.maxUnwindDepth)
+	if err != nil {
+		return err
+	}
+
+	if depth > k.maxUnwindDepth {
+		return errorsmod.Wrapf(types.ErrInvalidMemo, "unwind depth exceeds maximum of %d", k.maxUnwindDepth)
+	}
+
+	if denomTrace.IsNativeDenom() {
+		return errorsmod.Wrapf(types.ErrInvalidMemo, "cannot unwind native denomination %s", denomTrace.BaseDenom)
+	}
+
+	// the first hop of the trace is the channel end on this chain over which the token was received from the previous chain
+	hop := strings.SplitN(denomTrace.Path, "/", 3)
+	relativeTimeout, err := unwindMemo.RelativeTimeout()
+	if err != nil {
+		return err
+	}
+
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(relativeTimeout).UnixNano())
+
+	msg := types.NewMsgTransfer(hop[0], hop[1], token, receiver.String(), unwindMemo.Receiver, clienttypes.ZeroHeight(), timeoutTimestamp, string(unwindMemo.Next))
+	if err := msg.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "invalid unwind transfer")
+	}
+
+	if _, err := k.Transfer(ctx, msg); err != nil {
+		return errorsmod.Wrap(err, "failed to unwind received tokens")
+	}
+
+	return nil
+}
@@ -0,0 +1,194 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *KeeperTestSuite) TestOnRecvPacketUnwind() {
+	var (
+		path        *ibctesting.Path
+		denom       string
+		memo        string
+		maxDepth    uint32
+		expUnwind   bool
+		expTimeout  time.Duration
+		expReceived sdk.Coin
+	)
+
+	// unwindMemo returns a memo which unwinds the received tokens to the sender account of chainA,
+	// followed by the provided next memo
+	unwindMemo := func(next string) string {
+		if next == "" {
+			return fmt.Sprintf(`{"unwind":{"receiver":"%s"}}`, suite.chainA.SenderAccount.GetAddress())
+		}
+		return fmt.Sprintf(`{"unwind":{"receiver":"%s","next":%s}}`, suite.chainA.SenderAccount.GetAddress(), next)
+	}
+
+	amount := sdkmath.NewInt(100)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: voucher unwound back to the origin chain",
+			func() {},
+			nil,
+		},
+		{
+			"success: unwind with timeout provided in the memo",
+			func() {
+				memo = fmt.Sprintf(`{"unwind":{"receiver":"%s","timeout":"1h"}}`, suite.chainA.SenderAccount.GetAddress())
+				expTimeout = time.Hour
+			},
+			nil,
+		},
+		{
+			"success: nested unwind within maximum depth",
+			func() {
+				maxDepth = 2
+				memo = unwindMemo(unwindMemo(""))
+			},
+			nil,
+		},
+		{
+			"success: unwind memo ignored when unwinding is disabled",
+			func() {
+				maxDepth = 0
+				expUnwind = false
+			},
+			nil,
+		},
+		{
+			"success: memo without unwind",
+			func() {
+				memo = `{"wasm":{}}`
+				expUnwind = false
+			},
+			nil,
+		},
+		{
+			"failure: unwind depth exceeds maximum",
+			func() {
+				// a memo bouncing the tokens back and forth is bounded by the maximum depth
+				memo = unwindMemo(unwindMemo(unwindMemo("")))
+				maxDepth = 2
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: invalid unwind timeout",
+			func() {
+				memo = fmt.Sprintf(`{"unwind":{"receiver":"%s","timeout":"0s"}}`, suite.chainA.SenderAccount.GetAddress())
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: empty unwind receiver",
+			func() {
+				memo = `{"unwind":{"receiver":""}}`
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: next memo is not a JSON object",
+			func() {
+				memo = unwindMemo(`"memo"`)
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: native denomination cannot be unwound",
+			func() {
+				// escrow tokens native to chainB by sending them to chainA
+				msg := types.NewMsgTransfer(
+					path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+					sdk.NewCoin(sdk.DefaultBondDenom, amount),
+					suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(),
+					suite.chainA.GetTimeoutHeight(), 0, "",
+				)
+				res, err := suite.chainB.SendMsgs(msg)
+				suite.Require().NoError(err)
+
+				sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+				suite.Require().NotEmpty(sendPacketEvents)
+				packet := sendPacketEvents[0].Packet
+				suite.Require().NoError(path.RelayPacket(packet))
+
+				// the vouchers are sent back to chainB, which unescrows its native tokens
+				denom = types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			},
+			types.ErrInvalidMemo,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			denom = sdk.DefaultBondDenom
+			memo = unwindMemo("")
+			maxDepth = 1
+			expUnwind = true
+			expTimeout = types.DefaultUnwindRelativeTimeout
+
+			tc.malleate()
+
+			// unwinding is enabled on a copy of the keeper of chainB
+			transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+			transferKeeper.WithMaxUnwindDepth(maxDepth)
+
+			data := types.NewFungibleTokenPacketData(denom, amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), memo)
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)).IBCDenom()
+			expReceived = sdk.NewCoin(voucherDenom, amount)
+
+			ctx := suite.chainB.GetContext()
+			channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+			sequence, found := channelKeeper.GetNextSequenceSend(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().True(found)
+
+			_, _, err := transferKeeper.OnRecvPacket(ctx, packet, data)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, suite.chainB.SenderAccount.GetAddress(), voucherDenom)
+				commitment := channelKeeper.GetPacketCommitment(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence)
+
+				if expUnwind {
+					// the vouchers are burned and sent back over the channel they were received on
+					suite.Require().True(balance.IsZero())
+					suite.Require().NotEmpty(commitment)
+
+					unwindPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](ctx.EventManager().Events().ToABCIEvents())
+					suite.Require().NotEmpty(unwindPacketEvents)
+					unwindPacket := unwindPacketEvents[0].Packet
+					suite.Require().Equal(uint64(ctx.BlockTime().Add(expTimeout).UnixNano()), unwindPacket.TimeoutTimestamp)
+				} else {
+					suite.Require().Equal(expReceived, balance)
+					suite.Require().Empty(commitment)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
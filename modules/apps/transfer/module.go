@@ -35,6 +35,7 @@ var (
 	_ module.HasServices         = (*AppModule)(nil)
 	_ module.HasProposalMsgs     = (*AppModule)(nil)
 	_ appmodule.AppModule        = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker    = (*AppModule)(nil)
 
 	_ porttypes.IBCModule = (*IBCModule)(nil)
 )
@@ -136,6 +137,14 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 4, m.MigrateDenomMetadata); err != nil {
 		panic(fmt.Errorf("failed to migrate transfer app from version 4 to 5 (set denom metadata migration): %v", err))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.MigrateEscrowClasses); err != nil {
+		panic(fmt.Errorf("failed to migrate transfer app from version 5 to 6 (escrow classes params migration): %v", err))
+	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.MigrateEscrowAccounts); err != nil {
+		panic(fmt.Errorf("failed to migrate transfer app from version 6 to 7 (escrow accounts migration): %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the ibc-transfer module. It returns
@@ -153,8 +162,14 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// EndBlock implements the AppModule interface. It resets the net outflow of transfer quotas whose epoch has elapsed.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	am.keeper.ResetElapsedQuotas(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion defining the current version of transfer.
-func (AppModule) ConsensusVersion() uint64 { return 5 }
+func (AppModule) ConsensusVersion() uint64 { return 7 }
 
 // AppModuleSimulation functions
 
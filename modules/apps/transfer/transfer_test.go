@@ -11,6 +11,7 @@ import (
 
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -56,8 +57,9 @@ func (suite *TransferTestSuite) TestHandleMsgTransfer() {
 	res, err := suite.chainA.SendMsgs(msg)
 	suite.Require().NoError(err) // message committed
 
-	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
-	suite.Require().NoError(err)
+	sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+	suite.Require().NotEmpty(sendPacketEvents)
+	packet := sendPacketEvents[0].Packet
 
 	// relay send
 	err = pathAtoB.RelayPacket(packet)
@@ -86,8 +88,9 @@ func (suite *TransferTestSuite) TestHandleMsgTransfer() {
 	res, err = suite.chainB.SendMsgs(msg)
 	suite.Require().NoError(err) // message committed
 
-	packet, err = ibctesting.ParsePacketFromEvents(res.Events)
-	suite.Require().NoError(err)
+	sendPacketEvents = ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+	suite.Require().NotEmpty(sendPacketEvents)
+	packet = sendPacketEvents[0].Packet
 
 	err = pathBtoC.RelayPacket(packet)
 	suite.Require().NoError(err) // relay committed
@@ -109,8 +112,9 @@ func (suite *TransferTestSuite) TestHandleMsgTransfer() {
 	res, err = suite.chainC.SendMsgs(msg)
 	suite.Require().NoError(err) // message committed
 
-	packet, err = ibctesting.ParsePacketFromEvents(res.Events)
-	suite.Require().NoError(err)
+	sendPacketEvents = ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+	suite.Require().NotEmpty(sendPacketEvents)
+	packet = sendPacketEvents[0].Packet
 
 	err = pathBtoC.RelayPacket(packet)
 	suite.Require().NoError(err) // relay committed
@@ -133,6 +137,137 @@ func (suite *TransferTestSuite) TestHandleMsgTransfer() {
 	suite.Require().Zero(balance.Amount.Int64())
 }
 
+// TestStructuredAcknowledgementResult tests that only channels upgraded to the structured acknowledgement transfer version
+// acknowledge successfully received packets with the received denomination and amount.
+func (suite *TransferTestSuite) TestStructuredAcknowledgementResult() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	timeoutHeight := clienttypes.NewHeight(1, 110)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+	sendAndRelay := func() (channeltypes.Packet, channeltypes.Acknowledgement) {
+		msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), timeoutHeight, 0, "")
+		res, err := suite.chainA.SendMsgs(msg)
+		suite.Require().NoError(err) // message committed
+
+		sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+		suite.Require().NotEmpty(sendPacketEvents)
+		packet := sendPacketEvents[0].Packet
+
+		_, ackBz, err := path.RelayPacketWithResults(packet)
+		suite.Require().NoError(err) // relay committed
+
+		var ack channeltypes.Acknowledgement
+		err = types.ModuleCdc.UnmarshalJSON(ackBz, &ack)
+		suite.Require().NoError(err)
+		suite.Require().True(ack.Success())
+
+		return packet, ack
+	}
+
+	// channels using the default transfer version acknowledge with an opaque result
+	_, ack := sendAndRelay()
+	suite.Require().Equal([]byte{byte(1)}, ack.GetResult())
+
+	_, err := types.UnmarshalAcknowledgementResult(ack.GetResult())
+	suite.Require().Error(err)
+
+	// upgrade the channel to the structured acknowledgement transfer version
+	path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = types.VersionStructuredAck
+	path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = types.VersionStructuredAck
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+
+	suite.Require().Equal(types.VersionStructuredAck, path.EndpointA.GetChannel().Version)
+	suite.Require().Equal(types.VersionStructuredAck, path.EndpointB.GetChannel().Version)
+
+	// channels using the structured acknowledgement transfer version acknowledge with the received denomination and amount
+	packet, ack := sendAndRelay()
+
+	ackResult, err := types.UnmarshalAcknowledgementResult(ack.GetResult())
+	suite.Require().NoError(err)
+
+	expDenom := types.ParseDenomTrace(types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom)).IBCDenom()
+	suite.Require().Equal(types.NewAcknowledgementResult(expDenom, coin.Amount.String()), ackResult)
+
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), ackResult.Denom)
+	suite.Require().Equal(coin.Amount.MulRaw(2), balance.Amount)
+}
+
+// TestErrorAcknowledgementWithCode tests that failed transfers are acknowledged with an error
+// acknowledgement from which the counterparty can obtain the module and code of the error.
+func (suite *TransferTestSuite) TestErrorAcknowledgementWithCode() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.Params{SendEnabled: true, ReceiveEnabled: false})
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 110), 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+	suite.Require().NotEmpty(sendPacketEvents)
+	packet := sendPacketEvents[0].Packet
+
+	_, ackBz, err := path.RelayPacketWithResults(packet)
+	suite.Require().NoError(err) // relay committed, the sender has been refunded
+
+	var ack channeltypes.Acknowledgement
+	err = types.ModuleCdc.UnmarshalJSON(ackBz, &ack)
+	suite.Require().NoError(err)
+	suite.Require().False(ack.Success())
+	suite.Require().Equal(channeltypes.NewErrorAcknowledgementWithCode(types.ErrReceiveDisabled), ack)
+
+	errAck, err := channeltypes.ParseErrorAcknowledgement(ack)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ModuleName, errAck.Module)
+	suite.Require().Equal(types.ErrReceiveDisabled.ABCICode(), errAck.Code)
+	suite.Require().True(errAck.Redacted)
+	suite.Require().True(errAck.IsError(types.ErrReceiveDisabled))
+
+	escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrow, sdk.DefaultBondDenom).IsZero())
+}
+
+// TestRecvPacketFrozenClient tests that a transfer cannot be received once the client tracking the sending chain
+// has been frozen by misbehaviour, leaving the sent tokens in escrow on the sending chain.
+func (suite *TransferTestSuite) TestRecvPacketFrozenClient() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	msg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(1, 110), 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err) // message committed
+
+	sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+	suite.Require().NotEmpty(sendPacketEvents)
+	packet := sendPacketEvents[0].Packet
+
+	err = path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.SubmitMisbehaviour()
+	suite.Require().NoError(err)
+
+	// the packet commitment cannot be verified against a frozen client
+	err = path.EndpointB.RecvPacket(packet)
+	suite.Require().ErrorContains(err, clienttypes.ErrClientNotActive.Error())
+
+	escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Equal(coin, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), escrow, sdk.DefaultBondDenom))
+
+	voucherDenomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenomTrace.IBCDenom()).IsZero())
+}
+
 func TestTransferTestSuite(t *testing.T) {
 	testifysuite.Run(t, new(TransferTestSuite))
 }
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+// AcknowledgementResult defines the structured result of a successful acknowledgement
+// written by channels using the structured acknowledgement transfer version. It contains the denomination and
+// amount of the tokens received on the destination chain.
+type AcknowledgementResult struct {
+	// the denomination of the received tokens, either a native denom or an ibc/{hash} voucher denom
+	Denom string `json:"denom"`
+	// the amount of received tokens
+	Amount string `json:"amount"`
+}
+
+// NewAcknowledgementResult creates a new AcknowledgementResult instance
+func NewAcknowledgementResult(denom, amount string) AcknowledgementResult {
+	return AcknowledgementResult{
+		Denom:  denom,
+		Amount: amount,
+	}
+}
+
+// ValidateBasic performs a basic check of the acknowledgement result fields.
+func (ar AcknowledgementResult) ValidateBasic() error {
+	if strings.TrimSpace(ar.Denom) == "" {
+		return errorsmod.Wrap(ErrInvalidDenomForTransfer, "denom cannot be blank")
+	}
+
+	amount, ok := sdkmath.NewIntFromString(ar.Amount)
+	if !ok {
+		return errorsmod.Wrapf(ErrInvalidAmount, "unable to parse transfer amount (%s) into math.Int", ar.Amount)
+	}
+
+	if !amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "amount must be strictly positive: got %d", amount)
+	}
+
+	return nil
+}
+
+// GetBytes is a helper for serialising the acknowledgement result to bytes.
+func (ar AcknowledgementResult) GetBytes() []byte {
+	bz, err := json.Marshal(ar)
+	if err != nil {
+		panic(errors.New("cannot marshal AcknowledgementResult into bytes"))
+	}
+
+	return bz
+}
+
+// UnmarshalAcknowledgementResult attempts to unmarshal the result bytes of a successful
+// acknowledgement into an AcknowledgementResult. An error is returned if the result was
+// not written by a channel using the structured acknowledgement transfer version.
+func UnmarshalAcknowledgementResult(bz []byte) (AcknowledgementResult, error) {
+	var ackResult AcknowledgementResult
+	if err := json.Unmarshal(bz, &ackResult); err != nil {
+		return AcknowledgementResult{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal acknowledgement result: %s", err.Error())
+	}
+
+	if err := ackResult.ValidateBasic(); err != nil {
+		return AcknowledgementResult{}, err
+	}
+
+	return ackResult, nil
+}
@@ -0,0 +1,61 @@
+package types
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// MaxAckErrorLength is the maximum length in bytes of the error message of the error
+// acknowledgement written when receiving a packet fails.
+const MaxAckErrorLength = 1024
+
+// AckErrorFormatter formats the error message of the error acknowledgement written
+// when receiving a packet fails. Integrators may set a formatter on the transfer keeper,
+// e.g. to include a trace identifier in the message.
+//
+// FormatAckError is called in the state machine and must be deterministic, as
+// acknowledgements are written into state. Since error messages may change between
+// patch versions, formatters should not include err.Error() in the message.
+// Counterparty applications can only obtain the codespace and code of the error with
+// channeltypes.ParseErrorAcknowledgement if the message of the DefaultAckErrorFormatter
+// is returned unchanged.
+type AckErrorFormatter interface {
+	FormatAckError(ctx sdk.Context, packet channeltypes.Packet, err error) string
+}
+
+var _ AckErrorFormatter = (*DefaultAckErrorFormatter)(nil)
+
+// DefaultAckErrorFormatter is the default AckErrorFormatter of the transfer keeper.
+// It returns the redacted message holding the ABCI codespace and code of the error,
+// as constructed by channeltypes.NewErrorAcknowledgementWithCode.
+type DefaultAckErrorFormatter struct{}
+
+// FormatAckError implements the AckErrorFormatter interface.
+func (DefaultAckErrorFormatter) FormatAckError(_ sdk.Context, _ channeltypes.Packet, err error) string {
+	ack := channeltypes.NewErrorAcknowledgementWithCode(err)
+	return ack.GetError()
+}
+
+// NewFormattedErrorAcknowledgement returns an error acknowledgement holding the provided
+// formatted error message. Messages exceeding MaxAckErrorLength are truncated and empty
+// messages are replaced by the message of the DefaultAckErrorFormatter, so that the
+// returned acknowledgement is always valid.
+func NewFormattedErrorAcknowledgement(err error, message string) channeltypes.Acknowledgement {
+	if strings.TrimSpace(message) == "" {
+		return channeltypes.NewErrorAcknowledgementWithCode(err)
+	}
+
+	if len(message) > MaxAckErrorLength {
+		// drop a multi-byte character split by the truncation
+		message = strings.ToValidUTF8(message[:MaxAckErrorLength], "")
+	}
+
+	return channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{
+			Error: message,
+		},
+	}
+}
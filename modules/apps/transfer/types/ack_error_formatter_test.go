@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+func TestNewFormattedErrorAcknowledgement(t *testing.T) {
+	defaultAck := channeltypes.NewErrorAcknowledgementWithCode(ibcerrors.ErrInvalidAddress)
+
+	testCases := []struct {
+		name     string
+		message  string
+		expError string
+	}{
+		{"message is kept", "trace-id 1: failed", "trace-id 1: failed"},
+		{"message at maximum length", strings.Repeat("a", types.MaxAckErrorLength), strings.Repeat("a", types.MaxAckErrorLength)},
+		{"message exceeding maximum length is truncated", strings.Repeat("a", types.MaxAckErrorLength+1), strings.Repeat("a", types.MaxAckErrorLength)},
+		{"split multi-byte character is dropped", strings.Repeat("a", types.MaxAckErrorLength-1) + "é", strings.Repeat("a", types.MaxAckErrorLength-1)},
+		{"empty message is replaced by the default message", "", defaultAck.GetError()},
+		{"blank message is replaced by the default message", "  ", defaultAck.GetError()},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		ack := types.NewFormattedErrorAcknowledgement(ibcerrors.ErrInvalidAddress, tc.message)
+		require.NoError(t, ack.ValidateBasic(), tc.name)
+		require.Equal(t, tc.expError, ack.GetError(), tc.name)
+	}
+}
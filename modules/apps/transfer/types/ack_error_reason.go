@@ -0,0 +1,61 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// Enumerated reasons for which receiving a transfer fails. The reasons are derived from
+// the codespace and code of the error encoded in the error acknowledgement, and are
+// therefore deterministic.
+const (
+	AckErrorReasonBlockedAddress  = "blocked_address"
+	AckErrorReasonReceiveDisabled = "receive_disabled"
+	AckErrorReasonInvalidReceiver = "invalid_receiver"
+	AckErrorReasonDenomBlocked    = "denom_blocked"
+)
+
+// ackErrorReasons maps the errors with which receiving a transfer may fail onto their
+// enumerated reasons.
+var ackErrorReasons = []struct {
+	err    *errorsmod.Error
+	reason string
+}{
+	{ErrBlockedAddress, AckErrorReasonBlockedAddress},
+	{ErrReceiveDisabled, AckErrorReasonReceiveDisabled},
+	{ErrInvalidReceiver, AckErrorReasonInvalidReceiver},
+	{ErrDenomBlocked, AckErrorReasonDenomBlocked},
+}
+
+// GetAckErrorReason returns the enumerated reason of the error with which receiving a
+// transfer failed. An empty string is returned if the error has no enumerated reason.
+func GetAckErrorReason(err error) string {
+	for _, r := range ackErrorReasons {
+		if errorsmod.IsOf(err, r.err) {
+			return r.reason
+		}
+	}
+
+	return ""
+}
+
+// ParseAckErrorReason returns the enumerated reason for which the counterparty failed to
+// receive a transfer, obtained from the codespace and code held by the provided error
+// acknowledgement. An empty string is returned if the acknowledgement does not hold the
+// code of an error with an enumerated reason, e.g. if the counterparty formats error
+// acknowledgements with a custom AckErrorFormatter.
+func ParseAckErrorReason(ack channeltypes.Acknowledgement) string {
+	errAck, err := channeltypes.ParseErrorAcknowledgement(ack)
+	if err != nil {
+		return ""
+	}
+
+	for _, r := range ackErrorReasons {
+		if errAck.IsError(r.err) {
+			return r.reason
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+)
+
+func TestAckErrorReason(t *testing.T) {
+	testCases := []struct {
+		name      string
+		err       error
+		expReason string
+	}{
+		{"blocked address", errorsmod.Wrap(types.ErrBlockedAddress, "receiver"), types.AckErrorReasonBlockedAddress},
+		{"receive disabled", types.ErrReceiveDisabled, types.AckErrorReasonReceiveDisabled},
+		{"invalid receiver", errorsmod.Wrap(types.ErrInvalidReceiver, "receiver"), types.AckErrorReasonInvalidReceiver},
+		{"denom blocked", errorsmod.Wrap(types.ErrDenomBlocked, "denom"), types.AckErrorReasonDenomBlocked},
+		{"error without enumerated reason", ibcerrors.ErrInvalidAddress, ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		require.Equal(t, tc.expReason, types.GetAckErrorReason(tc.err), tc.name)
+
+		// the reason round trips through the error acknowledgement written by the default formatter
+		ack := channeltypes.NewErrorAcknowledgementWithCode(tc.err)
+		require.Equal(t, tc.expReason, types.ParseAckErrorReason(ack), tc.name)
+	}
+}
+
+func TestParseAckErrorReasonWithoutCodespace(t *testing.T) {
+	// acknowledgements which do not hold the codespace of the error have no reason
+	require.Empty(t, types.ParseAckErrorReason(channeltypes.NewErrorAcknowledgement(types.ErrReceiveDisabled)))
+	require.Empty(t, types.ParseAckErrorReason(types.NewFormattedErrorAcknowledgement(types.ErrReceiveDisabled, "receive disabled")))
+	require.Empty(t, types.ParseAckErrorReason(channeltypes.NewResultAcknowledgement([]byte{byte(1)})))
+}
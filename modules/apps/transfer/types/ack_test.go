@@ -0,0 +1,64 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+func TestUnmarshalAcknowledgementResult(t *testing.T) {
+	ibcDenom := types.ParseDenomTrace(denom).IBCDenom()
+
+	testCases := []struct {
+		name         string
+		result       []byte
+		expAckResult types.AcknowledgementResult
+		expPass      bool
+	}{
+		{
+			"success: structured acknowledgement result",
+			types.NewAcknowledgementResult(ibcDenom, amount).GetBytes(),
+			types.NewAcknowledgementResult(ibcDenom, amount),
+			true,
+		},
+		{
+			"success: large amount",
+			types.NewAcknowledgementResult(ibcDenom, largeAmount).GetBytes(),
+			types.NewAcknowledgementResult(ibcDenom, largeAmount),
+			true,
+		},
+		{
+			"failure: opaque acknowledgement result",
+			[]byte{byte(1)},
+			types.AcknowledgementResult{},
+			false,
+		},
+		{
+			"failure: empty denom",
+			types.NewAcknowledgementResult("", amount).GetBytes(),
+			types.AcknowledgementResult{},
+			false,
+		},
+		{
+			"failure: invalid amount",
+			types.NewAcknowledgementResult(ibcDenom, "-1").GetBytes(),
+			types.AcknowledgementResult{},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		ackResult, err := types.UnmarshalAcknowledgementResult(tc.result)
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expAckResult, ackResult, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -42,6 +42,16 @@ func (suite *TypesTestSuite) TestCodecTypeRegistration() {
 			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
 			true,
 		},
+		{
+			"success: MsgSetTransferQuota",
+			sdk.MsgTypeURL(&types.MsgSetTransferQuota{}),
+			true,
+		},
+		{
+			"success: MsgSetReceiverPrefix",
+			sdk.MsgTypeURL(&types.MsgSetReceiverPrefix{}),
+			true,
+		},
 		{
 			"success: TransferAuthorization",
 			sdk.MsgTypeURL(&types.TransferAuthorization{}),
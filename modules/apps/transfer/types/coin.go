@@ -47,3 +47,25 @@ func GetTransferCoin(portID, channelID, baseDenom string, amount sdkmath.Int) sd
 	denomTrace := ParseDenomTrace(GetPrefixedDenom(portID, channelID, baseDenom))
 	return sdk.NewCoin(denomTrace.IBCDenom(), amount)
 }
+
+// GetReceivedDenom returns the denomination of the tokens which are received on the destination
+// chain when a packet with the given denomination is sent from the source port and channel to
+// the destination port and channel.
+func GetReceivedDenom(sourcePort, sourceChannel, destPort, destChannel, denom string) string {
+	return GetReceivedDenomTrace(sourcePort, sourceChannel, destPort, destChannel, denom).IBCDenom()
+}
+
+// GetReceivedDenomTrace returns the denomination trace of the tokens which are received on the
+// destination chain when a packet with the given full denomination path is sent from the source
+// port and channel to the destination port and channel. If the tokens return to the chain they
+// originally came from, the prefix added by the sender chain is removed, otherwise the destination
+// port and channel are prefixed.
+func GetReceivedDenomTrace(sourcePort, sourceChannel, destPort, destChannel, denom string) DenomTrace {
+	if ReceiverChainIsSource(sourcePort, sourceChannel, denom) {
+		// remove prefix added by sender chain
+		unprefixedDenom := denom[len(GetDenomPrefix(sourcePort, sourceChannel)):]
+		return ParseDenomTrace(unprefixedDenom)
+	}
+
+	return ParseDenomTrace(GetPrefixedDenom(destPort, destChannel, denom))
+}
@@ -0,0 +1,174 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewCompactedDenom creates a new CompactedDenom instance.
+func NewCompactedDenom(portID, channelID, denom, fullDenomPath string) CompactedDenom {
+	return CompactedDenom{
+		PortId:        portID,
+		ChannelId:     channelID,
+		Denom:         denom,
+		FullDenomPath: fullDenomPath,
+	}
+}
+
+// Validate performs a basic validation of the CompactedDenom fields.
+func (cd CompactedDenom) Validate() error {
+	if err := host.PortIdentifierValidator(cd.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(cd.ChannelId); err != nil {
+		return errorsmod.Wrap(err, "invalid channel ID")
+	}
+	if err := ValidatePrefixedDenom(cd.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCompactedDenom, "invalid compacted denomination trace: %v", err)
+	}
+	if err := ValidatePrefixedDenom(cd.FullDenomPath); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCompactedDenom, "invalid full denomination trace: %v", err)
+	}
+	if cd.Denom == cd.FullDenomPath {
+		return errorsmod.Wrapf(ErrInvalidCompactedDenom, "compacted denomination trace must differ from the full denomination trace %s", cd.FullDenomPath)
+	}
+
+	return nil
+}
+
+// ValidateCompactedDenoms validates the provided compacted denominations and ensures there is at
+// most one full denomination trace per compacted denomination trace of a channel.
+func ValidateCompactedDenoms(compactedDenoms []CompactedDenom) error {
+	seen := make(map[string]bool)
+	for _, cd := range compactedDenoms {
+		if err := cd.Validate(); err != nil {
+			return err
+		}
+
+		key := string(CompactedDenomKey(cd.PortId, cd.ChannelId, cd.Denom))
+		if seen[key] {
+			return errorsmod.Wrapf(ErrInvalidCompactedDenom, "duplicate compacted denomination trace %s for channel %s/%s", cd.Denom, cd.PortId, cd.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// TrustedMeshChannel is a transfer channel end of a chain participating in a trusted mesh,
+// along with the chain ID of the chain on the other end of the channel.
+type TrustedMeshChannel struct {
+	ChainID             string
+	PortID              string
+	ChannelID           string
+	CounterpartyChainID string
+}
+
+// TrustedMesh is the set of transfer channel ends connecting the chains participating in a
+// trusted mesh. The chains of a trusted mesh treat vouchers whose denomination traces only
+// differ by hops going back and forth between two of its chains as the same tokens, which
+// allows the hops to be collapsed from the denomination traces sent to one another.
+type TrustedMesh []TrustedMeshChannel
+
+// Validate performs a basic validation of the channels of the TrustedMesh and ensures
+// every channel end is listed at most once.
+func (m TrustedMesh) Validate() error {
+	seen := make(map[string]bool)
+	for _, channel := range m {
+		if strings.TrimSpace(channel.ChainID) == "" || strings.TrimSpace(channel.CounterpartyChainID) == "" {
+			return errorsmod.Wrapf(ErrInvalidTrustedMesh, "chain IDs of channel %s/%s cannot be blank", channel.PortID, channel.ChannelID)
+		}
+		if channel.ChainID == channel.CounterpartyChainID {
+			return errorsmod.Wrapf(ErrInvalidTrustedMesh, "channel %s/%s of chain %s cannot connect the chain to itself", channel.PortID, channel.ChannelID, channel.ChainID)
+		}
+		if err := host.PortIdentifierValidator(channel.PortID); err != nil {
+			return errorsmod.Wrapf(ErrInvalidTrustedMesh, "invalid port ID: %v", err)
+		}
+		if err := host.ChannelIdentifierValidator(channel.ChannelID); err != nil {
+			return errorsmod.Wrapf(ErrInvalidTrustedMesh, "invalid channel ID: %v", err)
+		}
+
+		key := strings.Join([]string{channel.ChainID, channel.PortID, channel.ChannelID}, "/")
+		if seen[key] {
+			return errorsmod.Wrapf(ErrInvalidTrustedMesh, "duplicate channel %s/%s of chain %s", channel.PortID, channel.ChannelID, channel.ChainID)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// HasChannel returns true if the given channel end of the given chain participates in the TrustedMesh.
+func (m TrustedMesh) HasChannel(chainID, portID, channelID string) bool {
+	_, found := m.counterpartyChainID(chainID, portID, channelID)
+	return found
+}
+
+// counterpartyChainID returns the chain ID of the chain on the other end of the given channel end.
+func (m TrustedMesh) counterpartyChainID(chainID, portID, channelID string) (string, bool) {
+	for _, channel := range m {
+		if channel.ChainID == chainID && channel.PortID == portID && channel.ChannelID == channelID {
+			return channel.CounterpartyChainID, true
+		}
+	}
+
+	return "", false
+}
+
+// CompactDenomTrace collapses the redundant round-trip hops from the denomination trace of a
+// voucher held on the given chain. A round-trip hop is a pair of consecutive hops through which
+// the tokens left a chain of the mesh and came back to it, for example on chain A:
+//
+//	transfer/channel-1/transfer/channel-7/transfer/channel-0/uatom => transfer/channel-0/uatom
+//
+// if channel-1 of chain A connects to chain B and channel-7 of chain B connects to chain A.
+// The hops are walked from the given chain until a hop through a channel end not participating
+// in the mesh is reached, the remainder of the trace is left untouched.
+func (m TrustedMesh) CompactDenomTrace(chainID string, denomTrace DenomTrace) DenomTrace {
+	if denomTrace.Path == "" {
+		return denomTrace
+	}
+
+	type hop struct {
+		portID, channelID string
+		// chain the tokens were received on through the hop
+		chainID string
+	}
+
+	identifiers := strings.Split(denomTrace.Path, "/")
+
+	var (
+		hops []hop
+		i    int
+	)
+	for currentChainID := chainID; i+1 < len(identifiers); i += 2 {
+		portID, channelID := identifiers[i], identifiers[i+1]
+		counterpartyChainID, found := m.counterpartyChainID(currentChainID, portID, channelID)
+		if !found {
+			break
+		}
+
+		// the tokens went from the chain of the previous hop to this chain and straight back, drop both hops
+		if len(hops) > 0 && hops[len(hops)-1].chainID == counterpartyChainID {
+			hops = hops[:len(hops)-1]
+		} else {
+			hops = append(hops, hop{portID: portID, channelID: channelID, chainID: currentChainID})
+		}
+
+		currentChainID = counterpartyChainID
+	}
+
+	path := make([]string, 0, len(identifiers))
+	for _, h := range hops {
+		path = append(path, h.portID, h.channelID)
+	}
+	path = append(path, identifiers[i:]...)
+
+	return DenomTrace{
+		Path:      strings.Join(path, "/"),
+		BaseDenom: denomTrace.BaseDenom,
+	}
+}
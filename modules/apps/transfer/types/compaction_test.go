@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// mesh of chains A, B and C, where chainA and chainB are connected by two channels and chainB is connected to chainC
+var mesh = types.TrustedMesh{
+	{ChainID: "chainA", PortID: "transfer", ChannelID: "channel-0", CounterpartyChainID: "chainB"},
+	{ChainID: "chainA", PortID: "transfer", ChannelID: "channel-1", CounterpartyChainID: "chainB"},
+	{ChainID: "chainA", PortID: "transfer", ChannelID: "channel-2", CounterpartyChainID: "chainC"},
+	{ChainID: "chainB", PortID: "transfer", ChannelID: "channel-0", CounterpartyChainID: "chainA"},
+	{ChainID: "chainB", PortID: "transfer", ChannelID: "channel-1", CounterpartyChainID: "chainA"},
+	{ChainID: "chainB", PortID: "transfer", ChannelID: "channel-2", CounterpartyChainID: "chainC"},
+	{ChainID: "chainC", PortID: "transfer", ChannelID: "channel-0", CounterpartyChainID: "chainA"},
+}
+
+func TestCompactDenomTrace(t *testing.T) {
+	testCases := []struct {
+		name     string
+		denom    string
+		expDenom string
+	}{
+		{"base denom", "uatom", "uatom"},
+		{"single hop", "transfer/channel-0/uatom", "transfer/channel-0/uatom"},
+		{"round-trip hop", "transfer/channel-1/transfer/channel-0/uatom", "uatom"},
+		{"round-trip hop with remaining trace", "transfer/channel-1/transfer/channel-0/transfer/channel-2/uatom", "transfer/channel-2/uatom"},
+		{"nested round-trip hops", "transfer/channel-1/transfer/channel-0/transfer/channel-0/transfer/channel-1/uatom", "uatom"},
+		{"hops through distinct chains", "transfer/channel-0/transfer/channel-2/uatom", "transfer/channel-0/transfer/channel-2/uatom"},
+		{"hop outside of the mesh", "transfer/channel-5/transfer/channel-0/uatom", "transfer/channel-5/transfer/channel-0/uatom"},
+		{"round-trip hop beyond a hop outside of the mesh", "transfer/channel-2/transfer/channel-5/transfer/channel-1/transfer/channel-0/uatom", "transfer/channel-2/transfer/channel-5/transfer/channel-1/transfer/channel-0/uatom"},
+		{"base denom with '/'s", "transfer/channel-1/transfer/channel-0/gamm/pool/1", "gamm/pool/1"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		denomTrace := mesh.CompactDenomTrace("chainA", types.ParseDenomTrace(tc.denom))
+		require.Equal(t, tc.expDenom, denomTrace.GetFullDenomPath(), tc.name)
+	}
+}
+
+func TestCompactDenomTraceHashStability(t *testing.T) {
+	denomTrace := types.ParseDenomTrace("transfer/channel-1/transfer/channel-0/uatom")
+
+	// the trace is left untouched on a chain which does not participate in the mesh
+	require.Equal(t, denomTrace.IBCDenom(), mesh.CompactDenomTrace("chainD", denomTrace).IBCDenom())
+	require.Equal(t, denomTrace.IBCDenom(), types.TrustedMesh{}.CompactDenomTrace("chainA", denomTrace).IBCDenom())
+}
+
+func TestTrustedMeshValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mesh    types.TrustedMesh
+		expPass bool
+	}{
+		{"valid mesh", mesh, true},
+		{"empty mesh", types.TrustedMesh{}, true},
+		{"blank chain ID", types.TrustedMesh{{ChainID: "", PortID: "transfer", ChannelID: "channel-0", CounterpartyChainID: "chainB"}}, false},
+		{"blank counterparty chain ID", types.TrustedMesh{{ChainID: "chainA", PortID: "transfer", ChannelID: "channel-0", CounterpartyChainID: " "}}, false},
+		{"channel connecting chain to itself", types.TrustedMesh{{ChainID: "chainA", PortID: "transfer", ChannelID: "channel-0", CounterpartyChainID: "chainA"}}, false},
+		{"invalid port ID", types.TrustedMesh{{ChainID: "chainA", PortID: "(transfer)", ChannelID: "channel-0", CounterpartyChainID: "chainB"}}, false},
+		{"invalid channel ID", types.TrustedMesh{{ChainID: "chainA", PortID: "transfer", ChannelID: "channel", CounterpartyChainID: "chainB"}}, false},
+		{"duplicate channel", append(types.TrustedMesh{{ChainID: "chainA", PortID: "transfer", ChannelID: "channel-0", CounterpartyChainID: "chainC"}}, mesh...), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.mesh.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidTrustedMesh, tc.name)
+		}
+	}
+}
+
+func TestCompactedDenomValidate(t *testing.T) {
+	testCases := []struct {
+		name           string
+		compactedDenom types.CompactedDenom
+		expPass        bool
+	}{
+		{"valid compacted denom", types.NewCompactedDenom("transfer", "channel-2", "uatom", "transfer/channel-1/transfer/channel-0/uatom"), true},
+		{"invalid port ID", types.NewCompactedDenom("", "channel-2", "uatom", "transfer/channel-1/transfer/channel-0/uatom"), false},
+		{"invalid channel ID", types.NewCompactedDenom("transfer", "channel", "uatom", "transfer/channel-1/transfer/channel-0/uatom"), false},
+		{"blank compacted denom", types.NewCompactedDenom("transfer", "channel-2", "", "transfer/channel-1/transfer/channel-0/uatom"), false},
+		{"invalid full denom path", types.NewCompactedDenom("transfer", "channel-2", "uatom", "transfer/channel-1/transfer/channel-0/"), false},
+		{"compacted denom matches full denom path", types.NewCompactedDenom("transfer", "channel-2", "transfer/channel-0/uatom", "transfer/channel-0/uatom"), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.compactedDenom.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -6,14 +6,29 @@ import (
 
 // IBC transfer sentinel errors
 var (
-	ErrInvalidPacketTimeout    = errorsmod.Register(ModuleName, 2, "invalid packet timeout")
-	ErrInvalidDenomForTransfer = errorsmod.Register(ModuleName, 3, "invalid denomination for cross-chain transfer")
-	ErrInvalidVersion          = errorsmod.Register(ModuleName, 4, "invalid ICS20 version")
-	ErrInvalidAmount           = errorsmod.Register(ModuleName, 5, "invalid token amount")
-	ErrTraceNotFound           = errorsmod.Register(ModuleName, 6, "denomination trace not found")
-	ErrSendDisabled            = errorsmod.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
-	ErrReceiveDisabled         = errorsmod.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
-	ErrMaxTransferChannels     = errorsmod.Register(ModuleName, 9, "max transfer channels")
-	ErrInvalidAuthorization    = errorsmod.Register(ModuleName, 10, "invalid transfer authorization")
-	ErrInvalidMemo             = errorsmod.Register(ModuleName, 11, "invalid memo")
+	ErrInvalidPacketTimeout     = errorsmod.Register(ModuleName, 2, "invalid packet timeout")
+	ErrInvalidDenomForTransfer  = errorsmod.Register(ModuleName, 3, "invalid denomination for cross-chain transfer")
+	ErrInvalidVersion           = errorsmod.Register(ModuleName, 4, "invalid ICS20 version")
+	ErrInvalidAmount            = errorsmod.Register(ModuleName, 5, "invalid token amount")
+	ErrTraceNotFound            = errorsmod.Register(ModuleName, 6, "denomination trace not found")
+	ErrSendDisabled             = errorsmod.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
+	ErrReceiveDisabled          = errorsmod.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
+	ErrMaxTransferChannels      = errorsmod.Register(ModuleName, 9, "max transfer channels")
+	ErrInvalidAuthorization     = errorsmod.Register(ModuleName, 10, "invalid transfer authorization")
+	ErrInvalidMemo              = errorsmod.Register(ModuleName, 11, "invalid memo")
+	ErrInvalidQuota             = errorsmod.Register(ModuleName, 12, "invalid transfer quota")
+	ErrQuotaExceeded            = errorsmod.Register(ModuleName, 13, "transfer quota exceeded")
+	ErrInvalidEscrowClass       = errorsmod.Register(ModuleName, 14, "invalid escrow class")
+	ErrInvalidVoucherTax        = errorsmod.Register(ModuleName, 15, "invalid outbound voucher tax")
+	ErrInvalidReceiverPrefix    = errorsmod.Register(ModuleName, 16, "invalid receiver prefix")
+	ErrReceiverPrefixMismatch   = errorsmod.Register(ModuleName, 17, "receiver prefix mismatch")
+	ErrInvalidMemoNamespace     = errorsmod.Register(ModuleName, 18, "invalid memo namespace")
+	ErrInvalidTrustedMesh       = errorsmod.Register(ModuleName, 19, "invalid trusted mesh")
+	ErrInvalidCompactedDenom    = errorsmod.Register(ModuleName, 20, "invalid compacted denomination")
+	ErrInvalidTransferFee       = errorsmod.Register(ModuleName, 21, "invalid transfer fee")
+	ErrTransferFeeExceedsAmount = errorsmod.Register(ModuleName, 22, "transfer amount does not cover the transfer fee")
+	ErrBlockedAddress           = errorsmod.Register(ModuleName, 23, "address is not allowed to receive funds")
+	ErrInvalidReceiver          = errorsmod.Register(ModuleName, 24, "invalid receiver address")
+	ErrDenomBlocked             = errorsmod.Register(ModuleName, 25, "denomination is not allowed to be received")
+	ErrInvalidEscrowAccount     = errorsmod.Register(ModuleName, 26, "invalid escrow account")
 )
@@ -0,0 +1,56 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// NewEscrowAccount creates a new EscrowAccount instance.
+func NewEscrowAccount(portID, channelID, denom string, address sdk.AccAddress) EscrowAccount {
+	return EscrowAccount{
+		PortId:    portID,
+		ChannelId: channelID,
+		Denom:     denom,
+		Address:   address.String(),
+	}
+}
+
+// Validate performs a basic validation of the EscrowAccount fields.
+func (ea EscrowAccount) Validate() error {
+	if err := host.PortIdentifierValidator(ea.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(ea.ChannelId); err != nil {
+		return errorsmod.Wrap(err, "invalid channel ID")
+	}
+	if err := sdk.ValidateDenom(ea.Denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidEscrowAccount, "invalid denomination: %v", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(ea.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidEscrowAccount, "invalid address: %v", err)
+	}
+
+	return nil
+}
+
+// ValidateEscrowAccounts validates the provided escrow accounts and ensures there is at most one
+// escrow account per denomination of a channel.
+func ValidateEscrowAccounts(escrowAccounts []EscrowAccount) error {
+	seen := make(map[string]bool)
+	for _, ea := range escrowAccounts {
+		if err := ea.Validate(); err != nil {
+			return err
+		}
+
+		key := string(EscrowAccountKey(ea.PortId, ea.ChannelId, ea.Denom))
+		if seen[key] {
+			return errorsmod.Wrapf(ErrInvalidEscrowAccount, "duplicate escrow account for denomination %s of channel %s/%s", ea.Denom, ea.PortId, ea.ChannelId)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
@@ -7,16 +7,34 @@ const (
 	EventTypeTransfer     = "ibc_transfer"
 	EventTypeChannelClose = "channel_closed"
 	EventTypeDenomTrace   = "denomination_trace"
+	EventTypeCoinSplit    = "coin_split"
+	EventTypeQuotaUpdated = "transfer_quota_updated"
+	EventTypeVoucherTax   = "outbound_voucher_tax"
+	EventTypeTransferFee  = "outbound_transfer_fee"
 
-	AttributeKeyReceiver       = "receiver"
-	AttributeKeyDenom          = "denom"
-	AttributeKeyAmount         = "amount"
-	AttributeKeyRefundReceiver = "refund_receiver"
-	AttributeKeyRefundDenom    = "refund_denom"
-	AttributeKeyRefundAmount   = "refund_amount"
-	AttributeKeyAckSuccess     = "success"
-	AttributeKeyAck            = "acknowledgement"
-	AttributeKeyAckError       = "error"
-	AttributeKeyTraceHash      = "trace_hash"
-	AttributeKeyMemo           = "memo"
+	EventTypeReceiverPrefixUpdated = "receiver_prefix_updated"
+
+	AttributeKeyReceiver         = "receiver"
+	AttributeKeyResolvedReceiver = "resolved_receiver"
+	AttributeKeyDenom            = "denom"
+	AttributeKeyAmount           = "amount"
+	AttributeKeyRefundReceiver   = "refund_receiver"
+	AttributeKeyRefundDenom      = "refund_denom"
+	AttributeKeyRefundAmount     = "refund_amount"
+	AttributeKeyAckSuccess       = "success"
+	AttributeKeyAck              = "acknowledgement"
+	AttributeKeyAckError         = "error"
+	AttributeKeyAckErrorReason   = "error_reason"
+	AttributeKeyTraceHash        = "trace_hash"
+	AttributeKeyTrace            = "trace"
+	AttributeKeyMemo             = "memo"
+	AttributeKeySourceSender     = "src_sender"
+	AttributeKeyParts            = "parts"
+	AttributeKeyRemainder        = "remainder"
+	AttributeKeyChannelID        = "channel_id"
+	AttributeKeyMaxOutflow       = "max_outflow"
+	AttributeKeyEpochDuration    = "epoch_duration"
+	AttributeKeyTaxCollector     = "tax_collector"
+	AttributeKeyFeeCollector     = "fee_collector"
+	AttributeKeyPrefix           = "prefix"
 )
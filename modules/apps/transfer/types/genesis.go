@@ -7,22 +7,30 @@ import (
 )
 
 // NewGenesisState creates a new ibc-transfer GenesisState instance.
-func NewGenesisState(portID string, denomTraces Traces, params Params, totalEscrowed sdk.Coins) *GenesisState {
+func NewGenesisState(portID string, denomTraces Traces, params Params, totalEscrowed sdk.Coins, transferQuotas []TransferQuota, receiverPrefixes []ReceiverPrefix, compactedDenoms []CompactedDenom, escrowAccounts []EscrowAccount) *GenesisState {
 	return &GenesisState{
-		PortId:        portID,
-		DenomTraces:   denomTraces,
-		Params:        params,
-		TotalEscrowed: totalEscrowed,
+		PortId:           portID,
+		DenomTraces:      denomTraces,
+		Params:           params,
+		TotalEscrowed:    totalEscrowed,
+		TransferQuotas:   transferQuotas,
+		ReceiverPrefixes: receiverPrefixes,
+		CompactedDenoms:  compactedDenoms,
+		EscrowAccounts:   escrowAccounts,
 	}
 }
 
 // DefaultGenesisState returns a GenesisState with "transfer" as the default PortID.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		PortId:        PortID,
-		DenomTraces:   Traces{},
-		Params:        DefaultParams(),
-		TotalEscrowed: sdk.Coins{},
+		PortId:           PortID,
+		DenomTraces:      Traces{},
+		Params:           DefaultParams(),
+		TotalEscrowed:    sdk.Coins{},
+		TransferQuotas:   []TransferQuota{},
+		ReceiverPrefixes: []ReceiverPrefix{},
+		CompactedDenoms:  []CompactedDenom{},
+		EscrowAccounts:   []EscrowAccount{},
 	}
 }
 
@@ -35,5 +43,20 @@ func (gs GenesisState) Validate() error {
 	if err := gs.DenomTraces.Validate(); err != nil {
 		return err
 	}
-	return gs.TotalEscrowed.Validate() // will fail if there are duplicates for any denom
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.TotalEscrowed.Validate(); err != nil { // will fail if there are duplicates for any denom
+		return err
+	}
+	if err := ValidateTransferQuotas(gs.TransferQuotas); err != nil {
+		return err
+	}
+	if err := ValidateReceiverPrefixes(gs.ReceiverPrefixes); err != nil {
+		return err
+	}
+	if err := ValidateCompactedDenoms(gs.CompactedDenoms); err != nil {
+		return err
+	}
+	return ValidateEscrowAccounts(gs.EscrowAccounts)
 }
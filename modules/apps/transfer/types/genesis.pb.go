@@ -33,6 +33,15 @@ type GenesisState struct {
 	// total_escrowed contains the total amount of tokens escrowed
 	// by the transfer module
 	TotalEscrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_escrowed,json=totalEscrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed"`
+	// transfer_quotas contains the outflow quotas and their usage in the current epoch
+	TransferQuotas []TransferQuota `protobuf:"bytes,5,rep,name=transfer_quotas,json=transferQuotas,proto3" json:"transfer_quotas"`
+	// receiver_prefixes contains the expected bech32 prefixes of receiver addresses per channel
+	ReceiverPrefixes []ReceiverPrefix `protobuf:"bytes,6,rep,name=receiver_prefixes,json=receiverPrefixes,proto3" json:"receiver_prefixes"`
+	// compacted_denoms contains the full denomination traces of the tokens escrowed for transfers sent with a
+	// compacted denomination trace
+	CompactedDenoms []CompactedDenom `protobuf:"bytes,7,rep,name=compacted_denoms,json=compactedDenoms,proto3" json:"compacted_denoms"`
+	// escrow_accounts contains the escrow accounts holding the escrowed tokens of each channel and denomination
+	EscrowAccounts []EscrowAccount `protobuf:"bytes,8,rep,name=escrow_accounts,json=escrowAccounts,proto3" json:"escrow_accounts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -96,6 +105,34 @@ func (m *GenesisState) GetTotalEscrowed() github_com_cosmos_cosmos_sdk_types.Coi
 	return nil
 }
 
+func (m *GenesisState) GetTransferQuotas() []TransferQuota {
+	if m != nil {
+		return m.TransferQuotas
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReceiverPrefixes() []ReceiverPrefix {
+	if m != nil {
+		return m.ReceiverPrefixes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCompactedDenoms() []CompactedDenom {
+	if m != nil {
+		return m.CompactedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrowAccounts() []EscrowAccount {
+	if m != nil {
+		return m.EscrowAccounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v1.GenesisState")
 }
@@ -105,31 +142,38 @@ func init() {
 }
 
 var fileDescriptor_a4f788affd5bea89 = []byte{
-	// 378 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xb1, 0x8e, 0xd3, 0x40,
-	0x10, 0xb5, 0x93, 0xc8, 0x08, 0x27, 0xa4, 0xb0, 0x90, 0x30, 0x11, 0x72, 0x22, 0x44, 0x61, 0x81,
-	0xb2, 0x8b, 0x43, 0x01, 0xb5, 0x01, 0x21, 0x3a, 0x30, 0x54, 0x50, 0x44, 0xeb, 0xf5, 0x62, 0x56,
-	0xc4, 0x1e, 0x6b, 0x67, 0x63, 0x74, 0x7f, 0x71, 0xdf, 0x71, 0xdf, 0x70, 0x1f, 0x90, 0x32, 0xe5,
-	0x55, 0x77, 0xa7, 0xe4, 0x47, 0x4e, 0x5e, 0xfb, 0xa2, 0x48, 0x27, 0xa5, 0xf2, 0x8c, 0xe7, 0xbd,
-	0x37, 0xb3, 0xef, 0xb9, 0xaf, 0x65, 0xca, 0x29, 0xab, 0xaa, 0x95, 0xe4, 0x4c, 0x4b, 0x28, 0x91,
-	0x6a, 0xc5, 0x4a, 0xfc, 0x23, 0x14, 0xad, 0x23, 0x9a, 0x8b, 0x52, 0xa0, 0x44, 0x52, 0x29, 0xd0,
-	0xe0, 0xbd, 0x90, 0x29, 0x27, 0xc7, 0x58, 0x72, 0x8f, 0x25, 0x75, 0x34, 0x79, 0x73, 0x52, 0xe9,
-	0x80, 0x34, 0x52, 0x93, 0x80, 0x03, 0x16, 0x80, 0x34, 0x65, 0x28, 0x68, 0x1d, 0xa5, 0x42, 0xb3,
-	0x88, 0x72, 0x90, 0x65, 0x37, 0x7f, 0x9a, 0x43, 0x0e, 0xa6, 0xa4, 0x4d, 0xd5, 0xfe, 0x7d, 0x79,
-	0xd9, 0x73, 0x47, 0x5f, 0xda, 0x93, 0x7e, 0x68, 0xa6, 0x85, 0xf7, 0xcc, 0x7d, 0x54, 0x81, 0xd2,
-	0x4b, 0x99, 0xf9, 0xf6, 0xcc, 0x0e, 0x1f, 0x27, 0x4e, 0xd3, 0x7e, 0xcd, 0xbc, 0xdf, 0xee, 0x28,
-	0x13, 0x25, 0x14, 0x4b, 0xad, 0x18, 0x17, 0xe8, 0xf7, 0x66, 0xfd, 0x70, 0xb8, 0x08, 0xc9, 0xa9,
-	0x17, 0x90, 0x4f, 0x0d, 0xe3, 0x67, 0x43, 0x88, 0xc7, 0x9b, 0xeb, 0xa9, 0x75, 0x71, 0x33, 0x75,
-	0x4c, 0x8b, 0xc9, 0x30, 0x3b, 0xcc, 0xd0, 0x8b, 0x5d, 0xa7, 0x62, 0x8a, 0x15, 0xe8, 0xf7, 0x67,
-	0x76, 0x38, 0x5c, 0xbc, 0x3a, 0x2d, 0xfb, 0xcd, 0x60, 0xe3, 0x41, 0x23, 0x99, 0x74, 0x4c, 0x4f,
-	0xb9, 0x63, 0x0d, 0x9a, 0xad, 0x96, 0x02, 0xb9, 0x82, 0xff, 0x22, 0xf3, 0x07, 0xe6, 0xc4, 0xe7,
-	0xa4, 0x75, 0x86, 0x34, 0xce, 0x90, 0xce, 0x19, 0xf2, 0x11, 0x64, 0x19, 0xbf, 0xed, 0x6e, 0x0a,
-	0x73, 0xa9, 0xff, 0xae, 0x53, 0xc2, 0xa1, 0xa0, 0x9d, 0x8d, 0xed, 0x67, 0x8e, 0xd9, 0x3f, 0xaa,
-	0xcf, 0x2a, 0x81, 0x86, 0x80, 0xc9, 0x13, 0xb3, 0xe2, 0x73, 0xb7, 0x21, 0xfe, 0xbe, 0xd9, 0x05,
-	0xf6, 0x76, 0x17, 0xd8, 0xb7, 0xbb, 0xc0, 0x3e, 0xdf, 0x07, 0xd6, 0x76, 0x1f, 0x58, 0x57, 0xfb,
-	0xc0, 0xfa, 0xf5, 0xfe, 0xa1, 0xa4, 0x4c, 0xf9, 0x3c, 0x07, 0x5a, 0x7f, 0xa0, 0x05, 0x64, 0xeb,
-	0x95, 0xc0, 0x26, 0xdb, 0xa3, 0x4c, 0xcd, 0x9e, 0xd4, 0x31, 0xc1, 0xbc, 0xbb, 0x0b, 0x00, 0x00,
-	0xff, 0xff, 0xfe, 0xf0, 0xde, 0x54, 0x47, 0x02, 0x00, 0x00,
+	// 492 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd4, 0x30,
+	0x10, 0xdd, 0xd0, 0x36, 0x85, 0xb4, 0x6c, 0x4b, 0x84, 0x44, 0xa8, 0x50, 0xba, 0x42, 0x1c, 0x22,
+	0x4a, 0x6d, 0xb6, 0x1c, 0xe0, 0x4a, 0x0a, 0x42, 0xdc, 0xda, 0xd0, 0x53, 0x11, 0x8a, 0x1c, 0x67,
+	0x1a, 0x2c, 0x36, 0x71, 0xf0, 0x78, 0x03, 0xfc, 0x05, 0xdf, 0xc1, 0x99, 0x8f, 0xe8, 0xb1, 0x47,
+	0x4e, 0x80, 0x76, 0x7f, 0x04, 0xc5, 0xf1, 0x56, 0x5b, 0x21, 0x45, 0x3d, 0x65, 0x32, 0x9e, 0xf7,
+	0x66, 0xe6, 0x3d, 0x8d, 0xf7, 0x58, 0x64, 0x9c, 0xb2, 0xba, 0x9e, 0x08, 0xce, 0xb4, 0x90, 0x15,
+	0x52, 0xad, 0x58, 0x85, 0x67, 0xa0, 0x68, 0x33, 0xa6, 0x05, 0x54, 0x80, 0x02, 0x49, 0xad, 0xa4,
+	0x96, 0xfe, 0x03, 0x91, 0x71, 0xb2, 0x5c, 0x4b, 0x16, 0xb5, 0xa4, 0x19, 0xef, 0xec, 0xf5, 0x32,
+	0x5d, 0x56, 0x1a, 0xaa, 0x9d, 0x90, 0x4b, 0x2c, 0x25, 0xd2, 0x8c, 0x21, 0xd0, 0x66, 0x9c, 0x81,
+	0x66, 0x63, 0xca, 0xa5, 0xa8, 0xec, 0xfb, 0xdd, 0x42, 0x16, 0xd2, 0x84, 0xb4, 0x8d, 0xba, 0xec,
+	0xc3, 0x9f, 0x6b, 0xde, 0xe6, 0x9b, 0x6e, 0xa4, 0x77, 0x9a, 0x69, 0xf0, 0xef, 0x79, 0xeb, 0xb5,
+	0x54, 0x3a, 0x15, 0x79, 0xe0, 0x8c, 0x9c, 0xe8, 0x56, 0xe2, 0xb6, 0xbf, 0x6f, 0x73, 0xff, 0xbd,
+	0xb7, 0x99, 0x43, 0x25, 0xcb, 0x54, 0x2b, 0xc6, 0x01, 0x83, 0x1b, 0xa3, 0x95, 0x68, 0xe3, 0x20,
+	0x22, 0x7d, 0x1b, 0x90, 0x57, 0x2d, 0xe2, 0xa4, 0x05, 0xc4, 0xc3, 0xf3, 0xdf, 0xbb, 0x83, 0x1f,
+	0x7f, 0x76, 0x5d, 0xf3, 0x8b, 0xc9, 0x46, 0x7e, 0xf9, 0x86, 0x7e, 0xec, 0xb9, 0x35, 0x53, 0xac,
+	0xc4, 0x60, 0x65, 0xe4, 0x44, 0x1b, 0x07, 0x8f, 0xfa, 0x69, 0x8f, 0x4c, 0x6d, 0xbc, 0xda, 0x52,
+	0x26, 0x16, 0xe9, 0x2b, 0x6f, 0xa8, 0xa5, 0x66, 0x93, 0x14, 0x90, 0x2b, 0xf9, 0x05, 0xf2, 0x60,
+	0xd5, 0x8c, 0x78, 0x9f, 0x74, 0xca, 0x90, 0x56, 0x19, 0x62, 0x95, 0x21, 0x87, 0x52, 0x54, 0xf1,
+	0x53, 0x3b, 0x53, 0x54, 0x08, 0xfd, 0x71, 0x9a, 0x11, 0x2e, 0x4b, 0x6a, 0x65, 0xec, 0x3e, 0xfb,
+	0x98, 0x7f, 0xa2, 0xfa, 0x5b, 0x0d, 0x68, 0x00, 0x98, 0xdc, 0x36, 0x2d, 0x5e, 0xdb, 0x0e, 0xfe,
+	0xa9, 0xb7, 0xb5, 0x98, 0x2b, 0xfd, 0x3c, 0x95, 0x9a, 0x61, 0xb0, 0x66, 0x9a, 0xee, 0xf5, 0x2f,
+	0x70, 0x62, 0xe3, 0xe3, 0x16, 0x63, 0xf7, 0x18, 0xea, 0xe5, 0x24, 0xfa, 0xa9, 0x77, 0x47, 0x01,
+	0x07, 0xd1, 0x80, 0x4a, 0x6b, 0x05, 0x67, 0xe2, 0x2b, 0x60, 0xe0, 0x1a, 0xf6, 0x27, 0xfd, 0xec,
+	0x89, 0x85, 0x1d, 0x19, 0x94, 0xa5, 0xdf, 0x56, 0x57, 0xb2, 0x80, 0xfe, 0x07, 0x6f, 0x9b, 0xcb,
+	0xb2, 0x66, 0x5c, 0x43, 0x9e, 0x1a, 0x37, 0x30, 0x58, 0xbf, 0x0e, 0xff, 0xe1, 0x02, 0x65, 0xec,
+	0xb5, 0xfc, 0x5b, 0xfc, 0x4a, 0x16, 0x5b, 0x6d, 0x3a, 0x27, 0x52, 0xc6, 0xb9, 0x9c, 0x56, 0x1a,
+	0x83, 0x9b, 0xd7, 0xd1, 0xa6, 0x13, 0xf7, 0x65, 0x87, 0x59, 0x68, 0x03, 0xcb, 0x49, 0x8c, 0x8f,
+	0xcf, 0x67, 0xa1, 0x73, 0x31, 0x0b, 0x9d, 0xbf, 0xb3, 0xd0, 0xf9, 0x3e, 0x0f, 0x07, 0x17, 0xf3,
+	0x70, 0xf0, 0x6b, 0x1e, 0x0e, 0x4e, 0x9f, 0xff, 0x6f, 0xa5, 0xc8, 0xf8, 0x7e, 0x21, 0x69, 0xf3,
+	0x82, 0x96, 0x32, 0x9f, 0x4e, 0x00, 0xdb, 0x9b, 0x5a, 0xba, 0x25, 0xe3, 0x6f, 0xe6, 0x9a, 0x83,
+	0x78, 0xf6, 0x6f, 0x00, 0x03, 0x55, 0x23, 0x72, 0xbf, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -152,6 +196,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EscrowAccounts) > 0 {
+		for iNdEx := len(m.EscrowAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CompactedDenoms) > 0 {
+		for iNdEx := len(m.CompactedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CompactedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ReceiverPrefixes) > 0 {
+		for iNdEx := len(m.ReceiverPrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiverPrefixes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TransferQuotas) > 0 {
+		for iNdEx := len(m.TransferQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.TotalEscrowed) > 0 {
 		for iNdEx := len(m.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -235,6 +335,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TransferQuotas) > 0 {
+		for _, e := range m.TransferQuotas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReceiverPrefixes) > 0 {
+		for _, e := range m.ReceiverPrefixes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CompactedDenoms) > 0 {
+		for _, e := range m.CompactedDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EscrowAccounts) > 0 {
+		for _, e := range m.EscrowAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -406,6 +530,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferQuotas = append(m.TransferQuotas, TransferQuota{})
+			if err := m.TransferQuotas[len(m.TransferQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverPrefixes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverPrefixes = append(m.ReceiverPrefixes, ReceiverPrefix{})
+			if err := m.ReceiverPrefixes[len(m.ReceiverPrefixes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactedDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CompactedDenoms = append(m.CompactedDenoms, CompactedDenom{})
+			if err := m.CompactedDenoms[len(m.CompactedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAccounts = append(m.EscrowAccounts, EscrowAccount{})
+			if err := m.EscrowAccounts[len(m.EscrowAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,9 +2,12 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 )
 
@@ -26,6 +29,154 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid genesis with transfer quotas",
+			&types.GenesisState{
+				PortId: "portidone",
+				TransferQuotas: []types.TransferQuota{
+					types.NewTransferQuota("channel-0", "atom", sdkmath.NewInt(100), time.Hour, time.Unix(0, 0)),
+					types.NewTransferQuota("channel-1", "atom", sdkmath.NewInt(100), time.Hour, time.Unix(0, 0)),
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesis with invalid escrow class",
+			&types.GenesisState{
+				PortId: "portidone",
+				Params: types.Params{
+					EscrowClasses: []types.EscrowClass{{Name: "stable", DenomPatterns: []string{"*atom"}}},
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis with duplicate transfer quotas",
+			&types.GenesisState{
+				PortId: "portidone",
+				TransferQuotas: []types.TransferQuota{
+					types.NewTransferQuota("channel-0", "atom", sdkmath.NewInt(100), time.Hour, time.Unix(0, 0)),
+					types.NewTransferQuota("channel-0", "atom", sdkmath.NewInt(200), time.Hour, time.Unix(0, 0)),
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis with zero epoch duration transfer quota",
+			&types.GenesisState{
+				PortId: "portidone",
+				TransferQuotas: []types.TransferQuota{
+					types.NewTransferQuota("channel-0", "atom", sdkmath.NewInt(100), 0, time.Unix(0, 0)),
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis with zero max outflow transfer quota",
+			&types.GenesisState{
+				PortId: "portidone",
+				TransferQuotas: []types.TransferQuota{
+					types.NewTransferQuota("channel-0", "atom", sdkmath.ZeroInt(), time.Hour, time.Unix(0, 0)),
+				},
+			},
+			false,
+		},
+		{
+			"valid genesis with receiver prefixes",
+			&types.GenesisState{
+				PortId: "portidone",
+				ReceiverPrefixes: []types.ReceiverPrefix{
+					types.NewReceiverPrefix("channel-0", "osmo"),
+					types.NewReceiverPrefix("channel-1", "cosmos"),
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesis with duplicate receiver prefixes",
+			&types.GenesisState{
+				PortId: "portidone",
+				ReceiverPrefixes: []types.ReceiverPrefix{
+					types.NewReceiverPrefix("channel-0", "osmo"),
+					types.NewReceiverPrefix("channel-0", "cosmos"),
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis with uppercase receiver prefix",
+			&types.GenesisState{
+				PortId: "portidone",
+				ReceiverPrefixes: []types.ReceiverPrefix{
+					types.NewReceiverPrefix("channel-0", "OSMO"),
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis with empty receiver prefix",
+			&types.GenesisState{
+				PortId: "portidone",
+				ReceiverPrefixes: []types.ReceiverPrefix{
+					types.NewReceiverPrefix("channel-0", ""),
+				},
+			},
+			false,
+		},
+		{
+			"valid genesis with compacted denoms",
+			&types.GenesisState{
+				PortId: "portidone",
+				CompactedDenoms: []types.CompactedDenom{
+					types.NewCompactedDenom("transfer", "channel-0", "uatom", "transfer/channel-1/transfer/channel-2/uatom"),
+					types.NewCompactedDenom("transfer", "channel-1", "uatom", "transfer/channel-3/transfer/channel-2/uatom"),
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesis with duplicate compacted denoms",
+			&types.GenesisState{
+				PortId: "portidone",
+				CompactedDenoms: []types.CompactedDenom{
+					types.NewCompactedDenom("transfer", "channel-0", "uatom", "transfer/channel-1/transfer/channel-2/uatom"),
+					types.NewCompactedDenom("transfer", "channel-0", "uatom", "transfer/channel-3/transfer/channel-2/uatom"),
+				},
+			},
+			false,
+		},
+		{
+			"valid genesis with escrow accounts",
+			&types.GenesisState{
+				PortId: "portidone",
+				EscrowAccounts: []types.EscrowAccount{
+					types.NewEscrowAccount("transfer", "channel-0", "uatom", types.GetEscrowAddress("transfer", "channel-0")),
+					types.NewEscrowAccount("transfer", "channel-1", "uatom", types.GetEscrowAddressForClass("transfer", "channel-1", "atom")),
+				},
+			},
+			true,
+		},
+		{
+			"invalid genesis with duplicate escrow accounts",
+			&types.GenesisState{
+				PortId: "portidone",
+				EscrowAccounts: []types.EscrowAccount{
+					types.NewEscrowAccount("transfer", "channel-0", "uatom", types.GetEscrowAddress("transfer", "channel-0")),
+					types.NewEscrowAccount("transfer", "channel-0", "uatom", types.GetEscrowAddressForClass("transfer", "channel-0", "atom")),
+				},
+			},
+			false,
+		},
+		{
+			"invalid genesis with invalid escrow account address",
+			&types.GenesisState{
+				PortId: "portidone",
+				EscrowAccounts: []types.EscrowAccount{
+					{PortId: "transfer", ChannelId: "channel-0", Denom: "uatom", Address: "invalid"},
+				},
+			},
+			false,
+		},
 		{
 			"invalid client",
 			&types.GenesisState{
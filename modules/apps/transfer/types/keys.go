@@ -15,6 +15,11 @@ const (
 	// module supports
 	Version = "ics20-1"
 
+	// VersionStructuredAck defines the IBC transfer version which acknowledges successfully
+	// received packets with a structured acknowledgement result (see AcknowledgementResult).
+	// It is distinct from the upstream ics20-2 version, which uses a different packet format.
+	VersionStructuredAck = "ics20-1-structured-ack"
+
 	// PortID is the default port id that transfer module binds to
 	PortID = "transfer"
 
@@ -33,12 +38,31 @@ const (
 	// AllowAllPacketDataKeys holds the string key that allows all memo strings in authz transfer messages
 	AllowAllPacketDataKeys = "*"
 
+	// SourceSenderMemoKey holds the memo key under which the address of the account on whose
+	// behalf a transfer was sent may be provided
+	SourceSenderMemoKey = "src_sender"
+
+	// UnwindMemoKey holds the memo key under which the instructions to unwind the received
+	// tokens back towards their origin chain may be provided
+	UnwindMemoKey = "unwind"
+
 	KeyTotalEscrowPrefix = "totalEscrowForDenom"
 
+	KeyTransferQuotaPrefix = "transferQuota"
+
+	KeyReceiverPrefixPrefix = "receiverPrefix"
+
+	KeyCompactedDenomPrefix = "compactedDenom"
+
+	KeyEscrowAccountPrefix = "escrowAccount"
+
 	ParamsKey = "params"
 )
 
 var (
+	// SupportedVersions defines all versions that the IBC transfer module supports
+	SupportedVersions = []string{Version, VersionStructuredAck}
+
 	// PortKey defines the key to store the port ID in store
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
@@ -61,8 +85,71 @@ func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
 	return hash[:20]
 }
 
+// GetEscrowAddressForClass returns the escrow address of the provided escrow class for the specified channel.
+// The escrow address is derived like the default escrow address returned by GetEscrowAddress, with the name of
+// the escrow class appended to the port and channel identifiers. The default escrow address is returned if the
+// escrow class is empty.
+func GetEscrowAddressForClass(portID, channelID, escrowClass string) sdk.AccAddress {
+	if escrowClass == "" {
+		return GetEscrowAddress(portID, channelID)
+	}
+
+	// port and channel identifiers cannot contain slashes, the contents can therefore not collide with
+	// the contents used to derive the default escrow address of any channel
+	contents := fmt.Sprintf("%s/%s/%s", portID, channelID, escrowClass)
+
+	// ADR 028 AddressHash construction
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
+
+// GetDenomEscrowAddress returns the escrow address of the provided denomination for the specified channel,
+// used when tokens are escrowed per denomination. The escrow address is derived like the default escrow address
+// returned by GetEscrowAddress, with a "denom" separator and the denomination appended to the port and channel
+// identifiers.
+func GetDenomEscrowAddress(portID, channelID, denom string) sdk.AccAddress {
+	// escrow class names cannot contain slashes, the contents can therefore not collide with the contents used
+	// to derive the escrow address of any escrow class
+	contents := fmt.Sprintf("%s/%s/denom/%s", portID, channelID, denom)
+
+	// ADR 028 AddressHash construction
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
+
 // TotalEscrowForDenomKey returns the store key of under which the total amount of
 // source chain tokens in escrow is stored.
 func TotalEscrowForDenomKey(denom string) []byte {
 	return []byte(fmt.Sprintf("%s/%s", KeyTotalEscrowPrefix, denom))
 }
+
+// TransferQuotaKey returns the store key under which the transfer quota of the
+// provided channel and denomination is stored.
+func TransferQuotaKey(channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", KeyTransferQuotaPrefix, channelID, denom))
+}
+
+// ReceiverPrefixKey returns the store key under which the expected bech32 prefix of
+// receiver addresses of the provided channel is stored.
+func ReceiverPrefixKey(channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyReceiverPrefixPrefix, channelID))
+}
+
+// CompactedDenomKey returns the store key under which the full denomination trace of the tokens
+// escrowed on the provided channel for transfers sent with the provided compacted denomination
+// trace is stored.
+func CompactedDenomKey(portID, channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", KeyCompactedDenomPrefix, portID, channelID, denom))
+}
+
+// EscrowAccountKey returns the store key under which the escrow account holding the tokens of the
+// provided denomination escrowed on the provided channel is stored.
+func EscrowAccountKey(portID, channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", KeyEscrowAccountPrefix, portID, channelID, denom))
+}
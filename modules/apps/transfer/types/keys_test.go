@@ -22,3 +22,36 @@ func TestGetEscrowAddress(t *testing.T) {
 	escrow2 := types.GetEscrowAddress(port2, channel2)
 	require.NotEqual(t, escrow1, escrow2)
 }
+
+// Test that escrow addresses of escrow classes are separated from each other and from the default escrow address
+func TestGetEscrowAddressForClass(t *testing.T) {
+	var (
+		port    = "transfer"
+		channel = "channel-0"
+	)
+
+	require.Equal(t, types.GetEscrowAddress(port, channel), types.GetEscrowAddressForClass(port, channel, ""))
+
+	stableEscrow := types.GetEscrowAddressForClass(port, channel, "stable")
+	require.NotEqual(t, types.GetEscrowAddress(port, channel), stableEscrow)
+	require.NotEqual(t, types.GetEscrowAddressForClass(port, "channel-1", "stable"), stableEscrow)
+	require.NotEqual(t, types.GetEscrowAddressForClass(port, channel, "volatile"), stableEscrow)
+	require.Equal(t, stableEscrow, types.GetEscrowAddressForClass(port, channel, "stable"))
+}
+
+// Test that escrow addresses of denominations are separated from each other, from the default escrow address and
+// from the escrow addresses of escrow classes
+func TestGetDenomEscrowAddress(t *testing.T) {
+	var (
+		port    = "transfer"
+		channel = "channel-0"
+	)
+
+	atomEscrow := types.GetDenomEscrowAddress(port, channel, "uatom")
+	require.NotEqual(t, types.GetEscrowAddress(port, channel), atomEscrow)
+	require.NotEqual(t, types.GetEscrowAddressForClass(port, channel, "uatom"), atomEscrow)
+	require.NotEqual(t, types.GetEscrowAddressForClass(port, channel, "denom"), types.GetDenomEscrowAddress(port, channel, ""))
+	require.NotEqual(t, types.GetDenomEscrowAddress(port, "channel-1", "uatom"), atomEscrow)
+	require.NotEqual(t, types.GetDenomEscrowAddress(port, channel, "stake"), atomEscrow)
+	require.Equal(t, atomEscrow, types.GetDenomEscrowAddress(port, channel, "uatom"))
+}
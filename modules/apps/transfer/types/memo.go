@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/json"
+	"slices"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MaxMemoNamespaceLength is the maximum length of a memo namespace key.
+const MaxMemoNamespaceLength = 64
+
+// ReservedMemoNamespaces are the memo namespaces used by well known applications and middlewares,
+// e.g. packet forwarding, ibc hooks and ibc callbacks. Once memo namespaces are registered on the
+// transfer keeper, memos using a reserved namespace which is not registered are rejected.
+var ReservedMemoNamespaces = []string{"autopilot", "dest_callback", "forward", "ibc_callback", "src_callback", "wasm"}
+
+// IsReservedMemoNamespace returns true if the provided key is one of the ReservedMemoNamespaces.
+func IsReservedMemoNamespace(key string) bool {
+	return slices.Contains(ReservedMemoNamespaces, key)
+}
+
+// ValidateMemoNamespace returns an error if the provided key is not a valid memo namespace.
+// Memo namespaces are top level keys of a JSON object memo, e.g. "forward" or "src_callback",
+// which consist of lowercase letters, digits and underscores and start with a letter.
+func ValidateMemoNamespace(key string) error {
+	if len(key) == 0 || len(key) > MaxMemoNamespaceLength {
+		return errorsmod.Wrapf(ErrInvalidMemoNamespace, "namespace length must be between 1 and %d: %s", MaxMemoNamespaceLength, key)
+	}
+	if key[0] < 'a' || key[0] > 'z' {
+		return errorsmod.Wrapf(ErrInvalidMemoNamespace, "namespace must start with a lowercase letter: %s", key)
+	}
+	for _, c := range key {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '_' {
+			return errorsmod.Wrapf(ErrInvalidMemoNamespace, "namespace contains invalid character %q: %s", c, key)
+		}
+	}
+
+	return nil
+}
+
+// MemoNamespaces returns the sorted top level keys of the provided memo which are valid memo
+// namespaces. Nil is returned for free-form memos which are not JSON objects.
+func MemoNamespaces(memo string) []string {
+	if len(memo) == 0 {
+		return nil
+	}
+
+	jsonObject := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(memo), &jsonObject); err != nil {
+		return nil
+	}
+
+	var namespaces []string
+	for key := range jsonObject {
+		if ValidateMemoNamespace(key) == nil {
+			namespaces = append(namespaces, key)
+		}
+	}
+	sort.Strings(namespaces)
+
+	return namespaces
+}
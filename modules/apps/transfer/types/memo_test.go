@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+func TestValidateMemoNamespace(t *testing.T) {
+	testCases := []struct {
+		name   string
+		key    string
+		expErr error
+	}{
+		{"success: letters", "forward", nil},
+		{"success: letters, digits and underscores", "src_callback2", nil},
+		{"failure: empty", "", types.ErrInvalidMemoNamespace},
+		{"failure: too long", strings.Repeat("a", types.MaxMemoNamespaceLength+1), types.ErrInvalidMemoNamespace},
+		{"failure: starts with digit", "1forward", types.ErrInvalidMemoNamespace},
+		{"failure: starts with underscore", "_forward", types.ErrInvalidMemoNamespace},
+		{"failure: uppercase", "Forward", types.ErrInvalidMemoNamespace},
+		{"failure: contains space", "src callback", types.ErrInvalidMemoNamespace},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateMemoNamespace(tc.key)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestMemoNamespaces(t *testing.T) {
+	testCases := []struct {
+		name          string
+		memo          string
+		expNamespaces []string
+	}{
+		{"empty memo", "", nil},
+		{"plain text memo", "hello", nil},
+		{"JSON array memo", `["forward"]`, nil},
+		{"JSON object memo", `{"wasm":{},"forward":{}}`, []string{"forward", "wasm"}},
+		{"JSON object memo with free-form keys", `{"Note":"hi","forward":{},"order id":1}`, []string{"forward"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expNamespaces, types.MemoNamespaces(tc.memo))
+		})
+	}
+}
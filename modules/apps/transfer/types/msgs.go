@@ -2,8 +2,10 @@ package types
 
 import (
 	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -20,8 +22,12 @@ const (
 var (
 	_ sdk.Msg              = (*MsgUpdateParams)(nil)
 	_ sdk.Msg              = (*MsgTransfer)(nil)
+	_ sdk.Msg              = (*MsgSetTransferQuota)(nil)
+	_ sdk.Msg              = (*MsgSetReceiverPrefix)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgTransfer)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetTransferQuota)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetReceiverPrefix)(nil)
 )
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
@@ -39,7 +45,7 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
 	}
 
-	return nil
+	return msg.Params.Validate()
 }
 
 // NewMsgTransfer creates a new MsgTransfer instance
@@ -75,8 +81,9 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if !msg.Token.IsValid() {
 		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, msg.Token.String())
 	}
-	if !msg.Token.IsPositive() {
-		return errorsmod.Wrap(ibcerrors.ErrInsufficientFunds, msg.Token.String())
+	// a zero amount transfer would only waste a packet, negative amounts are rejected by the coin validation above
+	if msg.Token.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "transfer amount must be positive: %s", msg.Token)
 	}
 
 	_, err := sdk.AccAddressFromBech32(msg.Sender)
@@ -92,5 +99,70 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if len(msg.Memo) > MaximumMemoLength {
 		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
 	}
+	if msg.RefundAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.RefundAddress); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "refund address could not be parsed as address: %v", err)
+		}
+	}
 	return ValidateIBCDenom(msg.Token.Denom)
 }
+
+// NewMsgSetTransferQuota creates a new MsgSetTransferQuota instance
+func NewMsgSetTransferQuota(signer, channelID, denom string, maxOutflow sdkmath.Int, epochDuration time.Duration) *MsgSetTransferQuota {
+	return &MsgSetTransferQuota{
+		Signer:        signer,
+		ChannelId:     channelID,
+		Denom:         denom,
+		MaxOutflow:    maxOutflow,
+		EpochDuration: epochDuration,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgSetTransferQuota fields.
+// NOTE: a zero max outflow removes the quota and a zero epoch duration defaults to DefaultQuotaEpochDuration.
+func (msg MsgSetTransferQuota) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return errorsmod.Wrap(err, "invalid channel ID")
+	}
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomForTransfer, err.Error())
+	}
+	if msg.MaxOutflow.IsNil() || msg.MaxOutflow.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "max outflow must not be negative: %s", msg.MaxOutflow)
+	}
+	if msg.EpochDuration < 0 {
+		return errorsmod.Wrapf(ErrInvalidQuota, "epoch duration must not be negative: %s", msg.EpochDuration)
+	}
+
+	return nil
+}
+
+// NewMsgSetReceiverPrefix creates a new MsgSetReceiverPrefix instance
+func NewMsgSetReceiverPrefix(signer, channelID, prefix string) *MsgSetReceiverPrefix {
+	return &MsgSetReceiverPrefix{
+		Signer:    signer,
+		ChannelId: channelID,
+		Prefix:    prefix,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgSetReceiverPrefix fields.
+// NOTE: an empty prefix removes the receiver prefix of the channel.
+func (msg MsgSetReceiverPrefix) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return errorsmod.Wrap(err, "invalid channel ID")
+	}
+	if msg.Prefix != "" {
+		return ValidateReceiverPrefix(msg.Prefix)
+	}
+
+	return nil
+}
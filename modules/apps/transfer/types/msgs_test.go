@@ -1,7 +1,9 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer"
 	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -70,6 +73,8 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"missing recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, "", timeoutHeight, 0, ""), false},
 		{"too long recipient address", types.NewMsgTransfer(validPort, validChannel, coin, sender, ibctesting.GenerateString(types.MaximumReceiverLength+1), timeoutHeight, 0, ""), false},
 		{"empty coin", types.NewMsgTransfer(validPort, validChannel, sdk.Coin{}, sender, receiver, timeoutHeight, 0, ""), false},
+		{"valid refund address", withRefundAddress(types.NewMsgTransfer(validPort, validChannel, coin, sender, receiver, timeoutHeight, 0, ""), receiver), true},
+		{"invalid refund address", withRefundAddress(types.NewMsgTransfer(validPort, validChannel, coin, sender, receiver, timeoutHeight, 0, ""), invalidAddress), false},
 	}
 
 	for i, tc := range testCases {
@@ -84,6 +89,39 @@ func TestMsgTransferValidation(t *testing.T) {
 	}
 }
 
+// TestMsgTransferValidateAmount tests the validation of the token amount in ValidateBasic for MsgTransfer
+func TestMsgTransferValidateAmount(t *testing.T) {
+	testCases := []struct {
+		name   string
+		token  sdk.Coin
+		expErr error
+	}{
+		{"success: positive amount", coin, nil},
+		{"success: unbounded spend limit sentinel", sdk.Coin{Denom: coin.Denom, Amount: types.UnboundedSpendLimit()}, nil},
+		{"failure: zero amount", zeroCoin, types.ErrInvalidAmount},
+		{"failure: negative amount", sdk.Coin{Denom: coin.Denom, Amount: sdkmath.NewInt(-1)}, ibcerrors.ErrInvalidCoins},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.NewMsgTransfer(validPort, validChannel, tc.token, sender, receiver, timeoutHeight, 0, "").ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+// withRefundAddress sets the refund address of the given MsgTransfer.
+func withRefundAddress(msg *types.MsgTransfer, refundAddress string) *types.MsgTransfer {
+	msg.RefundAddress = refundAddress
+	return msg
+}
+
 // TestMsgTransferGetSigners tests GetSigners for MsgTransfer
 func TestMsgTransferGetSigners(t *testing.T) {
 	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
@@ -105,6 +143,7 @@ func TestMsgUpdateParamsValidateBasic(t *testing.T) {
 		{"success: valid signer and valid params", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.DefaultParams()), true},
 		{"failure: invalid signer with valid params", types.NewMsgUpdateParams(invalidAddress, types.DefaultParams()), false},
 		{"failure: empty signer with valid params", types.NewMsgUpdateParams(emptyAddr, types.DefaultParams()), false},
+		{"failure: valid signer with invalid params", types.NewMsgUpdateParams(ibctesting.TestAccAddress, types.Params{EscrowClasses: []types.EscrowClass{{Name: "stable"}}}), false},
 	}
 
 	for i, tc := range testCases {
@@ -148,3 +187,64 @@ func TestMsgUpdateParamsGetSigners(t *testing.T) {
 		}
 	}
 }
+
+// TestMsgSetTransferQuotaValidateBasic tests ValidateBasic for MsgSetTransferQuota
+func TestMsgSetTransferQuotaValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSetTransferQuota
+		expPass bool
+	}{
+		{"success: valid quota", types.NewMsgSetTransferQuota(ibctesting.TestAccAddress, validChannel, coin.Denom, sdkmath.NewInt(100), time.Hour), true},
+		{"success: ibc denom", types.NewMsgSetTransferQuota(ibctesting.TestAccAddress, validChannel, ibcCoin.Denom, sdkmath.NewInt(100), time.Hour), true},
+		{"success: zero max outflow removes quota", types.NewMsgSetTransferQuota(ibctesting.TestAccAddress, validChannel, coin.Denom, sdkmath.ZeroInt(), time.Hour), true},
+		{"success: zero epoch duration uses default", types.NewMsgSetTransferQuota(ibctesting.TestAccAddress, validChannel, coin.Denom, sdkmath.NewInt(100), 0), true},
+		{"failure: invalid signer", types.NewMsgSetTransferQuota(invalidAddress, validChannel, coin.Denom, sdkmath.NewInt(100), time.Hour), false},
+		{"failure: empty signer", types.NewMsgSetTransferQuota(emptyAddr, validChannel, coin.Denom, sdkmath.NewInt(100), time.Hour), false},
+		{"failure: invalid channel", types.NewMsgSetTransferQuota(ibctesting.TestAccAddress, invalidChannel, coin.Denom, sdkmath.NewInt(100), time.Hour), false},
+		{"failure: invalid denom", types.NewMsgSetTransferQuota(ibctesting.TestAccAddress, validChannel, invalidDenomCoin.Denom, sdkmath.NewInt(100), time.Hour), false},
+		{"failure: negative max outflow", types.NewMsgSetTransferQuota(ibctesting.TestAccAddress, validChannel, coin.Denom, sdkmath.NewInt(-1), time.Hour), false},
+		{"failure: nil max outflow", types.NewMsgSetTransferQuota(ibctesting.TestAccAddress, validChannel, coin.Denom, sdkmath.Int{}, time.Hour), false},
+		{"failure: negative epoch duration", types.NewMsgSetTransferQuota(ibctesting.TestAccAddress, validChannel, coin.Denom, sdkmath.NewInt(100), -time.Hour), false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
+
+// TestMsgSetReceiverPrefixValidateBasic tests ValidateBasic for MsgSetReceiverPrefix
+func TestMsgSetReceiverPrefixValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSetReceiverPrefix
+		expPass bool
+	}{
+		{"success: valid prefix", types.NewMsgSetReceiverPrefix(ibctesting.TestAccAddress, validChannel, "osmo"), true},
+		{"success: empty prefix removes receiver prefix", types.NewMsgSetReceiverPrefix(ibctesting.TestAccAddress, validChannel, ""), true},
+		{"failure: invalid signer", types.NewMsgSetReceiverPrefix(invalidAddress, validChannel, "osmo"), false},
+		{"failure: empty signer", types.NewMsgSetReceiverPrefix(emptyAddr, validChannel, "osmo"), false},
+		{"failure: invalid channel", types.NewMsgSetReceiverPrefix(ibctesting.TestAccAddress, invalidChannel, "osmo"), false},
+		{"failure: uppercase prefix", types.NewMsgSetReceiverPrefix(ibctesting.TestAccAddress, validChannel, "Osmo"), false},
+		{"failure: prefix with whitespace", types.NewMsgSetReceiverPrefix(ibctesting.TestAccAddress, validChannel, "os mo"), false},
+		{"failure: prefix too long", types.NewMsgSetReceiverPrefix(ibctesting.TestAccAddress, validChannel, strings.Repeat("a", types.MaxReceiverPrefixLength+1)), false},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, "valid test case %d failed: %s", i, tc.name)
+		} else {
+			require.Error(t, err, "invalid test case %d passed: %s", i, tc.name)
+		}
+	}
+}
@@ -75,6 +75,19 @@ func (ftpd FungibleTokenPacketData) GetPacketSender(sourcePortID string) string
 	return ftpd.Sender
 }
 
+// GetSourceSender returns the address provided under the SourceSenderMemoKey of the memo.
+// The source sender is an optional, non-validated hint of the account on whose behalf the
+// transfer was sent, for example the user who instructed a contract to send the transfer.
+// An empty string is returned if the memo does not contain a source sender.
+func (ftpd FungibleTokenPacketData) GetSourceSender() string {
+	srcSender, ok := ftpd.GetCustomPacketData(SourceSenderMemoKey).(string)
+	if !ok {
+		return ""
+	}
+
+	return srcSender
+}
+
 // GetCustomPacketData interprets the memo field of the packet data as a JSON object
 // and returns the value associated with the given key.
 // If the key is missing or the memo is not properly formatted, then nil is returned.
@@ -36,6 +36,8 @@ type FungibleTokenPacketData struct {
 	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// optional memo
 	Memo string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	// optional address on the sending chain receiving the refund if the transfer times out or fails
+	RefundAddress string `protobuf:"bytes,6,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
 }
 
 func (m *FungibleTokenPacketData) Reset()         { *m = FungibleTokenPacketData{} }
@@ -106,6 +108,13 @@ func (m *FungibleTokenPacketData) GetMemo() string {
 	return ""
 }
 
+func (m *FungibleTokenPacketData) GetRefundAddress() string {
+	if m != nil {
+		return m.RefundAddress
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*FungibleTokenPacketData)(nil), "ibc.applications.transfer.v2.FungibleTokenPacketData")
 }
@@ -115,23 +124,25 @@ func init() {
 }
 
 var fileDescriptor_653ca2ce9a5ca313 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xb1, 0x4a, 0x34, 0x31,
-	0x14, 0x46, 0x27, 0xff, 0xbf, 0xbb, 0x68, 0xca, 0x20, 0x3a, 0x88, 0x04, 0xb1, 0xd2, 0xc2, 0x09,
-	0xac, 0x85, 0xd6, 0x22, 0xd6, 0x2a, 0x56, 0x76, 0x49, 0xe6, 0x3a, 0x86, 0x9d, 0xe4, 0x86, 0x24,
-	0x33, 0xe0, 0x53, 0xe8, 0x63, 0x59, 0x6e, 0x69, 0x29, 0x33, 0x2f, 0x22, 0x9b, 0x51, 0xd9, 0x2e,
-	0xe7, 0xe4, 0xbb, 0xcd, 0xa1, 0x67, 0x46, 0x69, 0x21, 0xbd, 0x6f, 0x8d, 0x96, 0xc9, 0xa0, 0x8b,
-	0x22, 0x05, 0xe9, 0xe2, 0x33, 0x04, 0xd1, 0x2f, 0x85, 0x97, 0x7a, 0x05, 0xa9, 0xf2, 0x01, 0x13,
-	0xb2, 0x23, 0xa3, 0x74, 0xb5, 0x3d, 0xad, 0x7e, 0xa7, 0x55, 0xbf, 0x3c, 0x79, 0x23, 0xf4, 0xe0,
-	0xb6, 0x73, 0x8d, 0x51, 0x2d, 0x3c, 0xe2, 0x0a, 0xdc, 0x5d, 0xbe, 0xbd, 0x91, 0x49, 0xb2, 0x3d,
-	0x3a, 0xaf, 0xc1, 0xa1, 0x2d, 0xc9, 0x31, 0x39, 0xdd, 0x7d, 0x98, 0x80, 0xed, 0xd3, 0x85, 0xb4,
-	0xd8, 0xb9, 0x54, 0xfe, 0xcb, 0xfa, 0x87, 0x36, 0x3e, 0x82, 0xab, 0x21, 0x94, 0xff, 0x27, 0x3f,
-	0x11, 0x3b, 0xa4, 0x3b, 0x01, 0x34, 0x98, 0x1e, 0x42, 0x39, 0xcb, 0x3f, 0x7f, 0xcc, 0x18, 0x9d,
-	0x59, 0xb0, 0x58, 0xce, 0xb3, 0xcf, 0xef, 0xeb, 0xfb, 0x8f, 0x81, 0x93, 0xf5, 0xc0, 0xc9, 0xd7,
-	0xc0, 0xc9, 0xfb, 0xc8, 0x8b, 0xf5, 0xc8, 0x8b, 0xcf, 0x91, 0x17, 0x4f, 0x97, 0x8d, 0x49, 0x2f,
-	0x9d, 0xaa, 0x34, 0x5a, 0xa1, 0x31, 0x5a, 0x8c, 0xc2, 0x28, 0x7d, 0xde, 0xa0, 0xe8, 0xaf, 0x84,
-	0xc5, 0xba, 0x6b, 0x21, 0x6e, 0xa2, 0x6c, 0xc5, 0x48, 0xaf, 0x1e, 0xa2, 0x5a, 0xe4, 0x12, 0x17,
-	0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x93, 0x3d, 0xc6, 0x36, 0x36, 0x01, 0x00, 0x00,
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbf, 0x4a, 0x73, 0x31,
+	0x18, 0xc6, 0x9b, 0xef, 0x6b, 0x8b, 0x06, 0x74, 0x08, 0xa2, 0x41, 0x24, 0x88, 0x20, 0xe8, 0xe0,
+	0x09, 0xd4, 0x41, 0x57, 0x45, 0x9c, 0x55, 0x9c, 0x5c, 0x24, 0x7f, 0xde, 0xd6, 0xd0, 0x26, 0xef,
+	0x21, 0xc9, 0x39, 0xe0, 0x5d, 0x78, 0x35, 0x5e, 0x83, 0x63, 0x47, 0x47, 0x69, 0x6f, 0x44, 0x9a,
+	0xa3, 0xd2, 0xed, 0xfd, 0xfd, 0x9e, 0xe7, 0x5d, 0x1e, 0x7a, 0xea, 0xb4, 0x91, 0xaa, 0xae, 0x67,
+	0xce, 0xa8, 0xec, 0x30, 0x24, 0x99, 0xa3, 0x0a, 0x69, 0x0c, 0x51, 0xb6, 0x23, 0x59, 0x2b, 0x33,
+	0x85, 0x5c, 0xd5, 0x11, 0x33, 0xb2, 0x03, 0xa7, 0x4d, 0xb5, 0x5e, 0xad, 0x7e, 0xab, 0x55, 0x3b,
+	0x3a, 0x7a, 0x27, 0x74, 0xef, 0xb6, 0x09, 0x13, 0xa7, 0x67, 0xf0, 0x88, 0x53, 0x08, 0x77, 0xe5,
+	0xf7, 0x46, 0x65, 0xc5, 0x76, 0xe8, 0xc0, 0x42, 0x40, 0xcf, 0xc9, 0x21, 0x39, 0xd9, 0x7c, 0xe8,
+	0x80, 0xed, 0xd2, 0xa1, 0xf2, 0xd8, 0x84, 0xcc, 0xff, 0x15, 0xfd, 0x43, 0x2b, 0x9f, 0x20, 0x58,
+	0x88, 0xfc, 0x7f, 0xe7, 0x3b, 0x62, 0xfb, 0x74, 0x23, 0x82, 0x01, 0xd7, 0x42, 0xe4, 0xfd, 0x92,
+	0xfc, 0x31, 0x63, 0xb4, 0xef, 0xc1, 0x23, 0x1f, 0x14, 0x5f, 0x6e, 0x76, 0x4c, 0xb7, 0x23, 0x8c,
+	0x9b, 0x60, 0x9f, 0x95, 0xb5, 0x11, 0x52, 0xe2, 0xc3, 0x92, 0x6e, 0x75, 0xf6, 0xaa, 0x93, 0xd7,
+	0xf7, 0x1f, 0x0b, 0x41, 0xe6, 0x0b, 0x41, 0xbe, 0x16, 0x82, 0xbc, 0x2d, 0x45, 0x6f, 0xbe, 0x14,
+	0xbd, 0xcf, 0xa5, 0xe8, 0x3d, 0x5d, 0x4c, 0x5c, 0x7e, 0x69, 0x74, 0x65, 0xd0, 0x4b, 0x83, 0xc9,
+	0x63, 0x92, 0x4e, 0x9b, 0xb3, 0x09, 0xca, 0xf6, 0x52, 0x7a, 0xb4, 0xcd, 0x0c, 0xd2, 0x6a, 0xbb,
+	0xb5, 0xcd, 0xf2, 0x6b, 0x0d, 0x49, 0x0f, 0xcb, 0x60, 0xe7, 0xdf, 0x03, 0x00, 0xdc, 0x62, 0x0d,
+	0x1f, 0x5d, 0x01, 0x00, 0x00,
 }
 
 func (m *FungibleTokenPacketData) Marshal() (dAtA []byte, err error) {
@@ -154,6 +165,13 @@ func (m *FungibleTokenPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Memo) > 0 {
 		i -= len(m.Memo)
 		copy(dAtA[i:], m.Memo)
@@ -229,6 +247,10 @@ func (m *FungibleTokenPacketData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
 	return n
 }
 
@@ -427,6 +449,38 @@ func (m *FungibleTokenPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
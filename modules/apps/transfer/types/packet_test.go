@@ -136,6 +136,52 @@ func (suite *TypesTestSuite) TestPacketDataProvider() {
 	}
 }
 
+func (suite *TypesTestSuite) TestGetSourceSender() {
+	testCases := []struct {
+		name         string
+		memo         string
+		expSrcSender string
+	}{
+		{
+			"success: src_sender key in memo",
+			fmt.Sprintf(`{"src_sender": "%s"}`, receiver),
+			receiver,
+		},
+		{
+			"success: src_sender key in memo with additional fields",
+			fmt.Sprintf(`{"src_sender": "%s", "src_callback": {"address": "%s"}}`, receiver, receiver),
+			receiver,
+		},
+		{
+			"failure: src_sender has non-string value",
+			`{"src_sender": {"address": "string"}}`,
+			"",
+		},
+		{
+			"failure: src_sender key not in memo",
+			fmt.Sprintf(`{"src_callback": {"address": "%s"}}`, receiver),
+			"",
+		},
+		{
+			"failure: empty memo",
+			"",
+			"",
+		},
+		{
+			"failure: non-json memo",
+			"invalid",
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		packetData := types.NewFungibleTokenPacketData(denom, amount, sender, receiver, tc.memo)
+		suite.Require().Equal(tc.expSrcSender, packetData.GetSourceSender(), tc.name)
+	}
+}
+
 func (suite *TypesTestSuite) TestFungibleTokenPacketDataOmitEmpty() {
 	// check that omitempty is present for the memo field
 	packetData := types.FungibleTokenPacketData{
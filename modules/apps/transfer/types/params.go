@@ -1,12 +1,37 @@
 package types
 
+import (
+	"regexp"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// DefaultSendEnabled enabled
 	DefaultSendEnabled = true
 	// DefaultReceiveEnabled enabled
 	DefaultReceiveEnabled = true
+
+	// MaxOutboundVoucherTaxBps is the maximum outbound voucher tax in basis points (10%)
+	MaxOutboundVoucherTaxBps = 1000
+
+	// MaxTransferFeeBps is the maximum transfer fee in basis points (10%)
+	MaxTransferFeeBps = 1000
+
+	// basisPointsDenominator is the number of basis points in a whole
+	basisPointsDenominator = 10000
+
+	// escrowClassWildcard is the suffix of a denomination pattern matching all denominations with the preceding prefix
+	escrowClassWildcard = "*"
 )
 
+// IsValidEscrowClassName defines the regular expression to check if an escrow class name is valid
+var IsValidEscrowClassName = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,64}$`).MatchString
+
 // NewParams creates a new parameter configuration for the ibc transfer module
 func NewParams(enableSend, enableReceive bool) Params {
 	return Params{
@@ -19,3 +44,145 @@ func NewParams(enableSend, enableReceive bool) Params {
 func DefaultParams() Params {
 	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
 }
+
+// Validate performs basic validation of the transfer parameters.
+func (p Params) Validate() error {
+	if p.EscrowPerDenom && len(p.EscrowClasses) > 0 {
+		return errorsmod.Wrap(ErrInvalidEscrowClass, "escrow classes cannot be configured when tokens are escrowed per denomination")
+	}
+
+	seenNames := make(map[string]bool)
+	for _, class := range p.EscrowClasses {
+		if err := class.Validate(); err != nil {
+			return err
+		}
+
+		if seenNames[class.Name] {
+			return errorsmod.Wrapf(ErrInvalidEscrowClass, "duplicate escrow class name %s", class.Name)
+		}
+		seenNames[class.Name] = true
+	}
+
+	if p.OutboundVoucherTaxBps > MaxOutboundVoucherTaxBps {
+		return errorsmod.Wrapf(ErrInvalidVoucherTax, "outbound voucher tax of %d basis points exceeds maximum of %d", p.OutboundVoucherTaxBps, MaxOutboundVoucherTaxBps)
+	}
+
+	if p.OutboundVoucherTaxBps > 0 || p.TaxCollector != "" {
+		if _, err := sdk.AccAddressFromBech32(p.TaxCollector); err != nil {
+			return errorsmod.Wrapf(ErrInvalidVoucherTax, "invalid tax collector address %s: %s", p.TaxCollector, err)
+		}
+	}
+
+	if p.TransferFee != nil {
+		if err := p.TransferFee.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// OutboundVoucherTax returns the outbound voucher tax due on the provided amount, rounded down.
+func (p Params) OutboundVoucherTax(amount sdkmath.Int) sdkmath.Int {
+	return amount.MulRaw(int64(p.OutboundVoucherTaxBps)).QuoRaw(basisPointsDenominator)
+}
+
+// Validate performs basic validation of the transfer fee. Exactly one of the flat amount and the basis points
+// must be set.
+func (f TransferFee) Validate() error {
+	if strings.TrimSpace(f.Collector) == "" {
+		return errorsmod.Wrap(ErrInvalidTransferFee, "fee collector module account name cannot be empty")
+	}
+
+	flatAmount := f.flatAmount()
+	if flatAmount.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidTransferFee, "flat amount %s cannot be negative", flatAmount)
+	}
+
+	if f.Bps > MaxTransferFeeBps {
+		return errorsmod.Wrapf(ErrInvalidTransferFee, "transfer fee of %d basis points exceeds maximum of %d", f.Bps, MaxTransferFeeBps)
+	}
+
+	if flatAmount.IsPositive() == (f.Bps > 0) {
+		return errorsmod.Wrap(ErrInvalidTransferFee, "exactly one of the flat amount and the basis points must be set")
+	}
+
+	return nil
+}
+
+// Fee returns the transfer fee due on the provided amount. A fee in basis points is rounded down.
+func (f TransferFee) Fee(amount sdkmath.Int) sdkmath.Int {
+	if flatAmount := f.flatAmount(); flatAmount.IsPositive() {
+		return flatAmount
+	}
+
+	return amount.MulRaw(int64(f.Bps)).QuoRaw(basisPointsDenominator)
+}
+
+// flatAmount returns the flat amount of the transfer fee, or zero if it is unset.
+func (f TransferFee) flatAmount() sdkmath.Int {
+	if f.FlatAmount.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+
+	return f.FlatAmount
+}
+
+// EscrowClassForDenom returns the name of the first escrow class with a denomination pattern matching the
+// provided denomination. An empty string is returned if the denomination is escrowed in the default escrow account.
+func (p Params) EscrowClassForDenom(denom string) string {
+	for _, class := range p.EscrowClasses {
+		if class.Matches(denom) {
+			return class.Name
+		}
+	}
+
+	return ""
+}
+
+// EscrowAddressForDenom returns the escrow address of the provided channel in which tokens of the provided
+// denomination are escrowed. If tokens are escrowed per denomination, this is the escrow address of the denomination.
+// Otherwise it is the escrow address of the escrow class matching the denomination, or the default escrow address of
+// the channel if no escrow class matches the denomination.
+func (p Params) EscrowAddressForDenom(portID, channelID, denom string) sdk.AccAddress {
+	if p.EscrowPerDenom {
+		return GetDenomEscrowAddress(portID, channelID, denom)
+	}
+
+	return GetEscrowAddressForClass(portID, channelID, p.EscrowClassForDenom(denom))
+}
+
+// Validate performs basic validation of the escrow class.
+func (c EscrowClass) Validate() error {
+	if !IsValidEscrowClassName(c.Name) {
+		return errorsmod.Wrapf(ErrInvalidEscrowClass, "invalid escrow class name %s: must be between 1 and 64 characters of [a-zA-Z0-9._-]", c.Name)
+	}
+
+	if len(c.DenomPatterns) == 0 {
+		return errorsmod.Wrapf(ErrInvalidEscrowClass, "escrow class %s must have at least one denomination pattern", c.Name)
+	}
+
+	for _, pattern := range c.DenomPatterns {
+		prefix := strings.TrimSuffix(pattern, escrowClassWildcard)
+		if strings.TrimSpace(pattern) == "" || strings.Contains(prefix, escrowClassWildcard) {
+			return errorsmod.Wrapf(ErrInvalidEscrowClass, "invalid denomination pattern %q of escrow class %s: the '%s' wildcard may only be used as the last character", pattern, c.Name, escrowClassWildcard)
+		}
+	}
+
+	return nil
+}
+
+// Matches returns true if the provided denomination matches any denomination pattern of the escrow class.
+func (c EscrowClass) Matches(denom string) bool {
+	for _, pattern := range c.DenomPatterns {
+		if prefix, found := strings.CutSuffix(pattern, escrowClassWildcard); found {
+			if strings.HasPrefix(denom, prefix) {
+				return true
+			}
+		} else if denom == pattern {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,182 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{"default params", types.DefaultParams(), true},
+		{"valid escrow classes", types.Params{EscrowClasses: []types.EscrowClass{{Name: "stable", DenomPatterns: []string{"uusdc", "ibc/*"}}, {Name: "volatile", DenomPatterns: []string{"*"}}}}, true},
+		{"empty escrow class name", types.Params{EscrowClasses: []types.EscrowClass{{Name: "", DenomPatterns: []string{"uusdc"}}}}, false},
+		{"invalid escrow class name", types.Params{EscrowClasses: []types.EscrowClass{{Name: "stable/coins", DenomPatterns: []string{"uusdc"}}}}, false},
+		{"duplicate escrow class names", types.Params{EscrowClasses: []types.EscrowClass{{Name: "stable", DenomPatterns: []string{"uusdc"}}, {Name: "stable", DenomPatterns: []string{"uusdt"}}}}, false},
+		{"escrow class without denomination patterns", types.Params{EscrowClasses: []types.EscrowClass{{Name: "stable"}}}, false},
+		{"empty denomination pattern", types.Params{EscrowClasses: []types.EscrowClass{{Name: "stable", DenomPatterns: []string{""}}}}, false},
+		{"wildcard not at end of denomination pattern", types.Params{EscrowClasses: []types.EscrowClass{{Name: "stable", DenomPatterns: []string{"ibc/*/usdc"}}}}, false},
+		{"escrow per denomination", types.Params{EscrowPerDenom: true}, true},
+		{"escrow classes with escrow per denomination", types.Params{EscrowPerDenom: true, EscrowClasses: []types.EscrowClass{{Name: "stable", DenomPatterns: []string{"uusdc"}}}}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidEscrowClass, tc.name)
+		}
+	}
+}
+
+func TestParamsValidateOutboundVoucherTax(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{"tax with tax collector", types.Params{OutboundVoucherTaxBps: 50, TaxCollector: ibctesting.TestAccAddress}, true},
+		{"maximum tax", types.Params{OutboundVoucherTaxBps: types.MaxOutboundVoucherTaxBps, TaxCollector: ibctesting.TestAccAddress}, true},
+		{"tax collector without tax", types.Params{TaxCollector: ibctesting.TestAccAddress}, true},
+		{"tax exceeds maximum", types.Params{OutboundVoucherTaxBps: types.MaxOutboundVoucherTaxBps + 1, TaxCollector: ibctesting.TestAccAddress}, false},
+		{"tax without tax collector", types.Params{OutboundVoucherTaxBps: 50}, false},
+		{"invalid tax collector", types.Params{OutboundVoucherTaxBps: 50, TaxCollector: "invalid"}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidVoucherTax, tc.name)
+		}
+	}
+}
+
+func TestOutboundVoucherTax(t *testing.T) {
+	testCases := []struct {
+		taxBps uint32
+		amount int64
+		expTax int64
+	}{
+		{0, 1000, 0},
+		{1, 10000, 1},
+		{1, 9999, 0},
+		{25, 1000, 2},
+		{100, 1, 0},
+		{types.MaxOutboundVoucherTaxBps, 1005, 100},
+	}
+
+	for _, tc := range testCases {
+		params := types.Params{OutboundVoucherTaxBps: tc.taxBps}
+		require.Equal(t, tc.expTax, params.OutboundVoucherTax(sdkmath.NewInt(tc.amount)).Int64(), "%d bps of %d", tc.taxBps, tc.amount)
+	}
+}
+
+func TestParamsValidateTransferFee(t *testing.T) {
+	testCases := []struct {
+		name        string
+		transferFee types.TransferFee
+		expPass     bool
+	}{
+		{"flat fee", types.TransferFee{FlatAmount: sdkmath.NewInt(10), Collector: authtypes.FeeCollectorName}, true},
+		{"percentage fee", types.TransferFee{Bps: 50, Collector: authtypes.FeeCollectorName}, true},
+		{"maximum percentage fee", types.TransferFee{Bps: types.MaxTransferFeeBps, Collector: authtypes.FeeCollectorName}, true},
+		{"percentage fee exceeds maximum", types.TransferFee{Bps: types.MaxTransferFeeBps + 1, Collector: authtypes.FeeCollectorName}, false},
+		{"both flat and percentage fee", types.TransferFee{FlatAmount: sdkmath.NewInt(10), Bps: 50, Collector: authtypes.FeeCollectorName}, false},
+		{"neither flat nor percentage fee", types.TransferFee{Collector: authtypes.FeeCollectorName}, false},
+		{"negative flat fee", types.TransferFee{FlatAmount: sdkmath.NewInt(-10), Collector: authtypes.FeeCollectorName}, false},
+		{"empty collector", types.TransferFee{Bps: 50}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		transferFee := tc.transferFee
+		params := types.Params{TransferFee: &transferFee}
+
+		err := params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidTransferFee, tc.name)
+		}
+	}
+}
+
+func TestTransferFee(t *testing.T) {
+	testCases := []struct {
+		name        string
+		transferFee types.TransferFee
+		amount      int64
+		expFee      int64
+	}{
+		{"flat fee", types.TransferFee{FlatAmount: sdkmath.NewInt(10)}, 1000, 10},
+		{"flat fee exceeding amount", types.TransferFee{FlatAmount: sdkmath.NewInt(10)}, 5, 10},
+		{"percentage fee", types.TransferFee{Bps: 25}, 1000, 2},
+		{"percentage fee rounded down", types.TransferFee{Bps: 1}, 9999, 0},
+		{"maximum percentage fee", types.TransferFee{Bps: types.MaxTransferFeeBps}, 1005, 100},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expFee, tc.transferFee.Fee(sdkmath.NewInt(tc.amount)).Int64(), tc.name)
+	}
+}
+
+func TestEscrowClassForDenom(t *testing.T) {
+	params := types.Params{
+		EscrowClasses: []types.EscrowClass{
+			{Name: "stable", DenomPatterns: []string{"uusdc", "ibc/*"}},
+			{Name: "volatile", DenomPatterns: []string{"u*"}},
+		},
+	}
+
+	testCases := []struct {
+		denom          string
+		expEscrowClass string
+	}{
+		{"uusdc", "stable"},
+		{"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "stable"},
+		{"uatom", "volatile"},
+		{"uusdc2", "volatile"},
+		{"stake", ""},
+		{"ibc", ""},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expEscrowClass, params.EscrowClassForDenom(tc.denom), tc.denom)
+	}
+
+	require.Empty(t, types.DefaultParams().EscrowClassForDenom("uusdc"))
+}
+
+func TestEscrowAddressForDenom(t *testing.T) {
+	var (
+		port    = "transfer"
+		channel = "channel-0"
+	)
+
+	params := types.Params{EscrowClasses: []types.EscrowClass{{Name: "stable", DenomPatterns: []string{"uusdc"}}}}
+	require.Equal(t, types.GetEscrowAddressForClass(port, channel, "stable"), params.EscrowAddressForDenom(port, channel, "uusdc"))
+	require.Equal(t, types.GetEscrowAddress(port, channel), params.EscrowAddressForDenom(port, channel, "uatom"))
+
+	params = types.Params{EscrowPerDenom: true}
+	require.Equal(t, types.GetDenomEscrowAddress(port, channel, "uusdc"), params.EscrowAddressForDenom(port, channel, "uusdc"))
+	require.Equal(t, types.GetDenomEscrowAddress(port, channel, "uatom"), params.EscrowAddressForDenom(port, channel, "uatom"))
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// DefaultQuotaEpochDuration is the epoch duration used for transfer quotas which are set without an epoch duration.
+const DefaultQuotaEpochDuration = 24 * time.Hour
+
+// NewTransferQuota creates a new TransferQuota instance with an empty net outflow for an epoch starting at the provided time.
+func NewTransferQuota(channelID, denom string, maxOutflow sdkmath.Int, epochDuration time.Duration, epochStart time.Time) TransferQuota {
+	return TransferQuota{
+		ChannelId:     channelID,
+		Denom:         denom,
+		MaxOutflow:    maxOutflow,
+		EpochDuration: epochDuration,
+		NetOutflow:    sdkmath.ZeroInt(),
+		EpochStart:    epochStart,
+	}
+}
+
+// Validate performs a basic validation of the TransferQuota fields.
+func (q TransferQuota) Validate() error {
+	if err := host.ChannelIdentifierValidator(q.ChannelId); err != nil {
+		return errorsmod.Wrap(err, "invalid channel ID")
+	}
+	if err := sdk.ValidateDenom(q.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenomForTransfer, err.Error())
+	}
+	if q.MaxOutflow.IsNil() || !q.MaxOutflow.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "max outflow must be positive: %s", q.MaxOutflow)
+	}
+	if q.EpochDuration <= 0 {
+		return errorsmod.Wrapf(ErrInvalidQuota, "epoch duration must be positive: %s", q.EpochDuration)
+	}
+	if q.NetOutflow.IsNil() || q.NetOutflow.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "net outflow must not be negative: %s", q.NetOutflow)
+	}
+
+	return nil
+}
+
+// Remaining returns the amount which may still be sent out within the current epoch.
+func (q TransferQuota) Remaining() sdkmath.Int {
+	if q.NetOutflow.GTE(q.MaxOutflow) {
+		return sdkmath.ZeroInt()
+	}
+
+	return q.MaxOutflow.Sub(q.NetOutflow)
+}
+
+// EpochElapsed returns true if the current epoch has ended at the provided block time.
+func (q TransferQuota) EpochElapsed(blockTime time.Time) bool {
+	return !blockTime.Before(q.EpochStart.Add(q.EpochDuration))
+}
+
+// ValidateTransferQuotas validates the provided quotas and ensures there is at most one quota per channel and denomination.
+func ValidateTransferQuotas(quotas []TransferQuota) error {
+	seen := make(map[string]bool)
+	for _, quota := range quotas {
+		if err := quota.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%s", quota.ChannelId, quota.Denom)
+		if seen[key] {
+			return errorsmod.Wrapf(ErrInvalidQuota, "duplicate quota for channel %s and denom %s", quota.ChannelId, quota.Denom)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
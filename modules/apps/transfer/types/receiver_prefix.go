@@ -0,0 +1,76 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// MaxReceiverPrefixLength is the maximum length of a bech32 human readable part.
+const MaxReceiverPrefixLength = 83
+
+// NewReceiverPrefix creates a new ReceiverPrefix instance.
+func NewReceiverPrefix(channelID, prefix string) ReceiverPrefix {
+	return ReceiverPrefix{
+		ChannelId: channelID,
+		Prefix:    prefix,
+	}
+}
+
+// Validate performs a basic validation of the ReceiverPrefix fields.
+func (rp ReceiverPrefix) Validate() error {
+	if err := host.ChannelIdentifierValidator(rp.ChannelId); err != nil {
+		return errorsmod.Wrap(err, "invalid channel ID")
+	}
+
+	return ValidateReceiverPrefix(rp.Prefix)
+}
+
+// ValidateReceiverPrefix returns an error if the provided prefix is not a valid
+// lowercase bech32 human readable part.
+func ValidateReceiverPrefix(prefix string) error {
+	if len(prefix) == 0 || len(prefix) > MaxReceiverPrefixLength {
+		return errorsmod.Wrapf(ErrInvalidReceiverPrefix, "prefix length must be between 1 and %d: %s", MaxReceiverPrefixLength, prefix)
+	}
+	if strings.ToLower(prefix) != prefix {
+		return errorsmod.Wrapf(ErrInvalidReceiverPrefix, "prefix must be lowercase: %s", prefix)
+	}
+	for _, c := range prefix {
+		if c < 33 || c > 126 {
+			return errorsmod.Wrapf(ErrInvalidReceiverPrefix, "prefix contains invalid character %q: %s", c, prefix)
+		}
+	}
+
+	return nil
+}
+
+// ValidateReceiverPrefixes validates the provided receiver prefixes and ensures there is at most one prefix per channel.
+func ValidateReceiverPrefixes(prefixes []ReceiverPrefix) error {
+	seen := make(map[string]bool)
+	for _, rp := range prefixes {
+		if err := rp.Validate(); err != nil {
+			return err
+		}
+
+		if seen[rp.ChannelId] {
+			return errorsmod.Wrapf(ErrInvalidReceiverPrefix, "duplicate receiver prefix for channel %s", rp.ChannelId)
+		}
+		seen[rp.ChannelId] = true
+	}
+
+	return nil
+}
+
+// Bech32Prefix returns the human readable part of the provided bech32 address.
+func Bech32Prefix(address string) (string, error) {
+	hrp, _, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return "", err
+	}
+
+	return hrp, nil
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReceiverResolver resolves the receiver provided in the packet data of a received
+// transfer into the account credited with the transferred tokens. Chains supporting
+// multiple address formats may set a resolver on the transfer keeper which maps
+// aliases of an account, e.g. through a registry, onto a single address.
+//
+// Resolve is called in the state machine and must be deterministic. A returned error
+// fails the receipt of the packet with an error acknowledgement. Resolvers should return
+// errors wrapping ErrInvalidReceiver for receivers which cannot be resolved, so that the
+// sending chain is informed of the AckErrorReasonInvalidReceiver reason.
+type ReceiverResolver interface {
+	Resolve(ctx sdk.Context, packetReceiver string) (sdk.AccAddress, error)
+}
+
+var _ ReceiverResolver = (*Bech32ReceiverResolver)(nil)
+
+// Bech32ReceiverResolver is the default ReceiverResolver of the transfer keeper.
+// It performs no aliasing and decodes the packet receiver as a bech32 address.
+type Bech32ReceiverResolver struct{}
+
+// Resolve implements the ReceiverResolver interface.
+func (Bech32ReceiverResolver) Resolve(_ sdk.Context, packetReceiver string) (sdk.AccAddress, error) {
+	receiver, err := sdk.AccAddressFromBech32(packetReceiver)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidReceiver, "failed to decode receiver address %s: %v", packetReceiver, err)
+	}
+
+	return receiver, nil
+}
@@ -82,6 +82,22 @@ func (dt DenomTrace) IsNativeDenom() bool {
 	return dt.Path == ""
 }
 
+// Hops returns the ordered 'port/channel' hops of the trace path, starting with the most recent hop.
+// An empty slice is returned for native denominations.
+func (dt DenomTrace) Hops() []string {
+	if dt.Path == "" {
+		return []string{}
+	}
+
+	identifiers := strings.Split(dt.Path, "/")
+	hops := make([]string, 0, len(identifiers)/2)
+	for i := 0; i+1 < len(identifiers); i += 2 {
+		hops = append(hops, identifiers[i]+"/"+identifiers[i+1])
+	}
+
+	return hops
+}
+
 // extractPathAndBaseFromFullDenom returns the trace path and the base denom from
 // the elements that constitute the complete denom.
 func extractPathAndBaseFromFullDenom(fullDenomItems []string) (string, string) {
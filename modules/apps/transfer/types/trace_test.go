@@ -64,6 +64,25 @@ func TestDenomTrace_IBCDenom(t *testing.T) {
 	}
 }
 
+func TestDenomTrace_Hops(t *testing.T) {
+	testCases := []struct {
+		name    string
+		trace   types.DenomTrace
+		expHops []string
+	}{
+		{"base denom", types.DenomTrace{BaseDenom: "uatom"}, []string{}},
+		{"single hop", types.DenomTrace{BaseDenom: "uatom", Path: "transfer/channel-1"}, []string{"transfer/channel-1"}},
+		{"multiple hops", types.DenomTrace{BaseDenom: "gamm/pool/1", Path: "transfer/channel-1/transfer/channel-5/portidone/channel-0"}, []string{"transfer/channel-1", "transfer/channel-5", "portidone/channel-0"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		hops := tc.trace.Hops()
+		require.Equal(t, tc.expHops, hops, tc.name)
+	}
+}
+
 func TestDenomTrace_Validate(t *testing.T) {
 	testCases := []struct {
 		name     string
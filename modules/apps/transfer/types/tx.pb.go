@@ -5,26 +5,32 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types1 "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -54,6 +60,11 @@ type MsgTransfer struct {
 	TimeoutTimestamp uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
 	// optional memo
 	Memo string `protobuf:"bytes,8,opt,name=memo,proto3" json:"memo,omitempty"`
+	// skip the check of the receiver address against the bech32 prefix expected on the destination chain
+	UnsafeReceiver bool `protobuf:"varint,9,opt,name=unsafe_receiver,json=unsafeReceiver,proto3" json:"unsafe_receiver,omitempty"`
+	// optional address receiving the refund if the transfer times out or fails, defaults to the sender.
+	// Only supported on channels using the ics20-1-structured-ack transfer version.
+	RefundAddress string `protobuf:"bytes,10,opt,name=refund_address,json=refundAddress,proto3" json:"refund_address,omitempty"`
 }
 
 func (m *MsgTransfer) Reset()         { *m = MsgTransfer{} }
@@ -209,11 +220,181 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetTransferQuota defines the request type for the SetTransferQuota rpc.
+// A zero max_outflow removes the quota of the channel and denomination.
+type MsgSetTransferQuota struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// the channel on which the quota applies
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the denomination, as represented on this chain, on which the quota applies
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the maximum net outflow allowed within an epoch
+	MaxOutflow cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_outflow,json=maxOutflow,proto3,customtype=cosmossdk.io/math.Int" json:"max_outflow"`
+	// the duration of an epoch, defaults to one day if zero
+	EpochDuration time.Duration `protobuf:"bytes,5,opt,name=epoch_duration,json=epochDuration,proto3,stdduration" json:"epoch_duration"`
+}
+
+func (m *MsgSetTransferQuota) Reset()         { *m = MsgSetTransferQuota{} }
+func (m *MsgSetTransferQuota) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferQuota) ProtoMessage()    {}
+func (*MsgSetTransferQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{4}
+}
+func (m *MsgSetTransferQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTransferQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferQuota.Merge(m, src)
+}
+func (m *MsgSetTransferQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTransferQuota proto.InternalMessageInfo
+
+// MsgSetTransferQuotaResponse defines the response type for the SetTransferQuota rpc.
+type MsgSetTransferQuotaResponse struct {
+}
+
+func (m *MsgSetTransferQuotaResponse) Reset()         { *m = MsgSetTransferQuotaResponse{} }
+func (m *MsgSetTransferQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTransferQuotaResponse) ProtoMessage()    {}
+func (*MsgSetTransferQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{5}
+}
+func (m *MsgSetTransferQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTransferQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTransferQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTransferQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTransferQuotaResponse.Merge(m, src)
+}
+func (m *MsgSetTransferQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTransferQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTransferQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTransferQuotaResponse proto.InternalMessageInfo
+
+// MsgSetReceiverPrefix defines the request type for the SetReceiverPrefix rpc.
+// An empty prefix removes the receiver prefix of the channel.
+type MsgSetReceiverPrefix struct {
+	// signer address
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// the channel on which the receiver prefix applies
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the expected bech32 human readable part of receiver addresses
+	Prefix string `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+}
+
+func (m *MsgSetReceiverPrefix) Reset()         { *m = MsgSetReceiverPrefix{} }
+func (m *MsgSetReceiverPrefix) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReceiverPrefix) ProtoMessage()    {}
+func (*MsgSetReceiverPrefix) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{6}
+}
+func (m *MsgSetReceiverPrefix) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReceiverPrefix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReceiverPrefix.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetReceiverPrefix) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReceiverPrefix.Merge(m, src)
+}
+func (m *MsgSetReceiverPrefix) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReceiverPrefix) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReceiverPrefix.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReceiverPrefix proto.InternalMessageInfo
+
+// MsgSetReceiverPrefixResponse defines the response type for the SetReceiverPrefix rpc.
+type MsgSetReceiverPrefixResponse struct {
+}
+
+func (m *MsgSetReceiverPrefixResponse) Reset()         { *m = MsgSetReceiverPrefixResponse{} }
+func (m *MsgSetReceiverPrefixResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetReceiverPrefixResponse) ProtoMessage()    {}
+func (*MsgSetReceiverPrefixResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7401ed9bed2f8e09, []int{7}
+}
+func (m *MsgSetReceiverPrefixResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetReceiverPrefixResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetReceiverPrefixResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetReceiverPrefixResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetReceiverPrefixResponse.Merge(m, src)
+}
+func (m *MsgSetReceiverPrefixResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetReceiverPrefixResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetReceiverPrefixResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetReceiverPrefixResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgTransfer)(nil), "ibc.applications.transfer.v1.MsgTransfer")
 	proto.RegisterType((*MsgTransferResponse)(nil), "ibc.applications.transfer.v1.MsgTransferResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ibc.applications.transfer.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.applications.transfer.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetTransferQuota)(nil), "ibc.applications.transfer.v1.MsgSetTransferQuota")
+	proto.RegisterType((*MsgSetTransferQuotaResponse)(nil), "ibc.applications.transfer.v1.MsgSetTransferQuotaResponse")
+	proto.RegisterType((*MsgSetReceiverPrefix)(nil), "ibc.applications.transfer.v1.MsgSetReceiverPrefix")
+	proto.RegisterType((*MsgSetReceiverPrefixResponse)(nil), "ibc.applications.transfer.v1.MsgSetReceiverPrefixResponse")
 }
 
 func init() {
@@ -221,46 +402,63 @@ func init() {
 }
 
 var fileDescriptor_7401ed9bed2f8e09 = []byte{
-	// 613 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x4f, 0x13, 0x4f,
-	0x14, 0xef, 0x7e, 0x29, 0xfd, 0xc2, 0x54, 0x40, 0x56, 0x03, 0xcb, 0xc6, 0x6c, 0x49, 0x23, 0x09,
-	0x96, 0x30, 0x93, 0x62, 0x0c, 0xa6, 0xc7, 0x72, 0xf1, 0x20, 0x09, 0x36, 0x78, 0xf1, 0x42, 0x76,
-	0xa7, 0xcf, 0xed, 0x84, 0xee, 0xcc, 0x3a, 0x33, 0x6d, 0xf4, 0x62, 0x88, 0x27, 0xe3, 0xc9, 0x3f,
-	0xc1, 0xa3, 0x47, 0xfe, 0x0c, 0x8e, 0x1c, 0x3d, 0x19, 0x03, 0x07, 0x2e, 0xfe, 0x11, 0x66, 0x66,
-	0xa7, 0x75, 0xf5, 0x50, 0xf5, 0xb2, 0xfb, 0x7e, 0x7c, 0xde, 0xaf, 0xcf, 0x9b, 0x87, 0xb6, 0x58,
-	0x42, 0x49, 0x9c, 0xe7, 0x43, 0x46, 0x63, 0xcd, 0x04, 0x57, 0x44, 0xcb, 0x98, 0xab, 0x97, 0x20,
-	0xc9, 0xb8, 0x4d, 0xf4, 0x6b, 0x9c, 0x4b, 0xa1, 0x85, 0x7f, 0x8f, 0x25, 0x14, 0x97, 0x61, 0x78,
-	0x02, 0xc3, 0xe3, 0x76, 0xb8, 0x1a, 0x67, 0x8c, 0x0b, 0x62, 0xbf, 0x45, 0x40, 0x78, 0x37, 0x15,
-	0xa9, 0xb0, 0x22, 0x31, 0x92, 0xb3, 0xae, 0x53, 0xa1, 0x32, 0xa1, 0x48, 0xa6, 0x52, 0x93, 0x3e,
-	0x53, 0xa9, 0x73, 0x44, 0xce, 0x91, 0xc4, 0x0a, 0xc8, 0xb8, 0x9d, 0x80, 0x8e, 0xdb, 0x84, 0x0a,
-	0xc6, 0x9d, 0xbf, 0x61, 0xda, 0xa4, 0x42, 0x02, 0xa1, 0x43, 0x06, 0x5c, 0x9b, 0xe8, 0x42, 0x72,
-	0x80, 0x9d, 0xd9, 0x73, 0x4c, 0x9a, 0xb5, 0xe0, 0xe6, 0xd9, 0x1c, 0xaa, 0x1f, 0xaa, 0xf4, 0xd8,
-	0x59, 0xfd, 0x06, 0xaa, 0x2b, 0x31, 0x92, 0x14, 0x4e, 0x72, 0x21, 0x75, 0xe0, 0x6d, 0x7a, 0xdb,
-	0x8b, 0x3d, 0x54, 0x98, 0x8e, 0x84, 0xd4, 0xfe, 0x16, 0x5a, 0x76, 0x00, 0x3a, 0x88, 0x39, 0x87,
-	0x61, 0xf0, 0x9f, 0xc5, 0x2c, 0x15, 0xd6, 0x83, 0xc2, 0xe8, 0x77, 0xd0, 0xbc, 0x16, 0xa7, 0xc0,
-	0x83, 0xb9, 0x4d, 0x6f, 0xbb, 0xbe, 0xb7, 0x81, 0x8b, 0xa9, 0xb0, 0x99, 0x0a, 0xbb, 0xa9, 0xf0,
-	0x81, 0x60, 0xbc, 0xbb, 0x78, 0xf1, 0xb5, 0x51, 0xf9, 0x7c, 0x73, 0xde, 0xf2, 0x7a, 0x45, 0x88,
-	0xbf, 0x86, 0x6a, 0x0a, 0x78, 0x1f, 0x64, 0x50, 0xb5, 0xa9, 0x9d, 0xe6, 0x87, 0x68, 0x41, 0x02,
-	0x05, 0x36, 0x06, 0x19, 0xcc, 0x5b, 0xcf, 0x54, 0xf7, 0x9f, 0xa2, 0x65, 0xcd, 0x32, 0x10, 0x23,
-	0x7d, 0x32, 0x00, 0x96, 0x0e, 0x74, 0x50, 0xb3, 0x85, 0x43, 0x6c, 0xd6, 0x65, 0xe8, 0xc2, 0x8e,
-	0xa4, 0x71, 0x1b, 0x3f, 0xb1, 0x88, 0x72, 0xe5, 0x25, 0x17, 0x5c, 0x78, 0xfc, 0x1d, 0xb4, 0x3a,
-	0xc9, 0x66, 0xfe, 0x4a, 0xc7, 0x59, 0x1e, 0xfc, 0xbf, 0xe9, 0x6d, 0x57, 0x7b, 0xb7, 0x9d, 0xe3,
-	0x78, 0x62, 0xf7, 0x7d, 0x54, 0xcd, 0x20, 0x13, 0xc1, 0x82, 0x6d, 0xc9, 0xca, 0x9d, 0xd6, 0xfb,
-	0x4f, 0x8d, 0xca, 0xbb, 0x9b, 0xf3, 0x96, 0xeb, 0xfd, 0xc3, 0xcd, 0x79, 0x6b, 0xad, 0xa0, 0x60,
-	0x57, 0xf5, 0x4f, 0x49, 0x89, 0xf2, 0xe6, 0x3e, 0xba, 0x53, 0x52, 0x7b, 0xa0, 0x72, 0xc1, 0x15,
-	0x98, 0x69, 0x15, 0xbc, 0x1a, 0x01, 0xa7, 0x60, 0xd7, 0x50, 0xed, 0x4d, 0xf5, 0x4e, 0xd5, 0xa4,
-	0x6f, 0xbe, 0x45, 0x2b, 0x87, 0x2a, 0x7d, 0x9e, 0xf7, 0x63, 0x0d, 0x47, 0xb1, 0x8c, 0x33, 0x65,
-	0xa9, 0x63, 0x29, 0x07, 0xe9, 0x36, 0xe7, 0x34, 0xbf, 0x8b, 0x6a, 0xb9, 0x45, 0xd8, 0x6d, 0xd5,
-	0xf7, 0xee, 0xe3, 0x59, 0xaf, 0x18, 0x17, 0xd9, 0xba, 0x55, 0x43, 0x50, 0xcf, 0x45, 0x76, 0x56,
-	0x7e, 0xce, 0x64, 0x93, 0x36, 0x37, 0xd0, 0xfa, 0x6f, 0xf5, 0x27, 0xcd, 0xef, 0x7d, 0xf7, 0xd0,
-	0xdc, 0xa1, 0x4a, 0xfd, 0x01, 0x5a, 0x98, 0x3e, 0xad, 0x07, 0xb3, 0x6b, 0x96, 0x38, 0x08, 0xdb,
-	0x7f, 0x0d, 0x9d, 0xd2, 0xa5, 0xd1, 0xad, 0x5f, 0x98, 0xd8, 0xfd, 0x63, 0x8a, 0x32, 0x3c, 0x7c,
-	0xf4, 0x4f, 0xf0, 0x49, 0xd5, 0x70, 0xfe, 0xcc, 0x3c, 0x9f, 0xee, 0xb3, 0x8b, 0xab, 0xc8, 0xbb,
-	0xbc, 0x8a, 0xbc, 0x6f, 0x57, 0x91, 0xf7, 0xf1, 0x3a, 0xaa, 0x5c, 0x5e, 0x47, 0x95, 0x2f, 0xd7,
-	0x51, 0xe5, 0xc5, 0x7e, 0xca, 0xf4, 0x60, 0x94, 0x60, 0x2a, 0x32, 0xe2, 0x0e, 0x9b, 0x25, 0x74,
-	0x37, 0x15, 0x64, 0xfc, 0x98, 0x64, 0xa2, 0x3f, 0x1a, 0x82, 0x32, 0xc7, 0x5a, 0x3a, 0x52, 0xfd,
-	0x26, 0x07, 0x95, 0xd4, 0xec, 0x7d, 0x3e, 0xfc, 0x11, 0x00, 0x00, 0xff, 0xff, 0x3b, 0xda, 0xd1,
-	0xc3, 0x96, 0x04, 0x00, 0x00,
+	// 895 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
+	0x1c, 0xf5, 0x36, 0xb6, 0xb1, 0x7f, 0x26, 0x4e, 0xb3, 0x84, 0x74, 0xb3, 0xb4, 0x76, 0x64, 0x11,
+	0x11, 0x12, 0x65, 0x47, 0x0e, 0x42, 0x05, 0xdf, 0x70, 0x39, 0x50, 0xd4, 0x88, 0x74, 0x29, 0x17,
+	0x2e, 0xd6, 0x78, 0x77, 0xbc, 0x1e, 0xc5, 0x3b, 0xb3, 0xec, 0xcc, 0x9a, 0x70, 0x41, 0x15, 0x5c,
+	0x10, 0x12, 0x12, 0x47, 0x8e, 0x1c, 0x39, 0xe6, 0xc0, 0x87, 0xe8, 0x31, 0xe2, 0x84, 0x38, 0x14,
+	0x94, 0x1c, 0xf2, 0x09, 0xb8, 0xa3, 0x9d, 0x99, 0x35, 0x6e, 0x5d, 0xd2, 0x84, 0x8b, 0x3d, 0xbf,
+	0xff, 0x6f, 0xde, 0xbc, 0xd9, 0x81, 0x2d, 0x3a, 0x0c, 0x10, 0x4e, 0x92, 0x09, 0x0d, 0xb0, 0xa4,
+	0x9c, 0x09, 0x24, 0x53, 0xcc, 0xc4, 0x88, 0xa4, 0x68, 0xda, 0x45, 0xf2, 0xd8, 0x4b, 0x52, 0x2e,
+	0xb9, 0x7d, 0x9b, 0x0e, 0x03, 0x6f, 0x3e, 0xcd, 0x2b, 0xd2, 0xbc, 0x69, 0xd7, 0x5d, 0xc5, 0x31,
+	0x65, 0x1c, 0xa9, 0x5f, 0x5d, 0xe0, 0xae, 0x45, 0x3c, 0xe2, 0x6a, 0x89, 0xf2, 0x95, 0xf1, 0xde,
+	0x0a, 0xb8, 0x88, 0xb9, 0x40, 0xb1, 0x88, 0xf2, 0xf6, 0xb1, 0x88, 0x4c, 0xa0, 0x65, 0x02, 0x43,
+	0x2c, 0x08, 0x9a, 0x76, 0x87, 0x44, 0xe2, 0x2e, 0x0a, 0x38, 0x65, 0x26, 0xbe, 0xa1, 0xe3, 0x03,
+	0xdd, 0x51, 0x1b, 0x45, 0x69, 0xc4, 0x79, 0x34, 0x21, 0x48, 0x59, 0xc3, 0x6c, 0x84, 0xc2, 0x2c,
+	0x55, 0x18, 0x4d, 0xbc, 0x9d, 0xef, 0x30, 0xe0, 0x29, 0x41, 0xc1, 0x84, 0x12, 0x26, 0xf3, 0xc1,
+	0x7a, 0x65, 0x12, 0x76, 0x2f, 0xa7, 0xa0, 0xd8, 0xa7, 0x4a, 0xee, 0x9c, 0x2e, 0x41, 0xe3, 0x40,
+	0x44, 0x8f, 0x8c, 0xd7, 0x6e, 0x43, 0x43, 0xf0, 0x2c, 0x0d, 0xc8, 0x20, 0xe1, 0xa9, 0x74, 0xac,
+	0x4d, 0x6b, 0xbb, 0xee, 0x83, 0x76, 0x1d, 0xf2, 0x54, 0xda, 0x5b, 0xd0, 0x34, 0x09, 0xc1, 0x18,
+	0x33, 0x46, 0x26, 0xce, 0x0d, 0x95, 0xb3, 0xac, 0xbd, 0xf7, 0xb4, 0xd3, 0xee, 0x41, 0x45, 0xf2,
+	0x23, 0xc2, 0x9c, 0xa5, 0x4d, 0x6b, 0xbb, 0xb1, 0xbf, 0xe1, 0x99, 0x3d, 0xe6, 0x84, 0x78, 0x86,
+	0x10, 0xef, 0x1e, 0xa7, 0xac, 0x5f, 0x7f, 0xf2, 0xb4, 0x5d, 0xfa, 0xe5, 0xe2, 0x64, 0xc7, 0xf2,
+	0x75, 0x89, 0xbd, 0x0e, 0x55, 0x41, 0x58, 0x48, 0x52, 0xa7, 0xac, 0x5a, 0x1b, 0xcb, 0x76, 0xa1,
+	0x96, 0x92, 0x80, 0xd0, 0x29, 0x49, 0x9d, 0x8a, 0x8a, 0xcc, 0x6c, 0xfb, 0x01, 0x34, 0x25, 0x8d,
+	0x09, 0xcf, 0xe4, 0x60, 0x4c, 0x68, 0x34, 0x96, 0x4e, 0x55, 0x0d, 0x76, 0xbd, 0xfc, 0xa4, 0x73,
+	0xba, 0x3c, 0x43, 0xd2, 0xb4, 0xeb, 0x7d, 0xa4, 0x32, 0xe6, 0x27, 0x2f, 0x9b, 0x62, 0x1d, 0xb1,
+	0x77, 0x61, 0xb5, 0xe8, 0x96, 0xff, 0x0b, 0x89, 0xe3, 0xc4, 0x79, 0x65, 0xd3, 0xda, 0x2e, 0xfb,
+	0x37, 0x4d, 0xe0, 0x51, 0xe1, 0xb7, 0x6d, 0x28, 0xc7, 0x24, 0xe6, 0x4e, 0x4d, 0x41, 0x52, 0x6b,
+	0xfb, 0x2d, 0x58, 0xc9, 0x98, 0xc0, 0x23, 0x32, 0x98, 0x21, 0xae, 0x6f, 0x5a, 0xdb, 0x35, 0xbf,
+	0xa9, 0xdd, 0x7e, 0x81, 0x7b, 0x0b, 0x9a, 0x29, 0x19, 0x65, 0x2c, 0x1c, 0xe0, 0x30, 0x4c, 0x89,
+	0x10, 0x0e, 0x68, 0x3a, 0xb5, 0xf7, 0x03, 0xed, 0xec, 0xed, 0x7c, 0xf7, 0x73, 0xbb, 0xf4, 0xcd,
+	0xc5, 0xc9, 0x8e, 0xe1, 0xe2, 0xfb, 0x8b, 0x93, 0x9d, 0x75, 0x4d, 0xe9, 0x9e, 0x08, 0x8f, 0xd0,
+	0xdc, 0x11, 0x76, 0xee, 0xc2, 0x6b, 0x73, 0xa6, 0x4f, 0x44, 0xc2, 0x99, 0x20, 0x39, 0x7b, 0x82,
+	0x7c, 0x91, 0x11, 0x16, 0x10, 0x75, 0xac, 0x65, 0x7f, 0x66, 0xf7, 0xca, 0x79, 0xfb, 0xce, 0xd7,
+	0xb0, 0x72, 0x20, 0xa2, 0xcf, 0x92, 0x10, 0x4b, 0x72, 0x88, 0x53, 0x1c, 0x0b, 0x75, 0x14, 0x34,
+	0x62, 0x24, 0x35, 0x4a, 0x30, 0x96, 0xdd, 0x87, 0x6a, 0xa2, 0x32, 0xd4, 0xe9, 0x37, 0xf6, 0xdf,
+	0xf4, 0x2e, 0xbb, 0x50, 0x9e, 0xee, 0xd6, 0x2f, 0xe7, 0x84, 0xfb, 0xa6, 0xb2, 0xb7, 0xf2, 0xef,
+	0x9e, 0x54, 0xd3, 0xce, 0x06, 0xdc, 0x7a, 0x6e, 0x7e, 0x01, 0xbe, 0xf3, 0xc3, 0x0d, 0xb5, 0xa9,
+	0x4f, 0x89, 0x2c, 0xf6, 0xf5, 0x30, 0xe3, 0x12, 0xff, 0x27, 0xbe, 0x3b, 0x00, 0x46, 0x9e, 0x03,
+	0x1a, 0x1a, 0x85, 0xd6, 0x8d, 0xe7, 0x7e, 0x68, 0xaf, 0x41, 0x25, 0x24, 0x8c, 0xc7, 0x4a, 0x9d,
+	0x75, 0x5f, 0x1b, 0xf6, 0x03, 0x68, 0xc4, 0xf8, 0x78, 0xc0, 0x33, 0x39, 0x9a, 0xf0, 0x2f, 0xb5,
+	0xf8, 0xfa, 0xbb, 0x39, 0xe6, 0x3f, 0x9e, 0xb6, 0x5f, 0xd7, 0x6c, 0x8b, 0xf0, 0xc8, 0xa3, 0x1c,
+	0xc5, 0x58, 0x8e, 0xbd, 0xfb, 0x4c, 0xfe, 0xf6, 0xeb, 0x1e, 0xe8, 0x40, 0x6e, 0xf9, 0x10, 0xe3,
+	0xe3, 0x4f, 0x74, 0xb9, 0xfd, 0x31, 0x34, 0x49, 0xc2, 0x83, 0xf1, 0xa0, 0xb8, 0xbf, 0x4e, 0xc5,
+	0x5c, 0x05, 0x7d, 0xc1, 0xbd, 0xe2, 0x82, 0x7b, 0x1f, 0x9a, 0x84, 0x7e, 0x2d, 0x9f, 0xf5, 0xd3,
+	0x9f, 0x6d, 0xcb, 0x5f, 0x56, 0xa5, 0x45, 0x60, 0x91, 0xaa, 0x3b, 0xf0, 0xc6, 0x0b, 0xe8, 0x98,
+	0xd1, 0x35, 0x85, 0x35, 0x1d, 0x2e, 0x74, 0x76, 0x98, 0x92, 0x11, 0x3d, 0xfe, 0xbf, 0x74, 0xad,
+	0x43, 0x35, 0x51, 0x0d, 0x0c, 0x5f, 0xc6, 0x5a, 0x84, 0xd5, 0x82, 0xdb, 0x2f, 0x9a, 0x5b, 0xe0,
+	0xda, 0xff, 0x7b, 0x09, 0x96, 0x0e, 0x44, 0x64, 0x8f, 0xa1, 0x36, 0xfb, 0xe2, 0xbc, 0x7d, 0xb9,
+	0x74, 0xe6, 0xa4, 0xec, 0x76, 0xaf, 0x9c, 0x3a, 0x53, 0xbd, 0x84, 0x57, 0x9f, 0x11, 0xf4, 0xde,
+	0x4b, 0x5b, 0xcc, 0xa7, 0xbb, 0xef, 0x5e, 0x2b, 0x7d, 0x36, 0xf5, 0xb1, 0x05, 0x37, 0x17, 0xb4,
+	0xfa, 0x72, 0xf4, 0xcf, 0x97, 0xb8, 0xef, 0x5f, 0xbb, 0x64, 0x06, 0xe1, 0x5b, 0x0b, 0x56, 0x17,
+	0x05, 0xb0, 0x7f, 0x95, 0x86, 0xcf, 0xd6, 0xb8, 0xbd, 0xeb, 0xd7, 0x14, 0x28, 0xdc, 0xca, 0xe3,
+	0xfc, 0xf3, 0xda, 0x7f, 0xf8, 0xe4, 0xac, 0x65, 0x9d, 0x9e, 0xb5, 0xac, 0xbf, 0xce, 0x5a, 0xd6,
+	0x8f, 0xe7, 0xad, 0xd2, 0xe9, 0x79, 0xab, 0xf4, 0xfb, 0x79, 0xab, 0xf4, 0xf9, 0xdd, 0x88, 0xca,
+	0x71, 0x36, 0xf4, 0x02, 0x1e, 0x9b, 0x67, 0x10, 0xd1, 0x61, 0xb0, 0x17, 0x71, 0x34, 0x7d, 0x0f,
+	0xc5, 0x3c, 0xcc, 0x26, 0x44, 0xe4, 0x8f, 0xd9, 0xdc, 0x23, 0x26, 0xbf, 0x4a, 0x88, 0x18, 0x56,
+	0xd5, 0xf5, 0x79, 0xe7, 0x9f, 0x01, 0x00, 0x59, 0xf0, 0xa1, 0xea, 0xf1, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -279,6 +477,10 @@ type MsgClient interface {
 	Transfer(ctx context.Context, in *MsgTransfer, opts ...grpc.CallOption) (*MsgTransferResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetTransferQuota defines a rpc handler for MsgSetTransferQuota.
+	SetTransferQuota(ctx context.Context, in *MsgSetTransferQuota, opts ...grpc.CallOption) (*MsgSetTransferQuotaResponse, error)
+	// SetReceiverPrefix defines a rpc handler for MsgSetReceiverPrefix.
+	SetReceiverPrefix(ctx context.Context, in *MsgSetReceiverPrefix, opts ...grpc.CallOption) (*MsgSetReceiverPrefixResponse, error)
 }
 
 type msgClient struct {
@@ -307,12 +509,34 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetTransferQuota(ctx context.Context, in *MsgSetTransferQuota, opts ...grpc.CallOption) (*MsgSetTransferQuotaResponse, error) {
+	out := new(MsgSetTransferQuotaResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/SetTransferQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetReceiverPrefix(ctx context.Context, in *MsgSetReceiverPrefix, opts ...grpc.CallOption) (*MsgSetReceiverPrefixResponse, error) {
+	out := new(MsgSetReceiverPrefixResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v1.Msg/SetReceiverPrefix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Transfer defines a rpc handler method for MsgTransfer.
 	Transfer(context.Context, *MsgTransfer) (*MsgTransferResponse, error)
 	// UpdateParams defines a rpc handler for MsgUpdateParams.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetTransferQuota defines a rpc handler for MsgSetTransferQuota.
+	SetTransferQuota(context.Context, *MsgSetTransferQuota) (*MsgSetTransferQuotaResponse, error)
+	// SetReceiverPrefix defines a rpc handler for MsgSetReceiverPrefix.
+	SetReceiverPrefix(context.Context, *MsgSetReceiverPrefix) (*MsgSetReceiverPrefixResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -325,6 +549,12 @@ func (*UnimplementedMsgServer) Transfer(ctx context.Context, req *MsgTransfer) (
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetTransferQuota(ctx context.Context, req *MsgSetTransferQuota) (*MsgSetTransferQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransferQuota not implemented")
+}
+func (*UnimplementedMsgServer) SetReceiverPrefix(ctx context.Context, req *MsgSetReceiverPrefix) (*MsgSetReceiverPrefixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReceiverPrefix not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -366,6 +596,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetTransferQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTransferQuota)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTransferQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/SetTransferQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTransferQuota(ctx, req.(*MsgSetTransferQuota))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetReceiverPrefix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetReceiverPrefix)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetReceiverPrefix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v1.Msg/SetReceiverPrefix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetReceiverPrefix(ctx, req.(*MsgSetReceiverPrefix))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.applications.transfer.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -378,6 +644,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetTransferQuota",
+			Handler:    _Msg_SetTransferQuota_Handler,
+		},
+		{
+			MethodName: "SetReceiverPrefix",
+			Handler:    _Msg_SetReceiverPrefix_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/applications/transfer/v1/tx.proto",
@@ -403,6 +677,23 @@ func (m *MsgTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RefundAddress) > 0 {
+		i -= len(m.RefundAddress)
+		copy(dAtA[i:], m.RefundAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RefundAddress)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.UnsafeReceiver {
+		i--
+		if m.UnsafeReceiver {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if len(m.Memo) > 0 {
 		i -= len(m.Memo)
 		copy(dAtA[i:], m.Memo)
@@ -557,6 +848,158 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetTransferQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTransferQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxOutflow.Size()
+		i -= size
+		if _, err := m.MaxOutflow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTransferQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTransferQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTransferQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReceiverPrefix) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReceiverPrefix) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReceiverPrefix) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefix) > 0 {
+		i -= len(m.Prefix)
+		copy(dAtA[i:], m.Prefix)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Prefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetReceiverPrefixResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetReceiverPrefixResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetReceiverPrefixResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -601,6 +1044,13 @@ func (m *MsgTransfer) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.UnsafeReceiver {
+		n += 2
+	}
+	l = len(m.RefundAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -640,7 +1090,71 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
+func (m *MsgSetTransferQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxOutflow.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetTransferQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetReceiverPrefix) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetReceiverPrefixResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
@@ -920,6 +1434,58 @@ func (m *MsgTransfer) Unmarshal(dAtA []byte) error {
 			}
 			m.Memo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnsafeReceiver", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnsafeReceiver = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1175,6 +1741,465 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetTransferQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTransferQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTransferQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutflow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOutflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTransferQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTransferQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTransferQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetReceiverPrefix) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetReceiverPrefix: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetReceiverPrefix: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetReceiverPrefixResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetReceiverPrefixResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetReceiverPrefixResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
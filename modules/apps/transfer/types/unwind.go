@@ -0,0 +1,119 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// DefaultUnwindRelativeTimeout is the timeout, relative to the block time of the receiving
+// chain, of the transfers sent to unwind received tokens if the unwind memo does not provide one.
+const DefaultUnwindRelativeTimeout = 10 * time.Minute
+
+// UnwindMemo holds the instructions provided under the UnwindMemoKey of the memo to send
+// the received tokens back along the first hop of their denomination trace. Next is used
+// as the memo of the follow-on transfer and may itself contain an UnwindMemo to continue
+// unwinding on the next chain. Timeout is the timeout of the follow-on transfer relative to
+// the block time of the receiving chain, as a duration string, e.g.:
+//
+//	{"unwind":{"receiver":"cosmos1...","timeout":"30m","next":{"unwind":{"receiver":"osmo1..."}}}}
+type UnwindMemo struct {
+	Receiver string          `json:"receiver"`
+	Timeout  string          `json:"timeout,omitempty"`
+	Next     json.RawMessage `json:"next,omitempty"`
+}
+
+// ValidateBasic performs a basic validation of the UnwindMemo fields.
+func (m UnwindMemo) ValidateBasic() error {
+	if strings.TrimSpace(m.Receiver) == "" {
+		return errorsmod.Wrap(ErrInvalidMemo, "unwind receiver cannot be empty")
+	}
+
+	if _, err := m.RelativeTimeout(); err != nil {
+		return err
+	}
+
+	if len(m.Next) != 0 && !bytes.HasPrefix(bytes.TrimSpace(m.Next), []byte("{")) {
+		return errorsmod.Wrap(ErrInvalidMemo, "unwind next memo must be a JSON object")
+	}
+
+	return nil
+}
+
+// RelativeTimeout returns the timeout of the follow-on transfer relative to the block time of
+// the receiving chain. DefaultUnwindRelativeTimeout is returned if the memo does not provide one.
+func (m UnwindMemo) RelativeTimeout() (time.Duration, error) {
+	if m.Timeout == "" {
+		return DefaultUnwindRelativeTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(m.Timeout)
+	if err != nil {
+		return 0, errorsmod.Wrapf(ErrInvalidMemo, "invalid unwind timeout %s: %s", m.Timeout, err)
+	}
+
+	if timeout <= 0 {
+		return 0, errorsmod.Wrapf(ErrInvalidMemo, "unwind timeout must be positive: %s", m.Timeout)
+	}
+
+	return timeout, nil
+}
+
+// GetUnwindMemo returns the UnwindMemo provided under the UnwindMemoKey of the memo.
+// Nil is returned if the memo is not a JSON object or does not contain the key.
+func (ftpd FungibleTokenPacketData) GetUnwindMemo() (*UnwindMemo, error) {
+	return parseUnwindMemo(ftpd.Memo)
+}
+
+// UnwindDepth returns the number of consecutive unwind hops requested by the memo, that is
+// the number of UnwindMemos nested through their next memos. Counting stops once the depth
+// exceeds maxDepth.
+func (m UnwindMemo) UnwindDepth(maxDepth uint32) (uint32, error) {
+	depth := uint32(1)
+	next := m.Next
+	for len(next) != 0 && depth <= maxDepth {
+		unwindMemo, err := parseUnwindMemo(string(next))
+		if err != nil {
+			return 0, err
+		}
+		if unwindMemo == nil {
+			break
+		}
+
+		depth++
+		next = unwindMemo.Next
+	}
+
+	return depth, nil
+}
+
+// parseUnwindMemo parses and validates the UnwindMemo under the UnwindMemoKey of the provided memo.
+func parseUnwindMemo(memo string) (*UnwindMemo, error) {
+	if len(memo) == 0 {
+		return nil, nil
+	}
+
+	jsonObject := make(map[string]json.RawMessage)
+	if err := json.Unmarshal([]byte(memo), &jsonObject); err != nil {
+		return nil, nil
+	}
+
+	bz, found := jsonObject[UnwindMemoKey]
+	if !found {
+		return nil, nil
+	}
+
+	var unwindMemo UnwindMemo
+	if err := json.Unmarshal(bz, &unwindMemo); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidMemo, "failed to unmarshal unwind memo: %s", err)
+	}
+
+	if err := unwindMemo.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &unwindMemo, nil
+}
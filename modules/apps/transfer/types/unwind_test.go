@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+func TestGetUnwindMemo(t *testing.T) {
+	testCases := []struct {
+		name          string
+		memo          string
+		expUnwindMemo *types.UnwindMemo
+		expErr        error
+	}{
+		{"empty memo", "", nil, nil},
+		{"plain text memo", "hello", nil, nil},
+		{"JSON memo without unwind", `{"wasm":{}}`, nil, nil},
+		{"unwind memo", `{"unwind":{"receiver":"cosmos1"}}`, &types.UnwindMemo{Receiver: "cosmos1"}, nil},
+		{"unwind memo with next memo", `{"unwind":{"receiver":"cosmos1","next":{"wasm":{}}}}`, &types.UnwindMemo{Receiver: "cosmos1", Next: json.RawMessage(`{"wasm":{}}`)}, nil},
+		{"unwind memo with timeout", `{"unwind":{"receiver":"cosmos1","timeout":"30m"}}`, &types.UnwindMemo{Receiver: "cosmos1", Timeout: "30m"}, nil},
+		{"failure: invalid timeout", `{"unwind":{"receiver":"cosmos1","timeout":"30"}}`, nil, types.ErrInvalidMemo},
+		{"failure: non-positive timeout", `{"unwind":{"receiver":"cosmos1","timeout":"-1m"}}`, nil, types.ErrInvalidMemo},
+		{"failure: unwind is not a JSON object", `{"unwind":"cosmos1"}`, nil, types.ErrInvalidMemo},
+		{"failure: empty receiver", `{"unwind":{"receiver":" "}}`, nil, types.ErrInvalidMemo},
+		{"failure: next memo is not a JSON object", `{"unwind":{"receiver":"cosmos1","next":["wasm"]}}`, nil, types.ErrInvalidMemo},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			data := types.NewFungibleTokenPacketData("stake", "100", "sender", "receiver", tc.memo)
+
+			unwindMemo, err := data.GetUnwindMemo()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.expUnwindMemo, unwindMemo)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestUnwindDepth(t *testing.T) {
+	testCases := []struct {
+		name     string
+		next     string
+		maxDepth uint32
+		expDepth uint32
+		expErr   error
+	}{
+		{"single hop", "", 3, 1, nil},
+		{"next memo without unwind", `{"wasm":{}}`, 3, 1, nil},
+		{"nested hops", `{"unwind":{"receiver":"cosmos1","next":{"unwind":{"receiver":"cosmos1"}}}}`, 3, 3, nil},
+		{"counting stops above maximum depth", `{"unwind":{"receiver":"cosmos1","next":{"unwind":{"receiver":"cosmos1"}}}}`, 1, 2, nil},
+		{"failure: invalid nested unwind memo", `{"unwind":{"receiver":""}}`, 3, 0, types.ErrInvalidMemo},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			unwindMemo := types.UnwindMemo{Receiver: "cosmos1", Next: json.RawMessage(tc.next)}
+
+			depth, err := unwindMemo.UnwindDepth(tc.maxDepth)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.expDepth, depth)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -8,7 +8,7 @@ import (
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 )
 
-// BeginBlocker is used to perform IBC client upgrades
+// BeginBlocker is used to perform IBC client upgrades and to forfeit the deposits of abandoned clients
 func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
 	plan, err := k.GetUpgradePlan(ctx)
 	if err == nil {
@@ -39,4 +39,6 @@ func BeginBlocker(ctx sdk.Context, k *keeper.Keeper) {
 			k.UpdateLocalhostClient(ctx, clientState)
 		}
 	}
+
+	k.ForfeitAbandonedClientDeposits(ctx)
 }
@@ -22,6 +22,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryClientStates(),
 		GetCmdQueryClientState(),
 		GetCmdQueryClientStatus(),
+		GetCmdQueryClientAlias(),
+		GetCmdQueryVerifyClientParameters(),
 		GetCmdQueryConsensusStates(),
 		GetCmdQueryConsensusStateHeights(),
 		GetCmdQueryConsensusState(),
@@ -48,7 +50,9 @@ func NewTxCmd() *cobra.Command {
 		newUpdateClientCmd(),
 		newSubmitMisbehaviourCmd(), // Deprecated
 		newUpgradeClientCmd(),
+		newReclaimClientDepositCmd(),
 		newSubmitRecoverClientProposalCmd(),
+		newSubmitFreezeClientProposalCmd(),
 		newScheduleIBCUpgradeProposalCmd(),
 	)
 
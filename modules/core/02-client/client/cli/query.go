@@ -16,7 +16,8 @@ import (
 )
 
 const (
-	flagLatestHeight = "latest-height"
+	flagLatestHeight    = "latest-height"
+	flagUnbondingPeriod = "unbonding-period"
 )
 
 // GetCmdQueryClientStates defines the command to query all the light clients
@@ -125,6 +126,83 @@ func GetCmdQueryClientStatus() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryClientAlias defines the command to query the alias of a client
+func GetCmdQueryClientAlias() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "alias [client-id-or-alias]",
+		Short:   "Query client alias",
+		Long:    "Query the client identifier and human-readable alias of a client by its client identifier or alias",
+		Example: fmt.Sprintf("%s query %s %s alias [client-id-or-alias]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryClientAliasRequest{
+				ClientId: args[0],
+			}
+
+			res, err := queryClient.ClientAlias(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVerifyClientParameters defines the command to verify the parameters of a client
+// against their recommended bounds.
+func GetCmdQueryVerifyClientParameters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-parameters [client-id]",
+		Short: "Verify client parameters against their recommended bounds",
+		Long: `Verify that the trusting period, max clock drift and trust level of a client lie within their recommended bounds.
+If the '--unbonding-period' flag is not provided, the unbonding period stored in the client state is used as the counterparty unbonding period.`,
+		Example: fmt.Sprintf("%s query %s %s verify-parameters [client-id] --%s 504h", version.AppName, ibcexported.ModuleName, types.SubModuleName, flagUnbondingPeriod),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			unbondingPeriod, err := cmd.Flags().GetDuration(flagUnbondingPeriod)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryVerifyClientParametersRequest{
+				ClientId:                    args[0],
+				CounterpartyUnbondingPeriod: unbondingPeriod,
+			}
+
+			res, err := queryClient.VerifyClientParameters(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(flagUnbondingPeriod, 0, "unbonding period of the counterparty chain")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryConsensusStates defines the command to query all the consensus states from a given
 // client state.
 func GetCmdQueryConsensusStates() *cobra.Command {
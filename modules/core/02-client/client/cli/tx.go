@@ -23,7 +23,10 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-const FlagAuthority = "authority"
+const (
+	FlagAuthority      = "authority"
+	FlagTrustedHeights = "trusted-heights"
+)
 
 // newCreateClientCmd defines the command to create a new IBC light client.
 func newCreateClientCmd() *cobra.Command {
@@ -132,6 +135,30 @@ func newUpdateClientCmd() *cobra.Command {
 	return cmd
 }
 
+// newReclaimClientDepositCmd defines the command to reclaim the deposit escrowed for the creation of a client.
+func newReclaimClientDepositCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "reclaim-deposit [client-id]",
+		Short:   "reclaim the deposit escrowed for the creation of a client",
+		Long:    "reclaim the deposit escrowed for the creation of a client which has not been active for the client deposit grace period, the signer must be the depositor",
+		Example: fmt.Sprintf("%s tx ibc %s reclaim-deposit [client-id] --from node0 --home ../node0/<app>cli --chain-id $CID", version.AppName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReclaimClientDeposit(clientCtx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // newSubmitMisbehaviourCmd defines the command to submit a misbehaviour to prevent
 // future updates.
 // Deprecated: NewSubmitMisbehaviourCmd is deprecated and will be removed in a future release.
@@ -245,15 +272,23 @@ func newUpgradeClientCmd() *cobra.Command {
 	return cmd
 }
 
-// newSubmitRecoverClientProposalCmd defines the command to recover an IBC light client.
+// newSubmitRecoverClientProposalCmd defines the command to recover one or more IBC light clients.
 func newSubmitRecoverClientProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "recover-client [subject-client-id] [substitute-client-id] [flags]",
-		Args:  cobra.ExactArgs(2),
-		Short: "recover an IBC client",
+		Use: "recover-client [subject-client-id] [substitute-client-id] [[subject-client-id] [substitute-client-id]...] [flags]",
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) < 2 || len(args)%2 != 0 {
+				return fmt.Errorf("expected pairs of subject and substitute client identifiers, received %d arg(s)", len(args))
+			}
+
+			return nil
+		},
+		Short: "recover one or more IBC clients",
 		Long: `Submit a recover IBC client proposal along with an initial deposit
 		Please specify a subject client identifier you want to recover
-		Please specify the substitute client the subject client will be recovered to.`,
+		Please specify the substitute client the subject client will be recovered to.
+		Multiple subject and substitute client pairs may be provided to recover several clients atomically in a single proposal.`,
+		Example: fmt.Sprintf("%s tx ibc client recover-client 07-tendermint-0 07-tendermint-2 07-tendermint-1 07-tendermint-3 --title \"recover clients\" --summary \"recover expired clients\" --deposit 10stake", version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -265,8 +300,6 @@ func newSubmitRecoverClientProposalCmd() *cobra.Command {
 				return err
 			}
 
-			subjectClientID, substituteClientID := args[0], args[1]
-
 			authority, _ := cmd.Flags().GetString(FlagAuthority)
 			if authority != "" {
 				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
@@ -276,10 +309,40 @@ func newSubmitRecoverClientProposalCmd() *cobra.Command {
 				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
 			}
 
-			msg := types.NewMsgRecoverClient(authority, subjectClientID, substituteClientID)
+			var trustedHeights []types.Height
+			trustedHeightStrs, _ := cmd.Flags().GetStringSlice(FlagTrustedHeights)
+			for _, trustedHeight := range trustedHeightStrs {
+				height, err := types.ParseHeight(trustedHeight)
+				if err != nil {
+					return fmt.Errorf("invalid trusted height %s: %w", trustedHeight, err)
+				}
 
-			if err = msg.ValidateBasic(); err != nil {
-				return fmt.Errorf("error validating %T: %w", types.MsgRecoverClient{}, err)
+				trustedHeights = append(trustedHeights, height)
+			}
+
+			var msg sdk.Msg
+			if len(args) == 2 {
+				msg = &types.MsgRecoverClient{
+					SubjectClientId:    args[0],
+					SubstituteClientId: args[1],
+					Signer:             authority,
+					TrustedHeights:     trustedHeights,
+				}
+			} else {
+				if len(trustedHeights) != 0 {
+					return fmt.Errorf("flag --%s is only supported when recovering a single client", FlagTrustedHeights)
+				}
+
+				var recoveries []types.ClientRecovery
+				for i := 0; i < len(args); i += 2 {
+					recoveries = append(recoveries, types.NewClientRecovery(args[i], args[i+1], nil))
+				}
+
+				msg = types.NewMsgRecoverClients(authority, recoveries)
+			}
+
+			if err = msg.(sdk.HasValidateBasic).ValidateBasic(); err != nil {
+				return fmt.Errorf("error validating %T: %w", msg, err)
 			}
 
 			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
@@ -290,6 +353,62 @@ func newSubmitRecoverClientProposalCmd() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagAuthority, "", "The address of the client module authority (defaults to gov)")
+	cmd.Flags().StringSlice(FlagTrustedHeights, []string{}, "Comma separated heights of the substitute client whose consensus states are copied to the subject client in addition to the latest height, in the format {revision}-{height}")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	err := cmd.MarkFlagRequired(govcli.FlagTitle)
+	if err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// newSubmitFreezeClientProposalCmd defines the command to freeze an IBC light client without a proof of misbehaviour.
+func newSubmitFreezeClientProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-client [client-id] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "freeze an IBC client",
+		Long: `Submit a freeze IBC client proposal along with an initial deposit
+		Please specify the identifier of the active client you want to freeze.
+		The client may be unfrozen again by recovering it with a substitute client.`,
+		Example: fmt.Sprintf("%s tx ibc client freeze-client 07-tendermint-0 --title \"freeze client\" --summary \"freeze compromised client\" --deposit 10stake", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			authority, _ := cmd.Flags().GetString(FlagAuthority)
+			if authority != "" {
+				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
+				}
+			} else {
+				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+			}
+
+			msg := types.NewMsgFreezeClient(authority, args[0])
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("error validating %T: %w", msg, err)
+			}
+
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create freeze client proposal message: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
 	cmd.Flags().String(FlagAuthority, "", "The address of the client module authority (defaults to gov)")
 
 	flags.AddTxFlagsToCmd(cmd)
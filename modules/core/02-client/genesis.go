@@ -56,6 +56,9 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, gs types.GenesisState) {
 	if err := k.CreateLocalhostClient(ctx); err != nil {
 		panic(fmt.Errorf("failed to initialise localhost client: %s", err.Error()))
 	}
+
+	k.SetAllClientAliases(ctx, gs.ClientAliases)
+	k.SetAllClientDeposits(ctx, gs.ClientDeposits)
 }
 
 // ExportGenesis returns the ibc client submodule's exported genesis.
@@ -75,5 +78,7 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) types.GenesisState {
 		// Warning: CreateLocalhost is deprecated
 		CreateLocalhost:    false,
 		NextClientSequence: k.GetNextClientSequence(ctx),
+		ClientAliases:      k.GetAllClientAliases(ctx),
+		ClientDeposits:     k.GetAllClientDeposits(ctx),
 	}
 }
@@ -18,6 +18,19 @@ import (
 // via the Initialize method. This includes the client state, initial consensus state and any associated
 // metadata. The generated client identifier will be returned if a client was successfully initialized.
 func (k *Keeper) CreateClient(ctx sdk.Context, clientType string, clientState, consensusState []byte) (string, error) {
+	return k.CreateClientWithSeedConsensusStates(ctx, clientType, clientState, consensusState, nil)
+}
+
+// CreateClientWithSeedConsensusStates creates a new client in the same way as CreateClient, and additionally
+// stores the given seed consensus states, in ascending order of height, once the client has been initialized.
+// Seed consensus states are only supported by light client modules implementing the SeedConsensusStatesModule
+// interface, which are responsible for validating them against the initialized client state.
+func (k *Keeper) CreateClientWithSeedConsensusStates(
+	ctx sdk.Context,
+	clientType string,
+	clientState, consensusState []byte,
+	seedConsensusStates []types.ConsensusStateWithHeight,
+) (string, error) {
 	if clientType == exported.Localhost {
 		return "", errorsmod.Wrapf(types.ErrInvalidClientType, "cannot create client of type: %s", clientType)
 	}
@@ -41,6 +54,28 @@ func (k *Keeper) CreateClient(ctx sdk.Context, clientType string, clientState, c
 		return "", err
 	}
 
+	if len(seedConsensusStates) > 0 {
+		seedModule, ok := clientModule.(exported.SeedConsensusStatesModule)
+		if !ok {
+			return "", errorsmod.Wrapf(types.ErrClientTypeNotSupported, "light client module for client type %s does not support seed consensus states", clientType)
+		}
+
+		heights := make([]exported.Height, len(seedConsensusStates))
+		consensusStates := make([][]byte, len(seedConsensusStates))
+		for i, seed := range seedConsensusStates {
+			if seed.ConsensusState == nil {
+				return "", errorsmod.Wrapf(types.ErrInvalidConsensus, "seed consensus state at height %s cannot be empty", seed.Height)
+			}
+
+			heights[i] = seed.Height
+			consensusStates[i] = seed.ConsensusState.Value
+		}
+
+		if err := seedModule.InitializeSeedConsensusStates(ctx, clientID, heights, consensusStates); err != nil {
+			return "", errorsmod.Wrapf(err, "failed to initialize seed consensus states for client (%s)", clientID)
+		}
+	}
+
 	if status := k.GetClientStatus(ctx, clientID); status != exported.Active {
 		return "", errorsmod.Wrapf(types.ErrClientNotActive, "cannot create client (%s) with status %s", clientID, status)
 	}
@@ -60,8 +95,13 @@ func (k *Keeper) CreateClient(ctx sdk.Context, clientType string, clientState, c
 }
 
 // UpdateClient updates the consensus state and the state root from a provided header.
+// Events emitted by the light client module while updating its state, such as the prune consensus
+// states event of 07-tendermint clients, are emitted alongside the update client event. Clients within
+// their expiry grace period only accept client messages which are misbehaviour, freezing the client.
 func (k *Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
-	if status := k.GetClientStatus(ctx, clientID); status != exported.Active {
+	// misbehaviour may still be submitted for a client within its expiry grace period
+	status := k.GetClientStatus(ctx, clientID)
+	if status != exported.Active && status != exported.ExpiredGrace {
 		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
 	}
 
@@ -79,7 +119,13 @@ func (k *Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg export
 		return err
 	}
 
+	previousHeight := clientModule.LatestHeight(ctx, clientID)
+
 	foundMisbehaviour := clientModule.CheckForMisbehaviour(ctx, clientID, clientMsg)
+	if !foundMisbehaviour && status != exported.Active {
+		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
+	}
+
 	if foundMisbehaviour {
 		clientModule.UpdateStateOnMisbehaviour(ctx, clientID, clientMsg)
 
@@ -95,14 +141,37 @@ func (k *Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg export
 			},
 		)
 
-		emitSubmitMisbehaviourEvent(ctx, clientID, clientType)
+		frozenHeight, _, err := k.ClientFrozenHeight(ctx, clientID)
+		if err != nil {
+			return err
+		}
+
+		emitSubmitMisbehaviourEvent(ctx, clientID, clientType, previousHeight, frozenHeight)
+		emitUpdateClientResultEvent(ctx, clientID, clientType, types.UpdateResultMisbehaviour, previousHeight, nil, nil, frozenHeight)
 
 		return nil
 	}
 
-	consensusHeights := clientModule.UpdateState(ctx, clientID, clientMsg)
+	// the update is applied on a cached context to determine which of the returned
+	// consensus heights did not have a consensus state stored prior to the update
+	cacheCtx, writeFn := ctx.CacheContext()
+	consensusHeights := clientModule.UpdateState(cacheCtx, clientID, clientMsg)
+
+	var newConsensusHeights []exported.Height
+	for _, height := range consensusHeights {
+		if !k.HasClientConsensusState(ctx, clientID, height) {
+			newConsensusHeights = append(newConsensusHeights, height)
+		}
+	}
 
-	k.Logger(ctx).Info("client state updated", "client-id", clientID, "heights", consensusHeights)
+	writeFn()
+
+	updateResult := types.UpdateResultUpdate
+	if len(newConsensusHeights) == 0 {
+		updateResult = types.UpdateResultNoop
+	}
+
+	k.Logger(ctx).Info("client state updated", "client-id", clientID, "heights", consensusHeights, "result", updateResult)
 
 	defer telemetry.IncrCounterWithLabels(
 		[]string{"ibc", "client", "update"},
@@ -115,7 +184,39 @@ func (k *Keeper) UpdateClient(ctx sdk.Context, clientID string, clientMsg export
 	)
 
 	// emitting events in the keeper emits for both begin block and handler client updates
-	emitUpdateClientEvent(ctx, clientID, clientType, consensusHeights, k.cdc, clientMsg)
+	emitUpdateClientEvent(ctx, clientID, clientType, consensusHeights, updateResult, previousHeight, newConsensusHeights)
+	emitUpdateClientResultEvent(ctx, clientID, clientType, updateResult, previousHeight, consensusHeights, newConsensusHeights, types.ZeroHeight())
+
+	return nil
+}
+
+// DryRunUpdateClient returns the error, if any, with which an update of the client using the provided client message
+// would be rejected, without updating the client. It runs the checks of UpdateClient through the light client module
+// of the client: the client must be active, the client message must pass basic validation and verification, and it
+// must not be detected as misbehaviour, as the update would otherwise freeze the client. The checks are run on a
+// cached context which is discarded.
+func (k *Keeper) DryRunUpdateClient(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) error {
+	if status := k.GetClientStatus(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot update client (%s) with status %s", clientID, status)
+	}
+
+	clientModule, found := k.router.GetRoute(clientID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRouteNotFound, clientID)
+	}
+
+	if err := clientMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	if err := clientModule.VerifyClientMessage(cacheCtx, clientID, clientMsg); err != nil {
+		return err
+	}
+
+	if clientModule.CheckForMisbehaviour(cacheCtx, clientID, clientMsg) {
+		return errorsmod.Wrapf(types.ErrInvalidHeader, "client message conflicts with the state of client (%s), updating the client would freeze it", clientID)
+	}
 
 	return nil
 }
@@ -166,8 +267,10 @@ func (k *Keeper) UpgradeClient(
 // recover the subject client given a substitute client identifier. The light client implementation
 // is responsible for validating the parameters of the substitute (ensuring they match the subject's parameters)
 // as well as copying the necessary consensus states from the substitute to the subject client store.
-// The substitute must be Active and the subject must not be Active.
-func (k *Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClientID string) error {
+// The substitute must be Active and the subject must not be Active. If trusted heights are provided, the light
+// client module must implement the TrustedHeightsRecoveryModule interface in order to additionally copy the
+// substitute consensus states at the trusted heights to the subject client store.
+func (k *Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClientID string, trustedHeights []types.Height) error {
 	if status := k.GetClientStatus(ctx, subjectClientID); status == exported.Active {
 		return errorsmod.Wrapf(types.ErrInvalidRecoveryClient, "cannot recover %s subject client", exported.Active)
 	}
@@ -192,8 +295,24 @@ func (k *Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClien
 		return errorsmod.Wrapf(types.ErrInvalidHeight, "subject client state latest height is greater or equal to substitute client state latest height (%s >= %s)", subjectLatestHeight, substituteLatestHeight)
 	}
 
-	if err := clientModule.RecoverClient(ctx, subjectClientID, substituteClientID); err != nil {
-		return err
+	if len(trustedHeights) == 0 {
+		if err := clientModule.RecoverClient(ctx, subjectClientID, substituteClientID); err != nil {
+			return err
+		}
+	} else {
+		trustedHeightsRecoveryModule, ok := clientModule.(exported.TrustedHeightsRecoveryModule)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrInvalidRecoveryClient, "client type %s does not support recovery with trusted heights", clientType)
+		}
+
+		heights := make([]exported.Height, len(trustedHeights))
+		for i, height := range trustedHeights {
+			heights[i] = height
+		}
+
+		if err := trustedHeightsRecoveryModule.RecoverClientWithTrustedHeights(ctx, subjectClientID, substituteClientID, heights); err != nil {
+			return err
+		}
 	}
 
 	k.Logger(ctx).Info("client recovered", "client-id", subjectClientID)
@@ -213,3 +332,63 @@ func (k *Keeper) RecoverClient(ctx sdk.Context, subjectClientID, substituteClien
 
 	return nil
 }
+
+// RecoverClients recovers the subject client of each of the provided client recoveries in order, see RecoverClient.
+// The recoveries are processed atomically: if any recovery fails, no client is recovered and the returned error
+// identifies the index of the failing recovery. A recover client event is emitted for each recovered client.
+func (k *Keeper) RecoverClients(ctx sdk.Context, recoveries []types.ClientRecovery) error {
+	cacheCtx, writeFn := ctx.CacheContext()
+	for i, recovery := range recoveries {
+		if err := k.RecoverClient(cacheCtx, recovery.SubjectClientId, recovery.SubstituteClientId, recovery.TrustedHeights); err != nil {
+			return errorsmod.Wrapf(err, "client recovery %d for subject client %s", i, recovery.SubjectClientId)
+		}
+	}
+
+	writeFn()
+
+	return nil
+}
+
+// FreezeClient freezes an active client without a proof of misbehaviour by invoking the light client module
+// associated with the clientID, which must implement the ClientFreezingModule interface. Once frozen, all
+// verification using the client fails until it is recovered with a substitute client.
+func (k *Keeper) FreezeClient(ctx sdk.Context, clientID string) error {
+	clientType, _, err := types.ParseClientIdentifier(clientID)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrClientNotFound, "clientID (%s)", clientID)
+	}
+
+	if status := k.GetClientStatus(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(types.ErrClientNotActive, "cannot freeze client (%s) with status %s", clientID, status)
+	}
+
+	clientModule, found := k.router.GetRoute(clientID)
+	if !found {
+		return errorsmod.Wrap(types.ErrRouteNotFound, clientID)
+	}
+
+	freezingModule, ok := clientModule.(exported.ClientFreezingModule)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrClientTypeNotSupported, "client type %s does not support freezing", clientType)
+	}
+
+	frozenHeight, err := freezingModule.FreezeClient(ctx, clientID)
+	if err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("client frozen", "client-id", clientID, "frozen-height", frozenHeight.String())
+
+	defer telemetry.IncrCounterWithLabels(
+		[]string{"ibc", "client", "freeze"},
+		1,
+		[]metrics.Label{
+			telemetry.NewLabel(types.LabelClientType, clientType),
+			telemetry.NewLabel(types.LabelClientID, clientID),
+		},
+	)
+
+	emitFreezeClientEvent(ctx, clientID, clientType, frozenHeight)
+
+	return nil
+}
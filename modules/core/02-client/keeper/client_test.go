@@ -11,6 +11,7 @@ import (
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
@@ -101,6 +102,103 @@ func (suite *KeeperTestSuite) TestCreateClient() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestCreateClientWithSeedConsensusStates() {
+	var (
+		clientType     string
+		clientState    []byte
+		consensusState []byte
+		seeds          []clienttypes.ConsensusStateWithHeight
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: 07-tendermint client with seed consensus states",
+			func() {},
+			nil,
+		},
+		{
+			"success: no seed consensus states",
+			func() {
+				seeds = nil
+			},
+			nil,
+		},
+		{
+			"failure: seed consensus state is empty",
+			func() {
+				seeds[0].ConsensusState = nil
+			},
+			clienttypes.ErrInvalidConsensus,
+		},
+		{
+			"failure: light client module rejects seed consensus state",
+			func() {
+				seeds[1].Height = seeds[0].Height
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"failure: 06-solomachine does not support seed consensus states",
+			func() {
+				smConsensusState := &solomachine.ConsensusState{PublicKey: suite.solomachine.ConsensusState().PublicKey, Diversifier: suite.solomachine.Diversifier, Timestamp: suite.solomachine.Time}
+				clientType = exported.Solomachine
+				clientState = suite.chainA.App.AppCodec().MustMarshal(solomachine.NewClientState(1, smConsensusState))
+				consensusState = suite.chainA.App.AppCodec().MustMarshal(smConsensusState)
+				seeds = []clienttypes.ConsensusStateWithHeight{clienttypes.NewConsensusStateWithHeight(clienttypes.NewHeight(0, 1), smConsensusState)}
+			},
+			clienttypes.ErrClientTypeNotSupported,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			seeds = nil
+			for i := 0; i < 2; i++ {
+				suite.coordinator.CommitBlock(suite.chainB)
+				height, ok := suite.chainB.LatestCommittedHeader.GetHeight().(clienttypes.Height)
+				suite.Require().True(ok)
+
+				seeds = append(seeds, clienttypes.NewConsensusStateWithHeight(height, suite.chainB.LatestCommittedHeader.ConsensusState()))
+			}
+
+			suite.coordinator.CommitBlock(suite.chainB)
+			latestHeight, ok := suite.chainB.LatestCommittedHeader.GetHeight().(clienttypes.Height)
+			suite.Require().True(ok)
+
+			tmClientState := ibctm.NewClientState(suite.chainB.ChainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, latestHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+			clientType = exported.Tendermint
+			clientState = suite.chainA.App.AppCodec().MustMarshal(tmClientState)
+			consensusState = suite.chainA.App.AppCodec().MustMarshal(suite.chainB.LatestCommittedHeader.ConsensusState())
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			clientID, err := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.CreateClientWithSeedConsensusStates(ctx, clientType, clientState, consensusState, seeds)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotEmpty(clientID)
+
+				for _, seed := range seeds {
+					_, found := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper.GetClientConsensusState(ctx, clientID, seed.Height)
+					suite.Require().True(found)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Empty(clientID)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 	var (
 		path         *ibctesting.Path
@@ -263,6 +361,15 @@ func (suite *KeeperTestSuite) TestUpdateClientTendermint() {
 			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), path.EndpointA.ClientID, clientState)
 			updateHeader = createFutureUpdateFn(clientState.LatestHeight)
 		}, false, false},
+		{"client is within expiry grace period", func() {
+			clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			clientState.ExpiryGracePeriod = time.Hour
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), path.EndpointA.ClientID, clientState)
+
+			suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod)
+			updateHeader = createFutureUpdateFn(clientState.LatestHeight)
+		}, false, false},
 		{"invalid header", func() {
 			clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
 			suite.Require().True(ok)
@@ -504,6 +611,74 @@ func (suite *KeeperTestSuite) TestUpgradeClient() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestUpdateClientMisbehaviourWithinExpiryGracePeriod() {
+	var (
+		path         *ibctesting.Path
+		misbehaviour *ibctm.Misbehaviour
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: client within expiry grace period is frozen",
+			func() {},
+			nil,
+		},
+		{
+			"failure: client expired past the expiry grace period",
+			func() {
+				suite.coordinator.IncrementTimeBy(time.Hour)
+			},
+			clienttypes.ErrClientNotActive,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			clientState.ExpiryGracePeriod = time.Hour
+			path.EndpointA.SetClientState(clientState)
+
+			trustedHeight := clientState.LatestHeight
+			trustedVals, err := suite.chainB.GetTrustedValidators(int64(trustedHeight.RevisionHeight))
+			suite.Require().NoError(err)
+
+			// expire the client into its expiry grace period
+			suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod + time.Minute)
+			suite.Require().Equal(exported.ExpiredGrace, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), path.EndpointA.ClientID))
+
+			tc.malleate()
+
+			// conflicting headers at the same height
+			height := int64(trustedHeight.RevisionHeight) + 1
+			misbehaviour = &ibctm.Misbehaviour{
+				Header1: suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, height, trustedHeight, suite.chainB.ProposedHeader.Time.Add(time.Minute), suite.chainB.Vals, suite.chainB.NextVals, trustedVals, suite.chainB.Signers),
+				Header2: suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, height, trustedHeight, suite.chainB.ProposedHeader.Time, suite.chainB.Vals, suite.chainB.NextVals, trustedVals, suite.chainB.Signers),
+			}
+
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), path.EndpointA.ClientID, misbehaviour)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(exported.Frozen, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), path.EndpointA.ClientID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal(exported.Expired, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), path.EndpointA.ClientID))
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestUpdateClientEventEmission() {
 	path := ibctesting.NewPath(suite.chainA, suite.chainB)
 	path.SetupClients()
@@ -529,6 +704,8 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 	var (
 		subject, substitute                       string
 		subjectClientState, substituteClientState exported.ClientState
+		intermediateHeight                        clienttypes.Height
+		trustedHeights                            []clienttypes.Height
 	)
 
 	testCases := []struct {
@@ -541,6 +718,13 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 			func() {},
 			nil,
 		},
+		{
+			"success, with trusted heights",
+			func() {
+				trustedHeights = []clienttypes.Height{intermediateHeight}
+			},
+			nil,
+		},
 		{
 			"success, subject and substitute use different revision number",
 			func() {
@@ -626,6 +810,13 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 			},
 			clienttypes.ErrInvalidSubstitute,
 		},
+		{
+			"light client module RecoverClient fails, consensus state not found for trusted height",
+			func() {
+				trustedHeights = []clienttypes.Height{clienttypes.NewHeight(intermediateHeight.RevisionNumber, intermediateHeight.RevisionHeight+1)}
+			},
+			clienttypes.ErrConsensusStateNotFound,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -633,6 +824,7 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 
 		suite.Run(tc.msg, func() {
 			suite.SetupTest() // reset
+			trustedHeights = nil
 
 			subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
 			subjectPath.SetupClients()
@@ -646,6 +838,11 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 			// update substitute twice
 			err := substitutePath.EndpointA.UpdateClient()
 			suite.Require().NoError(err)
+			var ok bool
+			intermediateHeight, ok = substitutePath.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+			suite.Require().True(ok)
+			// skip a block so that the intermediate height is not adjacent to the latest height
+			suite.coordinator.CommitNBlocks(suite.chainB, 2)
 			err = substitutePath.EndpointA.UpdateClient()
 			suite.Require().NoError(err)
 			substituteClientState = suite.chainA.GetClientState(substitute)
@@ -658,7 +855,7 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 			tc.malleate()
 
 			ctx := suite.chainA.GetContext()
-			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.RecoverClient(ctx, subject, substitute)
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.RecoverClient(ctx, subject, substitute, trustedHeights)
 
 			expPass := tc.expErr == nil
 			if expPass {
@@ -681,6 +878,12 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 				suite.Require().True(ok)
 				suite.Require().Equal(tmClientState.Status(suite.chainA.GetContext(), clientStore, suite.chainA.App.AppCodec()), exported.Active)
 
+				// Assert that the consensus states at the trusted heights were copied from the substitute
+				for _, height := range trustedHeights {
+					consensusState, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientConsensusState(suite.chainA.GetContext(), subject, height)
+					suite.Require().True(found)
+					suite.Require().Equal(substitutePath.EndpointA.GetConsensusState(height), consensusState)
+				}
 			} else {
 				suite.Require().Error(err)
 				suite.Require().ErrorIs(err, tc.expErr)
@@ -688,3 +891,96 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 		})
 	}
 }
+
+// TestUpdateClientAfterRecoveryAcrossHeightGap tests that a recovered client can be updated to a height
+// between the heights copied from the substitute when the trusted consensus state was copied during recovery.
+func (suite *KeeperTestSuite) TestUpdateClientAfterRecoveryAcrossHeightGap() {
+	var (
+		intermediateHeight clienttypes.Height
+		trustedHeights     []clienttypes.Height
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: trusted consensus state copied from the substitute",
+			func() {},
+			nil,
+		},
+		{
+			"failure: trusted consensus state not copied from the substitute",
+			func() {
+				trustedHeights = nil
+			},
+			clienttypes.ErrConsensusStateNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			subjectPath.SetupClients()
+			subject := subjectPath.EndpointA.ClientID
+
+			substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			substitutePath.SetupClients()
+			substitute := substitutePath.EndpointA.ClientID
+
+			err := substitutePath.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+			var ok bool
+			intermediateHeight, ok = substitutePath.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+			suite.Require().True(ok)
+
+			// the substitute skips a large number of blocks
+			suite.coordinator.CommitNBlocks(suite.chainB, 20)
+			err = substitutePath.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			tmClientState, ok := suite.chainA.GetClientState(subject).(*ibctm.ClientState)
+			suite.Require().True(ok)
+			tmClientState.FrozenHeight = tmClientState.LatestHeight
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientState(suite.chainA.GetContext(), subject, tmClientState)
+
+			trustedHeights = []clienttypes.Height{intermediateHeight}
+
+			tc.malleate()
+
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.RecoverClient(suite.chainA.GetContext(), subject, substitute, trustedHeights)
+			suite.Require().NoError(err)
+
+			// update the subject to a height within the gap, trusting the intermediate height
+			intermediateConsensusState, ok := substitutePath.EndpointA.GetConsensusState(intermediateHeight).(*ibctm.ConsensusState)
+			suite.Require().True(ok)
+			trustedVals, err := suite.chainB.GetTrustedValidators(int64(intermediateHeight.RevisionHeight))
+			suite.Require().NoError(err)
+
+			gapHeight := clienttypes.NewHeight(intermediateHeight.RevisionNumber, intermediateHeight.RevisionHeight+10)
+			header := suite.chainB.CreateTMClientHeader(
+				suite.chainB.ChainID, int64(gapHeight.RevisionHeight), intermediateHeight, intermediateConsensusState.Timestamp.Add(time.Minute),
+				suite.chainB.Vals, suite.chainB.NextVals, trustedVals, suite.chainB.Signers,
+			)
+
+			err = suite.chainA.App.GetIBCKeeper().ClientKeeper.UpdateClient(suite.chainA.GetContext(), subject, header)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				_, found := suite.chainA.GetConsensusState(subject, gapHeight)
+				suite.Require().True(found)
+
+				status := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), subject)
+				suite.Require().Equal(exported.Active, status)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// SetDepositKeepers sets the bank and distribution keepers used to escrow, refund and forfeit client creation
+// deposits. The IBC module account must be registered with the auth keeper of the application for deposits to
+// be escrowed. Client creation deposits cannot be enabled unless the keepers are set.
+func (k *Keeper) SetDepositKeepers(bankKeeper types.BankKeeper, distributionKeeper types.DistributionKeeper) {
+	if bankKeeper == nil {
+		panic(errors.New("cannot set a nil bank keeper"))
+	}
+
+	if distributionKeeper == nil {
+		panic(errors.New("cannot set a nil distribution keeper"))
+	}
+
+	k.bankKeeper = bankKeeper
+	k.distributionKeeper = distributionKeeper
+}
+
+// IsClientDepositSupported returns true if the keepers required to escrow client creation deposits are set.
+func (k *Keeper) IsClientDepositSupported() bool {
+	return k.bankKeeper != nil && k.distributionKeeper != nil
+}
+
+// GetClientDeposit returns the deposit escrowed for the creation of the given client, if any.
+func (k *Keeper) GetClientDeposit(ctx sdk.Context, clientID string) (types.ClientDeposit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ClientDepositKey(clientID))
+	if len(bz) == 0 {
+		return types.ClientDeposit{}, false
+	}
+
+	var deposit types.ClientDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+	return deposit, true
+}
+
+// setClientDeposit stores the deposit escrowed for the creation of a client.
+func (k *Keeper) setClientDeposit(ctx sdk.Context, deposit types.ClientDeposit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ClientDepositKey(deposit.ClientId), k.cdc.MustMarshal(&deposit))
+}
+
+// deleteClientDeposit deletes the deposit escrowed for the creation of a client.
+func (k *Keeper) deleteClientDeposit(ctx sdk.Context, clientID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ClientDepositKey(clientID))
+}
+
+// GetAllClientDeposits returns the deposits escrowed for the creation of clients, ordered by client identifier.
+func (k *Keeper) GetAllClientDeposits(ctx sdk.Context) []types.ClientDeposit {
+	var deposits []types.ClientDeposit
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s/", types.KeyClientDepositPrefix)))
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.ClientDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits
+}
+
+// SetAllClientDeposits sets the given client deposits in state, it is used when initialising from genesis.
+func (k *Keeper) SetAllClientDeposits(ctx sdk.Context, deposits []types.ClientDeposit) {
+	for _, deposit := range deposits {
+		k.setClientDeposit(ctx, deposit)
+	}
+}
+
+// EscrowClientDeposit escrows the client creation deposit defined in the module parameters from the depositor into
+// the IBC module account and records it for the given client. It is a no-op if no client creation deposit is set.
+func (k *Keeper) EscrowClientDeposit(ctx sdk.Context, clientID string, depositor sdk.AccAddress) error {
+	amount := k.GetParams(ctx).ClientCreationDeposit
+	if amount.IsZero() {
+		return nil
+	}
+
+	if !k.IsClientDepositSupported() {
+		return errorsmod.Wrap(types.ErrInvalidClientDeposit, "client creation deposits are not supported by the application")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, exported.ModuleName, amount); err != nil {
+		return errorsmod.Wrapf(err, "failed to escrow client creation deposit for client %s", clientID)
+	}
+
+	deposit := types.NewClientDeposit(clientID, depositor.String(), amount)
+	k.setClientDeposit(ctx, deposit)
+
+	k.Logger(ctx).Info("client deposit escrowed", "client-id", clientID, "depositor", deposit.Depositor, "amount", amount.String())
+
+	emitClientDepositEvent(ctx, types.EventTypeEscrowClientDeposit, deposit)
+
+	return nil
+}
+
+// ReclaimClientDeposit refunds the deposit escrowed for the creation of the given client to its depositor. The deposit
+// may only be reclaimed by the depositor once the client has been expired or frozen for the client deposit grace period.
+// The consensus states of the client are pruned before the deposit is refunded if its light client module implements
+// the ConsensusStatePruningModule interface. The number of consensus states pruned is bounded by the gas limit of the
+// transaction.
+func (k *Keeper) ReclaimClientDeposit(ctx sdk.Context, clientID, signer string) error {
+	deposit, found := k.GetClientDeposit(ctx, clientID)
+	if !found {
+		return errorsmod.Wrap(types.ErrClientDepositNotFound, clientID)
+	}
+
+	if deposit.Depositor != signer {
+		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected depositor %s, got %s", deposit.Depositor, signer)
+	}
+
+	if err := k.checkClientInactiveFor(ctx, clientID, k.GetParams(ctx).ClientDepositGracePeriod); err != nil {
+		return err
+	}
+
+	if !k.IsClientDepositSupported() {
+		return errorsmod.Wrap(types.ErrInvalidClientDeposit, "client creation deposits are not supported by the application")
+	}
+
+	if _, err := k.pruneClientConsensusStates(ctx, clientID, 0); err != nil {
+		return err
+	}
+
+	depositor, err := sdk.AccAddressFromBech32(deposit.Depositor)
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, exported.ModuleName, depositor, deposit.Amount); err != nil {
+		return errorsmod.Wrapf(err, "failed to refund client creation deposit for client %s", clientID)
+	}
+
+	k.deleteClientDeposit(ctx, clientID)
+
+	k.Logger(ctx).Info("client deposit reclaimed", "client-id", clientID, "depositor", deposit.Depositor, "amount", deposit.Amount.String())
+
+	emitClientDepositEvent(ctx, types.EventTypeReclaimClientDeposit, deposit)
+
+	return nil
+}
+
+// ForfeitAbandonedClientDeposits sends the deposits of abandoned clients to the community pool and prunes the consensus
+// states of the abandoned clients, except those against which proofs may still be verified. A client is deemed abandoned once it has been expired or frozen for the sum of the
+// client deposit grace and forfeiture periods. Deposits are never forfeited if the forfeiture period is zero.
+// At most MaxClientDepositsCheckedPerBlock deposits are checked per block, resuming after the last deposit checked in
+// the previous block, so that the cost of a block does not grow with the number of client deposits. At most
+// MaxConsensusStatesPrunedPerBlock consensus states are pruned per block. The deposit of an abandoned client is only
+// forfeited once its consensus states have been pruned, which may take several passes over the client deposits.
+func (k *Keeper) ForfeitAbandonedClientDeposits(ctx sdk.Context) {
+	if !k.IsClientDepositSupported() {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	if params.ClientDepositForfeiturePeriod == 0 {
+		return
+	}
+
+	abandonedPeriod := params.ClientDepositGracePeriod + params.ClientDepositForfeiturePeriod
+	moduleAddr := authtypes.NewModuleAddress(exported.ModuleName)
+	pruneLimit := types.MaxConsensusStatesPrunedPerBlock
+
+	for _, deposit := range k.nextClientDepositsToCheck(ctx) {
+		if err := k.checkClientInactiveFor(ctx, deposit.ClientId, abandonedPeriod); err != nil {
+			continue
+		}
+
+		// use a cached context so that a failed transfer does not leave partial state changes behind
+		cacheCtx, writeFn := ctx.CacheContext()
+		pruned, err := k.pruneClientConsensusStates(cacheCtx, deposit.ClientId, pruneLimit)
+		if err != nil {
+			k.Logger(ctx).Error("failed to forfeit client deposit", "client-id", deposit.ClientId, "error", err.Error())
+			continue
+		}
+
+		pruneLimit -= pruned
+		if pruneLimit == 0 {
+			// consensus states may remain to be pruned, the deposit is checked again on the next pass over the deposits
+			writeFn()
+			break
+		}
+
+		if err := k.distributionKeeper.FundCommunityPool(cacheCtx, deposit.Amount, moduleAddr); err != nil {
+			k.Logger(ctx).Error("failed to forfeit client deposit", "client-id", deposit.ClientId, "error", err.Error())
+			continue
+		}
+
+		writeFn()
+		k.deleteClientDeposit(ctx, deposit.ClientId)
+
+		k.Logger(ctx).Info("client deposit forfeited", "client-id", deposit.ClientId, "depositor", deposit.Depositor, "amount", deposit.Amount.String())
+
+		emitClientDepositEvent(ctx, types.EventTypeForfeitClientDeposit, deposit)
+	}
+}
+
+// nextClientDepositsToCheck returns up to MaxClientDepositsCheckedPerBlock client deposits following the deposit of
+// the client stored as the forfeiture cursor, ordered by client identifier. The cursor is advanced to the last deposit
+// returned, or reset once the last client deposit has been returned so that the next block starts over.
+func (k *Keeper) nextClientDepositsToCheck(ctx sdk.Context) []types.ClientDeposit {
+	store := ctx.KVStore(k.storeKey)
+
+	var start []byte
+	if cursor := store.Get([]byte(types.KeyClientDepositForfeitureCursor)); len(cursor) != 0 {
+		// start iterating at the first key following the cursor
+		start = append(cursor, 0x00)
+	}
+
+	deposits, done := k.getClientDepositsFrom(ctx, start, types.MaxClientDepositsCheckedPerBlock)
+	if done {
+		store.Delete([]byte(types.KeyClientDepositForfeitureCursor))
+	} else {
+		store.Set([]byte(types.KeyClientDepositForfeitureCursor), []byte(deposits[len(deposits)-1].ClientId))
+	}
+
+	return deposits
+}
+
+// getClientDepositsFrom returns up to limit client deposits, ordered by client identifier, starting at the deposit of
+// the given client identifier. The returned boolean is true if no deposits follow the returned deposits.
+func (k *Keeper) getClientDepositsFrom(ctx sdk.Context, start []byte, limit int) ([]types.ClientDeposit, bool) {
+	var deposits []types.ClientDeposit
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s/", types.KeyClientDepositPrefix)))
+	iterator := store.Iterator(start, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid() && len(deposits) < limit; iterator.Next() {
+		var deposit types.ClientDeposit
+		k.cdc.MustUnmarshal(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+
+	return deposits, !iterator.Valid()
+}
+
+// pruneClientConsensusStates prunes up to limit consensus states of the given client, or all of them if limit is zero,
+// if its light client module implements the ConsensusStatePruningModule interface. The number of consensus states
+// pruned is returned.
+func (k *Keeper) pruneClientConsensusStates(ctx sdk.Context, clientID string, limit int) (int, error) {
+	clientModule, found := k.router.GetRoute(clientID)
+	if !found {
+		return 0, errorsmod.Wrap(types.ErrRouteNotFound, clientID)
+	}
+
+	pruningModule, ok := clientModule.(exported.ConsensusStatePruningModule)
+	if !ok {
+		return 0, nil
+	}
+
+	pruned, err := pruningModule.PruneConsensusStates(ctx, clientID, limit)
+	if err != nil {
+		return 0, errorsmod.Wrapf(err, "failed to prune consensus states of client %s", clientID)
+	}
+
+	return pruned, nil
+}
+
+// checkClientInactiveFor returns an error unless the given client is expired or frozen and its latest consensus state is
+// at least as old as the provided period. A client within its expiry grace period is not inactive, as proofs may still be
+// verified against its consensus states. The timestamp of the latest consensus state is used as the time since which the
+// client has not been active, as it bounds the time of the last update of the client.
+func (k *Keeper) checkClientInactiveFor(ctx sdk.Context, clientID string, period time.Duration) error {
+	if status := k.GetClientStatus(ctx, clientID); status != exported.Expired && status != exported.Frozen {
+		return errorsmod.Wrapf(types.ErrClientDepositNotReclaimable, "client (%s) status is %s", clientID, status)
+	}
+
+	timestamp, err := k.GetClientTimestampAtHeight(ctx, clientID, k.GetClientLatestHeight(ctx, clientID))
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrClientDepositNotReclaimable, "failed to get latest consensus state timestamp of client (%s): %v", clientID, err)
+	}
+
+	if ctx.BlockTime().Before(time.Unix(0, int64(timestamp)).Add(period)) {
+		return errorsmod.Wrapf(types.ErrClientDepositNotReclaimable, "client (%s) has not been inactive for %s, latest consensus state timestamp is %s", clientID, period, time.Unix(0, int64(timestamp)).UTC())
+	}
+
+	return nil
+}
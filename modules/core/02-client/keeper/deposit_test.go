@@ -0,0 +1,402 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+var clientDeposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+
+// setClientDepositParams sets the client creation deposit params on chainA.
+func (suite *KeeperTestSuite) setClientDepositParams(deposit sdk.Coins, gracePeriod, forfeiturePeriod time.Duration) {
+	params := types.DefaultParams()
+	params.ClientCreationDeposit = deposit
+	params.ClientDepositGracePeriod = gracePeriod
+	params.ClientDepositForfeiturePeriod = forfeiturePeriod
+
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+}
+
+// setExpiryGracePeriod sets the expiry grace period of the client of the given endpoint.
+func setExpiryGracePeriod(endpoint *ibctesting.Endpoint, expiryGracePeriod time.Duration) {
+	clientState, ok := endpoint.GetClientState().(*ibctm.ClientState)
+	if !ok {
+		panic("client state is not a tendermint client state")
+	}
+
+	clientState.ExpiryGracePeriod = expiryGracePeriod
+	endpoint.SetClientState(clientState)
+}
+
+// freezeClient freezes the client of the given endpoint.
+func freezeClient(endpoint *ibctesting.Endpoint) {
+	clientState, ok := endpoint.GetClientState().(*ibctm.ClientState)
+	if !ok {
+		panic("client state is not a tendermint client state")
+	}
+
+	clientState.FrozenHeight = types.NewHeight(0, 1)
+	endpoint.SetClientState(clientState)
+}
+
+func (suite *KeeperTestSuite) TestEscrowClientDeposit() {
+	var deposit sdk.Coins
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"success: no client creation deposit", func() {
+				deposit = sdk.NewCoins()
+			}, nil,
+		},
+		{
+			"failure: insufficient funds", func() {
+				deposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntWithDecimal(1, 40)))
+			}, sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			deposit = clientDeposit
+
+			tc.malleate()
+
+			suite.setClientDepositParams(deposit, 0, 0)
+
+			ctx := suite.chainA.GetContext()
+			depositor := suite.chainA.SenderAccount.GetAddress()
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+			err := clientKeeper.EscrowClientDeposit(ctx, path.EndpointA.ClientID, depositor)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				storedDeposit, found := clientKeeper.GetClientDeposit(ctx, path.EndpointA.ClientID)
+				suite.Require().Equal(!deposit.IsZero(), found)
+
+				moduleBalances := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(exported.ModuleName))
+				if found {
+					suite.Require().Equal(types.NewClientDeposit(path.EndpointA.ClientID, depositor.String(), deposit), storedDeposit)
+					suite.Require().Equal(deposit, moduleBalances)
+				} else {
+					suite.Require().True(moduleBalances.IsZero())
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+
+				_, found := clientKeeper.GetClientDeposit(ctx, path.EndpointA.ClientID)
+				suite.Require().False(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReclaimClientDeposit() {
+	var (
+		path   *ibctesting.Path
+		signer string
+	)
+
+	gracePeriod := time.Hour
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: expired client", func() {
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + gracePeriod)
+			}, nil,
+		},
+		{
+			"success: frozen client", func() {
+				freezeClient(path.EndpointA)
+				suite.coordinator.IncrementTimeBy(gracePeriod)
+			}, nil,
+		},
+		{
+			"failure: deposit not found", func() {
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + gracePeriod)
+				path.EndpointA.ClientID = ibctesting.InvalidID
+			}, types.ErrClientDepositNotFound,
+		},
+		{
+			"failure: signer is not the depositor", func() {
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + gracePeriod)
+				signer = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			}, ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: client is active", func() {}, types.ErrClientDepositNotReclaimable,
+		},
+		{
+			"failure: grace period has not elapsed", func() {
+				freezeClient(path.EndpointA)
+			}, types.ErrClientDepositNotReclaimable,
+		},
+		{
+			"failure: client is within its expiry grace period", func() {
+				setExpiryGracePeriod(path.EndpointA, 2*gracePeriod)
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + gracePeriod)
+			}, types.ErrClientDepositNotReclaimable,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.setClientDepositParams(clientDeposit, gracePeriod, 0)
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			signer = suite.chainA.SenderAccount.GetAddress().String()
+			latestHeight := path.EndpointA.GetClientLatestHeight()
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			bankKeeper := suite.chainA.GetSimApp().BankKeeper
+			balanceBefore := bankKeeper.GetAllBalances(ctx, suite.chainA.SenderAccount.GetAddress())
+
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+			err := clientKeeper.ReclaimClientDeposit(ctx, path.EndpointA.ClientID, signer)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				_, found := clientKeeper.GetClientDeposit(ctx, path.EndpointA.ClientID)
+				suite.Require().False(found)
+
+				// the consensus states of the client are pruned
+				_, found = clientKeeper.GetClientConsensusState(ctx, path.EndpointA.ClientID, latestHeight)
+				suite.Require().False(found)
+
+				balanceAfter := bankKeeper.GetAllBalances(ctx, suite.chainA.SenderAccount.GetAddress())
+				suite.Require().Equal(balanceBefore.Add(clientDeposit...), balanceAfter)
+				suite.Require().True(bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(exported.ModuleName)).IsZero())
+
+				suite.Require().NotEqual(exported.Active, clientKeeper.GetClientStatus(ctx, path.EndpointA.ClientID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+
+				balanceAfter := bankKeeper.GetAllBalances(ctx, suite.chainA.SenderAccount.GetAddress())
+				suite.Require().Equal(balanceBefore, balanceAfter)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestForfeitAbandonedClientDeposits() {
+	var (
+		path             *ibctesting.Path
+		forfeiturePeriod time.Duration
+	)
+
+	gracePeriod := time.Hour
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expForfeited bool
+	}{
+		{
+			"success: abandoned client deposit forfeited", func() {
+				freezeClient(path.EndpointA)
+				suite.coordinator.IncrementTimeBy(gracePeriod + forfeiturePeriod)
+			}, true,
+		},
+		{
+			"deposit not forfeited: forfeiture disabled", func() {
+				forfeiturePeriod = 0
+				freezeClient(path.EndpointA)
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+			}, false,
+		},
+		{
+			"deposit not forfeited: client is active", func() {
+				suite.coordinator.IncrementTimeBy(gracePeriod + forfeiturePeriod)
+			}, false,
+		},
+		{
+			"deposit not forfeited: client is within its expiry grace period", func() {
+				setExpiryGracePeriod(path.EndpointA, 2*(gracePeriod+forfeiturePeriod))
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + gracePeriod + forfeiturePeriod)
+			}, false,
+		},
+		{
+			"deposit not forfeited: forfeiture period has not elapsed", func() {
+				freezeClient(path.EndpointA)
+				suite.coordinator.IncrementTimeBy(gracePeriod)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			forfeiturePeriod = 24 * time.Hour
+
+			suite.setClientDepositParams(clientDeposit, gracePeriod, forfeiturePeriod)
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			latestHeight := path.EndpointA.GetClientLatestHeight()
+
+			tc.malleate()
+
+			suite.setClientDepositParams(clientDeposit, gracePeriod, forfeiturePeriod)
+
+			ctx := suite.chainA.GetContext()
+			distrKeeper := suite.chainA.GetSimApp().DistrKeeper
+			feePoolBefore, err := distrKeeper.FeePool.Get(ctx)
+			suite.Require().NoError(err)
+
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+			clientKeeper.ForfeitAbandonedClientDeposits(ctx)
+
+			feePoolAfter, err := distrKeeper.FeePool.Get(ctx)
+			suite.Require().NoError(err)
+
+			_, found := clientKeeper.GetClientDeposit(ctx, path.EndpointA.ClientID)
+			suite.Require().Equal(!tc.expForfeited, found)
+
+			// the consensus states of abandoned clients are pruned
+			_, found = clientKeeper.GetClientConsensusState(ctx, path.EndpointA.ClientID, latestHeight)
+			suite.Require().Equal(!tc.expForfeited, found)
+
+			if tc.expForfeited {
+				expCommunityPool := feePoolBefore.CommunityPool.Add(sdk.NewDecCoinsFromCoins(clientDeposit...)...)
+				suite.Require().Equal(expCommunityPool, feePoolAfter.CommunityPool)
+			} else {
+				suite.Require().Equal(feePoolBefore.CommunityPool, feePoolAfter.CommunityPool)
+			}
+		})
+	}
+}
+
+// TestForfeitAbandonedClientDepositsPerBlock asserts that at most MaxClientDepositsCheckedPerBlock client deposits are
+// checked for forfeiture per block, resuming after the last deposit checked in the previous block.
+func (suite *KeeperTestSuite) TestForfeitAbandonedClientDepositsPerBlock() {
+	gracePeriod, forfeiturePeriod := time.Hour, 24*time.Hour
+
+	suite.setClientDepositParams(clientDeposit, gracePeriod, forfeiturePeriod)
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	freezeClient(path.EndpointA)
+	suite.coordinator.IncrementTimeBy(gracePeriod + forfeiturePeriod)
+
+	// deposits of clients which do not exist are never forfeited and are ordered before the deposit of the abandoned client
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	for i := 0; i < types.MaxClientDepositsCheckedPerBlock; i++ {
+		clientKeeper.SetAllClientDeposits(suite.chainA.GetContext(), []types.ClientDeposit{
+			types.NewClientDeposit(fmt.Sprintf("00-unknown-%d", i), suite.chainA.SenderAccount.GetAddress().String(), clientDeposit),
+		})
+	}
+
+	// the deposit of the abandoned client is not checked in the first block
+	clientKeeper.ForfeitAbandonedClientDeposits(suite.chainA.GetContext())
+
+	_, found := clientKeeper.GetClientDeposit(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().True(found)
+
+	// the deposit of the abandoned client is forfeited in the next block
+	clientKeeper.ForfeitAbandonedClientDeposits(suite.chainA.GetContext())
+
+	_, found = clientKeeper.GetClientDeposit(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().False(found)
+	suite.Require().Len(clientKeeper.GetAllClientDeposits(suite.chainA.GetContext()), types.MaxClientDepositsCheckedPerBlock)
+}
+
+// TestForfeitAbandonedClientDepositsPruningPerBlock asserts that at most MaxConsensusStatesPrunedPerBlock consensus states
+// are pruned per block, that the deposit of an abandoned client is only forfeited once its consensus states have been
+// pruned and that the consensus states of a frozen client prior to its misbehaviour height are kept.
+func (suite *KeeperTestSuite) TestForfeitAbandonedClientDepositsPruningPerBlock() {
+	gracePeriod, forfeiturePeriod := time.Hour, 24*time.Hour
+
+	suite.setClientDepositParams(clientDeposit, gracePeriod, forfeiturePeriod)
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	ctx := suite.chainA.GetContext()
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	clientStore := clientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+
+	latestHeight, ok := path.EndpointA.GetClientLatestHeight().(types.Height)
+	suite.Require().True(ok)
+	consensusState := path.EndpointA.GetConsensusState(latestHeight)
+
+	// store more consensus states following the latest one than may be pruned in a single block
+	var lastHeight types.Height
+	for i := 1; i <= types.MaxConsensusStatesPrunedPerBlock+1; i++ {
+		lastHeight = types.NewHeight(latestHeight.RevisionNumber, latestHeight.RevisionHeight+uint64(i))
+		clientKeeper.SetClientConsensusState(ctx, path.EndpointA.ClientID, lastHeight, consensusState)
+		ibctm.SetIterationKey(clientStore, lastHeight)
+	}
+
+	freezeClient(path.EndpointA)
+	clientStore.Set(ibctm.KeyMisbehaviourHeight, []byte(latestHeight.Increment().String()))
+
+	suite.coordinator.IncrementTimeBy(gracePeriod + forfeiturePeriod)
+
+	// the deposit is not forfeited while consensus states remain to be pruned
+	clientKeeper.ForfeitAbandonedClientDeposits(suite.chainA.GetContext())
+
+	_, found := clientKeeper.GetClientDeposit(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().True(found)
+
+	_, found = clientKeeper.GetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, lastHeight)
+	suite.Require().True(found)
+
+	// the deposit is forfeited in the next block once the remaining consensus states have been pruned
+	clientKeeper.ForfeitAbandonedClientDeposits(suite.chainA.GetContext())
+
+	_, found = clientKeeper.GetClientDeposit(suite.chainA.GetContext(), path.EndpointA.ClientID)
+	suite.Require().False(found)
+
+	_, found = clientKeeper.GetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, lastHeight)
+	suite.Require().False(found)
+
+	// the consensus state prior to the misbehaviour height is kept for the verification of timeouts
+	_, found = clientKeeper.GetClientConsensusState(suite.chainA.GetContext(), path.EndpointA.ClientID, latestHeight)
+	suite.Require().True(found)
+}
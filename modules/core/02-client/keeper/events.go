@@ -7,7 +7,6 @@ import (
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
-	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -31,17 +30,12 @@ func emitCreateClientEvent(ctx sdk.Context, clientID, clientType string, initial
 }
 
 // emitUpdateClientEvent emits an update client event
-func emitUpdateClientEvent(ctx sdk.Context, clientID string, clientType string, consensusHeights []exported.Height, _ codec.BinaryCodec, _ exported.ClientMessage) {
+func emitUpdateClientEvent(ctx sdk.Context, clientID string, clientType string, consensusHeights []exported.Height, updateResult string, previousHeight exported.Height, newConsensusHeights []exported.Height) {
 	var consensusHeightAttr string
 	if len(consensusHeights) != 0 {
 		consensusHeightAttr = consensusHeights[0].String()
 	}
 
-	consensusHeightsAttr := make([]string, len(consensusHeights))
-	for i, height := range consensusHeights {
-		consensusHeightsAttr[i] = height.String()
-	}
-
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeUpdateClient,
@@ -50,7 +44,10 @@ func emitUpdateClientEvent(ctx sdk.Context, clientID string, clientType string,
 			// Deprecated: AttributeKeyConsensusHeight is deprecated and will be removed in a future release.
 			// Please use AttributeKeyConsensusHeights instead.
 			sdk.NewAttribute(types.AttributeKeyConsensusHeight, consensusHeightAttr),
-			sdk.NewAttribute(types.AttributeKeyConsensusHeights, strings.Join(consensusHeightsAttr, ",")),
+			sdk.NewAttribute(types.AttributeKeyConsensusHeights, joinHeights(consensusHeights)),
+			sdk.NewAttribute(types.AttributeKeyUpdateResult, updateResult),
+			sdk.NewAttribute(types.AttributeKeyPreviousHeight, previousHeight.String()),
+			sdk.NewAttribute(types.AttributeKeyNewConsensusHeights, joinHeights(newConsensusHeights)),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -59,6 +56,50 @@ func emitUpdateClientEvent(ctx sdk.Context, clientID string, clientType string,
 	})
 }
 
+// emitUpdateClientResultEvent emits the typed update client result event
+func emitUpdateClientResultEvent(ctx sdk.Context, clientID, clientType, updateResult string, previousHeight exported.Height, consensusHeights, newConsensusHeights []exported.Height, frozenHeight types.Height) {
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventUpdateClientResult{
+		ClientId:            clientID,
+		ClientType:          clientType,
+		UpdateResult:        updateResult,
+		PreviousHeight:      toHeight(previousHeight),
+		ConsensusHeights:    toHeights(consensusHeights),
+		NewConsensusHeights: toHeights(newConsensusHeights),
+		FrozenHeight:        frozenHeight,
+	}); err != nil {
+		ctx.Logger().Error("failed to emit update client result event", "client-id", clientID, "error", err)
+	}
+}
+
+// joinHeights returns the comma separated list of the provided heights.
+func joinHeights(heights []exported.Height) string {
+	heightsAttr := make([]string, len(heights))
+	for i, height := range heights {
+		heightsAttr[i] = height.String()
+	}
+
+	return strings.Join(heightsAttr, ",")
+}
+
+// toHeight converts the provided height into a types.Height.
+func toHeight(height exported.Height) types.Height {
+	return types.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight())
+}
+
+// toHeights converts the provided heights into types.Height values.
+func toHeights(heights []exported.Height) []types.Height {
+	if len(heights) == 0 {
+		return nil
+	}
+
+	converted := make([]types.Height, len(heights))
+	for i, height := range heights {
+		converted[i] = toHeight(height)
+	}
+
+	return converted
+}
+
 // emitUpgradeClientEvent emits an upgrade client event
 func emitUpgradeClientEvent(ctx sdk.Context, clientID, clientType string, latestHeight exported.Height) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -76,12 +117,15 @@ func emitUpgradeClientEvent(ctx sdk.Context, clientID, clientType string, latest
 }
 
 // emitSubmitMisbehaviourEvent emits a client misbehaviour event
-func emitSubmitMisbehaviourEvent(ctx sdk.Context, clientID string, clientType string) {
+func emitSubmitMisbehaviourEvent(ctx sdk.Context, clientID string, clientType string, previousHeight exported.Height, frozenHeight types.Height) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeSubmitMisbehaviour,
 			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
 			sdk.NewAttribute(types.AttributeKeyClientType, clientType),
+			sdk.NewAttribute(types.AttributeKeyUpdateResult, types.UpdateResultMisbehaviour),
+			sdk.NewAttribute(types.AttributeKeyPreviousHeight, previousHeight.String()),
+			sdk.NewAttribute(types.AttributeKeyFrozenHeight, frozenHeight.String()),
 		),
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -105,6 +149,54 @@ func emitRecoverClientEvent(ctx sdk.Context, clientID, clientType string) {
 	})
 }
 
+// emitFreezeClientEvent emits a freeze client event
+func emitFreezeClientEvent(ctx sdk.Context, clientID, clientType string, frozenHeight exported.Height) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeFreezeClient,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyClientType, clientType),
+			sdk.NewAttribute(types.AttributeKeyFrozenHeight, frozenHeight.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitSetClientAliasEvent emits a set client alias event. An empty alias indicates the alias of the client was removed.
+func emitSetClientAliasEvent(ctx sdk.Context, clientID, alias, previousAlias string) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetClientAlias,
+			sdk.NewAttribute(types.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(types.AttributeKeyAlias, alias),
+			sdk.NewAttribute(types.AttributeKeyPreviousAlias, previousAlias),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// emitClientDepositEvent emits an escrow, reclaim or forfeit client deposit event
+func emitClientDepositEvent(ctx sdk.Context, eventType string, deposit types.ClientDeposit) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyClientID, deposit.ClientId),
+			sdk.NewAttribute(types.AttributeKeyDepositor, deposit.Depositor),
+			sdk.NewAttribute(types.AttributeKeyAmount, deposit.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
 // emitScheduleIBCSoftwareUpgradeEvent emits a schedule IBC software upgrade event
 func emitScheduleIBCSoftwareUpgradeEvent(ctx sdk.Context, title string, height int64) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -5,6 +5,7 @@ import (
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
@@ -55,6 +56,7 @@ func (suite *KeeperTestSuite) TestMsgUpdateClientEvents() {
 	path := ibctesting.NewPath(suite.chainA, suite.chainB)
 
 	suite.Require().NoError(path.EndpointA.CreateClient())
+	previousHeight := path.EndpointA.GetClientLatestHeight()
 
 	suite.chainB.Coordinator.CommitBlock(suite.chainB)
 
@@ -85,6 +87,9 @@ func (suite *KeeperTestSuite) TestMsgUpdateClientEvents() {
 			sdk.NewAttribute(clienttypes.AttributeKeyClientType, path.EndpointA.GetClientState().ClientType()),
 			sdk.NewAttribute(clienttypes.AttributeKeyConsensusHeight, path.EndpointA.GetClientLatestHeight().String()),
 			sdk.NewAttribute(clienttypes.AttributeKeyConsensusHeights, path.EndpointA.GetClientLatestHeight().String()),
+			sdk.NewAttribute(clienttypes.AttributeKeyUpdateResult, clienttypes.UpdateResultUpdate),
+			sdk.NewAttribute(clienttypes.AttributeKeyPreviousHeight, previousHeight.String()),
+			sdk.NewAttribute(clienttypes.AttributeKeyNewConsensusHeights, path.EndpointA.GetClientLatestHeight().String()),
 		),
 	}.ToABCIEvents()
 
@@ -92,3 +97,138 @@ func (suite *KeeperTestSuite) TestMsgUpdateClientEvents() {
 	expectedEvents = sdk.MarkEventsToIndex(expectedEvents, indexSet)
 	ibctesting.AssertEvents(&suite.Suite, expectedEvents, events)
 }
+
+func (suite *KeeperTestSuite) TestUpdateClientResultEvents() {
+	var (
+		path      *ibctesting.Path
+		clientMsg exported.ClientMessage
+	)
+
+	testCases := []struct {
+		name            string
+		malleate        func()
+		expUpdateResult string
+		expNewHeights   bool
+	}{
+		{
+			"update with new consensus state",
+			func() {},
+			clienttypes.UpdateResultUpdate,
+			true,
+		},
+		{
+			"no-op update with existing consensus state",
+			func() {
+				// submit the header before the update under test
+				msg, err := clienttypes.NewMsgUpdateClient(path.EndpointA.ClientID, clientMsg, suite.chainA.SenderAccount.GetAddress().String())
+				suite.Require().NoError(err)
+
+				_, err = suite.chainA.SendMsgs(msg)
+				suite.Require().NoError(err)
+			},
+			clienttypes.UpdateResultNoop,
+			false,
+		},
+		{
+			"misbehaviour",
+			func() {
+				header, ok := clientMsg.(*ibctm.Header)
+				suite.Require().True(ok)
+
+				clientMsg = ibctm.NewMisbehaviour(
+					path.EndpointA.ClientID,
+					header,
+					suite.chainA.CreateConflictingHeader(path.EndpointA.ClientID, header.GetHeight().(clienttypes.Height)),
+				)
+			},
+			clienttypes.UpdateResultMisbehaviour,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			previousHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+			suite.Require().True(ok)
+
+			suite.coordinator.CommitBlock(suite.chainB)
+
+			var err error
+			clientMsg, err = suite.chainB.IBCClientHeader(suite.chainB.LatestCommittedHeader, previousHeight)
+			suite.Require().NoError(err)
+
+			expHeight, ok := clientMsg.(*ibctm.Header).GetHeight().(clienttypes.Height)
+			suite.Require().True(ok)
+
+			tc.malleate()
+
+			// the no-op case updates the client prior to the update under test
+			previousHeight, ok = path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+			suite.Require().True(ok)
+
+			msg, err := clienttypes.NewMsgUpdateClient(path.EndpointA.ClientID, clientMsg, suite.chainA.SenderAccount.GetAddress().String())
+			suite.Require().NoError(err)
+
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+
+			result, err := ibctesting.ParseUpdateClientResultFromEvents(res.Events)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(path.EndpointA.ClientID, result.ClientId)
+			suite.Require().Equal(exported.Tendermint, result.ClientType)
+			suite.Require().Equal(tc.expUpdateResult, result.UpdateResult)
+			suite.Require().Equal(previousHeight, result.PreviousHeight)
+
+			switch tc.expUpdateResult {
+			case clienttypes.UpdateResultMisbehaviour:
+				suite.Require().Empty(result.ConsensusHeights)
+				suite.Require().Equal(ibctm.FrozenHeight, result.FrozenHeight)
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						clienttypes.EventTypeSubmitMisbehaviour,
+						sdk.NewAttribute(clienttypes.AttributeKeyClientID, path.EndpointA.ClientID),
+						sdk.NewAttribute(clienttypes.AttributeKeyClientType, exported.Tendermint),
+						sdk.NewAttribute(clienttypes.AttributeKeyUpdateResult, clienttypes.UpdateResultMisbehaviour),
+						sdk.NewAttribute(clienttypes.AttributeKeyPreviousHeight, previousHeight.String()),
+						sdk.NewAttribute(clienttypes.AttributeKeyFrozenHeight, ibctm.FrozenHeight.String()),
+					),
+				}.ToABCIEvents()
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, res.Events)
+			default:
+				suite.Require().Equal([]clienttypes.Height{expHeight}, result.ConsensusHeights)
+				suite.Require().True(result.FrozenHeight.IsZero())
+
+				expNewHeightsAttr := ""
+				if tc.expNewHeights {
+					suite.Require().Equal([]clienttypes.Height{expHeight}, result.NewConsensusHeights)
+					expNewHeightsAttr = expHeight.String()
+				} else {
+					suite.Require().Empty(result.NewConsensusHeights)
+				}
+
+				expectedEvents := sdk.Events{
+					sdk.NewEvent(
+						clienttypes.EventTypeUpdateClient,
+						sdk.NewAttribute(clienttypes.AttributeKeyClientID, path.EndpointA.ClientID),
+						sdk.NewAttribute(clienttypes.AttributeKeyClientType, exported.Tendermint),
+						sdk.NewAttribute(clienttypes.AttributeKeyConsensusHeight, expHeight.String()),
+						sdk.NewAttribute(clienttypes.AttributeKeyConsensusHeights, expHeight.String()),
+						sdk.NewAttribute(clienttypes.AttributeKeyUpdateResult, tc.expUpdateResult),
+						sdk.NewAttribute(clienttypes.AttributeKeyPreviousHeight, previousHeight.String()),
+						sdk.NewAttribute(clienttypes.AttributeKeyNewConsensusHeights, expNewHeightsAttr),
+					),
+				}.ToABCIEvents()
+				ibctesting.AssertEvents(&suite.Suite, expectedEvents, res.Events)
+			}
+		})
+	}
+}
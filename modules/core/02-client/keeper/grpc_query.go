@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 )
 
 var _ types.QueryServer = (*Keeper)(nil)
@@ -30,16 +31,18 @@ func (k *Keeper) ClientState(c context.Context, req *types.QueryClientStateReque
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+	ctx := sdk.UnwrapSDKContext(c)
+	clientID := k.ResolveClientID(ctx, req.ClientId)
+
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
-	clientState, found := k.GetClientState(ctx, req.ClientId)
+	clientState, found := k.GetClientState(ctx, clientID)
 	if !found {
 		return nil, status.Error(
 			codes.NotFound,
-			errorsmod.Wrap(types.ErrClientNotFound, req.ClientId).Error(),
+			errorsmod.Wrap(types.ErrClientNotFound, clientID).Error(),
 		)
 	}
 
@@ -48,10 +51,13 @@ func (k *Keeper) ClientState(c context.Context, req *types.QueryClientStateReque
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	alias, _ := k.GetClientAlias(ctx, clientID)
+
 	proofHeight := types.GetSelfHeight(ctx)
 	return &types.QueryClientStateResponse{
 		ClientState: protoAny,
 		ProofHeight: proofHeight,
+		Alias:       alias,
 	}, nil
 }
 
@@ -105,12 +111,13 @@ func (k *Keeper) ConsensusState(c context.Context, req *types.QueryConsensusStat
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+	ctx := sdk.UnwrapSDKContext(c)
+	clientID := k.ResolveClientID(ctx, req.ClientId)
+
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
-
 	var (
 		consensusState exported.ConsensusState
 		found          bool
@@ -118,19 +125,19 @@ func (k *Keeper) ConsensusState(c context.Context, req *types.QueryConsensusStat
 
 	height := types.NewHeight(req.RevisionNumber, req.RevisionHeight)
 	if req.LatestHeight {
-		consensusState, found = k.GetLatestClientConsensusState(ctx, req.ClientId)
+		consensusState, found = k.GetLatestClientConsensusState(ctx, clientID)
 	} else {
 		if req.RevisionHeight == 0 {
 			return nil, status.Error(codes.InvalidArgument, "consensus state height cannot be 0")
 		}
 
-		consensusState, found = k.GetClientConsensusState(ctx, req.ClientId, height)
+		consensusState, found = k.GetClientConsensusState(ctx, clientID, height)
 	}
 
 	if !found {
 		return nil, status.Error(
 			codes.NotFound,
-			errorsmod.Wrapf(types.ErrConsensusStateNotFound, "client-id: %s, height: %s", req.ClientId, height).Error(),
+			errorsmod.Wrapf(types.ErrConsensusStateNotFound, "client-id: %s, height: %s", clientID, height).Error(),
 		)
 	}
 
@@ -139,10 +146,13 @@ func (k *Keeper) ConsensusState(c context.Context, req *types.QueryConsensusStat
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	alias, _ := k.GetClientAlias(ctx, clientID)
+
 	proofHeight := types.GetSelfHeight(ctx)
 	return &types.QueryConsensusStateResponse{
 		ConsensusState: protoAny,
 		ProofHeight:    proofHeight,
+		Alias:          alias,
 	}, nil
 }
 
@@ -152,14 +162,15 @@ func (k *Keeper) ConsensusStates(c context.Context, req *types.QueryConsensusSta
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+	ctx := sdk.UnwrapSDKContext(c)
+	clientID := k.ResolveClientID(ctx, req.ClientId)
+
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
-
 	var consensusStates []types.ConsensusStateWithHeight
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), host.FullClientKey(req.ClientId, []byte(fmt.Sprintf("%s/", host.KeyConsensusStatePrefix))))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), host.FullClientKey(clientID, []byte(fmt.Sprintf("%s/", host.KeyConsensusStatePrefix))))
 
 	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
 		// filter any metadata stored under consensus state key
@@ -196,14 +207,15 @@ func (k *Keeper) ConsensusStateHeights(c context.Context, req *types.QueryConsen
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+	ctx := sdk.UnwrapSDKContext(c)
+	clientID := k.ResolveClientID(ctx, req.ClientId)
+
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
-
 	var consensusStateHeights []types.Height
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), host.FullClientKey(req.ClientId, []byte(fmt.Sprintf("%s/", host.KeyConsensusStatePrefix))))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), host.FullClientKey(clientID, []byte(fmt.Sprintf("%s/", host.KeyConsensusStatePrefix))))
 
 	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
 		// filter any metadata stored under consensus state key
@@ -235,15 +247,135 @@ func (k *Keeper) ClientStatus(c context.Context, req *types.QueryClientStatusReq
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+	ctx := sdk.UnwrapSDKContext(c)
+	clientID := k.ResolveClientID(ctx, req.ClientId)
+
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
-	clientStatus := k.GetClientStatus(ctx, req.ClientId)
+	clientStatus := k.GetClientStatus(ctx, clientID)
+	alias, _ := k.GetClientAlias(ctx, clientID)
 
 	return &types.QueryClientStatusResponse{
 		Status: clientStatus.String(),
+		Alias:  alias,
+	}, nil
+}
+
+// VerifyClientParameters implements the Query/VerifyClientParameters gRPC method
+func (k *Keeper) VerifyClientParameters(c context.Context, req *types.QueryVerifyClientParametersRequest) (*types.QueryVerifyClientParametersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	clientID := k.ResolveClientID(ctx, req.ClientId)
+
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if req.CounterpartyUnbondingPeriod < 0 {
+		return nil, status.Error(codes.InvalidArgument, "counterparty unbonding period cannot be negative")
+	}
+
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrClientNotFound, clientID).Error(),
+		)
+	}
+
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			errorsmod.Wrapf(types.ErrInvalidClientType, "expected: %T, got: %T", &ibctm.ClientState{}, clientState).Error(),
+		)
+	}
+
+	violations := []types.ClientParameterViolation{}
+	for _, err := range tmClientState.CheckParameters(req.CounterpartyUnbondingPeriod) {
+		codespace, code, _ := errorsmod.ABCIInfo(err, false)
+		violations = append(violations, types.ClientParameterViolation{
+			Codespace: codespace,
+			Code:      code,
+			Reason:    err.Error(),
+		})
+	}
+
+	return &types.QueryVerifyClientParametersResponse{
+		Valid:      len(violations) == 0,
+		Violations: violations,
+	}, nil
+}
+
+// VerifyHeaderAgainstClient implements the Query/VerifyHeaderAgainstClient gRPC method
+func (k *Keeper) VerifyHeaderAgainstClient(c context.Context, req *types.QueryVerifyHeaderAgainstClientRequest) (*types.QueryVerifyHeaderAgainstClientResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	clientID := k.ResolveClientID(ctx, req.ClientId)
+
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	clientMsg, err := types.UnpackClientMessage(req.Header)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if _, found := k.GetClientState(ctx, clientID); !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrClientNotFound, clientID).Error(),
+		)
+	}
+
+	if err := k.DryRunUpdateClient(ctx, clientID, clientMsg); err != nil {
+		codespace, code, _ := errorsmod.ABCIInfo(err, false)
+		return &types.QueryVerifyHeaderAgainstClientResponse{
+			Valid:     false,
+			Codespace: codespace,
+			Code:      code,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	return &types.QueryVerifyHeaderAgainstClientResponse{
+		Valid: true,
+	}, nil
+}
+
+// ClientAlias implements the Query/ClientAlias gRPC method
+func (k *Keeper) ClientAlias(c context.Context, req *types.QueryClientAliasRequest) (*types.QueryClientAliasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	clientID := k.ResolveClientID(ctx, req.ClientId)
+
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	alias, found := k.GetClientAlias(ctx, clientID)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrClientAliasNotFound, clientID).Error(),
+		)
+	}
+
+	return &types.QueryClientAliasResponse{
+		ClientId: clientID,
+		Alias:    alias,
 	}, nil
 }
 
@@ -287,8 +419,16 @@ func (k *Keeper) UpgradedClientState(c context.Context, req *types.QueryUpgraded
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	// the chain parameters may have changed since the upgrade was scheduled
+	var validationError string
+	if err := k.ValidateUpgradedClientState(ctx, clientState); err != nil {
+		validationError = err.Error()
+	}
+
 	return &types.QueryUpgradedClientStateResponse{
 		UpgradedClientState: protoAny,
+		Valid:               validationError == "",
+		ValidationError:     validationError,
 	}, nil
 }
 
@@ -375,7 +515,7 @@ func (k *Keeper) VerifyMembership(c context.Context, req *types.QueryVerifyMembe
 		return nil, status.Error(codes.NotFound, req.ClientId)
 	}
 
-	if clientStatus := k.GetClientStatus(ctx, req.ClientId); clientStatus != exported.Active {
+	if clientStatus := k.GetClientStatus(ctx, req.ClientId); clientStatus != exported.Active && clientStatus != exported.ExpiredGrace {
 		return nil, status.Error(codes.FailedPrecondition, errorsmod.Wrapf(types.ErrClientNotActive, "cannot verify membership using client (%s) with status %s", req.ClientId, clientStatus).Error())
 	}
 
@@ -3,10 +3,12 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	errorsmod "cosmossdk.io/errors"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -25,6 +27,7 @@ func (suite *KeeperTestSuite) TestQueryClientState() {
 	var (
 		req            *types.QueryClientStateRequest
 		expClientState *codectypes.Any
+		expAlias       string
 	)
 
 	testCases := []struct {
@@ -71,6 +74,25 @@ func (suite *KeeperTestSuite) TestQueryClientState() {
 			},
 			true,
 		},
+		{
+			"success: query by alias",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupClients()
+
+				expAlias = "cosmoshub"
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientAlias(suite.chainA.GetContext(), path.EndpointA.ClientID, expAlias)
+				suite.Require().NoError(err)
+
+				expClientState, err = types.PackClientState(path.EndpointA.GetClientState())
+				suite.Require().NoError(err)
+
+				req = &types.QueryClientStateRequest{
+					ClientId: expAlias,
+				}
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -78,6 +100,7 @@ func (suite *KeeperTestSuite) TestQueryClientState() {
 
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
+			expAlias = ""
 
 			tc.malleate()
 			ctx := suite.chainA.GetContext()
@@ -87,6 +110,7 @@ func (suite *KeeperTestSuite) TestQueryClientState() {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
 				suite.Require().Equal(expClientState, res.ClientState)
+				suite.Require().Equal(expAlias, res.Alias)
 
 				// ensure UnpackInterfaces is defined
 				cachedValue := res.ClientState.GetCachedValue()
@@ -544,6 +568,21 @@ func (suite *KeeperTestSuite) TestQueryClientStatus() {
 			},
 			true, exported.Active.String(),
 		},
+		{
+			"Active client status queried by alias",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupClients()
+
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientAlias(suite.chainA.GetContext(), path.EndpointA.ClientID, "cosmoshub")
+				suite.Require().NoError(err)
+
+				req = &types.QueryClientStatusRequest{
+					ClientId: "cosmoshub",
+				}
+			},
+			true, exported.Active.String(),
+		},
 		{
 			"Unknown client status",
 			func() {
@@ -603,11 +642,364 @@ func (suite *KeeperTestSuite) TestQueryClientStatus() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryClientAlias() {
+	var (
+		req      *types.QueryClientAliasRequest
+		clientID string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: query by alias",
+			func() {
+				req.ClientId = "cosmoshub"
+			},
+			nil,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid clientID",
+			func() {
+				req.ClientId = ""
+			},
+			status.Error(codes.InvalidArgument, errorsmod.Wrap(host.ErrInvalidID, "identifier cannot be blank").Error()),
+		},
+		{
+			"alias not found",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupClients()
+
+				req.ClientId = path.EndpointA.ClientID
+			},
+			status.Error(codes.NotFound, errorsmod.Wrap(types.ErrClientAliasNotFound, "07-tendermint-1").Error()),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			clientID = path.EndpointA.ClientID
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientAlias(suite.chainA.GetContext(), clientID, "cosmoshub")
+			suite.Require().NoError(err)
+
+			req = &types.QueryClientAliasRequest{
+				ClientId: clientID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.QueryServer.ClientAlias(suite.chainA.GetContext(), req)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(clientID, res.ClientId)
+				suite.Require().Equal("cosmoshub", res.Alias)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(tc.expErr.Error(), err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryVerifyClientParameters() {
+	var (
+		req  *types.QueryVerifyClientParametersRequest
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		msg           string
+		malleate      func()
+		expErr        error
+		expViolations []error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+			nil,
+		},
+		{
+			"success: trusting period exceeds 2/3 of counterparty unbonding period",
+			func() {
+				req.CounterpartyUnbondingPeriod = ibctesting.TrustingPeriod + time.Hour
+			},
+			nil,
+			[]error{ibctm.ErrInvalidUnbondingPeriod, ibctm.ErrTrustingPeriodNotRecommended},
+		},
+		{
+			"success: max clock drift and trust level out of bounds",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				clientState.MaxClockDrift = ibctm.MaxRecommendedClockDrift + time.Second
+				clientState.TrustLevel = ibctm.Fraction{Numerator: 1, Denominator: 4}
+				path.EndpointA.SetClientState(clientState)
+			},
+			nil,
+			[]error{ibctm.ErrMaxClockDriftNotRecommended, ibctm.ErrInvalidTrustLevel},
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+			nil,
+		},
+		{
+			"invalid clientID",
+			func() {
+				req.ClientId = ""
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+			nil,
+		},
+		{
+			"negative counterparty unbonding period",
+			func() {
+				req.CounterpartyUnbondingPeriod = -time.Second
+			},
+			status.Error(codes.InvalidArgument, "counterparty unbonding period cannot be negative"),
+			nil,
+		},
+		{
+			"client not found",
+			func() {
+				req.ClientId = ibctesting.InvalidID
+			},
+			status.Error(codes.NotFound, fmt.Sprintf("%s: light client not found", ibctesting.InvalidID)),
+			nil,
+		},
+		{
+			"client is not a tendermint client",
+			func() {
+				req.ClientId = exported.LocalhostClientID
+			},
+			status.Error(codes.InvalidArgument, fmt.Sprintf("expected: %T, got: %T: invalid client type", &ibctm.ClientState{}, suite.chainA.GetClientState(exported.LocalhostClientID))),
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			req = &types.QueryVerifyClientParametersRequest{
+				ClientId:                    path.EndpointA.ClientID,
+				CounterpartyUnbondingPeriod: ibctesting.UnbondingPeriod,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.QueryServer.VerifyClientParameters(suite.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(len(tc.expViolations) == 0, res.Valid)
+				suite.Require().Len(res.Violations, len(tc.expViolations))
+
+				for i, expErr := range tc.expViolations {
+					codespace, code, _ := errorsmod.ABCIInfo(expErr, false)
+					suite.Require().Equal(codespace, res.Violations[i].Codespace)
+					suite.Require().Equal(code, res.Violations[i].Code)
+					suite.Require().NotEmpty(res.Violations[i].Reason)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryVerifyHeaderAgainstClient() {
+	var (
+		req    *types.QueryVerifyHeaderAgainstClientRequest
+		path   *ibctesting.Path
+		header *ibctm.Header
+	)
+
+	packHeader := func(header *ibctm.Header) *codectypes.Any {
+		protoAny, err := types.PackClientMessage(header)
+		suite.Require().NoError(err)
+		return protoAny
+	}
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expErr    error
+		expReason error
+	}{
+		{
+			"success: valid header",
+			func() {},
+			nil,
+			nil,
+		},
+		{
+			"success: header with trusted height without consensus state",
+			func() {
+				header.TrustedHeight = types.NewHeight(header.TrustedHeight.RevisionNumber, 1)
+				req.Header = packHeader(header)
+			},
+			nil,
+			types.ErrConsensusStateNotFound,
+		},
+		{
+			"success: header height not greater than trusted height",
+			func() {
+				header.TrustedHeight = header.GetHeight().(types.Height)
+				req.Header = packHeader(header)
+			},
+			nil,
+			ibctm.ErrInvalidHeaderHeight,
+		},
+		{
+			"success: header conflicts with stored consensus state",
+			func() {
+				consensusState := &ibctm.ConsensusState{
+					Timestamp:          header.GetTime(),
+					Root:               commitmenttypes.NewMerkleRoot([]byte("conflicting root")),
+					NextValidatorsHash: header.Header.NextValidatorsHash,
+				}
+				path.EndpointA.SetConsensusState(consensusState, header.GetHeight())
+			},
+			nil,
+			types.ErrInvalidHeader,
+		},
+		{
+			"success: client is frozen",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				clientState.FrozenHeight = types.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			nil,
+			types.ErrClientNotActive,
+		},
+		{
+			"req is nil",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+			nil,
+		},
+		{
+			"invalid clientID",
+			func() {
+				req.ClientId = ""
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+			nil,
+		},
+		{
+			"header is nil",
+			func() {
+				req.Header = nil
+			},
+			status.Error(codes.InvalidArgument, "protobuf Any message cannot be nil: failed unpacking protobuf message from Any"),
+			nil,
+		},
+		{
+			"client not found",
+			func() {
+				req.ClientId = ibctesting.InvalidID
+			},
+			status.Error(codes.NotFound, fmt.Sprintf("%s: light client not found", ibctesting.InvalidID)),
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(types.Height)
+			suite.Require().True(ok)
+
+			var err error
+			header, err = suite.chainB.IBCClientHeader(suite.chainB.LatestCommittedHeader, trustedHeight)
+			suite.Require().NoError(err)
+
+			req = &types.QueryVerifyHeaderAgainstClientRequest{
+				ClientId: path.EndpointA.ClientID,
+				Header:   packHeader(header),
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainA.QueryServer.VerifyHeaderAgainstClient(suite.chainA.GetContext(), req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(tc.expReason == nil, res.Valid)
+
+				if tc.expReason != nil {
+					codespace, code, _ := errorsmod.ABCIInfo(tc.expReason, false)
+					suite.Require().Equal(codespace, res.Codespace)
+					suite.Require().Equal(code, res.Code)
+					suite.Require().NotEmpty(res.Reason)
+				}
+
+				// the client is not updated
+				suite.Require().Equal(trustedHeight, path.EndpointA.GetClientLatestHeight())
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryUpgradedClientState() {
 	var (
 		req            *types.QueryUpgradedClientStateRequest
 		path           *ibctesting.Path
 		expClientState *ibctm.ClientState
+		expValid       bool
 	)
 
 	upgradePlan := upgradetypes.Plan{
@@ -625,14 +1017,14 @@ func (suite *KeeperTestSuite) TestQueryUpgradedClientState() {
 			func() {
 				validAuthority := suite.chainA.App.GetIBCKeeper().GetAuthority()
 
-				// update trusting period
-				clientState := path.EndpointA.GetClientState()
-				clientState.(*ibctm.ClientState).TrustingPeriod += 100
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				expClientState = clientState.ZeroCustomFields()
 
 				msg, err := types.NewMsgIBCSoftwareUpgrade(
 					validAuthority,
 					upgradePlan,
-					clientState,
+					expClientState,
 				)
 				suite.Require().NoError(err)
 
@@ -640,9 +1032,36 @@ func (suite *KeeperTestSuite) TestQueryUpgradedClientState() {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(resp)
 
-				var ok bool
-				expClientState, ok = clientState.(*ibctm.ClientState)
+				expValid = true
+			},
+			nil,
+		},
+		{
+			"success: upgraded client state invalidated by changed unbonding period",
+			func() {
+				validAuthority := suite.chainA.App.GetIBCKeeper().GetAuthority()
+
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
 				suite.Require().True(ok)
+				expClientState = clientState.ZeroCustomFields()
+
+				msg, err := types.NewMsgIBCSoftwareUpgrade(
+					validAuthority,
+					upgradePlan,
+					expClientState,
+				)
+				suite.Require().NoError(err)
+
+				_, err = suite.chainA.App.GetIBCKeeper().IBCSoftwareUpgrade(suite.chainA.GetContext(), msg)
+				suite.Require().NoError(err)
+
+				// change the unbonding period after the upgrade has been scheduled
+				params, err := suite.chainA.GetSimApp().StakingKeeper.GetParams(suite.chainA.GetContext())
+				suite.Require().NoError(err)
+				params.UnbondingTime += time.Hour
+				suite.Require().NoError(suite.chainA.GetSimApp().StakingKeeper.SetParams(suite.chainA.GetContext(), params))
+
+				expValid = false
 			},
 			nil,
 		},
@@ -704,7 +1123,13 @@ func (suite *KeeperTestSuite) TestQueryUpgradedClientState() {
 				upgradedClientStateCmt, ok := upgradedClientState.(*ibctm.ClientState)
 				suite.Require().True(ok)
 
-				suite.Require().Equal(expClientState.ZeroCustomFields(), upgradedClientStateCmt)
+				suite.Require().Equal(expClientState, upgradedClientStateCmt)
+				suite.Require().Equal(expValid, res.Valid)
+				if expValid {
+					suite.Require().Empty(res.ValidationError)
+				} else {
+					suite.Require().Contains(res.ValidationError, types.ErrInvalidUpgradeClient.Error())
+				}
 			} else {
 				suite.Require().ErrorIs(err, tc.expError)
 			}
@@ -30,6 +30,9 @@ type Keeper struct {
 	consensusHost  types.ConsensusHost
 	legacySubspace types.ParamSubspace
 	upgradeKeeper  types.UpgradeKeeper
+
+	bankKeeper         types.BankKeeper
+	distributionKeeper types.DistributionKeeper
 }
 
 // NewKeeper creates a new NewKeeper instance
@@ -157,6 +160,112 @@ func (k *Keeper) SetNextClientSequence(ctx sdk.Context, sequence uint64) {
 	store.Set([]byte(types.KeyNextClientSequence), bz)
 }
 
+// GetClientAlias returns the human-readable alias of the given client, if set.
+func (k *Keeper) GetClientAlias(ctx sdk.Context, clientID string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ClientAliasKey(clientID))
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// GetClientIDByAlias returns the identifier of the client the given alias is registered for, if any.
+func (k *Keeper) GetClientIDByAlias(ctx sdk.Context, alias string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ClientIDByAliasKey(alias))
+	if len(bz) == 0 {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// ResolveClientID returns the identifier of the client registered for the given alias. If no client is registered
+// for it, the provided value is assumed to be a client identifier and is returned unchanged.
+// NOTE: aliases are only resolved by query servers, message handlers only accept client identifiers.
+func (k *Keeper) ResolveClientID(ctx sdk.Context, clientIDOrAlias string) string {
+	if clientID, found := k.GetClientIDByAlias(ctx, clientIDOrAlias); found {
+		return clientID
+	}
+
+	return clientIDOrAlias
+}
+
+// SetClientAlias sets the human-readable alias of an existing client, replacing the alias previously set for it.
+// An empty alias removes the alias of the client. An error is returned if the alias is invalid or already
+// registered for another client.
+func (k *Keeper) SetClientAlias(ctx sdk.Context, clientID, alias string) error {
+	if _, found := k.GetClientState(ctx, clientID); !found {
+		return errorsmod.Wrap(types.ErrClientNotFound, clientID)
+	}
+
+	if alias != "" {
+		if err := types.ValidateClientAlias(alias); err != nil {
+			return err
+		}
+
+		if owner, found := k.GetClientIDByAlias(ctx, alias); found && owner != clientID {
+			return errorsmod.Wrapf(types.ErrInvalidClientAlias, "alias %s is already registered for client %s", alias, owner)
+		}
+	}
+
+	previousAlias, found := k.GetClientAlias(ctx, clientID)
+	if found {
+		k.deleteClientAlias(ctx, clientID, previousAlias)
+	}
+
+	if alias != "" {
+		k.setClientAlias(ctx, clientID, alias)
+	}
+
+	k.Logger(ctx).Info("client alias set", "client-id", clientID, "alias", alias, "previous-alias", previousAlias)
+
+	emitSetClientAliasEvent(ctx, clientID, alias, previousAlias)
+
+	return nil
+}
+
+// setClientAlias stores the alias of a client along with the reverse lookup of the client identifier by its alias.
+func (k *Keeper) setClientAlias(ctx sdk.Context, clientID, alias string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ClientAliasKey(clientID), []byte(alias))
+	store.Set(types.ClientIDByAliasKey(alias), []byte(clientID))
+}
+
+// deleteClientAlias deletes the alias of a client along with the reverse lookup of the client identifier by its alias.
+func (k *Keeper) deleteClientAlias(ctx sdk.Context, clientID, alias string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ClientAliasKey(clientID))
+	store.Delete(types.ClientIDByAliasKey(alias))
+}
+
+// GetAllClientAliases returns the aliases of all clients, ordered by client identifier.
+func (k *Keeper) GetAllClientAliases(ctx sdk.Context) []types.ClientAlias {
+	var aliases []types.ClientAlias
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(fmt.Sprintf("%s/", types.KeyClientAliasPrefix)))
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(ctx.Logger(), func() error { return iterator.Close() })
+
+	for ; iterator.Valid(); iterator.Next() {
+		aliases = append(aliases, types.ClientAlias{
+			ClientId: string(iterator.Key()),
+			Alias:    string(iterator.Value()),
+		})
+	}
+
+	return aliases
+}
+
+// SetAllClientAliases sets the given client aliases in state, it is used when initialising from genesis.
+func (k *Keeper) SetAllClientAliases(ctx sdk.Context, aliases []types.ClientAlias) {
+	for _, alias := range aliases {
+		k.setClientAlias(ctx, alias.ClientId, alias.Alias)
+	}
+}
+
 // IterateConsensusStates provides an iterator over all stored consensus states.
 // objects. For each State object, cb will be called. If the cb returns true,
 // the iterator will close and stop.
@@ -430,6 +539,25 @@ func (k *Keeper) GetClientLatestHeight(ctx sdk.Context, clientID string) types.H
 	return latestHeight
 }
 
+// ClientFrozenHeight returns the frozen height of the client state for the given client identifier. A zero height
+// is returned for active clients and for client types which do not record a frozen height. The returned boolean is
+// only set for 07-tendermint clients and indicates whether the client was frozen by misbehaviour, i.e. the height of
+// the misbehaviour which froze the client is stored.
+func (k *Keeper) ClientFrozenHeight(ctx sdk.Context, clientID string) (types.Height, bool, error) {
+	clientState, found := k.GetClientState(ctx, clientID)
+	if !found {
+		return types.ZeroHeight(), false, errorsmod.Wrapf(types.ErrClientNotFound, "client (%s) not found", clientID)
+	}
+
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok || tmClientState.FrozenHeight.IsZero() {
+		return types.ZeroHeight(), false, nil
+	}
+
+	_, frozenByMisbehaviour := ibctm.GetMisbehaviourHeight(k.ClientStore(ctx, clientID))
+	return tmClientState.FrozenHeight, frozenByMisbehaviour, nil
+}
+
 // GetClientTimestampAtHeight returns the timestamp in nanoseconds of the consensus state at the given height.
 func (k *Keeper) GetClientTimestampAtHeight(ctx sdk.Context, clientID string, height exported.Height) (uint64, error) {
 	clientType, _, err := types.ParseClientIdentifier(clientID)
@@ -469,16 +597,59 @@ func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	store.Set([]byte(types.ParamsKey), bz)
 }
 
-// ScheduleIBCSoftwareUpgrade schedules an upgrade for the IBC client.
-func (k *Keeper) ScheduleIBCSoftwareUpgrade(ctx sdk.Context, plan upgradetypes.Plan, upgradedClientState exported.ClientState) error {
-	// zero out any custom fields before setting
+// ValidateUpgradedClientState validates the upgraded client state of an IBC software upgrade. The upgraded client state
+// must be a tendermint client state with all customizable fields zeroed. If the consensus host implements the
+// UpgradedSelfClientValidator interface, the remaining fields are validated against the current parameters of the chain.
+func (k *Keeper) ValidateUpgradedClientState(ctx sdk.Context, upgradedClientState exported.ClientState) error {
 	cs, ok := upgradedClientState.(*ibctm.ClientState)
 	if !ok {
 		return errorsmod.Wrapf(types.ErrInvalidClientType, "expected: %T, got: %T", &ibctm.ClientState{}, upgradedClientState)
 	}
 
-	cs = cs.ZeroCustomFields()
-	bz, err := types.MarshalClientState(k.cdc, cs)
+	if err := validateZeroedCustomFields(cs); err != nil {
+		return err
+	}
+
+	if validator, ok := k.consensusHost.(types.UpgradedSelfClientValidator); ok {
+		return validator.ValidateUpgradedSelfClient(ctx, cs)
+	}
+
+	return nil
+}
+
+// validateZeroedCustomFields returns an error if any of the customizable fields of the provided tendermint
+// client state is set. Counterparties upgrading their clients keep their own values for these fields.
+func validateZeroedCustomFields(cs *ibctm.ClientState) error {
+	if cs.TrustLevel != (ibctm.Fraction{}) {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeClient, "trust level must be zeroed, got %v", cs.TrustLevel)
+	}
+
+	if cs.TrustingPeriod != 0 {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeClient, "trusting period must be zeroed, got %s", cs.TrustingPeriod)
+	}
+
+	if cs.MaxClockDrift != 0 {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeClient, "max clock drift must be zeroed, got %s", cs.MaxClockDrift)
+	}
+
+	if !cs.FrozenHeight.IsZero() {
+		return errorsmod.Wrapf(types.ErrInvalidUpgradeClient, "frozen height must be zeroed, got %s", cs.FrozenHeight)
+	}
+
+	if cs.AllowUpdateAfterExpiry || cs.AllowUpdateAfterMisbehaviour { //nolint:staticcheck // deprecated fields must be zeroed
+		return errorsmod.Wrap(types.ErrInvalidUpgradeClient, "deprecated allow update fields must be zeroed")
+	}
+
+	return nil
+}
+
+// ScheduleIBCSoftwareUpgrade schedules an upgrade for the IBC client.
+func (k *Keeper) ScheduleIBCSoftwareUpgrade(ctx sdk.Context, plan upgradetypes.Plan, upgradedClientState exported.ClientState) error {
+	if err := k.ValidateUpgradedClientState(ctx, upgradedClientState); err != nil {
+		return err
+	}
+
+	bz, err := types.MarshalClientState(k.cdc, upgradedClientState)
 	if err != nil {
 		return errorsmod.Wrap(err, "could not marshal UpgradedClientState")
 	}
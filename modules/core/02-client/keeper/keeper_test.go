@@ -141,6 +141,131 @@ func (suite *KeeperTestSuite) TestSetClientConsensusState() {
 	suite.Require().Equal(suite.consensusState, tmConsState, "ConsensusState not stored correctly")
 }
 
+func (suite *KeeperTestSuite) TestSetClientAlias() {
+	var (
+		clientID string
+		alias    string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"success: replace existing alias", func() {
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientAlias(suite.chainA.GetContext(), clientID, "previous-alias")
+				suite.Require().NoError(err)
+			}, nil,
+		},
+		{
+			"success: remove alias", func() {
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientAlias(suite.chainA.GetContext(), clientID, "previous-alias")
+				suite.Require().NoError(err)
+
+				alias = ""
+			}, nil,
+		},
+		{
+			"success: alias already set for the same client", func() {
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientAlias(suite.chainA.GetContext(), clientID, alias)
+				suite.Require().NoError(err)
+			}, nil,
+		},
+		{
+			"failure: alias registered for another client", func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupClients()
+
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientAlias(suite.chainA.GetContext(), path.EndpointA.ClientID, alias)
+				suite.Require().NoError(err)
+			}, types.ErrInvalidClientAlias,
+		},
+		{
+			"failure: client not found", func() {
+				clientID = ibctesting.InvalidID
+			}, types.ErrClientNotFound,
+		},
+		{
+			"failure: invalid alias", func() {
+				alias = "07-tendermint-100"
+			}, types.ErrInvalidClientAlias,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			clientID = path.EndpointA.ClientID
+			alias = "cosmoshub"
+
+			tc.malleate()
+
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+			err := clientKeeper.SetClientAlias(suite.chainA.GetContext(), clientID, alias)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				storedAlias, found := clientKeeper.GetClientAlias(suite.chainA.GetContext(), clientID)
+				suite.Require().Equal(alias != "", found)
+				suite.Require().Equal(alias, storedAlias)
+
+				// the previous alias no longer resolves to the client
+				_, found = clientKeeper.GetClientIDByAlias(suite.chainA.GetContext(), "previous-alias")
+				suite.Require().False(found)
+
+				if alias != "" {
+					suite.Require().Equal(clientID, clientKeeper.ResolveClientID(suite.chainA.GetContext(), alias))
+				}
+				suite.Require().Equal(clientID, clientKeeper.ResolveClientID(suite.chainA.GetContext(), clientID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetAllClientAliases() {
+	pathA := ibctesting.NewPath(suite.chainA, suite.chainB)
+	pathA.SetupClients()
+
+	pathB := ibctesting.NewPath(suite.chainA, suite.chainB)
+	pathB.SetupClients()
+
+	clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+	suite.Require().Empty(clientKeeper.GetAllClientAliases(suite.chainA.GetContext()))
+
+	suite.Require().NoError(clientKeeper.SetClientAlias(suite.chainA.GetContext(), pathB.EndpointA.ClientID, "osmosis"))
+	suite.Require().NoError(clientKeeper.SetClientAlias(suite.chainA.GetContext(), pathA.EndpointA.ClientID, "cosmoshub"))
+
+	expAliases := []types.ClientAlias{
+		{ClientId: pathA.EndpointA.ClientID, Alias: "cosmoshub"},
+		{ClientId: pathB.EndpointA.ClientID, Alias: "osmosis"},
+	}
+	suite.Require().Equal(expAliases, clientKeeper.GetAllClientAliases(suite.chainA.GetContext()))
+
+	// set the aliases on a fresh store and ensure both lookup directions are restored
+	suite.SetupTest()
+	clientKeeper = suite.chainA.App.GetIBCKeeper().ClientKeeper
+	clientKeeper.SetAllClientAliases(suite.chainA.GetContext(), expAliases)
+
+	suite.Require().Equal(expAliases, clientKeeper.GetAllClientAliases(suite.chainA.GetContext()))
+	for _, alias := range expAliases {
+		suite.Require().Equal(alias.ClientId, clientKeeper.ResolveClientID(suite.chainA.GetContext(), alias.Alias))
+	}
+}
+
 func (suite *KeeperTestSuite) TestGetAllGenesisClients() {
 	clientIDs := []string{
 		exported.LocalhostClientID, testClientID2, testClientID3, testClientID,
@@ -396,6 +521,79 @@ func (suite *KeeperTestSuite) TestGetClientLatestHeight() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestClientFrozenHeight() {
+	var path *ibctesting.Path
+
+	cases := []struct {
+		name                    string
+		malleate                func()
+		expFrozenHeight         types.Height
+		expFrozenByMisbehaviour bool
+		expErr                  error
+	}{
+		{
+			"success: active client",
+			func() {},
+			types.ZeroHeight(),
+			false,
+			nil,
+		},
+		{
+			"success: client frozen by misbehaviour",
+			func() {
+				err := path.EndpointA.SubmitMisbehaviour()
+				suite.Require().NoError(err)
+			},
+			ibctm.FrozenHeight,
+			true,
+			nil,
+		},
+		{
+			"success: client frozen without misbehaviour height",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				clientState.FrozenHeight = ibctm.FrozenHeight
+				path.EndpointA.SetClientState(clientState)
+			},
+			ibctm.FrozenHeight,
+			false,
+			nil,
+		},
+		{
+			"failure: client not found",
+			func() {
+				path.EndpointA.ClientID = ibctesting.InvalidID
+			},
+			types.ZeroHeight(),
+			false,
+			types.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			tc.malleate()
+
+			frozenHeight, frozenByMisbehaviour, err := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientFrozenHeight(suite.chainA.GetContext(), path.EndpointA.ClientID)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+			suite.Require().Equal(tc.expFrozenHeight, frozenHeight)
+			suite.Require().Equal(tc.expFrozenByMisbehaviour, frozenByMisbehaviour)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestGetTimestampAtHeight() {
 	var (
 		height exported.Height
@@ -559,6 +757,41 @@ func (suite *KeeperTestSuite) TestIBCSoftwareUpgrade() {
 			},
 			sdkerrors.ErrInvalidRequest,
 		},
+		{
+			"fail: non-zeroed trusting period",
+			func() {
+				upgradedClientState.TrustingPeriod = ibctesting.TrustingPeriod
+			},
+			types.ErrInvalidUpgradeClient,
+		},
+		{
+			"fail: non-zeroed max clock drift",
+			func() {
+				upgradedClientState.MaxClockDrift = ibctesting.MaxClockDrift
+			},
+			types.ErrInvalidUpgradeClient,
+		},
+		{
+			"fail: unbonding period does not match staking parameters",
+			func() {
+				upgradedClientState.UnbondingPeriod += time.Hour
+			},
+			types.ErrInvalidUpgradeClient,
+		},
+		{
+			"fail: zeroed proof specs",
+			func() {
+				upgradedClientState.ProofSpecs = nil
+			},
+			types.ErrInvalidUpgradeClient,
+		},
+		{
+			"fail: empty upgrade path",
+			func() {
+				upgradedClientState.UpgradePath = nil
+			},
+			types.ErrInvalidUpgradeClient,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -20,7 +20,7 @@ func NewClientProposalHandler(k *keeper.Keeper) govtypes.Handler { //nolint:stat
 		switch c := content.(type) {
 		case *types.ClientUpdateProposal:
 			// NOTE: RecoverClient is called in favour of the deprecated ClientUpdateProposal function.
-			return k.RecoverClient(ctx, c.SubjectClientId, c.SubstituteClientId)
+			return k.RecoverClient(ctx, c.SubjectClientId, c.SubstituteClientId, nil)
 		default:
 			return errorsmod.Wrapf(ibcerrors.ErrUnknownRequest, "unrecognized ibc proposal content type: %T", c)
 		}
@@ -28,6 +28,12 @@ type ConsensusHost interface {
 	ValidateSelfClient(ctx sdk.Context, clientState exported.ClientState) error
 }
 
+// UpgradedSelfClientValidator defines an optional interface for a ConsensusHost to validate the upgraded client state of
+// a scheduled IBC software upgrade against the host chain's current consensus parameters.
+type UpgradedSelfClientValidator interface {
+	ValidateUpgradedSelfClient(ctx sdk.Context, upgradedClientState exported.ClientState) error
+}
+
 // NewIdentifiedClientState creates a new IdentifiedClientState instance
 func NewIdentifiedClientState(clientID string, clientState exported.ClientState) IdentifiedClientState {
 	msg, ok := clientState.(proto.Message)
@@ -118,3 +124,33 @@ func ValidateClientType(clientType string) error {
 
 	return nil
 }
+
+// NewClientDeposit creates a new ClientDeposit instance
+func NewClientDeposit(clientID, depositor string, amount sdk.Coins) ClientDeposit {
+	return ClientDeposit{
+		ClientId:  clientID,
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+// Validate performs basic validation of a ClientDeposit. The escrowed amount must be valid and non-zero.
+func (cd ClientDeposit) Validate() error {
+	if err := host.ClientIdentifierValidator(cd.ClientId); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(cd.Depositor); err != nil {
+		return errorsmod.Wrapf(ErrInvalidClientDeposit, "depositor could not be parsed as address: %v", err)
+	}
+
+	if err := cd.Amount.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidClientDeposit, "invalid deposit amount: %v", err)
+	}
+
+	if cd.Amount.IsZero() {
+		return errorsmod.Wrap(ErrInvalidClientDeposit, "deposit amount cannot be zero")
+	}
+
+	return nil
+}
@@ -4,21 +4,27 @@
 package types
 
 import (
-	types1 "cosmossdk.io/x/upgrade/types"
+	types2 "cosmossdk.io/x/upgrade/types"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -82,6 +88,61 @@ func (m *IdentifiedClientState) GetClientState() *types.Any {
 	return nil
 }
 
+// ClientAlias defines a human-readable alias of a client identifier.
+type ClientAlias struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// human-readable alias of the client
+	Alias string `protobuf:"bytes,2,opt,name=alias,proto3" json:"alias,omitempty"`
+}
+
+func (m *ClientAlias) Reset()         { *m = ClientAlias{} }
+func (m *ClientAlias) String() string { return proto.CompactTextString(m) }
+func (*ClientAlias) ProtoMessage()    {}
+func (*ClientAlias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{1}
+}
+func (m *ClientAlias) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientAlias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientAlias.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientAlias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientAlias.Merge(m, src)
+}
+func (m *ClientAlias) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientAlias) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientAlias.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientAlias proto.InternalMessageInfo
+
+func (m *ClientAlias) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ClientAlias) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
 // ConsensusStateWithHeight defines a consensus state with an additional height
 // field.
 type ConsensusStateWithHeight struct {
@@ -95,7 +156,7 @@ func (m *ConsensusStateWithHeight) Reset()         { *m = ConsensusStateWithHeig
 func (m *ConsensusStateWithHeight) String() string { return proto.CompactTextString(m) }
 func (*ConsensusStateWithHeight) ProtoMessage()    {}
 func (*ConsensusStateWithHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{1}
+	return fileDescriptor_b6bc4c8185546947, []int{2}
 }
 func (m *ConsensusStateWithHeight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -151,7 +212,7 @@ func (m *ClientConsensusStates) Reset()         { *m = ClientConsensusStates{} }
 func (m *ClientConsensusStates) String() string { return proto.CompactTextString(m) }
 func (*ClientConsensusStates) ProtoMessage()    {}
 func (*ClientConsensusStates) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{2}
+	return fileDescriptor_b6bc4c8185546947, []int{3}
 }
 func (m *ClientConsensusStates) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -214,7 +275,7 @@ type Height struct {
 func (m *Height) Reset()      { *m = Height{} }
 func (*Height) ProtoMessage() {}
 func (*Height) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{3}
+	return fileDescriptor_b6bc4c8185546947, []int{4}
 }
 func (m *Height) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -249,13 +310,22 @@ type Params struct {
 	// and interacted with. If a client type is removed from the allowed clients list, usage
 	// of this client will be disabled until it is added again to the list.
 	AllowedClients []string `protobuf:"bytes,1,rep,name=allowed_clients,json=allowedClients,proto3" json:"allowed_clients,omitempty"`
+	// client_creation_deposit defines the deposit escrowed from the signer of MsgCreateClient. No deposit is
+	// escrowed if empty.
+	ClientCreationDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=client_creation_deposit,json=clientCreationDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"client_creation_deposit"`
+	// client_deposit_grace_period defines the duration for which a client which is not active must not have been
+	// updated before its deposit can be reclaimed by the depositor.
+	ClientDepositGracePeriod time.Duration `protobuf:"bytes,3,opt,name=client_deposit_grace_period,json=clientDepositGracePeriod,proto3,stdduration" json:"client_deposit_grace_period"`
+	// client_deposit_forfeiture_period defines the duration, following the grace period, after which the deposit of
+	// a client which is not active is deemed abandoned and sent to the community pool.
+	ClientDepositForfeiturePeriod time.Duration `protobuf:"bytes,4,opt,name=client_deposit_forfeiture_period,json=clientDepositForfeiturePeriod,proto3,stdduration" json:"client_deposit_forfeiture_period"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{4}
+	return fileDescriptor_b6bc4c8185546947, []int{5}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,6 +361,91 @@ func (m *Params) GetAllowedClients() []string {
 	return nil
 }
 
+func (m *Params) GetClientCreationDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClientCreationDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetClientDepositGracePeriod() time.Duration {
+	if m != nil {
+		return m.ClientDepositGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetClientDepositForfeiturePeriod() time.Duration {
+	if m != nil {
+		return m.ClientDepositForfeiturePeriod
+	}
+	return 0
+}
+
+// ClientDeposit defines the deposit escrowed for the creation of a client.
+type ClientDeposit struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// address of the account which paid the deposit
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// escrowed deposit
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ClientDeposit) Reset()         { *m = ClientDeposit{} }
+func (m *ClientDeposit) String() string { return proto.CompactTextString(m) }
+func (*ClientDeposit) ProtoMessage()    {}
+func (*ClientDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b6bc4c8185546947, []int{6}
+}
+func (m *ClientDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClientDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClientDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClientDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClientDeposit.Merge(m, src)
+}
+func (m *ClientDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClientDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClientDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClientDeposit proto.InternalMessageInfo
+
+func (m *ClientDeposit) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ClientDeposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *ClientDeposit) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
 // ClientUpdateProposal is a legacy governance proposal. If it passes, the substitute
 // client's latest consensus state is copied over to the subject client. The proposal
 // handler may fail if the subject and the substitute do not match in client and
@@ -315,7 +470,7 @@ func (m *ClientUpdateProposal) Reset()         { *m = ClientUpdateProposal{} }
 func (m *ClientUpdateProposal) String() string { return proto.CompactTextString(m) }
 func (*ClientUpdateProposal) ProtoMessage()    {}
 func (*ClientUpdateProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{5}
+	return fileDescriptor_b6bc4c8185546947, []int{7}
 }
 func (m *ClientUpdateProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,7 +508,7 @@ var xxx_messageInfo_ClientUpdateProposal proto.InternalMessageInfo
 type UpgradeProposal struct {
 	Title       string      `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Description string      `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Plan        types1.Plan `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan"`
+	Plan        types2.Plan `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan"`
 	// An UpgradedClientState must be provided to perform an IBC breaking upgrade.
 	// This will make the chain commit to the correct upgraded (self) client state
 	// before the upgrade occurs, so that connecting chains can verify that the
@@ -366,7 +521,7 @@ type UpgradeProposal struct {
 func (m *UpgradeProposal) Reset()      { *m = UpgradeProposal{} }
 func (*UpgradeProposal) ProtoMessage() {}
 func (*UpgradeProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b6bc4c8185546947, []int{6}
+	return fileDescriptor_b6bc4c8185546947, []int{8}
 }
 func (m *UpgradeProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,10 +552,12 @@ var xxx_messageInfo_UpgradeProposal proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*IdentifiedClientState)(nil), "ibc.core.client.v1.IdentifiedClientState")
+	proto.RegisterType((*ClientAlias)(nil), "ibc.core.client.v1.ClientAlias")
 	proto.RegisterType((*ConsensusStateWithHeight)(nil), "ibc.core.client.v1.ConsensusStateWithHeight")
 	proto.RegisterType((*ClientConsensusStates)(nil), "ibc.core.client.v1.ClientConsensusStates")
 	proto.RegisterType((*Height)(nil), "ibc.core.client.v1.Height")
 	proto.RegisterType((*Params)(nil), "ibc.core.client.v1.Params")
+	proto.RegisterType((*ClientDeposit)(nil), "ibc.core.client.v1.ClientDeposit")
 	proto.RegisterType((*ClientUpdateProposal)(nil), "ibc.core.client.v1.ClientUpdateProposal")
 	proto.RegisterType((*UpgradeProposal)(nil), "ibc.core.client.v1.UpgradeProposal")
 }
@@ -408,50 +565,63 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/client.proto", fileDescriptor_b6bc4c8185546947) }
 
 var fileDescriptor_b6bc4c8185546947 = []byte{
-	// 677 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xce, 0xa6, 0x31, 0x34, 0x13, 0x69, 0x74, 0x4d, 0x61, 0x4d, 0x4b, 0x36, 0x2c, 0x05, 0x73,
-	0x68, 0x77, 0x4d, 0x04, 0x2d, 0x01, 0x0f, 0xa6, 0x97, 0xf6, 0x22, 0x75, 0xa5, 0x08, 0x82, 0x84,
-	0xfd, 0x98, 0x6e, 0xa6, 0xec, 0xce, 0x2c, 0x3b, 0xb3, 0x91, 0xfc, 0x03, 0x8f, 0x8a, 0x17, 0xc1,
-	0x4b, 0x7f, 0x84, 0x3f, 0xa2, 0x78, 0xea, 0xd1, 0x53, 0x90, 0xf6, 0xe2, 0xb9, 0xbf, 0x40, 0x76,
-	0x3e, 0x6c, 0x63, 0x5a, 0x15, 0xbc, 0xcd, 0xfb, 0xcc, 0x33, 0xef, 0xfb, 0xcc, 0x33, 0xf3, 0xbe,
-	0xc0, 0x44, 0x7e, 0xe0, 0x04, 0x24, 0x83, 0x4e, 0x10, 0x23, 0x88, 0x99, 0x33, 0xe9, 0xc9, 0x95,
-	0x9d, 0x66, 0x84, 0x11, 0x5d, 0x47, 0x7e, 0x60, 0x17, 0x04, 0x5b, 0xc2, 0x93, 0x5e, 0x6b, 0x23,
-	0x20, 0x34, 0x21, 0xd4, 0xc9, 0xd3, 0x28, 0xf3, 0x42, 0xe8, 0x4c, 0x7a, 0x3e, 0x64, 0x5e, 0x4f,
-	0xc5, 0xe2, 0x64, 0xeb, 0xbe, 0x60, 0x8d, 0x78, 0xe4, 0x88, 0x40, 0x6e, 0x35, 0x23, 0x12, 0x11,
-	0x81, 0x17, 0x2b, 0x75, 0x20, 0x22, 0x24, 0x8a, 0xa1, 0xc3, 0x23, 0x3f, 0x3f, 0x74, 0x3c, 0x3c,
-	0x15, 0x5b, 0x56, 0x02, 0x56, 0xf7, 0x42, 0x88, 0x19, 0x3a, 0x44, 0x30, 0xdc, 0xe1, 0x42, 0x5e,
-	0x32, 0x8f, 0x41, 0x7d, 0x0d, 0xd4, 0x84, 0xae, 0x11, 0x0a, 0x0d, 0xad, 0xa3, 0x75, 0x6b, 0xee,
-	0xb2, 0x00, 0xf6, 0x42, 0xfd, 0x09, 0xb8, 0x2d, 0x37, 0x69, 0x41, 0x36, 0xca, 0x1d, 0xad, 0x5b,
-	0xef, 0x37, 0x6d, 0x51, 0xc7, 0x56, 0x75, 0xec, 0x67, 0x78, 0xea, 0xd6, 0x83, 0xcb, 0xac, 0xd6,
-	0x47, 0x0d, 0x18, 0x3b, 0x04, 0x53, 0x88, 0x69, 0x4e, 0x39, 0xf4, 0x0a, 0xb1, 0xf1, 0x2e, 0x44,
-	0xd1, 0x98, 0xe9, 0xdb, 0xa0, 0x3a, 0xe6, 0x2b, 0x5e, 0xaf, 0xde, 0x6f, 0xd9, 0x8b, 0x16, 0xd9,
-	0x82, 0x3b, 0xac, 0x9c, 0xcc, 0xcc, 0x92, 0x2b, 0xf9, 0xfa, 0x53, 0xd0, 0x08, 0x54, 0xd6, 0x7f,
-	0x90, 0xb4, 0x12, 0xcc, 0x49, 0x28, 0x54, 0xad, 0x8a, 0xbb, 0xcf, 0x6b, 0xa3, 0x7f, 0x76, 0xe1,
-	0x0d, 0xb8, 0xf3, 0x5b, 0x55, 0x6a, 0x94, 0x3b, 0x4b, 0xdd, 0x7a, 0x7f, 0xf3, 0x3a, 0xe5, 0x37,
-	0xdd, 0x5b, 0xde, 0xa5, 0x31, 0x2f, 0x8a, 0x5a, 0x21, 0xa8, 0x4a, 0x63, 0x1e, 0x80, 0x46, 0x06,
-	0x27, 0x88, 0x22, 0x82, 0x47, 0x38, 0x4f, 0x7c, 0x98, 0x71, 0x2d, 0x15, 0x77, 0x45, 0xc1, 0xcf,
-	0x39, 0x3a, 0x47, 0x94, 0x56, 0x96, 0xe7, 0x89, 0x22, 0xe3, 0x60, 0xf9, 0xdd, 0xb1, 0x59, 0xfa,
-	0x74, 0x6c, 0x96, 0xac, 0x1e, 0xa8, 0xee, 0x7b, 0x99, 0x97, 0xd0, 0xe2, 0xb0, 0x17, 0xc7, 0xe4,
-	0x2d, 0x0c, 0x47, 0x42, 0x34, 0x35, 0xb4, 0xce, 0x52, 0xb7, 0xe6, 0xae, 0x48, 0x58, 0x58, 0x44,
-	0xad, 0x0f, 0x65, 0xd0, 0x14, 0xeb, 0x83, 0x34, 0xf4, 0x18, 0xdc, 0xcf, 0x48, 0x4a, 0xa8, 0x17,
-	0xeb, 0x4d, 0x70, 0x8b, 0x21, 0x16, 0x43, 0xe9, 0x94, 0x08, 0xf4, 0x0e, 0xa8, 0x87, 0x90, 0x06,
-	0x19, 0x4a, 0x19, 0x22, 0x98, 0x0b, 0xaa, 0xb9, 0x57, 0x21, 0x7d, 0x17, 0xdc, 0xa5, 0xb9, 0x7f,
-	0x04, 0x03, 0x36, 0xba, 0x74, 0x7b, 0xa9, 0xe0, 0x0d, 0xd7, 0x2f, 0x66, 0xa6, 0x31, 0xf5, 0x92,
-	0x78, 0x60, 0x2d, 0x50, 0x2c, 0xb7, 0x21, 0xb1, 0x1d, 0xf5, 0x24, 0x2f, 0x40, 0x93, 0xe6, 0x3e,
-	0x65, 0x88, 0xe5, 0x0c, 0x5e, 0x49, 0x56, 0xe1, 0xc9, 0xcc, 0x8b, 0x99, 0xb9, 0xf6, 0x2b, 0xd9,
-	0x02, 0xcb, 0x72, 0xf5, 0x4b, 0x58, 0xa5, 0x1c, 0x6c, 0x14, 0x56, 0x7d, 0xfd, 0xb2, 0xd5, 0x92,
-	0x8d, 0x16, 0x91, 0x89, 0x2d, 0xfb, 0xb2, 0x78, 0x52, 0x06, 0x31, 0x33, 0x34, 0xeb, 0x73, 0x19,
-	0x34, 0x0e, 0x44, 0x97, 0xfe, 0xb7, 0x1d, 0x8f, 0x41, 0x25, 0x8d, 0x3d, 0xcc, 0x1d, 0xa8, 0xf7,
-	0xd7, 0x6d, 0x59, 0x58, 0x0d, 0x01, 0x55, 0x7c, 0x3f, 0xf6, 0xb0, 0xfc, 0x3b, 0x9c, 0xaf, 0x1f,
-	0x81, 0x55, 0xc9, 0x51, 0x2f, 0x28, 0x7b, 0xa1, 0x72, 0x73, 0x2f, 0x0c, 0x3b, 0x17, 0x33, 0x73,
-	0x5d, 0x78, 0x72, 0xed, 0x61, 0xcb, 0xbd, 0xa7, 0xf0, 0x2b, 0xe3, 0x61, 0xb0, 0xa9, 0x3e, 0xd0,
-	0x8f, 0x63, 0x53, 0xfb, 0x9b, 0x3b, 0x43, 0xf7, 0xe4, 0xac, 0xad, 0x9d, 0x9e, 0xb5, 0xb5, 0xef,
-	0x67, 0x6d, 0xed, 0xfd, 0x79, 0xbb, 0x74, 0x7a, 0xde, 0x2e, 0x7d, 0x3b, 0x6f, 0x97, 0x5e, 0x6f,
-	0x47, 0x88, 0x8d, 0x73, 0xdf, 0x0e, 0x48, 0x22, 0x27, 0x99, 0x83, 0xfc, 0x60, 0x2b, 0x22, 0xce,
-	0x64, 0xdb, 0x49, 0x48, 0x98, 0xc7, 0x90, 0x8a, 0x31, 0xfa, 0xb0, 0xbf, 0x25, 0x27, 0x29, 0x9b,
-	0xa6, 0x90, 0xfa, 0x55, 0x7e, 0x8d, 0x47, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x80, 0x51,
-	0x2a, 0x69, 0x05, 0x00, 0x00,
+	// 886 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x9b, 0x10, 0x35, 0x13, 0x68, 0xc0, 0xa4, 0xc2, 0xfd, 0x41, 0x1c, 0x59, 0x2b, 0x91,
+	0xc3, 0xd6, 0xde, 0x16, 0x09, 0xaa, 0x4a, 0x48, 0x6c, 0xb2, 0x82, 0xdd, 0x0b, 0x2a, 0x46, 0x2b,
+	0x24, 0x24, 0x64, 0x8d, 0xc7, 0x53, 0x77, 0x16, 0xdb, 0x63, 0x79, 0xc6, 0x41, 0x3d, 0x73, 0x81,
+	0x1b, 0x88, 0xcb, 0x4a, 0x5c, 0x7a, 0xe6, 0xc2, 0x85, 0x3f, 0x62, 0xc5, 0x69, 0x8f, 0x9c, 0xba,
+	0xa8, 0xbd, 0x70, 0xee, 0x5f, 0x80, 0x3c, 0xf3, 0xdc, 0x24, 0xcd, 0xfe, 0xa8, 0xc4, 0x9e, 0x92,
+	0x79, 0xf3, 0xcd, 0xf7, 0x7d, 0xef, 0xf9, 0xbd, 0x19, 0x64, 0xb3, 0x90, 0x78, 0x84, 0x17, 0xd4,
+	0x23, 0x09, 0xa3, 0x99, 0xf4, 0xa6, 0xbb, 0xf0, 0xcf, 0xcd, 0x0b, 0x2e, 0xb9, 0x69, 0xb2, 0x90,
+	0xb8, 0x15, 0xc0, 0x85, 0xf0, 0x74, 0x77, 0x73, 0x40, 0xb8, 0x48, 0xb9, 0xf0, 0x42, 0x2c, 0xa8,
+	0x37, 0xdd, 0x0d, 0xa9, 0xc4, 0xbb, 0x1e, 0xe1, 0x2c, 0xd3, 0x67, 0x36, 0x6f, 0xc1, 0x7e, 0x99,
+	0xc7, 0x05, 0x8e, 0x66, 0x10, 0x58, 0x03, 0x6a, 0x43, 0xa3, 0x02, 0xb5, 0xf2, 0xf4, 0x02, 0xb6,
+	0xfa, 0x31, 0x8f, 0xb9, 0x8e, 0x57, 0xff, 0xea, 0x03, 0x31, 0xe7, 0x71, 0x42, 0x3d, 0xb5, 0x0a,
+	0xcb, 0x23, 0x0f, 0x67, 0x27, 0xb0, 0x35, 0xb8, 0xbe, 0x15, 0x95, 0x05, 0x96, 0x8c, 0x83, 0x23,
+	0x27, 0x45, 0xeb, 0x0f, 0x22, 0x9a, 0x49, 0x76, 0xc4, 0x68, 0x34, 0x51, 0x89, 0x7c, 0x25, 0xb1,
+	0xa4, 0xe6, 0x16, 0xea, 0xe8, 0xbc, 0x02, 0x16, 0x59, 0xc6, 0xd0, 0x18, 0x75, 0xfc, 0x55, 0x1d,
+	0x78, 0x10, 0x99, 0x1f, 0xa3, 0x37, 0x61, 0x53, 0x54, 0x60, 0x6b, 0x65, 0x68, 0x8c, 0xba, 0x7b,
+	0x7d, 0x57, 0x8b, 0xb9, 0xb5, 0x98, 0x7b, 0x37, 0x3b, 0xf1, 0xbb, 0x64, 0xc6, 0xea, 0x7c, 0x8a,
+	0xba, 0x5a, 0xe4, 0x6e, 0xc2, 0xb0, 0x78, 0xb9, 0x48, 0x1f, 0xbd, 0x81, 0x2b, 0x94, 0x62, 0xef,
+	0xf8, 0x7a, 0xe1, 0xfc, 0x6a, 0x20, 0x6b, 0xc2, 0x33, 0x41, 0x33, 0x51, 0x0a, 0x45, 0xfa, 0x35,
+	0x93, 0xc7, 0xf7, 0x29, 0x8b, 0x8f, 0xa5, 0xb9, 0x8f, 0xda, 0xc7, 0xea, 0x9f, 0x22, 0xeb, 0xee,
+	0x6d, 0xba, 0xcb, 0x1f, 0xc9, 0xd5, 0xd8, 0x71, 0xeb, 0xc9, 0x99, 0xdd, 0xf0, 0x01, 0x6f, 0x7e,
+	0x82, 0x7a, 0xa4, 0x66, 0xbd, 0x41, 0x52, 0x6b, 0x64, 0xc1, 0x42, 0xe5, 0x6a, 0x5d, 0x27, 0xb6,
+	0xe8, 0xed, 0x15, 0x29, 0x7e, 0x8b, 0xde, 0xbe, 0xa6, 0x5a, 0x65, 0xdb, 0x1c, 0x75, 0xf7, 0x6e,
+	0x3f, 0xcf, 0xf9, 0x8b, 0xf2, 0x86, 0x5c, 0x7a, 0x8b, 0xa6, 0x84, 0x13, 0xa1, 0x36, 0x14, 0xe6,
+	0x03, 0xd4, 0x2b, 0xe8, 0x94, 0x09, 0xc6, 0xb3, 0x20, 0x2b, 0xd3, 0x90, 0x16, 0xca, 0x4b, 0xcb,
+	0x5f, 0xab, 0xc3, 0x5f, 0xa8, 0xe8, 0x02, 0x10, 0x4a, 0xb9, 0xb2, 0x08, 0xd4, 0x8c, 0x07, 0xab,
+	0x3f, 0x9e, 0xda, 0x8d, 0xc7, 0xa7, 0x76, 0xc3, 0xf9, 0xa9, 0x89, 0xda, 0x87, 0xb8, 0xc0, 0xa9,
+	0xa8, 0x4e, 0xe3, 0x24, 0xe1, 0xdf, 0xd3, 0x28, 0xd0, 0xae, 0x85, 0x65, 0x0c, 0x9b, 0xa3, 0x8e,
+	0xbf, 0x06, 0x61, 0x5d, 0x23, 0x61, 0xfe, 0x60, 0xa0, 0xf7, 0xa0, 0x2c, 0xa4, 0xa0, 0xaa, 0x21,
+	0x83, 0x88, 0xe6, 0x5c, 0x30, 0x09, 0x05, 0xd8, 0x70, 0xa1, 0xf1, 0xab, 0x59, 0x72, 0x61, 0x50,
+	0xdc, 0x09, 0x67, 0xd9, 0xf8, 0x4e, 0x95, 0xed, 0xef, 0xcf, 0xec, 0x51, 0xcc, 0xe4, 0x71, 0x19,
+	0xba, 0x84, 0xa7, 0x30, 0x25, 0xf0, 0xb3, 0x23, 0xa2, 0xef, 0x3c, 0x79, 0x92, 0x53, 0xa1, 0x0e,
+	0x08, 0x7f, 0x5d, 0x6b, 0x4d, 0x40, 0xea, 0x9e, 0x56, 0x32, 0x43, 0xb4, 0x05, 0x26, 0x40, 0x3b,
+	0x88, 0x0b, 0x4c, 0x68, 0x90, 0xd3, 0x82, 0xf1, 0xc8, 0x6a, 0xaa, 0x06, 0xd8, 0x58, 0x6a, 0x80,
+	0x7b, 0x30, 0x42, 0xe3, 0xd5, 0xca, 0xc8, 0xe3, 0x67, 0xb6, 0xe1, 0x5b, 0x9a, 0x07, 0x88, 0x3f,
+	0xaf, 0x58, 0x0e, 0x15, 0x89, 0x99, 0xa0, 0xe1, 0x35, 0x8d, 0x23, 0x5e, 0x1c, 0x51, 0x26, 0xcb,
+	0xe2, 0x4a, 0xa8, 0x75, 0x73, 0xa1, 0xf7, 0x17, 0x84, 0x3e, 0xbb, 0xa2, 0xd2, 0x6a, 0xce, 0x1f,
+	0x06, 0x7a, 0x6b, 0x32, 0x8f, 0x78, 0x79, 0xff, 0x6d, 0xa3, 0x0e, 0xb8, 0xe2, 0x05, 0x8c, 0xd9,
+	0x2c, 0x60, 0x12, 0xd4, 0xc6, 0x29, 0x2f, 0x33, 0x69, 0x35, 0x5f, 0xff, 0x27, 0x01, 0x6a, 0xe7,
+	0x97, 0x15, 0xd4, 0xd7, 0x8e, 0x1f, 0xe6, 0x11, 0x96, 0xf4, 0xb0, 0xe0, 0x39, 0x17, 0x38, 0xa9,
+	0xc6, 0x5f, 0x32, 0x99, 0x50, 0x30, 0xad, 0x17, 0xe6, 0x10, 0x75, 0x23, 0x2a, 0x48, 0xc1, 0xf2,
+	0xaa, 0x30, 0xe0, 0x79, 0x3e, 0x64, 0xde, 0x47, 0xef, 0x88, 0x32, 0x7c, 0x44, 0x89, 0x0c, 0x66,
+	0x89, 0x57, 0x9f, 0xb2, 0x33, 0xde, 0xbe, 0x3c, 0xb3, 0xad, 0x13, 0x9c, 0x26, 0x07, 0xce, 0x12,
+	0xc4, 0xf1, 0x7b, 0x10, 0x9b, 0xd4, 0xd5, 0xf9, 0x12, 0xf5, 0x45, 0x19, 0x0a, 0xc9, 0x64, 0x29,
+	0xe9, 0x1c, 0x59, 0x4b, 0x91, 0xd9, 0x97, 0x67, 0xf6, 0xd6, 0x15, 0xd9, 0x12, 0xca, 0xf1, 0xcd,
+	0x59, 0xb8, 0xa6, 0x3c, 0xb8, 0x55, 0x4d, 0xcd, 0x5f, 0x7f, 0xee, 0x6c, 0x42, 0x25, 0x63, 0x3e,
+	0x9d, 0x2b, 0x64, 0x26, 0x69, 0x26, 0x2d, 0xc3, 0xf9, 0x6d, 0x05, 0xf5, 0x1e, 0xea, 0x27, 0xe1,
+	0x7f, 0x97, 0xe3, 0x23, 0xd4, 0xca, 0x13, 0x9c, 0x41, 0x33, 0x6f, 0xd7, 0x9f, 0xb0, 0x7e, 0x71,
+	0x6a, 0xf1, 0xc3, 0x04, 0x67, 0x70, 0x8d, 0x28, 0xbc, 0xf9, 0x08, 0xad, 0x03, 0xa6, 0x9e, 0x65,
+	0xb8, 0x16, 0x5b, 0x2f, 0xbe, 0x16, 0xc7, 0xc3, 0xcb, 0x33, 0x7b, 0x5b, 0xd7, 0xe4, 0xb9, 0x87,
+	0x1d, 0xff, 0xdd, 0x3a, 0x3e, 0xf7, 0xd6, 0x1c, 0xdc, 0xae, 0xef, 0x92, 0x7f, 0x4f, 0x6d, 0xe3,
+	0x55, 0xd5, 0x19, 0xfb, 0x4f, 0xce, 0x07, 0xc6, 0xd3, 0xf3, 0x81, 0xf1, 0xcf, 0xf9, 0xc0, 0xf8,
+	0xf9, 0x62, 0xd0, 0x78, 0x7a, 0x31, 0x68, 0xfc, 0x7d, 0x31, 0x68, 0x7c, 0xb3, 0xbf, 0xdc, 0x7d,
+	0x2c, 0x24, 0x3b, 0x31, 0xf7, 0xa6, 0xfb, 0x5e, 0xca, 0xa3, 0x32, 0xa1, 0x42, 0xbf, 0xe9, 0x77,
+	0xf6, 0x76, 0xe0, 0x59, 0x57, 0x3d, 0x19, 0xb6, 0x55, 0x1a, 0x1f, 0xfe, 0x37, 0x00, 0xba, 0x40,
+	0xa1, 0xec, 0xf6, 0x07, 0x00, 0x00,
 }
 
 func (this *UpgradeProposal) Equal(that interface{}) bool {
@@ -529,6 +699,43 @@ func (m *IdentifiedClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClientAlias) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientAlias) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientAlias) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Alias) > 0 {
+		i -= len(m.Alias)
+		copy(dAtA[i:], m.Alias)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Alias)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConsensusStateWithHeight) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -671,6 +878,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ClientDepositForfeiturePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClientDepositForfeiturePeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintClient(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ClientDepositGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClientDepositGracePeriod):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintClient(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ClientCreationDeposit) > 0 {
+		for iNdEx := len(m.ClientCreationDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientCreationDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.AllowedClients) > 0 {
 		for iNdEx := len(m.AllowedClients) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedClients[iNdEx])
@@ -683,6 +920,57 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClientDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClientDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClientDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintClient(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintClient(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ClientUpdateProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -821,6 +1109,23 @@ func (m *IdentifiedClientState) Size() (n int) {
 	return n
 }
 
+func (m *ClientAlias) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.Alias)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	return n
+}
+
 func (m *ConsensusStateWithHeight) Size() (n int) {
 	if m == nil {
 		return 0
@@ -882,6 +1187,39 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovClient(uint64(l))
 		}
 	}
+	if len(m.ClientCreationDeposit) > 0 {
+		for _, e := range m.ClientCreationDeposit {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClientDepositGracePeriod)
+	n += 1 + l + sovClient(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClientDepositForfeiturePeriod)
+	n += 1 + l + sovClient(uint64(l))
+	return n
+}
+
+func (m *ClientDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovClient(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovClient(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1057,7 +1395,7 @@ func (m *IdentifiedClientState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
+func (m *ClientAlias) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1080,17 +1418,17 @@ func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsensusStateWithHeight: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClientAlias: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsensusStateWithHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClientAlias: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -1100,30 +1438,29 @@ func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthClient
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClient
@@ -1133,25 +1470,140 @@ func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthClient
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthClient
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsensusState == nil {
-				m.ConsensusState = &types.Any{}
-			}
-			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Alias = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusStateWithHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusStateWithHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusStateWithHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsensusState == nil {
+				m.ConsensusState = &types.Any{}
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1441,6 +1893,254 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedClients = append(m.AllowedClients, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCreationDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientCreationDeposit = append(m.ClientCreationDeposit, types1.Coin{})
+			if err := m.ClientCreationDeposit[len(m.ClientCreationDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientDepositGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ClientDepositGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientDepositForfeiturePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ClientDepositForfeiturePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClient(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClient
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClientDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClient
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClient
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClient
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClient
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClient(dAtA[iNdEx:])
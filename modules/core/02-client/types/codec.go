@@ -41,11 +41,16 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCreateClient{},
 		&MsgUpdateClient{},
+		&MsgUpdateClientBatch{},
 		&MsgUpgradeClient{},
 		&MsgSubmitMisbehaviour{},
 		&MsgRecoverClient{},
+		&MsgRecoverClients{},
 		&MsgIBCSoftwareUpgrade{},
 		&MsgUpdateParams{},
+		&MsgSetClientAlias{},
+		&MsgReclaimClientDeposit{},
+		&MsgFreezeClient{},
 	)
 	registry.RegisterImplementations(
 		(*govtypesv1beta1.Content)(nil),
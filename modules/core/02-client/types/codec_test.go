@@ -181,6 +181,11 @@ func (suite *TypesTestSuite) TestCodecTypeRegistration() {
 			sdk.MsgTypeURL(&types.MsgUpdateClient{}),
 			true,
 		},
+		{
+			"success: MsgUpdateClientBatch",
+			sdk.MsgTypeURL(&types.MsgUpdateClientBatch{}),
+			true,
+		},
 		{
 			"success: MsgUpgradeClient",
 			sdk.MsgTypeURL(&types.MsgUpgradeClient{}),
@@ -196,6 +201,11 @@ func (suite *TypesTestSuite) TestCodecTypeRegistration() {
 			sdk.MsgTypeURL(&types.MsgRecoverClient{}),
 			true,
 		},
+		{
+			"success: MsgRecoverClients",
+			sdk.MsgTypeURL(&types.MsgRecoverClients{}),
+			true,
+		},
 		{
 			"success: MsgIBCSoftwareUpgrade",
 			sdk.MsgTypeURL(&types.MsgIBCSoftwareUpgrade{}),
@@ -38,4 +38,9 @@ var (
 	ErrFailedNonMembershipVerification        = errorsmod.Register(SubModuleName, 31, "non-membership verification failed")
 	ErrRouteNotFound                          = errorsmod.Register(SubModuleName, 32, "light client module route not found")
 	ErrClientTypeNotSupported                 = errorsmod.Register(SubModuleName, 33, "client type not supported")
+	ErrInvalidClientAlias                     = errorsmod.Register(SubModuleName, 34, "invalid client alias")
+	ErrClientAliasNotFound                    = errorsmod.Register(SubModuleName, 35, "client alias not found")
+	ErrInvalidClientDeposit                   = errorsmod.Register(SubModuleName, 36, "invalid client deposit")
+	ErrClientDepositNotFound                  = errorsmod.Register(SubModuleName, 37, "client deposit not found")
+	ErrClientDepositNotReclaimable            = errorsmod.Register(SubModuleName, 38, "client deposit not reclaimable")
 )
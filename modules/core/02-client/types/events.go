@@ -16,8 +16,36 @@ const (
 	AttributeKeyUpgradeStore      = "upgrade_store"
 	AttributeKeyUpgradePlanHeight = "upgrade_plan_height"
 	AttributeKeyUpgradePlanTitle  = "title"
+	AttributeKeyPrunedHeights     = "pruned_heights"
+	AttributeKeyPrunedCount       = "pruned_count"
+
+	AttributeKeyUpdateResult        = "update_result"
+	AttributeKeyPreviousHeight      = "previous_height"
+	AttributeKeyNewConsensusHeights = "new_consensus_heights"
+	AttributeKeyFrozenHeight        = "frozen_height"
+
+	AttributeKeyAlias         = "alias"
+	AttributeKeyPreviousAlias = "previous_alias"
+
+	AttributeKeyDepositor = "depositor"
+	AttributeKeyAmount    = "amount"
 )
 
+// Values of the update result attribute of update client and client misbehaviour events.
+const (
+	// UpdateResultNoop indicates the client message did not add any new consensus state, e.g. a duplicate header.
+	UpdateResultNoop = "noop"
+	// UpdateResultUpdate indicates the client was updated with at least one new consensus state.
+	UpdateResultUpdate = "update"
+	// UpdateResultMisbehaviour indicates the client was frozen due to misbehaviour.
+	UpdateResultMisbehaviour = "misbehaviour"
+)
+
+// MaxPrunedHeightsAttributeLength is the maximum number of heights listed in the pruned heights
+// attribute of a prune consensus states event. The pruned count attribute always contains the
+// total number of pruned consensus states.
+const MaxPrunedHeightsAttributeLength = 100
+
 // IBC client events vars
 var (
 	EventTypeCreateClient               = "create_client"
@@ -27,6 +55,12 @@ var (
 	EventTypeRecoverClient              = "recover_client"
 	EventTypeScheduleIBCSoftwareUpgrade = "schedule_ibc_software_upgrade"
 	EventTypeUpgradeChain               = "upgrade_chain"
+	EventTypePruneConsensusStates       = "prune_consensus_states"
+	EventTypeSetClientAlias             = "set_client_alias"
+	EventTypeEscrowClientDeposit        = "escrow_client_deposit"
+	EventTypeReclaimClientDeposit       = "reclaim_client_deposit"
+	EventTypeForfeitClientDeposit       = "forfeit_client_deposit"
+	EventTypeFreezeClient               = "freeze_client"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
@@ -0,0 +1,670 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/core/client/v1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventUpdateClientResult is the typed event emitted when a client message is processed
+// by UpdateClient. It reports whether the update was a no-op, updated the client or
+// froze the client due to misbehaviour.
+type EventUpdateClientResult struct {
+	// client identifier
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// client type
+	ClientType string `protobuf:"bytes,2,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`
+	// outcome of the update, one of noop, update or misbehaviour
+	UpdateResult string `protobuf:"bytes,3,opt,name=update_result,json=updateResult,proto3" json:"update_result,omitempty"`
+	// latest height of the client prior to the update
+	PreviousHeight Height `protobuf:"bytes,4,opt,name=previous_height,json=previousHeight,proto3" json:"previous_height"`
+	// consensus heights returned by the light client module, empty on misbehaviour
+	ConsensusHeights []Height `protobuf:"bytes,5,rep,name=consensus_heights,json=consensusHeights,proto3" json:"consensus_heights"`
+	// consensus heights which were not stored prior to the update
+	NewConsensusHeights []Height `protobuf:"bytes,6,rep,name=new_consensus_heights,json=newConsensusHeights,proto3" json:"new_consensus_heights"`
+	// frozen height of the client, only set on misbehaviour
+	FrozenHeight Height `protobuf:"bytes,7,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height"`
+}
+
+func (m *EventUpdateClientResult) Reset()         { *m = EventUpdateClientResult{} }
+func (m *EventUpdateClientResult) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateClientResult) ProtoMessage()    {}
+func (*EventUpdateClientResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3279dcdded75b691, []int{0}
+}
+func (m *EventUpdateClientResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateClientResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateClientResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateClientResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateClientResult.Merge(m, src)
+}
+func (m *EventUpdateClientResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateClientResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateClientResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateClientResult proto.InternalMessageInfo
+
+func (m *EventUpdateClientResult) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *EventUpdateClientResult) GetClientType() string {
+	if m != nil {
+		return m.ClientType
+	}
+	return ""
+}
+
+func (m *EventUpdateClientResult) GetUpdateResult() string {
+	if m != nil {
+		return m.UpdateResult
+	}
+	return ""
+}
+
+func (m *EventUpdateClientResult) GetPreviousHeight() Height {
+	if m != nil {
+		return m.PreviousHeight
+	}
+	return Height{}
+}
+
+func (m *EventUpdateClientResult) GetConsensusHeights() []Height {
+	if m != nil {
+		return m.ConsensusHeights
+	}
+	return nil
+}
+
+func (m *EventUpdateClientResult) GetNewConsensusHeights() []Height {
+	if m != nil {
+		return m.NewConsensusHeights
+	}
+	return nil
+}
+
+func (m *EventUpdateClientResult) GetFrozenHeight() Height {
+	if m != nil {
+		return m.FrozenHeight
+	}
+	return Height{}
+}
+
+func init() {
+	proto.RegisterType((*EventUpdateClientResult)(nil), "ibc.core.client.v1.EventUpdateClientResult")
+}
+
+func init() { proto.RegisterFile("ibc/core/client/v1/events.proto", fileDescriptor_3279dcdded75b691) }
+
+var fileDescriptor_3279dcdded75b691 = []byte{
+	// 357 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcd, 0x4e, 0xf2, 0x40,
+	0x14, 0x86, 0xdb, 0x0f, 0x3e, 0x94, 0x01, 0xfc, 0xa9, 0x1a, 0x1b, 0x4c, 0x0a, 0xd1, 0x0d, 0x1b,
+	0x3a, 0x82, 0x1b, 0xd6, 0x12, 0x12, 0x59, 0xb8, 0x69, 0x70, 0xe3, 0xa6, 0xb1, 0xd3, 0x63, 0x99,
+	0x04, 0x3a, 0x4d, 0x67, 0x5a, 0x82, 0x57, 0xe1, 0xbd, 0x78, 0x13, 0x2c, 0x59, 0xba, 0x32, 0x06,
+	0x6e, 0xc4, 0x74, 0xa6, 0xb0, 0x10, 0x17, 0xec, 0x9a, 0xf7, 0x3c, 0xef, 0xd3, 0x9c, 0xcc, 0x41,
+	0x0d, 0xea, 0x11, 0x4c, 0x58, 0x0c, 0x98, 0x4c, 0x28, 0x84, 0x02, 0xa7, 0x1d, 0x0c, 0x29, 0x84,
+	0x82, 0xdb, 0x51, 0xcc, 0x04, 0x33, 0x0c, 0xea, 0x11, 0x3b, 0x03, 0x6c, 0x05, 0xd8, 0x69, 0xa7,
+	0x7e, 0x1e, 0xb0, 0x80, 0xc9, 0x31, 0xce, 0xbe, 0x14, 0x59, 0xff, 0x4b, 0x95, 0x77, 0x24, 0x70,
+	0xfd, 0x51, 0x40, 0x97, 0x83, 0xcc, 0xfd, 0x14, 0xf9, 0x2f, 0x02, 0xfa, 0x72, 0xe6, 0x00, 0x4f,
+	0x26, 0xc2, 0xb8, 0x42, 0x65, 0xc5, 0xba, 0xd4, 0x37, 0xf5, 0xa6, 0xde, 0x2a, 0x3b, 0x87, 0x2a,
+	0x18, 0xfa, 0x46, 0x03, 0x55, 0xf2, 0xa1, 0x98, 0x47, 0x60, 0xfe, 0x93, 0x63, 0xa4, 0xa2, 0xd1,
+	0x3c, 0x02, 0xe3, 0x06, 0xd5, 0x12, 0xe9, 0x74, 0x63, 0xa9, 0x33, 0x0b, 0x12, 0xa9, 0xaa, 0x30,
+	0xff, 0xc5, 0x10, 0x1d, 0x47, 0x31, 0xa4, 0x94, 0x25, 0xdc, 0x1d, 0x03, 0x0d, 0xc6, 0xc2, 0x2c,
+	0x36, 0xf5, 0x56, 0xa5, 0x5b, 0xb7, 0x77, 0x77, 0xb4, 0x1f, 0x24, 0x71, 0x5f, 0x5c, 0x7c, 0x35,
+	0x34, 0xe7, 0x68, 0x53, 0x54, 0xa9, 0xf1, 0x88, 0x4e, 0x09, 0x0b, 0x39, 0x84, 0x7c, 0xeb, 0xe2,
+	0xe6, 0xff, 0x66, 0x61, 0x2f, 0xd9, 0xc9, 0xb6, 0xaa, 0x62, 0x6e, 0x8c, 0xd0, 0x45, 0x08, 0x33,
+	0x77, 0x57, 0x59, 0xda, 0x53, 0x79, 0x16, 0xc2, 0xac, 0xff, 0xdb, 0x3a, 0x40, 0xb5, 0xd7, 0x98,
+	0xbd, 0x41, 0xb8, 0xd9, 0xf6, 0x60, 0xcf, 0x6d, 0xab, 0xaa, 0x96, 0x67, 0xce, 0x62, 0x65, 0xe9,
+	0xcb, 0x95, 0xa5, 0x7f, 0xaf, 0x2c, 0xfd, 0x7d, 0x6d, 0x69, 0xcb, 0xb5, 0xa5, 0x7d, 0xae, 0x2d,
+	0xed, 0xb9, 0x17, 0x50, 0x31, 0x4e, 0x3c, 0x9b, 0xb0, 0x29, 0x26, 0x8c, 0x4f, 0x19, 0xc7, 0xd4,
+	0x23, 0xed, 0x80, 0xe1, 0xb4, 0x87, 0xa7, 0xcc, 0x4f, 0x26, 0xc0, 0xd5, 0x41, 0xdc, 0x76, 0xdb,
+	0xf9, 0x4d, 0x64, 0x0f, 0xc8, 0xbd, 0x92, 0x3c, 0x88, 0xbb, 0x9f, 0x01, 0x00, 0xdc, 0xee, 0xdd,
+	0x61, 0x7e, 0x02, 0x00, 0x00,
+}
+
+func (m *EventUpdateClientResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateClientResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateClientResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FrozenHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.NewConsensusHeights) > 0 {
+		for iNdEx := len(m.NewConsensusHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewConsensusHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ConsensusHeights) > 0 {
+		for iNdEx := len(m.ConsensusHeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsensusHeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.PreviousHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.UpdateResult) > 0 {
+		i -= len(m.UpdateResult)
+		copy(dAtA[i:], m.UpdateResult)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdateResult)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientType) > 0 {
+		i -= len(m.ClientType)
+		copy(dAtA[i:], m.ClientType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ClientType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventUpdateClientResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ClientType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.UpdateResult)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.PreviousHeight.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.ConsensusHeights) > 0 {
+		for _, e := range m.ConsensusHeights {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.NewConsensusHeights) > 0 {
+		for _, e := range m.NewConsensusHeights {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.FrozenHeight.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventUpdateClientResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateClientResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateClientResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateResult", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateResult = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusHeights = append(m.ConsensusHeights, Height{})
+			if err := m.ConsensusHeights[len(m.ConsensusHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsensusHeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewConsensusHeights = append(m.NewConsensusHeights, Height{})
+			if err := m.NewConsensusHeights[len(m.NewConsensusHeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FrozenHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
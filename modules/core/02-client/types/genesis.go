@@ -198,6 +198,46 @@ func (gs GenesisState) Validate() error {
 
 	}
 
+	seenAliases := make(map[string]bool)
+	seenAliasClients := make(map[string]bool)
+	for i, clientAlias := range gs.ClientAliases {
+		// check that the alias is for a client in the genesis clients list
+		if _, ok := validClients[clientAlias.ClientId]; !ok {
+			return fmt.Errorf("client alias in genesis has a client id %s that does not map to a genesis client", clientAlias.ClientId)
+		}
+
+		if err := ValidateClientAlias(clientAlias.Alias); err != nil {
+			return fmt.Errorf("invalid client alias %s clientID %s index %d: %w", clientAlias.Alias, clientAlias.ClientId, i, err)
+		}
+
+		if seenAliasClients[clientAlias.ClientId] {
+			return fmt.Errorf("duplicate client alias for client id %s", clientAlias.ClientId)
+		}
+		seenAliasClients[clientAlias.ClientId] = true
+
+		if seenAliases[clientAlias.Alias] {
+			return fmt.Errorf("duplicate client alias %s", clientAlias.Alias)
+		}
+		seenAliases[clientAlias.Alias] = true
+	}
+
+	seenDeposits := make(map[string]bool)
+	for i, deposit := range gs.ClientDeposits {
+		// check that the deposit is for a client in the genesis clients list
+		if _, ok := validClients[deposit.ClientId]; !ok {
+			return fmt.Errorf("client deposit in genesis has a client id %s that does not map to a genesis client", deposit.ClientId)
+		}
+
+		if err := deposit.Validate(); err != nil {
+			return fmt.Errorf("invalid client deposit clientID %s index %d: %w", deposit.ClientId, i, err)
+		}
+
+		if seenDeposits[deposit.ClientId] {
+			return fmt.Errorf("duplicate client deposit for client id %s", deposit.ClientId)
+		}
+		seenDeposits[deposit.ClientId] = true
+	}
+
 	if maxSequence != 0 && maxSequence >= gs.NextClientSequence {
 		return fmt.Errorf("next client identifier sequence %d must be greater than the maximum sequence used in the provided client identifiers %d", gs.NextClientSequence, maxSequence)
 	}
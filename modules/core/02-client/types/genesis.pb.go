@@ -37,6 +37,10 @@ type GenesisState struct {
 	CreateLocalhost bool `protobuf:"varint,5,opt,name=create_localhost,json=createLocalhost,proto3" json:"create_localhost,omitempty"` // Deprecated: Do not use.
 	// the sequence for the next generated client identifier
 	NextClientSequence uint64 `protobuf:"varint,6,opt,name=next_client_sequence,json=nextClientSequence,proto3" json:"next_client_sequence,omitempty"`
+	// human-readable aliases of clients
+	ClientAliases []ClientAlias `protobuf:"bytes,7,rep,name=client_aliases,json=clientAliases,proto3" json:"client_aliases"`
+	// deposits escrowed for the creation of clients
+	ClientDeposits []ClientDeposit `protobuf:"bytes,8,rep,name=client_deposits,json=clientDeposits,proto3" json:"client_deposits"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -115,6 +119,20 @@ func (m *GenesisState) GetNextClientSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetClientAliases() []ClientAlias {
+	if m != nil {
+		return m.ClientAliases
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClientDeposits() []ClientDeposit {
+	if m != nil {
+		return m.ClientDeposits
+	}
+	return nil
+}
+
 // GenesisMetadata defines the genesis type for metadata that will be used
 // to export all client store keys that are not client or consensus states.
 type GenesisMetadata struct {
@@ -220,37 +238,40 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/client/v1/genesis.proto", fileDescriptor_bcd0c0f1f2e6a91a) }
 
 var fileDescriptor_bcd0c0f1f2e6a91a = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0xf5, 0x25, 0x69, 0x68, 0xaf, 0x15, 0x09, 0xa7, 0x08, 0x99, 0x20, 0x39, 0x56, 0x58, 0xcc,
-	0x10, 0xbb, 0x0d, 0x4b, 0xc4, 0x82, 0x94, 0x0e, 0xa8, 0x12, 0x48, 0xc8, 0x6c, 0x0c, 0x58, 0xf6,
-	0xf9, 0xc3, 0xb5, 0xb0, 0x7d, 0x21, 0x77, 0xb6, 0xe8, 0x3f, 0x60, 0x60, 0xe0, 0x27, 0x30, 0xf3,
-	0x4b, 0x3a, 0x76, 0x64, 0x02, 0x94, 0x48, 0xfc, 0x0e, 0xe4, 0xbb, 0x33, 0x95, 0x82, 0xc3, 0xf6,
-	0xe5, 0xbd, 0xf7, 0xbd, 0x97, 0x7b, 0xd6, 0x87, 0xed, 0x34, 0xa2, 0x1e, 0x65, 0x6b, 0xf0, 0x68,
-	0x96, 0x42, 0x21, 0xbc, 0xea, 0xcc, 0x4b, 0xa0, 0x00, 0x9e, 0x72, 0x77, 0xb5, 0x66, 0x82, 0x11,
-	0x92, 0x46, 0xd4, 0xad, 0x15, 0xae, 0x52, 0xb8, 0xd5, 0xd9, 0x78, 0xd2, 0xb2, 0xa5, 0x59, 0xb9,
-	0x34, 0x1e, 0x25, 0x2c, 0x61, 0x72, 0xf4, 0xea, 0x49, 0xa1, 0xd3, 0xdf, 0x5d, 0x7c, 0xf2, 0x5c,
-	0x99, 0xbf, 0x16, 0xa1, 0x00, 0x42, 0xf1, 0x1d, 0xb5, 0xc6, 0x4d, 0x64, 0x77, 0x9d, 0xe3, 0xf9,
-	0x63, 0xf7, 0xdf, 0x34, 0xf7, 0x22, 0x86, 0x42, 0xa4, 0xef, 0x52, 0x88, 0xcf, 0x25, 0x26, 0x77,
-	0x97, 0xd6, 0xf5, 0x8f, 0x89, 0xf1, 0xed, 0xe7, 0xe4, 0x7e, 0x2b, 0xcd, 0xfd, 0xc6, 0x99, 0x54,
-	0xf8, 0x9e, 0x1e, 0x03, 0xca, 0x0a, 0x0e, 0x05, 0x2f, 0xb9, 0xd9, 0xd9, 0x1f, 0xa7, 0x5c, 0xce,
-	0x1b, 0xa9, 0xb2, 0xbb, 0x8d, 0x53, 0x34, 0xdf, 0xe1, 0xfd, 0x21, 0xdd, 0xc1, 0xc9, 0x5b, 0xdc,
-	0x60, 0x41, 0x0e, 0x22, 0x8c, 0x43, 0x11, 0x9a, 0x5d, 0x19, 0x3b, 0xfb, 0xff, 0x2b, 0x75, 0x45,
-	0x2f, 0xf5, 0xd2, 0xb2, 0x57, 0x47, 0xfb, 0x03, 0x6d, 0xd6, 0xc0, 0x64, 0x81, 0xfb, 0xab, 0x70,
-	0x1d, 0xe6, 0xdc, 0xec, 0xd9, 0xc8, 0x39, 0x9e, 0x8f, 0xdb, 0x5c, 0x5f, 0x49, 0x85, 0xb6, 0xd0,
-	0x7a, 0x32, 0xc3, 0x43, 0xba, 0x86, 0x50, 0x40, 0x90, 0x31, 0x1a, 0x66, 0x97, 0x8c, 0x0b, 0xf3,
-	0xc0, 0x46, 0xce, 0xe1, 0xb2, 0x63, 0x22, 0x7f, 0xa0, 0xb8, 0x17, 0x0d, 0x45, 0x4e, 0xf1, 0xa8,
-	0x80, 0x8f, 0x22, 0x50, 0xae, 0x01, 0x87, 0x0f, 0x25, 0x14, 0x14, 0xcc, 0xbe, 0x8d, 0x9c, 0x9e,
-	0x4f, 0x6a, 0x4e, 0x37, 0xaf, 0x99, 0xe9, 0x33, 0x3c, 0xd8, 0x79, 0x04, 0x19, 0xe2, 0xee, 0x7b,
-	0xb8, 0x32, 0x91, 0x8d, 0x9c, 0x13, 0xbf, 0x1e, 0xc9, 0x08, 0x1f, 0x54, 0x61, 0x56, 0x82, 0xd9,
-	0x91, 0x98, 0xfa, 0xf1, 0xb4, 0xf7, 0xe9, 0xeb, 0xc4, 0x98, 0x7e, 0x46, 0xf8, 0xc1, 0xde, 0x42,
-	0xc8, 0x43, 0x7c, 0xa4, 0xff, 0x4b, 0x1a, 0x4b, 0xc7, 0x23, 0xff, 0x50, 0x01, 0x17, 0x31, 0xf1,
-	0xb1, 0x6e, 0xea, 0xb6, 0x75, 0xf5, 0xb1, 0x1f, 0xb5, 0xf5, 0xd3, 0xde, 0xf5, 0x5d, 0x25, 0xf8,
-	0x8b, 0xfa, 0xd7, 0x1b, 0x0b, 0xdd, 0x6c, 0x2c, 0xf4, 0x6b, 0x63, 0xa1, 0x2f, 0x5b, 0xcb, 0xb8,
-	0xd9, 0x5a, 0xc6, 0xf7, 0xad, 0x65, 0xbc, 0x59, 0x24, 0xa9, 0xb8, 0x2c, 0x23, 0x97, 0xb2, 0xdc,
-	0xa3, 0x8c, 0xe7, 0x8c, 0x7b, 0x69, 0x44, 0x67, 0x09, 0xf3, 0xaa, 0x85, 0x97, 0xb3, 0xb8, 0xcc,
-	0x80, 0xab, 0x4b, 0x39, 0x9d, 0xcf, 0xf4, 0xb1, 0x88, 0xab, 0x15, 0xf0, 0xa8, 0x2f, 0x6f, 0xe2,
-	0xc9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc5, 0x83, 0x8e, 0x3a, 0x82, 0x03, 0x00, 0x00,
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x41, 0x6f, 0x12, 0x41,
+	0x14, 0x66, 0x5a, 0xa0, 0x74, 0x5a, 0x0b, 0x4e, 0x88, 0x19, 0x31, 0x59, 0x56, 0xbc, 0xe0, 0x81,
+	0xdd, 0x16, 0x2f, 0xc4, 0x8b, 0x91, 0x9a, 0x98, 0x26, 0x35, 0x69, 0xd6, 0x9b, 0x07, 0x37, 0xc3,
+	0xec, 0x93, 0x4e, 0x5c, 0x76, 0x90, 0x19, 0x88, 0xfd, 0x03, 0xc6, 0x83, 0x07, 0x7f, 0x82, 0x67,
+	0x7f, 0x49, 0x8f, 0x3d, 0x7a, 0x52, 0x03, 0x7f, 0xc4, 0xec, 0xcc, 0x40, 0x0d, 0x82, 0xb7, 0xb7,
+	0xdf, 0xf7, 0xbd, 0xef, 0xcd, 0xf7, 0x76, 0x06, 0xfb, 0x62, 0xc0, 0x43, 0x2e, 0x27, 0x10, 0xf2,
+	0x54, 0x40, 0xa6, 0xc3, 0xd9, 0x49, 0x38, 0x84, 0x0c, 0x94, 0x50, 0xc1, 0x78, 0x22, 0xb5, 0x24,
+	0x44, 0x0c, 0x78, 0x90, 0x2b, 0x02, 0xab, 0x08, 0x66, 0x27, 0x8d, 0xe6, 0x86, 0x2e, 0xc7, 0x9a,
+	0xa6, 0x46, 0x7d, 0x28, 0x87, 0xd2, 0x94, 0x61, 0x5e, 0x59, 0xb4, 0xf5, 0xa9, 0x84, 0x0f, 0x5f,
+	0x5a, 0xf3, 0xd7, 0x9a, 0x69, 0x20, 0x1c, 0xef, 0xd9, 0x36, 0x45, 0x91, 0xbf, 0xdb, 0x3e, 0xe8,
+	0x3e, 0x0e, 0xfe, 0x9d, 0x16, 0x9c, 0x25, 0x90, 0x69, 0xf1, 0x4e, 0x40, 0x72, 0x6a, 0x30, 0xd3,
+	0xdb, 0xf7, 0xae, 0x7f, 0x36, 0x0b, 0xdf, 0x7f, 0x35, 0xef, 0x6d, 0xa4, 0x55, 0xb4, 0x74, 0x26,
+	0x33, 0x7c, 0xd7, 0x95, 0x31, 0x97, 0x99, 0x82, 0x4c, 0x4d, 0x15, 0xdd, 0xd9, 0x3e, 0xce, 0xba,
+	0x9c, 0x2e, 0xa5, 0xd6, 0xee, 0x76, 0x9c, 0xa5, 0xd5, 0x1a, 0x1f, 0xd5, 0xf8, 0x1a, 0x4e, 0xde,
+	0xe2, 0x25, 0x16, 0x8f, 0x40, 0xb3, 0x84, 0x69, 0x46, 0x77, 0xcd, 0xd8, 0xce, 0xff, 0x53, 0xba,
+	0x15, 0xbd, 0x72, 0x4d, 0xfd, 0x62, 0x3e, 0x3a, 0xaa, 0x3a, 0xb3, 0x25, 0x4c, 0x7a, 0xb8, 0x3c,
+	0x66, 0x13, 0x36, 0x52, 0xb4, 0xe8, 0xa3, 0xf6, 0x41, 0xb7, 0xb1, 0xc9, 0xf5, 0xc2, 0x28, 0x9c,
+	0x85, 0xd3, 0x93, 0x0e, 0xae, 0xf1, 0x09, 0x30, 0x0d, 0x71, 0x2a, 0x39, 0x4b, 0x2f, 0xa5, 0xd2,
+	0xb4, 0xe4, 0xa3, 0x76, 0xa5, 0xbf, 0x43, 0x51, 0x54, 0xb5, 0xdc, 0xf9, 0x92, 0x22, 0xc7, 0xb8,
+	0x9e, 0xc1, 0x47, 0x1d, 0x5b, 0xd7, 0x58, 0xc1, 0x87, 0x29, 0x64, 0x1c, 0x68, 0xd9, 0x47, 0xed,
+	0x62, 0x44, 0x72, 0xce, 0x6d, 0xde, 0x31, 0xe4, 0x1c, 0x1f, 0x39, 0x31, 0x4b, 0x05, 0x53, 0xa0,
+	0xe8, 0x9e, 0x09, 0xde, 0xdc, 0xbe, 0xef, 0xe7, 0xb9, 0xd0, 0x9d, 0xf3, 0x0e, 0xbf, 0x85, 0x40,
+	0x91, 0x0b, 0xec, 0xb2, 0xc7, 0x09, 0x8c, 0xa5, 0x12, 0x5a, 0xd1, 0x8a, 0xb1, 0x7b, 0xb8, 0xdd,
+	0xee, 0x85, 0x55, 0x3a, 0xc3, 0x23, 0xfe, 0x37, 0xa8, 0x5a, 0xcf, 0x70, 0x75, 0x6d, 0xc9, 0xa4,
+	0x86, 0x77, 0xdf, 0xc3, 0x15, 0x45, 0x3e, 0x6a, 0x1f, 0x46, 0x79, 0x49, 0xea, 0xb8, 0x34, 0x63,
+	0xe9, 0x14, 0xe8, 0x8e, 0xc1, 0xec, 0xc7, 0xd3, 0xe2, 0xe7, 0x6f, 0xcd, 0x42, 0xeb, 0x0b, 0xc2,
+	0xf7, 0xb7, 0xfe, 0x30, 0xf2, 0x00, 0xef, 0xbb, 0x03, 0x8b, 0xc4, 0x38, 0xee, 0x47, 0x15, 0x0b,
+	0x9c, 0x25, 0x24, 0x5a, 0xa5, 0x59, 0xdd, 0x0a, 0x7b, 0x19, 0x1f, 0x6d, 0x4a, 0xb3, 0xf9, 0x2e,
+	0xb8, 0x3c, 0x2b, 0x34, 0xba, 0x9e, 0x7b, 0xe8, 0x66, 0xee, 0xa1, 0xdf, 0x73, 0x0f, 0x7d, 0x5d,
+	0x78, 0x85, 0x9b, 0x85, 0x57, 0xf8, 0xb1, 0xf0, 0x0a, 0x6f, 0x7a, 0x43, 0xa1, 0x2f, 0xa7, 0x83,
+	0x80, 0xcb, 0x51, 0xc8, 0xa5, 0x1a, 0x49, 0x15, 0x8a, 0x01, 0xef, 0x0c, 0x65, 0x38, 0xeb, 0x85,
+	0x23, 0x99, 0x4c, 0x53, 0x50, 0xf6, 0x25, 0x1f, 0x77, 0x3b, 0xee, 0x31, 0xeb, 0xab, 0x31, 0xa8,
+	0x41, 0xd9, 0xbc, 0xd9, 0x27, 0x7f, 0x06, 0x00, 0xa6, 0x68, 0x1f, 0x45, 0x22, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -273,6 +294,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClientDeposits) > 0 {
+		for iNdEx := len(m.ClientDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ClientAliases) > 0 {
+		for iNdEx := len(m.ClientAliases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClientAliases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.NextClientSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextClientSequence))
 		i--
@@ -467,6 +516,18 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextClientSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.NextClientSequence))
 	}
+	if len(m.ClientAliases) > 0 {
+		for _, e := range m.ClientAliases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClientDeposits) > 0 {
+		for _, e := range m.ClientDeposits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -715,6 +776,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientAliases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientAliases = append(m.ClientAliases, ClientAlias{})
+			if err := m.ClientAliases[len(m.ClientAliases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientDeposits = append(m.ClientDeposits, ClientDeposit{})
+			if err := m.ClientDeposits[len(m.ClientDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,8 @@ package types_test
 import (
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	client "github.com/cosmos/ibc-go/v8/modules/core/02-client"
@@ -484,3 +486,156 @@ func (suite *TypesTestSuite) TestValidateGenesis() {
 		}
 	}
 }
+
+func (suite *TypesTestSuite) TestValidateGenesisClientAliases() {
+	var genState types.GenesisState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: valid client aliases",
+			func() {},
+			true,
+		},
+		{
+			"failure: alias for a client not in genesis",
+			func() {
+				genState.ClientAliases = append(genState.ClientAliases, types.ClientAlias{ClientId: "07-tendermint-5", Alias: "juno"})
+			},
+			false,
+		},
+		{
+			"failure: invalid alias",
+			func() {
+				genState.ClientAliases[0].Alias = tmClientID1
+			},
+			false,
+		},
+		{
+			"failure: duplicate alias",
+			func() {
+				genState.ClientAliases[1].Alias = genState.ClientAliases[0].Alias
+			},
+			false,
+		},
+		{
+			"failure: duplicate alias for a client",
+			func() {
+				genState.ClientAliases[1].ClientId = genState.ClientAliases[0].ClientId
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			clientState := ibctm.NewClientState(suite.chainA.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+			genState = types.NewGenesisState(
+				[]types.IdentifiedClientState{
+					types.NewIdentifiedClientState(tmClientID0, clientState),
+					types.NewIdentifiedClientState(tmClientID1, clientState),
+				},
+				nil,
+				nil,
+				types.NewParams(exported.Tendermint),
+				false,
+				2,
+			)
+			genState.ClientAliases = []types.ClientAlias{
+				{ClientId: tmClientID0, Alias: "osmosis"},
+				{ClientId: tmClientID1, Alias: "cosmoshub"},
+			}
+
+			tc.malleate()
+
+			err := genState.Validate()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestValidateGenesisClientDeposits() {
+	var genState types.GenesisState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: valid client deposits",
+			func() {},
+			true,
+		},
+		{
+			"failure: deposit for a client not in genesis",
+			func() {
+				genState.ClientDeposits = append(genState.ClientDeposits, types.NewClientDeposit("07-tendermint-5", ibctesting.TestAccAddress, genState.ClientDeposits[0].Amount))
+			},
+			false,
+		},
+		{
+			"failure: invalid depositor",
+			func() {
+				genState.ClientDeposits[0].Depositor = ibctesting.InvalidID
+			},
+			false,
+		},
+		{
+			"failure: zero deposit amount",
+			func() {
+				genState.ClientDeposits[0].Amount = sdk.NewCoins()
+			},
+			false,
+		},
+		{
+			"failure: duplicate deposit for a client",
+			func() {
+				genState.ClientDeposits[1].ClientId = genState.ClientDeposits[0].ClientId
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			clientState := ibctm.NewClientState(suite.chainA.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+			genState = types.NewGenesisState(
+				[]types.IdentifiedClientState{
+					types.NewIdentifiedClientState(tmClientID0, clientState),
+					types.NewIdentifiedClientState(tmClientID1, clientState),
+				},
+				nil,
+				nil,
+				types.NewParams(exported.Tendermint),
+				false,
+				2,
+			)
+			deposit := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+			genState.ClientDeposits = []types.ClientDeposit{
+				types.NewClientDeposit(tmClientID0, ibctesting.TestAccAddress, deposit),
+				types.NewClientDeposit(tmClientID1, ibctesting.TestAccAddress, deposit),
+			}
+
+			tc.malleate()
+
+			err := genState.Validate()
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
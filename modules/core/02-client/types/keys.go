@@ -29,6 +29,28 @@ const (
 	// ParamsKey is the store key for the IBC client parameters
 	ParamsKey = "clientParams"
 
+	// KeyClientAliasPrefix is the prefix of the keys used to store the alias of a client
+	KeyClientAliasPrefix = "clientAliases"
+
+	// KeyClientIDByAliasPrefix is the prefix of the keys used to store the client identifier of an alias
+	KeyClientIDByAliasPrefix = "clientAliasIDs"
+
+	// KeyClientDepositPrefix is the prefix of the keys used to store the deposit escrowed for the creation of a client
+	KeyClientDepositPrefix = "clientDeposits"
+
+	// KeyClientDepositForfeitureCursor is the key under which the identifier of the last client whose deposit was
+	// checked for forfeiture is stored
+	KeyClientDepositForfeitureCursor = "clientDepositForfeitureCursor"
+
+	// MaxClientDepositsCheckedPerBlock is the maximum number of client deposits checked for forfeiture in a block
+	MaxClientDepositsCheckedPerBlock = 20
+
+	// MaxConsensusStatesPrunedPerBlock is the maximum number of consensus states of abandoned clients pruned in a block
+	MaxConsensusStatesPrunedPerBlock = 100
+
+	// MaxClientAliasLength is the maximum length of a client alias
+	MaxClientAliasLength = 64
+
 	// AllowAllClients is the value that if set in AllowedClients param
 	// would allow any wired up light client modules to be allowed
 	AllowAllClients = "*"
@@ -55,6 +77,44 @@ func IsValidClientID(clientID string) bool {
 	return err == nil
 }
 
+// ClientAliasKey returns the store key under which the alias of the given client is stored.
+func ClientAliasKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyClientAliasPrefix, clientID))
+}
+
+// ClientIDByAliasKey returns the store key under which the client identifier of the given alias is stored.
+func ClientIDByAliasKey(alias string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyClientIDByAliasPrefix, alias))
+}
+
+// ClientDepositKey returns the store key under which the deposit escrowed for the creation of the given client is stored.
+func ClientDepositKey(clientID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyClientDepositPrefix, clientID))
+}
+
+// ValidateClientAlias validates a client alias. A valid alias must be between 1 and 64 characters, only contain
+// alphanumeric and the special characters allowed in identifiers (see host.IsValidID), and must not itself be in
+// the format of a client identifier, so that an alias can never shadow the identifier of another client.
+func ValidateClientAlias(alias string) error {
+	if strings.TrimSpace(alias) == "" {
+		return errorsmod.Wrap(ErrInvalidClientAlias, "alias cannot be blank")
+	}
+
+	if len(alias) > MaxClientAliasLength {
+		return errorsmod.Wrapf(ErrInvalidClientAlias, "alias %s has invalid length: %d, must not exceed %d characters", alias, len(alias), MaxClientAliasLength)
+	}
+
+	if !host.IsValidID(alias) {
+		return errorsmod.Wrapf(ErrInvalidClientAlias, "alias %s must contain only alphanumeric or the following characters: '.', '_', '+', '-', '#', '[', ']', '<', '>'", alias)
+	}
+
+	if IsValidClientID(alias) {
+		return errorsmod.Wrapf(ErrInvalidClientAlias, "alias %s cannot be in the format of a client identifier", alias)
+	}
+
+	return nil
+}
+
 // ParseClientIdentifier parses the client type and sequence from the client identifier.
 func ParseClientIdentifier(clientID string) (string, uint64, error) {
 	if clientID == exported.LocalhostClientID {
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"math"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -63,3 +64,35 @@ func TestParseClientIdentifier(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateClientAlias(t *testing.T) {
+	testCases := []struct {
+		name    string
+		alias   string
+		expPass bool
+	}{
+		{"valid alias", "osmosis", true},
+		{"valid alias with special characters", "cosmoshub-main.net_#1", true},
+		{"valid alias of maximum length", strings.Repeat("a", types.MaxClientAliasLength), true},
+		{"empty alias", "", false},
+		{"blank alias", "   ", false},
+		{"alias too long", strings.Repeat("a", types.MaxClientAliasLength+1), false},
+		{"alias with slash", "osmosis/main", false},
+		{"alias with whitespace", "osmosis main", false},
+		{"alias in client identifier format", "07-tendermint-0", false},
+		{"alias of localhost client identifier", "09-localhost", false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateClientAlias(tc.alias)
+
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidClientAlias)
+			}
+		})
+	}
+}
@@ -15,22 +15,33 @@ import (
 var (
 	_ sdk.Msg = (*MsgCreateClient)(nil)
 	_ sdk.Msg = (*MsgUpdateClient)(nil)
+	_ sdk.Msg = (*MsgUpdateClientBatch)(nil)
 	_ sdk.Msg = (*MsgSubmitMisbehaviour)(nil)
 	_ sdk.Msg = (*MsgUpgradeClient)(nil)
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgIBCSoftwareUpgrade)(nil)
 	_ sdk.Msg = (*MsgRecoverClient)(nil)
+	_ sdk.Msg = (*MsgRecoverClients)(nil)
+	_ sdk.Msg = (*MsgSetClientAlias)(nil)
+	_ sdk.Msg = (*MsgReclaimClientDeposit)(nil)
+	_ sdk.Msg = (*MsgFreezeClient)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgCreateClient)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateClient)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateClientBatch)(nil)
 	_ sdk.HasValidateBasic = (*MsgSubmitMisbehaviour)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpgradeClient)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgIBCSoftwareUpgrade)(nil)
 	_ sdk.HasValidateBasic = (*MsgRecoverClient)(nil)
+	_ sdk.HasValidateBasic = (*MsgRecoverClients)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetClientAlias)(nil)
+	_ sdk.HasValidateBasic = (*MsgReclaimClientDeposit)(nil)
+	_ sdk.HasValidateBasic = (*MsgFreezeClient)(nil)
 
 	_ codectypes.UnpackInterfacesMessage = (*MsgCreateClient)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgUpdateClient)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*MsgUpdateClientBatch)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgSubmitMisbehaviour)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgUpgradeClient)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*MsgIBCSoftwareUpgrade)(nil)
@@ -57,6 +68,21 @@ func NewMsgCreateClient(
 	}, nil
 }
 
+// NewMsgCreateClientWithSeedConsensusStates creates a new MsgCreateClient instance which stores the given seed
+// consensus states, in ascending order of height, alongside the initial consensus state.
+func NewMsgCreateClientWithSeedConsensusStates(
+	clientState exported.ClientState, consensusState exported.ConsensusState, seedConsensusStates []ConsensusStateWithHeight, signer string,
+) (*MsgCreateClient, error) {
+	msg, err := NewMsgCreateClient(clientState, consensusState, signer)
+	if err != nil {
+		return nil, err
+	}
+
+	msg.SeedConsensusStates = seedConsensusStates
+
+	return msg, nil
+}
+
 // ValidateBasic implements sdk.Msg
 func (msg MsgCreateClient) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(msg.Signer)
@@ -80,7 +106,36 @@ func (msg MsgCreateClient) ValidateBasic() error {
 	if err := ValidateClientType(clientState.ClientType()); err != nil {
 		return errorsmod.Wrap(err, "client type does not meet naming constraints")
 	}
-	return consensusState.ValidateBasic()
+	if err := consensusState.ValidateBasic(); err != nil {
+		return err
+	}
+	return validateSeedConsensusStates(clientState.ClientType(), msg.SeedConsensusStates)
+}
+
+// validateSeedConsensusStates validates that the seed consensus states are valid consensus states of the given client type
+// at non-zero, strictly increasing heights.
+func validateSeedConsensusStates(clientType string, seedConsensusStates []ConsensusStateWithHeight) error {
+	for i, seed := range seedConsensusStates {
+		if seed.Height.IsZero() {
+			return errorsmod.Wrapf(ErrInvalidHeight, "seed consensus state %d height cannot be zero", i)
+		}
+		if i > 0 && !seed.Height.GT(seedConsensusStates[i-1].Height) {
+			return errorsmod.Wrapf(ErrInvalidHeight, "seed consensus state heights must be strictly increasing, got %s after %s", seed.Height, seedConsensusStates[i-1].Height)
+		}
+
+		consensusState, err := UnpackConsensusState(seed.ConsensusState)
+		if err != nil {
+			return errorsmod.Wrapf(err, "seed consensus state %d", i)
+		}
+		if consensusState.ClientType() != clientType {
+			return errorsmod.Wrapf(ErrInvalidClientType, "client type for client state and seed consensus state %d do not match", i)
+		}
+		if err := consensusState.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "seed consensus state %d", i)
+		}
+	}
+
+	return nil
 }
 
 // UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
@@ -92,7 +147,17 @@ func (msg MsgCreateClient) UnpackInterfaces(unpacker codectypes.AnyUnpacker) err
 	}
 
 	var consensusState exported.ConsensusState
-	return unpacker.UnpackAny(msg.ConsensusState, &consensusState)
+	if err := unpacker.UnpackAny(msg.ConsensusState, &consensusState); err != nil {
+		return err
+	}
+
+	for _, seed := range msg.SeedConsensusStates {
+		if err := seed.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // NewMsgUpdateClient creates a new MsgUpdateClient instance
@@ -131,6 +196,62 @@ func (msg MsgUpdateClient) UnpackInterfaces(unpacker codectypes.AnyUnpacker) err
 	return unpacker.UnpackAny(msg.ClientMessage, &clientMsg)
 }
 
+// NewClientUpdate creates a new ClientUpdate instance
+func NewClientUpdate(id string, clientMsg exported.ClientMessage) (ClientUpdate, error) {
+	anyClientMsg, err := PackClientMessage(clientMsg)
+	if err != nil {
+		return ClientUpdate{}, err
+	}
+
+	return ClientUpdate{
+		ClientId:      id,
+		ClientMessage: anyClientMsg,
+	}, nil
+}
+
+// NewMsgUpdateClientBatch creates a new MsgUpdateClientBatch instance
+func NewMsgUpdateClientBatch(updates []ClientUpdate, signer string) *MsgUpdateClientBatch {
+	return &MsgUpdateClientBatch{
+		Updates: updates,
+		Signer:  signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateClientBatch) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	if len(msg.Updates) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "client updates cannot be empty")
+	}
+	for i, update := range msg.Updates {
+		clientMsg, err := UnpackClientMessage(update.ClientMessage)
+		if err != nil {
+			return errorsmod.Wrapf(err, "client update %d", i)
+		}
+		if err := clientMsg.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "client update %d", i)
+		}
+		if err := host.ClientIdentifierValidator(update.ClientId); err != nil {
+			return errorsmod.Wrapf(err, "client update %d", i)
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgUpdateClientBatch) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, update := range msg.Updates {
+		var clientMsg exported.ClientMessage
+		if err := unpacker.UnpackAny(update.ClientMessage, &clientMsg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // NewMsgUpgradeClient creates a new MsgUpgradeClient instance
 func NewMsgUpgradeClient(clientID string, clientState exported.ClientState, consState exported.ConsensusState,
 	upgradeClientProof, upgradeConsensusStateProof []byte, signer string,
@@ -250,18 +371,77 @@ func (msg *MsgRecoverClient) ValidateBasic() error {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
 	}
 
-	if err := host.ClientIdentifierValidator(msg.SubjectClientId); err != nil {
+	return NewClientRecovery(msg.SubjectClientId, msg.SubstituteClientId, msg.TrustedHeights).Validate()
+}
+
+// NewMsgRecoverClients creates a new MsgRecoverClients instance
+func NewMsgRecoverClients(signer string, recoveries []ClientRecovery) *MsgRecoverClients {
+	return &MsgRecoverClients{
+		Signer:     signer,
+		Recoveries: recoveries,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgRecoverClients.
+func (msg *MsgRecoverClients) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if len(msg.Recoveries) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "client recoveries cannot be empty")
+	}
+
+	seenSubjects := make(map[string]bool)
+	for i, recovery := range msg.Recoveries {
+		if err := recovery.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "client recovery %d", i)
+		}
+
+		if seenSubjects[recovery.SubjectClientId] {
+			return errorsmod.Wrapf(ErrInvalidRecoveryClient, "client recovery %d: duplicate subject client %s", i, recovery.SubjectClientId)
+		}
+		seenSubjects[recovery.SubjectClientId] = true
+	}
+
+	return nil
+}
+
+// NewClientRecovery creates a new ClientRecovery instance
+func NewClientRecovery(subjectClientID, substituteClientID string, trustedHeights []Height) ClientRecovery {
+	return ClientRecovery{
+		SubjectClientId:    subjectClientID,
+		SubstituteClientId: substituteClientID,
+		TrustedHeights:     trustedHeights,
+	}
+}
+
+// Validate performs basic checks on a ClientRecovery.
+func (cr ClientRecovery) Validate() error {
+	if err := host.ClientIdentifierValidator(cr.SubjectClientId); err != nil {
 		return err
 	}
 
-	if err := host.ClientIdentifierValidator(msg.SubstituteClientId); err != nil {
+	if err := host.ClientIdentifierValidator(cr.SubstituteClientId); err != nil {
 		return err
 	}
 
-	if msg.SubjectClientId == msg.SubstituteClientId {
+	if cr.SubjectClientId == cr.SubstituteClientId {
 		return errorsmod.Wrapf(ErrInvalidSubstitute, "subject and substitute clients must be different")
 	}
 
+	seenHeights := make(map[Height]bool)
+	for _, height := range cr.TrustedHeights {
+		if height.IsZero() {
+			return errorsmod.Wrap(ErrInvalidHeight, "trusted height cannot be zero")
+		}
+
+		if seenHeights[height] {
+			return errorsmod.Wrapf(ErrInvalidHeight, "duplicate trusted height %s", height)
+		}
+		seenHeights[height] = true
+	}
+
 	return nil
 }
 
@@ -318,3 +498,63 @@ func (msg *MsgUpdateParams) ValidateBasic() error {
 	}
 	return msg.Params.Validate()
 }
+
+// NewMsgSetClientAlias creates a new MsgSetClientAlias instance
+func NewMsgSetClientAlias(signer, clientID, alias string) *MsgSetClientAlias {
+	return &MsgSetClientAlias{
+		Signer:   signer,
+		ClientId: clientID,
+		Alias:    alias,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgSetClientAlias. An empty alias is valid and removes the alias of the client.
+func (msg *MsgSetClientAlias) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ClientIdentifierValidator(msg.ClientId); err != nil {
+		return err
+	}
+
+	if msg.Alias == "" {
+		return nil
+	}
+
+	return ValidateClientAlias(msg.Alias)
+}
+
+// NewMsgReclaimClientDeposit creates a new MsgReclaimClientDeposit instance
+func NewMsgReclaimClientDeposit(signer, clientID string) *MsgReclaimClientDeposit {
+	return &MsgReclaimClientDeposit{
+		Signer:   signer,
+		ClientId: clientID,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgReclaimClientDeposit.
+func (msg *MsgReclaimClientDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return host.ClientIdentifierValidator(msg.ClientId)
+}
+
+// NewMsgFreezeClient creates a new MsgFreezeClient instance
+func NewMsgFreezeClient(signer, clientID string) *MsgFreezeClient {
+	return &MsgFreezeClient{
+		Signer:   signer,
+		ClientId: clientID,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgFreezeClient.
+func (msg *MsgFreezeClient) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return host.ClientIdentifierValidator(msg.ClientId)
+}
@@ -74,6 +74,15 @@ func (suite *TypesTestSuite) TestMarshalMsgCreateClient() {
 				suite.Require().NoError(err)
 			},
 		},
+		{
+			"tendermint client with seed consensus states", func() {
+				tendermintClient := ibctm.NewClientState(suite.chainA.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+				consensusState := suite.chainA.CurrentTMClientHeader().ConsensusState()
+				seeds := []types.ConsensusStateWithHeight{types.NewConsensusStateWithHeight(types.NewHeight(0, 1), consensusState)}
+				msg, err = types.NewMsgCreateClientWithSeedConsensusStates(tendermintClient, consensusState, seeds, suite.chainA.SenderAccount.GetAddress().String())
+				suite.Require().NoError(err)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -189,6 +198,57 @@ func (suite *TypesTestSuite) TestMsgCreateClient_ValidateBasic() {
 			},
 			false,
 		},
+		{
+			"valid - tendermint client with seed consensus states",
+			func() {
+				tendermintClient := ibctm.NewClientState(suite.chainA.ChainID, ibctesting.DefaultTrustLevel, ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, ibctesting.MaxClockDrift, clientHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath)
+				consensusState := suite.chainA.CurrentTMClientHeader().ConsensusState()
+				seeds := []types.ConsensusStateWithHeight{
+					types.NewConsensusStateWithHeight(types.NewHeight(0, 1), consensusState),
+					types.NewConsensusStateWithHeight(types.NewHeight(0, 2), consensusState),
+				}
+				msg, err = types.NewMsgCreateClientWithSeedConsensusStates(tendermintClient, consensusState, seeds, suite.chainA.SenderAccount.GetAddress().String())
+				suite.Require().NoError(err)
+			},
+			true,
+		},
+		{
+			"invalid - seed consensus state height is zero",
+			func() {
+				msg.SeedConsensusStates[0].Height = types.ZeroHeight()
+			},
+			false,
+		},
+		{
+			"invalid - seed consensus state heights are not strictly increasing",
+			func() {
+				msg.SeedConsensusStates[0].Height = types.NewHeight(0, 2)
+			},
+			false,
+		},
+		{
+			"invalid - failed to unpack seed consensus state",
+			func() {
+				msg.SeedConsensusStates[0].Height = types.NewHeight(0, 1)
+				msg.SeedConsensusStates[0].ConsensusState = nil
+			},
+			false,
+		},
+		{
+			"invalid - client state and seed consensus state client types do not match",
+			func() {
+				soloMachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "", 2)
+				msg.SeedConsensusStates[0] = types.NewConsensusStateWithHeight(types.NewHeight(0, 1), soloMachine.ConsensusState())
+			},
+			false,
+		},
+		{
+			"invalid - invalid seed consensus state",
+			func() {
+				msg.SeedConsensusStates[0] = types.NewConsensusStateWithHeight(types.NewHeight(0, 1), &ibctm.ConsensusState{})
+			},
+			false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -332,6 +392,86 @@ func (suite *TypesTestSuite) TestMsgUpdateClient_ValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestMsgUpdateClientBatch_ValidateBasic() {
+	var msg *types.MsgUpdateClientBatch
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid - tendermint header",
+			func() {},
+			true,
+		},
+		{
+			"valid - tendermint and solomachine headers",
+			func() {
+				soloMachine := ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "", 2)
+				update, err := types.NewClientUpdate(soloMachine.ClientID, soloMachine.CreateHeader(soloMachine.Diversifier))
+				suite.Require().NoError(err)
+
+				msg.Updates = append(msg.Updates, update)
+			},
+			true,
+		},
+		{
+			"empty client updates",
+			func() {
+				msg.Updates = nil
+			},
+			false,
+		},
+		{
+			"invalid client-id",
+			func() {
+				msg.Updates = append(msg.Updates, types.ClientUpdate{ClientId: "", ClientMessage: msg.Updates[0].ClientMessage})
+			},
+			false,
+		},
+		{
+			"invalid tendermint header",
+			func() {
+				update, err := types.NewClientUpdate("tendermint", &ibctm.Header{})
+				suite.Require().NoError(err)
+
+				msg.Updates = append(msg.Updates, update)
+			},
+			false,
+		},
+		{
+			"failed to unpack header",
+			func() {
+				msg.Updates = append(msg.Updates, types.ClientUpdate{ClientId: "tendermint"})
+			},
+			false,
+		},
+		{
+			"invalid signer",
+			func() {
+				msg.Signer = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range cases {
+		update, err := types.NewClientUpdate("tendermint", suite.chainA.CurrentTMClientHeader())
+		suite.Require().NoError(err)
+
+		msg = types.NewMsgUpdateClientBatch([]types.ClientUpdate{update}, suite.chainA.SenderAccount.GetAddress().String())
+
+		tc.malleate()
+		err = msg.ValidateBasic()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
 func (suite *TypesTestSuite) TestMarshalMsgUpgradeClient() {
 	var (
 		msg *types.MsgUpgradeClient
@@ -665,6 +805,27 @@ func (suite *TypesTestSuite) TestMsgRecoverClientValidateBasic() {
 			},
 			types.ErrInvalidSubstitute,
 		},
+		{
+			"success: valid trusted heights",
+			func() {
+				msg.TrustedHeights = []types.Height{types.NewHeight(0, 5), types.NewHeight(1, 5)}
+			},
+			nil,
+		},
+		{
+			"failure: zero trusted height",
+			func() {
+				msg.TrustedHeights = []types.Height{types.ZeroHeight()}
+			},
+			types.ErrInvalidHeight,
+		},
+		{
+			"failure: duplicate trusted heights",
+			func() {
+				msg.TrustedHeights = []types.Height{types.NewHeight(0, 5), types.NewHeight(0, 5)}
+			},
+			types.ErrInvalidHeight,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -687,6 +848,85 @@ func (suite *TypesTestSuite) TestMsgRecoverClientValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestMsgRecoverClientsValidateBasic() {
+	var msg *types.MsgRecoverClients
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid signer and client recoveries",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: empty client recoveries",
+			func() {
+				msg.Recoveries = nil
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: invalid subject client ID",
+			func() {
+				msg.Recoveries[1].SubjectClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: subject and substitute client IDs are the same",
+			func() {
+				msg.Recoveries[1].SubstituteClientId = msg.Recoveries[1].SubjectClientId
+			},
+			types.ErrInvalidSubstitute,
+		},
+		{
+			"failure: zero trusted height",
+			func() {
+				msg.Recoveries[0].TrustedHeights = []types.Height{types.ZeroHeight()}
+			},
+			types.ErrInvalidHeight,
+		},
+		{
+			"failure: duplicate subject client",
+			func() {
+				msg.Recoveries[1].SubjectClientId = ibctesting.FirstClientID
+			},
+			types.ErrInvalidRecoveryClient,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgRecoverClients(
+			ibctesting.TestAccAddress,
+			[]types.ClientRecovery{
+				types.NewClientRecovery(ibctesting.FirstClientID, ibctesting.SecondClientID, nil),
+				types.NewClientRecovery("07-tendermint-2", "07-tendermint-3", nil),
+			},
+		)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		expPass := tc.expError == nil
+		if expPass {
+			suite.Require().NoError(err, "valid case %s failed", tc.name)
+		} else {
+			suite.Require().Error(err, "invalid case %s passed", tc.name)
+			suite.Require().ErrorIs(err, tc.expError, "invalid case %s passed", tc.name)
+		}
+	}
+}
+
 // TestMsgRecoverClientGetSigners tests GetSigners for MsgRecoverClient
 func TestMsgRecoverClientGetSigners(t *testing.T) {
 	testCases := []struct {
@@ -714,6 +954,156 @@ func TestMsgRecoverClientGetSigners(t *testing.T) {
 	}
 }
 
+// TestMsgSetClientAliasValidateBasic tests ValidateBasic for MsgSetClientAlias
+func (suite *TypesTestSuite) TestMsgSetClientAliasValidateBasic() {
+	var msg *types.MsgSetClientAlias
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid signer, client identifier and alias",
+			func() {},
+			nil,
+		},
+		{
+			"success: empty alias removes the alias",
+			func() {
+				msg.Alias = ""
+			},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid client ID",
+			func() {
+				msg.ClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: alias in client identifier format",
+			func() {
+				msg.Alias = ibctesting.SecondClientID
+			},
+			types.ErrInvalidClientAlias,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgSetClientAlias(ibctesting.TestAccAddress, ibctesting.FirstClientID, "osmosis")
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		expPass := tc.expError == nil
+		if expPass {
+			suite.Require().NoError(err, "valid case %s failed", tc.name)
+		} else {
+			suite.Require().Error(err, "invalid case %s passed", tc.name)
+			suite.Require().ErrorIs(err, tc.expError, "invalid case %s passed", tc.name)
+		}
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgReclaimClientDepositValidateBasic() {
+	var msg *types.MsgReclaimClientDeposit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid signer and client identifier",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid client ID",
+			func() {
+				msg.ClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgReclaimClientDeposit(ibctesting.TestAccAddress, ibctesting.FirstClientID)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		expPass := tc.expError == nil
+		if expPass {
+			suite.Require().NoError(err, "valid case %s failed", tc.name)
+		} else {
+			suite.Require().Error(err, "invalid case %s passed", tc.name)
+			suite.Require().ErrorIs(err, tc.expError, "invalid case %s passed", tc.name)
+		}
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgFreezeClientValidateBasic() {
+	var msg *types.MsgFreezeClient
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid signer and client identifier",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			func() {
+				msg.Signer = "invalid"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid client ID",
+			func() {
+				msg.ClientId = ""
+			},
+			host.ErrInvalidID,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgFreezeClient(ibctesting.TestAccAddress, ibctesting.FirstClientID)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		expPass := tc.expError == nil
+		if expPass {
+			suite.Require().NoError(err, "valid case %s failed", tc.name)
+		} else {
+			suite.Require().Error(err, "invalid case %s passed", tc.name)
+			suite.Require().ErrorIs(err, tc.expError, "invalid case %s passed", tc.name)
+		}
+	}
+}
+
 // TestMsgIBCSoftwareUpgrade_NewMsgIBCSoftwareUpgrade tests NewMsgIBCSoftwareUpgrade
 func (suite *TypesTestSuite) TestMsgIBCSoftwareUpgrade_NewMsgIBCSoftwareUpgrade() {
 	testCases := []struct {
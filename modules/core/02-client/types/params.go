@@ -4,6 +4,11 @@ import (
 	"fmt"
 	"slices"
 	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Maximum length of the allowed clients list
@@ -27,7 +32,11 @@ func DefaultParams() Params {
 
 // Validate all ibc-client module parameters
 func (p Params) Validate() error {
-	return validateClients(p.AllowedClients)
+	if err := validateClients(p.AllowedClients); err != nil {
+		return err
+	}
+
+	return validateClientDeposit(p.ClientCreationDeposit, p.ClientDepositGracePeriod, p.ClientDepositForfeiturePeriod)
 }
 
 // IsAllowedClient checks if the given client type is registered on the allowlist.
@@ -70,3 +79,21 @@ func validateClients(clients []string) error {
 
 	return nil
 }
+
+// validateClientDeposit checks that the client creation deposit consists of valid coins and that the grace and
+// forfeiture periods are not negative.
+func validateClientDeposit(deposit sdk.Coins, gracePeriod, forfeiturePeriod time.Duration) error {
+	if err := deposit.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidClientDeposit, "invalid client creation deposit: %v", err)
+	}
+
+	if gracePeriod < 0 {
+		return errorsmod.Wrapf(ErrInvalidClientDeposit, "client deposit grace period cannot be negative: %s", gracePeriod)
+	}
+
+	if forfeiturePeriod < 0 {
+		return errorsmod.Wrapf(ErrInvalidClientDeposit, "client deposit forfeiture period cannot be negative: %s", forfeiturePeriod)
+	}
+
+	return nil
+}
@@ -2,9 +2,14 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
@@ -41,6 +46,10 @@ func TestValidateParams(t *testing.T) {
 		{"duplicate clients", NewParams(exported.Tendermint, exported.Tendermint), false},
 		{"allow all clients plus valid client", NewParams(AllowAllClients, exported.Tendermint), false},
 		{"too many allowed clients", NewParams(make([]string, MaxAllowedClientsLength+1)...), false},
+		{"client creation deposit", Params{AllowedClients: DefaultAllowedClients, ClientCreationDeposit: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), ClientDepositGracePeriod: time.Hour, ClientDepositForfeiturePeriod: time.Hour}, true},
+		{"invalid client creation deposit", Params{AllowedClients: DefaultAllowedClients, ClientCreationDeposit: sdk.Coins{sdk.Coin{Denom: "", Amount: sdkmath.NewInt(1000)}}}, false},
+		{"negative client deposit grace period", Params{AllowedClients: DefaultAllowedClients, ClientDepositGracePeriod: -time.Hour}, false},
+		{"negative client deposit forfeiture period", Params{AllowedClients: DefaultAllowedClients, ClientDepositForfeiturePeriod: -time.Hour}, false},
 	}
 
 	for _, tc := range testCases {
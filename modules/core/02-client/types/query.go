@@ -11,6 +11,7 @@ var (
 	_ codectypes.UnpackInterfacesMessage = (*QueryClientStatesResponse)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*QueryConsensusStateResponse)(nil)
 	_ codectypes.UnpackInterfacesMessage = (*QueryConsensusStatesResponse)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*QueryVerifyHeaderAgainstClientRequest)(nil)
 )
 
 // UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
@@ -64,3 +65,8 @@ func NewQueryConsensusStateResponse(
 func (qcsr QueryConsensusStateResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(qcsr.ConsensusState, new(exported.ConsensusState))
 }
+
+// UnpackInterfaces implements UnpackInterfacesMesssage.UnpackInterfaces
+func (req QueryVerifyHeaderAgainstClientRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	return unpacker.UnpackAny(req.Header, new(exported.ClientMessage))
+}
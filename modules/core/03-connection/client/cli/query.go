@@ -118,6 +118,39 @@ func GetCmdQueryClientConnections() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryConnectionHealth defines the command to query the health of a connection
+func GetCmdQueryConnectionHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "health [connection-id]",
+		Short:   "Query the health of a connection",
+		Long:    "Query the state, client status and delay period of a connection and any issues which prevent it from being used",
+		Example: fmt.Sprintf("%s query %s %s health [connection-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConnectionHealthRequest{
+				ConnectionId: args[0],
+			}
+
+			res, err := queryClient.ConnectionHealth(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdConnectionParams returns the command handler for ibc connection parameter querying.
 func GetCmdConnectionParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -0,0 +1,15 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MembershipVerifierCacheLen returns the number of membership verifiers recorded in the cache of the context.
+func MembershipVerifierCacheLen(ctx sdk.Context) int {
+	cache, ok := getMembershipVerifierCache(ctx)
+	if !ok {
+		return 0
+	}
+
+	return len(cache.entries)
+}
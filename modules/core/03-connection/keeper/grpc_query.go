@@ -87,16 +87,18 @@ func (k *Keeper) ClientConnections(c context.Context, req *types.QueryClientConn
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 
-	if err := host.ClientIdentifierValidator(req.ClientId); err != nil {
+	ctx := sdk.UnwrapSDKContext(c)
+	clientID := k.clientKeeper.ResolveClientID(ctx, req.ClientId)
+
+	if err := host.ClientIdentifierValidator(clientID); err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	ctx := sdk.UnwrapSDKContext(c)
-	clientConnectionPaths, found := k.GetClientConnectionPaths(ctx, req.ClientId)
+	clientConnectionPaths, found := k.GetClientConnectionPaths(ctx, clientID)
 	if !found {
 		return nil, status.Error(
 			codes.NotFound,
-			errorsmod.Wrap(types.ErrClientConnectionPathsNotFound, req.ClientId).Error(),
+			errorsmod.Wrap(types.ErrClientConnectionPathsNotFound, clientID).Error(),
 		)
 	}
 
@@ -178,6 +180,31 @@ func (k *Keeper) ConnectionConsensusState(c context.Context, req *types.QueryCon
 	return types.NewQueryConnectionConsensusStateResponse(connection.ClientId, anyConsensusState, height, nil, proofHeight), nil
 }
 
+// ConnectionHealth implements the Query/ConnectionHealth gRPC method
+func (k *Keeper) ConnectionHealth(c context.Context, req *types.QueryConnectionHealthRequest) (*types.QueryConnectionHealthResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	connection, found := k.GetConnection(ctx, req.ConnectionId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrConnectionNotFound, "connection-id: %s", req.ConnectionId).Error(),
+		)
+	}
+
+	clientStatus := k.clientKeeper.GetClientStatus(ctx, connection.ClientId)
+
+	return types.NewQueryConnectionHealthResponse(connection, clientStatus, k.getBlockDelay(ctx, connection)), nil
+}
+
 // ConnectionParams implements the Query/ConnectionParams gRPC method.
 func (k *Keeper) ConnectionParams(c context.Context, req *types.QueryConnectionParamsRequest) (*types.QueryConnectionParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -2,12 +2,19 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -235,6 +242,23 @@ func (suite *KeeperTestSuite) TestQueryClientConnections() {
 			},
 			true,
 		},
+		{
+			"success: query by client alias",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupConnections()
+
+				err := suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientAlias(suite.chainA.GetContext(), path.EndpointA.ClientID, "cosmoshub")
+				suite.Require().NoError(err)
+
+				expPaths = []string{path.EndpointA.ConnectionID}
+
+				req = &types.QueryClientConnectionsRequest{
+					ClientId: "cosmoshub",
+				}
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -457,6 +481,135 @@ func (suite *KeeperTestSuite) TestQueryConnectionConsensusState() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryConnectionHealth() {
+	var (
+		req    *types.QueryConnectionHealthRequest
+		expRes *types.QueryConnectionHealthResponse
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: open connection",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.EndpointA.ConnectionConfig.DelayPeriod = uint64(time.Hour.Nanoseconds())
+				path.EndpointB.ConnectionConfig.DelayPeriod = uint64(time.Hour.Nanoseconds())
+				path.SetupConnections()
+
+				req = &types.QueryConnectionHealthRequest{
+					ConnectionId: path.EndpointA.ConnectionID,
+				}
+
+				expRes = &types.QueryConnectionHealthResponse{
+					State:        types.OPEN,
+					ClientId:     path.EndpointA.ClientID,
+					ClientStatus: exported.Active.String(),
+					Counterparty: path.EndpointA.GetConnection().Counterparty,
+					DelayPeriod:  uint64(time.Hour.Nanoseconds()),
+					BlockDelay:   uint64(time.Hour / types.DefaultTimePerBlock),
+				}
+			},
+			nil,
+		},
+		{
+			"success: connection in INIT with unknown counterparty connection",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupClients()
+				err := path.EndpointA.ConnOpenInit()
+				suite.Require().NoError(err)
+
+				req = &types.QueryConnectionHealthRequest{
+					ConnectionId: path.EndpointA.ConnectionID,
+				}
+
+				expRes = &types.QueryConnectionHealthResponse{
+					State:        types.INIT,
+					ClientId:     path.EndpointA.ClientID,
+					ClientStatus: exported.Active.String(),
+					Counterparty: path.EndpointA.GetConnection().Counterparty,
+					Issues:       []types.ConnectionHealthIssue{types.CONNECTION_NOT_OPEN, types.COUNTERPARTY_CONNECTION_UNKNOWN},
+				}
+			},
+			nil,
+		},
+		{
+			"success: client expired",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupConnections()
+
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod)
+
+				req = &types.QueryConnectionHealthRequest{
+					ConnectionId: path.EndpointA.ConnectionID,
+				}
+
+				expRes = &types.QueryConnectionHealthResponse{
+					State:        types.OPEN,
+					ClientId:     path.EndpointA.ClientID,
+					ClientStatus: exported.Expired.String(),
+					Counterparty: path.EndpointA.GetConnection().Counterparty,
+					Issues:       []types.ConnectionHealthIssue{types.CLIENT_EXPIRED},
+				}
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid connectionID",
+			func() {
+				req = &types.QueryConnectionHealthRequest{}
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"connection not found",
+			func() {
+				req = &types.QueryConnectionHealthRequest{
+					ConnectionId: ibctesting.InvalidID,
+				}
+			},
+			status.Error(codes.NotFound, errorsmod.Wrapf(types.ErrConnectionNotFound, "connection-id: %s", ibctesting.InvalidID).Error()),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.ConnectionHealth(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+				suite.Require().Equal(len(expRes.Issues) == 0, res.IsHealthy())
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryConnectionParams() {
 	ctx := suite.chainA.GetContext()
 	expParams := types.DefaultParams()
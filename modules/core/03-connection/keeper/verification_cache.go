@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// membershipVerifierCacheKey is the context key under which the membership verifier cache is stored.
+type membershipVerifierCacheKey struct{}
+
+// membershipVerifierCache records the membership verifiers created within the lifecycle of a single context,
+// usually a transaction. A verifier is created for the consensus state of a client at a proof height once the
+// client status has been checked, so an entry is only reused while the client state it was created for is unchanged.
+type membershipVerifierCache struct {
+	entries map[string]membershipVerifierCacheEntry
+}
+
+// membershipVerifierCacheEntry is a membership verifier together with the client state it was created for and
+// the gas consumed to create it.
+type membershipVerifierCacheEntry struct {
+	clientState []byte
+	verifier    exported.MembershipVerifier
+	gas         storetypes.Gas
+}
+
+// WithMembershipVerifierCache returns a copy of the context containing an empty membership verifier cache.
+// Packet commitments verified using the returned context, or any context derived from it, reuse the client status
+// and consensus state loaded for previous verifications against the same client and proof height, so that relaying
+// multiple packets within a single transaction only loads them once. Every proof is still verified and the gas of
+// the skipped reads is still charged, so the cache does not change the gas consumed by a transaction.
+// The cache lives as long as the context, it should be set on a per transaction basis, for example in an ante handler.
+func WithMembershipVerifierCache(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(membershipVerifierCacheKey{}, &membershipVerifierCache{
+		entries: make(map[string]membershipVerifierCacheEntry),
+	})
+}
+
+// getMembershipVerifierCache returns the membership verifier cache of the context, if one has been set.
+func getMembershipVerifierCache(ctx sdk.Context) (*membershipVerifierCache, bool) {
+	cache, ok := ctx.Value(membershipVerifierCacheKey{}).(*membershipVerifierCache)
+	return cache, ok && cache != nil
+}
+
+// get returns the membership verifier identified by the given key, and the gas consumed to create it, if it was
+// created for the given client state.
+func (c *membershipVerifierCache) get(key string, clientState []byte) (exported.MembershipVerifier, storetypes.Gas, bool) {
+	entry, found := c.entries[key]
+	if !found || !bytes.Equal(entry.clientState, clientState) {
+		return nil, 0, false
+	}
+
+	return entry.verifier, entry.gas, true
+}
+
+// add records the membership verifier identified by the given key, created for the given client state
+// by consuming the given amount of gas.
+func (c *membershipVerifierCache) add(key string, clientState []byte, verifier exported.MembershipVerifier, gas storetypes.Gas) {
+	c.entries[key] = membershipVerifierCacheEntry{
+		clientState: clientState,
+		verifier:    verifier,
+		gas:         gas,
+	}
+}
+
+// membershipVerifierKey returns the key identifying the membership verifier for the given client, proof height
+// and delay periods.
+func membershipVerifierKey(clientID string, height exported.Height, delayTimePeriod, delayBlockPeriod uint64) string {
+	return fmt.Sprintf("%s/%s/%d/%d", clientID, height, delayTimePeriod, delayBlockPeriod)
+}
@@ -12,6 +12,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
@@ -183,8 +184,47 @@ func (k *Keeper) VerifyChannelState(
 	return nil
 }
 
+// VerifyChannelStateForTimeout verifies a proof of the channel state of the specified
+// channel end, under the specified port, stored on the target machine. It must only be
+// used to time out packets on close, as verification against a frozen client is permitted
+// at heights prior to the misbehaviour if supported by the light client module.
+func (k *Keeper) VerifyChannelStateForTimeout(
+	ctx sdk.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	channel channeltypes.Channel,
+) error {
+	clientID := connection.ClientId
+
+	merklePath := commitmenttypes.NewMerklePath(host.ChannelPath(portID, channelID))
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&channel)
+	if err != nil {
+		return err
+	}
+
+	if err := k.verifyMembershipForTimeout(
+		ctx, clientID, height,
+		0, 0, // skip delay period checks for non-packet processing verification
+		proof, merklePath, bz,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed channel state verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyPacketCommitment verifies a proof of an outgoing packet commitment at
-// the specified port, specified channel, and specified sequence.
+// the specified port, specified channel, and specified sequence. Verification
+// against clients within their expiry grace period is permitted.
+// The commitment is verified as a batch of a single packet, see VerifyPacketCommitmentBatch.
 func (k *Keeper) VerifyPacketCommitment(
 	ctx sdk.Context,
 	connection types.ConnectionEnd,
@@ -195,30 +235,66 @@ func (k *Keeper) VerifyPacketCommitment(
 	sequence uint64,
 	commitmentBytes []byte,
 ) error {
-	clientID := connection.ClientId
-	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
-		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
-	}
+	return k.VerifyPacketCommitmentBatch(ctx, connection, height, [][]byte{proof}, portID, channelID, []uint64{sequence}, [][]byte{commitmentBytes})
+}
 
-	clientModule, found := k.clientKeeper.Route(clientID)
-	if !found {
-		return errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
+// VerifyPacketCommitmentBatch verifies proofs of multiple outgoing packet commitments at the specified port and channel
+// against the consensus state at a single proof height. The proofs, sequences and commitments are matched by index.
+// If the light client module implements the exported.BatchMembershipVerificationModule interface, the client status is
+// checked and the consensus state is loaded once for all packets, and once per transaction if the context contains a
+// membership verifier cache. Otherwise each packet commitment is verified with the VerifyMembership function of the
+// light client module. Every proof is verified individually.
+func (k *Keeper) VerifyPacketCommitmentBatch(
+	ctx sdk.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proofs [][]byte,
+	portID,
+	channelID string,
+	sequences []uint64,
+	commitments [][]byte,
+) error {
+	if len(proofs) != len(sequences) || len(commitments) != len(sequences) {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "number of proofs (%d), sequences (%d) and commitments (%d) must be equal", len(proofs), len(sequences), len(commitments))
 	}
 
-	// get time and block delays
-	timeDelay := connection.DelayPeriod
-	blockDelay := k.getBlockDelay(ctx, connection)
+	clientID := connection.ClientId
 
-	merklePath := commitmenttypes.NewMerklePath(host.PacketCommitmentPath(portID, channelID, sequence))
-	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
+	var (
+		verifier exported.MembershipVerifier
+		found    bool
+		err      error
+	)
+	// reuse the membership verifier created for a previous packet commitment verified within the lifecycle of the context
+	if cache, ok := getMembershipVerifierCache(ctx); ok {
+		verifier, found, err = k.cachedMembershipVerifier(ctx, cache, connection, height)
+	} else {
+		verifier, found, err = k.membershipVerifier(ctx, connection, height)
+	}
 	if err != nil {
 		return err
 	}
 
-	if err := clientModule.VerifyMembership(
-		ctx, clientID, height, timeDelay, blockDelay, proof, merklePath, commitmentBytes,
-	); err != nil {
-		return errorsmod.Wrapf(err, "failed packet commitment verification for client (%s)", clientID)
+	for i, sequence := range sequences {
+		merklePath := commitmenttypes.NewMerklePath(host.PacketCommitmentPath(portID, channelID, sequence))
+		merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			err = verifier.VerifyMembership(proofs[i], merklePath, commitments[i])
+		} else {
+			err = k.verifyPacketCommitment(ctx, connection, height, proofs[i], merklePath, commitments[i])
+		}
+
+		if err != nil {
+			if len(sequences) == 1 {
+				return errorsmod.Wrapf(err, "failed packet commitment verification for client (%s)", clientID)
+			}
+
+			return errorsmod.Wrapf(err, "failed packet commitment verification for client (%s) and packet sequence %d", clientID, sequence)
+		}
 	}
 
 	return nil
@@ -226,6 +302,7 @@ func (k *Keeper) VerifyPacketCommitment(
 
 // VerifyPacketAcknowledgement verifies a proof of an incoming packet
 // acknowledgement at the specified port, specified channel, and specified sequence.
+// Verification against clients within their expiry grace period is permitted.
 func (k *Keeper) VerifyPacketAcknowledgement(
 	ctx sdk.Context,
 	connection types.ConnectionEnd,
@@ -237,7 +314,7 @@ func (k *Keeper) VerifyPacketAcknowledgement(
 	acknowledgement []byte,
 ) error {
 	clientID := connection.ClientId
-	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active && status != exported.ExpiredGrace {
 		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
 	}
 
@@ -268,7 +345,9 @@ func (k *Keeper) VerifyPacketAcknowledgement(
 
 // VerifyPacketReceiptAbsence verifies a proof of the absence of an
 // incoming packet receipt at the specified port, specified channel, and
-// specified sequence.
+// specified sequence. The proof is only used to time out packets, thus
+// verification against a frozen client is permitted at heights prior to the
+// misbehaviour if supported by the light client module.
 func (k *Keeper) VerifyPacketReceiptAbsence(
 	ctx sdk.Context,
 	connection types.ConnectionEnd,
@@ -279,41 +358,68 @@ func (k *Keeper) VerifyPacketReceiptAbsence(
 	sequence uint64,
 ) error {
 	clientID := connection.ClientId
-	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
-		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
-	}
 
-	clientType, _, err := clienttypes.ParseClientIdentifier(clientID)
+	// get time and block delays
+	timeDelay := connection.DelayPeriod
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	merklePath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(portID, channelID, sequence))
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
 	if err != nil {
 		return err
 	}
 
-	clientModule, found := k.clientKeeper.Route(clientID)
-	if !found {
-		return errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientType)
+	if err := k.verifyNonMembershipForTimeout(
+		ctx, clientID, height, timeDelay, blockDelay, proof, merklePath,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed packet receipt absence verification for client (%s)", clientID)
 	}
 
+	return nil
+}
+
+// VerifyPacketTimeoutReceipt verifies a proof of the timeout receipt written by the
+// counterparty chain for a packet of an ORDERED_ALLOW_TIMEOUT channel which it did not
+// execute as the packet timed out. The proof is only used to time out packets, thus
+// verification against a frozen client is permitted at heights prior to the
+// misbehaviour if supported by the light client module.
+func (k *Keeper) VerifyPacketTimeoutReceipt(
+	ctx sdk.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	clientID := connection.ClientId
+
 	// get time and block delays
 	timeDelay := connection.DelayPeriod
 	blockDelay := k.getBlockDelay(ctx, connection)
 
 	merklePath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(portID, channelID, sequence))
-	merklePath, err = commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
 	if err != nil {
 		return err
 	}
 
-	if err := clientModule.VerifyNonMembership(
-		ctx, clientID, height, timeDelay, blockDelay, proof, merklePath,
+	if err := k.verifyMembershipForTimeout(
+		ctx, clientID, height,
+		timeDelay, blockDelay,
+		proof, merklePath, channeltypes.TimeoutReceipt,
 	); err != nil {
-		return errorsmod.Wrapf(err, "failed packet receipt absence verification for client (%s)", clientID)
+		return errorsmod.Wrapf(err, "failed packet timeout receipt verification for client (%s)", clientID)
 	}
 
 	return nil
 }
 
 // VerifyNextSequenceRecv verifies a proof of the next sequence number to be
-// received of the specified channel at the specified port.
+// received of the specified channel at the specified port. The proof is only
+// used to time out packets, thus verification against a frozen client is
+// permitted at heights prior to the misbehaviour if supported by the light
+// client module.
 func (k *Keeper) VerifyNextSequenceRecv(
 	ctx sdk.Context,
 	connection types.ConnectionEnd,
@@ -324,14 +430,6 @@ func (k *Keeper) VerifyNextSequenceRecv(
 	nextSequenceRecv uint64,
 ) error {
 	clientID := connection.ClientId
-	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
-		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
-	}
-
-	clientModule, found := k.clientKeeper.Route(clientID)
-	if !found {
-		return errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
-	}
 
 	// get time and block delays
 	timeDelay := connection.DelayPeriod
@@ -343,7 +441,7 @@ func (k *Keeper) VerifyNextSequenceRecv(
 		return err
 	}
 
-	if err := clientModule.VerifyMembership(
+	if err := k.verifyMembershipForTimeout(
 		ctx, clientID, height,
 		timeDelay, blockDelay,
 		proof, merklePath, sdk.Uint64ToBigEndian(nextSequenceRecv),
@@ -452,3 +550,154 @@ func (k *Keeper) getBlockDelay(ctx sdk.Context, connection types.ConnectionEnd)
 	timeDelay := connection.DelayPeriod
 	return uint64(math.Ceil(float64(timeDelay) / float64(expectedTimePerBlock)))
 }
+
+// membershipVerifier returns a verifier of membership proofs against the consensus state of the connection client at
+// the given height, once the client has been checked to be active or within its expiry grace period. A false boolean is
+// returned if the light client module does not implement the exported.BatchMembershipVerificationModule interface.
+func (k *Keeper) membershipVerifier(ctx sdk.Context, connection types.ConnectionEnd, height exported.Height) (exported.MembershipVerifier, bool, error) {
+	clientID := connection.ClientId
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active && status != exported.ExpiredGrace {
+		return nil, false, errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	clientModule, found := k.clientKeeper.Route(clientID)
+	if !found {
+		return nil, false, errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
+	}
+
+	batchModule, ok := clientModule.(exported.BatchMembershipVerificationModule)
+	if !ok {
+		return nil, false, nil
+	}
+
+	verifier, err := batchModule.MembershipVerifier(ctx, clientID, height, connection.DelayPeriod, k.getBlockDelay(ctx, connection))
+	if err != nil {
+		return nil, false, errorsmod.Wrapf(err, "failed to create membership verifier for client (%s)", clientID)
+	}
+
+	return verifier, true, nil
+}
+
+// verifyPacketCommitment verifies a proof of an outgoing packet commitment at the given merkle path with the
+// VerifyMembership function of the light client module, once the client has been checked to be active or within its
+// expiry grace period.
+func (k *Keeper) verifyPacketCommitment(
+	ctx sdk.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	merklePath exported.Path,
+	commitmentBytes []byte,
+) error {
+	clientID := connection.ClientId
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active && status != exported.ExpiredGrace {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	clientModule, found := k.clientKeeper.Route(clientID)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
+	}
+
+	// get time and block delays
+	timeDelay := connection.DelayPeriod
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	return clientModule.VerifyMembership(ctx, clientID, height, timeDelay, blockDelay, proof, merklePath, commitmentBytes)
+}
+
+// cachedMembershipVerifier returns the membership verifier recorded in the cache for the connection client and the given
+// height if the client state is unchanged since the verifier was created. Otherwise a new membership verifier is created
+// and recorded in the cache, see membershipVerifier. The gas consumed to create the verifier is recorded alongside it and
+// charged again on every cache hit, so that the gas consumed by a packet commitment verification does not depend on the
+// verifications which preceded it within the same transaction.
+func (k *Keeper) cachedMembershipVerifier(ctx sdk.Context, cache *membershipVerifierCache, connection types.ConnectionEnd, height exported.Height) (exported.MembershipVerifier, bool, error) {
+	clientID := connection.ClientId
+	clientState := k.clientKeeper.ClientStore(ctx, clientID).Get(host.ClientStateKey())
+	key := membershipVerifierKey(clientID, height, connection.DelayPeriod, k.getBlockDelay(ctx, connection))
+
+	if verifier, gas, found := cache.get(key, clientState); found {
+		// charge the client status check and consensus state load skipped by reusing the verifier
+		ctx.GasMeter().ConsumeGas(gas, "cached membership verifier")
+		return verifier, true, nil
+	}
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	verifier, found, err := k.membershipVerifier(ctx, connection, height)
+	if err != nil || !found {
+		return nil, false, err
+	}
+
+	cache.add(key, clientState, verifier, ctx.GasMeter().GasConsumed()-gasBefore)
+
+	return verifier, true, nil
+}
+
+// verifyMembershipForTimeout verifies a membership proof used to time out a packet. Active clients, and clients within
+// their expiry grace period, verify the proof as usual. Frozen clients may only verify the proof if the light client module implements the
+// exported.TimeoutVerificationModule interface.
+func (k *Keeper) verifyMembershipForTimeout(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	status := k.clientKeeper.GetClientStatus(ctx, clientID)
+	if status != exported.Active && status != exported.ExpiredGrace && status != exported.Frozen {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	clientModule, found := k.clientKeeper.Route(clientID)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
+	}
+
+	if status == exported.Active || status == exported.ExpiredGrace {
+		return clientModule.VerifyMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
+	}
+
+	timeoutModule, ok := clientModule.(exported.TimeoutVerificationModule)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	return timeoutModule.VerifyMembershipForTimeout(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
+}
+
+// verifyNonMembershipForTimeout verifies a non-membership proof used to time out a packet. Active clients, and clients
+// within their expiry grace period, verify the proof as usual. Frozen clients may only verify the proof if the light client module implements the
+// exported.TimeoutVerificationModule interface.
+func (k *Keeper) verifyNonMembershipForTimeout(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	status := k.clientKeeper.GetClientStatus(ctx, clientID)
+	if status != exported.Active && status != exported.ExpiredGrace && status != exported.Frozen {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	clientModule, found := k.clientKeeper.Route(clientID)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrRouteNotFound, clientID)
+	}
+
+	if status == exported.Active || status == exported.ExpiredGrace {
+		return clientModule.VerifyNonMembership(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path)
+	}
+
+	timeoutModule, ok := clientModule.(exported.TimeoutVerificationModule)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	return timeoutModule.VerifyNonMembershipForTimeout(ctx, clientID, height, delayTimePeriod, delayBlockPeriod, proof, path)
+}
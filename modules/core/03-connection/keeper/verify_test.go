@@ -4,10 +4,17 @@ import (
 	"fmt"
 	"time"
 
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/keeper"
 	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
@@ -319,6 +326,22 @@ func (suite *KeeperTestSuite) TestVerifyPacketCommitment() {
 			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
 			path.EndpointB.SetClientState(clientState)
 		}, false},
+		{"verification success: client within expiry grace period", func() {
+			clientState, ok := path.EndpointB.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			clientState.ExpiryGracePeriod = time.Hour
+			path.EndpointB.SetClientState(clientState)
+
+			suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod)
+		}, true},
+		{"client status is not active - client expired after expiry grace period", func() {
+			clientState, ok := path.EndpointB.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			clientState.ExpiryGracePeriod = time.Hour
+			path.EndpointB.SetClientState(clientState)
+
+			suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod + time.Hour)
+		}, false},
 	}
 
 	for _, tc := range cases {
@@ -365,6 +388,238 @@ func (suite *KeeperTestSuite) TestVerifyPacketCommitment() {
 	}
 }
 
+// TestVerifyPacketCommitmentBatch has chainB verify the packet commitments of
+// multiple packets on channelA at the same proof height.
+func (suite *KeeperTestSuite) TestVerifyPacketCommitmentBatch() {
+	var (
+		path        *ibctesting.Path
+		proofs      [][]byte
+		sequences   []uint64
+		commitments [][]byte
+		heightDiff  uint64
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{"verification success", func() {}, nil},
+		{"verification success: empty batch", func() {
+			proofs, sequences, commitments = nil, nil, nil
+		}, nil},
+		{"mismatched number of proofs and sequences", func() {
+			proofs = proofs[1:]
+		}, ibcerrors.ErrInvalidRequest},
+		{"mismatched number of commitments and sequences", func() {
+			commitments = commitments[1:]
+		}, ibcerrors.ErrInvalidRequest},
+		{"client not updated - increased proof height", func() {
+			heightDiff = 5
+		}, ibcerrors.ErrInvalidHeight},
+		{"verification failed - changed packet commitment state", func() {
+			commitments[1] = []byte(ibctesting.InvalidID)
+		}, commitmenttypes.ErrInvalidProof},
+		{"verification failed - proof for a different packet", func() {
+			proofs[0] = proofs[1]
+		}, commitmenttypes.ErrInvalidProof},
+		{"client status is not active - client is frozen", func() {
+			clientState, ok := path.EndpointB.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointB.SetClientState(clientState)
+		}, clienttypes.ErrClientNotActive},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			var packets []channeltypes.Packet
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packets = append(packets, channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0))
+			}
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			proofs, sequences, commitments = nil, nil, nil
+			var proofHeight clienttypes.Height
+			for _, packet := range packets {
+				var proof []byte
+				proof, proofHeight = suite.chainA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+				proofs = append(proofs, proof)
+				sequences = append(sequences, packet.GetSequence())
+				commitments = append(commitments, channeltypes.CommitPacket(suite.chainB.App.GetIBCKeeper().Codec(), packet))
+			}
+
+			heightDiff = 0
+			tc.malleate()
+
+			err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketCommitmentBatch(
+				suite.chainB.GetContext(), path.EndpointB.GetConnection(), malleateHeight(proofHeight, heightDiff), proofs,
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequences, commitments,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestVerifyPacketCommitmentWithCache has chainB verify the packet commitments
+// of multiple packets on channelA using a membership verifier cache.
+func (suite *KeeperTestSuite) TestVerifyPacketCommitmentWithCache() {
+	var (
+		path    *ibctesting.Path
+		packets []channeltypes.Packet
+	)
+
+	verifyPacketCommitment := func(ctx sdk.Context, packet channeltypes.Packet, proofHeight exported.Height) error {
+		proof, _ := suite.chainA.QueryProofAtHeight(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()), int64(proofHeight.GetRevisionHeight()))
+		commitment := channeltypes.CommitPacket(suite.chainB.App.GetIBCKeeper().Codec(), packet)
+
+		return suite.chainB.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketCommitment(
+			ctx, path.EndpointB.GetConnection(), proofHeight, proof,
+			packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), commitment,
+		)
+	}
+
+	cases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context, proofHeight exported.Height)
+		expErr      error
+		expCacheLen int
+	}{
+		{
+			"success: verifier is reused for packets at the same proof height",
+			func(ctx sdk.Context, proofHeight exported.Height) {
+				suite.Require().NoError(verifyPacketCommitment(ctx, packets[0], proofHeight))
+				suite.Require().Equal(1, keeper.MembershipVerifierCacheLen(ctx))
+			},
+			nil,
+			1,
+		},
+		{
+			"success: verifier is created and cached on first use",
+			func(ctx sdk.Context, proofHeight exported.Height) {},
+			nil,
+			1,
+		},
+		{
+			"failure: cached verifier does not skip proof verification",
+			func(ctx sdk.Context, proofHeight exported.Height) {
+				suite.Require().NoError(verifyPacketCommitment(ctx, packets[0], proofHeight))
+
+				packets[1].Data = []byte(ibctesting.InvalidID)
+			},
+			commitmenttypes.ErrInvalidProof,
+			1,
+		},
+		{
+			"failure: cached verifier is not reused once the client is frozen",
+			func(ctx sdk.Context, proofHeight exported.Height) {
+				suite.Require().NoError(verifyPacketCommitment(ctx, packets[0], proofHeight))
+
+				clientState, ok := path.EndpointB.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				suite.chainB.App.GetIBCKeeper().ClientKeeper.SetClientState(ctx, path.EndpointB.ClientID, clientState)
+			},
+			clienttypes.ErrClientNotActive,
+			1,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			packets = nil
+			for i := 0; i < 2; i++ {
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packets = append(packets, channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0))
+			}
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			proofHeight := path.EndpointB.GetClientLatestHeight()
+
+			ctx := keeper.WithMembershipVerifierCache(suite.chainB.GetContext())
+			tc.malleate(ctx, proofHeight)
+
+			err := verifyPacketCommitment(ctx, packets[1], proofHeight)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+
+			suite.Require().Equal(tc.expCacheLen, keeper.MembershipVerifierCacheLen(ctx))
+		})
+	}
+}
+
+// TestVerifyPacketCommitmentWithCacheGas asserts that reusing a cached membership
+// verifier still charges the gas of the reads skipped by the cache hit.
+func (suite *KeeperTestSuite) TestVerifyPacketCommitmentWithCacheGas() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	var packets []channeltypes.Packet
+	for i := 0; i < 2; i++ {
+		sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, 0, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packets = append(packets, channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0))
+	}
+
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+	proofHeight := path.EndpointB.GetClientLatestHeight()
+
+	verifyPacketCommitment := func(ctx sdk.Context, packet channeltypes.Packet) storetypes.Gas {
+		proof, _ := suite.chainA.QueryProofAtHeight(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()), int64(proofHeight.GetRevisionHeight()))
+		commitment := channeltypes.CommitPacket(suite.chainB.App.GetIBCKeeper().Codec(), packet)
+
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		err := suite.chainB.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketCommitment(
+			ctx, path.EndpointB.GetConnection(), proofHeight, proof,
+			packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), commitment,
+		)
+		suite.Require().NoError(err)
+
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	gasWithoutCache := verifyPacketCommitment(suite.chainB.GetContext(), packets[1])
+
+	ctx := keeper.WithMembershipVerifierCache(suite.chainB.GetContext())
+	gasCacheMiss := verifyPacketCommitment(ctx, packets[0])
+	gasCacheHit := verifyPacketCommitment(ctx, packets[1])
+
+	suite.Require().Equal(1, keeper.MembershipVerifierCacheLen(ctx))
+	suite.Require().Equal(gasCacheMiss, gasCacheHit)
+	suite.Require().GreaterOrEqual(gasCacheHit, gasWithoutCache)
+}
+
 // TestVerifyPacketAcknowledgement has chainA verify the acknowledgement on
 // channelB. The channels on chainA and chainB are fully opened and a packet
 // is sent from chainA to chainB and received.
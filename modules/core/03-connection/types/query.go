@@ -69,3 +69,53 @@ func NewQueryConnectionConsensusStateResponse(clientID string, anyConsensusState
 func (qccsr QueryConnectionConsensusStateResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
 	return unpacker.UnpackAny(qccsr.ConsensusState, new(exported.ConsensusState))
 }
+
+// NewQueryConnectionHealthResponse creates a new QueryConnectionHealthResponse instance for a connection end and the
+// status of its client, reporting the issues detected from the connection state and the client status
+func NewQueryConnectionHealthResponse(connection ConnectionEnd, clientStatus exported.Status, blockDelay uint64) *QueryConnectionHealthResponse {
+	var issues []ConnectionHealthIssue
+	if connection.State != OPEN {
+		issues = append(issues, CONNECTION_NOT_OPEN)
+	}
+
+	if connection.Counterparty.ConnectionId == "" {
+		issues = append(issues, COUNTERPARTY_CONNECTION_UNKNOWN)
+	}
+
+	// a client within its expiry grace period cannot be used to open channels and is reported as expired
+	switch clientStatus {
+	case exported.Active:
+		// no issue
+	case exported.Expired, exported.ExpiredGrace:
+		issues = append(issues, CLIENT_EXPIRED)
+	case exported.Frozen:
+		issues = append(issues, CLIENT_FROZEN)
+	default:
+		issues = append(issues, CLIENT_NOT_ACTIVE)
+	}
+
+	return &QueryConnectionHealthResponse{
+		State:        connection.State,
+		ClientId:     connection.ClientId,
+		ClientStatus: clientStatus.String(),
+		Counterparty: connection.Counterparty,
+		DelayPeriod:  connection.DelayPeriod,
+		BlockDelay:   blockDelay,
+		Issues:       issues,
+	}
+}
+
+// SetCounterpartyClientStatus sets the status of the counterparty client, as queried from the counterparty chain,
+// and reports an issue if the counterparty client is not active. It allows relayers to extend the response of the
+// queried chain with the counterparty data.
+func (r *QueryConnectionHealthResponse) SetCounterpartyClientStatus(status exported.Status) {
+	r.CounterpartyClientStatus = status.String()
+	if status != exported.Active {
+		r.Issues = append(r.Issues, COUNTERPARTY_CLIENT_NOT_ACTIVE)
+	}
+}
+
+// IsHealthy returns true if no issues were detected for the connection.
+func (r QueryConnectionHealthResponse) IsHealthy() bool {
+	return len(r.Issues) == 0
+}
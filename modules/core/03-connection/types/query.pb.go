@@ -32,6 +32,54 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ConnectionHealthIssue defines an issue detected by the Query/ConnectionHealth RPC method.
+type ConnectionHealthIssue int32
+
+const (
+	// Default issue
+	UNSPECIFIED ConnectionHealthIssue = 0
+	// The connection has not completed the opening handshake.
+	CONNECTION_NOT_OPEN ConnectionHealthIssue = 1
+	// The counterparty connection identifier is not yet known.
+	COUNTERPARTY_CONNECTION_UNKNOWN ConnectionHealthIssue = 2
+	// The client has expired.
+	CLIENT_EXPIRED ConnectionHealthIssue = 3
+	// The client is frozen.
+	CLIENT_FROZEN ConnectionHealthIssue = 4
+	// The client is not active for another reason.
+	CLIENT_NOT_ACTIVE ConnectionHealthIssue = 5
+	// The counterparty client is not active.
+	COUNTERPARTY_CLIENT_NOT_ACTIVE ConnectionHealthIssue = 6
+)
+
+var ConnectionHealthIssue_name = map[int32]string{
+	0: "CONNECTION_HEALTH_ISSUE_UNSPECIFIED",
+	1: "CONNECTION_HEALTH_ISSUE_CONNECTION_NOT_OPEN",
+	2: "CONNECTION_HEALTH_ISSUE_COUNTERPARTY_CONNECTION_UNKNOWN",
+	3: "CONNECTION_HEALTH_ISSUE_CLIENT_EXPIRED",
+	4: "CONNECTION_HEALTH_ISSUE_CLIENT_FROZEN",
+	5: "CONNECTION_HEALTH_ISSUE_CLIENT_NOT_ACTIVE",
+	6: "CONNECTION_HEALTH_ISSUE_COUNTERPARTY_CLIENT_NOT_ACTIVE",
+}
+
+var ConnectionHealthIssue_value = map[string]int32{
+	"CONNECTION_HEALTH_ISSUE_UNSPECIFIED":                     0,
+	"CONNECTION_HEALTH_ISSUE_CONNECTION_NOT_OPEN":             1,
+	"CONNECTION_HEALTH_ISSUE_COUNTERPARTY_CONNECTION_UNKNOWN": 2,
+	"CONNECTION_HEALTH_ISSUE_CLIENT_EXPIRED":                  3,
+	"CONNECTION_HEALTH_ISSUE_CLIENT_FROZEN":                   4,
+	"CONNECTION_HEALTH_ISSUE_CLIENT_NOT_ACTIVE":               5,
+	"CONNECTION_HEALTH_ISSUE_COUNTERPARTY_CLIENT_NOT_ACTIVE":  6,
+}
+
+func (x ConnectionHealthIssue) String() string {
+	return proto.EnumName(ConnectionHealthIssue_name, int32(x))
+}
+
+func (ConnectionHealthIssue) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cd8d529f8c7cd06b, []int{0}
+}
+
 // QueryConnectionRequest is the request type for the Query/Connection RPC
 // method
 type QueryConnectionRequest struct {
@@ -259,7 +307,7 @@ func (m *QueryConnectionsResponse) GetHeight() types.Height {
 // QueryClientConnectionsRequest is the request type for the
 // Query/ClientConnections RPC method
 type QueryClientConnectionsRequest struct {
-	// client identifier associated with a connection
+	// client identifier or alias associated with a connection
 	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 }
 
@@ -700,7 +748,165 @@ func (m *QueryConnectionParamsResponse) GetParams() *Params {
 	return nil
 }
 
+// QueryConnectionHealthRequest is the request type for the Query/ConnectionHealth RPC method
+type QueryConnectionHealthRequest struct {
+	// connection unique identifier
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+}
+
+func (m *QueryConnectionHealthRequest) Reset()         { *m = QueryConnectionHealthRequest{} }
+func (m *QueryConnectionHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConnectionHealthRequest) ProtoMessage()    {}
+func (*QueryConnectionHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd8d529f8c7cd06b, []int{12}
+}
+func (m *QueryConnectionHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConnectionHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConnectionHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConnectionHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConnectionHealthRequest.Merge(m, src)
+}
+func (m *QueryConnectionHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConnectionHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConnectionHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConnectionHealthRequest proto.InternalMessageInfo
+
+func (m *QueryConnectionHealthRequest) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+// QueryConnectionHealthResponse is the response type for the Query/ConnectionHealth RPC method.
+// The counterparty client status is not known to the queried chain and may be set by relayers
+// from a query of the counterparty chain.
+type QueryConnectionHealthResponse struct {
+	// current state of the connection end
+	State State `protobuf:"varint,1,opt,name=state,proto3,enum=ibc.core.connection.v1.State" json:"state,omitempty"`
+	// client associated with the connection
+	ClientId string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// status of the client associated with the connection
+	ClientStatus string `protobuf:"bytes,3,opt,name=client_status,json=clientStatus,proto3" json:"client_status,omitempty"`
+	// counterparty chain associated with the connection
+	Counterparty Counterparty `protobuf:"bytes,4,opt,name=counterparty,proto3" json:"counterparty"`
+	// status of the counterparty client, empty if unknown
+	CounterpartyClientStatus string `protobuf:"bytes,5,opt,name=counterparty_client_status,json=counterpartyClientStatus,proto3" json:"counterparty_client_status,omitempty"`
+	// delay period of the connection in nanoseconds
+	DelayPeriod uint64 `protobuf:"varint,6,opt,name=delay_period,json=delayPeriod,proto3" json:"delay_period,omitempty"`
+	// minimum number of blocks which must pass for the delay period to elapse
+	BlockDelay uint64 `protobuf:"varint,7,opt,name=block_delay,json=blockDelay,proto3" json:"block_delay,omitempty"`
+	// issues detected which prevent the connection from being used
+	Issues []ConnectionHealthIssue `protobuf:"varint,8,rep,packed,name=issues,proto3,enum=ibc.core.connection.v1.ConnectionHealthIssue" json:"issues,omitempty"`
+}
+
+func (m *QueryConnectionHealthResponse) Reset()         { *m = QueryConnectionHealthResponse{} }
+func (m *QueryConnectionHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConnectionHealthResponse) ProtoMessage()    {}
+func (*QueryConnectionHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd8d529f8c7cd06b, []int{13}
+}
+func (m *QueryConnectionHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConnectionHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConnectionHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConnectionHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConnectionHealthResponse.Merge(m, src)
+}
+func (m *QueryConnectionHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConnectionHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConnectionHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConnectionHealthResponse proto.InternalMessageInfo
+
+func (m *QueryConnectionHealthResponse) GetState() State {
+	if m != nil {
+		return m.State
+	}
+	return UNINITIALIZED
+}
+
+func (m *QueryConnectionHealthResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *QueryConnectionHealthResponse) GetClientStatus() string {
+	if m != nil {
+		return m.ClientStatus
+	}
+	return ""
+}
+
+func (m *QueryConnectionHealthResponse) GetCounterparty() Counterparty {
+	if m != nil {
+		return m.Counterparty
+	}
+	return Counterparty{}
+}
+
+func (m *QueryConnectionHealthResponse) GetCounterpartyClientStatus() string {
+	if m != nil {
+		return m.CounterpartyClientStatus
+	}
+	return ""
+}
+
+func (m *QueryConnectionHealthResponse) GetDelayPeriod() uint64 {
+	if m != nil {
+		return m.DelayPeriod
+	}
+	return 0
+}
+
+func (m *QueryConnectionHealthResponse) GetBlockDelay() uint64 {
+	if m != nil {
+		return m.BlockDelay
+	}
+	return 0
+}
+
+func (m *QueryConnectionHealthResponse) GetIssues() []ConnectionHealthIssue {
+	if m != nil {
+		return m.Issues
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("ibc.core.connection.v1.ConnectionHealthIssue", ConnectionHealthIssue_name, ConnectionHealthIssue_value)
 	proto.RegisterType((*QueryConnectionRequest)(nil), "ibc.core.connection.v1.QueryConnectionRequest")
 	proto.RegisterType((*QueryConnectionResponse)(nil), "ibc.core.connection.v1.QueryConnectionResponse")
 	proto.RegisterType((*QueryConnectionsRequest)(nil), "ibc.core.connection.v1.QueryConnectionsRequest")
@@ -713,6 +919,8 @@ func init() {
 	proto.RegisterType((*QueryConnectionConsensusStateResponse)(nil), "ibc.core.connection.v1.QueryConnectionConsensusStateResponse")
 	proto.RegisterType((*QueryConnectionParamsRequest)(nil), "ibc.core.connection.v1.QueryConnectionParamsRequest")
 	proto.RegisterType((*QueryConnectionParamsResponse)(nil), "ibc.core.connection.v1.QueryConnectionParamsResponse")
+	proto.RegisterType((*QueryConnectionHealthRequest)(nil), "ibc.core.connection.v1.QueryConnectionHealthRequest")
+	proto.RegisterType((*QueryConnectionHealthResponse)(nil), "ibc.core.connection.v1.QueryConnectionHealthResponse")
 }
 
 func init() {
@@ -720,66 +928,91 @@ func init() {
 }
 
 var fileDescriptor_cd8d529f8c7cd06b = []byte{
-	// 934 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0xdc, 0x44,
-	0x14, 0xce, 0x6c, 0xd2, 0x88, 0xbc, 0x0d, 0x6d, 0x19, 0xa5, 0xed, 0x62, 0x8a, 0x1b, 0x5c, 0xd2,
-	0xa4, 0x40, 0x67, 0xba, 0x09, 0x89, 0x02, 0x24, 0x08, 0x52, 0x15, 0x92, 0x4b, 0x15, 0x8c, 0x04,
-	0x12, 0x97, 0xc8, 0xf6, 0x4e, 0x1c, 0x4b, 0x59, 0xcf, 0x76, 0xc7, 0xbb, 0x28, 0xaa, 0x22, 0x24,
-	0x7e, 0x01, 0x12, 0x17, 0x2e, 0xbd, 0x82, 0xc4, 0x3f, 0x40, 0xdc, 0x38, 0xf5, 0x58, 0x89, 0x4b,
-	0x4f, 0x15, 0x4a, 0xb8, 0xf2, 0x1f, 0x90, 0x67, 0xc6, 0xf5, 0x78, 0x77, 0x9d, 0xec, 0xae, 0x94,
-	0x9b, 0xf7, 0xcd, 0x7b, 0x6f, 0xbe, 0xef, 0x7b, 0xcf, 0x9f, 0x17, 0x9c, 0xc8, 0x0f, 0x68, 0xc0,
-	0xdb, 0x8c, 0x06, 0x3c, 0x8e, 0x59, 0x90, 0x44, 0x3c, 0xa6, 0xdd, 0x3a, 0x7d, 0xdc, 0x61, 0xed,
-	0x23, 0xd2, 0x6a, 0xf3, 0x84, 0xe3, 0xeb, 0x91, 0x1f, 0x90, 0x34, 0x87, 0xe4, 0x39, 0xa4, 0x5b,
-	0xb7, 0xe6, 0x42, 0x1e, 0x72, 0x99, 0x42, 0xd3, 0x27, 0x95, 0x6d, 0xbd, 0x17, 0x70, 0xd1, 0xe4,
-	0x82, 0xfa, 0x9e, 0x60, 0xaa, 0x0d, 0xed, 0xd6, 0x7d, 0x96, 0x78, 0x75, 0xda, 0xf2, 0xc2, 0x28,
-	0xf6, 0x64, 0xb9, 0xca, 0xbd, 0x95, 0xdf, 0x7e, 0x18, 0xb1, 0x38, 0x49, 0x6f, 0x56, 0x4f, 0x3a,
-	0x61, 0xb1, 0x04, 0x9e, 0x01, 0x44, 0x25, 0xde, 0x0c, 0x39, 0x0f, 0x0f, 0x19, 0xf5, 0x5a, 0x11,
-	0xf5, 0xe2, 0x98, 0x27, 0xf2, 0x1a, 0xa1, 0x4f, 0xdf, 0xd4, 0xa7, 0xf2, 0x97, 0xdf, 0xd9, 0xa7,
-	0x5e, 0xac, 0xc9, 0x39, 0x9b, 0x70, 0xfd, 0xab, 0x14, 0xe4, 0x83, 0x57, 0x1d, 0x5d, 0xf6, 0xb8,
-	0xc3, 0x44, 0x82, 0x6f, 0xc3, 0xeb, 0xf9, 0x35, 0x7b, 0x51, 0xa3, 0x86, 0xe6, 0xd1, 0xd2, 0x8c,
-	0x3b, 0x9b, 0x07, 0x77, 0x1a, 0xce, 0x9f, 0x08, 0x6e, 0xf4, 0xd5, 0x8b, 0x16, 0x8f, 0x05, 0xc3,
-	0x0f, 0x01, 0xf2, 0x5c, 0x59, 0x5d, 0x5d, 0x5e, 0x20, 0x83, 0xc5, 0x24, 0x79, 0xfd, 0xc3, 0xb8,
-	0xe1, 0x1a, 0x85, 0x78, 0x0e, 0x2e, 0xb5, 0xda, 0x9c, 0xef, 0xd7, 0x2a, 0xf3, 0x68, 0x69, 0xd6,
-	0x55, 0x3f, 0xf0, 0x03, 0x98, 0x95, 0x0f, 0x7b, 0x07, 0x2c, 0x0a, 0x0f, 0x92, 0xda, 0xa4, 0x6c,
-	0x6f, 0x19, 0xed, 0x95, 0x8e, 0xdd, 0x3a, 0xd9, 0x96, 0x19, 0x5b, 0x53, 0xcf, 0x5e, 0xde, 0x9a,
-	0x70, 0xab, 0xb2, 0x4a, 0x85, 0x1c, 0xaf, 0x0f, 0xbc, 0xc8, 0xd8, 0x7f, 0x01, 0x90, 0x8f, 0x4b,
-	0x83, 0xbf, 0x43, 0xd4, 0x6c, 0x49, 0x3a, 0x5b, 0xa2, 0x56, 0x44, 0xcf, 0x96, 0xec, 0x7a, 0x21,
-	0xd3, 0xb5, 0xae, 0x51, 0xe9, 0xfc, 0x87, 0xa0, 0xd6, 0x7f, 0x87, 0x56, 0xe8, 0x11, 0x54, 0x73,
-	0xa2, 0xa2, 0x86, 0xe6, 0x27, 0x97, 0xaa, 0xcb, 0x1f, 0x94, 0x49, 0xb4, 0xd3, 0x60, 0x71, 0x12,
-	0xed, 0x47, 0xac, 0x61, 0x88, 0x6d, 0x36, 0xc0, 0x5f, 0x16, 0x40, 0x57, 0x24, 0xe8, 0xc5, 0x73,
-	0x41, 0x2b, 0x30, 0x26, 0x6a, 0xbc, 0x0e, 0xd3, 0x23, 0xea, 0xaa, 0xf3, 0x9d, 0x0d, 0x78, 0x5b,
-	0xd1, 0x95, 0x69, 0x03, 0x84, 0x7d, 0x0b, 0x66, 0x54, 0x8b, 0x7c, 0xa5, 0x5e, 0x53, 0x81, 0x9d,
-	0x86, 0xf3, 0x2b, 0x02, 0xbb, 0xac, 0x5c, 0x6b, 0x76, 0x17, 0xae, 0x1a, 0x6b, 0xd9, 0xf2, 0x92,
-	0x03, 0x25, 0xdc, 0x8c, 0x7b, 0x25, 0x8f, 0xef, 0xa6, 0xe1, 0x8b, 0xdc, 0x9c, 0x6d, 0x78, 0xa7,
-	0x67, 0xaa, 0x0a, 0xf1, 0xd7, 0x89, 0x97, 0xb0, 0x91, 0xde, 0xa0, 0x13, 0x04, 0xce, 0x59, 0xad,
-	0x34, 0x6d, 0x0f, 0x6e, 0x44, 0xaf, 0xe6, 0xbf, 0xa7, 0x15, 0x14, 0x69, 0x8a, 0x5e, 0xce, 0xbb,
-	0x83, 0x08, 0x18, 0x2b, 0x63, 0xf4, 0xbc, 0x16, 0x0d, 0x0a, 0x5f, 0xa4, 0x5c, 0x4f, 0x11, 0xbc,
-	0xdb, 0x4b, 0x32, 0xa5, 0x15, 0x8b, 0x8e, 0x18, 0x59, 0x32, 0xbc, 0x08, 0x57, 0xda, 0xac, 0x1b,
-	0x89, 0x34, 0x25, 0xee, 0x34, 0x7d, 0xd6, 0x96, 0x90, 0xa7, 0xdc, 0xcb, 0x59, 0xf8, 0x91, 0x8c,
-	0x16, 0x12, 0x0d, 0xf8, 0x46, 0xa2, 0xc6, 0xf7, 0x12, 0xc1, 0xc2, 0x39, 0xf8, 0xf4, 0x1c, 0x36,
-	0x21, 0x5d, 0x33, 0x75, 0x52, 0xd0, 0x7f, 0x8e, 0x28, 0x93, 0x25, 0x99, 0xc9, 0x92, 0xcf, 0xe3,
-	0x23, 0xf7, 0x72, 0x50, 0x68, 0x53, 0xdc, 0xfe, 0x4a, 0x71, 0xfb, 0xf3, 0x01, 0x4c, 0x9e, 0x35,
-	0x80, 0xa9, 0x71, 0x06, 0x60, 0xc3, 0xcd, 0x1e, 0x7e, 0xbb, 0x5e, 0xdb, 0x6b, 0x66, 0x6f, 0xa5,
-	0xf3, 0x6d, 0xf6, 0xda, 0xf6, 0x9d, 0x6b, 0xde, 0x6b, 0x30, 0xdd, 0x92, 0x11, 0x4d, 0xd7, 0x2e,
-	0x73, 0x29, 0x5d, 0xa7, 0xb3, 0x97, 0xff, 0x98, 0x81, 0x4b, 0xb2, 0x33, 0xfe, 0x1d, 0x01, 0xe4,
-	0xed, 0x31, 0x29, 0x6b, 0x30, 0xf8, 0x73, 0x64, 0xd1, 0xa1, 0xf3, 0x15, 0x62, 0xe7, 0x93, 0x1f,
-	0xff, 0xfe, 0xf7, 0xe7, 0xca, 0x2a, 0x5e, 0xa1, 0xe7, 0x7e, 0x44, 0x05, 0x7d, 0x52, 0xd8, 0xba,
-	0x63, 0xfc, 0x14, 0x41, 0xd5, 0x70, 0x1f, 0x3c, 0xec, 0xed, 0x99, 0xa0, 0xd6, 0xfd, 0xe1, 0x0b,
-	0x34, 0xde, 0xf7, 0x25, 0xde, 0x05, 0x7c, 0x7b, 0x08, 0xbc, 0xf8, 0x2f, 0x04, 0x6f, 0xf4, 0x79,
-	0x24, 0x5e, 0x3d, 0xfb, 0xd2, 0x12, 0x4b, 0xb6, 0xd6, 0x46, 0x2d, 0xd3, 0x88, 0x3f, 0x95, 0x88,
-	0xd7, 0xf1, 0x5a, 0x29, 0x62, 0xb5, 0xea, 0x45, 0xa1, 0xb3, 0xf5, 0x3f, 0xc6, 0x2f, 0x10, 0x5c,
-	0x1b, 0xe8, 0x7a, 0xf8, 0xa3, 0x21, 0xd5, 0xeb, 0x37, 0x5d, 0xeb, 0xe3, 0x71, 0x4a, 0x35, 0xa1,
-	0x6d, 0x49, 0x68, 0x0b, 0x7f, 0x36, 0xc6, 0xca, 0x50, 0xd3, 0x93, 0xf1, 0x2f, 0x15, 0xa8, 0x95,
-	0x79, 0x09, 0xde, 0x18, 0x16, 0xe2, 0x20, 0x8b, 0xb4, 0x36, 0xc7, 0xac, 0xd6, 0x1c, 0x7f, 0x90,
-	0x1c, 0x8f, 0xf0, 0xf7, 0x63, 0x71, 0x2c, 0x5a, 0x1f, 0xcd, 0x6c, 0x94, 0x3e, 0xe9, 0x31, 0xe4,
-	0x63, 0xaa, 0xdc, 0xca, 0x38, 0x50, 0x81, 0x63, 0xfc, 0x1b, 0x82, 0xab, 0xbd, 0x36, 0x83, 0x3f,
-	0x1c, 0x92, 0x54, 0xc1, 0xb5, 0xac, 0xd5, 0x11, 0xab, 0xb4, 0x04, 0x77, 0xa4, 0x04, 0xf3, 0xd8,
-	0x2e, 0x93, 0x40, 0x79, 0xd7, 0xd6, 0x37, 0xcf, 0x4e, 0x6c, 0xf4, 0xfc, 0xc4, 0x46, 0xff, 0x9c,
-	0xd8, 0xe8, 0xa7, 0x53, 0x7b, 0xe2, 0xf9, 0xa9, 0x3d, 0xf1, 0xe2, 0xd4, 0x9e, 0xf8, 0x6e, 0x23,
-	0x8c, 0x92, 0x83, 0x8e, 0x4f, 0x02, 0xde, 0xa4, 0xfa, 0xff, 0x7e, 0xe4, 0x07, 0xf7, 0x42, 0x4e,
-	0xbb, 0xeb, 0xb4, 0xc9, 0x1b, 0x9d, 0x43, 0x26, 0x54, 0xe3, 0xfb, 0x2b, 0xf7, 0x8c, 0xde, 0xc9,
-	0x51, 0x8b, 0x09, 0x7f, 0x5a, 0x7e, 0x22, 0x56, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0x46, 0x93,
-	0x88, 0xce, 0x7d, 0x0c, 0x00, 0x00,
+	// 1331 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdf, 0x6f, 0xdb, 0x54,
+	0x14, 0xae, 0x9b, 0xb6, 0x6c, 0x27, 0x5d, 0x9b, 0x5d, 0xf6, 0x23, 0x98, 0xd5, 0xf5, 0xdc, 0x75,
+	0xeb, 0x36, 0x66, 0xaf, 0x2d, 0x2d, 0x65, 0x74, 0x13, 0x6d, 0xea, 0x91, 0x88, 0xc9, 0x0d, 0x6e,
+	0xba, 0xc1, 0x1e, 0x08, 0x8e, 0x73, 0x97, 0x5a, 0xb4, 0x76, 0x16, 0x3b, 0x45, 0xd5, 0x54, 0x21,
+	0xf1, 0x84, 0xf2, 0x84, 0xc4, 0x0b, 0x2f, 0x79, 0x42, 0x62, 0x12, 0x2f, 0xfc, 0x01, 0xbc, 0xc1,
+	0xcb, 0x1e, 0x27, 0xf1, 0xb2, 0xa7, 0x09, 0x75, 0xbc, 0xf2, 0x37, 0x80, 0x7c, 0xef, 0xcd, 0x6c,
+	0x37, 0x71, 0x93, 0x54, 0xda, 0x5b, 0x7c, 0xee, 0xf7, 0x9d, 0xfb, 0x7d, 0xe7, 0x5c, 0x5f, 0x1f,
+	0x05, 0x24, 0xab, 0x64, 0x2a, 0xa6, 0x53, 0xc3, 0x8a, 0xe9, 0xd8, 0x36, 0x36, 0x3d, 0xcb, 0xb1,
+	0x95, 0xdd, 0x59, 0xe5, 0x71, 0x1d, 0xd7, 0xf6, 0xe4, 0x6a, 0xcd, 0xf1, 0x1c, 0x74, 0xce, 0x2a,
+	0x99, 0xb2, 0x8f, 0x91, 0x03, 0x8c, 0xbc, 0x3b, 0xcb, 0x9f, 0xa9, 0x38, 0x15, 0x87, 0x40, 0x14,
+	0xff, 0x17, 0x45, 0xf3, 0xd7, 0x4c, 0xc7, 0xdd, 0x71, 0x5c, 0xa5, 0x64, 0xb8, 0x98, 0xa6, 0x51,
+	0x76, 0x67, 0x4b, 0xd8, 0x33, 0x66, 0x95, 0xaa, 0x51, 0xb1, 0x6c, 0x83, 0xd0, 0x29, 0x76, 0x32,
+	0xd8, 0x7d, 0xdb, 0xc2, 0xb6, 0xe7, 0xef, 0x4c, 0x7f, 0x31, 0xc0, 0x95, 0x18, 0x79, 0xc1, 0x13,
+	0x03, 0x5e, 0xa8, 0x38, 0x4e, 0x65, 0x1b, 0x2b, 0x46, 0xd5, 0x52, 0x0c, 0xdb, 0x76, 0x3c, 0xb2,
+	0x8d, 0xcb, 0x56, 0xdf, 0x61, 0xab, 0xe4, 0xa9, 0x54, 0x7f, 0xa4, 0x18, 0x36, 0x33, 0x27, 0xdd,
+	0x86, 0x73, 0x9f, 0xf9, 0x22, 0x33, 0xaf, 0x33, 0xea, 0xf8, 0x71, 0x1d, 0xbb, 0x1e, 0x9a, 0x82,
+	0x53, 0xc1, 0x36, 0x45, 0xab, 0x9c, 0xe6, 0x44, 0x6e, 0xe6, 0xa4, 0x3e, 0x1a, 0x04, 0x73, 0x65,
+	0xe9, 0x77, 0x0e, 0xce, 0xb7, 0xf1, 0xdd, 0xaa, 0x63, 0xbb, 0x18, 0xa9, 0x00, 0x01, 0x96, 0xb0,
+	0x93, 0x73, 0xd3, 0x72, 0xe7, 0x62, 0xca, 0x01, 0x5f, 0xb5, 0xcb, 0x7a, 0x88, 0x88, 0xce, 0xc0,
+	0x70, 0xb5, 0xe6, 0x38, 0x8f, 0xd2, 0x83, 0x22, 0x37, 0x33, 0xaa, 0xd3, 0x07, 0x94, 0x81, 0x51,
+	0xf2, 0xa3, 0xb8, 0x85, 0xad, 0xca, 0x96, 0x97, 0x4e, 0x90, 0xf4, 0x7c, 0x28, 0x3d, 0xad, 0xe3,
+	0xee, 0xac, 0x9c, 0x25, 0x88, 0xd5, 0xa1, 0x67, 0x2f, 0x27, 0x07, 0xf4, 0x24, 0x61, 0xd1, 0x90,
+	0x64, 0xb4, 0x89, 0x77, 0x5b, 0xee, 0xef, 0x02, 0x04, 0xed, 0x62, 0xe2, 0x2f, 0xcb, 0xb4, 0xb7,
+	0xb2, 0xdf, 0x5b, 0x99, 0x1e, 0x11, 0xd6, 0x5b, 0x39, 0x6f, 0x54, 0x30, 0xe3, 0xea, 0x21, 0xa6,
+	0xf4, 0x2f, 0x07, 0xe9, 0xf6, 0x3d, 0x58, 0x85, 0x34, 0x48, 0x06, 0x46, 0xdd, 0x34, 0x27, 0x26,
+	0x66, 0x92, 0x73, 0xef, 0xc5, 0x95, 0x28, 0x57, 0xc6, 0xb6, 0x67, 0x3d, 0xb2, 0x70, 0x39, 0x54,
+	0xec, 0x70, 0x02, 0xf4, 0x49, 0x44, 0xf4, 0x20, 0x11, 0x7d, 0xa5, 0xab, 0x68, 0x2a, 0x26, 0xac,
+	0x1a, 0x2d, 0xc1, 0x48, 0x9f, 0x75, 0x65, 0x78, 0x69, 0x19, 0x26, 0xa8, 0x5d, 0x02, 0xeb, 0x50,
+	0xd8, 0x77, 0xe1, 0x24, 0x4d, 0x11, 0x1c, 0xa9, 0x13, 0x34, 0x90, 0x2b, 0x4b, 0xbf, 0x70, 0x20,
+	0xc4, 0xd1, 0x59, 0xcd, 0xae, 0x42, 0x2a, 0x74, 0x2c, 0xab, 0x86, 0xb7, 0x45, 0x0b, 0x77, 0x52,
+	0x1f, 0x0f, 0xe2, 0x79, 0x3f, 0xfc, 0x26, 0x4f, 0x4e, 0x16, 0x2e, 0x1e, 0xea, 0x2a, 0x55, 0xbc,
+	0xe1, 0x19, 0x1e, 0xee, 0xeb, 0x0d, 0x3a, 0xe0, 0x40, 0x3a, 0x2a, 0x15, 0xb3, 0x6d, 0xc0, 0x79,
+	0xeb, 0x75, 0xff, 0x8b, 0xac, 0x82, 0xae, 0x0f, 0x61, 0x87, 0xf3, 0x6a, 0x27, 0x03, 0xa1, 0x23,
+	0x13, 0xca, 0x79, 0xd6, 0xea, 0x14, 0x7e, 0x93, 0xe5, 0x6a, 0x72, 0x70, 0xe9, 0xb0, 0x49, 0xdf,
+	0x96, 0xed, 0xd6, 0xdd, 0xbe, 0x4b, 0x86, 0xae, 0xc0, 0x78, 0x0d, 0xef, 0x5a, 0xae, 0x0f, 0xb1,
+	0xeb, 0x3b, 0x25, 0x5c, 0x23, 0x92, 0x87, 0xf4, 0xb1, 0x56, 0x58, 0x23, 0xd1, 0x08, 0x30, 0x24,
+	0x3f, 0x04, 0x64, 0xfa, 0x5e, 0x72, 0x30, 0xdd, 0x45, 0x1f, 0xeb, 0xc3, 0x6d, 0x18, 0x37, 0x5b,
+	0x2b, 0x91, 0xfa, 0x9f, 0x91, 0xe9, 0x25, 0x2b, 0xb7, 0x2e, 0x59, 0x79, 0xc5, 0xde, 0xd3, 0xc7,
+	0xcc, 0x48, 0x9a, 0xe8, 0xe9, 0x1f, 0x8c, 0x9e, 0xfe, 0xa0, 0x01, 0x89, 0xa3, 0x1a, 0x30, 0x74,
+	0x9c, 0x06, 0x08, 0x70, 0xe1, 0x90, 0xbf, 0xbc, 0x51, 0x33, 0x76, 0x5a, 0x6f, 0xa5, 0xf4, 0x00,
+	0x26, 0x62, 0xd6, 0x99, 0xef, 0x45, 0x18, 0xa9, 0x92, 0x08, 0xb3, 0x2b, 0xc4, 0xdd, 0x52, 0x8c,
+	0xc7, 0xd0, 0x52, 0xa6, 0x6d, 0xe3, 0x2c, 0x36, 0xb6, 0xbd, 0xad, 0xbe, 0xde, 0x91, 0xdf, 0x12,
+	0x30, 0x11, 0x93, 0x85, 0xc9, 0x9b, 0x87, 0xe1, 0xa0, 0x19, 0x63, 0x73, 0x13, 0x71, 0xea, 0x68,
+	0x33, 0x87, 0xdd, 0xee, 0xcd, 0xf0, 0x85, 0x05, 0x6f, 0x59, 0xdd, 0x4d, 0x27, 0x98, 0xb0, 0xd7,
+	0x6f, 0x4c, 0xdd, 0x45, 0x1a, 0x8c, 0x9a, 0x4e, 0xdd, 0xf6, 0x70, 0xad, 0x6a, 0xd4, 0xbc, 0x3d,
+	0xd6, 0x9b, 0x4b, 0xf1, 0x1f, 0xb9, 0x00, 0xcb, 0xba, 0x14, 0xe1, 0xa3, 0x65, 0xe0, 0xc3, 0xcf,
+	0xc5, 0xa8, 0x82, 0x61, 0xa2, 0x20, 0x1d, 0x46, 0x64, 0xc2, 0x6a, 0x2e, 0xc2, 0x68, 0x19, 0x6f,
+	0x1b, 0x7b, 0xc5, 0x2a, 0xae, 0x59, 0x4e, 0x39, 0x3d, 0x42, 0xce, 0x7a, 0x92, 0xc4, 0xf2, 0x24,
+	0x84, 0x26, 0x21, 0x59, 0xda, 0x76, 0xcc, 0xaf, 0x8b, 0x24, 0x98, 0x7e, 0x8b, 0x20, 0x80, 0x84,
+	0xd6, 0xfc, 0x08, 0x52, 0x61, 0xc4, 0x72, 0xdd, 0x3a, 0x76, 0xd3, 0x27, 0xc4, 0xc4, 0xcc, 0xd8,
+	0xdc, 0x8d, 0xee, 0x1f, 0x6c, 0xda, 0x8a, 0x9c, 0xcf, 0xd2, 0x19, 0xf9, 0xda, 0xd3, 0x21, 0x38,
+	0xdb, 0x11, 0x81, 0x96, 0x60, 0x2a, 0xb3, 0xae, 0x69, 0x6a, 0xa6, 0x90, 0x5b, 0xd7, 0x8a, 0x59,
+	0x75, 0xe5, 0x5e, 0x21, 0x5b, 0xcc, 0x6d, 0x6c, 0x6c, 0xaa, 0xc5, 0x4d, 0x6d, 0x23, 0xaf, 0x66,
+	0x72, 0x77, 0x73, 0xea, 0x5a, 0x6a, 0x80, 0x1f, 0x6f, 0x34, 0xc5, 0x64, 0x28, 0x84, 0xb2, 0x70,
+	0x3d, 0x8e, 0x19, 0x8a, 0x6b, 0xeb, 0x85, 0xe2, 0x7a, 0x5e, 0xd5, 0x52, 0x1c, 0x7f, 0xbe, 0xd1,
+	0x14, 0xdf, 0xee, 0xb0, 0x84, 0xbe, 0x82, 0x0f, 0xe2, 0x33, 0x6d, 0x6a, 0x05, 0x55, 0xcf, 0xaf,
+	0xe8, 0x85, 0x2f, 0xc2, 0x69, 0x37, 0xb5, 0x4f, 0xb5, 0xf5, 0x07, 0x5a, 0x6a, 0x90, 0x9f, 0x6a,
+	0x34, 0xc5, 0xc9, 0x2e, 0x30, 0x74, 0x07, 0x2e, 0xc7, 0xee, 0x70, 0x2f, 0xa7, 0x6a, 0x85, 0xa2,
+	0xfa, 0x79, 0x3e, 0xa7, 0xab, 0x6b, 0xa9, 0x04, 0x8f, 0x1a, 0x4d, 0x71, 0x2c, 0x1a, 0x45, 0xcb,
+	0x30, 0xdd, 0x85, 0x7f, 0x57, 0x5f, 0x7f, 0xa8, 0x6a, 0xa9, 0x21, 0xfe, 0x74, 0xa3, 0x29, 0x9e,
+	0x8a, 0x04, 0xd1, 0x1a, 0x5c, 0xed, 0xc2, 0xf6, 0x4b, 0xb1, 0x92, 0x29, 0xe4, 0xee, 0xab, 0xa9,
+	0x61, 0xfe, 0x6c, 0xa3, 0x29, 0x9e, 0x6e, 0x5b, 0x40, 0x5f, 0xc2, 0x62, 0x6f, 0x55, 0x6a, 0x4b,
+	0x39, 0xc2, 0x4b, 0x8d, 0xa6, 0x28, 0x1c, 0x8d, 0xe2, 0x87, 0xbe, 0xff, 0x59, 0x18, 0x98, 0xfb,
+	0x0f, 0x60, 0x98, 0xbc, 0xdb, 0xe8, 0x57, 0x0e, 0x20, 0x38, 0x33, 0x48, 0x8e, 0x3b, 0x79, 0x9d,
+	0xe7, 0x55, 0x5e, 0xe9, 0x19, 0x4f, 0xef, 0x0c, 0xe9, 0xa3, 0xef, 0xfe, 0xfa, 0xe7, 0xc7, 0xc1,
+	0x05, 0x34, 0xaf, 0x74, 0x9d, 0xb2, 0x5d, 0xe5, 0x49, 0xe4, 0x96, 0xda, 0x47, 0x4d, 0x0e, 0x92,
+	0x41, 0x4e, 0x17, 0xf5, 0xba, 0x7b, 0xeb, 0xc6, 0xe5, 0x6f, 0xf6, 0x4e, 0x60, 0x7a, 0xaf, 0x13,
+	0xbd, 0xd3, 0x68, 0xaa, 0x07, 0xbd, 0xe8, 0x0f, 0x0e, 0x4e, 0xb7, 0x0d, 0x51, 0x68, 0xe1, 0xe8,
+	0x4d, 0x63, 0x66, 0x36, 0x7e, 0xb1, 0x5f, 0x1a, 0x53, 0x7c, 0x87, 0x28, 0x5e, 0x42, 0x8b, 0xb1,
+	0x8a, 0xe9, 0xfd, 0x16, 0x2d, 0x74, 0xeb, 0x4a, 0xde, 0x47, 0x2f, 0xb8, 0xf0, 0x2d, 0x12, 0x9e,
+	0x55, 0x3e, 0xec, 0xb1, 0x7a, 0xed, 0x53, 0x19, 0x7f, 0xeb, 0x38, 0x54, 0x66, 0x28, 0x4b, 0x0c,
+	0xad, 0xa2, 0x8f, 0x8f, 0x71, 0x64, 0x94, 0xf0, 0xd0, 0x86, 0x7e, 0x1a, 0x84, 0x74, 0xdc, 0xb0,
+	0x81, 0x96, 0x7b, 0x95, 0xd8, 0x69, 0x86, 0xe2, 0x6f, 0x1f, 0x93, 0xcd, 0x3c, 0x7e, 0x4b, 0x3c,
+	0xee, 0xa1, 0x6f, 0x8e, 0xe5, 0x31, 0x3a, 0x1b, 0x29, 0xad, 0x39, 0x4b, 0x79, 0x72, 0x68, 0x62,
+	0xdb, 0x57, 0xe8, 0x38, 0x13, 0x5a, 0xa0, 0x81, 0x7d, 0xf4, 0x27, 0x07, 0xa9, 0xc3, 0xdf, 0x0e,
+	0xf4, 0x7e, 0x8f, 0xa6, 0x22, 0xd3, 0x05, 0xbf, 0xd0, 0x27, 0x8b, 0x95, 0x60, 0x95, 0x94, 0x60,
+	0x19, 0xdd, 0x3a, 0x4e, 0x09, 0xb6, 0xa8, 0xe0, 0xa7, 0x11, 0x17, 0x74, 0x2a, 0xea, 0xd9, 0x45,
+	0x64, 0x38, 0xe3, 0x17, 0xfa, 0x64, 0x31, 0x17, 0x97, 0x89, 0x0b, 0x11, 0x09, 0x71, 0x2e, 0xe8,
+	0x88, 0xb6, 0x7a, 0xff, 0xd9, 0x81, 0xc0, 0x3d, 0x3f, 0x10, 0xb8, 0xbf, 0x0f, 0x04, 0xee, 0x87,
+	0x57, 0xc2, 0xc0, 0xf3, 0x57, 0xc2, 0xc0, 0x8b, 0x57, 0xc2, 0xc0, 0xc3, 0xe5, 0x8a, 0xe5, 0x6d,
+	0xd5, 0x4b, 0xb2, 0xe9, 0xec, 0x28, 0xec, 0x6f, 0x0d, 0xab, 0x64, 0xde, 0xa8, 0x38, 0xca, 0xee,
+	0x92, 0xb2, 0xe3, 0x94, 0xeb, 0xdb, 0xd8, 0xa5, 0x89, 0x6f, 0xce, 0xdf, 0x08, 0xe5, 0xf6, 0xf6,
+	0xaa, 0xd8, 0x2d, 0x8d, 0x90, 0x49, 0x78, 0xfe, 0xff, 0x01, 0x00, 0xfe, 0x1c, 0x38, 0x1e, 0x64,
+	0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -807,6 +1040,9 @@ type QueryClient interface {
 	// ConnectionConsensusState queries the consensus state associated with the
 	// connection.
 	ConnectionConsensusState(ctx context.Context, in *QueryConnectionConsensusStateRequest, opts ...grpc.CallOption) (*QueryConnectionConsensusStateResponse, error)
+	// ConnectionHealth queries the state, client status and delay period of a
+	// connection and reports any issues which prevent it from being used.
+	ConnectionHealth(ctx context.Context, in *QueryConnectionHealthRequest, opts ...grpc.CallOption) (*QueryConnectionHealthResponse, error)
 	// ConnectionParams queries all parameters of the ibc connection submodule.
 	ConnectionParams(ctx context.Context, in *QueryConnectionParamsRequest, opts ...grpc.CallOption) (*QueryConnectionParamsResponse, error)
 }
@@ -864,6 +1100,15 @@ func (c *queryClient) ConnectionConsensusState(ctx context.Context, in *QueryCon
 	return out, nil
 }
 
+func (c *queryClient) ConnectionHealth(ctx context.Context, in *QueryConnectionHealthRequest, opts ...grpc.CallOption) (*QueryConnectionHealthResponse, error) {
+	out := new(QueryConnectionHealthResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Query/ConnectionHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ConnectionParams(ctx context.Context, in *QueryConnectionParamsRequest, opts ...grpc.CallOption) (*QueryConnectionParamsResponse, error) {
 	out := new(QueryConnectionParamsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.connection.v1.Query/ConnectionParams", in, out, opts...)
@@ -888,6 +1133,9 @@ type QueryServer interface {
 	// ConnectionConsensusState queries the consensus state associated with the
 	// connection.
 	ConnectionConsensusState(context.Context, *QueryConnectionConsensusStateRequest) (*QueryConnectionConsensusStateResponse, error)
+	// ConnectionHealth queries the state, client status and delay period of a
+	// connection and reports any issues which prevent it from being used.
+	ConnectionHealth(context.Context, *QueryConnectionHealthRequest) (*QueryConnectionHealthResponse, error)
 	// ConnectionParams queries all parameters of the ibc connection submodule.
 	ConnectionParams(context.Context, *QueryConnectionParamsRequest) (*QueryConnectionParamsResponse, error)
 }
@@ -911,6 +1159,9 @@ func (*UnimplementedQueryServer) ConnectionClientState(ctx context.Context, req
 func (*UnimplementedQueryServer) ConnectionConsensusState(ctx context.Context, req *QueryConnectionConsensusStateRequest) (*QueryConnectionConsensusStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectionConsensusState not implemented")
 }
+func (*UnimplementedQueryServer) ConnectionHealth(ctx context.Context, req *QueryConnectionHealthRequest) (*QueryConnectionHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionHealth not implemented")
+}
 func (*UnimplementedQueryServer) ConnectionParams(ctx context.Context, req *QueryConnectionParamsRequest) (*QueryConnectionParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectionParams not implemented")
 }
@@ -1009,6 +1260,24 @@ func _Query_ConnectionConsensusState_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ConnectionHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConnectionHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConnectionHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.connection.v1.Query/ConnectionHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConnectionHealth(ctx, req.(*QueryConnectionHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ConnectionParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryConnectionParamsRequest)
 	if err := dec(in); err != nil {
@@ -1051,6 +1320,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ConnectionConsensusState",
 			Handler:    _Query_ConnectionConsensusState_Handler,
 		},
+		{
+			MethodName: "ConnectionHealth",
+			Handler:    _Query_ConnectionHealth_Handler,
+		},
 		{
 			MethodName: "ConnectionParams",
 			Handler:    _Query_ConnectionParams_Handler,
@@ -1554,6 +1827,123 @@ func (m *QueryConnectionParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryConnectionHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConnectionHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConnectionHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConnectionHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConnectionHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConnectionHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Issues) > 0 {
+		dAtA13 := make([]byte, len(m.Issues)*10)
+		var j12 int
+		for _, num := range m.Issues {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintQuery(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.BlockDelay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockDelay))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DelayPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DelayPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CounterpartyClientStatus) > 0 {
+		i -= len(m.CounterpartyClientStatus)
+		copy(dAtA[i:], m.CounterpartyClientStatus)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CounterpartyClientStatus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Counterparty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ClientStatus) > 0 {
+		i -= len(m.ClientStatus)
+		copy(dAtA[i:], m.ClientStatus)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientStatus)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.State != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1761,6 +2151,58 @@ func (m *QueryConnectionParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryConnectionHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConnectionHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovQuery(uint64(m.State))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientStatus)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Counterparty.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.CounterpartyClientStatus)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DelayPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.DelayPeriod))
+	}
+	if m.BlockDelay != 0 {
+		n += 1 + sovQuery(uint64(m.BlockDelay))
+	}
+	if len(m.Issues) > 0 {
+		l = 0
+		for _, e := range m.Issues {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3148,6 +3590,393 @@ func (m *QueryConnectionParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryConnectionHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConnectionHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConnectionHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConnectionHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConnectionHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConnectionHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Counterparty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyClientStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyClientStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayPeriod", wireType)
+			}
+			m.DelayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDelay", wireType)
+			}
+			m.BlockDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockDelay |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType == 0 {
+				var v ConnectionHealthIssue
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ConnectionHealthIssue(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Issues = append(m.Issues, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Issues) == 0 {
+					m.Issues = make([]ConnectionHealthIssue, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ConnectionHealthIssue
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ConnectionHealthIssue(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Issues = append(m.Issues, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issues", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
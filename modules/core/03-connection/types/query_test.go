@@ -0,0 +1,102 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestNewQueryConnectionHealthResponse(t *testing.T) {
+	var connection types.ConnectionEnd
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		clientStatus exported.Status
+		expIssues    []types.ConnectionHealthIssue
+	}{
+		{
+			"healthy open connection",
+			func() {},
+			exported.Active,
+			nil,
+		},
+		{
+			"connection not open and counterparty connection unknown",
+			func() {
+				connection.State = types.INIT
+				connection.Counterparty.ConnectionId = ""
+			},
+			exported.Active,
+			[]types.ConnectionHealthIssue{types.CONNECTION_NOT_OPEN, types.COUNTERPARTY_CONNECTION_UNKNOWN},
+		},
+		{
+			"client expired",
+			func() {},
+			exported.Expired,
+			[]types.ConnectionHealthIssue{types.CLIENT_EXPIRED},
+		},
+		{
+			"client within expiry grace period is reported as expired",
+			func() {},
+			exported.ExpiredGrace,
+			[]types.ConnectionHealthIssue{types.CLIENT_EXPIRED},
+		},
+		{
+			"client frozen",
+			func() {},
+			exported.Frozen,
+			[]types.ConnectionHealthIssue{types.CLIENT_FROZEN},
+		},
+		{
+			"client status unknown",
+			func() {},
+			exported.Unknown,
+			[]types.ConnectionHealthIssue{types.CLIENT_NOT_ACTIVE},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			counterparty := types.NewCounterparty(clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix")))
+			connection = types.NewConnectionEnd(types.OPEN, clientID, counterparty, []*types.Version{ibctesting.ConnectionVersion}, 500)
+
+			tc.malleate()
+
+			res := types.NewQueryConnectionHealthResponse(connection, tc.clientStatus, 1)
+
+			require.Equal(t, connection.State, res.State)
+			require.Equal(t, clientID, res.ClientId)
+			require.Equal(t, tc.clientStatus.String(), res.ClientStatus)
+			require.Equal(t, connection.Counterparty, res.Counterparty)
+			require.Empty(t, res.CounterpartyClientStatus)
+			require.Equal(t, uint64(500), res.DelayPeriod)
+			require.Equal(t, uint64(1), res.BlockDelay)
+			require.Equal(t, tc.expIssues, res.Issues)
+			require.Equal(t, len(tc.expIssues) == 0, res.IsHealthy())
+		})
+	}
+}
+
+func TestQueryConnectionHealthResponseSetCounterpartyClientStatus(t *testing.T) {
+	counterparty := types.NewCounterparty(clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix")))
+	connection := types.NewConnectionEnd(types.OPEN, clientID, counterparty, []*types.Version{ibctesting.ConnectionVersion}, 500)
+
+	res := types.NewQueryConnectionHealthResponse(connection, exported.Active, 1)
+	res.SetCounterpartyClientStatus(exported.Active)
+	require.Equal(t, exported.Active.String(), res.CounterpartyClientStatus)
+	require.True(t, res.IsHealthy())
+
+	res = types.NewQueryConnectionHealthResponse(connection, exported.Active, 1)
+	res.SetCounterpartyClientStatus(exported.Expired)
+	require.Equal(t, exported.Expired.String(), res.CounterpartyClientStatus)
+	require.Equal(t, []types.ConnectionHealthIssue{types.COUNTERPARTY_CLIENT_NOT_ACTIVE}, res.Issues)
+	require.False(t, res.IsHealthy())
+}
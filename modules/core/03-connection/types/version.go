@@ -18,8 +18,8 @@ var (
 	DefaultIBCVersionIdentifier = "1"
 
 	// SupportedOrderings is the list of orderings supported by IBC. The current
-	// version supports only ORDERED and UNORDERED channels.
-	SupportedOrderings = []string{"ORDER_ORDERED", "ORDER_UNORDERED"}
+	// version supports ORDERED, UNORDERED and ORDERED_ALLOW_TIMEOUT channels.
+	SupportedOrderings = []string{"ORDER_ORDERED", "ORDER_UNORDERED", "ORDER_ORDERED_ALLOW_TIMEOUT"}
 
 	// AllowNilFeatureSet is a helper map to indicate if a specified version
 	// identifier is allowed to have a nil feature set. Any versions supported,
@@ -20,19 +20,27 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetCmdQueryChannels(),
+		GetCmdQueryChannelsByState(),
 		GetCmdQueryChannel(),
 		GetCmdQueryConnectionChannels(),
 		GetCmdQueryChannelClientState(),
 		GetCmdQueryPacketCommitment(),
 		GetCmdQueryPacketCommitments(),
 		GetCmdQueryPacketReceipt(),
+		GetCmdQueryPacketReceiptCount(),
 		GetCmdQueryPacketAcknowledgement(),
+		GetCmdQueryPacketState(),
 		GetCmdQueryUnreceivedPackets(),
+		GetCmdQueryUnreceivedPacketsCount(),
 		GetCmdQueryUnreceivedAcks(),
 		GetCmdQueryNextSequenceReceive(),
 		GetCmdQueryNextSequenceSend(),
+		GetCmdQueryChannelSequences(),
+		GetCmdQueryChannelSendPaused(),
+		GetCmdQueryUnrelayedAcknowledgements(),
 		GetCmdQueryUpgradeError(),
 		GetCmdQueryUpgrade(),
+		GetCmdQueryChannelUpgradeInfo(),
 		GetCmdChannelParams(),
 	)
 
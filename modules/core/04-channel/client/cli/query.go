@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -59,6 +60,52 @@ func GetCmdQueryChannels() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryChannelsByState defines the command to query all the channel ends
+// which are in the provided state
+func GetCmdQueryChannelsByState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channels-by-state [state]",
+		Short:   "Query all channels in a given state",
+		Long:    "Query all channels from a chain which are in the given state (e.g. STATE_INIT, STATE_TRYOPEN, STATE_CLOSED)",
+		Example: fmt.Sprintf("%s query %s %s channels-by-state STATE_CLOSED", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			state, ok := types.State_value[strings.ToUpper(args[0])]
+			if !ok {
+				return fmt.Errorf("invalid channel state %s", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelsByStateRequest{
+				State:      types.State(state),
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ChannelsByState(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channels in a given state")
+
+	return cmd
+}
+
 // GetCmdQueryChannel defines the command to query a channel end
 func GetCmdQueryChannel() *cobra.Command {
 	cmd := &cobra.Command{
@@ -282,6 +329,48 @@ func GetCmdQueryPacketReceipt() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryPacketState defines the command to query the lifecycle state of a packet
+func GetCmdQueryPacketState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-state [port-id] [channel-id] [sequence]",
+		Short: "Query the lifecycle state of a packet",
+		Long:  "Query whether a packet was sent, received, timed out or acknowledged on a channel, consolidating its commitment, receipt and acknowledgement",
+		Example: fmt.Sprintf(
+			"%s query %s %s packet-state [port-id] [channel-id] [sequence]", version.AppName, ibcexported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seq, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketStateRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  seq,
+			}
+
+			res, err := queryClient.PacketState(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryPacketAcknowledgement defines the command to query a packet acknowledgement
 func GetCmdQueryPacketAcknowledgement() *cobra.Command {
 	cmd := &cobra.Command{
@@ -372,6 +461,97 @@ The return value represents:
 	return cmd
 }
 
+// GetCmdQueryPacketReceiptCount defines the command to query the number of packet receipts
+// stored for a channel
+func GetCmdQueryPacketReceiptCount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "packet-receipt-count [port-id] [channel-id]",
+		Short: "Query the number of packet receipts stored for a channel",
+		Long: `Query the number of packet receipts stored for a channel and an estimate of the bytes they occupy in the store.
+
+The count and size only cover the packet receipts of the requested page. Use the pagination flags to count the remaining receipts.
+`,
+		Example: fmt.Sprintf("%s query %s %s packet-receipt-count [port-id] [channel-id] --limit=1000", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPacketReceiptCountRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PacketReceiptCount(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packet receipts associated with a channel")
+
+	return cmd
+}
+
+// GetCmdQueryUnreceivedPacketsCount defines the command to query the number of unreceived
+// packets on the receiving chain
+func GetCmdQueryUnreceivedPacketsCount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unreceived-packets-count [port-id] [channel-id]",
+		Short:   "Query the number of unreceived packets associated with a channel",
+		Long:    "Query the number of packets, given a list of packet commitment sequences, which are unreceived.",
+		Example: fmt.Sprintf("%s query %s %s unreceived-packets-count [port-id] [channel-id] --sequences=1,2,3", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			seqSlice, err := cmd.Flags().GetInt64Slice(flagSequences)
+			if err != nil {
+				return err
+			}
+
+			seqs := make([]uint64, len(seqSlice))
+			for i := range seqSlice {
+				seqs[i] = uint64(seqSlice[i])
+			}
+
+			req := &types.QueryUnreceivedPacketsCountRequest{
+				PortId:                    args[0],
+				ChannelId:                 args[1],
+				PacketCommitmentSequences: seqs,
+			}
+
+			res, err := queryClient.UnreceivedPacketsCount(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64Slice(flagSequences, []int64{}, "comma separated list of packet sequence numbers")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryUnreceivedAcks defines the command to query all the unreceived acks on the original sending chain
 func GetCmdQueryUnreceivedAcks() *cobra.Command {
 	cmd := &cobra.Command{
@@ -492,6 +672,140 @@ func GetCmdQueryNextSequenceSend() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryChannelSequences defines the command to query the next send, receive and acknowledgement
+// sequences for a given channel
+func GetCmdQueryChannelSequences() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sequences [port-id] [channel-id]",
+		Short: "Query the next sequences of a channel",
+		Long:  "Query the next send, receive and acknowledgement sequences as well as the state and ordering for a given channel",
+		Example: fmt.Sprintf(
+			"%s query %s %s sequences [port-id] [channel-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			portID := args[0]
+			channelID := args[1]
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			req := &types.QueryChannelSequencesRequest{
+				PortId:    portID,
+				ChannelId: channelID,
+				WithProof: prove,
+			}
+
+			sequencesRes, err := utils.QueryChannelSequences(clientCtx, req)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(int64(sequencesRes.ProofHeight.RevisionHeight))
+			return clientCtx.PrintProto(sequencesRes)
+		},
+	}
+
+	cmd.Flags().Bool(flags.FlagProve, false, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryChannelSendPaused defines the command to query whether sending packets on a given channel is paused
+func GetCmdQueryChannelSendPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-paused [port-id] [channel-id]",
+		Short: "Query whether sending packets on a channel is paused",
+		Long:  "Query whether sending packets on a channel is paused. Packets already in flight may still be received, acknowledged or timed out",
+		Example: fmt.Sprintf(
+			"%s query %s %s send-paused [port-id] [channel-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelSendPausedRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.ChannelSendPaused(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryUnrelayedAcknowledgements defines the command to query the acknowledgements written on a channel
+// which may not have been relayed yet, along with the height and time at which they were written
+func GetCmdQueryUnrelayedAcknowledgements() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unrelayed-acks [port-id] [channel-id]",
+		Short: "Query the acknowledgements which may not have been relayed",
+		Long:  "Query the acknowledgements written on a channel which may not have been relayed to the counterparty, along with the height and time at which they were written",
+		Example: fmt.Sprintf(
+			"%s query %s %s unrelayed-acks [port-id] [channel-id] --sequences=1,2,3", version.AppName, ibcexported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			seqSlice, err := cmd.Flags().GetInt64Slice(flagSequences)
+			if err != nil {
+				return err
+			}
+
+			seqs := make([]uint64, len(seqSlice))
+			for i := range seqSlice {
+				seqs[i] = uint64(seqSlice[i])
+			}
+
+			req := &types.QueryUnrelayedAcknowledgementsRequest{
+				PortId:                    args[0],
+				ChannelId:                 args[1],
+				Pagination:                pageReq,
+				PacketCommitmentSequences: seqs,
+			}
+
+			res, err := queryClient.UnrelayedAcknowledgements(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Int64Slice(flagSequences, []int64{}, "comma separated list of the sequences of the packet commitments stored on the counterparty")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unrelayed acknowledgements")
+
+	return cmd
+}
+
 // GetCmdQueryUpgradeError defines the command to query for the error receipt associated with an upgrade
 func GetCmdQueryUpgradeError() *cobra.Command {
 	cmd := &cobra.Command{
@@ -560,6 +874,47 @@ func GetCmdQueryUpgrade() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryChannelUpgradeInfo defines the command to query the pending upgrade, the latest upgrade
+// error receipt, the upgrade sequence and the flush status of a channel in a single query.
+func GetCmdQueryChannelUpgradeInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-info [port-id] [channel-id]",
+		Short: "Query the upgrade info of a channel",
+		Long:  "Query the pending upgrade, the latest upgrade error receipt, the upgrade sequence and the flush status of a channel",
+		Example: fmt.Sprintf(
+			"%s query %s %s upgrade-info [port-id] [channel-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName,
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			portID := args[0]
+			channelID := args[1]
+			prove, _ := cmd.Flags().GetBool(flags.FlagProve)
+
+			req := &types.QueryChannelUpgradeInfoRequest{
+				PortId:    portID,
+				ChannelId: channelID,
+				WithProof: prove,
+			}
+
+			res, err := utils.QueryChannelUpgradeInfo(clientCtx, req)
+			if err != nil {
+				return err
+			}
+
+			clientCtx = clientCtx.WithHeight(int64(res.ProofHeight.RevisionHeight))
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Bool(flags.FlagProve, false, "show proofs for the query results")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdChannelParams returns the command handler for ibc channel parameter querying.
 func GetCmdChannelParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	clientutils "github.com/cosmos/ibc-go/v8/modules/core/02-client/client/utils"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -196,6 +197,78 @@ func queryNextSequenceSendABCI(clientCtx client.Context, portID, channelID strin
 	return types.NewQueryNextSequenceSendResponse(sequence, proofBz, proofHeight), nil
 }
 
+// QueryChannelSequences returns the next send, receive and acknowledgement sequences of a channel along
+// with the channel state and ordering. If the request sets WithProof, it performs ABCI store queries at a
+// single height in order to retrieve the merkle proofs of the sequences. Otherwise, it uses the gRPC query client.
+func QueryChannelSequences(
+	clientCtx client.Context, req *types.QueryChannelSequencesRequest,
+) (*types.QueryChannelSequencesResponse, error) {
+	if req.WithProof {
+		return queryChannelSequencesABCI(clientCtx, req.PortId, req.ChannelId)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	return queryClient.ChannelSequences(context.Background(), req)
+}
+
+func queryChannelSequencesABCI(clientCtx client.Context, portID, channelID string) (*types.QueryChannelSequencesResponse, error) {
+	channelRes, err := queryChannelABCI(clientCtx, portID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	// query the sequences at the height the channel was retrieved at, so that all proofs
+	// are verifiable at the same proof height
+	proofHeight := channelRes.ProofHeight
+	clientCtx = clientCtx.WithHeight(int64(proofHeight.RevisionHeight))
+
+	sequenceSendRes, err := queryNextSequenceSendABCI(clientCtx, portID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	// unordered channels do not make use of the next sequence receive and acknowledgement
+	var (
+		sequenceRecv, sequenceAck uint64
+		proofRecv, proofAck       []byte
+	)
+	if channelRes.Channel.Ordering != types.UNORDERED {
+		sequenceRecvRes, err := queryNextSequenceRecvABCI(clientCtx, portID, channelID)
+		if err != nil {
+			return nil, err
+		}
+
+		sequenceRecv, proofRecv = sequenceRecvRes.NextSequenceReceive, sequenceRecvRes.Proof
+
+		sequenceAck, proofAck, _, err = queryNextSequenceAckABCI(clientCtx, portID, channelID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return types.NewQueryChannelSequencesResponse(
+		channelRes.Channel.State, channelRes.Channel.Ordering,
+		sequenceSendRes.NextSequenceSend, sequenceRecv, sequenceAck,
+		sequenceSendRes.Proof, proofRecv, proofAck, proofHeight,
+	), nil
+}
+
+func queryNextSequenceAckABCI(clientCtx client.Context, portID, channelID string) (uint64, []byte, clienttypes.Height, error) {
+	key := host.NextSequenceAckKey(portID, channelID)
+
+	value, proofBz, proofHeight, err := ibcclient.QueryTendermintProof(clientCtx, key)
+	if err != nil {
+		return 0, nil, clienttypes.Height{}, err
+	}
+
+	// check if next sequence acknowledgement exists
+	if len(value) == 0 {
+		return 0, nil, clienttypes.Height{}, errorsmod.Wrapf(types.ErrSequenceAckNotFound, "portID (%s), channelID (%s)", portID, channelID)
+	}
+
+	return binary.BigEndian.Uint64(value), proofBz, proofHeight, nil
+}
+
 // QueryUpgradeError returns the upgrade error.
 // If prove is true, it performs an ABCI store query in order to retrieve the merkle proof. Otherwise,
 // it uses the gRPC query client.
@@ -282,6 +355,87 @@ func queryUpgradeABCI(clientCtx client.Context, portID, channelID string) (*type
 	return types.NewQueryUpgradeResponse(upgrade, proofBz, proofHeight), nil
 }
 
+// QueryChannelUpgradeInfo returns the pending upgrade, the latest upgrade error receipt, the upgrade sequence
+// and the flush status of a channel. If the request sets WithProof, it performs ABCI store queries in order to
+// retrieve the merkle proofs of the channel end, the upgrade and the error receipt at the same height. Otherwise,
+// it uses the gRPC query client.
+func QueryChannelUpgradeInfo(
+	clientCtx client.Context, req *types.QueryChannelUpgradeInfoRequest,
+) (*types.QueryChannelUpgradeInfoResponse, error) {
+	if req.WithProof {
+		return queryChannelUpgradeInfoABCI(clientCtx, req.PortId, req.ChannelId)
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	return queryClient.ChannelUpgradeInfo(context.Background(), req)
+}
+
+// queryChannelUpgradeInfoABCI queries the channel upgrade info from the store. All proofs are
+// retrieved at the height at which the channel end proof was retrieved.
+func queryChannelUpgradeInfoABCI(clientCtx client.Context, portID, channelID string) (*types.QueryChannelUpgradeInfoResponse, error) {
+	channelRes, err := queryChannelABCI(clientCtx, portID, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	proofHeight := channelRes.ProofHeight
+	clientCtx = clientCtx.WithHeight(int64(proofHeight.RevisionHeight))
+
+	upgradeBz, upgradeProof, _, err := ibcclient.QueryTendermintProof(clientCtx, host.ChannelUpgradeKey(portID, channelID))
+	if err != nil {
+		return nil, err
+	}
+
+	receiptBz, receiptProof, _, err := ibcclient.QueryTendermintProof(clientCtx, host.ChannelUpgradeErrorKey(portID, channelID))
+	if err != nil {
+		return nil, err
+	}
+
+	// the proofs are retrieved against the state committed at the block prior to the proof height,
+	// query the in-flight packets for the same state
+	queryClient := types.NewQueryClient(clientCtx.WithHeight(int64(proofHeight.RevisionHeight) - 1))
+	commitmentsRes, err := queryClient.PacketCommitments(context.Background(), &types.QueryPacketCommitmentsRequest{
+		PortId:     portID,
+		ChannelId:  channelID,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+	res := &types.QueryChannelUpgradeInfoResponse{
+		UpgradeSequence:    channelRes.Channel.UpgradeSequence,
+		ChannelState:       channelRes.Channel.State,
+		HasInflightPackets: len(commitmentsRes.Commitments) > 0,
+		ChannelProof:       channelRes.Proof,
+		UpgradeProof:       upgradeProof,
+		ErrorReceiptProof:  receiptProof,
+		ProofHeight:        proofHeight,
+	}
+
+	if len(upgradeBz) != 0 {
+		var upgrade types.Upgrade
+		if err := cdc.Unmarshal(upgradeBz, &upgrade); err != nil {
+			return nil, err
+		}
+
+		res.Upgrade = &upgrade
+	}
+
+	if len(receiptBz) != 0 {
+		var receipt types.ErrorReceipt
+		if err := cdc.Unmarshal(receiptBz, &receipt); err != nil {
+			return nil, err
+		}
+
+		res.ErrorReceipt = &receipt
+	}
+
+	return res, nil
+}
+
 // QueryPacketCommitment returns a packet commitment.
 // If prove is true, it performs an ABCI store query in order to retrieve the merkle proof. Otherwise,
 // it uses the gRPC query client.
@@ -38,6 +38,9 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, gs types.GenesisState) {
 	for _, as := range gs.AckSequences {
 		k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
 	}
+	for _, age := range gs.AcknowledgementAges {
+		k.SetPacketAcknowledgementAge(ctx, age)
+	}
 	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
 }
 
@@ -53,5 +56,6 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) types.GenesisState {
 		AckSequences:        k.GetAllPacketAckSeqs(ctx),
 		NextChannelSequence: k.GetNextChannelSequence(ctx),
 		Params:              k.GetParams(ctx),
+		AcknowledgementAges: k.GetAllPacketAcknowledgementAges(ctx),
 	}
 }
@@ -3,6 +3,7 @@ package keeper
 import (
 	"encoding/hex"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -144,6 +145,14 @@ func emitSendPacketEvent(ctx sdk.Context, packet types.Packet, channel types.Cha
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSendPacket{
+		Packet:          packet,
+		ChannelOrdering: channel.Ordering,
+		ConnectionId:    channel.ConnectionHops[0],
+	}); err != nil {
+		ctx.Logger().Error("failed to emit send packet event", "sequence", packet.GetSequence(), "error", err)
+	}
 }
 
 // emitRecvPacketEvent emits a receive packet event. It will be emitted both the first time a packet
@@ -173,6 +182,45 @@ func emitRecvPacketEvent(ctx sdk.Context, packet types.Packet, channel types.Cha
 	})
 }
 
+// EmitPacketAlreadyReceivedEvent emits a packet already received event. It is emitted by the
+// msg server when a redundant relay of a packet is treated as a no-op.
+func EmitPacketAlreadyReceivedEvent(ctx sdk.Context, packet types.Packet) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePacketAlreadyReceived,
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
+// EmitPanicInApplicationEvent emits a panic in application event. It is emitted by the msg server when
+// a panic in the OnRecvPacket callback of the receiving application is recovered. The panic message is
+// not included as it is not deterministic.
+func EmitPanicInApplicationEvent(ctx sdk.Context, packet types.Packet) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePanicInApplication,
+			sdk.NewAttribute(types.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeySrcPort, packet.GetSourcePort()),
+			sdk.NewAttribute(types.AttributeKeySrcChannel, packet.GetSourceChannel()),
+			sdk.NewAttribute(types.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(types.AttributeKeyDstChannel, packet.GetDestChannel()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
+
 // emitWriteAcknowledgementEvent emits an event that the relayer can query for
 func emitWriteAcknowledgementEvent(ctx sdk.Context, packet types.Packet, channel types.Channel, acknowledgement []byte) {
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -197,6 +245,14 @@ func emitWriteAcknowledgementEvent(ctx sdk.Context, packet types.Packet, channel
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	})
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWriteAcknowledgement{
+		Packet:          packet,
+		Acknowledgement: acknowledgement,
+		ConnectionId:    channel.ConnectionHops[0],
+	}); err != nil {
+		ctx.Logger().Error("failed to emit write acknowledgement event", "sequence", packet.GetSequence(), "error", err)
+	}
 }
 
 // emitAcknowledgePacketEvent emits an acknowledge packet event. It will be emitted both the first time
@@ -433,3 +489,19 @@ func emitChannelFlushCompleteEvent(ctx sdk.Context, portID string, channelID str
 		),
 	})
 }
+
+// emitChannelSendPausedEvent emits an event indicating that sending packets on the channel has been paused or unpaused
+func emitChannelSendPausedEvent(ctx sdk.Context, portID string, channelID string, paused bool) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeChannelSendPaused,
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeySendPaused, strconv.FormatBool(paused)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+}
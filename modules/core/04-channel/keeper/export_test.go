@@ -34,3 +34,16 @@ func (k *Keeper) SetUpgradeErrorReceipt(ctx sdk.Context, portID, channelID strin
 func (k *Keeper) SetRecvStartSequence(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	k.setRecvStartSequence(ctx, portID, channelID, sequence)
 }
+
+// RecordPacketSendTime is a wrapper around recordPacketSendTime to allow the function to be directly called in tests.
+func (k *Keeper) RecordPacketSendTime(ctx sdk.Context, packet types.Packet) {
+	k.recordPacketSendTime(ctx, packet)
+}
+
+// PacketSendTimesCount returns the number of packet send times held in memory.
+func (k *Keeper) PacketSendTimesCount() int {
+	return k.packetSendTimes.count()
+}
+
+// MaxPacketSendTimes is the maximum number of packet send times held in memory.
+const MaxPacketSendTimes = maxPacketSendTimes
@@ -84,6 +84,57 @@ func (k *Keeper) Channels(c context.Context, req *types.QueryChannelsRequest) (*
 	}, nil
 }
 
+// ChannelsByState implements the Query/ChannelsByState gRPC method
+func (k *Keeper) ChannelsByState(c context.Context, req *types.QueryChannelsByStateRequest) (*types.QueryChannelsByStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if _, ok := types.State_name[int32(req.State)]; !ok || req.State == types.UNINITIALIZED {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid channel state %s", req.State)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var channels []*types.IdentifiedChannel
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(host.KeyChannelEndPrefix))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var result types.Channel
+		if err := k.cdc.Unmarshal(value, &result); err != nil {
+			return false, err
+		}
+
+		// ignore channel and continue to the next item if the state is
+		// different than the requested one
+		if result.State != req.State {
+			return false, nil
+		}
+
+		if accumulate {
+			portID, channelID, err := host.ParseChannelPath(string(key))
+			if err != nil {
+				return false, err
+			}
+
+			identifiedChannel := types.NewIdentifiedChannel(portID, channelID, result)
+			channels = append(channels, &identifiedChannel)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryChannelsByStateResponse{
+		Channels:   channels,
+		Pagination: pageRes,
+		Height:     selfHeight,
+	}, nil
+}
+
 // ConnectionChannels implements the Query/ConnectionChannels gRPC method
 func (k *Keeper) ConnectionChannels(c context.Context, req *types.QueryConnectionChannelsRequest) (*types.QueryConnectionChannelsResponse, error) {
 	if req == nil {
@@ -307,6 +358,51 @@ func (k *Keeper) PacketReceipt(c context.Context, req *types.QueryPacketReceiptR
 	return types.NewQueryPacketReceiptResponse(recvd, nil, selfHeight), nil
 }
 
+// PacketReceiptCount implements the Query/PacketReceiptCount gRPC method. The
+// packet receipts of the channel are paginated to bound the work done by a single
+// request, the count and size returned only cover the receipts of the requested
+// page. Callers may follow the next key of the pagination response to count all
+// receipts of the channel.
+func (k *Keeper) PacketReceiptCount(c context.Context, req *types.QueryPacketReceiptCountRequest) (*types.QueryPacketReceiptCountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasChannel(ctx, req.PortId, req.ChannelId) {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	var count, sizeBytes uint64
+	receiptPrefix := []byte(host.PacketReceiptPrefixPath(req.PortId, req.ChannelId))
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), receiptPrefix)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		count++
+		sizeBytes += uint64(len(receiptPrefix) + len(key) + len(value))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPacketReceiptCountResponse{
+		Count:      count,
+		SizeBytes:  sizeBytes,
+		Pagination: pageRes,
+		Height:     selfHeight,
+	}, nil
+}
+
 // PacketAcknowledgement implements the Query/PacketAcknowledgement gRPC method
 func (k *Keeper) PacketAcknowledgement(c context.Context, req *types.QueryPacketAcknowledgementRequest) (*types.QueryPacketAcknowledgementResponse, error) {
 	if req == nil {
@@ -338,6 +434,72 @@ func (k *Keeper) PacketAcknowledgement(c context.Context, req *types.QueryPacket
 	return types.NewQueryPacketAcknowledgementResponse(acknowledgementBz, nil, selfHeight), nil
 }
 
+// PacketState implements the Query/PacketState gRPC method. It consolidates the packet commitment, receipt and
+// acknowledgement stored for the sequence on the channel into a single view of the packet lifecycle.
+func (k *Keeper) PacketState(c context.Context, req *types.QueryPacketStateRequest) (*types.QueryPacketStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if req.Sequence == 0 {
+		return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	channel, found := k.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	nextSequenceSend, found := k.GetNextSequenceSend(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrSequenceSendNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	res := &types.QueryPacketStateResponse{
+		Ordering:       channel.Ordering,
+		Sent:           req.Sequence < nextSequenceSend,
+		Commitment:     k.GetPacketCommitment(ctx, req.PortId, req.ChannelId, req.Sequence),
+		TimeoutReceipt: k.HasPacketTimeoutReceipt(ctx, req.PortId, req.ChannelId, req.Sequence),
+		Height:         clienttypes.GetSelfHeight(ctx),
+	}
+
+	// unordered channels record received packets with a receipt, ordered channels with the next sequence receive
+	if channel.Ordering == types.UNORDERED {
+		_, res.Received = k.GetPacketReceipt(ctx, req.PortId, req.ChannelId, req.Sequence)
+	} else {
+		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, req.PortId, req.ChannelId)
+		if !found {
+			return nil, status.Error(
+				codes.NotFound,
+				errorsmod.Wrapf(types.ErrSequenceReceiveNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+			)
+		}
+
+		res.Received = req.Sequence < nextSequenceRecv
+	}
+
+	// a packet for which a timeout receipt was written was never received
+	res.Received = res.Received && !res.TimeoutReceipt
+
+	res.Acknowledgement, _ = k.GetPacketAcknowledgement(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if age, found := k.GetPacketAcknowledgementAge(ctx, req.PortId, req.ChannelId, req.Sequence); found {
+		res.AcknowledgementAge = &age
+	}
+
+	return res, nil
+}
+
 // PacketAcknowledgements implements the Query/PacketAcknowledgements gRPC method
 func (k *Keeper) PacketAcknowledgements(c context.Context, req *types.QueryPacketAcknowledgementsRequest) (*types.QueryPacketAcknowledgementsResponse, error) {
 	if req == nil {
@@ -405,6 +567,84 @@ func (k *Keeper) PacketAcknowledgements(c context.Context, req *types.QueryPacke
 	}, nil
 }
 
+// UnrelayedAcknowledgements implements the Query/UnrelayedAcknowledgements gRPC method. Acknowledgements of
+// packets with a sequence below the recv start sequence of the channel have been relayed, as the counterparty
+// has no packets in flight once a channel upgrade completes. All other stored acknowledgements may not have been
+// relayed yet and are returned along with the height and time at which they were written. If the sequences of
+// the packet commitments still stored on the counterparty are provided, only the acknowledgements of those packets
+// are returned, as the acknowledgements of all other packets have been relayed. Acknowledgements written before
+// their age was recorded are returned with a zero height and timestamp.
+func (k *Keeper) UnrelayedAcknowledgements(c context.Context, req *types.QueryUnrelayedAcknowledgementsRequest) (*types.QueryUnrelayedAcknowledgementsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasChannel(ctx, req.PortId, req.ChannelId) {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	recvStartSequence, _ := k.GetRecvStartSequence(ctx, req.PortId, req.ChannelId)
+
+	commitmentSequences := make(map[uint64]bool, len(req.PacketCommitmentSequences))
+	for _, seq := range req.PacketCommitmentSequences {
+		if seq == 0 {
+			return nil, status.Error(codes.InvalidArgument, "packet sequence cannot be 0")
+		}
+
+		commitmentSequences[seq] = true
+	}
+
+	var acks []types.PacketAcknowledgementAge
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), []byte(host.PacketAcknowledgementPrefixPath(req.PortId, req.ChannelId)))
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, _ []byte, accumulate bool) (bool, error) {
+		keySplit := strings.Split(string(key), "/")
+
+		sequence, err := strconv.ParseUint(keySplit[len(keySplit)-1], 10, 64)
+		if err != nil {
+			return false, err
+		}
+
+		if sequence < recvStartSequence {
+			return false, nil
+		}
+
+		if len(commitmentSequences) != 0 && !commitmentSequences[sequence] {
+			return false, nil
+		}
+
+		if accumulate {
+			age, found := k.GetPacketAcknowledgementAge(ctx, req.PortId, req.ChannelId, sequence)
+			if !found {
+				age = types.NewPacketAcknowledgementAge(req.PortId, req.ChannelId, sequence, clienttypes.ZeroHeight(), 0)
+			}
+
+			acks = append(acks, age)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryUnrelayedAcknowledgementsResponse{
+		Acknowledgements: acks,
+		Pagination:       pageRes,
+		Height:           selfHeight,
+	}, nil
+}
+
 // UnreceivedPackets implements the Query/UnreceivedPackets gRPC method. Given
 // a list of counterparty packet commitments, the querier checks if the packet
 // has already been received by checking if a receipt exists on this
@@ -432,61 +672,41 @@ func (k *Keeper) UnreceivedPackets(c context.Context, req *types.QueryUnreceived
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	channel, found := k.GetChannel(ctx, req.PortId, req.ChannelId)
-	if !found {
-		return nil, status.Error(
-			codes.NotFound,
-			errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
-		)
+	unreceivedSequences, err := k.unreceivedPackets(ctx, req.PortId, req.ChannelId, req.PacketCommitmentSequences)
+	if err != nil {
+		return nil, err
 	}
 
-	var unreceivedSequences []uint64
-	switch channel.Ordering {
-	case types.UNORDERED:
-		for i, seq := range req.PacketCommitmentSequences {
-			// filter for invalid sequences to ensure they are not included in the response value.
-			if seq == 0 {
-				return nil, status.Errorf(codes.InvalidArgument, "packet sequence %d cannot be 0", i)
-			}
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryUnreceivedPacketsResponse{
+		Sequences: unreceivedSequences,
+		Height:    selfHeight,
+	}, nil
+}
 
-			// if the packet receipt does not exist, then it is unreceived
-			if _, found := k.GetPacketReceipt(ctx, req.PortId, req.ChannelId, seq); !found {
-				unreceivedSequences = append(unreceivedSequences, seq)
-			}
-		}
-	case types.ORDERED:
-		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, req.PortId, req.ChannelId)
-		if !found {
-			return nil, status.Error(
-				codes.NotFound,
-				errorsmod.Wrapf(
-					types.ErrSequenceReceiveNotFound,
-					"destination port: %s, destination channel: %s", req.PortId, req.ChannelId,
-				).Error(),
-			)
-		}
+// UnreceivedPacketsCount implements the Query/UnreceivedPacketsCount gRPC method.
+// It returns the number of sequences which would be returned by the
+// Query/UnreceivedPackets gRPC method for the same request.
+func (k *Keeper) UnreceivedPacketsCount(c context.Context, req *types.QueryUnreceivedPacketsCountRequest) (*types.QueryUnreceivedPacketsCountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
 
-		for i, seq := range req.PacketCommitmentSequences {
-			// filter for invalid sequences to ensure they are not included in the response value.
-			if seq == 0 {
-				return nil, status.Errorf(codes.InvalidArgument, "packet sequence %d cannot be 0", i)
-			}
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
 
-			// Any sequence greater than or equal to the next sequence to be received is not received.
-			if seq >= nextSequenceRecv {
-				unreceivedSequences = append(unreceivedSequences, seq)
-			}
-		}
-	default:
-		return nil, status.Error(
-			codes.InvalidArgument,
-			errorsmod.Wrapf(types.ErrInvalidChannelOrdering, "channel order %s is not supported", channel.Ordering.String()).Error())
+	ctx := sdk.UnwrapSDKContext(c)
+
+	unreceivedSequences, err := k.unreceivedPackets(ctx, req.PortId, req.ChannelId, req.PacketCommitmentSequences)
+	if err != nil {
+		return nil, err
 	}
 
 	selfHeight := clienttypes.GetSelfHeight(ctx)
-	return &types.QueryUnreceivedPacketsResponse{
-		Sequences: unreceivedSequences,
-		Height:    selfHeight,
+	return &types.QueryUnreceivedPacketsCountResponse{
+		Count:  uint64(len(unreceivedSequences)),
+		Height: selfHeight,
 	}, nil
 }
 
@@ -604,6 +824,86 @@ func (k *Keeper) NextSequenceSend(c context.Context, req *types.QueryNextSequenc
 	return types.NewQueryNextSequenceSendResponse(sequence, nil, selfHeight), nil
 }
 
+// ChannelSequences implements the Query/ChannelSequences gRPC method
+func (k *Keeper) ChannelSequences(c context.Context, req *types.QueryChannelSequencesRequest) (*types.QueryChannelSequencesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	// merkle proofs are only available through ABCI store queries
+	if req.WithProof {
+		return nil, status.Error(codes.InvalidArgument, "proofs of the channel sequences must be queried through ABCI store queries")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	channel, found := k.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	sequenceSend, found := k.GetNextSequenceSend(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrSequenceSendNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	// Return the next sequence received and acknowledged for ordered channels. Unordered
+	// channels do not make use of the next sequence receive and acknowledgement.
+	var sequenceRecv, sequenceAck uint64
+	if channel.Ordering != types.UNORDERED {
+		sequenceRecv, found = k.GetNextSequenceRecv(ctx, req.PortId, req.ChannelId)
+		if !found {
+			return nil, status.Error(
+				codes.NotFound,
+				errorsmod.Wrapf(types.ErrSequenceReceiveNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+			)
+		}
+
+		sequenceAck, found = k.GetNextSequenceAck(ctx, req.PortId, req.ChannelId)
+		if !found {
+			return nil, status.Error(
+				codes.NotFound,
+				errorsmod.Wrapf(types.ErrSequenceAckNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+			)
+		}
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return types.NewQueryChannelSequencesResponse(channel.State, channel.Ordering, sequenceSend, sequenceRecv, sequenceAck, nil, nil, nil, selfHeight), nil
+}
+
+// ChannelSendPaused implements the Query/ChannelSendPaused gRPC method
+func (k *Keeper) ChannelSendPaused(c context.Context, req *types.QueryChannelSendPausedRequest) (*types.QueryChannelSendPausedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !k.HasChannel(ctx, req.PortId, req.ChannelId) {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryChannelSendPausedResponse{
+		Paused: k.IsChannelSendPaused(ctx, req.PortId, req.ChannelId),
+	}, nil
+}
+
 // UpgradeErrorReceipt implements the Query/UpgradeErrorReceipt gRPC method
 func (k *Keeper) UpgradeErrorReceipt(c context.Context, req *types.QueryUpgradeErrorRequest) (*types.QueryUpgradeErrorResponse, error) {
 	if req == nil {
@@ -666,6 +966,48 @@ func (k *Keeper) Upgrade(c context.Context, req *types.QueryUpgradeRequest) (*ty
 	return types.NewQueryUpgradeResponse(upgrade, nil, selfHeight), nil
 }
 
+// ChannelUpgradeInfo implements the Query/ChannelUpgradeInfo gRPC method
+func (k *Keeper) ChannelUpgradeInfo(c context.Context, req *types.QueryChannelUpgradeInfoRequest) (*types.QueryChannelUpgradeInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	// merkle proofs are only available through ABCI store queries
+	if req.WithProof {
+		return nil, status.Error(codes.InvalidArgument, "proofs of the channel upgrade info must be queried through ABCI store queries")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	channel, found := k.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	res := &types.QueryChannelUpgradeInfoResponse{
+		UpgradeSequence:    channel.UpgradeSequence,
+		ChannelState:       channel.State,
+		HasInflightPackets: k.HasInflightPackets(ctx, req.PortId, req.ChannelId),
+		ProofHeight:        clienttypes.GetSelfHeight(ctx),
+	}
+
+	if upgrade, found := k.GetUpgrade(ctx, req.PortId, req.ChannelId); found {
+		res.Upgrade = &upgrade
+	}
+
+	if receipt, found := k.GetUpgradeErrorReceipt(ctx, req.PortId, req.ChannelId); found {
+		res.ErrorReceipt = &receipt
+	}
+
+	return res, nil
+}
+
 // ChannelParams implements the Query/ChannelParams gRPC method.
 func (k *Keeper) ChannelParams(c context.Context, req *types.QueryChannelParamsRequest) (*types.QueryChannelParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -675,3 +1017,60 @@ func (k *Keeper) ChannelParams(c context.Context, req *types.QueryChannelParamsR
 		Params: &params,
 	}, nil
 }
+
+// unreceivedPackets returns the subset of the provided packet sequences which have not
+// been received yet on the given channel.
+func (k *Keeper) unreceivedPackets(ctx sdk.Context, portID, channelID string, packetCommitmentSequences []uint64) ([]uint64, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port-id: %s, channel-id %s", portID, channelID).Error(),
+		)
+	}
+
+	var unreceivedSequences []uint64
+	switch channel.Ordering {
+	case types.UNORDERED:
+		for i, seq := range packetCommitmentSequences {
+			// filter for invalid sequences to ensure they are not included in the response value.
+			if seq == 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "packet sequence %d cannot be 0", i)
+			}
+
+			// if the packet receipt does not exist, then it is unreceived
+			if _, found := k.GetPacketReceipt(ctx, portID, channelID, seq); !found {
+				unreceivedSequences = append(unreceivedSequences, seq)
+			}
+		}
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
+		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, portID, channelID)
+		if !found {
+			return nil, status.Error(
+				codes.NotFound,
+				errorsmod.Wrapf(
+					types.ErrSequenceReceiveNotFound,
+					"destination port: %s, destination channel: %s", portID, channelID,
+				).Error(),
+			)
+		}
+
+		for i, seq := range packetCommitmentSequences {
+			// filter for invalid sequences to ensure they are not included in the response value.
+			if seq == 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "packet sequence %d cannot be 0", i)
+			}
+
+			// Any sequence greater than or equal to the next sequence to be received is not received.
+			if seq >= nextSequenceRecv {
+				unreceivedSequences = append(unreceivedSequences, seq)
+			}
+		}
+	default:
+		return nil, status.Error(
+			codes.InvalidArgument,
+			errorsmod.Wrapf(types.ErrInvalidChannelOrdering, "channel order %s is not supported", channel.Ordering.String()).Error())
+	}
+
+	return unreceivedSequences, nil
+}
@@ -2,6 +2,10 @@ package keeper_test
 
 import (
 	"fmt"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -207,6 +211,200 @@ func (suite *KeeperTestSuite) TestQueryChannels() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryChannelsByState() {
+	var (
+		req         *types.QueryChannelsByStateRequest
+		expChannels []*types.IdentifiedChannel
+		expTotal    uint64
+	)
+
+	// setupChannels creates one channel on chainA in each of the INIT, TRYOPEN, OPEN and CLOSED states
+	// and returns the identified channel ends keyed by state.
+	setupChannels := func() map[types.State]types.IdentifiedChannel {
+		openPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+		openPath.Setup()
+
+		newPathOnConnection := func() *ibctesting.Path {
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ClientID = openPath.EndpointA.ClientID
+			path.EndpointB.ClientID = openPath.EndpointB.ClientID
+			path.EndpointA.ConnectionID = openPath.EndpointA.ConnectionID
+			path.EndpointB.ConnectionID = openPath.EndpointB.ConnectionID
+			return path
+		}
+
+		initPath := newPathOnConnection()
+		suite.Require().NoError(initPath.EndpointA.ChanOpenInit())
+
+		tryPath := newPathOnConnection()
+		suite.Require().NoError(tryPath.EndpointB.ChanOpenInit())
+		suite.Require().NoError(tryPath.EndpointA.ChanOpenTry())
+
+		closedPath := newPathOnConnection()
+		suite.coordinator.CreateChannels(closedPath)
+		suite.Require().NoError(closedPath.EndpointA.SetChannelState(types.CLOSED))
+
+		channels := make(map[types.State]types.IdentifiedChannel)
+		for _, endpoint := range []*ibctesting.Endpoint{openPath.EndpointA, initPath.EndpointA, tryPath.EndpointA, closedPath.EndpointA} {
+			channel := endpoint.GetChannel()
+			channels[channel.State] = types.NewIdentifiedChannel(endpoint.ChannelConfig.PortID, endpoint.ChannelID, channel)
+		}
+
+		suite.Require().Len(channels, 4)
+		return channels
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: INIT",
+			func() {
+				channel := setupChannels()[types.INIT]
+				expChannels = []*types.IdentifiedChannel{&channel}
+				expTotal = 1
+
+				req = &types.QueryChannelsByStateRequest{
+					State:      types.INIT,
+					Pagination: &query.PageRequest{CountTotal: true},
+				}
+			},
+			nil,
+		},
+		{
+			"success: TRYOPEN",
+			func() {
+				channel := setupChannels()[types.TRYOPEN]
+				expChannels = []*types.IdentifiedChannel{&channel}
+				expTotal = 1
+
+				req = &types.QueryChannelsByStateRequest{
+					State:      types.TRYOPEN,
+					Pagination: &query.PageRequest{CountTotal: true},
+				}
+			},
+			nil,
+		},
+		{
+			"success: OPEN",
+			func() {
+				channel := setupChannels()[types.OPEN]
+				expChannels = []*types.IdentifiedChannel{&channel}
+				expTotal = 1
+
+				req = &types.QueryChannelsByStateRequest{
+					State:      types.OPEN,
+					Pagination: &query.PageRequest{CountTotal: true},
+				}
+			},
+			nil,
+		},
+		{
+			"success: CLOSED",
+			func() {
+				channel := setupChannels()[types.CLOSED]
+				expChannels = []*types.IdentifiedChannel{&channel}
+				expTotal = 1
+
+				req = &types.QueryChannelsByStateRequest{
+					State:      types.CLOSED,
+					Pagination: &query.PageRequest{CountTotal: true},
+				}
+			},
+			nil,
+		},
+		{
+			"success: paginated results only include channels in the requested state",
+			func() {
+				initChannel := setupChannels()[types.INIT]
+
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupConnections()
+				suite.Require().NoError(path.EndpointA.ChanOpenInit())
+				secondInitChannel := types.NewIdentifiedChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointA.GetChannel())
+
+				// both INIT channels are bound to the same port, so the first page holds the
+				// channel with the lexicographically smallest identifier
+				channels := []types.IdentifiedChannel{initChannel, secondInitChannel}
+				sort.Slice(channels, func(i, j int) bool { return channels[i].ChannelId < channels[j].ChannelId })
+				expChannels = []*types.IdentifiedChannel{&channels[0]}
+				expTotal = 2
+
+				req = &types.QueryChannelsByStateRequest{
+					State:      types.INIT,
+					Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+				}
+			},
+			nil,
+		},
+		{
+			"success: no channels in the requested state",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				expChannels = nil
+				expTotal = 0
+
+				req = &types.QueryChannelsByStateRequest{
+					State:      types.FLUSHING,
+					Pagination: &query.PageRequest{CountTotal: true},
+				}
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid state: UNINITIALIZED",
+			func() {
+				req = &types.QueryChannelsByStateRequest{
+					State: types.UNINITIALIZED,
+				}
+			},
+			status.Error(codes.InvalidArgument, "invalid channel state STATE_UNINITIALIZED_UNSPECIFIED"),
+		},
+		{
+			"invalid state: unknown",
+			func() {
+				req = &types.QueryChannelsByStateRequest{
+					State: types.State(100),
+				}
+			},
+			status.Error(codes.InvalidArgument, "invalid channel state 100"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.ChannelsByState(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expChannels, res.Channels)
+				suite.Require().Equal(expTotal, res.Pagination.Total)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryConnectionChannels() {
 	var (
 		req         *types.QueryConnectionChannelsRequest
@@ -934,10 +1132,14 @@ func (suite *KeeperTestSuite) TestQueryPacketReceipt() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestQueryPacketAcknowledgement() {
+func (suite *KeeperTestSuite) TestQueryPacketReceiptCount() {
+	const numReceipts = 300
+
 	var (
-		req    *types.QueryPacketAcknowledgementRequest
-		expAck []byte
+		req          *types.QueryPacketReceiptCountRequest
+		expCount     uint64
+		expSizeBytes uint64
+		expNextKey   bool
 	)
 
 	testCases := []struct {
@@ -955,10 +1157,9 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgement() {
 		{
 			"invalid port ID",
 			func() {
-				req = &types.QueryPacketAcknowledgementRequest{
+				req = &types.QueryPacketReceiptCountRequest{
 					PortId:    "",
 					ChannelId: "test-channel-id",
-					Sequence:  0,
 				}
 			},
 			false,
@@ -966,65 +1167,82 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgement() {
 		{
 			"invalid channel ID",
 			func() {
-				req = &types.QueryPacketAcknowledgementRequest{
+				req = &types.QueryPacketReceiptCountRequest{
 					PortId:    "test-port-id",
 					ChannelId: "",
-					Sequence:  0,
 				}
 			},
 			false,
 		},
 		{
-			"invalid sequence",
+			"channel not found",
 			func() {
-				req = &types.QueryPacketAcknowledgementRequest{
+				req = &types.QueryPacketReceiptCountRequest{
 					PortId:    "test-port-id",
 					ChannelId: "test-channel-id",
-					Sequence:  0,
 				}
 			},
 			false,
 		},
 		{
-			"ack not found",
+			"success: no receipts",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.Setup()
-				expAck = []byte("hash")
-				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, expAck)
 
-				req = &types.QueryPacketAcknowledgementRequest{
+				req = &types.QueryPacketReceiptCountRequest{
 					PortId:    path.EndpointA.ChannelConfig.PortID,
 					ChannelId: path.EndpointA.ChannelID,
-					Sequence:  2,
 				}
 			},
-			false,
+			true,
 		},
 		{
-			"channel not found",
+			"success: all receipts",
 			func() {
-				req = &types.QueryPacketAcknowledgementRequest{
-					PortId:    "test-port-id",
-					ChannelId: "test-channel-id",
-					Sequence:  1,
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				for seq := uint64(1); seq <= numReceipts; seq++ {
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+					expSizeBytes += uint64(len(host.PacketReceiptKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)) + 1)
+				}
+
+				// receipts of other channels are not counted
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID, 1)
+
+				req = &types.QueryPacketReceiptCountRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Pagination: &query.PageRequest{
+						Limit: numReceipts,
+					},
 				}
+				expCount = numReceipts
 			},
-			false,
+			true,
 		},
 		{
-			"success",
+			"success: first page of receipts",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.Setup()
-				expAck = []byte("hash")
-				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, expAck)
 
-				req = &types.QueryPacketAcknowledgementRequest{
+				// use sequences of equal length so every receipt occupies the same number of bytes
+				for seq := uint64(100); seq < 100+numReceipts; seq++ {
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+				}
+				expSizeBytes = 10 * uint64(len(host.PacketReceiptKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 100))+1)
+
+				req = &types.QueryPacketReceiptCountRequest{
 					PortId:    path.EndpointA.ChannelConfig.PortID,
 					ChannelId: path.EndpointA.ChannelID,
-					Sequence:  1,
+					Pagination: &query.PageRequest{
+						Limit: 10,
+					},
 				}
+				expCount = 10
+				expNextKey = true
 			},
 			true,
 		},
@@ -1036,15 +1254,19 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgement() {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 
+			expCount, expSizeBytes, expNextKey = 0, 0, false
+
 			tc.malleate()
 			ctx := suite.chainA.GetContext()
 
-			res, err := suite.chainA.QueryServer.PacketAcknowledgement(ctx, req)
+			res, err := suite.chainA.QueryServer.PacketReceiptCount(ctx, req)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
-				suite.Require().Equal(expAck, res.Acknowledgement)
+				suite.Require().Equal(expCount, res.Count)
+				suite.Require().Equal(expSizeBytes, res.SizeBytes)
+				suite.Require().Equal(expNextKey, res.Pagination.NextKey != nil)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -1052,10 +1274,47 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgement() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestQueryPacketAcknowledgements() {
+func (suite *KeeperTestSuite) TestQueryPacketReceiptCountAllPages() {
+	const numReceipts = 300
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	for seq := uint64(1); seq <= numReceipts; seq++ {
+		suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+	}
+
 	var (
-		req                 *types.QueryPacketAcknowledgementsRequest
-		expAcknowledgements = []*types.PacketState{}
+		count   uint64
+		nextKey []byte
+	)
+
+	for {
+		res, err := suite.chainA.QueryServer.PacketReceiptCount(suite.chainA.GetContext(), &types.QueryPacketReceiptCountRequest{
+			PortId:    path.EndpointA.ChannelConfig.PortID,
+			ChannelId: path.EndpointA.ChannelID,
+			Pagination: &query.PageRequest{
+				Key:   nextKey,
+				Limit: 50,
+			},
+		})
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(res.Count, uint64(50))
+
+		count += res.Count
+		nextKey = res.Pagination.NextKey
+		if nextKey == nil {
+			break
+		}
+	}
+
+	suite.Require().Equal(uint64(numReceipts), count)
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketAcknowledgement() {
+	var (
+		req    *types.QueryPacketAcknowledgementRequest
+		expAck []byte
 	)
 
 	testCases := []struct {
@@ -1071,74 +1330,77 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgements() {
 			false,
 		},
 		{
-			"invalid ID",
+			"invalid port ID",
 			func() {
-				req = &types.QueryPacketAcknowledgementsRequest{
+				req = &types.QueryPacketAcknowledgementRequest{
 					PortId:    "",
 					ChannelId: "test-channel-id",
+					Sequence:  0,
 				}
 			},
 			false,
 		},
 		{
-			"channel not found",
+			"invalid channel ID",
 			func() {
-				req = &types.QueryPacketAcknowledgementsRequest{
+				req = &types.QueryPacketAcknowledgementRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
+					Sequence:  0,
+				}
+			},
+			false,
+		},
+		{
+			"invalid sequence",
+			func() {
+				req = &types.QueryPacketAcknowledgementRequest{
 					PortId:    "test-port-id",
 					ChannelId: "test-channel-id",
+					Sequence:  0,
 				}
 			},
 			false,
 		},
 		{
-			"success, filtered res",
+			"ack not found",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.Setup()
+				expAck = []byte("hash")
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, expAck)
 
-				var commitments []uint64
-
-				for i := uint64(0); i < 100; i++ {
-					ack := types.NewPacketState(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, i, []byte(fmt.Sprintf("hash_%d", i)))
-					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), ack.PortId, ack.ChannelId, ack.Sequence, ack.Data)
-
-					if i < 10 { // populate the store with 100 and query for 10 specific acks
-						expAcknowledgements = append(expAcknowledgements, &ack)
-						commitments = append(commitments, ack.Sequence)
-					}
+				req = &types.QueryPacketAcknowledgementRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Sequence:  2,
 				}
-
-				req = &types.QueryPacketAcknowledgementsRequest{
-					PortId:                    path.EndpointA.ChannelConfig.PortID,
-					ChannelId:                 path.EndpointA.ChannelID,
-					PacketCommitmentSequences: commitments,
-					Pagination:                nil,
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryPacketAcknowledgementRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+					Sequence:  1,
 				}
 			},
-			true,
+			false,
 		},
 		{
 			"success",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.Setup()
+				expAck = []byte("hash")
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, expAck)
 
-				expAcknowledgements = make([]*types.PacketState, 9)
-
-				for i := uint64(0); i < 9; i++ {
-					ack := types.NewPacketState(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, i, []byte(fmt.Sprintf("hash_%d", i)))
-					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), ack.PortId, ack.ChannelId, ack.Sequence, ack.Data)
-					expAcknowledgements[i] = &ack
-				}
-
-				req = &types.QueryPacketAcknowledgementsRequest{
+				req = &types.QueryPacketAcknowledgementRequest{
 					PortId:    path.EndpointA.ChannelConfig.PortID,
 					ChannelId: path.EndpointA.ChannelID,
-					Pagination: &query.PageRequest{
-						Key:        nil,
-						Limit:      11,
-						CountTotal: true,
-					},
+					Sequence:  1,
 				}
 			},
 			true,
@@ -1154,12 +1416,12 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgements() {
 			tc.malleate()
 			ctx := suite.chainA.GetContext()
 
-			res, err := suite.chainA.QueryServer.PacketAcknowledgements(ctx, req)
+			res, err := suite.chainA.QueryServer.PacketAcknowledgement(ctx, req)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
-				suite.Require().Equal(expAcknowledgements, res.Acknowledgements)
+				suite.Require().Equal(expAck, res.Acknowledgement)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -1167,40 +1429,774 @@ func (suite *KeeperTestSuite) TestQueryPacketAcknowledgements() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestQueryUnreceivedPackets() {
+func (suite *KeeperTestSuite) TestQueryPacketState() {
 	var (
-		req    *types.QueryUnreceivedPacketsRequest
-		expSeq = []uint64(nil)
+		path   *ibctesting.Path
+		chain  *ibctesting.TestChain
+		req    *types.QueryPacketStateRequest
+		expRes *types.QueryPacketStateResponse
 	)
 
+	// sendPacket sends a mock packet from chainA to chainB and returns it, the query is set for the packet on chainA
+	sendPacket := func(timeoutHeight clienttypes.Height) types.Packet {
+		sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		chain = suite.chainA
+		req = &types.QueryPacketStateRequest{
+			PortId:    path.EndpointA.ChannelConfig.PortID,
+			ChannelId: path.EndpointA.ChannelID,
+			Sequence:  sequence,
+		}
+
+		return types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	}
+
+	// queryOnChainB sets the query for the packet on chainB
+	queryOnChainB := func(packet types.Packet) {
+		chain = suite.chainB
+		req = &types.QueryPacketStateRequest{
+			PortId:    path.EndpointB.ChannelConfig.PortID,
+			ChannelId: path.EndpointB.ChannelID,
+			Sequence:  packet.GetSequence(),
+		}
+	}
+
 	testCases := []struct {
 		msg      string
 		malleate func()
-		expPass  bool
+		expErr   error
 	}{
 		{
-			"empty request",
+			"success: packet not sent",
 			func() {
-				req = nil
+				path.Setup()
+
+				req.Sequence = 1
+				expRes = &types.QueryPacketStateResponse{Ordering: types.UNORDERED}
 			},
-			false,
+			nil,
 		},
 		{
-			"invalid port ID",
+			"success: sent packet awaiting acknowledgement",
 			func() {
-				req = &types.QueryUnreceivedPacketsRequest{
-					PortId:    "",
+				path.Setup()
+
+				packet := sendPacket(clienttypes.NewHeight(1, 1000))
+				expRes = &types.QueryPacketStateResponse{
+					Ordering:   types.UNORDERED,
+					Sent:       true,
+					Commitment: types.CommitPacket(suite.chainA.App.AppCodec(), packet),
+				}
+			},
+			nil,
+		},
+		{
+			"success: received packet",
+			func() {
+				path.Setup()
+
+				packet := sendPacket(clienttypes.NewHeight(1, 1000))
+				suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+
+				queryOnChainB(packet)
+				expRes = &types.QueryPacketStateResponse{
+					Ordering:        types.UNORDERED,
+					Received:        true,
+					Acknowledgement: types.CommitAcknowledgement(mock.MockAcknowledgement.Acknowledgement()),
+				}
+			},
+			nil,
+		},
+		{
+			"success: received packet on ordered channel",
+			func() {
+				path.SetChannelOrdered()
+				path.Setup()
+
+				packet := sendPacket(clienttypes.NewHeight(1, 1000))
+				suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+
+				queryOnChainB(packet)
+				expRes = &types.QueryPacketStateResponse{
+					Ordering:        types.ORDERED,
+					Received:        true,
+					Acknowledgement: types.CommitAcknowledgement(mock.MockAcknowledgement.Acknowledgement()),
+				}
+			},
+			nil,
+		},
+		{
+			"success: acknowledged packet",
+			func() {
+				path.Setup()
+
+				packet := sendPacket(clienttypes.NewHeight(1, 1000))
+				suite.Require().NoError(path.RelayPacket(packet))
+
+				expRes = &types.QueryPacketStateResponse{
+					Ordering: types.UNORDERED,
+					Sent:     true,
+				}
+			},
+			nil,
+		},
+		{
+			"success: timed out packet",
+			func() {
+				path.Setup()
+
+				packet := sendPacket(clienttypes.GetSelfHeight(suite.chainB.GetContext()))
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+				expRes = &types.QueryPacketStateResponse{
+					Ordering: types.UNORDERED,
+					Sent:     true,
+				}
+			},
+			nil,
+		},
+		{
+			"success: timeout receipt on ORDERED_ALLOW_TIMEOUT channel",
+			func() {
+				path.SetChannelOrderedAllowTimeout()
+				path.Setup()
+
+				packet := sendPacket(clienttypes.GetSelfHeight(suite.chainB.GetContext()))
+				suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+
+				queryOnChainB(packet)
+				expRes = &types.QueryPacketStateResponse{
+					Ordering:       types.ORDERED_ALLOW_TIMEOUT,
+					TimeoutReceipt: true,
+				}
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"packet sequence is 0",
+			func() {
+				req.Sequence = 0
+			},
+			status.Error(codes.InvalidArgument, "packet sequence cannot be 0"),
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = doesnotexist
+				req.Sequence = 1
+			},
+			status.Error(
+				codes.NotFound,
+				fmt.Sprintf("port ID (%s) channel ID (%s): %s", ibctesting.MockPort, doesnotexist, types.ErrChannelNotFound.Error()),
+			),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			chain = suite.chainA
+			req = &types.QueryPacketStateRequest{
+				PortId:    ibctesting.MockPort,
+				ChannelId: ibctesting.FirstChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := chain.GetContext()
+			res, err := chain.App.GetIBCKeeper().ChannelKeeper.PacketState(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				// the acknowledgement age is recorded along with the acknowledgement
+				suite.Require().Equal(expRes.Acknowledgement != nil, res.AcknowledgementAge != nil)
+				res.AcknowledgementAge = nil
+
+				expRes.Height = clienttypes.GetSelfHeight(ctx)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryPacketAcknowledgements() {
+	var (
+		req                 *types.QueryPacketAcknowledgementsRequest
+		expAcknowledgements = []*types.PacketState{}
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid ID",
+			func() {
+				req = &types.QueryPacketAcknowledgementsRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryPacketAcknowledgementsRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"success, filtered res",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				var commitments []uint64
+
+				for i := uint64(0); i < 100; i++ {
+					ack := types.NewPacketState(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, i, []byte(fmt.Sprintf("hash_%d", i)))
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), ack.PortId, ack.ChannelId, ack.Sequence, ack.Data)
+
+					if i < 10 { // populate the store with 100 and query for 10 specific acks
+						expAcknowledgements = append(expAcknowledgements, &ack)
+						commitments = append(commitments, ack.Sequence)
+					}
+				}
+
+				req = &types.QueryPacketAcknowledgementsRequest{
+					PortId:                    path.EndpointA.ChannelConfig.PortID,
+					ChannelId:                 path.EndpointA.ChannelID,
+					PacketCommitmentSequences: commitments,
+					Pagination:                nil,
+				}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				expAcknowledgements = make([]*types.PacketState, 9)
+
+				for i := uint64(0); i < 9; i++ {
+					ack := types.NewPacketState(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, i, []byte(fmt.Sprintf("hash_%d", i)))
+					suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainA.GetContext(), ack.PortId, ack.ChannelId, ack.Sequence, ack.Data)
+					expAcknowledgements[i] = &ack
+				}
+
+				req = &types.QueryPacketAcknowledgementsRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					Pagination: &query.PageRequest{
+						Key:        nil,
+						Limit:      11,
+						CountTotal: true,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.PacketAcknowledgements(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expAcknowledgements, res.Acknowledgements)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryUnrelayedAcknowledgements() {
+	var (
+		path    *ibctesting.Path
+		req     *types.QueryUnrelayedAcknowledgementsRequest
+		expAcks []types.PacketAcknowledgementAge
+	)
+
+	// recvPacket sends a packet from chainA and receives it on chainB without relaying the acknowledgement back,
+	// returning the expected age of the acknowledgement written on chainB
+	recvPacket := func() types.PacketAcknowledgementAge {
+		sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		// the packet is received in the block proposed next on chainB
+		ctx := suite.chainB.GetContext()
+		expAge := types.NewPacketAcknowledgementAge(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence, clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano()))
+
+		packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+		err = path.EndpointB.RecvPacket(packet)
+		suite.Require().NoError(err)
+
+		return expAge
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid ID",
+			func() {
+				req = &types.QueryUnrelayedAcknowledgementsRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryUnrelayedAcknowledgementsRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"success: no acknowledgements",
+			func() {
+				expAcks = nil
+			},
+			true,
+		},
+		{
+			"success: acknowledgements written at different heights",
+			func() {
+				firstAck := recvPacket()
+				suite.coordinator.IncrementTime()
+				suite.coordinator.CommitBlock(suite.chainB)
+				secondAck := recvPacket()
+
+				suite.Require().True(firstAck.Height.LT(secondAck.Height))
+				suite.Require().Less(firstAck.Timestamp, secondAck.Timestamp)
+
+				expAcks = []types.PacketAcknowledgementAge{firstAck, secondAck}
+			},
+			true,
+		},
+		{
+			"success: relayed acknowledgements below the recv start sequence are excluded",
+			func() {
+				recvPacket()
+				secondAck := recvPacket()
+
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetRecvStartSequence(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, secondAck.Sequence)
+
+				expAcks = []types.PacketAcknowledgementAge{secondAck}
+			},
+			true,
+		},
+		{
+			"success: acknowledgement without recorded age",
+			func() {
+				firstAck := recvPacket()
+
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 5, []byte("hash"))
+
+				expAcks = []types.PacketAcknowledgementAge{
+					firstAck,
+					types.NewPacketAcknowledgementAge(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 5, clienttypes.ZeroHeight(), 0),
+				}
+			},
+			true,
+		},
+		{
+			"success: paginated",
+			func() {
+				firstAck := recvPacket()
+				recvPacket()
+
+				req.Pagination = &query.PageRequest{
+					Limit:      1,
+					CountTotal: true,
+				}
+
+				expAcks = []types.PacketAcknowledgementAge{firstAck}
+			},
+			true,
+		},
+		{
+			"success: only acknowledgements of packets committed on the counterparty",
+			func() {
+				recvPacket()
+				secondAck := recvPacket()
+
+				req.PacketCommitmentSequences = []uint64{secondAck.Sequence, 10}
+
+				expAcks = []types.PacketAcknowledgementAge{secondAck}
+			},
+			true,
+		},
+		{
+			"invalid packet commitment sequence",
+			func() {
+				recvPacket()
+
+				req.PacketCommitmentSequences = []uint64{0}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			req = &types.QueryUnrelayedAcknowledgementsRequest{
+				PortId:    path.EndpointB.ChannelConfig.PortID,
+				ChannelId: path.EndpointB.ChannelID,
+			}
+
+			tc.malleate()
+
+			res, err := suite.chainB.QueryServer.UnrelayedAcknowledgements(suite.chainB.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expAcks, res.Acknowledgements)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryUnreceivedPackets() {
+	var (
+		req    *types.QueryUnreceivedPacketsRequest
+		expSeq = []uint64(nil)
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
+				}
+			},
+			false,
+		},
+		{
+			"invalid seq",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:                    path.EndpointA.ChannelConfig.PortID,
+					ChannelId:                 path.EndpointA.ChannelID,
+					PacketCommitmentSequences: []uint64{0},
+				}
+			},
+			false,
+		},
+		{
+			"invalid seq, ordered channel",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrdered()
+				path.Setup()
+
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:                    path.EndpointA.ChannelConfig.PortID,
+					ChannelId:                 path.EndpointA.ChannelID,
+					PacketCommitmentSequences: []uint64{0},
+				}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:    "invalid-port-id",
+					ChannelId: "invalid-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"basic success empty packet commitments",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				expSeq = []uint64(nil)
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:                    path.EndpointA.ChannelConfig.PortID,
+					ChannelId:                 path.EndpointA.ChannelID,
+					PacketCommitmentSequences: []uint64{},
+				}
+			},
+			true,
+		},
+		{
+			"basic success unreceived packet commitments",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				// no ack exists
+
+				expSeq = []uint64{1}
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:                    path.EndpointA.ChannelConfig.PortID,
+					ChannelId:                 path.EndpointA.ChannelID,
+					PacketCommitmentSequences: []uint64{1},
+				}
+			},
+			true,
+		},
+		{
+			"basic success unreceived packet commitments, nothing to relay",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+
+				expSeq = []uint64(nil)
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:                    path.EndpointA.ChannelConfig.PortID,
+					ChannelId:                 path.EndpointA.ChannelID,
+					PacketCommitmentSequences: []uint64{1},
+				}
+			},
+			true,
+		},
+		{
+			"success multiple unreceived packet commitments",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+				expSeq = []uint64(nil) // reset
+				packetCommitments := []uint64{}
+
+				// set packet receipt for every other sequence
+				for seq := uint64(1); seq < 10; seq++ {
+					packetCommitments = append(packetCommitments, seq)
+
+					if seq%2 == 0 {
+						suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+					} else {
+						expSeq = append(expSeq, seq)
+					}
+				}
+
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:                    path.EndpointA.ChannelConfig.PortID,
+					ChannelId:                 path.EndpointA.ChannelID,
+					PacketCommitmentSequences: packetCommitments,
+				}
+			},
+			true,
+		},
+		{
+			"basic success empty packet commitments, ordered channel",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrdered()
+				path.Setup()
+
+				expSeq = []uint64(nil)
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:                    path.EndpointA.ChannelConfig.PortID,
+					ChannelId:                 path.EndpointA.ChannelID,
+					PacketCommitmentSequences: []uint64{},
+				}
+			},
+			true,
+		},
+		{
+			"basic success unreceived packet commitments, ordered channel",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrdered()
+				path.Setup()
+
+				// Note: NextSequenceRecv is set to 1 on channel creation.
+				expSeq = []uint64{1}
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:                    path.EndpointA.ChannelConfig.PortID,
+					ChannelId:                 path.EndpointA.ChannelID,
+					PacketCommitmentSequences: []uint64{1},
+				}
+			},
+			true,
+		},
+		{
+			"basic success multiple unreceived packet commitments, ordered channel",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrdered()
+				path.Setup()
+
+				// Exercise scenario from issue #1532. NextSequenceRecv is 5, packet commitments provided are 2, 7, 9, 10.
+				// Packet sequence 2 is already received so only sequences 7, 9, 10 should be considered unreceived.
+				expSeq = []uint64{7, 9, 10}
+				packetCommitments := []uint64{2, 7, 9, 10}
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 5)
+
+				req = &types.QueryUnreceivedPacketsRequest{
+					PortId:                    path.EndpointA.ChannelConfig.PortID,
+					ChannelId:                 path.EndpointA.ChannelID,
+					PacketCommitmentSequences: packetCommitments,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.UnreceivedPackets(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSeq, res.Sequences)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryUnreceivedPacketsCount() {
+	const numReceipts = 300
+
+	var (
+		req      *types.QueryUnreceivedPacketsCountRequest
+		expCount uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryUnreceivedPacketsCountRequest{
+					PortId:    "",
 					ChannelId: "test-channel-id",
 				}
 			},
 			false,
 		},
 		{
-			"invalid channel ID",
+			"channel not found",
 			func() {
-				req = &types.QueryUnreceivedPacketsRequest{
+				req = &types.QueryUnreceivedPacketsCountRequest{
 					PortId:    "test-port-id",
-					ChannelId: "",
+					ChannelId: "test-channel-id",
 				}
 			},
 			false,
@@ -1211,7 +2207,7 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedPackets() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.Setup()
 
-				req = &types.QueryUnreceivedPacketsRequest{
+				req = &types.QueryUnreceivedPacketsCountRequest{
 					PortId:                    path.EndpointA.ChannelConfig.PortID,
 					ChannelId:                 path.EndpointA.ChannelID,
 					PacketCommitmentSequences: []uint64{0},
@@ -1220,16 +2216,107 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedPackets() {
 			false,
 		},
 		{
-			"invalid seq, ordered channel",
+			"success: unordered channel",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				// receive every other packet
+				var packetCommitmentSequences []uint64
+				for seq := uint64(1); seq <= numReceipts; seq++ {
+					if seq%2 == 0 {
+						suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+					}
+					packetCommitmentSequences = append(packetCommitmentSequences, seq)
+				}
+
+				req = &types.QueryUnreceivedPacketsCountRequest{
+					PortId:                    path.EndpointA.ChannelConfig.PortID,
+					ChannelId:                 path.EndpointA.ChannelID,
+					PacketCommitmentSequences: packetCommitmentSequences,
+				}
+				expCount = numReceipts / 2
+			},
+			true,
+		},
+		{
+			"success: ordered channel",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.SetChannelOrdered()
 				path.Setup()
 
-				req = &types.QueryUnreceivedPacketsRequest{
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 4)
+
+				req = &types.QueryUnreceivedPacketsCountRequest{
 					PortId:                    path.EndpointA.ChannelConfig.PortID,
 					ChannelId:                 path.EndpointA.ChannelID,
-					PacketCommitmentSequences: []uint64{0},
+					PacketCommitmentSequences: []uint64{1, 2, 3, 4, 5},
+				}
+				expCount = 2
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			expCount = 0
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.UnreceivedPacketsCount(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expCount, res.Count)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryUnreceivedAcks() {
+	var (
+		req    *types.QueryUnreceivedAcksRequest
+		expSeq = []uint64{}
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryUnreceivedAcksRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
+				}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryUnreceivedAcksRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
 				}
 			},
 			false,
@@ -1237,139 +2324,185 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedPackets() {
 		{
 			"channel not found",
 			func() {
-				req = &types.QueryUnreceivedPacketsRequest{
-					PortId:    "invalid-port-id",
-					ChannelId: "invalid-channel-id",
+				req = &types.QueryUnreceivedAcksRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
 				}
 			},
 			false,
 		},
 		{
-			"basic success empty packet commitments",
+			"invalid seq",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.Setup()
 
-				expSeq = []uint64(nil)
-				req = &types.QueryUnreceivedPacketsRequest{
-					PortId:                    path.EndpointA.ChannelConfig.PortID,
-					ChannelId:                 path.EndpointA.ChannelID,
-					PacketCommitmentSequences: []uint64{},
+				req = &types.QueryUnreceivedAcksRequest{
+					PortId:             path.EndpointA.ChannelConfig.PortID,
+					ChannelId:          path.EndpointA.ChannelID,
+					PacketAckSequences: []uint64{0},
 				}
 			},
-			true,
+			false,
 		},
 		{
-			"basic success unreceived packet commitments",
+			"basic success unreceived packet acks",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.Setup()
 
-				// no ack exists
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, []byte("commitment"))
 
 				expSeq = []uint64{1}
-				req = &types.QueryUnreceivedPacketsRequest{
-					PortId:                    path.EndpointA.ChannelConfig.PortID,
-					ChannelId:                 path.EndpointA.ChannelID,
-					PacketCommitmentSequences: []uint64{1},
+				req = &types.QueryUnreceivedAcksRequest{
+					PortId:             path.EndpointA.ChannelConfig.PortID,
+					ChannelId:          path.EndpointA.ChannelID,
+					PacketAckSequences: []uint64{1},
 				}
 			},
 			true,
 		},
 		{
-			"basic success unreceived packet commitments, nothing to relay",
+			"basic success unreceived packet acknowledgements, nothing to relay",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.Setup()
 
-				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
-
 				expSeq = []uint64(nil)
-				req = &types.QueryUnreceivedPacketsRequest{
-					PortId:                    path.EndpointA.ChannelConfig.PortID,
-					ChannelId:                 path.EndpointA.ChannelID,
-					PacketCommitmentSequences: []uint64{1},
+				req = &types.QueryUnreceivedAcksRequest{
+					PortId:             path.EndpointA.ChannelConfig.PortID,
+					ChannelId:          path.EndpointA.ChannelID,
+					PacketAckSequences: []uint64{1},
+				}
+			},
+			true,
+		},
+		{
+			"success multiple unreceived packet acknowledgements",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+				expSeq = []uint64{} // reset
+				packetAcks := []uint64{}
+
+				// set packet commitment for every other sequence
+				for seq := uint64(1); seq < 10; seq++ {
+					packetAcks = append(packetAcks, seq)
+
+					if seq%2 == 0 {
+						suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq, []byte("commitement"))
+						expSeq = append(expSeq, seq)
+					}
 				}
+
+				req = &types.QueryUnreceivedAcksRequest{
+					PortId:             path.EndpointA.ChannelConfig.PortID,
+					ChannelId:          path.EndpointA.ChannelID,
+					PacketAckSequences: packetAcks,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.QueryServer.UnreceivedAcks(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expSeq, res.Sequences)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
+	var (
+		req    *types.QueryNextSequenceReceiveRequest
+		expSeq uint64
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"empty request",
+			func() {
+				req = nil
 			},
-			true,
+			false,
 		},
 		{
-			"success multiple unreceived packet commitments",
+			"invalid port ID",
 			func() {
-				path := ibctesting.NewPath(suite.chainA, suite.chainB)
-				path.Setup()
-				expSeq = []uint64(nil) // reset
-				packetCommitments := []uint64{}
-
-				// set packet receipt for every other sequence
-				for seq := uint64(1); seq < 10; seq++ {
-					packetCommitments = append(packetCommitments, seq)
-
-					if seq%2 == 0 {
-						suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
-					} else {
-						expSeq = append(expSeq, seq)
-					}
+				req = &types.QueryNextSequenceReceiveRequest{
+					PortId:    "",
+					ChannelId: "test-channel-id",
 				}
-
-				req = &types.QueryUnreceivedPacketsRequest{
-					PortId:                    path.EndpointA.ChannelConfig.PortID,
-					ChannelId:                 path.EndpointA.ChannelID,
-					PacketCommitmentSequences: packetCommitments,
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryNextSequenceReceiveRequest{
+					PortId:    "test-port-id",
+					ChannelId: "",
 				}
 			},
-			true,
+			false,
 		},
 		{
-			"basic success empty packet commitments, ordered channel",
+			"channel not found",
 			func() {
-				path := ibctesting.NewPath(suite.chainA, suite.chainB)
-				path.SetChannelOrdered()
-				path.Setup()
-
-				expSeq = []uint64(nil)
-				req = &types.QueryUnreceivedPacketsRequest{
-					PortId:                    path.EndpointA.ChannelConfig.PortID,
-					ChannelId:                 path.EndpointA.ChannelID,
-					PacketCommitmentSequences: []uint64{},
+				req = &types.QueryNextSequenceReceiveRequest{
+					PortId:    "test-port-id",
+					ChannelId: "test-channel-id",
 				}
 			},
-			true,
+			false,
 		},
 		{
-			"basic success unreceived packet commitments, ordered channel",
+			"basic success on unordered channel returns zero",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
-				path.SetChannelOrdered()
 				path.Setup()
 
-				// Note: NextSequenceRecv is set to 1 on channel creation.
-				expSeq = []uint64{1}
-				req = &types.QueryUnreceivedPacketsRequest{
-					PortId:                    path.EndpointA.ChannelConfig.PortID,
-					ChannelId:                 path.EndpointA.ChannelID,
-					PacketCommitmentSequences: []uint64{1},
+				expSeq = 0
+				req = &types.QueryNextSequenceReceiveRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
 				}
 			},
 			true,
 		},
 		{
-			"basic success multiple unreceived packet commitments, ordered channel",
+			"basic success on ordered channel returns the set receive sequence",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.SetChannelOrdered()
 				path.Setup()
 
-				// Exercise scenario from issue #1532. NextSequenceRecv is 5, packet commitments provided are 2, 7, 9, 10.
-				// Packet sequence 2 is already received so only sequences 7, 9, 10 should be considered unreceived.
-				expSeq = []uint64{7, 9, 10}
-				packetCommitments := []uint64{2, 7, 9, 10}
-				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 5)
+				expSeq = 3
+				seq := uint64(3)
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
 
-				req = &types.QueryUnreceivedPacketsRequest{
-					PortId:                    path.EndpointA.ChannelConfig.PortID,
-					ChannelId:                 path.EndpointA.ChannelID,
-					PacketCommitmentSequences: packetCommitments,
+				req = &types.QueryNextSequenceReceiveRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
 				}
 			},
 			true,
@@ -1385,12 +2518,12 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedPackets() {
 			tc.malleate()
 			ctx := suite.chainA.GetContext()
 
-			res, err := suite.chainA.QueryServer.UnreceivedPackets(ctx, req)
+			res, err := suite.chainA.QueryServer.NextSequenceReceive(ctx, req)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
-				suite.Require().Equal(expSeq, res.Sequences)
+				suite.Require().Equal(expSeq, res.NextSequenceReceive)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -1398,10 +2531,10 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedPackets() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestQueryUnreceivedAcks() {
+func (suite *KeeperTestSuite) TestQueryChannelSequences() {
 	var (
-		req    *types.QueryUnreceivedAcksRequest
-		expSeq = []uint64{}
+		req    *types.QueryChannelSequencesRequest
+		expRes *types.QueryChannelSequencesResponse
 	)
 
 	testCases := []struct {
@@ -1419,7 +2552,7 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedAcks() {
 		{
 			"invalid port ID",
 			func() {
-				req = &types.QueryUnreceivedAcksRequest{
+				req = &types.QueryChannelSequencesRequest{
 					PortId:    "",
 					ChannelId: "test-channel-id",
 				}
@@ -1429,17 +2562,31 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedAcks() {
 		{
 			"invalid channel ID",
 			func() {
-				req = &types.QueryUnreceivedAcksRequest{
+				req = &types.QueryChannelSequencesRequest{
 					PortId:    "test-port-id",
 					ChannelId: "",
 				}
 			},
 			false,
 		},
+		{
+			"proofs requested",
+			func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				req = &types.QueryChannelSequencesRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+					WithProof: true,
+				}
+			},
+			false,
+		},
 		{
 			"channel not found",
 			func() {
-				req = &types.QueryUnreceivedAcksRequest{
+				req = &types.QueryChannelSequencesRequest{
 					PortId:    "test-port-id",
 					ChannelId: "test-channel-id",
 				}
@@ -1447,76 +2594,87 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedAcks() {
 			false,
 		},
 		{
-			"invalid seq",
+			"basic success on unordered channel returns zero receive and acknowledgement sequences",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.Setup()
 
-				req = &types.QueryUnreceivedAcksRequest{
-					PortId:             path.EndpointA.ChannelConfig.PortID,
-					ChannelId:          path.EndpointA.ChannelID,
-					PacketAckSequences: []uint64{0},
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 5)
+
+				expRes = &types.QueryChannelSequencesResponse{
+					State:            types.OPEN,
+					Ordering:         types.UNORDERED,
+					NextSequenceSend: 5,
+				}
+				req = &types.QueryChannelSequencesRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
 				}
 			},
-			false,
+			true,
 		},
 		{
-			"basic success unreceived packet acks",
+			"basic success on ordered channel returns the set sequences",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrdered()
 				path.Setup()
 
-				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, []byte("commitment"))
+				ctx := suite.chainA.GetContext()
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceSend(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 5)
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 4)
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceAck(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 3)
 
-				expSeq = []uint64{1}
-				req = &types.QueryUnreceivedAcksRequest{
-					PortId:             path.EndpointA.ChannelConfig.PortID,
-					ChannelId:          path.EndpointA.ChannelID,
-					PacketAckSequences: []uint64{1},
+				expRes = &types.QueryChannelSequencesResponse{
+					State:               types.OPEN,
+					Ordering:            types.ORDERED,
+					NextSequenceSend:    5,
+					NextSequenceReceive: 4,
+					NextSequenceAck:     3,
+				}
+				req = &types.QueryChannelSequencesRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
 				}
 			},
 			true,
 		},
 		{
-			"basic success unreceived packet acknowledgements, nothing to relay",
+			"success on closed channel",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.Setup()
 
-				expSeq = []uint64(nil)
-				req = &types.QueryUnreceivedAcksRequest{
-					PortId:             path.EndpointA.ChannelConfig.PortID,
-					ChannelId:          path.EndpointA.ChannelID,
-					PacketAckSequences: []uint64{1},
+				err := path.EndpointA.SetChannelState(types.CLOSED)
+				suite.Require().NoError(err)
+
+				expRes = &types.QueryChannelSequencesResponse{
+					State:            types.CLOSED,
+					Ordering:         types.UNORDERED,
+					NextSequenceSend: 1,
+				}
+				req = &types.QueryChannelSequencesRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
 				}
 			},
 			true,
 		},
 		{
-			"success multiple unreceived packet acknowledgements",
+			"next sequence acknowledgement not found on ordered channel",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrdered()
 				path.Setup()
-				expSeq = []uint64{} // reset
-				packetAcks := []uint64{}
-
-				// set packet commitment for every other sequence
-				for seq := uint64(1); seq < 10; seq++ {
-					packetAcks = append(packetAcks, seq)
 
-					if seq%2 == 0 {
-						suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq, []byte("commitement"))
-						expSeq = append(expSeq, seq)
-					}
-				}
+				suite.chainA.DeleteKey(host.NextSequenceAckKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
 
-				req = &types.QueryUnreceivedAcksRequest{
-					PortId:             path.EndpointA.ChannelConfig.PortID,
-					ChannelId:          path.EndpointA.ChannelID,
-					PacketAckSequences: packetAcks,
+				req = &types.QueryChannelSequencesRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
 				}
 			},
-			true,
+			false,
 		},
 	}
 
@@ -1529,12 +2687,14 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedAcks() {
 			tc.malleate()
 			ctx := suite.chainA.GetContext()
 
-			res, err := suite.chainA.QueryServer.UnreceivedAcks(ctx, req)
+			res, err := suite.chainA.QueryServer.ChannelSequences(ctx, req)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
-				suite.Require().Equal(expSeq, res.Sequences)
+
+				expRes.ProofHeight = clienttypes.GetSelfHeight(ctx)
+				suite.Require().Equal(expRes, res)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -1542,10 +2702,10 @@ func (suite *KeeperTestSuite) TestQueryUnreceivedAcks() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
+func (suite *KeeperTestSuite) TestQueryChannelSendPaused() {
 	var (
-		req    *types.QueryNextSequenceReceiveRequest
-		expSeq uint64
+		req       *types.QueryChannelSendPausedRequest
+		expPaused bool
 	)
 
 	testCases := []struct {
@@ -1563,7 +2723,7 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 		{
 			"invalid port ID",
 			func() {
-				req = &types.QueryNextSequenceReceiveRequest{
+				req = &types.QueryChannelSendPausedRequest{
 					PortId:    "",
 					ChannelId: "test-channel-id",
 				}
@@ -1573,7 +2733,7 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 		{
 			"invalid channel ID",
 			func() {
-				req = &types.QueryNextSequenceReceiveRequest{
+				req = &types.QueryChannelSendPausedRequest{
 					PortId:    "test-port-id",
 					ChannelId: "",
 				}
@@ -1583,7 +2743,7 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 		{
 			"channel not found",
 			func() {
-				req = &types.QueryNextSequenceReceiveRequest{
+				req = &types.QueryChannelSendPausedRequest{
 					PortId:    "test-port-id",
 					ChannelId: "test-channel-id",
 				}
@@ -1591,13 +2751,13 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 			false,
 		},
 		{
-			"basic success on unordered channel returns zero",
+			"success: channel send not paused",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.Setup()
 
-				expSeq = 0
-				req = &types.QueryNextSequenceReceiveRequest{
+				expPaused = false
+				req = &types.QueryChannelSendPausedRequest{
 					PortId:    path.EndpointA.ChannelConfig.PortID,
 					ChannelId: path.EndpointA.ChannelID,
 				}
@@ -1605,17 +2765,16 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 			true,
 		},
 		{
-			"basic success on ordered channel returns the set receive sequence",
+			"success: channel send paused",
 			func() {
 				path := ibctesting.NewPath(suite.chainA, suite.chainB)
-				path.SetChannelOrdered()
 				path.Setup()
 
-				expSeq = 3
-				seq := uint64(3)
-				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceRecv(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+				err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannelSendPaused(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, true)
+				suite.Require().NoError(err)
 
-				req = &types.QueryNextSequenceReceiveRequest{
+				expPaused = true
+				req = &types.QueryChannelSendPausedRequest{
 					PortId:    path.EndpointA.ChannelConfig.PortID,
 					ChannelId: path.EndpointA.ChannelID,
 				}
@@ -1633,12 +2792,12 @@ func (suite *KeeperTestSuite) TestQueryNextSequenceReceive() {
 			tc.malleate()
 			ctx := suite.chainA.GetContext()
 
-			res, err := suite.chainA.QueryServer.NextSequenceReceive(ctx, req)
+			res, err := suite.chainA.QueryServer.ChannelSendPaused(ctx, req)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().NotNil(res)
-				suite.Require().Equal(expSeq, res.NextSequenceReceive)
+				suite.Require().Equal(expPaused, res.Paused)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -1936,6 +3095,130 @@ func (suite *KeeperTestSuite) TestQueryUpgrade() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryChannelUpgradeInfo() {
+	var (
+		path   *ibctesting.Path
+		req    *types.QueryChannelUpgradeInfoRequest
+		expRes *types.QueryChannelUpgradeInfoResponse
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: no upgrade in progress",
+			func() {
+				expRes = &types.QueryChannelUpgradeInfoResponse{
+					ChannelState: types.OPEN,
+				}
+			},
+			nil,
+		},
+		{
+			"success: channel mid-upgrade with in-flight packets",
+			func() {
+				suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+
+				_, err := path.EndpointA.SendPacket(suite.chainB.GetTimeoutHeight(), 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+				suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+
+				upgrade := path.EndpointA.GetChannelUpgrade()
+
+				expRes = &types.QueryChannelUpgradeInfoResponse{
+					Upgrade:            &upgrade,
+					UpgradeSequence:    1,
+					ChannelState:       types.FLUSHING,
+					HasInflightPackets: true,
+				}
+			},
+			nil,
+		},
+		{
+			"success: channel with a cancelled upgrade",
+			func() {
+				suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.WriteUpgradeCancelChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+
+				errorReceipt := types.NewUpgradeError(1, types.ErrInvalidUpgrade).GetErrorReceipt()
+				expRes = &types.QueryChannelUpgradeInfoResponse{
+					ErrorReceipt:    &errorReceipt,
+					UpgradeSequence: 1,
+					ChannelState:    types.OPEN,
+				}
+			},
+			nil,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			"invalid port ID",
+			func() {
+				req.PortId = ""
+			},
+			status.Error(codes.InvalidArgument, "identifier cannot be blank: invalid identifier"),
+		},
+		{
+			"proofs requested",
+			func() {
+				req.WithProof = true
+			},
+			status.Error(codes.InvalidArgument, "proofs of the channel upgrade info must be queried through ABCI store queries"),
+		},
+		{
+			"channel not found",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+
+			req = &types.QueryChannelUpgradeInfoRequest{
+				PortId:    path.EndpointA.ChannelConfig.PortID,
+				ChannelId: path.EndpointA.ChannelID,
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.QueryServer.ChannelUpgradeInfo(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				expRes.ProofHeight = clienttypes.GetSelfHeight(ctx)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr.Error())
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestQueryChannelParams() {
 	ctx := suite.chainA.GetContext()
 	expParams := types.DefaultParams()
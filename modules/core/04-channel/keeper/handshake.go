@@ -277,6 +277,7 @@ func (k *Keeper) WriteOpenAckChannel(
 	defer telemetry.IncrCounter(1, "ibc", "channel", "open-ack")
 
 	emitChannelOpenAckEvent(ctx, portID, channelID, channel)
+	k.emitChannelStateMetric(ctx, types.INIT, types.OPEN)
 }
 
 // ChanOpenConfirm is called by the handshake-accepting module to confirm the acknowledgement
@@ -349,6 +350,7 @@ func (k *Keeper) WriteOpenConfirmChannel(
 	defer telemetry.IncrCounter(1, "ibc", "channel", "open-confirm")
 
 	emitChannelOpenConfirmEvent(ctx, portID, channelID, channel)
+	k.emitChannelStateMetric(ctx, types.TRYOPEN, types.OPEN)
 }
 
 // Closing Handshake
@@ -394,10 +396,12 @@ func (k *Keeper) ChanCloseInit(
 
 	defer telemetry.IncrCounter(1, "ibc", "channel", "close-init")
 
+	previousState := channel.State
 	channel.State = types.CLOSED
 	k.SetChannel(ctx, portID, channelID, channel)
 
 	emitChannelCloseInitEvent(ctx, portID, channelID, channel)
+	k.emitChannelStateMetric(ctx, previousState, types.CLOSED)
 
 	return nil
 }
@@ -470,10 +474,12 @@ func (k *Keeper) ChanCloseConfirm(
 
 	defer telemetry.IncrCounter(1, "ibc", "channel", "close-confirm")
 
+	previousState := channel.State
 	channel.State = types.CLOSED
 	k.SetChannel(ctx, portID, channelID, channel)
 
 	emitChannelCloseConfirmEvent(ctx, portID, channelID, channel)
+	k.emitChannelStateMetric(ctx, previousState, types.CLOSED)
 
 	return nil
 }
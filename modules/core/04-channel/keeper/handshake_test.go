@@ -208,6 +208,16 @@ func (suite *KeeperTestSuite) TestChanOpenTry() {
 
 			portCap = capabilitytypes.NewCapability(3)
 		}, false},
+		{"counterparty channel ordering does not match", func() {
+			path.SetupConnections()
+			// chainA opens an ORDERED_ALLOW_TIMEOUT channel end while chainB attempts to open an UNORDERED channel end
+			path.EndpointA.ChannelConfig.Order = types.ORDERED_ALLOW_TIMEOUT
+			err := path.EndpointA.ChanOpenInit()
+			suite.Require().NoError(err)
+
+			suite.chainB.CreatePortCapability(suite.chainB.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+			portCap = suite.chainB.GetPortCapability(ibctesting.MockPort)
+		}, false},
 		{"connection version not negotiated", func() {
 			path.SetupConnections()
 			path.SetChannelOrdered()
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"errors"
 	"strconv"
 	"strings"
@@ -9,10 +10,12 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -36,6 +39,12 @@ type Keeper struct {
 	connectionKeeper types.ConnectionKeeper
 	portKeeper       types.PortKeeper
 	scopedKeeper     exported.ScopedKeeper
+
+	// packetSendTimes holds the send times of packets in memory to sample the relay latency metric
+	packetSendTimes *packetSendTimes
+
+	// openChannels holds the number of open channels in memory to set the open channels gauge
+	openChannels *openChannelCount
 }
 
 // NewKeeper creates a new IBC channel Keeper instance
@@ -51,6 +60,8 @@ func NewKeeper(
 		connectionKeeper: connectionKeeper,
 		portKeeper:       portKeeper,
 		scopedKeeper:     scopedKeeper,
+		packetSendTimes:  newPacketSendTimes(),
+		openChannels:     &openChannelCount{},
 	}
 }
 
@@ -188,12 +199,25 @@ func (k *Keeper) GetPacketReceipt(ctx sdk.Context, portID, channelID string, seq
 	return string(bz), true
 }
 
-// SetPacketReceipt sets an empty packet receipt to the store
+// SetPacketReceipt sets a constant-size packet receipt to the store
 func (k *Keeper) SetPacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(host.PacketReceiptKey(portID, channelID, sequence), []byte{byte(1)})
 }
 
+// SetPacketTimeoutReceipt sets the receipt of a packet of an ORDERED_ALLOW_TIMEOUT channel which
+// was not executed as it timed out. The receipt is proven by the counterparty to time out the packet.
+func (k *Keeper) SetPacketTimeoutReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.PacketReceiptKey(portID, channelID, sequence), types.TimeoutReceipt)
+}
+
+// HasPacketTimeoutReceipt returns true if a timeout receipt is stored for the packet with the given sequence.
+func (k *Keeper) HasPacketTimeoutReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return bytes.Equal(store.Get(host.PacketReceiptKey(portID, channelID, sequence)), types.TimeoutReceipt)
+}
+
 // deletePacketReceipt deletes a packet receipt from the store
 func (k *Keeper) deletePacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	store := ctx.KVStore(k.storeKey)
@@ -246,10 +270,33 @@ func (k *Keeper) HasPacketAcknowledgement(ctx sdk.Context, portID, channelID str
 	return store.Has(host.PacketAcknowledgementKey(portID, channelID, sequence))
 }
 
-// deletePacketAcknowledgement deletes the packet ack hash from the store
+// deletePacketAcknowledgement deletes the packet ack hash and the age of the acknowledgement from the store
 func (k *Keeper) deletePacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(host.PacketAcknowledgementKey(portID, channelID, sequence))
+	store.Delete(host.PacketAcknowledgementAgeKey(portID, channelID, sequence))
+}
+
+// SetPacketAcknowledgementAge sets the height and time at which the packet acknowledgement was written to the store
+func (k *Keeper) SetPacketAcknowledgementAge(ctx sdk.Context, age types.PacketAcknowledgementAge) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&age)
+	store.Set(host.PacketAcknowledgementAgeKey(age.PortId, age.ChannelId, age.Sequence), bz)
+}
+
+// GetPacketAcknowledgementAge gets the height and time at which the packet acknowledgement was written from the store.
+// Acknowledgements written before their age was recorded have no age.
+func (k *Keeper) GetPacketAcknowledgementAge(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PacketAcknowledgementAge, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PacketAcknowledgementAgeKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.PacketAcknowledgementAge{}, false
+	}
+
+	var age types.PacketAcknowledgementAge
+	k.cdc.MustUnmarshal(bz, &age)
+
+	return age, true
 }
 
 // IteratePacketSequence provides an iterator over all send, receive or ack sequences.
@@ -366,6 +413,15 @@ func (k *Keeper) GetAllPacketReceipts(ctx sdk.Context) (receipts []types.PacketS
 	return receipts
 }
 
+// IteratePacketReceipts provides an iterator over all PacketReceipt objects at a specified
+// channel. For each receipt, cb will be called. If the cb returns true, the iterator will
+// close and stop.
+func (k *Keeper) IteratePacketReceipts(ctx sdk.Context, portID, channelID string, cb func(_, _ string, sequence uint64, receipt []byte) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(host.PacketReceiptPrefixPath(portID, channelID)))
+	k.iterateHashes(ctx, iterator, cb)
+}
+
 // IteratePacketAcknowledgement provides an iterator over all PacketAcknowledgement objects. For each
 // acknowledgement, cb will be called. If the cb returns true, the iterator will close
 // and stop.
@@ -375,6 +431,32 @@ func (k *Keeper) IteratePacketAcknowledgement(ctx sdk.Context, cb func(portID, c
 	k.iterateHashes(ctx, iterator, cb)
 }
 
+// IteratePacketAcknowledgementAges provides an iterator over all PacketAcknowledgementAge objects. For each
+// acknowledgement age, cb will be called. If the cb returns true, the iterator will close and stop.
+func (k *Keeper) IteratePacketAcknowledgementAges(ctx sdk.Context, cb func(age types.PacketAcknowledgementAge) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(host.KeyPacketAckAgePrefix))
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var age types.PacketAcknowledgementAge
+		k.cdc.MustUnmarshal(iterator.Value(), &age)
+
+		if cb(age) {
+			break
+		}
+	}
+}
+
+// GetAllPacketAcknowledgementAges returns all stored PacketAcknowledgementAge objects.
+func (k *Keeper) GetAllPacketAcknowledgementAges(ctx sdk.Context) (ages []types.PacketAcknowledgementAge) {
+	k.IteratePacketAcknowledgementAges(ctx, func(age types.PacketAcknowledgementAge) bool {
+		ages = append(ages, age)
+		return false
+	})
+	return ages
+}
+
 // GetAllPacketAcks returns all stored PacketAcknowledgements objects.
 func (k *Keeper) GetAllPacketAcks(ctx sdk.Context) (acks []types.PacketState) {
 	k.IteratePacketAcknowledgement(ctx, func(portID, channelID string, sequence uint64, ack []byte) bool {
@@ -427,6 +509,27 @@ func (k *Keeper) GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string
 	return filteredChannels
 }
 
+// GetPaginatedChannelsWithPort returns a page of the channels bound to the given port, in ascending order of
+// channel identifier. The page key is the identifier of the first channel of the page.
+func (k *Keeper) GetPaginatedChannelsWithPort(ctx sdk.Context, portID string, pagination *query.PageRequest) ([]types.IdentifiedChannel, *query.PageResponse, error) {
+	var channels []types.IdentifiedChannel
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PortChannelsPrefix(portID))
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var channel types.Channel
+		if err := k.cdc.Unmarshal(value, &channel); err != nil {
+			return err
+		}
+
+		channels = append(channels, types.NewIdentifiedChannel(portID, string(key), channel))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return channels, pageRes, nil
+}
+
 // GetAllChannels returns all stored Channel objects.
 func (k *Keeper) GetAllChannels(ctx sdk.Context) (channels []types.IdentifiedChannel) {
 	k.IterateChannels(ctx, func(channel types.IdentifiedChannel) bool {
@@ -680,6 +783,31 @@ func (k *Keeper) HasPruningSequenceStart(ctx sdk.Context, portID, channelID stri
 	return store.Has(host.PruningSequenceStartKey(portID, channelID))
 }
 
+// SetChannelSendPaused pauses or unpauses sending packets on the given channel. Pausing sends does
+// not affect the receipt, acknowledgement or timeout of packets which are already in flight.
+func (k *Keeper) SetChannelSendPaused(ctx sdk.Context, portID, channelID string, paused bool) error {
+	if !k.HasChannel(ctx, portID, channelID) {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if paused {
+		store.Set(host.ChannelSendPausedKey(portID, channelID), []byte{byte(1)})
+	} else {
+		store.Delete(host.ChannelSendPausedKey(portID, channelID))
+	}
+
+	emitChannelSendPausedEvent(ctx, portID, channelID, paused)
+
+	return nil
+}
+
+// IsChannelSendPaused returns true if sending packets on the given channel has been paused.
+func (k *Keeper) IsChannelSendPaused(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(host.ChannelSendPausedKey(portID, channelID))
+}
+
 // PruneAcknowledgements prunes packet acknowledgements and receipts that have a sequence number less than pruning sequence end.
 // The number of packet acks/receipts pruned is bounded by the limit. Pruning can only occur after a channel has been upgraded.
 //
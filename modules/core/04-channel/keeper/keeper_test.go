@@ -8,6 +8,8 @@ import (
 
 	testifysuite "github.com/stretchr/testify/suite"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -150,6 +152,35 @@ func (suite *KeeperTestSuite) TestGetAllChannelsWithPortPrefix() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGetPaginatedChannelsWithPort() {
+	// the channels of a port sharing the transfer port as a prefix must not be returned
+	channels := []types.IdentifiedChannel{
+		types.NewIdentifiedChannel(transfertypes.PortID, "channel-0", types.Channel{}),
+		types.NewIdentifiedChannel(transfertypes.PortID, "channel-1", types.Channel{}),
+		types.NewIdentifiedChannel(transfertypes.PortID+"-other", "channel-2", types.Channel{}),
+	}
+
+	for _, ch := range channels {
+		suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), ch.PortId, ch.ChannelId, types.Channel{})
+	}
+
+	channelKeeper := suite.chainA.GetSimApp().GetIBCKeeper().ChannelKeeper
+
+	page, pageRes, err := channelKeeper.GetPaginatedChannelsWithPort(suite.chainA.GetContext(), transfertypes.PortID, &query.PageRequest{Limit: 1, CountTotal: true})
+	suite.Require().NoError(err)
+	suite.Require().Equal(channels[:1], page)
+	suite.Require().Equal([]byte("channel-1"), pageRes.NextKey)
+	suite.Require().Equal(uint64(2), pageRes.Total)
+
+	page, pageRes, err = channelKeeper.GetPaginatedChannelsWithPort(suite.chainA.GetContext(), transfertypes.PortID, &query.PageRequest{Key: pageRes.NextKey})
+	suite.Require().NoError(err)
+	suite.Require().Equal(channels[1:2], page)
+	suite.Require().Nil(pageRes.NextKey)
+
+	_, _, err = channelKeeper.GetPaginatedChannelsWithPort(suite.chainA.GetContext(), transfertypes.PortID, &query.PageRequest{Key: []byte("channel-1"), Offset: 1})
+	suite.Require().Error(err)
+}
+
 // containsAll verifies if all elements in the expected slice exist in the actual slice
 // independent of order.
 func containsAll(expected, actual []types.IdentifiedChannel) bool {
@@ -315,7 +346,12 @@ func (suite *KeeperTestSuite) TestGetAllPacketState() {
 	comm3 := types.NewPacketState(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, 1, []byte("hash"))
 	comm4 := types.NewPacketState(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, 2, []byte("hash"))
 
+	// acknowledgement ages
+	age1 := types.NewPacketAcknowledgementAge(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, clienttypes.NewHeight(0, 10), 100)
+	age3 := types.NewPacketAcknowledgementAge(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, 1, clienttypes.NewHeight(0, 11), 200)
+
 	expAcks := []types.PacketState{ack1, ack2, ack3}
+	expAges := []types.PacketAcknowledgementAge{age1, age3}
 	expReceipts := []types.PacketState{rec1, rec2, rec3, rec4}
 	expCommitments := []types.PacketState{comm1, comm2, comm3, comm4}
 
@@ -326,6 +362,11 @@ func (suite *KeeperTestSuite) TestGetAllPacketState() {
 		suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgement(ctxA, ack.PortId, ack.ChannelId, ack.Sequence, ack.Data)
 	}
 
+	// set acknowledgement ages
+	for _, age := range expAges {
+		suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketAcknowledgementAge(ctxA, age)
+	}
+
 	// set packet receipts
 	for _, rec := range expReceipts {
 		suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(ctxA, rec.PortId, rec.ChannelId, rec.Sequence)
@@ -339,6 +380,7 @@ func (suite *KeeperTestSuite) TestGetAllPacketState() {
 	acks := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketAcks(ctxA)
 	receipts := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketReceipts(ctxA)
 	commitments := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketCommitments(ctxA)
+	ages := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketAcknowledgementAges(ctxA)
 
 	suite.Require().Len(acks, len(expAcks))
 	suite.Require().Len(commitments, len(expCommitments))
@@ -347,6 +389,7 @@ func (suite *KeeperTestSuite) TestGetAllPacketState() {
 	suite.Require().Equal(expAcks, acks)
 	suite.Require().Equal(expReceipts, receipts)
 	suite.Require().Equal(expCommitments, commitments)
+	suite.Require().Equal(expAges, ages)
 }
 
 // TestSetSequence verifies that the keeper correctly sets the sequence counters.
@@ -449,6 +492,41 @@ func (suite *KeeperTestSuite) TestGetAllPacketCommitmentsAtChannel() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestIteratePacketReceipts() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	ctxA := suite.chainA.GetContext()
+	numReceipts := uint64(300)
+
+	for seq := uint64(1); seq <= numReceipts; seq++ {
+		suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+	}
+
+	// add receipt on different channel
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(ctxA, path.EndpointA.ChannelConfig.PortID, ibctesting.InvalidID, numReceipts+1)
+
+	expectedSeqs := make(map[uint64]bool)
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.IteratePacketReceipts(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, func(portID, channelID string, sequence uint64, receipt []byte) bool {
+		suite.Require().Equal(path.EndpointA.ChannelConfig.PortID, portID)
+		suite.Require().Equal(path.EndpointA.ChannelID, channelID)
+		suite.Require().Equal([]byte{byte(1)}, receipt)
+		suite.Require().False(expectedSeqs[sequence], "duplicate receipt")
+
+		expectedSeqs[sequence] = true
+		return false
+	})
+	suite.Require().Len(expectedSeqs, int(numReceipts))
+
+	// stop iterating once the callback returns true
+	var count int
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.IteratePacketReceipts(ctxA, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, func(_, _ string, _ uint64, _ []byte) bool {
+		count++
+		return count == 10
+	})
+	suite.Require().Equal(10, count)
+}
+
 // TestSetPacketAcknowledgement verifies that packet acknowledgements are correctly
 // set in the keeper.
 func (suite *KeeperTestSuite) TestSetPacketAcknowledgement() {
@@ -563,6 +641,12 @@ func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
 			start, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPruningSequenceStart(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 			suite.Require().True(found)
 			suite.Require().Equal(start, expPruningSequenceStart)
+
+			// the ages of pruned acknowledgements are pruned along with them
+			for seq := uint64(1); seq < expPruningSequenceStart; seq++ {
+				_, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgementAge(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, seq)
+				suite.Require().False(found)
+			}
 		}
 	)
 
@@ -38,6 +38,14 @@ func (k *Keeper) SendPacket(
 		return 0, errorsmod.Wrapf(types.ErrInvalidChannelState, "channel is not OPEN (got %s)", channel.State)
 	}
 
+	if k.IsChannelSendPaused(ctx, sourcePort, sourceChannel) {
+		return 0, errorsmod.Wrapf(types.ErrChannelSendPaused, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	if err := k.GetParams(ctx).ValidatePacketDataSize(data); err != nil {
+		return 0, err
+	}
+
 	if !k.scopedKeeper.AuthenticateCapability(ctx, channelCap, host.ChannelCapabilityPath(sourcePort, sourceChannel)) {
 		return 0, errorsmod.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
 	}
@@ -90,6 +98,8 @@ func (k *Keeper) SendPacket(
 	k.SetPacketCommitment(ctx, sourcePort, sourceChannel, packet.GetSequence(), commitment)
 
 	emitSendPacketEvent(ctx, packet, channel, timeoutHeight)
+	emitPacketMetric(ctx, types.MetricKeyPacketSend, packet)
+	k.recordPacketSendTime(ctx, packet)
 
 	k.Logger(ctx).Info(
 		"packet sent",
@@ -104,7 +114,9 @@ func (k *Keeper) SendPacket(
 }
 
 // RecvPacket is called by a module in order to receive & process an IBC packet
-// sent on the corresponding channel end on the counterparty chain.
+// sent on the corresponding channel end on the counterparty chain. If a packet of an
+// ORDERED_ALLOW_TIMEOUT channel has timed out, a timeout receipt is written and
+// ErrTimeoutReceiptWritten is returned, the packet must then not be passed to the application.
 func (k *Keeper) RecvPacket(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
@@ -170,24 +182,6 @@ func (k *Keeper) RecvPacket(
 		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connectionEnd.State)
 	}
 
-	// check if packet timed out by comparing it with the latest height of the chain
-	selfHeight, selfTimestamp := clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano())
-	timeout := types.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp())
-	if timeout.Elapsed(selfHeight, selfTimestamp) {
-		return errorsmod.Wrap(timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp), "packet timeout elapsed")
-	}
-
-	commitment := types.CommitPacket(k.cdc, packet)
-
-	// verify that the counterparty did commit to sending this packet
-	if err := k.connectionKeeper.VerifyPacketCommitment(
-		ctx, connectionEnd, proofHeight, proof,
-		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
-		commitment,
-	); err != nil {
-		return errorsmod.Wrap(err, "couldn't verify counterparty packet commitment")
-	}
-
 	// REPLAY PROTECTION: The recvStartSequence will prevent historical proofs from allowing replay
 	// attacks on packets processed in previous lifecycles of a channel. After a successful channel
 	// upgrade all packets under the recvStartSequence will have been processed and thus should be
@@ -197,29 +191,24 @@ func (k *Keeper) RecvPacket(
 		return errorsmod.Wrap(types.ErrPacketReceived, "packet already processed in previous channel upgrade")
 	}
 
+	// Redundant relays are detected before the packet timeout is checked, in the same way AcknowledgePacket
+	// handles missing packet commitments, so that a packet which has already been received is treated as a
+	// no-op. The packet commitment is still verified before the redundant relay is reported, such that the
+	// events of a redundant relay are only emitted for packets actually committed by the counterparty.
+	var (
+		nextSequenceRecv uint64
+		redundantRelay   bool
+	)
 	switch channel.Ordering {
 	case types.UNORDERED:
 		// REPLAY PROTECTION: Packet receipts will indicate that a packet has already been received
 		// on unordered channels. Packet receipts must not be pruned, unless it has been marked stale
 		// by the increase of the recvStartSequence.
-		_, found := k.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
-		if found {
-			emitRecvPacketEvent(ctx, packet, channel)
-			// This error indicates that the packet has already been relayed. Core IBC will
-			// treat this error as a no-op in order to prevent an entire relay transaction
-			// from failing and consuming unnecessary fees.
-			return types.ErrNoOpMsg
-		}
+		_, redundantRelay = k.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 
-		// All verification complete, update state
-		// For unordered channels we must set the receipt so it can be verified on the other side.
-		// This receipt does not contain any data, since the packet has not yet been processed,
-		// it's just a single store key set to a single byte to indicate that the packet has been received
-		k.SetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
-
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		// check if the packet is being received in order
-		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel())
+		nextSequenceRecv, found = k.GetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel())
 		if !found {
 			return errorsmod.Wrapf(
 				types.ErrSequenceReceiveNotFound,
@@ -227,23 +216,54 @@ func (k *Keeper) RecvPacket(
 			)
 		}
 
-		if packet.GetSequence() < nextSequenceRecv {
-			emitRecvPacketEvent(ctx, packet, channel)
-			// This error indicates that the packet has already been relayed. Core IBC will
-			// treat this error as a no-op in order to prevent an entire relay transaction
-			// from failing and consuming unnecessary fees.
-			return types.ErrNoOpMsg
-		}
+		redundantRelay = packet.GetSequence() < nextSequenceRecv
 
 		// REPLAY PROTECTION: Ordered channels require packets to be received in a strict order.
 		// Any out of order or previously received packets are rejected.
-		if packet.GetSequence() != nextSequenceRecv {
+		if !redundantRelay && packet.GetSequence() != nextSequenceRecv {
 			return errorsmod.Wrapf(
 				types.ErrPacketSequenceOutOfOrder,
 				"packet sequence ≠ next receive sequence (%d ≠ %d)", packet.GetSequence(), nextSequenceRecv,
 			)
 		}
+	}
 
+	// check if packet timed out by comparing it with the latest height of the chain
+	selfHeight, selfTimestamp := clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano())
+	timeout := types.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp())
+	timeoutElapsed := timeout.Elapsed(selfHeight, selfTimestamp)
+	if timeoutElapsed && !redundantRelay && channel.Ordering != types.ORDERED_ALLOW_TIMEOUT {
+		return errorsmod.Wrap(timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp), "packet timeout elapsed")
+	}
+
+	commitment := types.CommitPacket(k.cdc, packet)
+
+	// verify that the counterparty did commit to sending this packet
+	if err := k.connectionKeeper.VerifyPacketCommitment(
+		ctx, connectionEnd, proofHeight, proof,
+		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
+		commitment,
+	); err != nil {
+		return errorsmod.Wrap(err, "couldn't verify counterparty packet commitment")
+	}
+
+	if redundantRelay {
+		emitRecvPacketEvent(ctx, packet, channel)
+		// This error indicates that the packet has already been relayed. Core IBC will
+		// treat this error as a no-op in order to prevent an entire relay transaction
+		// from failing and consuming unnecessary fees.
+		return types.ErrNoOpMsg
+	}
+
+	switch channel.Ordering {
+	case types.UNORDERED:
+		// All verification complete, update state
+		// For unordered channels we must set the receipt so it can be verified on the other side.
+		// This receipt does not contain any data, since the packet has not yet been processed,
+		// it's just a single store key set to a single byte to indicate that the packet has been received
+		k.SetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		// All verification complete, update state
 		// In ordered case, we must increment nextSequenceRecv
 		nextSequenceRecv++
@@ -251,6 +271,25 @@ func (k *Keeper) RecvPacket(
 		// incrementing nextSequenceRecv and storing under this chain's channelEnd identifiers
 		// Since this is the receiving chain, our channelEnd is packet's destination port and channel
 		k.SetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv)
+
+		// A packet of an ORDERED_ALLOW_TIMEOUT channel which timed out is skipped rather than executed.
+		// The timeout receipt is proven by the counterparty to time out the packet without closing the channel.
+		if timeoutElapsed {
+			k.SetPacketTimeoutReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+			k.Logger(ctx).Info(
+				"packet timeout receipt written",
+				"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+				"src_port", packet.GetSourcePort(),
+				"src_channel", packet.GetSourceChannel(),
+				"dst_port", packet.GetDestPort(),
+				"dst_channel", packet.GetDestChannel(),
+			)
+
+			emitRecvPacketEvent(ctx, packet, channel)
+
+			return types.ErrTimeoutReceiptWritten
+		}
 	}
 
 	// log that a packet has been received & executed
@@ -265,6 +304,7 @@ func (k *Keeper) RecvPacket(
 
 	// emit an event that the relayer can query for
 	emitRecvPacketEvent(ctx, packet, channel)
+	emitPacketMetric(ctx, types.MetricKeyPacketReceive, packet)
 
 	return nil
 }
@@ -335,6 +375,10 @@ func (k *Keeper) WriteAcknowledgement(
 		ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		types.CommitAcknowledgement(bz),
 	)
+	k.SetPacketAcknowledgementAge(ctx, types.NewPacketAcknowledgementAge(
+		packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+		clienttypes.GetSelfHeight(ctx), uint64(ctx.BlockTime().UnixNano()),
+	))
 
 	// log that a packet acknowledgement has been written
 	k.Logger(ctx).Info(
@@ -436,7 +480,7 @@ func (k *Keeper) AcknowledgePacket(
 	}
 
 	// assert packets acknowledged in order
-	if channel.Ordering == types.ORDERED {
+	if channel.Ordering == types.ORDERED || channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
 		nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 		if !found {
 			return errorsmod.Wrapf(
@@ -461,6 +505,8 @@ func (k *Keeper) AcknowledgePacket(
 
 	}
 
+	k.emitPacketRelayLatencyMetric(ctx, packet)
+
 	// Delete packet commitment, since the packet has been acknowledged, the commitement is no longer necessary
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
@@ -476,6 +522,7 @@ func (k *Keeper) AcknowledgePacket(
 
 	// emit an event marking that we have processed the acknowledgement
 	emitAcknowledgePacketEvent(ctx, packet, channel)
+	emitPacketMetric(ctx, types.MetricKeyPacketAcknowledge, packet)
 
 	// if an upgrade is in progress, handling packet flushing and update channel state appropriately
 	if channel.State == types.FLUSHING {
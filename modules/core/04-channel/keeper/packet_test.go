@@ -114,6 +114,35 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 
 			path.EndpointA.UpdateChannel(func(channel *types.Channel) { channel.State = types.TRYOPEN })
 		}, false},
+		{"success: packet data at maximum packet data size", func() {
+			path.Setup()
+			sourceChannel = path.EndpointA.ChannelID
+
+			params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+			params.MaxPacketDataSize = uint64(len(packetData))
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, true},
+		{"packet data exceeds maximum packet data size", func() {
+			path.Setup()
+			sourceChannel = path.EndpointA.ChannelID
+
+			params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+			params.MaxPacketDataSize = uint64(len(packetData) - 1)
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, false},
+		{"channel send paused", func() {
+			path.Setup()
+			sourceChannel = path.EndpointA.ChannelID
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannelSendPaused(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, true)
+			suite.Require().NoError(err)
+
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, false},
 		{"connection not found", func() {
 			// pass channel check
 			path.Setup()
@@ -642,6 +671,32 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			},
 			types.ErrNoOpMsg,
 		},
+		{
+			"receipt already stored, timeout timestamp passed (no-op)",
+			func() {
+				path.Setup()
+
+				// the timeout elapses on chainB once the client of chainA is updated
+				timeoutTimestamp := uint64(suite.chainB.GetContext().BlockTime().UnixNano()) + 1
+				sequence, err := path.EndpointA.SendPacket(disabledTimeoutHeight, timeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence)
+				packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, disabledTimeoutHeight, timeoutTimestamp)
+				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			},
+			types.ErrNoOpMsg,
+		},
+		{
+			"receipt already stored, invalid proof of packet commitment",
+			func() {
+				// packet commitment not set resulting in invalid proof, redundant relays are only reported once the proof is verified
+				path.Setup()
+				suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 1)
+				packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
 		{
 			"validation failed",
 			func() {
@@ -1348,3 +1403,55 @@ func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 		})
 	}
 }
+
+// TestChannelSendPausedInFlightPackets tests that pausing sends on a channel rejects new packets
+// while packets already in flight can still be received, acknowledged and timed out.
+func (suite *KeeperTestSuite) TestChannelSendPausedInFlightPackets() {
+	suite.SetupTest() // reset
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+
+	// send a packet which will be relayed and a packet which will time out
+	sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence, err = path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	timeoutPacket := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+	err = channelKeeper.SetChannelSendPaused(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, true)
+	suite.Require().NoError(err)
+	suite.Require().True(channelKeeper.IsChannelSendPaused(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+	// new sends are rejected
+	_, err = path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().ErrorIs(err, types.ErrChannelSendPaused)
+
+	// in-flight packets can still be relayed
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+	suite.Require().False(channelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.TimeoutPacket(timeoutPacket)
+	suite.Require().NoError(err)
+	suite.Require().False(channelKeeper.HasPacketCommitment(suite.chainA.GetContext(), timeoutPacket.GetSourcePort(), timeoutPacket.GetSourceChannel(), timeoutPacket.GetSequence()))
+
+	// the counterparty may still send packets on its end of the channel
+	_, err = path.EndpointB.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	// sends are accepted again once unpaused
+	err = channelKeeper.SetChannelSendPaused(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, false)
+	suite.Require().NoError(err)
+	suite.Require().False(channelKeeper.IsChannelSendPaused(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+	_, err = path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"container/list"
+	"slices"
+	"sync"
+	"time"
+
+	metrics "github.com/hashicorp/go-metrics"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// The metrics below are only emitted when telemetry is enabled, and only when executing a block so that packet
+// messages executed in CheckTx or simulated are not counted. No metric is charged gas or written to the store, so that
+// neither the gas consumed by a transaction nor the consensus state depends on whether telemetry is enabled on the node.
+
+// maxPacketSendTimes is the maximum number of packets for which the send time is held in memory to sample the
+// relay latency. The oldest send time is evicted when a packet is sent beyond this bound, so that the send times of
+// packets which are never relayed, or whose sending was reverted, do not prevent sampling the latency of new packets.
+const maxPacketSendTimes = 10_000
+
+// packetSendTimes holds the block time at which packets were sent by this node in memory, in the order in which they
+// were recorded. Send times are lost on restart, so the relay latency is only sampled for packets sent since the node
+// started.
+type packetSendTimes struct {
+	mu    sync.Mutex
+	order *list.List
+	times map[string]*list.Element
+}
+
+// packetSendTime is the send time of the packet identified by the key.
+type packetSendTime struct {
+	key      string
+	sendTime time.Time
+}
+
+// newPacketSendTimes returns an empty set of packet send times.
+func newPacketSendTimes() *packetSendTimes {
+	return &packetSendTimes{order: list.New(), times: make(map[string]*list.Element)}
+}
+
+// set records the send time of the packet identified by the given key, evicting the oldest send time if the bound
+// has been reached.
+func (p *packetSendTimes) set(key string, sendTime time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if element, found := p.times[key]; found {
+		p.order.Remove(element)
+		delete(p.times, key)
+	}
+
+	if p.order.Len() >= maxPacketSendTimes {
+		oldest := p.order.Front()
+		p.order.Remove(oldest)
+		delete(p.times, oldest.Value.(packetSendTime).key)
+	}
+
+	p.times[key] = p.order.PushBack(packetSendTime{key: key, sendTime: sendTime})
+}
+
+// pop returns and deletes the send time of the packet identified by the given key.
+func (p *packetSendTimes) pop(key string) (time.Time, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	element, found := p.times[key]
+	if !found {
+		return time.Time{}, false
+	}
+
+	p.order.Remove(element)
+	delete(p.times, key)
+
+	return element.Value.(packetSendTime).sendTime, true
+}
+
+// count returns the number of packet send times held in memory.
+func (p *packetSendTimes) count() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.order.Len()
+}
+
+// openChannelCount holds the number of open channels in memory, so that the open channels gauge can be set without
+// iterating over the channels on each channel state transition.
+type openChannelCount struct {
+	mu          sync.Mutex
+	initialized bool
+	count       int
+}
+
+// update adds delta to the number of open channels and returns it. If the number has not been initialized, it is set
+// to the number returned by countFn instead, which must already account for the transition being applied.
+func (o *openChannelCount) update(delta int, countFn func() int) int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if !o.initialized {
+		o.count = countFn()
+		o.initialized = true
+		return o.count
+	}
+
+	o.count += delta
+	return o.count
+}
+
+// shouldEmitMetrics returns true if telemetry is enabled and the context is used to execute a block.
+func shouldEmitMetrics(ctx sdk.Context) bool {
+	return telemetry.IsTelemetryEnabled() && ctx.ExecMode() == sdk.ExecModeFinalize
+}
+
+// packetLabels returns the metric labels identifying the source and destination of a packet.
+func packetLabels(packet types.Packet) []metrics.Label {
+	return []metrics.Label{
+		telemetry.NewLabel(types.LabelSourcePort, packet.GetSourcePort()),
+		telemetry.NewLabel(types.LabelSourceChannel, packet.GetSourceChannel()),
+		telemetry.NewLabel(types.LabelDestinationPort, packet.GetDestPort()),
+		telemetry.NewLabel(types.LabelDestinationChannel, packet.GetDestChannel()),
+	}
+}
+
+// emitPacketMetric increments the packet lifecycle counter with the given key.
+func emitPacketMetric(ctx sdk.Context, key []string, packet types.Packet) {
+	if !shouldEmitMetrics(ctx) {
+		return
+	}
+
+	telemetry.IncrCounterWithLabels(key, 1, packetLabels(packet))
+}
+
+// recordPacketSendTime records the current block time in memory as the time at which the packet was sent.
+func (k *Keeper) recordPacketSendTime(ctx sdk.Context, packet types.Packet) {
+	if !shouldEmitMetrics(ctx) {
+		return
+	}
+
+	k.packetSendTimes.set(string(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())), ctx.BlockTime())
+}
+
+// emitPacketRelayLatencyMetric samples the time in milliseconds elapsed between the block in which the packet
+// was sent and the current block, if the send time was recorded by this node.
+func (k *Keeper) emitPacketRelayLatencyMetric(ctx sdk.Context, packet types.Packet) {
+	if !shouldEmitMetrics(ctx) {
+		return
+	}
+
+	sendTime, found := k.packetSendTimes.pop(string(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())))
+	if !found {
+		return
+	}
+
+	latency := ctx.BlockTime().Sub(sendTime)
+	metrics.AddSampleWithLabels(types.MetricKeyPacketRelayLatency, float32(latency.Milliseconds()), packetLabels(packet))
+}
+
+// forgetPacketSendTime deletes the send time of a packet which timed out, as no relay latency is sampled for it.
+func (k *Keeper) forgetPacketSendTime(ctx sdk.Context, packet types.Packet) {
+	if !shouldEmitMetrics(ctx) {
+		return
+	}
+
+	k.packetSendTimes.pop(string(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())))
+}
+
+// isOpenState returns true if a channel in the given state is counted as open, channels being upgraded remain open.
+func isOpenState(state types.State) bool {
+	return slices.Contains([]types.State{types.OPEN, types.FLUSHING, types.FLUSHCOMPLETE}, state)
+}
+
+// emitChannelStateMetric increments the counter of channels which completed the opening handshake or were closed,
+// according to the new state of the channel, and sets the gauge of the number of open channels. The number of open
+// channels is held in memory and updated from the previous and new states of the channel on each transition, so that
+// the channels are only counted from the store on the first transition executed since the node started. They are
+// counted using a context with an infinite gas meter so that the gas consumed by the transaction does not depend on
+// whether telemetry is enabled. The gauge is therefore unset until the first transition executed since the node started.
+func (k *Keeper) emitChannelStateMetric(ctx sdk.Context, previousState, state types.State) {
+	if !shouldEmitMetrics(ctx) {
+		return
+	}
+
+	switch state {
+	case types.OPEN:
+		telemetry.IncrCounter(1, types.MetricKeyChannelOpened...)
+	case types.CLOSED:
+		telemetry.IncrCounter(1, types.MetricKeyChannelClosed...)
+	}
+
+	var delta int
+	switch {
+	case !isOpenState(previousState) && isOpenState(state):
+		delta = 1
+	case isOpenState(previousState) && !isOpenState(state):
+		delta = -1
+	}
+
+	openChannels := k.openChannels.update(delta, func() int {
+		var count int
+		k.IterateChannels(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), func(channel types.IdentifiedChannel) bool {
+			if isOpenState(channel.State) {
+				count++
+			}
+
+			return false
+		})
+
+		return count
+	})
+
+	telemetry.SetGauge(float32(openChannels), types.MetricKeyOpenChannels...)
+}
@@ -0,0 +1,266 @@
+package keeper_test
+
+import (
+	"strings"
+	"time"
+
+	metrics "github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/keeper"
+	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// enableInmemTelemetry enables telemetry and replaces the global metrics sink with an in-memory sink,
+// telemetry is disabled again once the test completes.
+func (suite *KeeperTestSuite) enableInmemTelemetry() *metrics.InmemSink {
+	_, err := telemetry.New(telemetry.Config{Enabled: true})
+	suite.Require().NoError(err)
+
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err = metrics.NewGlobal(cfg, sink)
+	suite.Require().NoError(err)
+
+	suite.T().Cleanup(func() {
+		_, err := telemetry.New(telemetry.Config{Enabled: false})
+		suite.Require().NoError(err)
+	})
+
+	return sink
+}
+
+// hasLabels returns true if all the expected labels are contained in labels.
+func hasLabels(labels, expLabels []metrics.Label) bool {
+	for _, expLabel := range expLabels {
+		found := false
+		for _, label := range labels {
+			if label == expLabel {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// counterValue returns the number of times the counter with the given key and labels has been incremented.
+func counterValue(sink *metrics.InmemSink, key []string, labels []metrics.Label) int {
+	var count int
+	for _, interval := range sink.Data() {
+		for _, counter := range interval.Counters {
+			if counter.Name == strings.Join(key, ".") && hasLabels(counter.Labels, labels) {
+				count += int(counter.Sum)
+			}
+		}
+	}
+
+	return count
+}
+
+// sampleCount returns the number of samples taken of the metric with the given key and labels.
+func sampleCount(sink *metrics.InmemSink, key []string, labels []metrics.Label) int {
+	var count int
+	for _, interval := range sink.Data() {
+		for _, sample := range interval.Samples {
+			if sample.Name == strings.Join(key, ".") && hasLabels(sample.Labels, labels) {
+				count += sample.Count
+			}
+		}
+	}
+
+	return count
+}
+
+// gaugeValue returns the last value set for the gauge with the given key.
+func gaugeValue(sink *metrics.InmemSink, key []string) (float32, bool) {
+	var (
+		value float32
+		found bool
+	)
+	for _, interval := range sink.Data() {
+		for _, gauge := range interval.Gauges {
+			if gauge.Name == strings.Join(key, ".") {
+				value, found = gauge.Value, true
+			}
+		}
+	}
+
+	return value, found
+}
+
+// sendPacket sends a packet on the given endpoint in a context executing a block, as a packet sent in a
+// transaction included in a block, and commits it.
+func (suite *KeeperTestSuite) sendPacket(endpoint *ibctesting.Endpoint, timeoutHeight clienttypes.Height) uint64 {
+	ctx := endpoint.Chain.GetContext().WithExecMode(sdk.ExecModeFinalize)
+	channelCap := endpoint.Chain.GetChannelCapability(endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	sequence, err := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.SendPacket(ctx, channelCap, endpoint.ChannelConfig.PortID, endpoint.ChannelID, timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+	suite.Require().NoError(endpoint.Counterparty.UpdateClient())
+
+	return sequence
+}
+
+func (suite *KeeperTestSuite) TestPacketLifecycleMetrics() {
+	sink := suite.enableInmemTelemetry()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	// both ends of the channel completed the opening handshake
+	suite.Require().Equal(2, counterValue(sink, types.MetricKeyChannelOpened, nil))
+
+	openChannels, found := gaugeValue(sink, types.MetricKeyOpenChannels)
+	suite.Require().True(found)
+	suite.Require().Equal(float32(1), openChannels)
+
+	labels := []metrics.Label{
+		telemetry.NewLabel(types.LabelSourcePort, path.EndpointA.ChannelConfig.PortID),
+		telemetry.NewLabel(types.LabelSourceChannel, path.EndpointA.ChannelID),
+		telemetry.NewLabel(types.LabelDestinationPort, path.EndpointB.ChannelConfig.PortID),
+		telemetry.NewLabel(types.LabelDestinationChannel, path.EndpointB.ChannelID),
+	}
+
+	// send, receive and acknowledge a packet
+	sequence := suite.sendPacket(path.EndpointA, defaultTimeoutHeight)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+	err := path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(1, counterValue(sink, types.MetricKeyPacketSend, labels))
+	suite.Require().Equal(1, counterValue(sink, types.MetricKeyPacketReceive, labels))
+	suite.Require().Equal(1, counterValue(sink, types.MetricKeyPacketAcknowledge, labels))
+	suite.Require().Equal(1, sampleCount(sink, types.MetricKeyPacketRelayLatency, labels))
+	suite.Require().Zero(counterValue(sink, types.MetricKeyPacketTimeout, labels))
+
+	// send and timeout a packet
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence = suite.sendPacket(path.EndpointA, timeoutHeight)
+
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	err = path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(2, counterValue(sink, types.MetricKeyPacketSend, labels))
+	suite.Require().Equal(1, counterValue(sink, types.MetricKeyPacketTimeout, labels))
+	suite.Require().Equal(1, sampleCount(sink, types.MetricKeyPacketRelayLatency, labels))
+
+	// closing the channel increments the closed channels counter
+	suite.Require().Zero(counterValue(sink, types.MetricKeyChannelClosed, nil))
+
+	err = path.EndpointA.ChanCloseInit()
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(1, counterValue(sink, types.MetricKeyChannelClosed, nil))
+	suite.Require().Equal(2, counterValue(sink, types.MetricKeyChannelOpened, nil))
+
+	openChannels, found = gaugeValue(sink, types.MetricKeyOpenChannels)
+	suite.Require().True(found)
+	suite.Require().Zero(openChannels)
+}
+
+// TestOpenChannelsGauge asserts that the open channels gauge is updated from the previous and new states of a channel
+// on each transition, rather than by counting the channels in the store.
+func (suite *KeeperTestSuite) TestOpenChannelsGauge() {
+	sink := suite.enableInmemTelemetry()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	openChannels, found := gaugeValue(sink, types.MetricKeyOpenChannels)
+	suite.Require().True(found)
+	suite.Require().Equal(float32(1), openChannels)
+
+	// an open channel stored without a state transition is not counted once the open channels have been counted
+	channel := path.EndpointA.GetChannel()
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, "channel-100", channel)
+
+	// closing a channel which never opened does not change the number of open channels
+	initPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	initPath.SetupConnections()
+	suite.Require().NoError(initPath.EndpointA.ChanOpenInit())
+	suite.Require().NoError(initPath.EndpointA.ChanCloseInit())
+
+	openChannels, found = gaugeValue(sink, types.MetricKeyOpenChannels)
+	suite.Require().True(found)
+	suite.Require().Equal(float32(1), openChannels)
+
+	suite.Require().NoError(path.EndpointA.ChanCloseInit())
+
+	openChannels, found = gaugeValue(sink, types.MetricKeyOpenChannels)
+	suite.Require().True(found)
+	suite.Require().Zero(openChannels)
+}
+
+func (suite *KeeperTestSuite) TestPacketSendTimes() {
+	sink := suite.enableInmemTelemetry()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	newPacket := func(sequence uint64) types.Packet {
+		return types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+	}
+
+	// packets sent outside of block execution, e.g. simulated, are neither counted nor recorded
+	_, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	channelKeeper.RecordPacketSendTime(suite.chainA.GetContext().WithExecMode(sdk.ExecModeSimulate), newPacket(1))
+
+	suite.Require().Zero(counterValue(sink, types.MetricKeyPacketSend, nil))
+	suite.Require().Zero(channelKeeper.PacketSendTimesCount())
+
+	// the oldest send times are evicted once the bound is reached, so the send time of a new packet is recorded
+	ctx := suite.chainA.GetContext().WithExecMode(sdk.ExecModeFinalize)
+	for sequence := uint64(1); sequence <= keeper.MaxPacketSendTimes; sequence++ {
+		channelKeeper.RecordPacketSendTime(ctx, newPacket(sequence))
+	}
+
+	suite.Require().Equal(keeper.MaxPacketSendTimes, channelKeeper.PacketSendTimesCount())
+
+	sequence := suite.sendPacket(path.EndpointA, defaultTimeoutHeight)
+	suite.Require().Equal(keeper.MaxPacketSendTimes, channelKeeper.PacketSendTimesCount())
+
+	err = path.RelayPacket(newPacket(sequence))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(1, sampleCount(sink, types.MetricKeyPacketRelayLatency, nil))
+	suite.Require().Equal(keeper.MaxPacketSendTimes-1, channelKeeper.PacketSendTimesCount())
+}
+
+func (suite *KeeperTestSuite) TestPacketMetricsDisabled() {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("")
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	suite.Require().NoError(err)
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	_, err = path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	suite.Require().Zero(counterValue(sink, types.MetricKeyPacketSend, nil))
+
+	suite.Require().Zero(counterValue(sink, types.MetricKeyChannelOpened, nil))
+}
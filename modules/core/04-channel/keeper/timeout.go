@@ -106,6 +106,16 @@ func (k *Keeper) TimeoutPacket(
 			ctx, connectionEnd, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
 		)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		if err := k.checkTimeoutInOrder(ctx, packet); err != nil {
+			return err
+		}
+
+		// check that the counterparty skipped the packet as it timed out
+		err = k.connectionKeeper.VerifyPacketTimeoutReceipt(
+			ctx, connectionEnd, proofHeight, proof,
+			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+		)
 	case types.UNORDERED:
 		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
 			ctx, connectionEnd, proofHeight, proof,
@@ -123,8 +133,33 @@ func (k *Keeper) TimeoutPacket(
 	return nil
 }
 
+// checkTimeoutInOrder checks that the packet of an ORDERED_ALLOW_TIMEOUT channel is timed out in
+// order with the acknowledgements of the packets sent on the channel, as the counterparty receives
+// the packets, or skips them if they timed out, in the order they were sent.
+func (k *Keeper) checkTimeoutInOrder(ctx sdk.Context, packet types.Packet) error {
+	nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrSequenceAckNotFound,
+			"source port: %s, source channel: %s", packet.GetSourcePort(), packet.GetSourceChannel(),
+		)
+	}
+
+	if packet.GetSequence() != nextSequenceAck {
+		return errorsmod.Wrapf(
+			types.ErrPacketSequenceOutOfOrder,
+			"packet sequence ≠ next ack sequence (%d ≠ %d)", packet.GetSequence(), nextSequenceAck,
+		)
+	}
+
+	return nil
+}
+
 // TimeoutExecuted deletes the commitment send from this chain after it verifies timeout.
 // If the timed-out packet came from an ORDERED channel then this channel will be closed.
+// If the timed-out packet came from an ORDERED_ALLOW_TIMEOUT channel then the channel remains
+// open and the next acknowledgement sequence is incremented, unless the channel is closed or the
+// packet was timed out on close ahead of the next packet to be acknowledged.
 // If the channel is in the FLUSHING state and there is a counterparty upgrade, then the
 // upgrade will be aborted if the upgrade has timed out. Otherwise, if there are no more inflight packets,
 // then the channel will be set to the FLUSHCOMPLETE state.
@@ -151,7 +186,7 @@ func (k *Keeper) TimeoutExecuted(
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
 	// if an upgrade is in progress, handling packet flushing and update channel state appropriately
-	if channel.State == types.FLUSHING && channel.Ordering == types.UNORDERED {
+	if channel.State == types.FLUSHING && channel.Ordering != types.ORDERED {
 		counterpartyUpgrade, found := k.GetCounterpartyUpgrade(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 		// once we have received the counterparty timeout in the channel UpgradeAck or UpgradeConfirm handshake steps
 		// then we can move to flushing complete if the timeout has not passed and there are no in-flight packets
@@ -185,9 +220,20 @@ func (k *Keeper) TimeoutExecuted(
 			)
 		}
 
+		previousState := channel.State
 		channel.State = types.CLOSED
 		k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
 		emitChannelClosedEvent(ctx, packet, channel)
+		k.emitChannelStateMetric(ctx, previousState, types.CLOSED)
+	}
+
+	if channel.Ordering == types.ORDERED_ALLOW_TIMEOUT && channel.State != types.CLOSED {
+		// packets timed out on close may be timed out out of order, so the next acknowledgement sequence
+		// is only advanced if the packet is the next packet to be acknowledged
+		nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+		if found && packet.GetSequence() == nextSequenceAck {
+			k.SetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), nextSequenceAck+1)
+		}
 	}
 
 	k.Logger(ctx).Info(
@@ -201,6 +247,8 @@ func (k *Keeper) TimeoutExecuted(
 
 	// emit an event marking that we have processed the timeout
 	emitTimeoutPacketEvent(ctx, packet, channel)
+	emitPacketMetric(ctx, types.MetricKeyPacketTimeout, packet)
+	k.forgetPacketSendTime(ctx, packet)
 
 	return nil
 }
@@ -281,7 +329,7 @@ func (k *Keeper) TimeoutOnClose(
 	}
 
 	// check that the opposing channel end has closed
-	if err := k.connectionKeeper.VerifyChannelState(
+	if err := k.connectionKeeper.VerifyChannelStateForTimeout(
 		ctx, connectionEnd, proofHeight, closedProof,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
 		expectedChannel,
@@ -291,7 +339,11 @@ func (k *Keeper) TimeoutOnClose(
 
 	var err error
 	switch channel.Ordering {
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
+		// NOTE: packets of an ORDERED_ALLOW_TIMEOUT channel are not required to be timed out in order on close,
+		// as the acknowledgements of packets received before the channel was closed can no longer be relayed
+		// once this channel end is closed.
+
 		// check that packet has not been received
 		if nextSequenceRecv > packet.GetSequence() {
 			return errorsmod.Wrapf(types.ErrInvalidPacket, "packet already received, next sequence receive > packet sequence (%d > %d", nextSequenceRecv, packet.GetSequence())
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -15,7 +16,9 @@ import (
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	"github.com/cosmos/ibc-go/v8/testing/mock"
 )
@@ -48,6 +51,51 @@ func (suite *KeeperTestSuite) TestTimeoutPacket() {
 			err = path.EndpointA.UpdateClient()
 			suite.Require().NoError(err)
 		}, true},
+		{"success: ORDERED_ALLOW_TIMEOUT", func() {
+			ordered = false
+			path.SetChannelOrderedAllowTimeout()
+			path.Setup()
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			// the timed out packet is skipped on chainB which writes a timeout receipt
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+		}, true},
+		{"timeout receipt not written: ORDERED_ALLOW_TIMEOUT", func() {
+			ordered = false
+			path.SetChannelOrderedAllowTimeout()
+			path.Setup()
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+		}, false},
+		{"packet timed out out of order: ORDERED_ALLOW_TIMEOUT", func() {
+			expError = types.ErrPacketSequenceOutOfOrder
+			ordered = false
+			path.SetChannelOrderedAllowTimeout()
+			path.Setup()
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence+1)
+		}, false},
 		{"success: UNORDERED", func() {
 			ordered = false
 			path.Setup()
@@ -276,6 +324,34 @@ func (suite *KeeperTestSuite) TestTimeoutExecuted() {
 			},
 			nil,
 		},
+		{
+			"success ORDERED_ALLOW_TIMEOUT",
+			func() {
+				path.SetChannelOrderedAllowTimeout()
+				path.Setup()
+
+				timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			},
+			func(packetCommitment []byte, err error) {
+				suite.Require().NoError(err)
+				suite.Require().Nil(packetCommitment)
+
+				// Check channel remains open and the next sequence ack is incremented
+				channel := path.EndpointA.GetChannel()
+				suite.Require().Equal(types.OPEN, channel.State)
+
+				nextSequenceAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(packet.GetSequence()+1, nextSequenceAck)
+			},
+			nil,
+		},
 		{
 			"channel not found",
 			func() {
@@ -773,3 +849,257 @@ func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 		})
 	}
 }
+
+// TestTimeoutPacketOrderedAllowTimeout tests that a packet which timed out on an ORDERED_ALLOW_TIMEOUT
+// channel is skipped by the receiving chain and timed out by the sending chain without closing the
+// channel, and that the next packet sent on the channel is then received and acknowledged in order.
+func (suite *KeeperTestSuite) TestTimeoutPacketOrderedAllowTimeout() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrderedAllowTimeout()
+	path.Setup()
+
+	// send a packet which times out at the current height of chainB
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+	// the timed out packet is skipped on chainB, the application callbacks are not executed
+	err = path.EndpointB.RecvPacket(packet)
+	suite.Require().NoError(err)
+
+	channelKeeperB := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+	suite.Require().True(channelKeeperB.HasPacketTimeoutReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), sequence))
+	suite.Require().False(channelKeeperB.HasPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), sequence))
+
+	nextSequenceRecv, found := channelKeeperB.GetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+	suite.Require().True(found)
+	suite.Require().Equal(sequence+1, nextSequenceRecv)
+
+	// the packet is timed out on chainA using the timeout receipt, the channel remains open
+	err = path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+	suite.Require().Equal(types.OPEN, path.EndpointB.GetChannel().State)
+	suite.Require().False(suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), sequence))
+
+	// the next packet is received and acknowledged in order
+	sequence, err = path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	suite.Require().False(channelKeeperB.HasPacketTimeoutReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), sequence))
+	suite.Require().True(channelKeeperB.HasPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), sequence))
+
+	nextSequenceAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(sequence+1, nextSequenceAck)
+	suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+}
+
+// TestTimeoutOnCloseOrderedAllowTimeoutPendingAck tests that packets of a closed ORDERED_ALLOW_TIMEOUT channel can be
+// timed out on close while the acknowledgement of a packet received before the channel was closed is still pending.
+// The acknowledgement can no longer be relayed to the closed channel, so the next acknowledgement sequence is not advanced.
+func (suite *KeeperTestSuite) TestTimeoutOnCloseOrderedAllowTimeoutPendingAck() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrderedAllowTimeout()
+	path.Setup()
+
+	var packets []types.Packet
+	for i := 0; i < 3; i++ {
+		sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+		packets = append(packets, types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp))
+	}
+
+	// the first packet is received on chainB, but its acknowledgement is not relayed before the channel is closed
+	err := path.EndpointB.RecvPacket(packets[0])
+	suite.Require().NoError(err)
+
+	ack, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packets[0].GetDestPort(), packets[0].GetDestChannel(), packets[0].GetSequence())
+	suite.Require().True(found)
+	suite.Require().NotEmpty(ack)
+
+	path.EndpointA.UpdateChannel(func(channel *types.Channel) { channel.State = types.CLOSED })
+	path.EndpointB.UpdateChannel(func(channel *types.Channel) { channel.State = types.CLOSED })
+
+	err = path.EndpointA.AcknowledgePacket(packets[0], mock.MockAcknowledgement.Acknowledgement())
+	suite.Require().ErrorContains(err, types.ErrInvalidChannelState.Error())
+
+	// the packets which have not been received are timed out on close, in any order
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	for _, packet := range []types.Packet{packets[2], packets[1]} {
+		err = path.EndpointA.TimeoutOnClose(packet)
+		suite.Require().NoError(err)
+		suite.Require().False(suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	}
+
+	nextSequenceAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(packets[0].GetSequence(), nextSequenceAck)
+}
+
+// TestTimeoutPacketFrozenClient tests that packets may be timed out on chainA after chainA's client
+// of chainB has been frozen, as long as the proof height is below the misbehaviour height.
+func (suite *KeeperTestSuite) TestTimeoutPacketFrozenClient() {
+	var (
+		proofHeight        exported.Height
+		misbehaviourHeight exported.Height
+	)
+
+	testCases := []struct {
+		name     string
+		ordered  bool
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: ORDERED",
+			true,
+			func() {},
+			nil,
+		},
+		{
+			"success: UNORDERED",
+			false,
+			func() {},
+			nil,
+		},
+		{
+			"failure: proof height is not below misbehaviour height",
+			false,
+			func() {
+				proofHeight = misbehaviourHeight
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			if tc.ordered {
+				path.SetChannelOrdered()
+			}
+			path.Setup()
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			// need to update chainA's client representing chainB to prove missing receipt
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			proofKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			if tc.ordered {
+				proofKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+			}
+
+			var proof []byte
+			proof, proofHeight = path.EndpointB.QueryProof(proofKey)
+
+			misbehaviourHeight = suite.freezeClient(path.EndpointA)
+
+			tc.malleate()
+
+			err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutPacket(suite.chainA.GetContext(), packet, proof, proofHeight, 1)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestTimeoutOnCloseFrozenClient tests that packets may be timed out on close on chainA after chainA's
+// client of chainB has been frozen, as long as the proofs are at a height below the misbehaviour height.
+func (suite *KeeperTestSuite) TestTimeoutOnCloseFrozenClient() {
+	suite.SetupTest() // reset
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+	path.EndpointB.UpdateChannel(func(channel *types.Channel) { channel.State = types.CLOSED })
+
+	err = path.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	closedProof, proofHeight := suite.chainB.QueryProof(host.ChannelKey(packet.GetDestPort(), packet.GetDestChannel()))
+	proof, _ := suite.chainB.QueryProof(host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+
+	suite.freezeClient(path.EndpointA)
+
+	chanCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutOnClose(suite.chainA.GetContext(), chanCap, packet, proof, closedProof, proofHeight, 1, 0)
+	suite.Require().NoError(err)
+}
+
+// TestAcknowledgePacketFrozenClient tests that acknowledgements cannot be verified using a frozen client,
+// even if the proof is at a height below the misbehaviour height.
+func (suite *KeeperTestSuite) TestAcknowledgePacketFrozenClient() {
+	suite.SetupTest() // reset
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+	err = path.EndpointB.RecvPacket(packet)
+	suite.Require().NoError(err)
+
+	proof, proofHeight := path.EndpointB.QueryProof(host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+
+	suite.freezeClient(path.EndpointA)
+
+	chanCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.AcknowledgePacket(suite.chainA.GetContext(), chanCap, packet, mock.MockAcknowledgement.Acknowledgement(), proof, proofHeight)
+	suite.Require().ErrorIs(err, clienttypes.ErrClientNotActive)
+}
+
+// freezeClient freezes the client of the provided endpoint by submitting fork misbehaviour of the counterparty chain
+// at a height above the client's current latest height. The client is updated before the misbehaviour is submitted,
+// so that proofs queried prior to calling freezeClient are below the trusted height of the misbehaviour. The misbehaviour
+// height, i.e. the trusted height of the misbehaviour, is returned.
+func (suite *KeeperTestSuite) freezeClient(endpoint *ibctesting.Endpoint) exported.Height {
+	counterparty := endpoint.Counterparty.Chain
+
+	suite.coordinator.CommitBlock(counterparty)
+	err := endpoint.UpdateClient()
+	suite.Require().NoError(err)
+
+	trustedHeight, ok := endpoint.GetClientLatestHeight().(clienttypes.Height)
+	suite.Require().True(ok)
+
+	trustedVals, err := counterparty.GetTrustedValidators(int64(trustedHeight.RevisionHeight))
+	suite.Require().NoError(err)
+
+	height := int64(trustedHeight.RevisionHeight) + 1
+	misbehaviour := &ibctm.Misbehaviour{
+		Header1: counterparty.CreateTMClientHeader(counterparty.ChainID, height, trustedHeight, counterparty.ProposedHeader.Time.Add(time.Minute), counterparty.Vals, counterparty.NextVals, trustedVals, counterparty.Signers),
+		Header2: counterparty.CreateTMClientHeader(counterparty.ChainID, height, trustedHeight, counterparty.ProposedHeader.Time, counterparty.Vals, counterparty.NextVals, trustedVals, counterparty.Signers),
+	}
+
+	err = endpoint.Chain.App.GetIBCKeeper().ClientKeeper.UpdateClient(endpoint.Chain.GetContext(), endpoint.ClientID, misbehaviour)
+	suite.Require().NoError(err)
+	suite.Require().Equal(exported.Frozen, endpoint.Chain.App.GetIBCKeeper().ClientKeeper.GetClientStatus(endpoint.Chain.GetContext(), endpoint.ClientID))
+
+	return trustedHeight
+}
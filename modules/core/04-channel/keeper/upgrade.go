@@ -102,6 +102,25 @@ func (k *Keeper) ChanUpgradeTry(
 		return types.Channel{}, types.Upgrade{}, errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connection.State)
 	}
 
+	var (
+		err                     error
+		upgrade                 types.Upgrade
+		isCrossingHello         bool
+		expectedUpgradeSequence uint64
+	)
+
+	// in the crossing hello case, the connection hops of the upgrade have been proposed by this chain and proofs
+	// are verified against the connection returned by getProofConnection. Otherwise the connection hops are provided
+	// by the relayer and proofs are verified against the existing connection of the channel.
+	proofConnection := connection
+	upgrade, isCrossingHello = k.GetUpgrade(ctx, portID, channelID)
+	if isCrossingHello {
+		proofConnection, err = k.getProofConnection(ctx, channel, upgrade.Fields)
+		if err != nil {
+			return types.Channel{}, types.Upgrade{}, err
+		}
+	}
+
 	// construct expected counterparty channel from information in state
 	// only the counterpartyUpgradeSequence is provided by the relayer
 	counterpartyConnectionHops := []string{connection.Counterparty.ConnectionId}
@@ -117,7 +136,7 @@ func (k *Keeper) ChanUpgradeTry(
 	// verify the counterparty channel state containing the upgrade sequence
 	if err := k.connectionKeeper.VerifyChannelState(
 		ctx,
-		connection,
+		proofConnection,
 		proofHeight, channelProof,
 		channel.Counterparty.PortId,
 		channel.Counterparty.ChannelId,
@@ -126,14 +145,6 @@ func (k *Keeper) ChanUpgradeTry(
 		return types.Channel{}, types.Upgrade{}, errorsmod.Wrap(err, "failed to verify counterparty channel state")
 	}
 
-	var (
-		err                     error
-		upgrade                 types.Upgrade
-		isCrossingHello         bool
-		expectedUpgradeSequence uint64
-	)
-
-	upgrade, isCrossingHello = k.GetUpgrade(ctx, portID, channelID)
 	if isCrossingHello {
 		expectedUpgradeSequence = channel.UpgradeSequence
 	} else {
@@ -173,7 +184,7 @@ func (k *Keeper) ChanUpgradeTry(
 	// verifies the proof that a particular proposed upgrade has been stored in the upgrade path of the counterparty
 	if err := k.connectionKeeper.VerifyChannelUpgrade(
 		ctx,
-		connection,
+		proofConnection,
 		proofHeight, upgradeProof,
 		channel.Counterparty.PortId,
 		channel.Counterparty.ChannelId,
@@ -208,6 +219,13 @@ func (k *Keeper) ChanUpgradeTry(
 		return types.Channel{}, types.Upgrade{}, errorsmod.Wrap(err, "failed upgrade compatibility check")
 	}
 
+	// the connection hops proposed by the relayer are not trusted: the client of a relayer provided connection may
+	// track a different chain reusing the chain ID of the counterparty. A channel can therefore only be migrated
+	// to a new connection chosen by the authority of this chain in ChanUpgradeInit, i.e. in the crossing hellos case.
+	if !isCrossingHello && !slices.Equal(proposedConnectionHops, channel.ConnectionHops) {
+		return types.Channel{}, types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidUpgrade, "proposed connection hops (%s) must match the existing connection hops (%s) unless the upgrade was initiated on this chain", proposedConnectionHops, channel.ConnectionHops)
+	}
+
 	// if the counterparty sequence is greater than the current sequence, we fast-forward to the counterparty sequence.
 	if counterpartyUpgradeSequence > channel.UpgradeSequence {
 		channel.UpgradeSequence = counterpartyUpgradeSequence
@@ -278,6 +296,19 @@ func (k *Keeper) ChanUpgradeAck(
 		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connection.State)
 	}
 
+	// if we have cancelled our upgrade after performing UpgradeInit
+	// or UpgradeTry, the lack of a stored upgrade will prevent us from
+	// continuing the upgrade handshake
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	proofConnection, err := k.getProofConnection(ctx, channel, upgrade.Fields)
+	if err != nil {
+		return err
+	}
+
 	counterpartyHops := []string{connection.Counterparty.ConnectionId}
 	counterpartyChannel := types.Channel{
 		State:           types.FLUSHING,
@@ -291,7 +322,7 @@ func (k *Keeper) ChanUpgradeAck(
 	// verify the counterparty channel state containing the upgrade sequence
 	if err := k.connectionKeeper.VerifyChannelState(
 		ctx,
-		connection,
+		proofConnection,
 		proofHeight, channelProof,
 		channel.Counterparty.PortId,
 		channel.Counterparty.ChannelId,
@@ -303,7 +334,7 @@ func (k *Keeper) ChanUpgradeAck(
 	// verifies the proof that a particular proposed upgrade has been stored in the upgrade path of the counterparty
 	if err := k.connectionKeeper.VerifyChannelUpgrade(
 		ctx,
-		connection,
+		proofConnection,
 		proofHeight, upgradeProof,
 		channel.Counterparty.PortId,
 		channel.Counterparty.ChannelId,
@@ -312,14 +343,6 @@ func (k *Keeper) ChanUpgradeAck(
 		return errorsmod.Wrap(err, "failed to verify counterparty upgrade")
 	}
 
-	// if we have cancelled our upgrade after performing UpgradeInit
-	// or UpgradeTry, the lack of a stored upgrade will prevent us from
-	// continuing the upgrade handshake
-	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
-	if !found {
-		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID)
-	}
-
 	// optimistically accept version that TRY chain proposes and pass this to callback for confirmation
 	// in the crossing hello case, we do not modify version that our TRY call returned and instead enforce
 	// that both TRY calls returned the same version. It is possible that this will fail in the OnChanUpgradeAck
@@ -414,6 +437,16 @@ func (k *Keeper) ChanUpgradeConfirm(
 		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connection.State)
 	}
 
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	proofConnection, err := k.getProofConnection(ctx, channel, upgrade.Fields)
+	if err != nil {
+		return err
+	}
+
 	counterpartyHops := []string{connection.Counterparty.ConnectionId}
 	counterpartyChannel := types.Channel{
 		State:           counterpartyChannelState,
@@ -426,7 +459,7 @@ func (k *Keeper) ChanUpgradeConfirm(
 
 	if err := k.connectionKeeper.VerifyChannelState(
 		ctx,
-		connection,
+		proofConnection,
 		proofHeight, channelProof,
 		channel.Counterparty.PortId,
 		channel.Counterparty.ChannelId,
@@ -437,7 +470,7 @@ func (k *Keeper) ChanUpgradeConfirm(
 
 	if err := k.connectionKeeper.VerifyChannelUpgrade(
 		ctx,
-		connection,
+		proofConnection,
 		proofHeight, upgradeProof,
 		channel.Counterparty.PortId,
 		channel.Counterparty.ChannelId,
@@ -509,23 +542,24 @@ func (k *Keeper) ChanUpgradeOpen(
 		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connection.State)
 	}
 
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	proofConnection, err := k.getProofConnection(ctx, channel, upgrade.Fields)
+	if err != nil {
+		return err
+	}
+
+	upgradeConnection, found := k.connectionKeeper.GetConnection(ctx, upgrade.Fields.ConnectionHops[0])
+	if !found {
+		return errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, upgrade.Fields.ConnectionHops[0])
+	}
+
 	var counterpartyChannel types.Channel
 	switch counterpartyChannelState {
 	case types.OPEN:
-		upgrade, found := k.GetUpgrade(ctx, portID, channelID)
-		if !found {
-			return errorsmod.Wrapf(types.ErrUpgradeNotFound, "failed to retrieve channel upgrade: port ID (%s) channel ID (%s)", portID, channelID)
-		}
-		// If counterparty has reached OPEN, we must use the upgraded connection to verify the counterparty channel
-		upgradeConnection, found := k.connectionKeeper.GetConnection(ctx, upgrade.Fields.ConnectionHops[0])
-		if !found {
-			return errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, upgrade.Fields.ConnectionHops[0])
-		}
-
-		if upgradeConnection.State != connectiontypes.OPEN {
-			return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", upgradeConnection.State)
-		}
-
 		// The counterparty upgrade sequence must be greater than or equal to
 		// the channel upgrade sequence. It should normally be equivalent, but
 		// in the unlikely case a new upgrade is initiated after it reopens,
@@ -559,7 +593,7 @@ func (k *Keeper) ChanUpgradeOpen(
 
 	if err := k.connectionKeeper.VerifyChannelState(
 		ctx,
-		connection,
+		proofConnection,
 		proofHeight, channelProof,
 		channel.Counterparty.PortId,
 		channel.Counterparty.ChannelId,
@@ -592,15 +626,15 @@ func (k *Keeper) WriteUpgradeOpenChannel(ctx sdk.Context, portID, channelID stri
 
 	// next seq recv and ack is used for ordered channels to verify the packet has been received/acked in the correct order
 	// this is no longer necessary if the channel is UNORDERED and should be reset to 1
-	if channel.Ordering == types.ORDERED && upgrade.Fields.Ordering == types.UNORDERED {
+	if channel.Ordering != types.UNORDERED && upgrade.Fields.Ordering == types.UNORDERED {
 		k.SetNextSequenceRecv(ctx, portID, channelID, 1)
 		k.SetNextSequenceAck(ctx, portID, channelID, 1)
 	}
 
-	// next seq recv and ack should updated when moving from UNORDERED to ORDERED using the counterparty NextSequenceSend as set just after blocking new packet sends.
+	// next seq recv and ack should updated when moving from UNORDERED to ORDERED (or ORDERED_ALLOW_TIMEOUT) using the counterparty NextSequenceSend as set just after blocking new packet sends.
 	// we can be sure that the next packet we are set to receive will be the first packet the counterparty sends after reopening.
 	// we can be sure that our next acknowledgement will be our first packet sent after upgrade, as the counterparty processed all sent packets after flushing completes.
-	if channel.Ordering == types.UNORDERED && upgrade.Fields.Ordering == types.ORDERED {
+	if channel.Ordering == types.UNORDERED && upgrade.Fields.Ordering != types.UNORDERED {
 		k.SetNextSequenceRecv(ctx, portID, channelID, counterpartyUpgrade.NextSequenceSend)
 		k.SetNextSequenceAck(ctx, portID, channelID, upgrade.NextSequenceSend)
 	}
@@ -642,7 +676,7 @@ func (k *Keeper) ChanUpgradeCancel(ctx sdk.Context, portID, channelID string, er
 		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
 	}
 
-	_, found = k.GetUpgrade(ctx, portID, channelID)
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
 	if !found {
 		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
 	}
@@ -668,13 +702,9 @@ func (k *Keeper) ChanUpgradeCancel(ctx sdk.Context, portID, channelID string, er
 	}
 
 	// get underlying connection for proof verification
-	connection, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
-	if !found {
-		return errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
-	}
-
-	if connection.State != connectiontypes.OPEN {
-		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connection.State)
+	connection, err := k.getProofConnection(ctx, channel, upgrade.Fields)
+	if err != nil {
+		return err
 	}
 
 	if err := k.connectionKeeper.VerifyChannelUpgradeError(
@@ -733,16 +763,9 @@ func (k *Keeper) ChanUpgradeTimeout(
 		return errorsmod.Wrapf(types.ErrUpgradeNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
 	}
 
-	connection, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
-	if !found {
-		return errorsmod.Wrap(
-			connectiontypes.ErrConnectionNotFound,
-			channel.ConnectionHops[0],
-		)
-	}
-
-	if connection.State != connectiontypes.OPEN {
-		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connection.State)
+	connection, err := k.getProofConnection(ctx, channel, upgrade.Fields)
+	if err != nil {
+		return err
 	}
 
 	proofTimestamp, err := k.clientKeeper.GetClientTimestampAtHeight(ctx, connection.ClientId, proofHeight)
@@ -864,6 +887,46 @@ func (k *Keeper) startFlushing(ctx sdk.Context, portID, channelID string, upgrad
 	return nil
 }
 
+// getProofConnection returns the connection end against which counterparty proofs are verified during the upgrade handshake.
+// Proofs are verified against the existing connection of the channel while its client is active. If the upgrade migrates
+// the channel to a new connection, proofs are verified against the new connection once the client of the existing connection
+// is no longer active, so that a channel can migrate away from a connection whose client has expired. The new connection of a
+// stored upgrade has been chosen by the authority of this chain, as ChanUpgradeTry rejects connection hops proposed by a relayer.
+func (k *Keeper) getProofConnection(ctx sdk.Context, channel types.Channel, upgradeFields types.UpgradeFields) (connectiontypes.ConnectionEnd, error) {
+	connection, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return connectiontypes.ConnectionEnd{}, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	if connection.State != connectiontypes.OPEN {
+		return connectiontypes.ConnectionEnd{}, errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connection.State)
+	}
+
+	upgradeConnectionID := upgradeFields.ConnectionHops[0]
+	if upgradeConnectionID == channel.ConnectionHops[0] {
+		return connection, nil
+	}
+
+	upgradeConnection, found := k.connectionKeeper.GetConnection(ctx, upgradeConnectionID)
+	if !found {
+		return connectiontypes.ConnectionEnd{}, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, upgradeConnectionID)
+	}
+
+	if upgradeConnection.State != connectiontypes.OPEN {
+		return connectiontypes.ConnectionEnd{}, errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "upgrade connection state is not OPEN (got %s)", upgradeConnection.State)
+	}
+
+	if err := k.validateConnectionMigration(ctx, channel.ConnectionHops[0], upgradeConnection); err != nil {
+		return connectiontypes.ConnectionEnd{}, err
+	}
+
+	if status := k.clientKeeper.GetClientStatus(ctx, connection.ClientId); status == exported.Active {
+		return connection, nil
+	}
+
+	return upgradeConnection, nil
+}
+
 // getAbsoluteUpgradeTimeout returns the absolute timeout for the given upgrade.
 func (k *Keeper) getAbsoluteUpgradeTimeout(ctx sdk.Context) types.Timeout {
 	upgradeTimeout := k.GetParams(ctx).UpgradeTimeout
@@ -909,6 +972,7 @@ func (k *Keeper) checkForUpgradeCompatibility(ctx sdk.Context, upgradeFields, co
 // - the proposed connection hops do not exist
 // - the proposed version is non-empty (checked in UpgradeFields.ValidateBasic())
 // - the proposed connection hops are not open
+// - the proposed connection hops do not terminate at the same counterparty chain as the existing connection hops
 func (k *Keeper) validateSelfUpgradeFields(ctx sdk.Context, proposedUpgrade types.UpgradeFields, channel types.Channel) error {
 	currentFields := extractUpgradeFields(channel)
 
@@ -926,6 +990,12 @@ func (k *Keeper) validateSelfUpgradeFields(ctx sdk.Context, proposedUpgrade type
 		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connection.State)
 	}
 
+	if connectionID != channel.ConnectionHops[0] {
+		if err := k.validateConnectionMigration(ctx, channel.ConnectionHops[0], connection); err != nil {
+			return err
+		}
+	}
+
 	getVersions := connection.Versions
 	if len(getVersions) != 1 {
 		return errorsmod.Wrapf(
@@ -946,6 +1016,46 @@ func (k *Keeper) validateSelfUpgradeFields(ctx sdk.Context, proposedUpgrade type
 	return nil
 }
 
+// validateConnectionMigration ensures that the proposed connection terminates at the same counterparty chain as the existing
+// connection of the channel. The chain IDs tracked by the clients of both connections must be equal.
+func (k *Keeper) validateConnectionMigration(ctx sdk.Context, connectionID string, proposedConnection connectiontypes.ConnectionEnd) error {
+	connection, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return errorsmod.Wrapf(connectiontypes.ErrConnectionNotFound, "failed to retrieve connection: %s", connectionID)
+	}
+
+	chainID, err := k.getCounterpartyChainID(ctx, connection.ClientId)
+	if err != nil {
+		return err
+	}
+
+	proposedChainID, err := k.getCounterpartyChainID(ctx, proposedConnection.ClientId)
+	if err != nil {
+		return err
+	}
+
+	if chainID != proposedChainID {
+		return errorsmod.Wrapf(types.ErrInvalidUpgrade, "proposed connection counterparty chain ID (%s) does not match existing connection counterparty chain ID (%s)", proposedChainID, chainID)
+	}
+
+	return nil
+}
+
+// getCounterpartyChainID returns the chain ID of the counterparty chain tracked by the client with the provided identifier.
+func (k *Keeper) getCounterpartyChainID(ctx sdk.Context, clientID string) (string, error) {
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return "", errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	chainIDClientState, ok := clientState.(interface{ GetChainID() string })
+	if !ok {
+		return "", errorsmod.Wrapf(types.ErrInvalidUpgrade, "client %s of type %s does not expose the chain ID of the counterparty", clientID, clientState.ClientType())
+	}
+
+	return chainIDClientState.GetChainID(), nil
+}
+
 // extractUpgradeFields returns the upgrade fields from the provided channel.
 func extractUpgradeFields(channel types.Channel) types.UpgradeFields {
 	return types.UpgradeFields{
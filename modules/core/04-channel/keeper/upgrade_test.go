@@ -4,8 +4,14 @@ import (
 	"fmt"
 	"math"
 	"testing"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
@@ -13,6 +19,7 @@ import (
 	commitmenttypes "github.com/cosmos/ibc-go/v8/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 	"github.com/cosmos/ibc-go/v8/testing/mock"
 )
@@ -212,6 +219,33 @@ func (suite *KeeperTestSuite) TestChanUpgradeTry() {
 			},
 			types.ErrIncompatibleCounterpartyUpgrade,
 		},
+		{
+			"fails due to connection hops proposed by the relayer, connection client impersonates the counterparty chain",
+			func() {
+				// create a client on chainB which reports the chain ID of chainA but tracks forged consensus states
+				impostorPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+				suite.Require().NoError(impostorPath.EndpointB.CreateClient())
+
+				height := impostorPath.EndpointB.GetClientLatestHeight()
+				consensusState, ok := impostorPath.EndpointB.GetConsensusState(height).(*ibctm.ConsensusState)
+				suite.Require().True(ok)
+				consensusState.Root = commitmenttypes.NewMerkleRoot([]byte("forged app hash"))
+				impostorPath.EndpointB.SetConsensusState(consensusState, height)
+
+				// the connection of the impostor client is a counterparty of the connection hops proposed by chainA
+				impostorPath.EndpointB.ConnectionID = "connection-100"
+				impostorPath.EndpointB.SetConnection(connectiontypes.NewConnectionEnd(
+					connectiontypes.OPEN,
+					impostorPath.EndpointB.ClientID,
+					connectiontypes.NewCounterparty(path.EndpointA.ClientID, counterpartyUpgrade.Fields.ConnectionHops[0], suite.chainA.GetPrefix()),
+					connectiontypes.GetCompatibleVersions(),
+					0,
+				))
+
+				proposedUpgrade.Fields.ConnectionHops = []string{impostorPath.EndpointB.ConnectionID}
+			},
+			types.ErrInvalidUpgrade,
+		},
 		{
 			"fails due to mismatch in upgrade sequences",
 			func() {
@@ -289,20 +323,26 @@ func (suite *KeeperTestSuite) TestChanUpgradeTry() {
 		},
 	}
 
+	// all test cases share the same upgrade handshake up to INIT, the handshake is
+	// performed once and the chain state is restored before each test case
+	suite.SetupTest()
+
+	setupPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	setupPath.Setup()
+
+	setupPath.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+	setupPath.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+
+	suite.Require().NoError(setupPath.EndpointA.ChanUpgradeInit())
+
+	snapshot := suite.coordinator.SnapshotAll()
+
 	for _, tc := range testCases {
 		tc := tc
 		suite.Run(tc.name, func() {
-			suite.SetupTest()
 			expPass := tc.expError == nil
 
-			path = ibctesting.NewPath(suite.chainA, suite.chainB)
-			path.Setup()
-
-			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
-			err := path.EndpointA.ChanUpgradeInit()
-			suite.Require().NoError(err)
-
-			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+			path = suite.restoreUpgradePath(snapshot, setupPath)
 			proposedUpgrade = path.EndpointB.GetProposedUpgrade()
 
 			var found bool
@@ -740,31 +780,34 @@ func (suite *KeeperTestSuite) TestChanUpgradeAck() {
 		},
 	}
 
-	for _, tc := range testCases {
-		tc := tc
-		suite.Run(tc.name, func() {
-			suite.SetupTest()
+	// all test cases share the same upgrade handshake up to TRY, the handshake is
+	// performed once and the chain state is restored before each test case
+	suite.SetupTest()
 
-			path = ibctesting.NewPath(suite.chainA, suite.chainB)
-			path.Setup()
+	setupPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	setupPath.Setup()
 
-			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
-			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+	setupPath.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+	setupPath.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
 
-			err := path.EndpointA.ChanUpgradeInit()
-			suite.Require().NoError(err)
+	suite.Require().NoError(setupPath.EndpointA.ChanUpgradeInit())
 
-			// manually set packet commitment so that the chainB channel state is FLUSHING
-			sequence, err := path.EndpointB.SendPacket(suite.chainB.GetTimeoutHeight(), 0, ibctesting.MockPacketData)
-			suite.Require().NoError(err)
-			suite.Require().Equal(uint64(1), sequence)
+	// manually set packet commitment so that the chainB channel state is FLUSHING
+	sequence, err := setupPath.EndpointB.SendPacket(suite.chainB.GetTimeoutHeight(), 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), sequence)
 
-			err = path.EndpointB.ChanUpgradeTry()
-			suite.Require().NoError(err)
+	suite.Require().NoError(setupPath.EndpointB.ChanUpgradeTry())
 
-			// ensure client is up to date to receive valid proofs
-			err = path.EndpointA.UpdateClient()
-			suite.Require().NoError(err)
+	// ensure client is up to date to receive valid proofs
+	suite.Require().NoError(setupPath.EndpointA.UpdateClient())
+
+	snapshot := suite.coordinator.SnapshotAll()
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			path = suite.restoreUpgradePath(snapshot, setupPath)
 
 			counterpartyUpgrade = path.EndpointB.GetChannelUpgrade()
 
@@ -772,7 +815,7 @@ func (suite *KeeperTestSuite) TestChanUpgradeAck() {
 
 			channelProof, upgradeProof, proofHeight := path.EndpointB.QueryChannelUpgradeProof()
 
-			err = suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.ChanUpgradeAck(
+			err := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.ChanUpgradeAck(
 				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, counterpartyUpgrade,
 				channelProof, upgradeProof, proofHeight,
 			)
@@ -1071,28 +1114,27 @@ func (suite *KeeperTestSuite) TestChanUpgradeConfirm() {
 		},
 	}
 
-	for _, tc := range testCases {
-		tc := tc
-		suite.Run(tc.name, func() {
-			suite.SetupTest()
+	// all test cases share the same upgrade handshake up to ACK, the handshake is
+	// performed once and the chain state is restored before each test case
+	suite.SetupTest()
 
-			path = ibctesting.NewPath(suite.chainA, suite.chainB)
-			path.Setup()
+	setupPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	setupPath.Setup()
 
-			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
-			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+	setupPath.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+	setupPath.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
 
-			err := path.EndpointA.ChanUpgradeInit()
-			suite.Require().NoError(err)
+	suite.Require().NoError(setupPath.EndpointA.ChanUpgradeInit())
+	suite.Require().NoError(setupPath.EndpointB.ChanUpgradeTry())
+	suite.Require().NoError(setupPath.EndpointA.ChanUpgradeAck())
+	suite.Require().NoError(setupPath.EndpointB.UpdateClient())
 
-			err = path.EndpointB.ChanUpgradeTry()
-			suite.Require().NoError(err)
+	snapshot := suite.coordinator.SnapshotAll()
 
-			err = path.EndpointA.ChanUpgradeAck()
-			suite.Require().NoError(err)
-
-			err = path.EndpointB.UpdateClient()
-			suite.Require().NoError(err)
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			path = suite.restoreUpgradePath(snapshot, setupPath)
 
 			counterpartyChannelState = path.EndpointA.GetChannel().State
 			counterpartyUpgrade = path.EndpointA.GetChannelUpgrade()
@@ -1101,7 +1143,7 @@ func (suite *KeeperTestSuite) TestChanUpgradeConfirm() {
 
 			channelProof, upgradeProof, proofHeight := path.EndpointA.QueryChannelUpgradeProof()
 
-			err = suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper.ChanUpgradeConfirm(
+			err := suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper.ChanUpgradeConfirm(
 				suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, counterpartyChannelState, counterpartyUpgrade,
 				channelProof, upgradeProof, proofHeight,
 			)
@@ -1292,6 +1334,8 @@ func (suite *KeeperTestSuite) TestChanUpgradeOpen() {
 		},
 	}
 
+	suite.SetupTest()
+
 	// Create an initial path used only to invoke a ChanOpenInit handshake.
 	// This bumps the channel identifier generated for chain A on the
 	// next path used to run the upgrade handshake.
@@ -1300,38 +1344,33 @@ func (suite *KeeperTestSuite) TestChanUpgradeOpen() {
 	path.SetupConnections()
 	suite.Require().NoError(path.EndpointA.ChanOpenInit())
 
-	for _, tc := range testCases {
-		tc := tc
-		suite.Run(tc.name, func() {
-			suite.SetupTest()
+	// all test cases share the same upgrade handshake up to CONFIRM, the handshake is
+	// performed once and the chain state is restored before each test case
+	setupPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+	setupPath.Setup()
 
-			path = ibctesting.NewPath(suite.chainA, suite.chainB)
-			path.Setup()
+	setupPath.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+	setupPath.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
 
-			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
-			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = mock.UpgradeVersion
+	suite.Require().NoError(setupPath.EndpointA.ChanUpgradeInit())
+	suite.Require().NoError(setupPath.EndpointB.ChanUpgradeTry())
+	suite.Require().NoError(setupPath.EndpointA.ChanUpgradeAck())
+	suite.Require().NoError(setupPath.EndpointB.ChanUpgradeConfirm())
+	suite.Require().NoError(setupPath.EndpointA.UpdateClient())
 
-			err := path.EndpointA.ChanUpgradeInit()
-			suite.Require().NoError(err)
-
-			err = path.EndpointB.ChanUpgradeTry()
-			suite.Require().NoError(err)
-
-			err = path.EndpointA.ChanUpgradeAck()
-			suite.Require().NoError(err)
+	snapshot := suite.coordinator.SnapshotAll()
 
-			err = path.EndpointB.ChanUpgradeConfirm()
-			suite.Require().NoError(err)
-
-			err = path.EndpointA.UpdateClient()
-			suite.Require().NoError(err)
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			path = suite.restoreUpgradePath(snapshot, setupPath)
 
 			tc.malleate()
 
-			channelKey := host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			channelKey := host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
 			channelProof, proofHeight := path.EndpointB.QueryProof(channelKey)
 
-			err = suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.ChanUpgradeOpen(
+			err := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.ChanUpgradeOpen(
 				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
 				path.EndpointB.GetChannel().State, path.EndpointB.GetChannel().UpgradeSequence, channelProof, proofHeight,
 			)
@@ -1345,6 +1384,107 @@ func (suite *KeeperTestSuite) TestChanUpgradeOpen() {
 	}
 }
 
+// TestChanUpgradeMigrateConnection tests migrating a transfer channel to a new connection once the clients of
+// the existing connection have expired. Both chains initiate the upgrade, so that the new connection hops are
+// proposed by each chain, and all handshake proofs are verified against the new connection.
+func (suite *KeeperTestSuite) TestChanUpgradeMigrateConnection() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	// create a new connection between the same chains to which the channel is migrated,
+	// its clients use a longer trusting period so that they remain active once the existing clients expire
+	newPath := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	newPath.EndpointA.ClientConfig.(*ibctesting.TendermintConfig).TrustingPeriod = ibctesting.UnbondingPeriod - time.Hour
+	newPath.EndpointB.ClientConfig.(*ibctesting.TendermintConfig).TrustingPeriod = ibctesting.UnbondingPeriod - time.Hour
+	newPath.SetupConnections()
+
+	// expire the clients of the existing connection
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+	suite.Require().Equal(exported.Expired, suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), path.EndpointA.ClientID))
+	suite.Require().Equal(exported.Expired, suite.chainB.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainB.GetContext(), path.EndpointB.ClientID))
+
+	// proofs can no longer be verified against the existing connection
+	path.EndpointA.ClientID, path.EndpointA.ConnectionID = newPath.EndpointA.ClientID, newPath.EndpointA.ConnectionID
+	path.EndpointB.ClientID, path.EndpointB.ConnectionID = newPath.EndpointB.ClientID, newPath.EndpointB.ConnectionID
+
+	// update the clients of the new connection, so that they remain active during the governance proposals initiating the upgrade
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+
+	path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.ConnectionHops = []string{newPath.EndpointA.ConnectionID}
+	path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.ConnectionHops = []string{newPath.EndpointB.ConnectionID}
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+
+	suite.Require().Equal(types.FLUSHCOMPLETE, path.EndpointA.GetChannel().State)
+	suite.Require().Equal(types.OPEN, path.EndpointB.GetChannel().State)
+	suite.Require().Equal([]string{newPath.EndpointB.ConnectionID}, path.EndpointB.GetChannel().ConnectionHops)
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+
+	suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+	suite.Require().Equal([]string{newPath.EndpointA.ConnectionID}, path.EndpointA.GetChannel().ConnectionHops)
+
+	// a transfer is relayed over the new connection
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	msg := transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	voucherDenomTrace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherDenomTrace.IBCDenom())
+	suite.Require().Equal(coin.Amount, balance.Amount)
+}
+
+// TestChanUpgradeMigrateConnectionActiveClients tests migrating a transfer channel to a new connection while the clients
+// of the existing connection are active. The handshake proofs are verified against the existing connection, and packets
+// are verified against the new connection once the upgrade has completed.
+func (suite *KeeperTestSuite) TestChanUpgradeMigrateConnectionActiveClients() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	newPath := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	newPath.SetupConnections()
+
+	path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.ConnectionHops = []string{newPath.EndpointA.ConnectionID}
+	path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.ConnectionHops = []string{newPath.EndpointB.ConnectionID}
+
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeInit())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeOpen())
+
+	suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+	suite.Require().Equal([]string{newPath.EndpointA.ConnectionID}, path.EndpointA.GetChannel().ConnectionHops)
+	suite.Require().Equal(types.OPEN, path.EndpointB.GetChannel().State)
+	suite.Require().Equal([]string{newPath.EndpointB.ConnectionID}, path.EndpointB.GetChannel().ConnectionHops)
+
+	// a transfer is relayed over the new connection
+	path.EndpointA.ClientID, path.EndpointA.ConnectionID = newPath.EndpointA.ClientID, newPath.EndpointA.ConnectionID
+	path.EndpointB.ClientID, path.EndpointB.ConnectionID = newPath.EndpointB.ClientID, newPath.EndpointB.ConnectionID
+
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+	msg := transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin, suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainB.GetTimeoutHeight(), 0, "")
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(path.RelayPacket(packet))
+}
+
 func (suite *KeeperTestSuite) TestWriteUpgradeOpenChannel() {
 	var path *ibctesting.Path
 
@@ -2032,6 +2172,15 @@ func (suite *KeeperTestSuite) TestChanUpgradeTimeout() {
 			},
 			connectiontypes.ErrConnectionNotFound,
 		},
+		{
+			"upgrade connection not found",
+			func() {
+				upgrade := path.EndpointA.GetChannelUpgrade()
+				upgrade.Fields.ConnectionHops = []string{ibctesting.InvalidID}
+				path.EndpointA.SetChannelUpgrade(upgrade)
+			},
+			connectiontypes.ErrConnectionNotFound,
+		},
 		{
 			"connection not open",
 			func() {
@@ -2302,6 +2451,21 @@ func (suite *KeeperTestSuite) TestValidateUpgradeFields() {
 			},
 			expPass: true,
 		},
+		{
+			name: "fails when proposed connection terminates at a different counterparty chain",
+			malleate: func() {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+				proposedUpgrade.ConnectionHops = []string{path.EndpointA.ConnectionID}
+
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				clientState.ChainId = "different-chain"
+				path.EndpointA.SetClientState(clientState)
+			},
+			expPass: false,
+		},
 		{
 			name:     "fails with unmodified fields",
 			malleate: func() {},
@@ -2375,6 +2539,30 @@ func (suite *KeeperTestSuite) TestValidateUpgradeFields() {
 	}
 }
 
+// restoreUpgradePath restores the chain state captured by the provided snapshot and returns a new path
+// using the identifiers and configuration of the path the snapshot state was created with. A new path
+// is returned so that changes made to the endpoint configuration by a test case are discarded.
+func (suite *KeeperTestSuite) restoreUpgradePath(snapshot ibctesting.CoordinatorSnapshot, setupPath *ibctesting.Path) *ibctesting.Path {
+	suite.coordinator.RestoreAll(snapshot)
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	for _, endpoints := range [][2]*ibctesting.Endpoint{
+		{path.EndpointA, setupPath.EndpointA},
+		{path.EndpointB, setupPath.EndpointB},
+	} {
+		endpoint, setupEndpoint := endpoints[0], endpoints[1]
+		endpoint.ClientID = setupEndpoint.ClientID
+		endpoint.ConnectionID = setupEndpoint.ConnectionID
+		endpoint.ChannelID = setupEndpoint.ChannelID
+
+		connectionConfig, channelConfig := *setupEndpoint.ConnectionConfig, *setupEndpoint.ChannelConfig
+		endpoint.ConnectionConfig = &connectionConfig
+		endpoint.ChannelConfig = &channelConfig
+	}
+
+	return path
+}
+
 func (suite *KeeperTestSuite) assertUpgradeError(actualError, expError error) {
 	suite.Require().Error(actualError)
 
@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 
 	errorsmod "cosmossdk.io/errors"
@@ -12,6 +13,11 @@ const (
 	// ackErrorString defines a string constant included in error acknowledgements
 	// NOTE: Changing this const is state machine breaking as acknowledgements are written into state.
 	ackErrorString = "error handling packet: see events for details"
+
+	// ackErrorCodePrefix defines the prefix of error acknowledgements constructed with NewErrorAcknowledgement
+	ackErrorCodePrefix = "ABCI code:"
+	// ackErrorWithCodespacePrefix defines the prefix of error acknowledgements constructed with NewErrorAcknowledgementWithCode
+	ackErrorWithCodespacePrefix = "ABCI error:"
 )
 
 // NewResultAcknowledgement returns a new instance of Acknowledgement using an Acknowledgement_Result
@@ -29,17 +35,29 @@ func NewResultAcknowledgement(result []byte) Acknowledgement {
 // NOTE: The error includes the ABCI codespace and code in the error string to provide more information about the module
 // that generated the error. This is useful for debugging but can potentially introduce non-determinism if care is
 // not taken to ensure the codespace doesn't change in non state-machine breaking versions.
+//
+// Deprecated: use NewErrorAcknowledgementWithCode, which returns the same acknowledgement.
 func NewErrorAcknowledgementWithCodespace(err error) Acknowledgement {
+	return NewErrorAcknowledgementWithCode(err)
+}
+
+// NewErrorAcknowledgementWithCode returns a new instance of Acknowledgement using an Acknowledgement_Error
+// type in the Response field, encoding the ErrorAcknowledgement which holds the ABCI code and codespace of the
+// provided error. The error message is redacted. Counterparty applications may use ParseErrorAcknowledgement
+// to obtain the ErrorAcknowledgement.
+// NOTE: Changing the codespace or code under which an error is registered is a consensus breaking change
+// for applications using this constructor, as acknowledgements are written into state.
+func NewErrorAcknowledgementWithCode(err error) Acknowledgement {
 	// The ABCI code is included in the abcitypes.ResponseDeliverTx hash
 	// constructed in Tendermint and is therefore deterministic.
 	// However, a code without codespace is incomplete information (e.g. sdk/5 and wasm/5 are
 	// different errors). We add this codespace here, in oder to provide a meaningful error
 	// identifier which means changing the codespace of an error becomes a consensus breaking change.
-	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	codespace, code, _ := errorsmod.ABCIInfo(err, false) // discard non-deterministic log value
 
 	return Acknowledgement{
 		Response: &Acknowledgement_Error{
-			Error: fmt.Sprintf("ABCI error: %s/%d: %s", codespace, code, ackErrorString),
+			Error: fmt.Sprintf("%s %s/%d: %s", ackErrorWithCodespacePrefix, codespace, code, ackErrorString),
 		},
 	}
 }
@@ -55,7 +73,7 @@ func NewErrorAcknowledgement(err error) Acknowledgement {
 
 	return Acknowledgement{
 		Response: &Acknowledgement_Error{
-			Error: fmt.Sprintf("ABCI code: %d: %s", code, ackErrorString),
+			Error: fmt.Sprintf("%s %d: %s", ackErrorCodePrefix, code, ackErrorString),
 		},
 	}
 }
@@ -90,3 +108,55 @@ func (ack Acknowledgement) Success() bool {
 func (ack Acknowledgement) Acknowledgement() []byte {
 	return SubModuleCdc.MustMarshalJSON(&ack)
 }
+
+// ParseErrorAcknowledgement returns the ErrorAcknowledgement encoded in the provided error acknowledgement.
+// Error acknowledgements constructed with NewErrorAcknowledgementWithCode contain the ABCI code and module
+// of the error, while error acknowledgements constructed with NewErrorAcknowledgement only contain the ABCI code.
+// Any other error string is treated as a free-form error message, for which an ErrorAcknowledgement
+// without code and module is returned which is not redacted.
+func ParseErrorAcknowledgement(ack Acknowledgement) (ErrorAcknowledgement, error) {
+	resp, ok := ack.Response.(*Acknowledgement_Error)
+	if !ok {
+		return ErrorAcknowledgement{}, errorsmod.Wrapf(ErrInvalidAcknowledgement, "expected %T, got %T", &Acknowledgement_Error{}, ack.Response)
+	}
+
+	suffix := fmt.Sprintf(": %s", ackErrorString)
+	if !strings.HasSuffix(resp.Error, suffix) {
+		return ErrorAcknowledgement{}, nil
+	}
+
+	identifier := strings.TrimSuffix(resp.Error, suffix)
+	switch {
+	case strings.HasPrefix(identifier, ackErrorWithCodespacePrefix+" "):
+		identifier = strings.TrimPrefix(identifier, ackErrorWithCodespacePrefix+" ")
+
+		// the code follows the last slash, as the codespace is arbitrary
+		idx := strings.LastIndex(identifier, "/")
+		if idx == -1 {
+			return ErrorAcknowledgement{}, nil
+		}
+
+		code, err := strconv.ParseUint(identifier[idx+1:], 10, 32)
+		if err != nil {
+			return ErrorAcknowledgement{}, nil
+		}
+
+		return ErrorAcknowledgement{Code: uint32(code), Module: identifier[:idx], Redacted: true}, nil
+	case strings.HasPrefix(identifier, ackErrorCodePrefix+" "):
+		code, err := strconv.ParseUint(strings.TrimPrefix(identifier, ackErrorCodePrefix+" "), 10, 32)
+		if err != nil {
+			return ErrorAcknowledgement{}, nil
+		}
+
+		return ErrorAcknowledgement{Code: uint32(code), Redacted: true}, nil
+	default:
+		return ErrorAcknowledgement{}, nil
+	}
+}
+
+// IsError returns true if the ErrorAcknowledgement was constructed from the provided registered error,
+// i.e. its module and code match the codespace and ABCI code of the error. Error acknowledgements which do
+// not contain a module never match.
+func (e ErrorAcknowledgement) IsError(target *errorsmod.Error) bool {
+	return e.Module != "" && e.Module == target.Codespace() && e.Code == target.ABCICode()
+}
@@ -173,3 +173,104 @@ func (suite TypesTestSuite) TestAcknowledgementWithCodespace() { //nolint:govet
 		})
 	}
 }
+
+// TestAcknowledgementWithCodeDeterminism verifies that error acknowledgements constructed with
+// NewErrorAcknowledgementWithCode only depend on the codespace and ABCI code of the error and
+// never include the non-deterministic error message.
+func (suite *TypesTestSuite) TestAcknowledgementWithCodeDeterminism() {
+	// same ABCI error code used
+	err := errorsmod.Wrap(ibcerrors.ErrOutOfGas, "error string 1")
+	errSameABCICode := errorsmod.Wrapf(ibcerrors.ErrOutOfGas, "error string 2 with %d", 1000)
+
+	// same ABCI error code used in a different codespace
+	errDifferentCodespace := errorsmod.Register("acktest", ibcerrors.ErrOutOfGas.ABCICode(), "different codespace")
+
+	ack := types.NewErrorAcknowledgementWithCode(err)
+	ackSameABCICode := types.NewErrorAcknowledgementWithCode(errSameABCICode)
+	ackDifferentCodespace := types.NewErrorAcknowledgementWithCode(errDifferentCodespace)
+
+	suite.Require().Equal(ack.Acknowledgement(), ackSameABCICode.Acknowledgement())
+	suite.Require().NotEqual(ack.Acknowledgement(), ackDifferentCodespace.Acknowledgement())
+	suite.Require().NotContains(ack.GetError(), "error string")
+	suite.Require().Equal([]byte(`{"error":"ABCI error: ibc/7: error handling packet: see events for details"}`), ack.Acknowledgement())
+}
+
+func (suite *TypesTestSuite) TestParseErrorAcknowledgement() {
+	testCases := []struct {
+		name   string
+		ack    types.Acknowledgement
+		expAck types.ErrorAcknowledgement
+		expErr error
+	}{
+		{
+			"success: error acknowledgement with code",
+			types.NewErrorAcknowledgementWithCode(errorsmod.Wrap(ibcerrors.ErrInsufficientFunds, "error string")),
+			types.ErrorAcknowledgement{Code: ibcerrors.ErrInsufficientFunds.ABCICode(), Module: ibcerrors.ErrInsufficientFunds.Codespace(), Redacted: true},
+			nil,
+		},
+		{
+			"success: error acknowledgement with code of unregistered error",
+			types.NewErrorAcknowledgementWithCode(fmt.Errorf("unknown error")),
+			types.ErrorAcknowledgement{Code: 1, Module: "undefined", Redacted: true},
+			nil,
+		},
+		{
+			"success: error acknowledgement with codespace containing a slash",
+			types.NewErrorAcknowledgementWithCode(errorsmod.Register("ack/test", 2, "codespace with slash")),
+			types.ErrorAcknowledgement{Code: 2, Module: "ack/test", Redacted: true},
+			nil,
+		},
+		{
+			"success: legacy error acknowledgement",
+			types.NewErrorAcknowledgement(ibcerrors.ErrInsufficientFunds),
+			types.ErrorAcknowledgement{Code: ibcerrors.ErrInsufficientFunds.ABCICode(), Redacted: true},
+			nil,
+		},
+		{
+			"success: free-form error acknowledgement",
+			types.Acknowledgement{Response: &types.Acknowledgement_Error{Error: "insufficient funds"}},
+			types.ErrorAcknowledgement{},
+			nil,
+		},
+		{
+			"success: free-form error acknowledgement with invalid code",
+			types.Acknowledgement{Response: &types.Acknowledgement_Error{Error: "ABCI error: ibc/abc: error handling packet: see events for details"}},
+			types.ErrorAcknowledgement{},
+			nil,
+		},
+		{
+			"failure: result acknowledgement",
+			types.NewResultAcknowledgement([]byte("success")),
+			types.ErrorAcknowledgement{},
+			types.ErrInvalidAcknowledgement,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			errAck, err := types.ParseErrorAcknowledgement(tc.ack)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expAck, errAck)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestErrorAcknowledgementIsError() {
+	errAck, err := types.ParseErrorAcknowledgement(types.NewErrorAcknowledgementWithCode(errorsmod.Wrap(ibcerrors.ErrInsufficientFunds, "error string")))
+	suite.Require().NoError(err)
+	suite.Require().True(errAck.IsError(ibcerrors.ErrInsufficientFunds))
+	suite.Require().False(errAck.IsError(ibcerrors.ErrOutOfGas))
+
+	// legacy error acknowledgements do not contain the module and therefore never match
+	errAck, err = types.ParseErrorAcknowledgement(types.NewErrorAcknowledgement(ibcerrors.ErrInsufficientFunds))
+	suite.Require().NoError(err)
+	suite.Require().False(errAck.IsError(ibcerrors.ErrInsufficientFunds))
+}
@@ -29,7 +29,7 @@ func (ch Channel) ValidateBasic() error {
 	if ch.State == UNINITIALIZED {
 		return ErrInvalidChannelState
 	}
-	if !slices.Contains([]Order{ORDERED, UNORDERED}, ch.Ordering) {
+	if !slices.Contains([]Order{ORDERED, UNORDERED, ORDERED_ALLOW_TIMEOUT}, ch.Ordering) {
 		return errorsmod.Wrap(ErrInvalidChannelOrdering, ch.Ordering.String())
 	}
 	if len(ch.ConnectionHops) != 1 {
@@ -86,18 +86,24 @@ const (
 	UNORDERED Order = 1
 	// packets are delivered exactly in the order which they were sent
 	ORDERED Order = 2
+	// packets are delivered exactly in the order which they were sent, a packet
+	// which timed out is skipped by the receiving chain, which writes a timeout
+	// receipt for it, so that the channel is not closed on timeout
+	ORDERED_ALLOW_TIMEOUT Order = 3
 )
 
 var Order_name = map[int32]string{
 	0: "ORDER_NONE_UNSPECIFIED",
 	1: "ORDER_UNORDERED",
 	2: "ORDER_ORDERED",
+	3: "ORDER_ORDERED_ALLOW_TIMEOUT",
 }
 
 var Order_value = map[string]int32{
-	"ORDER_NONE_UNSPECIFIED": 0,
-	"ORDER_UNORDERED":        1,
-	"ORDER_ORDERED":          2,
+	"ORDER_NONE_UNSPECIFIED":      0,
+	"ORDER_UNORDERED":             1,
+	"ORDER_ORDERED":               2,
+	"ORDER_ORDERED_ALLOW_TIMEOUT": 3,
 }
 
 func (x Order) String() string {
@@ -361,6 +367,54 @@ func (m *PacketState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PacketState proto.InternalMessageInfo
 
+// PacketAcknowledgementAge defines the height and time at which the acknowledgement
+// of a received packet was written.
+type PacketAcknowledgementAge struct {
+	// channel port identifier.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel unique identifier.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// packet sequence.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// block height at which the acknowledgement was written.
+	Height types.Height `protobuf:"bytes,4,opt,name=height,proto3" json:"height"`
+	// block time in nanoseconds at which the acknowledgement was written.
+	Timestamp uint64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *PacketAcknowledgementAge) Reset()         { *m = PacketAcknowledgementAge{} }
+func (m *PacketAcknowledgementAge) String() string { return proto.CompactTextString(m) }
+func (*PacketAcknowledgementAge) ProtoMessage()    {}
+func (*PacketAcknowledgementAge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{5}
+}
+func (m *PacketAcknowledgementAge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketAcknowledgementAge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketAcknowledgementAge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketAcknowledgementAge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketAcknowledgementAge.Merge(m, src)
+}
+func (m *PacketAcknowledgementAge) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketAcknowledgementAge) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketAcknowledgementAge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketAcknowledgementAge proto.InternalMessageInfo
+
 // PacketId is an identifier for a unique Packet
 // Source chains refer to packets by source port/channel
 // Destination chains refer to packets by destination port/channel
@@ -377,7 +431,7 @@ func (m *PacketId) Reset()         { *m = PacketId{} }
 func (m *PacketId) String() string { return proto.CompactTextString(m) }
 func (*PacketId) ProtoMessage()    {}
 func (*PacketId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{5}
+	return fileDescriptor_c3a07336710636a0, []int{6}
 }
 func (m *PacketId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -426,7 +480,7 @@ func (m *Acknowledgement) Reset()         { *m = Acknowledgement{} }
 func (m *Acknowledgement) String() string { return proto.CompactTextString(m) }
 func (*Acknowledgement) ProtoMessage()    {}
 func (*Acknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{6}
+	return fileDescriptor_c3a07336710636a0, []int{7}
 }
 func (m *Acknowledgement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -500,6 +554,72 @@ func (*Acknowledgement) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// ErrorAcknowledgement is the structured representation of an error acknowledgement.
+// It is encoded deterministically into the error field of an Acknowledgement, such that
+// counterparties unaware of it continue to handle the acknowledgement as a failure.
+type ErrorAcknowledgement struct {
+	// ABCI code of the error which caused the packet to fail
+	Code uint32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	// codespace of the module which registered the error, empty if unknown
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// redacted is true if the error message has been omitted from the acknowledgement
+	Redacted bool `protobuf:"varint,3,opt,name=redacted,proto3" json:"redacted,omitempty"`
+}
+
+func (m *ErrorAcknowledgement) Reset()         { *m = ErrorAcknowledgement{} }
+func (m *ErrorAcknowledgement) String() string { return proto.CompactTextString(m) }
+func (*ErrorAcknowledgement) ProtoMessage()    {}
+func (*ErrorAcknowledgement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{8}
+}
+func (m *ErrorAcknowledgement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorAcknowledgement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorAcknowledgement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ErrorAcknowledgement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorAcknowledgement.Merge(m, src)
+}
+func (m *ErrorAcknowledgement) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorAcknowledgement) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorAcknowledgement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorAcknowledgement proto.InternalMessageInfo
+
+func (m *ErrorAcknowledgement) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ErrorAcknowledgement) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
+func (m *ErrorAcknowledgement) GetRedacted() bool {
+	if m != nil {
+		return m.Redacted
+	}
+	return false
+}
+
 // Timeout defines an execution deadline structure for 04-channel handlers.
 // This includes packet lifecycle handlers as well as the upgrade handshake handlers.
 // A valid Timeout contains either one or both of a timestamp and block height (sequence).
@@ -514,7 +634,7 @@ func (m *Timeout) Reset()         { *m = Timeout{} }
 func (m *Timeout) String() string { return proto.CompactTextString(m) }
 func (*Timeout) ProtoMessage()    {}
 func (*Timeout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{7}
+	return fileDescriptor_c3a07336710636a0, []int{9}
 }
 func (m *Timeout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -561,13 +681,18 @@ func (m *Timeout) GetTimestamp() uint64 {
 type Params struct {
 	// the relative timeout after which channel upgrades will time out.
 	UpgradeTimeout Timeout `protobuf:"bytes,1,opt,name=upgrade_timeout,json=upgradeTimeout,proto3" json:"upgrade_timeout"`
+	// the maximum size in bytes of the data of sent and received packets, zero means unlimited.
+	MaxPacketDataSize uint64 `protobuf:"varint,2,opt,name=max_packet_data_size,json=maxPacketDataSize,proto3" json:"max_packet_data_size,omitempty"`
+	// if enabled, a panic in the OnRecvPacket callback of an application is recovered and answered with an error
+	// acknowledgement, instead of failing the transaction delivering the packet.
+	EnablePanicIsolation bool `protobuf:"varint,3,opt,name=enable_panic_isolation,json=enablePanicIsolation,proto3" json:"enable_panic_isolation,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{8}
+	return fileDescriptor_c3a07336710636a0, []int{10}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,6 +728,20 @@ func (m *Params) GetUpgradeTimeout() Timeout {
 	return Timeout{}
 }
 
+func (m *Params) GetMaxPacketDataSize() uint64 {
+	if m != nil {
+		return m.MaxPacketDataSize
+	}
+	return 0
+}
+
+func (m *Params) GetEnablePanicIsolation() bool {
+	if m != nil {
+		return m.EnablePanicIsolation
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.State", State_name, State_value)
 	proto.RegisterEnum("ibc.core.channel.v1.Order", Order_name, Order_value)
@@ -611,8 +750,10 @@ func init() {
 	proto.RegisterType((*Counterparty)(nil), "ibc.core.channel.v1.Counterparty")
 	proto.RegisterType((*Packet)(nil), "ibc.core.channel.v1.Packet")
 	proto.RegisterType((*PacketState)(nil), "ibc.core.channel.v1.PacketState")
+	proto.RegisterType((*PacketAcknowledgementAge)(nil), "ibc.core.channel.v1.PacketAcknowledgementAge")
 	proto.RegisterType((*PacketId)(nil), "ibc.core.channel.v1.PacketId")
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
+	proto.RegisterType((*ErrorAcknowledgement)(nil), "ibc.core.channel.v1.ErrorAcknowledgement")
 	proto.RegisterType((*Timeout)(nil), "ibc.core.channel.v1.Timeout")
 	proto.RegisterType((*Params)(nil), "ibc.core.channel.v1.Params")
 }
@@ -620,66 +761,76 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 937 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0xe2, 0xc6,
-	0x1b, 0xc6, 0xc4, 0xfc, 0x7b, 0x93, 0x80, 0x33, 0xf9, 0xfd, 0x52, 0xcb, 0x4a, 0xc1, 0x8b, 0x5a,
-	0x95, 0x4d, 0xb5, 0xb0, 0xd9, 0x56, 0xd5, 0xb6, 0xb7, 0x04, 0xbc, 0x8b, 0xb5, 0x14, 0x90, 0x81,
-	0x43, 0xf7, 0x82, 0x8c, 0x3d, 0x05, 0x6b, 0xc1, 0x43, 0xed, 0x81, 0xd5, 0xaa, 0xe7, 0x4a, 0x2b,
-	0x4e, 0xfd, 0x02, 0x48, 0x95, 0xfa, 0x15, 0xfa, 0x21, 0xf6, 0xb8, 0xc7, 0x3d, 0x55, 0x55, 0xf2,
-	0x1d, 0x7a, 0xae, 0x3c, 0x33, 0x0e, 0x10, 0x45, 0x51, 0x55, 0xa9, 0xb7, 0x9e, 0x98, 0xf7, 0x79,
-	0x9f, 0xf7, 0x7d, 0xde, 0x3f, 0xc3, 0xc8, 0xf0, 0xc0, 0x1b, 0x39, 0x35, 0x87, 0x04, 0xb8, 0xe6,
-	0x4c, 0x6c, 0xdf, 0xc7, 0xd3, 0xda, 0xf2, 0x3c, 0x3e, 0x56, 0xe7, 0x01, 0xa1, 0x04, 0x1d, 0x7b,
-	0x23, 0xa7, 0x1a, 0x51, 0xaa, 0x31, 0xbe, 0x3c, 0xd7, 0xfe, 0x37, 0x26, 0x63, 0xc2, 0xfc, 0xb5,
-	0xe8, 0xc4, 0xa9, 0x5a, 0x69, 0x93, 0x6d, 0xea, 0x61, 0x9f, 0xb2, 0x64, 0xec, 0xc4, 0x09, 0xe5,
-	0xdf, 0x92, 0x90, 0xa9, 0xf3, 0x2c, 0xe8, 0x31, 0xa4, 0x42, 0x6a, 0x53, 0xac, 0x4a, 0xba, 0x54,
-	0xc9, 0x3f, 0xd1, 0xaa, 0x77, 0xe8, 0x54, 0x7b, 0x11, 0xc3, 0xe2, 0x44, 0xf4, 0x15, 0x64, 0x49,
-	0xe0, 0xe2, 0xc0, 0xf3, 0xc7, 0x6a, 0xf2, 0x9e, 0xa0, 0x4e, 0x44, 0xb2, 0x6e, 0xb8, 0xe8, 0x05,
-	0x1c, 0x38, 0x64, 0xe1, 0x53, 0x1c, 0xcc, 0xed, 0x80, 0xbe, 0x51, 0xf7, 0x74, 0xa9, 0xb2, 0xff,
-	0xe4, 0xc1, 0x9d, 0xb1, 0xf5, 0x2d, 0xe2, 0xa5, 0xfc, 0xee, 0xf7, 0x52, 0xc2, 0xda, 0x09, 0x46,
-	0x9f, 0x41, 0xc1, 0x21, 0xbe, 0x8f, 0x1d, 0xea, 0x11, 0x7f, 0x38, 0x21, 0xf3, 0x50, 0x95, 0xf5,
-	0xbd, 0x4a, 0xce, 0xca, 0x6f, 0xe0, 0x26, 0x99, 0x87, 0x48, 0x85, 0xcc, 0x12, 0x07, 0xa1, 0x47,
-	0x7c, 0x35, 0xa5, 0x4b, 0x95, 0x9c, 0x15, 0x9b, 0xe8, 0x21, 0x28, 0x8b, 0xf9, 0x38, 0xb0, 0x5d,
-	0x3c, 0x0c, 0xf1, 0x0f, 0x0b, 0xec, 0x3b, 0x58, 0x4d, 0xeb, 0x52, 0x45, 0xb6, 0x0a, 0x02, 0xef,
-	0x09, 0xf8, 0x1b, 0xf9, 0xed, 0x2f, 0xa5, 0x44, 0xf9, 0xcf, 0x24, 0x1c, 0x99, 0x2e, 0xf6, 0xa9,
-	0xf7, 0xbd, 0x87, 0xdd, 0xff, 0x06, 0xf8, 0x11, 0x64, 0xe6, 0x24, 0xa0, 0x43, 0xcf, 0x65, 0x73,
-	0xcb, 0x59, 0xe9, 0xc8, 0x34, 0x5d, 0xf4, 0x31, 0x80, 0x28, 0x25, 0xf2, 0x65, 0x98, 0x2f, 0x27,
-	0x10, 0xd3, 0xbd, 0x73, 0xf0, 0xd9, 0xfb, 0x06, 0xdf, 0x82, 0x83, 0xed, 0x7e, 0xb6, 0x85, 0xa5,
-	0x7b, 0x84, 0x93, 0xb7, 0x84, 0x45, 0xb6, 0x0f, 0x49, 0x48, 0x77, 0x6d, 0xe7, 0x15, 0xa6, 0x48,
-	0x83, 0xec, 0x4d, 0x05, 0x12, 0xab, 0xe0, 0xc6, 0x46, 0x25, 0xd8, 0x0f, 0xc9, 0x22, 0x70, 0xf0,
-	0x30, 0x4a, 0x2e, 0x92, 0x01, 0x87, 0xba, 0x24, 0xa0, 0xe8, 0x53, 0xc8, 0x0b, 0x82, 0x50, 0x60,
-	0x0b, 0xc9, 0x59, 0x87, 0x1c, 0x8d, 0xef, 0xc7, 0x43, 0x50, 0x5c, 0x1c, 0x52, 0xcf, 0xb7, 0xd9,
-	0xa4, 0x59, 0x32, 0x99, 0x11, 0x0b, 0x5b, 0x38, 0xcb, 0x58, 0x83, 0xe3, 0x6d, 0x6a, 0x9c, 0x96,
-	0x8f, 0x1d, 0x6d, 0xb9, 0xe2, 0xdc, 0x08, 0x64, 0xd7, 0xa6, 0x36, 0x1b, 0xff, 0x81, 0xc5, 0xce,
-	0xe8, 0x39, 0xe4, 0xa9, 0x37, 0xc3, 0x64, 0x41, 0x87, 0x13, 0xec, 0x8d, 0x27, 0x94, 0x2d, 0x60,
-	0x7f, 0xe7, 0x8e, 0xf1, 0xc7, 0x60, 0x79, 0x5e, 0x6d, 0x32, 0x86, 0xb8, 0x20, 0x87, 0x22, 0x8e,
-	0x83, 0xe8, 0x73, 0x38, 0x8a, 0x13, 0x45, 0xbf, 0x21, 0xb5, 0x67, 0x73, 0xb1, 0x27, 0x45, 0x38,
-	0xfa, 0x31, 0x2e, 0x46, 0xfb, 0x23, 0xec, 0xf3, 0xc9, 0xb2, 0xfb, 0xfe, 0x4f, 0xf7, 0xb4, 0xb3,
-	0x96, 0xbd, 0x5b, 0x6b, 0x89, 0x5b, 0x96, 0x37, 0x2d, 0x0b, 0x71, 0x17, 0xb2, 0x5c, 0xdc, 0x74,
-	0xff, 0x0d, 0x65, 0xa1, 0xd2, 0x81, 0xc2, 0x85, 0xf3, 0xca, 0x27, 0xaf, 0xa7, 0xd8, 0x1d, 0xe3,
-	0x19, 0xf6, 0x29, 0x52, 0x21, 0x1d, 0xe0, 0x70, 0x31, 0xa5, 0xea, 0xff, 0xa3, 0xa2, 0x9a, 0x09,
-	0x4b, 0xd8, 0xe8, 0x04, 0x52, 0x38, 0x08, 0x48, 0xa0, 0x9e, 0x44, 0x42, 0xcd, 0x84, 0xc5, 0xcd,
-	0x4b, 0x80, 0x6c, 0x80, 0xc3, 0x39, 0xf1, 0x43, 0x5c, 0xb6, 0x21, 0xd3, 0xe7, 0xd3, 0x44, 0x4f,
-	0x21, 0x2d, 0x56, 0x26, 0xfd, 0xcd, 0x95, 0x09, 0x3e, 0x3a, 0x85, 0xdc, 0x66, 0x47, 0x49, 0x56,
-	0xf8, 0x06, 0x28, 0x0f, 0xa2, 0x0b, 0x1f, 0xd8, 0xb3, 0x10, 0xbd, 0x80, 0xf8, 0x2f, 0x36, 0x14,
-	0x2b, 0x14, 0x52, 0xa7, 0x77, 0xbe, 0x22, 0xa2, 0x30, 0x21, 0x96, 0x17, 0xa1, 0x02, 0x3d, 0xfb,
-	0x29, 0x09, 0xa9, 0x9e, 0x78, 0xd1, 0x4a, 0xbd, 0xfe, 0x45, 0xdf, 0x18, 0x0e, 0xda, 0x66, 0xdb,
-	0xec, 0x9b, 0x17, 0x2d, 0xf3, 0xa5, 0xd1, 0x18, 0x0e, 0xda, 0xbd, 0xae, 0x51, 0x37, 0x9f, 0x99,
-	0x46, 0x43, 0x49, 0x68, 0x47, 0xab, 0xb5, 0x7e, 0xb8, 0x43, 0x40, 0x2a, 0x00, 0x8f, 0x8b, 0x40,
-	0x45, 0xd2, 0xb2, 0xab, 0xb5, 0x2e, 0x47, 0x67, 0x54, 0x84, 0x43, 0xee, 0xe9, 0x5b, 0xdf, 0x75,
-	0xba, 0x46, 0x5b, 0x49, 0x6a, 0xfb, 0xab, 0xb5, 0x9e, 0x11, 0xe6, 0x26, 0x92, 0x39, 0xf7, 0x78,
-	0x24, 0xf3, 0x9c, 0xc2, 0x01, 0xf7, 0xd4, 0x5b, 0x9d, 0x9e, 0xd1, 0x50, 0x64, 0x0d, 0x56, 0x6b,
-	0x3d, 0xcd, 0x2d, 0xa4, 0x43, 0x9e, 0x7b, 0x9f, 0xb5, 0x06, 0xbd, 0xa6, 0xd9, 0x7e, 0xae, 0xa4,
-	0xb4, 0x83, 0xd5, 0x5a, 0xcf, 0xc6, 0x36, 0x3a, 0x83, 0xe3, 0x2d, 0x46, 0xbd, 0xf3, 0x6d, 0xb7,
-	0x65, 0xf4, 0x0d, 0x25, 0xcd, 0xeb, 0xdf, 0x01, 0x35, 0xf9, 0xed, 0xaf, 0xc5, 0xc4, 0xd9, 0x6b,
-	0x48, 0xb1, 0xa7, 0x1a, 0x7d, 0x02, 0x27, 0x1d, 0xab, 0x61, 0x58, 0xc3, 0x76, 0xa7, 0x6d, 0xdc,
-	0xea, 0x9e, 0x15, 0x18, 0xe1, 0xa8, 0x0c, 0x05, 0xce, 0x1a, 0xb4, 0xd9, 0xaf, 0xd1, 0x50, 0x24,
-	0xed, 0x70, 0xb5, 0xd6, 0x73, 0x37, 0x40, 0xd4, 0x3e, 0xe7, 0xc4, 0x0c, 0xd1, 0xbe, 0x30, 0xb9,
-	0xf0, 0x65, 0xef, 0xdd, 0x55, 0x51, 0x7a, 0x7f, 0x55, 0x94, 0xfe, 0xb8, 0x2a, 0x4a, 0x3f, 0x5f,
-	0x17, 0x13, 0xef, 0xaf, 0x8b, 0x89, 0x0f, 0xd7, 0xc5, 0xc4, 0xcb, 0xaf, 0xc7, 0x1e, 0x9d, 0x2c,
-	0x46, 0x55, 0x87, 0xcc, 0x6a, 0x0e, 0x09, 0x67, 0x24, 0xac, 0x79, 0x23, 0xe7, 0xd1, 0x98, 0xd4,
-	0x96, 0x4f, 0x6b, 0x33, 0xe2, 0x2e, 0xa6, 0x38, 0xe4, 0x9f, 0x08, 0x8f, 0xbf, 0x7c, 0x14, 0x7f,
-	0x73, 0xd0, 0x37, 0x73, 0x1c, 0x8e, 0xd2, 0xec, 0x1b, 0xe1, 0x8b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x90, 0xd1, 0xb4, 0xca, 0x94, 0x08, 0x00, 0x00,
+	// 1090 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x65, 0xea, 0x6f, 0x6c, 0xc9, 0xf2, 0xc6, 0x71, 0x59, 0x36, 0x95, 0x19, 0xa1, 0x45,
+	0x1d, 0x17, 0x91, 0xe2, 0x34, 0x28, 0xd2, 0xdc, 0x6c, 0x8b, 0x89, 0x89, 0x28, 0x92, 0x40, 0xc9,
+	0x28, 0x9a, 0x43, 0x09, 0x8a, 0xdc, 0xca, 0x44, 0x24, 0x2e, 0x4b, 0xae, 0xdc, 0x24, 0x3d, 0x17,
+	0x08, 0x74, 0xea, 0x0b, 0x08, 0x28, 0xd0, 0x47, 0x68, 0x8f, 0x7d, 0x80, 0x1c, 0x7a, 0xc8, 0x31,
+	0xa7, 0xa2, 0xb0, 0xdf, 0xa1, 0xe7, 0x82, 0xbb, 0x4b, 0xeb, 0x07, 0x86, 0x51, 0xb4, 0xc8, 0xad,
+	0x27, 0xed, 0x7c, 0xdf, 0xb7, 0x33, 0xb3, 0xdf, 0x2c, 0x29, 0xc2, 0x4d, 0xaf, 0xef, 0xd4, 0x1d,
+	0x12, 0xe2, 0xba, 0x73, 0x62, 0xfb, 0x3e, 0x1e, 0xd6, 0x4f, 0xf7, 0x92, 0x65, 0x2d, 0x08, 0x09,
+	0x25, 0xe8, 0x9a, 0xd7, 0x77, 0x6a, 0xb1, 0xa4, 0x96, 0xe0, 0xa7, 0x7b, 0xea, 0xe6, 0x80, 0x0c,
+	0x08, 0xe3, 0xeb, 0xf1, 0x8a, 0x4b, 0xd5, 0xed, 0x59, 0xb6, 0xa1, 0x87, 0x7d, 0xca, 0x92, 0xb1,
+	0x15, 0x17, 0x54, 0x7f, 0x4d, 0x43, 0xee, 0x90, 0x67, 0x41, 0x77, 0x20, 0x13, 0x51, 0x9b, 0x62,
+	0x45, 0xd2, 0xa4, 0x9d, 0xd2, 0x5d, 0xb5, 0x76, 0x49, 0x9d, 0x5a, 0x37, 0x56, 0x98, 0x5c, 0x88,
+	0x3e, 0x87, 0x3c, 0x09, 0x5d, 0x1c, 0x7a, 0xfe, 0x40, 0x49, 0x5f, 0xb1, 0xa9, 0x1d, 0x8b, 0xcc,
+	0x0b, 0x2d, 0x7a, 0x0c, 0x6b, 0x0e, 0x19, 0xfb, 0x14, 0x87, 0x81, 0x1d, 0xd2, 0x17, 0xca, 0x8a,
+	0x26, 0xed, 0xac, 0xde, 0xbd, 0x79, 0xe9, 0xde, 0xc3, 0x39, 0xe1, 0x81, 0xfc, 0xfa, 0x8f, 0xed,
+	0x94, 0xb9, 0xb0, 0x19, 0x7d, 0x02, 0xeb, 0x0e, 0xf1, 0x7d, 0xec, 0x50, 0x8f, 0xf8, 0xd6, 0x09,
+	0x09, 0x22, 0x45, 0xd6, 0x56, 0x76, 0x0a, 0x66, 0x69, 0x06, 0x1f, 0x91, 0x20, 0x42, 0x0a, 0xe4,
+	0x4e, 0x71, 0x18, 0x79, 0xc4, 0x57, 0x32, 0x9a, 0xb4, 0x53, 0x30, 0x93, 0x10, 0xdd, 0x82, 0xf2,
+	0x38, 0x18, 0x84, 0xb6, 0x8b, 0xad, 0x08, 0x7f, 0x3b, 0xc6, 0xbe, 0x83, 0x95, 0xac, 0x26, 0xed,
+	0xc8, 0xe6, 0xba, 0xc0, 0xbb, 0x02, 0x7e, 0x20, 0xbf, 0xfa, 0x69, 0x3b, 0x55, 0xfd, 0x2b, 0x0d,
+	0x1b, 0x86, 0x8b, 0x7d, 0xea, 0x7d, 0xe3, 0x61, 0xf7, 0x7f, 0x03, 0xdf, 0x83, 0x5c, 0x40, 0x42,
+	0x6a, 0x79, 0x2e, 0xf3, 0xad, 0x60, 0x66, 0xe3, 0xd0, 0x70, 0xd1, 0x87, 0x00, 0xa2, 0x95, 0x98,
+	0xcb, 0x31, 0xae, 0x20, 0x10, 0xc3, 0xbd, 0xd4, 0xf8, 0xfc, 0x55, 0xc6, 0x37, 0x61, 0x6d, 0xfe,
+	0x3c, 0xf3, 0x85, 0xa5, 0x2b, 0x0a, 0xa7, 0x97, 0x0a, 0x8b, 0x6c, 0x6f, 0xd3, 0x90, 0xed, 0xd8,
+	0xce, 0x33, 0x4c, 0x91, 0x0a, 0xf9, 0x8b, 0x0e, 0x24, 0xd6, 0xc1, 0x45, 0x8c, 0xb6, 0x61, 0x35,
+	0x22, 0xe3, 0xd0, 0xc1, 0x56, 0x9c, 0x5c, 0x24, 0x03, 0x0e, 0x75, 0x48, 0x48, 0xd1, 0xc7, 0x50,
+	0x12, 0x02, 0x51, 0x81, 0x0d, 0xa4, 0x60, 0x16, 0x39, 0x9a, 0xdc, 0x8f, 0x5b, 0x50, 0x76, 0x71,
+	0x44, 0x3d, 0xdf, 0x66, 0x4e, 0xb3, 0x64, 0x32, 0x13, 0xae, 0xcf, 0xe1, 0x2c, 0x63, 0x1d, 0xae,
+	0xcd, 0x4b, 0x93, 0xb4, 0xdc, 0x76, 0x34, 0x47, 0x25, 0xb9, 0x11, 0xc8, 0xae, 0x4d, 0x6d, 0x66,
+	0xff, 0x9a, 0xc9, 0xd6, 0xe8, 0x11, 0x94, 0xa8, 0x37, 0xc2, 0x64, 0x4c, 0xad, 0x13, 0xec, 0x0d,
+	0x4e, 0x28, 0x1b, 0xc0, 0xea, 0xc2, 0x1d, 0xe3, 0x2f, 0x83, 0xd3, 0xbd, 0xda, 0x11, 0x53, 0x88,
+	0x0b, 0x52, 0x14, 0xfb, 0x38, 0x88, 0x3e, 0x85, 0x8d, 0x24, 0x51, 0xfc, 0x1b, 0x51, 0x7b, 0x14,
+	0x88, 0x39, 0x95, 0x05, 0xd1, 0x4b, 0x70, 0x61, 0xed, 0xf7, 0xb0, 0xca, 0x9d, 0x65, 0xf7, 0xfd,
+	0xdf, 0xce, 0x69, 0x61, 0x2c, 0x2b, 0x4b, 0x63, 0x49, 0x8e, 0x2c, 0xcf, 0x8e, 0x2c, 0x8a, 0xff,
+	0x2e, 0x81, 0xc2, 0xab, 0xef, 0x3b, 0xcf, 0x7c, 0xf2, 0xdd, 0x10, 0xbb, 0x03, 0x3c, 0xc2, 0x3e,
+	0xdd, 0x1f, 0xbc, 0x9b, 0x56, 0xee, 0x43, 0x56, 0x38, 0x2c, 0xff, 0x43, 0x87, 0x85, 0x1e, 0xdd,
+	0x80, 0xc2, 0xcc, 0xd2, 0x0c, 0x4b, 0x3b, 0x03, 0xc4, 0x71, 0x5c, 0xc8, 0xf3, 0xd3, 0x18, 0xee,
+	0xbb, 0xe8, 0x5e, 0x54, 0x69, 0xc3, 0xfa, 0x92, 0x5b, 0x48, 0x81, 0x6c, 0x88, 0xa3, 0xf1, 0x90,
+	0x2a, 0xd7, 0x63, 0x8f, 0x8f, 0x52, 0xa6, 0x88, 0xd1, 0x16, 0x64, 0x70, 0x18, 0x92, 0x50, 0xd9,
+	0x8a, 0x0b, 0x1d, 0xa5, 0x4c, 0x1e, 0x1e, 0x00, 0xe4, 0x43, 0x1c, 0x05, 0xc4, 0x8f, 0x70, 0xf5,
+	0x6b, 0xd8, 0xd4, 0x63, 0x70, 0x39, 0x2b, 0x02, 0xd9, 0x21, 0x2e, 0x7f, 0xcc, 0x8a, 0x26, 0x5b,
+	0xa3, 0x2d, 0xc8, 0x8e, 0x88, 0x3b, 0x1e, 0x62, 0xd1, 0xb9, 0x88, 0xe2, 0xb6, 0x43, 0xec, 0xda,
+	0x0e, 0xc5, 0x2e, 0x6b, 0x3b, 0x6f, 0x5e, 0xc4, 0x55, 0x1b, 0x72, 0x3d, 0x7e, 0xf9, 0xe6, 0xfc,
+	0x97, 0xfe, 0x8b, 0xff, 0xe9, 0x25, 0xff, 0xab, 0xbf, 0x49, 0xf1, 0x0b, 0x22, 0xb4, 0x47, 0x11,
+	0x7a, 0x0c, 0xc9, 0x2b, 0xc9, 0x12, 0x57, 0x5e, 0xd4, 0xba, 0x71, 0xe9, 0x5b, 0x57, 0x74, 0x26,
+	0xaa, 0x95, 0xc4, 0xd6, 0xa4, 0xdf, 0x3a, 0x6c, 0x8e, 0xec, 0xe7, 0x56, 0xc0, 0xa6, 0x6a, 0xc5,
+	0x37, 0xd7, 0x8a, 0xbc, 0x97, 0x58, 0x34, 0xb0, 0x31, 0xb2, 0x9f, 0xf3, 0x81, 0x37, 0x6c, 0x6a,
+	0x77, 0xbd, 0x97, 0x18, 0xdd, 0x83, 0x2d, 0xec, 0xdb, 0xfd, 0x21, 0xb6, 0x02, 0xdb, 0xf7, 0x1c,
+	0xcb, 0x8b, 0xc8, 0x90, 0x3d, 0xff, 0xc2, 0x95, 0x4d, 0xce, 0x76, 0x62, 0xd2, 0x48, 0xb8, 0xdd,
+	0x1f, 0xd2, 0x90, 0xe9, 0x8a, 0x3f, 0x9a, 0xed, 0x6e, 0x6f, 0xbf, 0xa7, 0x5b, 0xc7, 0x2d, 0xa3,
+	0x65, 0xf4, 0x8c, 0xfd, 0xa6, 0xf1, 0x54, 0x6f, 0x58, 0xc7, 0xad, 0x6e, 0x47, 0x3f, 0x34, 0x1e,
+	0x1a, 0x7a, 0xa3, 0x9c, 0x52, 0x37, 0x26, 0x53, 0xad, 0xb8, 0x20, 0x40, 0x0a, 0x00, 0xdf, 0x17,
+	0x83, 0x65, 0x49, 0xcd, 0x4f, 0xa6, 0x9a, 0x1c, 0xaf, 0x51, 0x05, 0x8a, 0x9c, 0xe9, 0x99, 0x5f,
+	0xb5, 0x3b, 0x7a, 0xab, 0x9c, 0x56, 0x57, 0x27, 0x53, 0x2d, 0x27, 0xc2, 0xd9, 0x4e, 0x46, 0xae,
+	0xf0, 0x9d, 0x8c, 0xb9, 0x01, 0x6b, 0x9c, 0x39, 0x6c, 0xb6, 0xbb, 0x7a, 0xa3, 0x2c, 0xab, 0x30,
+	0x99, 0x6a, 0x59, 0x1e, 0x21, 0x0d, 0x4a, 0x9c, 0x7d, 0xd8, 0x3c, 0xee, 0x1e, 0x19, 0xad, 0x47,
+	0xe5, 0x8c, 0xba, 0x36, 0x99, 0x6a, 0xf9, 0x24, 0x46, 0xbb, 0x70, 0x6d, 0x4e, 0x71, 0xd8, 0x7e,
+	0xd2, 0x69, 0xea, 0x3d, 0xbd, 0x9c, 0xe5, 0xfd, 0x2f, 0x80, 0xaa, 0xfc, 0xea, 0xe7, 0x4a, 0x6a,
+	0xf7, 0x17, 0x09, 0x32, 0xec, 0x2f, 0x14, 0x7d, 0x04, 0x5b, 0x6d, 0xb3, 0xa1, 0x9b, 0x56, 0xab,
+	0xdd, 0xd2, 0x97, 0x8e, 0xcf, 0x3a, 0x8c, 0x71, 0x54, 0x85, 0x75, 0xae, 0x3a, 0x6e, 0xb1, 0x5f,
+	0xbd, 0x51, 0x96, 0xd4, 0xe2, 0x64, 0xaa, 0x15, 0x2e, 0x80, 0xf8, 0xfc, 0x5c, 0x93, 0x28, 0xc4,
+	0xf9, 0x13, 0xfe, 0x01, 0x7c, 0xb0, 0xc0, 0x5b, 0xfb, 0xcd, 0x66, 0xfb, 0x4b, 0xab, 0x67, 0x3c,
+	0xd1, 0xdb, 0xc7, 0xbd, 0xf2, 0x8a, 0xfa, 0xfe, 0x64, 0xaa, 0x5d, 0xbf, 0x94, 0xe4, 0x5d, 0x1f,
+	0x74, 0x5f, 0x9f, 0x55, 0xa4, 0x37, 0x67, 0x15, 0xe9, 0xcf, 0xb3, 0x8a, 0xf4, 0xe3, 0x79, 0x25,
+	0xf5, 0xe6, 0xbc, 0x92, 0x7a, 0x7b, 0x5e, 0x49, 0x3d, 0xfd, 0x62, 0xe0, 0xd1, 0x93, 0x71, 0xbf,
+	0xe6, 0x90, 0x51, 0xdd, 0x21, 0xd1, 0x88, 0x44, 0x75, 0xaf, 0xef, 0xdc, 0x1e, 0x90, 0xfa, 0xe9,
+	0xfd, 0x3a, 0x7f, 0x72, 0x22, 0xfe, 0xd9, 0x77, 0xe7, 0xde, 0xed, 0xe4, 0x3b, 0x92, 0xbe, 0x08,
+	0x70, 0xd4, 0xcf, 0xb2, 0xef, 0xbe, 0xcf, 0xfe, 0x1e, 0x00, 0x2e, 0x97, 0x7e, 0xf2, 0x68, 0x0a,
+	0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -988,6 +1139,63 @@ func (m *PacketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PacketAcknowledgementAge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketAcknowledgementAge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketAcknowledgementAge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChannel(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PacketId) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1096,6 +1304,51 @@ func (m *Acknowledgement_Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	dAtA[i] = 0xb2
 	return len(dAtA) - i, nil
 }
+func (m *ErrorAcknowledgement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorAcknowledgement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorAcknowledgement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Redacted {
+		i--
+		if m.Redacted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Code != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Timeout) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1154,6 +1407,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EnablePanicIsolation {
+		i--
+		if m.EnablePanicIsolation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxPacketDataSize != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.MaxPacketDataSize))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.UpgradeTimeout.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1324,6 +1592,31 @@ func (m *PacketState) Size() (n int) {
 	return n
 }
 
+func (m *PacketAcknowledgementAge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovChannel(uint64(m.Sequence))
+	}
+	l = m.Height.Size()
+	n += 1 + l + sovChannel(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovChannel(uint64(m.Timestamp))
+	}
+	return n
+}
+
 func (m *PacketId) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1378,6 +1671,25 @@ func (m *Acknowledgement_Error) Size() (n int) {
 	n += 2 + l + sovChannel(uint64(l))
 	return n
 }
+func (m *ErrorAcknowledgement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Code != 0 {
+		n += 1 + sovChannel(uint64(m.Code))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.Redacted {
+		n += 2
+	}
+	return n
+}
+
 func (m *Timeout) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1400,6 +1712,12 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.UpgradeTimeout.Size()
 	n += 1 + l + sovChannel(uint64(l))
+	if m.MaxPacketDataSize != 0 {
+		n += 1 + sovChannel(uint64(m.MaxPacketDataSize))
+	}
+	if m.EnablePanicIsolation {
+		n += 2
+	}
 	return n
 }
 
@@ -2445,7 +2763,7 @@ func (m *PacketState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PacketId) Unmarshal(dAtA []byte) error {
+func (m *PacketAcknowledgementAge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2468,10 +2786,10 @@ func (m *PacketId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PacketId: wiretype end group for non-group")
+			return fmt.Errorf("proto: PacketAcknowledgementAge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PacketId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PacketAcknowledgementAge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2557,31 +2875,216 @@ func (m *PacketId) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipChannel(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthChannel
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -2693,6 +3196,127 @@ func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ErrorAcknowledgement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorAcknowledgement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorAcknowledgement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redacted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Redacted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Timeout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2857,6 +3481,45 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPacketDataSize", wireType)
+			}
+			m.MaxPacketDataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPacketDataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnablePanicIsolation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnablePanicIsolation = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
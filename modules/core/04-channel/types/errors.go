@@ -60,4 +60,11 @@ var (
 	ErrTimeoutElapsed                  = errorsmod.Register(SubModuleName, 40, "timeout elapsed")
 	ErrPruningSequenceStartNotFound    = errorsmod.Register(SubModuleName, 41, "pruning sequence start not found")
 	ErrRecvStartSequenceNotFound       = errorsmod.Register(SubModuleName, 42, "recv start sequence not found")
+	ErrChannelSendPaused               = errorsmod.Register(SubModuleName, 43, "channel send paused")
+	ErrPacketDataTooLarge              = errorsmod.Register(SubModuleName, 44, "packet data too large")
+	ErrPanicInApplication              = errorsmod.Register(SubModuleName, 45, "panic_in_application")
+
+	// ErrTimeoutReceiptWritten is returned by RecvPacket when a timed out packet of an ORDERED_ALLOW_TIMEOUT channel
+	// is skipped and a timeout receipt is written in place of executing the packet
+	ErrTimeoutReceiptWritten = errorsmod.Register(SubModuleName, 46, "timeout receipt written for timed out packet")
 )
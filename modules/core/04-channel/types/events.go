@@ -22,6 +22,8 @@ const (
 	AttributeKeyUpgradeSequence         = "upgrade_sequence"
 	AttributeKeyErrorReceipt            = "error_receipt"
 
+	AttributeKeySendPaused = "send_paused"
+
 	AttributeCounterpartyPortID    = "counterparty_port_id"
 	AttributeCounterpartyChannelID = "counterparty_channel_id"
 
@@ -31,6 +33,9 @@ const (
 	EventTypeAcknowledgePacket = "acknowledge_packet"
 	EventTypeTimeoutPacket     = "timeout_packet"
 
+	EventTypePacketAlreadyReceived = "packet_already_received"
+	EventTypePanicInApplication    = "panic_in_application"
+
 	AttributeKeyDataHex          = "packet_data_hex"
 	AttributeKeyAckHex           = "packet_ack_hex"
 	AttributeKeyTimeoutHeight    = "packet_timeout_height"
@@ -62,6 +67,7 @@ var (
 	EventTypeChannelUpgradeCancel  = "channel_upgrade_cancelled"
 	EventTypeChannelUpgradeError   = "channel_upgrade_error"
 	EventTypeChannelFlushComplete  = "channel_flush_complete"
+	EventTypeChannelSendPaused     = "channel_send_paused"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
@@ -30,6 +30,15 @@ type ConnectionKeeper interface {
 		channelID string,
 		channel Channel,
 	) error
+	VerifyChannelStateForTimeout(
+		ctx sdk.Context,
+		connection connectiontypes.ConnectionEnd,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		channel Channel,
+	) error
 	VerifyPacketCommitment(
 		ctx sdk.Context,
 		connection connectiontypes.ConnectionEnd,
@@ -59,6 +68,15 @@ type ConnectionKeeper interface {
 		channelID string,
 		sequence uint64,
 	) error
+	VerifyPacketTimeoutReceipt(
+		ctx sdk.Context,
+		connection connectiontypes.ConnectionEnd,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+	) error
 	VerifyNextSequenceRecv(
 		ctx sdk.Context,
 		connection connectiontypes.ConnectionEnd,
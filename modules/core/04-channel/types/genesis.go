@@ -71,6 +71,7 @@ func DefaultGenesisState() GenesisState {
 		AckSequences:        []PacketSequence{},
 		NextChannelSequence: 0,
 		Params:              DefaultParams(),
+		AcknowledgementAges: []PacketAcknowledgementAge{},
 	}
 }
 
@@ -100,6 +101,7 @@ func (gs GenesisState) Validate() error {
 		return fmt.Errorf("next channel sequence %d must be greater than maximum sequence used in channel identifier %d", gs.NextChannelSequence, maxSequence)
 	}
 
+	acks := make(map[string]bool, len(gs.Acknowledgements))
 	for i, ack := range gs.Acknowledgements {
 		if err := ack.Validate(); err != nil {
 			return fmt.Errorf("invalid acknowledgement %v ack index %d: %w", ack, i, err)
@@ -107,6 +109,16 @@ func (gs GenesisState) Validate() error {
 		if len(ack.Data) == 0 {
 			return fmt.Errorf("invalid acknowledgement %v ack index %d: data bytes cannot be empty", ack, i)
 		}
+		acks[string(host.PacketAcknowledgementKey(ack.PortId, ack.ChannelId, ack.Sequence))] = true
+	}
+
+	for i, age := range gs.AcknowledgementAges {
+		if err := age.Validate(); err != nil {
+			return fmt.Errorf("invalid acknowledgement age %v index %d: %w", age, i, err)
+		}
+		if !acks[string(host.PacketAcknowledgementKey(age.PortId, age.ChannelId, age.Sequence))] {
+			return fmt.Errorf("invalid acknowledgement age %v index %d: acknowledgement not found", age, i)
+		}
 	}
 
 	for i, receipt := range gs.Receipts {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
 
@@ -93,6 +94,42 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid ack age",
+			genState: types.GenesisState{
+				Acknowledgements: []types.PacketState{
+					types.NewPacketState(testPort2, testChannel2, 1, []byte("ack")),
+				},
+				AcknowledgementAges: []types.PacketAcknowledgementAge{
+					types.NewPacketAcknowledgementAge(testPort2, testChannel2, 1, clienttypes.NewHeight(0, 10), 100),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid ack age",
+			genState: types.GenesisState{
+				Acknowledgements: []types.PacketState{
+					types.NewPacketState(testPort2, testChannel2, 1, []byte("ack")),
+				},
+				AcknowledgementAges: []types.PacketAcknowledgementAge{
+					types.NewPacketAcknowledgementAge(testPort2, testChannel2, 0, clienttypes.NewHeight(0, 10), 100),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "ack age without ack",
+			genState: types.GenesisState{
+				Acknowledgements: []types.PacketState{
+					types.NewPacketState(testPort2, testChannel2, 1, []byte("ack")),
+				},
+				AcknowledgementAges: []types.PacketAcknowledgementAge{
+					types.NewPacketAcknowledgementAge(testPort2, testChannel2, 2, clienttypes.NewHeight(0, 10), 100),
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid commitment",
 			genState: types.GenesisState{
@@ -33,6 +33,11 @@ const (
 	ParamsKey = "channelParams"
 )
 
+// TimeoutReceipt is the packet receipt written by the receiving chain for a packet of an
+// ORDERED_ALLOW_TIMEOUT channel which was not executed as it timed out. It is distinct
+// from the receipt of packets received on UNORDERED channels.
+var TimeoutReceipt = []byte{byte(2)}
+
 // FormatChannelIdentifier returns the channel identifier with the sequence appended.
 // This is a SDK specific format not enforced by IBC protocol.
 func FormatChannelIdentifier(sequence uint64) string {
@@ -68,3 +73,8 @@ func ParseChannelSequence(channelID string) (uint64, error) {
 func FilteredPortPrefix(portPrefix string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", host.KeyChannelEndPrefix, host.KeyPortPrefix, portPrefix))
 }
+
+// PortChannelsPrefix returns the key prefix under which the channel ends bound to the given port are stored
+func PortChannelsPrefix(portID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s/", host.KeyChannelEndPrefix, host.KeyPortPrefix, portID, host.KeyChannelPrefix))
+}
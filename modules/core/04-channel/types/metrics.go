@@ -0,0 +1,21 @@
+package types
+
+// Prometheus metric labels.
+const (
+	LabelSourcePort         = "source_port"
+	LabelSourceChannel      = "source_channel"
+	LabelDestinationPort    = "destination_port"
+	LabelDestinationChannel = "destination_channel"
+)
+
+// Prometheus metric keys.
+var (
+	MetricKeyPacketSend         = []string{"ibc", "packet", "send"}
+	MetricKeyPacketReceive      = []string{"ibc", "packet", "receive"}
+	MetricKeyPacketAcknowledge  = []string{"ibc", "packet", "acknowledge"}
+	MetricKeyPacketTimeout      = []string{"ibc", "packet", "timeout"}
+	MetricKeyPacketRelayLatency = []string{"ibc", "packet", "relay_latency_ms"}
+	MetricKeyChannelOpened      = []string{"ibc", "channel", "opened"}
+	MetricKeyChannelClosed      = []string{"ibc", "channel", "closed"}
+	MetricKeyOpenChannels       = []string{"ibc", "channel", "open"}
+)
@@ -132,3 +132,20 @@ func (p PacketId) Validate() error {
 func NewPacketID(portID, channelID string, seq uint64) PacketId {
 	return PacketId{PortId: portID, ChannelId: channelID, Sequence: seq}
 }
+
+// NewPacketAcknowledgementAge returns a new instance of PacketAcknowledgementAge
+func NewPacketAcknowledgementAge(portID, channelID string, seq uint64, height clienttypes.Height, timestamp uint64) PacketAcknowledgementAge {
+	return PacketAcknowledgementAge{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  seq,
+		Height:    height,
+		Timestamp: timestamp,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (pa PacketAcknowledgementAge) Validate() error {
+	return validateGenFields(pa.PortId, pa.ChannelId, pa.Sequence)
+}
@@ -35,3 +35,13 @@ func (p Params) Validate() error {
 	}
 	return nil
 }
+
+// ValidatePacketDataSize returns an error if the size of the provided packet data exceeds the maximum packet
+// data size. Packet data of any size is valid if the maximum packet data size is zero.
+func (p Params) ValidatePacketDataSize(data []byte) error {
+	if p.MaxPacketDataSize != 0 && uint64(len(data)) > p.MaxPacketDataSize {
+		return errorsmod.Wrapf(ErrPacketDataTooLarge, "packet data size %d bytes exceeds maximum of %d bytes", len(data), p.MaxPacketDataSize)
+	}
+
+	return nil
+}
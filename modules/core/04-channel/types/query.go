@@ -105,6 +105,24 @@ func NewQueryNextSequenceSendResponse(
 	}
 }
 
+// NewQueryChannelSequencesResponse creates a new QueryChannelSequencesResponse instance
+func NewQueryChannelSequencesResponse(
+	state State, ordering Order, sequenceSend, sequenceRecv, sequenceAck uint64,
+	proofSend, proofRecv, proofAck []byte, height clienttypes.Height,
+) *QueryChannelSequencesResponse {
+	return &QueryChannelSequencesResponse{
+		State:               state,
+		Ordering:            ordering,
+		NextSequenceSend:    sequenceSend,
+		NextSequenceReceive: sequenceRecv,
+		NextSequenceAck:     sequenceAck,
+		ProofSend:           proofSend,
+		ProofReceive:        proofRecv,
+		ProofAck:            proofAck,
+		ProofHeight:         height,
+	}
+}
+
 // NewQueryUpgradeErrorResponse creates a new QueryUpgradeErrorResponse instance
 func NewQueryUpgradeErrorResponse(errorReceipt ErrorReceipt, proof []byte, height clienttypes.Height) *QueryUpgradeErrorResponse {
 	return &QueryUpgradeErrorResponse{
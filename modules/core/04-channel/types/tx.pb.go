@@ -1630,6 +1630,88 @@ func (m *MsgPruneAcknowledgementsResponse) GetTotalRemainingSequences() uint64 {
 	return 0
 }
 
+// MsgSetChannelSendPaused defines the request type for the SetChannelSendPaused rpc.
+// Pausing sends on a channel rejects new packets while packets already in flight may still be
+// received, acknowledged or timed out.
+type MsgSetChannelSendPaused struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// paused defines whether sending packets on the channel is paused
+	Paused bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	// signer address, which must be the authority of the ibc module (defaults to x/gov unless overwritten)
+	Signer string `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *MsgSetChannelSendPaused) Reset()         { *m = MsgSetChannelSendPaused{} }
+func (m *MsgSetChannelSendPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChannelSendPaused) ProtoMessage()    {}
+func (*MsgSetChannelSendPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{38}
+}
+func (m *MsgSetChannelSendPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChannelSendPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChannelSendPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetChannelSendPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChannelSendPaused.Merge(m, src)
+}
+func (m *MsgSetChannelSendPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChannelSendPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChannelSendPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetChannelSendPaused proto.InternalMessageInfo
+
+// MsgSetChannelSendPausedResponse defines the response type for the SetChannelSendPaused rpc.
+type MsgSetChannelSendPausedResponse struct {
+}
+
+func (m *MsgSetChannelSendPausedResponse) Reset()         { *m = MsgSetChannelSendPausedResponse{} }
+func (m *MsgSetChannelSendPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChannelSendPausedResponse) ProtoMessage()    {}
+func (*MsgSetChannelSendPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4637e0ac3fc7b7, []int{39}
+}
+func (m *MsgSetChannelSendPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChannelSendPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChannelSendPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetChannelSendPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChannelSendPausedResponse.Merge(m, src)
+}
+func (m *MsgSetChannelSendPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChannelSendPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChannelSendPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetChannelSendPausedResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.ResponseResultType", ResponseResultType_name, ResponseResultType_value)
 	proto.RegisterType((*MsgChannelOpenInit)(nil), "ibc.core.channel.v1.MsgChannelOpenInit")
@@ -1670,136 +1752,140 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ibc.core.channel.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgPruneAcknowledgements)(nil), "ibc.core.channel.v1.MsgPruneAcknowledgements")
 	proto.RegisterType((*MsgPruneAcknowledgementsResponse)(nil), "ibc.core.channel.v1.MsgPruneAcknowledgementsResponse")
+	proto.RegisterType((*MsgSetChannelSendPaused)(nil), "ibc.core.channel.v1.MsgSetChannelSendPaused")
+	proto.RegisterType((*MsgSetChannelSendPausedResponse)(nil), "ibc.core.channel.v1.MsgSetChannelSendPausedResponse")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/tx.proto", fileDescriptor_bc4637e0ac3fc7b7) }
 
 var fileDescriptor_bc4637e0ac3fc7b7 = []byte{
-	// 1975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcf, 0x6f, 0xdb, 0xc8,
-	0x15, 0x36, 0xf5, 0x33, 0x7e, 0x4e, 0xd6, 0x0a, 0xe5, 0xc4, 0x32, 0x6d, 0x4b, 0x8a, 0x5a, 0x6c,
-	0xbc, 0x6e, 0x22, 0xad, 0xbd, 0x49, 0xd1, 0x0d, 0x16, 0x68, 0x1d, 0x55, 0xe9, 0x1a, 0x88, 0x63,
-	0x83, 0xb2, 0x8a, 0x76, 0xb7, 0xa8, 0x20, 0x53, 0x13, 0x8a, 0x90, 0x44, 0x72, 0x49, 0x4a, 0xbb,
-	0x2e, 0xd0, 0x62, 0xd1, 0x53, 0x90, 0xc3, 0xa2, 0x05, 0xf6, 0x1a, 0xa0, 0x45, 0xff, 0x81, 0x3d,
-	0xf7, 0xc7, 0xa1, 0xb7, 0x3d, 0x15, 0x7b, 0x5c, 0x14, 0xe8, 0xa2, 0x48, 0x0e, 0xb9, 0xf4, 0x2f,
-	0x28, 0x50, 0xa0, 0xe0, 0xcc, 0x90, 0xa2, 0xc8, 0xa1, 0x44, 0x59, 0xaa, 0xd1, 0x9b, 0x38, 0xf3,
-	0xcd, 0x7b, 0x6f, 0xbe, 0xef, 0xcd, 0xe3, 0xcc, 0x50, 0xb0, 0xa5, 0x9c, 0x49, 0x15, 0x49, 0x33,
-	0x50, 0x45, 0xea, 0xb4, 0x54, 0x15, 0xf5, 0x2a, 0xc3, 0xbd, 0x8a, 0xf5, 0x49, 0x59, 0x37, 0x34,
-	0x4b, 0xe3, 0xb3, 0xca, 0x99, 0x54, 0xb6, 0x7b, 0xcb, 0xb4, 0xb7, 0x3c, 0xdc, 0x13, 0xd6, 0x64,
-	0x4d, 0xd6, 0x70, 0x7f, 0xc5, 0xfe, 0x45, 0xa0, 0xc2, 0xba, 0xa4, 0x99, 0x7d, 0xcd, 0xac, 0xf4,
-	0x4d, 0xd9, 0x36, 0xd1, 0x37, 0x65, 0xda, 0x51, 0x18, 0x79, 0xe8, 0x29, 0x48, 0xb5, 0xec, 0x5e,
-	0xf2, 0x8b, 0x02, 0x6e, 0xb1, 0x42, 0x70, 0xfc, 0x4d, 0x80, 0x0c, 0x74, 0xd9, 0x68, 0xb5, 0x11,
-	0x81, 0x94, 0x3e, 0xe7, 0x80, 0x3f, 0x32, 0xe5, 0x2a, 0xe9, 0x3f, 0xd6, 0x91, 0x7a, 0xa8, 0x2a,
-	0x16, 0xbf, 0x0e, 0x69, 0x5d, 0x33, 0xac, 0xa6, 0xd2, 0xce, 0x71, 0x45, 0x6e, 0x67, 0x59, 0x4c,
-	0xd9, 0x8f, 0x87, 0x6d, 0xfe, 0x3d, 0x48, 0x53, 0x5b, 0xb9, 0x58, 0x91, 0xdb, 0x59, 0xd9, 0xdf,
-	0x2a, 0x33, 0x26, 0x5b, 0xa6, 0xf6, 0x1e, 0x26, 0xbe, 0xfc, 0xa6, 0xb0, 0x24, 0x3a, 0x43, 0xf8,
-	0x9b, 0x90, 0x32, 0x15, 0x59, 0x45, 0x46, 0x2e, 0x4e, 0xac, 0x92, 0xa7, 0x07, 0xab, 0xcf, 0x7e,
-	0x57, 0x58, 0xfa, 0xf5, 0xeb, 0x2f, 0x76, 0x69, 0x43, 0xe9, 0x43, 0x10, 0x82, 0x51, 0x89, 0xc8,
-	0xd4, 0x35, 0xd5, 0x44, 0xfc, 0x36, 0x00, 0xb5, 0x38, 0x0a, 0x70, 0x99, 0xb6, 0x1c, 0xb6, 0xf9,
-	0x1c, 0xa4, 0x87, 0xc8, 0x30, 0x15, 0x4d, 0xc5, 0x31, 0x2e, 0x8b, 0xce, 0xe3, 0x83, 0x84, 0xed,
-	0xa7, 0xf4, 0x4d, 0x0c, 0xae, 0x8f, 0x5b, 0x3f, 0x35, 0xce, 0xc3, 0xa7, 0xbc, 0x0f, 0x59, 0xdd,
-	0x40, 0x43, 0x45, 0x1b, 0x98, 0x4d, 0x8f, 0x5b, 0x6c, 0xfa, 0x61, 0x2c, 0xc7, 0x89, 0xd7, 0x9d,
-	0xee, 0xaa, 0x1b, 0x82, 0x87, 0xa6, 0xf8, 0xec, 0x34, 0xed, 0xc1, 0x9a, 0xa4, 0x0d, 0x54, 0x0b,
-	0x19, 0x7a, 0xcb, 0xb0, 0xce, 0x9b, 0xce, 0x6c, 0x12, 0x38, 0xae, 0xac, 0xb7, 0xef, 0xc7, 0xa4,
-	0xcb, 0xa6, 0x44, 0x37, 0x34, 0xed, 0x69, 0x53, 0x51, 0x15, 0x2b, 0x97, 0x2c, 0x72, 0x3b, 0x57,
-	0xc5, 0x65, 0xdc, 0x82, 0xf5, 0xac, 0xc2, 0x55, 0xd2, 0xdd, 0x41, 0x8a, 0xdc, 0xb1, 0x72, 0x29,
-	0x1c, 0x94, 0xe0, 0x09, 0x8a, 0xa4, 0xd6, 0x70, 0xaf, 0xfc, 0x3e, 0x46, 0xd0, 0x90, 0x56, 0xf0,
-	0x28, 0xd2, 0xe4, 0x51, 0x2f, 0x3d, 0x59, 0xbd, 0x0f, 0x60, 0x23, 0xc0, 0xaf, 0x2b, 0x9e, 0x47,
-	0x1d, 0x6e, 0x4c, 0x1d, 0x9f, 0xac, 0x31, 0x9f, 0xac, 0x54, 0xbc, 0xbf, 0x06, 0xc4, 0x3b, 0x90,
-	0xba, 0xe1, 0xe2, 0x4d, 0xb6, 0xc9, 0x7f, 0x17, 0xd6, 0xc7, 0x98, 0xf6, 0x60, 0x49, 0x86, 0xde,
-	0xf0, 0x76, 0x8f, 0xf4, 0xbd, 0x80, 0x42, 0x9b, 0x40, 0xf4, 0x68, 0x5a, 0xc6, 0x39, 0x15, 0xe8,
-	0x0a, 0x6e, 0xb0, 0x93, 0xef, 0x72, 0xf5, 0xd9, 0xf4, 0xeb, 0x73, 0x20, 0x75, 0x1d, 0x7d, 0x4a,
-	0x7f, 0xe7, 0xe0, 0xc6, 0x78, 0x6f, 0x55, 0x53, 0x9f, 0x2a, 0x46, 0xff, 0xc2, 0x24, 0xbb, 0x33,
-	0x6f, 0x49, 0x5d, 0x4c, 0xab, 0x33, 0x73, 0x5b, 0x39, 0xff, 0xcc, 0x13, 0xf3, 0xcd, 0x3c, 0x39,
-	0x79, 0xe6, 0x05, 0xd8, 0x66, 0xce, 0xcd, 0x9d, 0xfd, 0x10, 0xb2, 0x23, 0x40, 0xb5, 0xa7, 0x99,
-	0x68, 0x72, 0x3d, 0x9c, 0x32, 0xf5, 0xc8, 0x05, 0x6f, 0x1b, 0x36, 0x19, 0x7e, 0xdd, 0xb0, 0x7e,
-	0x1f, 0x83, 0x9b, 0xbe, 0xfe, 0x79, 0x55, 0x19, 0xaf, 0x18, 0xf1, 0x69, 0x15, 0x63, 0x91, 0xba,
-	0xf0, 0x0f, 0x61, 0x7b, 0x6c, 0xf9, 0xd0, 0x77, 0x52, 0xd3, 0x44, 0x1f, 0x0d, 0x90, 0x2a, 0x21,
-	0x9c, 0xff, 0x09, 0x71, 0xd3, 0x0b, 0x6a, 0x10, 0x4c, 0x9d, 0x42, 0x82, 0x14, 0x16, 0x21, 0xcf,
-	0xa6, 0xc8, 0x65, 0xf1, 0x15, 0x07, 0xd7, 0x8e, 0x4c, 0x59, 0x44, 0xd2, 0xf0, 0xa4, 0x25, 0x75,
-	0x91, 0xc5, 0xbf, 0x0b, 0x29, 0x1d, 0xff, 0xc2, 0xdc, 0xad, 0xec, 0x6f, 0x32, 0xcb, 0x34, 0x01,
-	0xd3, 0x09, 0xd2, 0x01, 0xfc, 0x5b, 0x90, 0x21, 0x04, 0x49, 0x5a, 0xbf, 0xaf, 0x58, 0x7d, 0xa4,
-	0x5a, 0x98, 0xe4, 0xab, 0xe2, 0x2a, 0x6e, 0xaf, 0xba, 0xcd, 0x01, 0x2e, 0xe3, 0xf3, 0x71, 0x99,
-	0x98, 0x9c, 0x4a, 0x3f, 0xc7, 0xeb, 0x77, 0x34, 0x49, 0xb7, 0xf2, 0x7e, 0x1f, 0x52, 0x06, 0x32,
-	0x07, 0x3d, 0x32, 0xd9, 0x37, 0xf6, 0x6f, 0x33, 0x27, 0xeb, 0xc0, 0x45, 0x0c, 0x3d, 0x3d, 0xd7,
-	0x91, 0x48, 0x87, 0xd1, 0x0a, 0xfc, 0x59, 0x0c, 0xe0, 0xc8, 0x94, 0x4f, 0x95, 0x3e, 0xd2, 0x06,
-	0x8b, 0xa1, 0x70, 0xa0, 0x1a, 0x48, 0x42, 0xca, 0x10, 0xb5, 0xc7, 0x28, 0x6c, 0xb8, 0xcd, 0x8b,
-	0xa1, 0xf0, 0x0e, 0xf0, 0x2a, 0xfa, 0xc4, 0x72, 0xd3, 0xac, 0x69, 0x20, 0x69, 0x88, 0xe9, 0x4c,
-	0x88, 0x19, 0xbb, 0xc7, 0x49, 0x2e, 0x9b, 0xbc, 0xe8, 0x45, 0xe5, 0x43, 0xbc, 0x85, 0xa2, 0x7c,
-	0x2c, 0x9a, 0xed, 0x7f, 0x93, 0xf7, 0x1d, 0xb5, 0x7e, 0xac, 0xe2, 0xc4, 0xbe, 0x24, 0xd2, 0x0b,
-	0xb0, 0x42, 0x53, 0xdc, 0x76, 0x4a, 0x6b, 0x04, 0xa9, 0x1a, 0x24, 0x8c, 0x85, 0x14, 0x09, 0xb6,
-	0x2a, 0xc9, 0xa9, 0xaa, 0xa4, 0x66, 0x2b, 0x29, 0xe9, 0x0b, 0x94, 0x94, 0x33, 0xfc, 0xa2, 0x1c,
-	0xe7, 0x7e, 0xd1, 0x02, 0x3f, 0x8b, 0xe1, 0xf4, 0x39, 0x90, 0xba, 0xaa, 0xf6, 0x71, 0x0f, 0xb5,
-	0x65, 0x84, 0x6b, 0xc6, 0x1c, 0x0a, 0xef, 0xc0, 0x6a, 0x6b, 0xdc, 0x9a, 0x23, 0xb0, 0xaf, 0x79,
-	0x24, 0xb0, 0x3d, 0xb0, 0x3d, 0x26, 0xf0, 0x81, 0xdd, 0x72, 0xc9, 0x6f, 0x67, 0x09, 0xef, 0xfa,
-	0x7d, 0x4c, 0x2c, 0x9a, 0xef, 0x3f, 0x8e, 0xed, 0x6f, 0x68, 0x0a, 0xcc, 0xf5, 0x92, 0xff, 0x01,
-	0xa4, 0x9e, 0x2a, 0xa8, 0xd7, 0x36, 0x69, 0x55, 0x2a, 0x31, 0x03, 0xa3, 0x9e, 0x1e, 0x61, 0xa4,
-	0xa3, 0x18, 0x19, 0x17, 0xbd, 0xb6, 0x7f, 0xc6, 0x79, 0x37, 0x30, 0x9e, 0xe0, 0x5d, 0x96, 0xde,
-	0x83, 0x34, 0x4d, 0x7d, 0x9a, 0x38, 0x5b, 0x93, 0xa2, 0x71, 0x4e, 0x1e, 0x74, 0x88, 0x5d, 0x1c,
-	0x02, 0x0b, 0x27, 0x86, 0x17, 0xce, 0xea, 0xc0, 0xb7, 0x58, 0x08, 0x9b, 0xff, 0x89, 0xc3, 0x5a,
-	0x20, 0xa0, 0x89, 0xc7, 0xa9, 0x29, 0x64, 0xfe, 0x08, 0x8a, 0xba, 0xa1, 0xe9, 0x9a, 0x89, 0xda,
-	0xee, 0x1a, 0x96, 0x34, 0x55, 0x45, 0x92, 0xa5, 0x68, 0x6a, 0xb3, 0xa3, 0xe9, 0x36, 0xcd, 0xf1,
-	0x9d, 0x65, 0x71, 0xdb, 0xc1, 0x51, 0xaf, 0x55, 0x17, 0xf5, 0xbe, 0xa6, 0x9b, 0x7c, 0x07, 0x36,
-	0x99, 0x05, 0x81, 0x4a, 0x95, 0x98, 0x51, 0xaa, 0x0d, 0x46, 0xe1, 0x20, 0x80, 0xe9, 0xa5, 0x27,
-	0x39, 0xb5, 0xf4, 0xf0, 0xdf, 0x82, 0x6b, 0xb4, 0xd4, 0xd2, 0x63, 0x63, 0x0a, 0xaf, 0x45, 0xb2,
-	0xfa, 0x28, 0xbb, 0x23, 0x90, 0xa3, 0x70, 0xda, 0x03, 0xa2, 0x16, 0x03, 0x4b, 0xf6, 0xca, 0x7c,
-	0x4b, 0x76, 0x79, 0x72, 0x42, 0xfe, 0x8d, 0x83, 0x2d, 0x96, 0xfe, 0x97, 0x9e, 0x8f, 0x9e, 0xf2,
-	0x10, 0x9f, 0xa7, 0x3c, 0xfc, 0x23, 0xc6, 0x48, 0xe8, 0x79, 0x8e, 0x98, 0x0d, 0xdf, 0x51, 0xd1,
-	0x61, 0x23, 0x1e, 0x99, 0x8d, 0x2c, 0x23, 0x71, 0x82, 0x09, 0x93, 0x88, 0x92, 0x30, 0xc9, 0x08,
-	0x09, 0xf3, 0xbf, 0x3d, 0x7b, 0x22, 0x46, 0xbe, 0x78, 0x8e, 0x9f, 0x8b, 0xaa, 0xf2, 0x7f, 0x8a,
-	0x43, 0x2e, 0xe0, 0x67, 0xde, 0x23, 0xd3, 0x4f, 0x40, 0x60, 0xde, 0x16, 0x98, 0x56, 0xcb, 0x42,
-	0x34, 0xed, 0x04, 0x66, 0xbc, 0x75, 0x1b, 0x21, 0xe6, 0x18, 0x97, 0x09, 0xb8, 0x27, 0x34, 0x49,
-	0x12, 0x0b, 0x4e, 0x92, 0x64, 0x94, 0x24, 0x49, 0x45, 0x48, 0x92, 0xf4, 0x7c, 0x49, 0x72, 0x65,
-	0x72, 0x92, 0x28, 0x50, 0x0c, 0x13, 0x6f, 0xd1, 0x89, 0xf2, 0x69, 0x9c, 0xb1, 0x1d, 0x38, 0xd6,
-	0x91, 0xfa, 0x7f, 0x98, 0x25, 0x53, 0x5f, 0x34, 0x89, 0x0b, 0xbc, 0x68, 0x58, 0x29, 0x71, 0xb9,
-	0x25, 0xa1, 0xc0, 0xd8, 0xd3, 0xd8, 0x0a, 0xb8, 0xe7, 0xf6, 0x3f, 0xc7, 0x18, 0x8b, 0xd9, 0x39,
-	0x7f, 0x2e, 0xaa, 0x2e, 0xcf, 0x7e, 0x5f, 0x9b, 0x65, 0x08, 0x15, 0xad, 0x2e, 0xfb, 0xf9, 0x4d,
-	0xce, 0xc7, 0x6f, 0x6a, 0x32, 0xbf, 0x25, 0xc6, 0x6a, 0xf2, 0x9d, 0x56, 0x4b, 0x7f, 0x89, 0xc1,
-	0x7a, 0x70, 0xc9, 0xb5, 0x54, 0x09, 0xf5, 0x2e, 0xcc, 0xf0, 0x63, 0xb8, 0x86, 0x0c, 0x43, 0x33,
-	0x9a, 0xf8, 0x40, 0xa9, 0x3b, 0x87, 0xf6, 0x5b, 0x4c, 0x6a, 0x6b, 0x36, 0x52, 0x24, 0x40, 0x3a,
-	0xdb, 0xab, 0xc8, 0xd3, 0xc6, 0x97, 0x21, 0x4b, 0x38, 0x1b, 0xb7, 0x49, 0xe8, 0xbd, 0x8e, 0xbb,
-	0xbc, 0x36, 0x2e, 0x99, 0xe3, 0x5b, 0x50, 0x08, 0xa1, 0xcf, 0xa5, 0xf8, 0x57, 0xb0, 0x7a, 0x64,
-	0xca, 0x0d, 0xbd, 0xdd, 0xb2, 0xd0, 0x49, 0xcb, 0x68, 0xf5, 0x4d, 0x7e, 0x0b, 0x96, 0x5b, 0x03,
-	0xab, 0xa3, 0x19, 0x8a, 0x75, 0xee, 0x7c, 0xc7, 0x70, 0x1b, 0xc8, 0x11, 0xd0, 0xc6, 0xd1, 0x4f,
-	0x2d, 0x61, 0x47, 0x40, 0x1b, 0x32, 0x3a, 0x02, 0xda, 0x4f, 0x0f, 0x78, 0x27, 0xbe, 0x91, 0xb9,
-	0xd2, 0x06, 0x56, 0xd8, 0xeb, 0xdf, 0x0d, 0xed, 0xb7, 0x1c, 0x5e, 0x60, 0x27, 0xc6, 0x40, 0x45,
-	0xbe, 0xe3, 0x97, 0x79, 0x61, 0xf9, 0xd7, 0x20, 0xd9, 0x53, 0xfa, 0xf4, 0x6e, 0x31, 0x21, 0x92,
-	0x87, 0xe8, 0x47, 0x9d, 0xcf, 0x39, 0x9c, 0xb6, 0xcc, 0x98, 0xdc, 0x97, 0xc0, 0x3d, 0xb8, 0x69,
-	0x69, 0x56, 0xab, 0xd7, 0xd4, 0x6d, 0x58, 0xdb, 0xad, 0x84, 0x26, 0x0e, 0x35, 0x21, 0xae, 0xe1,
-	0x5e, 0x6c, 0xa3, 0xed, 0x94, 0x40, 0x93, 0x7f, 0x00, 0x1b, 0x64, 0x94, 0x81, 0xfa, 0x2d, 0x45,
-	0x55, 0x54, 0xd9, 0x33, 0x90, 0x6c, 0x2f, 0xd7, 0x31, 0x40, 0x74, 0xfa, 0xdd, 0xb1, 0xbb, 0x5f,
-	0x73, 0xc0, 0x07, 0x5f, 0x2a, 0xfc, 0x7d, 0x28, 0x8a, 0xb5, 0xfa, 0xc9, 0xf1, 0x93, 0x7a, 0xad,
-	0x29, 0xd6, 0xea, 0x8d, 0xc7, 0xa7, 0xcd, 0xd3, 0x9f, 0x9e, 0xd4, 0x9a, 0x8d, 0x27, 0xf5, 0x93,
-	0x5a, 0xf5, 0xf0, 0xd1, 0x61, 0xed, 0x87, 0x99, 0x25, 0x61, 0xf5, 0xf9, 0x8b, 0xe2, 0x8a, 0xa7,
-	0x89, 0xbf, 0x0d, 0x1b, 0xcc, 0x61, 0x4f, 0x8e, 0x8f, 0x4f, 0x32, 0x9c, 0x70, 0xe5, 0xf9, 0x8b,
-	0x62, 0xc2, 0xfe, 0xcd, 0xdf, 0x85, 0x2d, 0x26, 0xb0, 0xde, 0xa8, 0x56, 0x6b, 0xf5, 0x7a, 0x26,
-	0x26, 0xac, 0x3c, 0x7f, 0x51, 0x4c, 0xd3, 0xc7, 0x50, 0xf8, 0xa3, 0x83, 0xc3, 0xc7, 0x0d, 0xb1,
-	0x96, 0x89, 0x13, 0x38, 0x7d, 0x14, 0x12, 0xcf, 0xfe, 0x90, 0x5f, 0xda, 0xff, 0x57, 0x06, 0xe2,
-	0x47, 0xa6, 0xcc, 0x77, 0x61, 0xd5, 0xff, 0x3d, 0x90, 0xfd, 0x72, 0x0d, 0x7e, 0xa2, 0x13, 0x2a,
-	0x11, 0x81, 0xae, 0x82, 0x1d, 0x78, 0xc3, 0xf7, 0x21, 0xee, 0xcd, 0x08, 0x26, 0x4e, 0x8d, 0x73,
-	0xa1, 0x1c, 0x0d, 0x17, 0xe2, 0xc9, 0xde, 0xd2, 0x47, 0xf1, 0x74, 0x20, 0x75, 0x23, 0x79, 0xf2,
-	0xee, 0x61, 0x2d, 0xe0, 0x19, 0x9f, 0x4f, 0x76, 0x23, 0x58, 0xa1, 0x58, 0x61, 0x3f, 0x3a, 0xd6,
-	0xf5, 0xaa, 0x42, 0x26, 0xf0, 0xdd, 0x62, 0x67, 0x8a, 0x1d, 0x17, 0x29, 0xbc, 0x1d, 0x15, 0xe9,
-	0xfa, 0xfb, 0x18, 0xb2, 0xac, 0xef, 0x11, 0xdf, 0x89, 0x62, 0xc8, 0x99, 0xe7, 0x3b, 0x33, 0x80,
-	0x5d, 0xc7, 0x3f, 0x03, 0xf0, 0x5c, 0xe1, 0x97, 0xc2, 0x4c, 0x8c, 0x30, 0xc2, 0xee, 0x74, 0x8c,
-	0x6b, 0xbd, 0x0e, 0x69, 0x67, 0x6b, 0x51, 0x08, 0x1b, 0x46, 0x01, 0xc2, 0xed, 0x29, 0x00, 0x6f,
-	0xee, 0xf9, 0x6e, 0x70, 0xdf, 0x9c, 0x32, 0x94, 0xe2, 0xc2, 0x73, 0x2f, 0xe4, 0x56, 0xb2, 0x0b,
-	0xab, 0xfe, 0xab, 0xc4, 0xd0, 0x28, 0x7d, 0xc0, 0xf0, 0xc5, 0x1b, 0x76, 0x25, 0x37, 0x4a, 0x74,
-	0xef, 0x3d, 0xda, 0xb4, 0x44, 0xf7, 0x60, 0xa7, 0x26, 0x3a, 0xeb, 0x8a, 0xeb, 0x23, 0xb8, 0x1e,
-	0xbc, 0x6f, 0x7a, 0x2b, 0x9a, 0x21, 0xbb, 0x70, 0xec, 0x45, 0x86, 0x86, 0xbb, 0xb4, 0xcb, 0x47,
-	0x44, 0x97, 0x76, 0x05, 0xd9, 0x8b, 0x0c, 0x75, 0x5d, 0xfe, 0x12, 0x6e, 0xb0, 0x4f, 0xaf, 0x77,
-	0xa3, 0xd9, 0x72, 0x96, 0xd8, 0xfd, 0x99, 0xe0, 0xe1, 0xd2, 0xe2, 0x33, 0x51, 0x44, 0x69, 0x6d,
-	0x6c, 0x54, 0x69, 0xbd, 0x3b, 0xfd, 0xe0, 0xa4, 0x9d, 0xa5, 0x18, 0x71, 0xd2, 0xce, 0xc2, 0xbc,
-	0x3f, 0x13, 0xdc, 0x75, 0xff, 0x0b, 0x58, 0x63, 0xee, 0x80, 0xef, 0x44, 0xe4, 0x10, 0xa3, 0x85,
-	0x7b, 0xb3, 0xa0, 0x5d, 0xdf, 0x0a, 0x64, 0xc9, 0xde, 0x8c, 0xa2, 0xe8, 0x16, 0xf1, 0xdb, 0x61,
-	0xc6, 0xbc, 0x1b, 0x39, 0xe1, 0x4e, 0x14, 0x94, 0x97, 0x65, 0xf6, 0x56, 0x2f, 0x94, 0x65, 0x26,
-	0x3c, 0x9c, 0xe5, 0x89, 0x9b, 0x36, 0x21, 0xf9, 0xe9, 0xeb, 0x2f, 0x76, 0xb9, 0x87, 0xf5, 0x2f,
-	0x5f, 0xe6, 0xb9, 0xaf, 0x5e, 0xe6, 0xb9, 0x7f, 0xbe, 0xcc, 0x73, 0xbf, 0x79, 0x95, 0x5f, 0xfa,
-	0xea, 0x55, 0x7e, 0xe9, 0xeb, 0x57, 0xf9, 0xa5, 0x0f, 0xde, 0x95, 0x15, 0xab, 0x33, 0x38, 0x2b,
-	0x4b, 0x5a, 0xbf, 0x42, 0xff, 0x1f, 0xa5, 0x9c, 0x49, 0x77, 0x65, 0xad, 0x32, 0xfc, 0x5e, 0xa5,
-	0xaf, 0xb5, 0x07, 0x3d, 0x64, 0x92, 0xff, 0x35, 0xbd, 0x7d, 0xef, 0xae, 0xf3, 0xd7, 0x26, 0xeb,
-	0x5c, 0x47, 0xe6, 0x59, 0x0a, 0xff, 0xad, 0xe9, 0x9d, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xf2,
-	0x6f, 0x97, 0x8a, 0xa1, 0x25, 0x00, 0x00,
+	// 2010 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0xf2, 0x53, 0x7a, 0xb2, 0x23, 0x69, 0x29, 0x5b, 0xd4, 0xea, 0x8b, 0x66, 0x8b, 0x58,
+	0x51, 0x6d, 0x32, 0x52, 0xec, 0xa2, 0x31, 0x02, 0xb4, 0x32, 0x4b, 0x37, 0x02, 0x2c, 0x4b, 0x58,
+	0x4a, 0x45, 0x9b, 0x14, 0x25, 0xa8, 0xe5, 0x98, 0x5a, 0x90, 0xdc, 0xdd, 0xec, 0x2e, 0x99, 0xa8,
+	0x40, 0x8b, 0xa0, 0x27, 0xc3, 0x87, 0xa0, 0x2d, 0x72, 0x35, 0xd0, 0xa2, 0xff, 0x40, 0xce, 0xfd,
+	0x38, 0xf4, 0x96, 0x53, 0x91, 0x63, 0x50, 0xa0, 0x41, 0x61, 0x1f, 0xd2, 0xbf, 0xa1, 0x40, 0x81,
+	0x62, 0x67, 0x66, 0x87, 0x4b, 0xee, 0x2c, 0x39, 0x14, 0x59, 0x21, 0x37, 0xee, 0xcc, 0x6f, 0xde,
+	0xc7, 0xef, 0xbd, 0x79, 0x3b, 0x6f, 0x96, 0xb0, 0xae, 0x9f, 0x69, 0x45, 0xcd, 0xb4, 0x51, 0x51,
+	0x3b, 0xaf, 0x19, 0x06, 0x6a, 0x15, 0xbb, 0xbb, 0x45, 0xf7, 0xa3, 0x82, 0x65, 0x9b, 0xae, 0x29,
+	0x67, 0xf4, 0x33, 0xad, 0xe0, 0xcd, 0x16, 0xe8, 0x6c, 0xa1, 0xbb, 0xab, 0x2c, 0x37, 0xcc, 0x86,
+	0x89, 0xe7, 0x8b, 0xde, 0x2f, 0x02, 0x55, 0x56, 0x34, 0xd3, 0x69, 0x9b, 0x4e, 0xb1, 0xed, 0x34,
+	0x3c, 0x11, 0x6d, 0xa7, 0x41, 0x27, 0xb6, 0x7a, 0x1a, 0x5a, 0x3a, 0x32, 0x5c, 0x6f, 0x96, 0xfc,
+	0xa2, 0x80, 0x5b, 0x3c, 0x13, 0x7c, 0x7d, 0x43, 0x20, 0x1d, 0xab, 0x61, 0xd7, 0xea, 0x88, 0x40,
+	0xf2, 0x9f, 0x4a, 0x20, 0x1f, 0x3a, 0x8d, 0x12, 0x99, 0x3f, 0xb2, 0x90, 0x71, 0x60, 0xe8, 0xae,
+	0xbc, 0x02, 0x69, 0xcb, 0xb4, 0xdd, 0xaa, 0x5e, 0xcf, 0x4a, 0x39, 0x69, 0x7b, 0x4e, 0x4d, 0x79,
+	0x8f, 0x07, 0x75, 0xf9, 0x1d, 0x48, 0x53, 0x59, 0xd9, 0x58, 0x4e, 0xda, 0x9e, 0xdf, 0x5b, 0x2f,
+	0x70, 0x9c, 0x2d, 0x50, 0x79, 0x0f, 0x13, 0x9f, 0x7f, 0xb5, 0x35, 0xa3, 0xfa, 0x4b, 0xe4, 0x9b,
+	0x90, 0x72, 0xf4, 0x86, 0x81, 0xec, 0x6c, 0x9c, 0x48, 0x25, 0x4f, 0x0f, 0x16, 0x9e, 0xfd, 0x7e,
+	0x6b, 0xe6, 0xd7, 0x5f, 0x7f, 0xb6, 0x43, 0x07, 0xf2, 0xef, 0x83, 0x12, 0xb6, 0x4a, 0x45, 0x8e,
+	0x65, 0x1a, 0x0e, 0x92, 0x37, 0x00, 0xa8, 0xc4, 0x9e, 0x81, 0x73, 0x74, 0xe4, 0xa0, 0x2e, 0x67,
+	0x21, 0xdd, 0x45, 0xb6, 0xa3, 0x9b, 0x06, 0xb6, 0x71, 0x4e, 0xf5, 0x1f, 0x1f, 0x24, 0x3c, 0x3d,
+	0xf9, 0xaf, 0x62, 0xb0, 0xd4, 0x2f, 0xfd, 0xc4, 0xbe, 0x88, 0x76, 0x79, 0x0f, 0x32, 0x96, 0x8d,
+	0xba, 0xba, 0xd9, 0x71, 0xaa, 0x01, 0xb5, 0x58, 0xf4, 0xc3, 0x58, 0x56, 0x52, 0x97, 0xfc, 0xe9,
+	0x12, 0x33, 0x21, 0x40, 0x53, 0x7c, 0x7c, 0x9a, 0x76, 0x61, 0x59, 0x33, 0x3b, 0x86, 0x8b, 0x6c,
+	0xab, 0x66, 0xbb, 0x17, 0x55, 0xdf, 0x9b, 0x04, 0xb6, 0x2b, 0x13, 0x9c, 0xfb, 0x31, 0x99, 0xf2,
+	0x28, 0xb1, 0x6c, 0xd3, 0x7c, 0x5a, 0xd5, 0x0d, 0xdd, 0xcd, 0x26, 0x73, 0xd2, 0xf6, 0x35, 0x75,
+	0x0e, 0x8f, 0xe0, 0x78, 0x96, 0xe0, 0x1a, 0x99, 0x3e, 0x47, 0x7a, 0xe3, 0xdc, 0xcd, 0xa6, 0xb0,
+	0x51, 0x4a, 0xc0, 0x28, 0x92, 0x5a, 0xdd, 0xdd, 0xc2, 0xbb, 0x18, 0x41, 0x4d, 0x9a, 0xc7, 0xab,
+	0xc8, 0x50, 0x20, 0x7a, 0xe9, 0xe1, 0xd1, 0x7b, 0x0f, 0x56, 0x43, 0xfc, 0xb2, 0xe0, 0x05, 0xa2,
+	0x23, 0xf5, 0x45, 0x67, 0x20, 0xac, 0xb1, 0x81, 0xb0, 0xd2, 0xe0, 0xfd, 0x2d, 0x14, 0xbc, 0x7d,
+	0xad, 0x19, 0x1d, 0xbc, 0xe1, 0x32, 0xe5, 0xef, 0xc2, 0x4a, 0x1f, 0xd3, 0x01, 0x2c, 0xc9, 0xd0,
+	0x1b, 0xc1, 0xe9, 0x5e, 0x7c, 0x2f, 0x11, 0xa1, 0x35, 0x20, 0xf1, 0xa8, 0xba, 0xf6, 0x05, 0x0d,
+	0xd0, 0x2c, 0x1e, 0xf0, 0x92, 0xef, 0x6a, 0xe3, 0xb3, 0x36, 0x18, 0x9f, 0x7d, 0xad, 0xe9, 0xc7,
+	0x27, 0xff, 0x0f, 0x09, 0x6e, 0xf4, 0xcf, 0x96, 0x4c, 0xe3, 0xa9, 0x6e, 0xb7, 0x2f, 0x4d, 0x32,
+	0xf3, 0xbc, 0xa6, 0x35, 0xb3, 0xf1, 0x80, 0xe7, 0x5e, 0xe4, 0x06, 0x3d, 0x4f, 0x4c, 0xe6, 0x79,
+	0x72, 0xb8, 0xe7, 0x5b, 0xb0, 0xc1, 0xf5, 0x8d, 0x79, 0xdf, 0x85, 0x4c, 0x0f, 0x50, 0x6a, 0x99,
+	0x0e, 0x1a, 0x5e, 0x0f, 0x47, 0xb8, 0x2e, 0x5c, 0xf0, 0x36, 0x60, 0x8d, 0xa3, 0x97, 0x99, 0xf5,
+	0x87, 0x18, 0xdc, 0x1c, 0x98, 0x9f, 0x34, 0x2a, 0xfd, 0x15, 0x23, 0x3e, 0xaa, 0x62, 0x4c, 0x33,
+	0x2e, 0xf2, 0x43, 0xd8, 0xe8, 0xdb, 0x3e, 0xf4, 0x9d, 0x54, 0x75, 0xd0, 0x07, 0x1d, 0x64, 0x68,
+	0x08, 0xe7, 0x7f, 0x42, 0x5d, 0x0b, 0x82, 0x4e, 0x09, 0xa6, 0x42, 0x21, 0x61, 0x0a, 0x73, 0xb0,
+	0xc9, 0xa7, 0x88, 0xb1, 0xf8, 0x4a, 0x82, 0xeb, 0x87, 0x4e, 0x43, 0x45, 0x5a, 0xf7, 0xb8, 0xa6,
+	0x35, 0x91, 0x2b, 0xbf, 0x0d, 0x29, 0x0b, 0xff, 0xc2, 0xdc, 0xcd, 0xef, 0xad, 0x71, 0xcb, 0x34,
+	0x01, 0x53, 0x07, 0xe9, 0x02, 0xf9, 0x0d, 0x58, 0x24, 0x04, 0x69, 0x66, 0xbb, 0xad, 0xbb, 0x6d,
+	0x64, 0xb8, 0x98, 0xe4, 0x6b, 0xea, 0x02, 0x1e, 0x2f, 0xb1, 0xe1, 0x10, 0x97, 0xf1, 0xc9, 0xb8,
+	0x4c, 0x0c, 0x4f, 0xa5, 0x9f, 0xc3, 0x8d, 0x3e, 0x27, 0x59, 0xe5, 0xfd, 0x3e, 0xa4, 0x6c, 0xe4,
+	0x74, 0x5a, 0xc4, 0xd9, 0xd7, 0xf6, 0x6e, 0x73, 0x9d, 0xf5, 0xe1, 0x2a, 0x86, 0x9e, 0x5c, 0x58,
+	0x48, 0xa5, 0xcb, 0x68, 0x05, 0xfe, 0x24, 0x06, 0x70, 0xe8, 0x34, 0x4e, 0xf4, 0x36, 0x32, 0x3b,
+	0xd3, 0xa1, 0xb0, 0x63, 0xd8, 0x48, 0x43, 0x7a, 0x17, 0xd5, 0xfb, 0x28, 0x3c, 0x65, 0xc3, 0xd3,
+	0xa1, 0xf0, 0x0e, 0xc8, 0x06, 0xfa, 0xc8, 0x65, 0x69, 0x56, 0xb5, 0x91, 0xd6, 0xc5, 0x74, 0x26,
+	0xd4, 0x45, 0x6f, 0xc6, 0x4f, 0x2e, 0x8f, 0x3c, 0xf1, 0xa2, 0xf2, 0x3e, 0xc8, 0x3d, 0x3e, 0xa6,
+	0xcd, 0xf6, 0x7f, 0xc8, 0xfb, 0x8e, 0x4a, 0x3f, 0x32, 0x70, 0x62, 0x5f, 0x11, 0xe9, 0x5b, 0x30,
+	0x4f, 0x53, 0xdc, 0x53, 0x4a, 0x6b, 0x04, 0xa9, 0x1a, 0xc4, 0x8c, 0xa9, 0x14, 0x09, 0x7e, 0x54,
+	0x92, 0x23, 0xa3, 0x92, 0x1a, 0xaf, 0xa4, 0xa4, 0x2f, 0x51, 0x52, 0xce, 0x60, 0x35, 0xc4, 0xfd,
+	0xb4, 0x03, 0xfc, 0x2c, 0x86, 0xd3, 0x67, 0x5f, 0x6b, 0x1a, 0xe6, 0x87, 0x2d, 0x54, 0x6f, 0x20,
+	0x5c, 0x33, 0x26, 0x88, 0xf0, 0x36, 0x2c, 0xd4, 0xfa, 0xa5, 0xf9, 0x01, 0x1e, 0x18, 0xee, 0x05,
+	0xd8, 0x5b, 0x58, 0xef, 0x0b, 0xf0, 0xbe, 0x37, 0x72, 0xc5, 0x6f, 0x67, 0x0d, 0x94, 0x30, 0x13,
+	0xd3, 0xe6, 0xfb, 0x4f, 0x7d, 0xe7, 0x1b, 0x9a, 0x02, 0x13, 0xbd, 0xe4, 0x7f, 0x00, 0xa9, 0xa7,
+	0x3a, 0x6a, 0xd5, 0x1d, 0x5a, 0x95, 0xf2, 0x5c, 0xc3, 0xa8, 0xa6, 0x47, 0x18, 0xe9, 0x47, 0x8c,
+	0xac, 0x13, 0xaf, 0xed, 0x9f, 0x48, 0xc1, 0x03, 0x4c, 0xc0, 0x78, 0xc6, 0xd2, 0x3b, 0x90, 0xa6,
+	0xa9, 0x9f, 0x95, 0x86, 0x74, 0x1e, 0x74, 0xa9, 0xdf, 0x79, 0xd0, 0x25, 0x5e, 0x71, 0x08, 0x6d,
+	0x9c, 0x18, 0xde, 0x38, 0x0b, 0x9d, 0x81, 0xcd, 0x42, 0xd8, 0xfc, 0x6f, 0x1c, 0x96, 0x43, 0x06,
+	0x0d, 0x6d, 0xa7, 0x46, 0x90, 0xf9, 0x23, 0xc8, 0x59, 0xb6, 0x69, 0x99, 0x0e, 0xaa, 0xb3, 0x3d,
+	0xac, 0x99, 0x86, 0x81, 0x34, 0x57, 0x37, 0x8d, 0xea, 0xb9, 0x69, 0x79, 0x34, 0xc7, 0xb7, 0xe7,
+	0xd4, 0x0d, 0x1f, 0x47, 0xb5, 0x96, 0x18, 0xea, 0x5d, 0xd3, 0x72, 0xe4, 0x73, 0x58, 0xe3, 0x16,
+	0x04, 0x1a, 0xaa, 0xc4, 0x98, 0xa1, 0x5a, 0xe5, 0x14, 0x0e, 0x02, 0x18, 0x5d, 0x7a, 0x92, 0x23,
+	0x4b, 0x8f, 0xfc, 0x2d, 0xb8, 0x4e, 0x4b, 0x2d, 0x6d, 0x1b, 0x53, 0x78, 0x2f, 0x92, 0xdd, 0x47,
+	0xd9, 0xed, 0x81, 0xfc, 0x08, 0xa7, 0x03, 0x20, 0x2a, 0x31, 0xb4, 0x65, 0x67, 0x27, 0xdb, 0xb2,
+	0x73, 0xc3, 0x13, 0xf2, 0xef, 0x12, 0xac, 0xf3, 0xe2, 0x7f, 0xe5, 0xf9, 0x18, 0x28, 0x0f, 0xf1,
+	0x49, 0xca, 0xc3, 0x3f, 0x63, 0x9c, 0x84, 0x9e, 0xa4, 0xc5, 0x3c, 0x1d, 0x68, 0x15, 0x7d, 0x36,
+	0xe2, 0xc2, 0x6c, 0x64, 0x38, 0x89, 0x13, 0x4e, 0x98, 0x84, 0x48, 0xc2, 0x24, 0x05, 0x12, 0xe6,
+	0xff, 0xdb, 0x7b, 0x22, 0x4e, 0xbe, 0x04, 0xda, 0xcf, 0x69, 0x55, 0xf9, 0x3f, 0xc7, 0x21, 0x1b,
+	0xd2, 0x33, 0x69, 0xcb, 0xf4, 0x13, 0x50, 0xb8, 0xb7, 0x05, 0x8e, 0x5b, 0x73, 0x11, 0x4d, 0x3b,
+	0x85, 0x6b, 0x6f, 0xc5, 0x43, 0xa8, 0x59, 0xce, 0x65, 0x02, 0x9e, 0x89, 0x4c, 0x92, 0xc4, 0x94,
+	0x93, 0x24, 0x29, 0x92, 0x24, 0x29, 0x81, 0x24, 0x49, 0x4f, 0x96, 0x24, 0xb3, 0xc3, 0x93, 0x44,
+	0x87, 0x5c, 0x54, 0xf0, 0xa6, 0x9d, 0x28, 0x1f, 0xc7, 0x39, 0xc7, 0x01, 0xef, 0x66, 0xe0, 0x1b,
+	0x98, 0x25, 0x23, 0x5f, 0x34, 0x89, 0x4b, 0xbc, 0x68, 0x78, 0x29, 0x71, 0xb5, 0x25, 0x61, 0x0b,
+	0x36, 0xb8, 0x11, 0x60, 0x7d, 0xfb, 0x5f, 0x62, 0x9c, 0xcd, 0xec, 0xf7, 0x9f, 0xd3, 0xaa, 0xcb,
+	0xe3, 0xdf, 0xd7, 0x66, 0x38, 0x81, 0x12, 0xab, 0xcb, 0x83, 0xfc, 0x26, 0x27, 0xe3, 0x37, 0x35,
+	0x9c, 0xdf, 0x3c, 0xe4, 0xa2, 0xd8, 0x63, 0x14, 0xff, 0x35, 0x06, 0x2b, 0xe1, 0x2d, 0x57, 0x33,
+	0x34, 0xd4, 0xba, 0x34, 0xc3, 0x8f, 0xe1, 0x3a, 0xb2, 0x6d, 0xd3, 0xae, 0xe2, 0x86, 0xd2, 0xf2,
+	0x9b, 0xf6, 0x5b, 0x5c, 0x6a, 0xcb, 0x1e, 0x52, 0x25, 0x40, 0xea, 0xed, 0x35, 0x14, 0x18, 0x93,
+	0x0b, 0x90, 0x21, 0x9c, 0xf5, 0xcb, 0x24, 0xf4, 0x2e, 0xe1, 0xa9, 0xa0, 0x8c, 0x2b, 0xe6, 0xf8,
+	0x16, 0x6c, 0x45, 0xd0, 0xc7, 0x28, 0xfe, 0x15, 0x2c, 0x1c, 0x3a, 0x8d, 0x53, 0xab, 0x5e, 0x73,
+	0xd1, 0x71, 0xcd, 0xae, 0xb5, 0x1d, 0x79, 0x1d, 0xe6, 0x6a, 0x1d, 0xf7, 0xdc, 0xb4, 0x75, 0xf7,
+	0xc2, 0xff, 0x8e, 0xc1, 0x06, 0x48, 0x0b, 0xe8, 0xe1, 0xb2, 0xb1, 0xa1, 0x2d, 0xa0, 0x07, 0xe9,
+	0xb5, 0x80, 0xde, 0xd3, 0x03, 0xd9, 0xb7, 0xaf, 0x27, 0x2e, 0xbf, 0x0a, 0x2b, 0x03, 0xfa, 0x99,
+	0x69, 0xbf, 0x95, 0xf0, 0x06, 0x3b, 0xb6, 0x3b, 0x06, 0x1a, 0x68, 0xbf, 0x9c, 0x4b, 0x87, 0x7f,
+	0x19, 0x92, 0x2d, 0xbd, 0x4d, 0xef, 0x16, 0x13, 0x2a, 0x79, 0x10, 0x6f, 0x75, 0x3e, 0x95, 0x20,
+	0x17, 0x65, 0x13, 0x7b, 0x09, 0xdc, 0x83, 0x9b, 0xae, 0xe9, 0xd6, 0x5a, 0x55, 0xcb, 0x83, 0xd5,
+	0x59, 0x25, 0x74, 0xb0, 0xa9, 0x09, 0x75, 0x19, 0xcf, 0x62, 0x19, 0x75, 0xbf, 0x04, 0x3a, 0xf2,
+	0x03, 0x58, 0x25, 0xab, 0x6c, 0xd4, 0xae, 0xe9, 0x86, 0x6e, 0x34, 0x02, 0x0b, 0xc9, 0xf1, 0x72,
+	0x05, 0x03, 0x54, 0x7f, 0x9e, 0xad, 0xcd, 0xff, 0x4e, 0xc2, 0x34, 0x56, 0x90, 0xeb, 0x97, 0x66,
+	0x64, 0xd4, 0x8f, 0x6b, 0x1d, 0x07, 0xd5, 0x27, 0xb9, 0x25, 0xb6, 0xb0, 0x04, 0x4c, 0xd5, 0xac,
+	0x4a, 0x9f, 0xc4, 0xb9, 0x22, 0xd9, 0xc7, 0xb3, 0xc9, 0x67, 0x6a, 0xe7, 0x4b, 0x09, 0xe4, 0xf0,
+	0xcb, 0x50, 0xbe, 0x0f, 0x39, 0xb5, 0x5c, 0x39, 0x3e, 0x7a, 0x52, 0x29, 0x57, 0xd5, 0x72, 0xe5,
+	0xf4, 0xf1, 0x49, 0xf5, 0xe4, 0xa7, 0xc7, 0xe5, 0xea, 0xe9, 0x93, 0xca, 0x71, 0xb9, 0x74, 0xf0,
+	0xe8, 0xa0, 0xfc, 0xc3, 0xc5, 0x19, 0x65, 0xe1, 0xf9, 0x8b, 0xdc, 0x7c, 0x60, 0x48, 0xbe, 0x0d,
+	0xab, 0xdc, 0x65, 0x4f, 0x8e, 0x8e, 0x8e, 0x17, 0x25, 0x65, 0xf6, 0xf9, 0x8b, 0x5c, 0xc2, 0xfb,
+	0x2d, 0xdf, 0x85, 0x75, 0x2e, 0xb0, 0x72, 0x5a, 0x2a, 0x95, 0x2b, 0x95, 0xc5, 0x98, 0x32, 0xff,
+	0xfc, 0x45, 0x2e, 0x4d, 0x1f, 0x23, 0xe1, 0x8f, 0xf6, 0x0f, 0x1e, 0x9f, 0xaa, 0xe5, 0xc5, 0x38,
+	0x81, 0xd3, 0x47, 0x25, 0xf1, 0xec, 0x8f, 0x9b, 0x33, 0x7b, 0xff, 0x5e, 0x82, 0xf8, 0xa1, 0xd3,
+	0x90, 0x9b, 0xb0, 0x30, 0xf8, 0x1d, 0x93, 0x7f, 0x28, 0x08, 0x7f, 0x5a, 0x54, 0x8a, 0x82, 0x40,
+	0x96, 0x79, 0xe7, 0xf0, 0xda, 0xc0, 0x07, 0xc4, 0xd7, 0x05, 0x44, 0x9c, 0xd8, 0x17, 0x4a, 0x41,
+	0x0c, 0x17, 0xa1, 0xc9, 0x6b, 0x45, 0x44, 0x34, 0xed, 0x6b, 0x4d, 0x21, 0x4d, 0xc1, 0xb3, 0xb7,
+	0x0b, 0x32, 0xe7, 0xb3, 0xcf, 0x8e, 0x80, 0x14, 0x8a, 0x55, 0xf6, 0xc4, 0xb1, 0x4c, 0xab, 0x01,
+	0x8b, 0xa1, 0xef, 0x2d, 0xdb, 0x23, 0xe4, 0x30, 0xa4, 0xf2, 0xa6, 0x28, 0x92, 0xe9, 0xfb, 0x10,
+	0x32, 0xbc, 0xef, 0x28, 0xdf, 0x11, 0x11, 0xe4, 0xfb, 0xf9, 0xd6, 0x18, 0x60, 0xa6, 0xf8, 0x67,
+	0x00, 0x81, 0x4f, 0x0f, 0xf9, 0x28, 0x11, 0x3d, 0x8c, 0xb2, 0x33, 0x1a, 0xc3, 0xa4, 0x57, 0x20,
+	0xed, 0x1f, 0x89, 0xb6, 0xa2, 0x96, 0x51, 0x80, 0x72, 0x7b, 0x04, 0x20, 0x98, 0x7b, 0x03, 0x37,
+	0xcf, 0xaf, 0x8f, 0x58, 0x4a, 0x71, 0x4a, 0x41, 0x0c, 0xc7, 0x34, 0x35, 0x61, 0x61, 0xf0, 0x0a,
+	0x34, 0xd2, 0xca, 0x01, 0xa0, 0x52, 0x14, 0x04, 0x72, 0x12, 0x3d, 0x78, 0xff, 0x37, 0x2a, 0xd1,
+	0x03, 0x58, 0x65, 0x4f, 0x1c, 0xcb, 0xb4, 0x7e, 0x00, 0x4b, 0xe1, 0x7b, 0xb2, 0x37, 0xc4, 0x04,
+	0x79, 0x85, 0x63, 0x57, 0x18, 0x1a, 0xad, 0xd2, 0x2b, 0x1f, 0x82, 0x2a, 0xbd, 0x0a, 0xb2, 0x2b,
+	0x0c, 0x65, 0x2a, 0x7f, 0x09, 0x37, 0xf8, 0x5d, 0xf7, 0x5d, 0x31, 0x59, 0xfe, 0x16, 0xbb, 0x3f,
+	0x16, 0x3c, 0x3a, 0xb4, 0xb8, 0x97, 0x13, 0x0c, 0xad, 0x87, 0x55, 0xf6, 0xc4, 0xb1, 0xd1, 0x4e,
+	0xfb, 0x5b, 0x51, 0xd0, 0x69, 0x7f, 0x63, 0xde, 0x1f, 0x0b, 0xce, 0xd4, 0xff, 0x02, 0x96, 0xb9,
+	0x27, 0xf7, 0x3b, 0x82, 0x1c, 0x62, 0xb4, 0x72, 0x6f, 0x1c, 0x34, 0xd3, 0xad, 0x43, 0x86, 0x9c,
+	0x29, 0x29, 0x8a, 0x1e, 0x6d, 0xbf, 0x1d, 0x25, 0x2c, 0x78, 0x00, 0x55, 0xee, 0x88, 0xa0, 0x82,
+	0x2c, 0xf3, 0x8f, 0xa8, 0x91, 0x2c, 0x73, 0xe1, 0xca, 0xfd, 0xb1, 0xe0, 0x41, 0x96, 0xb9, 0xc7,
+	0xbe, 0x48, 0x27, 0x78, 0x68, 0xe5, 0xde, 0x38, 0x68, 0x5f, 0xb7, 0x92, 0xfc, 0xf8, 0xeb, 0xcf,
+	0x76, 0xa4, 0x87, 0x95, 0xcf, 0x5f, 0x6e, 0x4a, 0x5f, 0xbc, 0xdc, 0x94, 0xfe, 0xf5, 0x72, 0x53,
+	0xfa, 0xcd, 0xab, 0xcd, 0x99, 0x2f, 0x5e, 0x6d, 0xce, 0x7c, 0xf9, 0x6a, 0x73, 0xe6, 0xbd, 0xb7,
+	0x1b, 0xba, 0x7b, 0xde, 0x39, 0x2b, 0x68, 0x66, 0xbb, 0x48, 0xff, 0x53, 0xa6, 0x9f, 0x69, 0x77,
+	0x1b, 0x66, 0xb1, 0xfb, 0xbd, 0x62, 0xdb, 0xac, 0x77, 0x5a, 0xc8, 0x21, 0xff, 0x05, 0x7b, 0xf3,
+	0xde, 0x5d, 0xff, 0xef, 0x60, 0xee, 0x85, 0x85, 0x9c, 0xb3, 0x14, 0xfe, 0x2b, 0xd8, 0x5b, 0xff,
+	0x1b, 0x00, 0x27, 0xd5, 0x70, 0xab, 0xd5, 0x26, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1853,6 +1939,8 @@ type MsgClient interface {
 	UpdateChannelParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
 	PruneAcknowledgements(ctx context.Context, in *MsgPruneAcknowledgements, opts ...grpc.CallOption) (*MsgPruneAcknowledgementsResponse, error)
+	// SetChannelSendPaused defines a rpc handler method for MsgSetChannelSendPaused.
+	SetChannelSendPaused(ctx context.Context, in *MsgSetChannelSendPaused, opts ...grpc.CallOption) (*MsgSetChannelSendPausedResponse, error)
 }
 
 type msgClient struct {
@@ -2034,6 +2122,15 @@ func (c *msgClient) PruneAcknowledgements(ctx context.Context, in *MsgPruneAckno
 	return out, nil
 }
 
+func (c *msgClient) SetChannelSendPaused(ctx context.Context, in *MsgSetChannelSendPaused, opts ...grpc.CallOption) (*MsgSetChannelSendPausedResponse, error) {
+	out := new(MsgSetChannelSendPausedResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Msg/SetChannelSendPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ChannelOpenInit defines a rpc handler method for MsgChannelOpenInit.
@@ -2075,6 +2172,8 @@ type MsgServer interface {
 	UpdateChannelParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 	// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
 	PruneAcknowledgements(context.Context, *MsgPruneAcknowledgements) (*MsgPruneAcknowledgementsResponse, error)
+	// SetChannelSendPaused defines a rpc handler method for MsgSetChannelSendPaused.
+	SetChannelSendPaused(context.Context, *MsgSetChannelSendPaused) (*MsgSetChannelSendPausedResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2138,6 +2237,9 @@ func (*UnimplementedMsgServer) UpdateChannelParams(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) PruneAcknowledgements(ctx context.Context, req *MsgPruneAcknowledgements) (*MsgPruneAcknowledgementsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneAcknowledgements not implemented")
 }
+func (*UnimplementedMsgServer) SetChannelSendPaused(ctx context.Context, req *MsgSetChannelSendPaused) (*MsgSetChannelSendPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelSendPaused not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2485,6 +2587,24 @@ func _Msg_PruneAcknowledgements_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetChannelSendPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChannelSendPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChannelSendPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Msg/SetChannelSendPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChannelSendPaused(ctx, req.(*MsgSetChannelSendPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ibc.core.channel.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2565,6 +2685,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PruneAcknowledgements",
 			Handler:    _Msg_PruneAcknowledgements_Handler,
 		},
+		{
+			MethodName: "SetChannelSendPaused",
+			Handler:    _Msg_SetChannelSendPaused_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ibc/core/channel/v1/tx.proto",
@@ -4347,6 +4471,83 @@ func (m *MsgPruneAcknowledgementsResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetChannelSendPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChannelSendPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChannelSendPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetChannelSendPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetChannelSendPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChannelSendPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -5112,6 +5313,39 @@ func (m *MsgPruneAcknowledgementsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetChannelSendPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetChannelSendPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -10729,6 +10963,222 @@ func (m *MsgPruneAcknowledgementsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetChannelSendPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetChannelSendPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetChannelSendPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetChannelSendPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetChannelSendPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetChannelSendPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
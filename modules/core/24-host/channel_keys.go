@@ -19,3 +19,8 @@ func ChannelUpgradeKey(portID, channelID string) []byte {
 func ChannelCounterpartyUpgradeKey(portID, channelID string) []byte {
 	return []byte(ChannelCounterpartyUpgradePath(portID, channelID))
 }
+
+// ChannelSendPausedKey returns the store key for the flag pausing packet sends on a particular channel.
+func ChannelSendPausedKey(portID, channelID string) []byte {
+	return []byte(ChannelSendPausedPath(portID, channelID))
+}
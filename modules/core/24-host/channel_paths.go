@@ -10,6 +10,7 @@ const (
 	KeyUpgradeErrorPrefix      = "upgradeError"
 	KeyCounterpartyUpgrade     = "counterpartyUpgrade"
 	KeyChannelCapabilityPrefix = "capabilities"
+	KeyChannelSendPaused       = "channelSendPaused"
 )
 
 // ICS04
@@ -41,6 +42,11 @@ func ChannelCounterpartyUpgradePath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s", KeyChannelUpgradePrefix, KeyCounterpartyUpgrade, channelPath(portID, channelID))
 }
 
+// ChannelSendPausedPath defines the path under which the flag pausing packet sends on a channel is stored.
+func ChannelSendPausedPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyChannelSendPaused, channelPath(portID, channelID))
+}
+
 func channelPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s/%s", KeyPortPrefix, portID, KeyChannelPrefix, channelID)
 }
@@ -30,6 +30,12 @@ func PacketAcknowledgementKey(portID, channelID string, sequence uint64) []byte
 	return []byte(PacketAcknowledgementPath(portID, channelID, sequence))
 }
 
+// PacketAcknowledgementAgeKey returns the store key under which the height and
+// time at which a packet acknowledgement was written is stored
+func PacketAcknowledgementAgeKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(PacketAcknowledgementAgePath(portID, channelID, sequence))
+}
+
 // PacketReceiptKey returns the store key of under which a packet
 // receipt is stored
 func PacketReceiptKey(portID, channelID string, sequence uint64) []byte {
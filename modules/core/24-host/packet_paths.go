@@ -12,6 +12,7 @@ const (
 	KeyPacketReceiptPrefix    = "receipts"
 	KeyPruningSequenceStart   = "pruningSequenceStart"
 	KeyRecvStartSequence      = "recvStartSequence"
+	KeyPacketAckAgePrefix     = "ackAges"
 )
 
 // ICS04
@@ -52,9 +53,19 @@ func PacketAcknowledgementPrefixPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s", KeyPacketAckPrefix, channelPath(portID, channelID), KeySequencePrefix)
 }
 
+// PacketAcknowledgementAgePath defines the store path of the height and time at which a packet acknowledgement was written
+func PacketAcknowledgementAgePath(portID, channelID string, sequence uint64) string {
+	return fmt.Sprintf("%s/%s/%s", KeyPacketAckAgePrefix, channelPath(portID, channelID), sequencePath(sequence))
+}
+
 // PacketReceiptPath defines the packet receipt store path
 func PacketReceiptPath(portID, channelID string, sequence uint64) string {
-	return fmt.Sprintf("%s/%s/%s", KeyPacketReceiptPrefix, channelPath(portID, channelID), sequencePath(sequence))
+	return fmt.Sprintf("%s/%d", PacketReceiptPrefixPath(portID, channelID), sequence)
+}
+
+// PacketReceiptPrefixPath defines the prefix for packet receipts store path.
+func PacketReceiptPrefixPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s/%s", KeyPacketReceiptPrefix, channelPath(portID, channelID), KeySequencePrefix)
 }
 
 // PruningSequenceStartPath defines the path under which the pruning sequence starting value is stored
@@ -149,6 +149,15 @@ func (suite *AnteTestSuite) createTimeoutOnCloseMessage(isRedundant bool) sdk.Ms
 	return channeltypes.NewMsgTimeoutOnClose(packet, 1, proof, closedProof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String(), 0)
 }
 
+func (suite *AnteTestSuite) createUpdateClientBatchMessage() sdk.Msg {
+	updateMsg, ok := suite.createUpdateClientMessage().(*clienttypes.MsgUpdateClient)
+	suite.Require().True(ok)
+
+	update := clienttypes.ClientUpdate{ClientId: updateMsg.ClientId, ClientMessage: updateMsg.ClientMessage}
+
+	return clienttypes.NewMsgUpdateClientBatch([]clienttypes.ClientUpdate{update}, updateMsg.Signer)
+}
+
 func (suite *AnteTestSuite) createUpdateClientMessage() sdk.Msg {
 	endpoint := suite.path.EndpointB
 
@@ -327,6 +336,13 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 			},
 			true,
 		},
+		{
+			"success on one new UpdateClientBatch message and one new RecvPacket message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createUpdateClientBatchMessage(), suite.createRecvPacketMessage(false)}
+			},
+			true,
+		},
 		{
 			"success on three redundant RecvPacket messages and one SubmitMisbehaviour message",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -387,6 +403,27 @@ func (suite *AnteTestSuite) TestAnteDecorator() {
 			},
 			false,
 		},
+		{
+			"no success on one new UpdateClientBatch message and three redundant RecvPacket messages",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				msgs := []sdk.Msg{suite.createUpdateClientBatchMessage()}
+
+				for i := 1; i <= 3; i++ {
+					msgs = append(msgs, suite.createRecvPacketMessage(true))
+				}
+
+				return msgs
+			},
+			false,
+		},
+		{
+			"no success on one UpdateClientBatch message with an invalid client update",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				update := clienttypes.ClientUpdate{ClientId: suite.path.EndpointB.ClientID}
+				return []sdk.Msg{clienttypes.NewMsgUpdateClientBatch([]clienttypes.ClientUpdate{update}, suite.chainB.SenderAccount.GetAddress().String())}
+			},
+			false,
+		},
 		{
 			"no success on three new UpdateClient messages and three redundant messages of each type",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -36,6 +36,11 @@ const (
 	// Expired is a status type of a client. An expired client is not allowed to be used.
 	Expired Status = "Expired"
 
+	// ExpiredGrace is a status type of a client which has expired but is within the grace period
+	// configured by its light client. Proofs of packet data may still be verified against the
+	// client, but it is not allowed to be updated or used to send packets or perform handshakes.
+	ExpiredGrace Status = "ExpiredGrace"
+
 	// Unknown indicates there was an error in determining the status of a client.
 	Unknown Status = "Unknown"
 
@@ -140,6 +145,110 @@ type LightClientModule interface {
 	) error
 }
 
+// TrustedHeightsRecoveryModule is an optional interface which may be implemented by light client modules
+// to support copying the consensus states of the substitute client at a set of trusted heights to the
+// subject client during client recovery.
+type TrustedHeightsRecoveryModule interface {
+	// RecoverClientWithTrustedHeights must perform the same verification and state changes as RecoverClient.
+	// In addition, the consensus states of the substitute client at the provided trusted heights must be set in the
+	// client store of the subject client. An error must be returned if a consensus state does not exist for any of the
+	// trusted heights.
+	RecoverClientWithTrustedHeights(ctx sdk.Context, clientID, substituteClientID string, trustedHeights []Height) error
+}
+
+// TimeoutVerificationModule is an optional interface which may be implemented by light client modules
+// to permit the verification of packet timeout proofs while the client is Frozen. This allows packets sent
+// prior to a misbehaviour to be timed out, so that funds are not stranded by the frozen client.
+// It is only used by core IBC to verify timeout and timeout on close proofs, and never for packet receipt or
+// acknowledgement proofs.
+type TimeoutVerificationModule interface {
+	// VerifyMembershipForTimeout must perform the same verification as VerifyMembership. If the client is frozen,
+	// an error must be returned unless the proof height is prior to the height of the misbehaviour which froze the client.
+	VerifyMembershipForTimeout(
+		ctx sdk.Context,
+		clientID string,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		path Path,
+		value []byte,
+	) error
+
+	// VerifyNonMembershipForTimeout must perform the same verification as VerifyNonMembership. If the client is frozen,
+	// an error must be returned unless the proof height is prior to the height of the misbehaviour which froze the client.
+	VerifyNonMembershipForTimeout(
+		ctx sdk.Context,
+		clientID string,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+		proof []byte,
+		path Path,
+	) error
+}
+
+// BatchMembershipVerificationModule is an optional interface which may be implemented by light client modules
+// to verify multiple membership proofs against the consensus state at the same height while only loading the
+// client and consensus states once. It is used by core IBC to verify the packet commitments of multiple packets
+// relayed within a single transaction.
+type BatchMembershipVerificationModule interface {
+	// MembershipVerifier must perform the same verification as VerifyMembership which does not depend on the proof,
+	// path and value, and return a MembershipVerifier performing the remaining verification against the consensus
+	// state at the given height. The client status is checked by core IBC and does not need to be checked.
+	MembershipVerifier(
+		ctx sdk.Context,
+		clientID string,
+		height Height,
+		delayTimePeriod uint64,
+		delayBlockPeriod uint64,
+	) (MembershipVerifier, error)
+}
+
+// MembershipVerifier verifies membership proofs against the consensus state of a client at a fixed height.
+type MembershipVerifier interface {
+	// VerifyMembership must verify the proof of the existence of the value at the given path against the
+	// consensus state the verifier was created for.
+	VerifyMembership(proof []byte, path Path, value []byte) error
+}
+
+// SeedConsensusStatesModule is an optional interface which may be implemented by light client modules
+// to allow clients to be created with additional consensus states at heights below the latest height of
+// the initial client state, such that proofs at several recent heights can be verified without updating
+// the client first.
+type SeedConsensusStatesModule interface {
+	// InitializeSeedConsensusStates is called immediately after Initialize with the heights and the
+	// marshalled consensus states provided alongside the initial client state, in ascending order of height.
+	// Implementations must validate that every consensus state is consistent with the initialized client
+	// state before storing it.
+	InitializeSeedConsensusStates(
+		ctx sdk.Context,
+		clientID string,
+		heights []Height,
+		consensusStates [][]byte,
+	) error
+}
+
+// ConsensusStatePruningModule is an optional interface which may be implemented by light client modules
+// to allow the consensus states of a client which is expired or frozen to be pruned, e.g. before the deposit
+// escrowed for the creation of the client is refunded.
+type ConsensusStatePruningModule interface {
+	// PruneConsensusStates must delete up to limit consensus states of the client, or all of them if limit is
+	// zero, along with any metadata stored for them, and return the number of consensus states pruned. Pruning
+	// is complete once fewer than limit consensus states are pruned. Consensus states against which proofs may
+	// still be verified, such as those of a frozen client prior to its misbehaviour, must not be pruned. An error
+	// must be returned unless the client is expired or frozen.
+	PruneConsensusStates(ctx sdk.Context, clientID string, limit int) (int, error)
+}
+
+// ClientFreezingModule is an optional interface which may be implemented by light client modules
+// to allow an active client to be frozen through governance without a proof of misbehaviour.
+type ClientFreezingModule interface {
+	// FreezeClient must freeze the client such that its status is Frozen and all verification using
+	// the client fails until it is recovered, and return the height at which the client was frozen.
+	FreezeClient(ctx sdk.Context, clientID string) (Height, error)
+}
+
 // ClientState defines the required common functions for light clients.
 type ClientState interface {
 	proto.Message
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	connectionkeeper "github.com/cosmos/ibc-go/v8/modules/core/03-connection/keeper"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+// BenchmarkUpdateClients compares updating a number of clients with a single MsgUpdateClientBatch
+// against updating them with one MsgUpdateClient per client.
+func BenchmarkUpdateClients(b *testing.B) {
+	for _, numClients := range []int{1, 10, 50} {
+		coord := &ibctesting.Coordinator{CurrentTime: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)}
+		chainA := ibctesting.NewTestChainWithOptions(b, coord, ibctesting.GetChainID(1))
+		chainB := ibctesting.NewTestChainWithOptions(b, coord, ibctesting.GetChainID(2))
+		coord.Chains = map[string]*ibctesting.TestChain{chainA.ChainID: chainA, chainB.ChainID: chainB}
+
+		var paths []*ibctesting.Path
+		for i := 0; i < numClients; i++ {
+			path := ibctesting.NewPath(chainA, chainB)
+			path.SetupClients()
+			paths = append(paths, path)
+		}
+
+		coord.CommitBlock(chainB)
+
+		signer := chainA.SenderAccount.GetAddress().String()
+
+		var (
+			updates    []clienttypes.ClientUpdate
+			updateMsgs []*clienttypes.MsgUpdateClient
+		)
+		for _, path := range paths {
+			trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+			if !ok {
+				b.Fatal("client latest height is not a clienttypes.Height")
+			}
+
+			latestHeader := *chainB.LatestCommittedHeader
+			header, err := chainB.IBCClientHeader(&latestHeader, trustedHeight)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			update, err := clienttypes.NewClientUpdate(path.EndpointA.ClientID, header)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			updates = append(updates, update)
+			updateMsgs = append(updateMsgs, &clienttypes.MsgUpdateClient{ClientId: update.ClientId, ClientMessage: update.ClientMessage, Signer: signer})
+		}
+
+		batchMsg := clienttypes.NewMsgUpdateClientBatch(updates, signer)
+
+		b.Run(fmt.Sprintf("%d single messages", numClients), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				// the updates are applied to a cached context, so that every iteration updates the same client states
+				ctx, _ := chainA.GetContext().CacheContext()
+				for _, msg := range updateMsgs {
+					if _, err := chainA.App.GetIBCKeeper().UpdateClient(ctx, msg); err != nil {
+						b.Fatal(err)
+					}
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("%d clients in batch message", numClients), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				ctx, _ := chainA.GetContext().CacheContext()
+				if _, err := chainA.App.GetIBCKeeper().UpdateClientBatch(ctx, batchMsg); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkRecvPackets compares the gas consumed by receiving a number of packets proven at the same height
+// within a single transaction with and without the membership verifier cache set by the ante handler.
+func BenchmarkRecvPackets(b *testing.B) {
+	for _, numPackets := range []int{1, 10} {
+		coord := &ibctesting.Coordinator{CurrentTime: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)}
+		chainA := ibctesting.NewTestChainWithOptions(b, coord, ibctesting.GetChainID(1))
+		chainB := ibctesting.NewTestChainWithOptions(b, coord, ibctesting.GetChainID(2))
+		coord.Chains = map[string]*ibctesting.TestChain{chainA.ChainID: chainA, chainB.ChainID: chainB}
+
+		path := ibctesting.NewPath(chainA, chainB)
+		path.Setup()
+
+		// send all packets within the same block, so that they are proven at the same height
+		channelCap := chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		timeoutHeight := clienttypes.NewHeight(1, 1000)
+
+		var packets []channeltypes.Packet
+		for i := 0; i < numPackets; i++ {
+			sequence, err := chainA.App.GetIBCKeeper().ChannelKeeper.SendPacket(chainA.GetContext(), channelCap, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, 0, ibctesting.MockPacketData)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			packets = append(packets, channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0))
+		}
+
+		coord.CommitBlock(chainA)
+		if err := path.EndpointB.UpdateClient(); err != nil {
+			b.Fatal(err)
+		}
+
+		signer := chainB.SenderAccount.GetAddress().String()
+
+		var msgs []*channeltypes.MsgRecvPacket
+		for _, packet := range packets {
+			proof, proofHeight := chainA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+			msgs = append(msgs, channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, signer))
+		}
+
+		recvPackets := func(b *testing.B, ctx sdk.Context) {
+			b.Helper()
+			for _, msg := range msgs {
+				if _, err := chainB.App.GetIBCKeeper().RecvPacket(ctx, msg); err != nil {
+					b.Fatal(err)
+				}
+			}
+
+			b.ReportMetric(float64(ctx.GasMeter().GasConsumed()), "gas/op")
+		}
+
+		b.Run(fmt.Sprintf("%d packets without cache", numPackets), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				// the packets are received on a cached context, so that every iteration receives the same packets
+				ctx, _ := chainB.GetContext().CacheContext()
+				recvPackets(b, ctx)
+			}
+		})
+
+		b.Run(fmt.Sprintf("%d packets with cache", numPackets), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				ctx, _ := chainB.GetContext().CacheContext()
+				recvPackets(b, connectionkeeper.WithMembershipVerifierCache(ctx))
+			}
+		})
+	}
+}
@@ -38,6 +38,21 @@ func (k *Keeper) ClientStatus(c context.Context, req *clienttypes.QueryClientSta
 	return k.ClientKeeper.ClientStatus(c, req)
 }
 
+// VerifyClientParameters implements the IBC QueryServer interface
+func (k *Keeper) VerifyClientParameters(c context.Context, req *clienttypes.QueryVerifyClientParametersRequest) (*clienttypes.QueryVerifyClientParametersResponse, error) {
+	return k.ClientKeeper.VerifyClientParameters(c, req)
+}
+
+// VerifyHeaderAgainstClient implements the IBC QueryServer interface
+func (k *Keeper) VerifyHeaderAgainstClient(c context.Context, req *clienttypes.QueryVerifyHeaderAgainstClientRequest) (*clienttypes.QueryVerifyHeaderAgainstClientResponse, error) {
+	return k.ClientKeeper.VerifyHeaderAgainstClient(c, req)
+}
+
+// ClientAlias implements the IBC QueryServer interface
+func (k *Keeper) ClientAlias(c context.Context, req *clienttypes.QueryClientAliasRequest) (*clienttypes.QueryClientAliasResponse, error) {
+	return k.ClientKeeper.ClientAlias(c, req)
+}
+
 // ClientParams implements the IBC QueryServer interface
 func (k *Keeper) ClientParams(c context.Context, req *clienttypes.QueryClientParamsRequest) (*clienttypes.QueryClientParamsResponse, error) {
 	return k.ClientKeeper.ClientParams(c, req)
@@ -83,6 +98,11 @@ func (k *Keeper) ConnectionConsensusState(c context.Context, req *connectiontype
 	return k.ConnectionKeeper.ConnectionConsensusState(c, req)
 }
 
+// ConnectionHealth implements the IBC QueryServer interface
+func (k *Keeper) ConnectionHealth(c context.Context, req *connectiontypes.QueryConnectionHealthRequest) (*connectiontypes.QueryConnectionHealthResponse, error) {
+	return k.ConnectionKeeper.ConnectionHealth(c, req)
+}
+
 // ConnectionParams implements the IBC QueryServer interface
 func (k *Keeper) ConnectionParams(c context.Context, req *connectiontypes.QueryConnectionParamsRequest) (*connectiontypes.QueryConnectionParamsResponse, error) {
 	return k.ConnectionKeeper.ConnectionParams(c, req)
@@ -98,6 +118,11 @@ func (k *Keeper) Channels(c context.Context, req *channeltypes.QueryChannelsRequ
 	return k.ChannelKeeper.Channels(c, req)
 }
 
+// ChannelsByState implements the IBC QueryServer interface
+func (k *Keeper) ChannelsByState(c context.Context, req *channeltypes.QueryChannelsByStateRequest) (*channeltypes.QueryChannelsByStateResponse, error) {
+	return k.ChannelKeeper.ChannelsByState(c, req)
+}
+
 // ConnectionChannels implements the IBC QueryServer interface
 func (k *Keeper) ConnectionChannels(c context.Context, req *channeltypes.QueryConnectionChannelsRequest) (*channeltypes.QueryConnectionChannelsResponse, error) {
 	return k.ChannelKeeper.ConnectionChannels(c, req)
@@ -128,6 +153,11 @@ func (k *Keeper) PacketReceipt(c context.Context, req *channeltypes.QueryPacketR
 	return k.ChannelKeeper.PacketReceipt(c, req)
 }
 
+// PacketReceiptCount implements the IBC QueryServer interface
+func (k *Keeper) PacketReceiptCount(c context.Context, req *channeltypes.QueryPacketReceiptCountRequest) (*channeltypes.QueryPacketReceiptCountResponse, error) {
+	return k.ChannelKeeper.PacketReceiptCount(c, req)
+}
+
 // PacketAcknowledgement implements the IBC QueryServer interface
 func (k *Keeper) PacketAcknowledgement(c context.Context, req *channeltypes.QueryPacketAcknowledgementRequest) (*channeltypes.QueryPacketAcknowledgementResponse, error) {
 	return k.ChannelKeeper.PacketAcknowledgement(c, req)
@@ -138,11 +168,26 @@ func (k *Keeper) PacketAcknowledgements(c context.Context, req *channeltypes.Que
 	return k.ChannelKeeper.PacketAcknowledgements(c, req)
 }
 
+// PacketState implements the IBC QueryServer interface
+func (k *Keeper) PacketState(c context.Context, req *channeltypes.QueryPacketStateRequest) (*channeltypes.QueryPacketStateResponse, error) {
+	return k.ChannelKeeper.PacketState(c, req)
+}
+
+// UnrelayedAcknowledgements implements the IBC QueryServer interface
+func (k *Keeper) UnrelayedAcknowledgements(c context.Context, req *channeltypes.QueryUnrelayedAcknowledgementsRequest) (*channeltypes.QueryUnrelayedAcknowledgementsResponse, error) {
+	return k.ChannelKeeper.UnrelayedAcknowledgements(c, req)
+}
+
 // UnreceivedPackets implements the IBC QueryServer interface
 func (k *Keeper) UnreceivedPackets(c context.Context, req *channeltypes.QueryUnreceivedPacketsRequest) (*channeltypes.QueryUnreceivedPacketsResponse, error) {
 	return k.ChannelKeeper.UnreceivedPackets(c, req)
 }
 
+// UnreceivedPacketsCount implements the IBC QueryServer interface
+func (k *Keeper) UnreceivedPacketsCount(c context.Context, req *channeltypes.QueryUnreceivedPacketsCountRequest) (*channeltypes.QueryUnreceivedPacketsCountResponse, error) {
+	return k.ChannelKeeper.UnreceivedPacketsCount(c, req)
+}
+
 // UnreceivedAcks implements the IBC QueryServer interface
 func (k *Keeper) UnreceivedAcks(c context.Context, req *channeltypes.QueryUnreceivedAcksRequest) (*channeltypes.QueryUnreceivedAcksResponse, error) {
 	return k.ChannelKeeper.UnreceivedAcks(c, req)
@@ -158,6 +203,16 @@ func (k *Keeper) NextSequenceSend(c context.Context, req *channeltypes.QueryNext
 	return k.ChannelKeeper.NextSequenceSend(c, req)
 }
 
+// ChannelSequences implements the IBC QueryServer interface
+func (k *Keeper) ChannelSequences(c context.Context, req *channeltypes.QueryChannelSequencesRequest) (*channeltypes.QueryChannelSequencesResponse, error) {
+	return k.ChannelKeeper.ChannelSequences(c, req)
+}
+
+// ChannelSendPaused implements the IBC QueryServer interface
+func (k *Keeper) ChannelSendPaused(c context.Context, req *channeltypes.QueryChannelSendPausedRequest) (*channeltypes.QueryChannelSendPausedResponse, error) {
+	return k.ChannelKeeper.ChannelSendPaused(c, req)
+}
+
 // UpgradeError implements the IBC QueryServer interface
 func (k *Keeper) UpgradeError(c context.Context, req *channeltypes.QueryUpgradeErrorRequest) (*channeltypes.QueryUpgradeErrorResponse, error) {
 	return k.ChannelKeeper.UpgradeErrorReceipt(c, req)
@@ -168,6 +223,11 @@ func (k *Keeper) Upgrade(c context.Context, req *channeltypes.QueryUpgradeReques
 	return k.ChannelKeeper.Upgrade(c, req)
 }
 
+// ChannelUpgradeInfo implements the IBC QueryServer interface
+func (k *Keeper) ChannelUpgradeInfo(c context.Context, req *channeltypes.QueryChannelUpgradeInfoRequest) (*channeltypes.QueryChannelUpgradeInfoResponse, error) {
+	return k.ChannelKeeper.ChannelUpgradeInfo(c, req)
+}
+
 // ChannelParams implements the IBC QueryServer interface
 func (k *Keeper) ChannelParams(c context.Context, req *channeltypes.QueryChannelParamsRequest) (*channeltypes.QueryChannelParamsResponse, error) {
 	return k.ChannelKeeper.ChannelParams(c, req)
@@ -7,6 +7,7 @@ import (
 	metrics "github.com/hashicorp/go-metrics"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +18,7 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	ibcerrors "github.com/cosmos/ibc-go/v8/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 	coretypes "github.com/cosmos/ibc-go/v8/modules/core/types"
 )
 
@@ -40,7 +42,17 @@ func (k *Keeper) CreateClient(goCtx context.Context, msg *clienttypes.MsgCreateC
 		return nil, err
 	}
 
-	if _, err = k.ClientKeeper.CreateClient(ctx, clientState.ClientType(), msg.ClientState.Value, msg.ConsensusState.Value); err != nil {
+	clientID, err := k.ClientKeeper.CreateClientWithSeedConsensusStates(ctx, clientState.ClientType(), msg.ClientState.Value, msg.ConsensusState.Value, msg.SeedConsensusStates)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := k.ClientKeeper.EscrowClientDeposit(ctx, clientID, signer); err != nil {
 		return nil, err
 	}
 
@@ -63,6 +75,25 @@ func (k *Keeper) UpdateClient(goCtx context.Context, msg *clienttypes.MsgUpdateC
 	return &clienttypes.MsgUpdateClientResponse{}, nil
 }
 
+// UpdateClientBatch defines a rpc handler method for MsgUpdateClientBatch.
+// The client updates are processed in order and processing stops at the first failing update.
+func (k *Keeper) UpdateClientBatch(goCtx context.Context, msg *clienttypes.MsgUpdateClientBatch) (*clienttypes.MsgUpdateClientBatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for i, update := range msg.Updates {
+		clientMsg, err := clienttypes.UnpackClientMessage(update.ClientMessage)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "client update %d for client %s", i, update.ClientId)
+		}
+
+		if err = k.ClientKeeper.UpdateClient(ctx, update.ClientId, clientMsg); err != nil {
+			return nil, errorsmod.Wrapf(err, "client update %d for client %s", i, update.ClientId)
+		}
+	}
+
+	return &clienttypes.MsgUpdateClientBatchResponse{}, nil
+}
+
 // UpgradeClient defines a rpc handler method for MsgUpgradeClient.
 // NOTE: The raw bytes of the concrete types encoded into protobuf.Any is passed to the client keeper.
 // The 02-client handler will route to the appropriate light client module based on client identifier and it is the responsibility
@@ -110,13 +141,27 @@ func (k *Keeper) RecoverClient(goCtx context.Context, msg *clienttypes.MsgRecove
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if err := k.ClientKeeper.RecoverClient(ctx, msg.SubjectClientId, msg.SubstituteClientId); err != nil {
+	if err := k.ClientKeeper.RecoverClient(ctx, msg.SubjectClientId, msg.SubstituteClientId, msg.TrustedHeights); err != nil {
 		return nil, errorsmod.Wrap(err, "client recovery failed")
 	}
 
 	return &clienttypes.MsgRecoverClientResponse{}, nil
 }
 
+// RecoverClients defines a rpc handler method for MsgRecoverClients.
+func (k *Keeper) RecoverClients(goCtx context.Context, msg *clienttypes.MsgRecoverClients) (*clienttypes.MsgRecoverClientsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ClientKeeper.RecoverClients(ctx, msg.Recoveries); err != nil {
+		return nil, errorsmod.Wrap(err, "client recovery failed")
+	}
+
+	return &clienttypes.MsgRecoverClientsResponse{}, nil
+}
+
 // IBCSoftwareUpgrade defines a rpc handler method for MsgIBCSoftwareUpgrade.
 func (k *Keeper) IBCSoftwareUpgrade(goCtx context.Context, msg *clienttypes.MsgIBCSoftwareUpgrade) (*clienttypes.MsgIBCSoftwareUpgradeResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -480,25 +525,53 @@ func (k *Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPack
 	case nil:
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
-		// no-ops do not need event emission as they will be ignored
+		// the packet has already been received, application callbacks are skipped
 		ctx.Logger().Debug("no-op on redundant relay", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
+		keeper.EmitPacketAlreadyReceivedEvent(ctx, msg.Packet)
 		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.NOOP}, nil
+	case channeltypes.ErrTimeoutReceiptWritten:
+		// a timed out packet of an ORDERED_ALLOW_TIMEOUT channel is skipped, application callbacks are not executed
+		writeFn()
+		ctx.Logger().Info("receive packet skipped as packet timed out", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
+		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
 	default:
 		ctx.Logger().Error("receive packet failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "receive packet verification failed"))
 		return nil, errorsmod.Wrap(err, "receive packet verification failed")
 	}
 
-	// Perform application logic callback
-	//
-	// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
-	cacheCtx, writeFn = ctx.CacheContext()
-	ack := cbs.OnRecvPacket(cacheCtx, msg.Packet, relayer)
-	if ack == nil || ack.Success() {
-		// write application state changes for asynchronous and successful acknowledgements
-		writeFn()
+	var ack ibcexported.Acknowledgement
+	params := k.ChannelKeeper.GetParams(ctx)
+	if err := params.ValidatePacketDataSize(msg.Packet.Data); err != nil {
+		// A packet with data exceeding the maximum packet data size is not passed to the application,
+		// an error acknowledgement is written instead
+		ctx.Logger().Info("receive packet skipped as packet data is too large", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", err)
+		ack = channeltypes.NewErrorAcknowledgementWithCode(err)
 	} else {
-		// Modify events in cached context to reflect unsuccessful acknowledgement
-		ctx.EventManager().EmitEvents(convertToErrorEvents(cacheCtx.EventManager().Events()))
+		// Perform application logic callback
+		//
+		// Cache context so that we may discard state changes from callback if the acknowledgement is unsuccessful.
+		cacheCtx, writeFn = ctx.CacheContext()
+
+		var panicked bool
+		if params.EnablePanicIsolation {
+			ack, panicked = recvPacketWithPanicRecovery(cacheCtx, cbs, msg.Packet, relayer)
+		} else {
+			ack = cbs.OnRecvPacket(cacheCtx, msg.Packet, relayer)
+		}
+
+		switch {
+		case panicked:
+			// discard application state changes and events, the panic is answered with an error acknowledgement
+			// so that the packet is rejected deterministically and ordered channels may progress
+			keeper.EmitPanicInApplicationEvent(ctx, msg.Packet)
+			ack = channeltypes.NewErrorAcknowledgementWithCode(channeltypes.ErrPanicInApplication)
+		case ack == nil || ack.Success():
+			// write application state changes for asynchronous and successful acknowledgements
+			writeFn()
+		default:
+			// Modify events in cached context to reflect unsuccessful acknowledgement
+			ctx.EventManager().EmitEvents(convertToErrorEvents(cacheCtx.EventManager().Events()))
+		}
 	}
 
 	// Set packet acknowledgement only if the acknowledgement is not nil.
@@ -1111,12 +1184,54 @@ func (k *Keeper) UpdateClientParams(goCtx context.Context, msg *clienttypes.MsgU
 		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
 	}
 
+	if !msg.Params.ClientCreationDeposit.IsZero() && !k.ClientKeeper.IsClientDepositSupported() {
+		return nil, errorsmod.Wrap(clienttypes.ErrInvalidClientDeposit, "client creation deposits are not supported by the application")
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	k.ClientKeeper.SetParams(ctx, msg.Params)
 
 	return &clienttypes.MsgUpdateParamsResponse{}, nil
 }
 
+// SetClientAlias defines a rpc handler method for MsgSetClientAlias.
+func (k *Keeper) SetClientAlias(goCtx context.Context, msg *clienttypes.MsgSetClientAlias) (*clienttypes.MsgSetClientAliasResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ClientKeeper.SetClientAlias(ctx, msg.ClientId, msg.Alias); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to set client alias")
+	}
+
+	return &clienttypes.MsgSetClientAliasResponse{}, nil
+}
+
+// ReclaimClientDeposit defines a rpc handler method for MsgReclaimClientDeposit.
+func (k *Keeper) ReclaimClientDeposit(goCtx context.Context, msg *clienttypes.MsgReclaimClientDeposit) (*clienttypes.MsgReclaimClientDepositResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ClientKeeper.ReclaimClientDeposit(ctx, msg.ClientId, msg.Signer); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to reclaim client deposit")
+	}
+
+	return &clienttypes.MsgReclaimClientDepositResponse{}, nil
+}
+
+// FreezeClient defines a rpc handler method for MsgFreezeClient.
+func (k *Keeper) FreezeClient(goCtx context.Context, msg *clienttypes.MsgFreezeClient) (*clienttypes.MsgFreezeClientResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ClientKeeper.FreezeClient(ctx, msg.ClientId); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to freeze client")
+	}
+
+	return &clienttypes.MsgFreezeClientResponse{}, nil
+}
+
 // UpdateConnectionParams defines a rpc handler method for MsgUpdateParams for the 03-connection submodule.
 func (k *Keeper) UpdateConnectionParams(goCtx context.Context, msg *connectiontypes.MsgUpdateParams) (*connectiontypes.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -1141,6 +1256,39 @@ func (k *Keeper) UpdateChannelParams(goCtx context.Context, msg *channeltypes.Ms
 	return &channeltypes.MsgUpdateParamsResponse{}, nil
 }
 
+// SetChannelSendPaused defines a rpc handler method for MsgSetChannelSendPaused.
+func (k *Keeper) SetChannelSendPaused(goCtx context.Context, msg *channeltypes.MsgSetChannelSendPaused) (*channeltypes.MsgSetChannelSendPausedResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.ChannelKeeper.SetChannelSendPaused(ctx, msg.PortId, msg.ChannelId, msg.Paused); err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgSetChannelSendPausedResponse{}, nil
+}
+
+// recvPacketWithPanicRecovery executes the OnRecvPacket callback of the application and recovers from a panic
+// in the callback, which is reported by returning true. Out of gas panics are not recovered, as the transaction
+// must be aborted to preserve gas accounting. The panic message is only logged, as it is not deterministic.
+func recvPacketWithPanicRecovery(ctx sdk.Context, cbs porttypes.IBCModule, packet channeltypes.Packet, relayer sdk.AccAddress) (ack ibcexported.Acknowledgement, panicked bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			switch r.(type) {
+			case storetypes.ErrorOutOfGas, storetypes.ErrorGasOverflow:
+				panic(r)
+			}
+
+			ctx.Logger().Error("recovered panic in receive packet callback", "port-id", packet.DestinationPort, "channel-id", packet.DestinationChannel, "sequence", packet.Sequence, "panic", r)
+			ack, panicked = nil, true
+		}
+	}()
+
+	return cbs.OnRecvPacket(ctx, packet, relayer), false
+}
+
 // convertToErrorEvents converts all events to error events by appending the
 // error attribute prefix to each event's attribute key.
 func convertToErrorEvents(events sdk.Events) sdk.Events {
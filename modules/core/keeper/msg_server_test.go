@@ -3,11 +3,16 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
@@ -222,6 +227,314 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 	}
 }
 
+// tests the IBC handler receiving a timed out packet on an ORDERED_ALLOW_TIMEOUT channel. The packet is skipped,
+// a timeout receipt is written and the application callbacks are not executed.
+func (suite *KeeperTestSuite) TestHandleRecvPacketOrderedAllowTimeout() {
+	suite.SetupTest()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrderedAllowTimeout()
+	path.Setup()
+
+	// send a packet which times out at the current height of chainB
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+	proof, proofHeight := path.EndpointA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+	ctx := suite.chainB.GetContext()
+	res, err := suite.chainB.App.GetIBCKeeper().RecvPacket(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.SUCCESS, res.Result)
+
+	// the timeout receipt and recv packet event are written, the application callbacks are not executed
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+	suite.Require().True(channelKeeper.HasPacketTimeoutReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), sequence))
+	suite.Require().False(channelKeeper.HasPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), sequence))
+
+	_, exists := suite.chainB.GetSimApp().ScopedIBCMockKeeper.GetCapability(suite.chainB.GetContext(), ibcmock.GetMockRecvCanaryCapabilityName(packet))
+	suite.Require().False(exists)
+
+	events := ctx.EventManager().Events()
+	suite.Require().NotContains(events, ibcmock.NewMockRecvPacketEvent())
+	suite.Require().True(slices.ContainsFunc(events, func(event sdk.Event) bool { return event.Type == channeltypes.EventTypeRecvPacket }))
+
+	// the replayed packet is a no-op
+	res, err = suite.chainB.App.GetIBCKeeper().RecvPacket(suite.chainB.GetContext(), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NOOP, res.Result)
+}
+
+// tests the IBC handler receiving packets with data at and above the maximum packet data size of the receiving chain.
+// Packets exceeding the maximum packet data size are not passed to the application and an error acknowledgement
+// is written instead.
+func (suite *KeeperTestSuite) TestHandleRecvPacketMaxPacketDataSize() {
+	testCases := []struct {
+		name              string
+		maxPacketDataSize uint64
+		expTooLarge       bool
+	}{
+		{"unlimited packet data size", 0, false},
+		{"packet data at maximum packet data size", uint64(len(ibctesting.MockPacketData)), false},
+		{"packet data exceeds maximum packet data size", uint64(len(ibctesting.MockPacketData) - 1), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+			// only the receiving chain limits the packet data size
+			channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+			params := channelKeeper.GetParams(suite.chainB.GetContext())
+			params.MaxPacketDataSize = tc.maxPacketDataSize
+			channelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			proof, proofHeight := path.EndpointA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+			msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+			ctx := suite.chainB.GetContext()
+			res, err := suite.chainB.App.GetIBCKeeper().RecvPacket(ctx, msg)
+			suite.Require().NoError(err)
+			suite.Require().Equal(channeltypes.SUCCESS, res.Result)
+
+			_, received := channelKeeper.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			suite.Require().True(received)
+
+			_, executed := suite.chainB.GetSimApp().ScopedIBCMockKeeper.GetCapability(ctx, ibcmock.GetMockRecvCanaryCapabilityName(packet))
+			suite.Require().Equal(!tc.expTooLarge, executed)
+
+			expAck := ibcmock.MockAcknowledgement.Acknowledgement()
+			if tc.expTooLarge {
+				expAck = channeltypes.NewErrorAcknowledgementWithCode(channeltypes.ErrPacketDataTooLarge).Acknowledgement()
+			}
+
+			ack, found := channelKeeper.GetPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			suite.Require().True(found)
+			suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck), ack)
+		})
+	}
+}
+
+// tests the IBC handler receiving packets on which the application panics. With panic isolation enabled the panic
+// is recovered and answered with an error acknowledgement, discarding the state changes of the application, such that
+// ordered channels may progress. Out of gas panics are never recovered.
+func (suite *KeeperTestSuite) TestHandleRecvPacketPanicIsolation() {
+	var (
+		path       *ibctesting.Path
+		panicValue interface{}
+	)
+
+	testCases := []struct {
+		name           string
+		malleate       func()
+		panicIsolation bool
+		expPanic       bool
+	}{
+		{
+			"success: panic is recovered on UNORDERED channel",
+			func() {},
+			true,
+			false,
+		},
+		{
+			"success: panic is recovered on ORDERED channel",
+			func() {
+				path.SetChannelOrdered()
+			},
+			true,
+			false,
+		},
+		{
+			"failure: panic isolation is disabled",
+			func() {},
+			false,
+			true,
+		},
+		{
+			"failure: out of gas panic is not recovered",
+			func() {
+				panicValue = storetypes.ErrorOutOfGas{Descriptor: "receive packet"}
+			},
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			panicValue = "application panic"
+
+			tc.malleate()
+
+			path.Setup()
+
+			channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+			params := channelKeeper.GetParams(suite.chainB.GetContext())
+			params.EnablePanicIsolation = tc.panicIsolation
+			channelKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			// the application writes state before panicking
+			mockApp := suite.chainB.GetSimApp().IBCMockModule.IBCApp
+			mockApp.OnRecvPacket = func(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+				_, err := mockApp.ScopedKeeper.NewCapability(ctx, ibcmock.GetMockRecvCanaryCapabilityName(packet))
+				suite.Require().NoError(err)
+
+				panic(panicValue)
+			}
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			proof, proofHeight := path.EndpointA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+			msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+			ctx := suite.chainB.GetContext()
+			if tc.expPanic {
+				suite.Require().PanicsWithValue(panicValue, func() {
+					_, _ = suite.chainB.App.GetIBCKeeper().RecvPacket(ctx, msg)
+				})
+				return
+			}
+
+			res, err := suite.chainB.App.GetIBCKeeper().RecvPacket(ctx, msg)
+			suite.Require().NoError(err)
+			suite.Require().Equal(channeltypes.SUCCESS, res.Result)
+
+			// the state changes of the application are discarded
+			_, found := suite.chainB.GetSimApp().ScopedIBCMockKeeper.GetCapability(ctx, ibcmock.GetMockRecvCanaryCapabilityName(packet))
+			suite.Require().False(found)
+
+			expAck := channeltypes.NewErrorAcknowledgementWithCode(channeltypes.ErrPanicInApplication).Acknowledgement()
+			ack, found := channelKeeper.GetPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			suite.Require().True(found)
+			suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck), ack)
+
+			if path.EndpointB.ChannelConfig.Order == channeltypes.ORDERED {
+				nextSequenceRecv, found := channelKeeper.GetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel())
+				suite.Require().True(found)
+				suite.Require().Equal(packet.GetSequence()+1, nextSequenceRecv)
+			} else {
+				_, received := channelKeeper.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().True(received)
+			}
+
+			expEvent := abci.Event{
+				Type: channeltypes.EventTypePanicInApplication,
+				Attributes: []abci.EventAttribute{
+					{Key: channeltypes.AttributeKeySequence, Value: fmt.Sprintf("%d", packet.GetSequence())},
+					{Key: channeltypes.AttributeKeySrcPort, Value: packet.GetSourcePort()},
+					{Key: channeltypes.AttributeKeySrcChannel, Value: packet.GetSourceChannel()},
+					{Key: channeltypes.AttributeKeyDstPort, Value: packet.GetDestPort()},
+					{Key: channeltypes.AttributeKeyDstChannel, Value: packet.GetDestChannel()},
+				},
+			}
+			suite.Require().Contains(ctx.EventManager().ABCIEvents(), expEvent)
+		})
+	}
+}
+
+// tests the IBC handler receiving a batch of packets in which one packet has already been received.
+// The redundant packet is treated as a no-op and does not prevent the remaining packets in the batch
+// from being received.
+func (suite *KeeperTestSuite) TestHandleRecvPacketBatch() {
+	testCases := []struct {
+		name         string
+		order        channeltypes.Order
+		redundantIdx int
+	}{
+		{"ORDERED: first packet already received", channeltypes.ORDERED, 0},
+		{"UNORDERED: first packet already received", channeltypes.UNORDERED, 0},
+		{"UNORDERED: middle packet already received", channeltypes.UNORDERED, 1},
+		{"UNORDERED: last packet already received", channeltypes.UNORDERED, 2},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			if tc.order == channeltypes.ORDERED {
+				path.SetChannelOrdered()
+			}
+			path.Setup()
+
+			var packets []channeltypes.Packet
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packets = append(packets, channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0))
+			}
+
+			// relay the redundant packet ahead of the batch
+			err := path.EndpointB.RecvPacket(packets[tc.redundantIdx])
+			suite.Require().NoError(err)
+
+			// process the batch of packets as the messages of a single transaction
+			ctx := suite.chainB.GetContext()
+			for i, packet := range packets {
+				packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+
+				msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+				res, err := suite.chainB.App.GetIBCKeeper().RecvPacket(ctx, msg)
+				suite.Require().NoError(err)
+
+				if i == tc.redundantIdx {
+					suite.Require().Equal(channeltypes.NOOP, res.Result)
+				} else {
+					suite.Require().Equal(channeltypes.SUCCESS, res.Result)
+				}
+
+				ack, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().True(found)
+				suite.Require().NotNil(ack)
+			}
+
+			// application callbacks are only executed for the fresh packets
+			var alreadyReceivedEvents, recvCallbackEvents int
+			for _, event := range ctx.EventManager().Events() {
+				switch event.Type {
+				case channeltypes.EventTypePacketAlreadyReceived:
+					alreadyReceivedEvents++
+					suite.Require().Contains(event.Attributes, abci.EventAttribute{Key: channeltypes.AttributeKeySequence, Value: fmt.Sprintf("%d", packets[tc.redundantIdx].GetSequence())})
+				case ibcmock.NewMockRecvPacketEvent().Type:
+					recvCallbackEvents++
+				}
+			}
+
+			suite.Require().Equal(1, alreadyReceivedEvents)
+			suite.Require().Equal(len(packets)-1, recvCallbackEvents)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestRecoverClient() {
 	var msg *clienttypes.MsgRecoverClient
 
@@ -299,6 +612,235 @@ func (suite *KeeperTestSuite) TestRecoverClient() {
 	}
 }
 
+// tests the IBC handler recovering multiple clients atomically.
+func (suite *KeeperTestSuite) TestRecoverClients() {
+	var (
+		msg             *clienttypes.MsgRecoverClients
+		substitutePaths []*ibctesting.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: recover clients",
+			func() {},
+			nil,
+		},
+		{
+			"signer doesn't match authority",
+			func() {
+				msg.Signer = ibctesting.InvalidID
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: substitute client state does not match subject client state",
+			func() {
+				substituteClientState, ok := substitutePaths[1].EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				substituteClientState.MaxClockDrift += time.Minute
+				substitutePaths[1].EndpointA.SetClientState(substituteClientState)
+			},
+			clienttypes.ErrInvalidSubstitute,
+		},
+		{
+			"failure: invalid subject client",
+			func() {
+				msg.Recoveries[1].SubjectClientId = ibctesting.InvalidID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			var (
+				subjectPaths []*ibctesting.Path
+				recoveries   []clienttypes.ClientRecovery
+			)
+			substitutePaths = nil
+
+			for i := 0; i < 2; i++ {
+				subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+				subjectPath.SetupClients()
+
+				substitutePath := ibctesting.NewPath(suite.chainA, suite.chainB)
+				substitutePath.SetupClients()
+
+				// update substitute twice
+				err := substitutePath.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+				err = substitutePath.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				tmClientState, ok := subjectPath.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				tmClientState.FrozenHeight = tmClientState.LatestHeight
+				subjectPath.EndpointA.SetClientState(tmClientState)
+
+				subjectPaths = append(subjectPaths, subjectPath)
+				substitutePaths = append(substitutePaths, substitutePath)
+				recoveries = append(recoveries, clienttypes.NewClientRecovery(subjectPath.EndpointA.ClientID, substitutePath.EndpointA.ClientID, nil))
+			}
+
+			msg = clienttypes.NewMsgRecoverClients(suite.chainA.App.GetIBCKeeper().GetAuthority(), recoveries)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.App.GetIBCKeeper().RecoverClients(ctx, msg)
+
+			expStatus := exported.Active
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+
+				// a recover client event is emitted for each recovered client
+				var recoveredClients []string
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == clienttypes.EventTypeRecoverClient {
+						attr, found := event.GetAttribute(clienttypes.AttributeKeySubjectClientID)
+						suite.Require().True(found)
+						recoveredClients = append(recoveredClients, attr.Value)
+					}
+				}
+				suite.Require().Equal([]string{subjectPaths[0].EndpointA.ClientID, subjectPaths[1].EndpointA.ClientID}, recoveredClients)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
+				if !errors.Is(tc.expErr, ibcerrors.ErrUnauthorized) {
+					suite.Require().ErrorContains(err, "client recovery 1")
+				}
+				expStatus = exported.Frozen
+			}
+
+			// either all subject clients are recovered or none of them are
+			for _, subjectPath := range subjectPaths {
+				status := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientStatus(suite.chainA.GetContext(), subjectPath.EndpointA.ClientID)
+				suite.Require().Equal(expStatus, status)
+			}
+		})
+	}
+}
+
+// tests the IBC handler updating multiple clients with a batch of client updates.
+func (suite *KeeperTestSuite) TestUpdateClientBatch() {
+	var (
+		paths   []*ibctesting.Path
+		updates []clienttypes.ClientUpdate
+	)
+
+	// clientUpdate returns an update of the client of the provided path to the latest committed header of chainB
+	clientUpdate := func(path *ibctesting.Path) clienttypes.ClientUpdate {
+		trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+		suite.Require().True(ok)
+
+		// copy the latest committed header, as the trusted height and validators are set on the provided header
+		latestHeader := *suite.chainB.LatestCommittedHeader
+		header, err := suite.chainB.IBCClientHeader(&latestHeader, trustedHeight)
+		suite.Require().NoError(err)
+
+		update, err := clienttypes.NewClientUpdate(path.EndpointA.ClientID, header)
+		suite.Require().NoError(err)
+
+		return update
+	}
+
+	testCases := []struct {
+		name             string
+		malleate         func()
+		expUpdated       []bool // whether the client of each path is expected to be updated
+		expFailingUpdate int    // index of the failing client update, -1 if all updates succeed
+	}{
+		{
+			"success: single client update",
+			func() {
+				updates = []clienttypes.ClientUpdate{clientUpdate(paths[0])}
+			},
+			[]bool{true, false, false},
+			-1,
+		},
+		{
+			"success: all clients updated",
+			func() {
+				updates = []clienttypes.ClientUpdate{clientUpdate(paths[0]), clientUpdate(paths[1]), clientUpdate(paths[2])}
+			},
+			[]bool{true, true, true},
+			-1,
+		},
+		{
+			"failure: client not found stops processing",
+			func() {
+				notFound := clientUpdate(paths[1])
+				notFound.ClientId = ibctesting.InvalidID
+
+				updates = []clienttypes.ClientUpdate{clientUpdate(paths[0]), notFound, clientUpdate(paths[2])}
+			},
+			[]bool{true, false, false},
+			1,
+		},
+		{
+			"failure: invalid client message stops processing",
+			func() {
+				invalid, err := clienttypes.NewClientUpdate(paths[1].EndpointA.ClientID, &ibctm.Header{})
+				suite.Require().NoError(err)
+
+				updates = []clienttypes.ClientUpdate{clientUpdate(paths[0]), invalid, clientUpdate(paths[2])}
+			},
+			[]bool{true, false, false},
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			paths = nil
+			for i := 0; i < 3; i++ {
+				path := ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetupClients()
+				paths = append(paths, path)
+			}
+
+			suite.coordinator.CommitBlock(suite.chainB)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			clientKeeper := suite.chainA.App.GetIBCKeeper().ClientKeeper
+
+			var preHeights []exported.Height
+			for _, path := range paths {
+				preHeights = append(preHeights, clientKeeper.GetClientLatestHeight(ctx, path.EndpointA.ClientID))
+			}
+
+			msg := clienttypes.NewMsgUpdateClientBatch(updates, suite.chainA.SenderAccount.GetAddress().String())
+			_, err := suite.chainA.App.GetIBCKeeper().UpdateClientBatch(ctx, msg)
+
+			if tc.expFailingUpdate < 0 {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorContains(err, fmt.Sprintf("client update %d for client %s", tc.expFailingUpdate, updates[tc.expFailingUpdate].ClientId))
+			}
+
+			for i, path := range paths {
+				latestHeight := clientKeeper.GetClientLatestHeight(ctx, path.EndpointA.ClientID)
+				suite.Require().Equal(tc.expUpdated[i], latestHeight.GT(preHeights[i]), "client %s", path.EndpointA.ClientID)
+			}
+		})
+	}
+}
+
 // tests the IBC handler acknowledgement of a packet on ordered and unordered
 // channels. It verifies that the deletion of packet commitments from state
 // occurs. It test high level properties like ordering and basic sanity
@@ -2438,183 +2980,457 @@ func (suite *KeeperTestSuite) TestChannelUpgradeTimeout() {
 			func() {
 				timeoutUpgrade()
 
-				suite.Require().NoError(path.EndpointA.UpdateClient())
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				_, _, proofHeight := path.EndpointB.QueryChannelUpgradeProof()
+
+				msg.ProofHeight = proofHeight
+				msg.ProofChannel = []byte("invalid proof")
+			},
+			func(res *channeltypes.MsgChannelUpgradeTimeoutResponse, events []abci.Event, err error) {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, commitmenttypes.ErrInvalidProof)
+
+				channel := path.EndpointA.GetChannel()
+				suite.Require().Equalf(channeltypes.FLUSHCOMPLETE, channel.State, "channel state should be %s", channeltypes.OPEN)
+				suite.Require().Equal(uint64(1), channel.UpgradeSequence, "channel upgrade sequence should not incremented")
+
+				_, found := path.EndpointA.Chain.GetSimApp().IBCKeeper.ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found, "channel upgrade should not be nil")
+
+				suite.Require().Empty(events)
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
+			suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+			suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+
+			channelKey := host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			channelProof, proofHeight := path.EndpointB.QueryProof(channelKey)
+
+			msg = &channeltypes.MsgChannelUpgradeTimeout{
+				PortId:              path.EndpointA.ChannelConfig.PortID,
+				ChannelId:           path.EndpointA.ChannelID,
+				CounterpartyChannel: path.EndpointB.GetChannel(),
+				ProofChannel:        channelProof,
+				ProofHeight:         proofHeight,
+				Signer:              suite.chainA.SenderAccount.GetAddress().String(),
+			}
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().GetIBCKeeper().ChannelUpgradeTimeout(ctx, msg)
+			events := ctx.EventManager().Events().ToABCIEvents()
+
+			tc.expResult(res, events, err)
+		})
+	}
+}
+
+// TestIBCSoftwareUpgrade tests the IBCSoftwareUpgrade rpc handler
+func (suite *KeeperTestSuite) TestIBCSoftwareUpgrade() {
+	var (
+		msg                 *clienttypes.MsgIBCSoftwareUpgrade
+		upgradedClientState *ibctm.ClientState
+	)
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: valid authority and client upgrade",
+			func() {},
+			nil,
+		},
+		{
+			"failure: invalid authority address",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: invalid clientState",
+			func() {
+				msg.UpgradedClientState = nil
+			},
+			clienttypes.ErrInvalidClientType,
+		},
+		{
+			"failure: non-zeroed trusting period",
+			func() {
+				upgradedClientState.TrustingPeriod = time.Hour
+
+				var err error
+				msg.UpgradedClientState, err = clienttypes.PackClientState(upgradedClientState)
+				suite.Require().NoError(err)
+			},
+			clienttypes.ErrInvalidUpgradeClient,
+		},
+		{
+			"failure: unbonding period does not match staking parameters",
+			func() {
+				upgradedClientState.UnbondingPeriod += time.Hour
+
+				var err error
+				msg.UpgradedClientState, err = clienttypes.PackClientState(upgradedClientState)
+				suite.Require().NoError(err)
+			},
+			clienttypes.ErrInvalidUpgradeClient,
+		},
+		{
+			"failure: failed to schedule client upgrade",
+			func() {
+				msg.Plan.Height = 0
+			},
+			sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+			validAuthority := suite.chainA.App.GetIBCKeeper().GetAuthority()
+			plan := upgradetypes.Plan{
+				Name:   "upgrade IBC clients",
+				Height: 1000,
+			}
+			clientState, ok := path.EndpointB.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			upgradedClientState = clientState.ZeroCustomFields()
+
+			var err error
+			msg, err = clienttypes.NewMsgIBCSoftwareUpgrade(
+				validAuthority,
+				plan,
+				upgradedClientState,
+			)
+
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			_, err = suite.chainA.App.GetIBCKeeper().IBCSoftwareUpgrade(suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				// upgrade plan is stored
+				storedPlan, err := suite.chainA.GetSimApp().UpgradeKeeper.GetUpgradePlan(suite.chainA.GetContext())
+				suite.Require().NoError(err)
+				suite.Require().Equal(plan, storedPlan)
+
+				// upgraded client state is stored
+				bz, err := suite.chainA.GetSimApp().UpgradeKeeper.GetUpgradedClient(suite.chainA.GetContext(), plan.Height)
+				suite.Require().NoError(err)
+				upgradedClientState, err := clienttypes.UnmarshalClientState(suite.chainA.App.AppCodec(), bz)
+				suite.Require().NoError(err)
+				suite.Require().Equal(clientState.ZeroCustomFields(), upgradedClientState)
+			} else {
+				suite.Require().True(errors.Is(err, tc.expError))
+			}
+		})
+	}
+}
+
+// TestUpdateClientParams tests the UpdateClientParams rpc handler
+func (suite *KeeperTestSuite) TestUpdateClientParams() {
+	signer := suite.chainA.App.GetIBCKeeper().GetAuthority()
+	testCases := []struct {
+		name    string
+		msg     *clienttypes.MsgUpdateParams
+		expPass bool
+	}{
+		{
+			"success: valid signer and default params",
+			clienttypes.NewMsgUpdateParams(signer, clienttypes.DefaultParams()),
+			true,
+		},
+		{
+			"failure: malformed signer address",
+			clienttypes.NewMsgUpdateParams(ibctesting.InvalidID, clienttypes.DefaultParams()),
+			false,
+		},
+		{
+			"failure: empty signer address",
+			clienttypes.NewMsgUpdateParams("", clienttypes.DefaultParams()),
+			false,
+		},
+		{
+			"failure: whitespace signer address",
+			clienttypes.NewMsgUpdateParams("    ", clienttypes.DefaultParams()),
+			false,
+		},
+		{
+			"failure: unauthorized signer address",
+			clienttypes.NewMsgUpdateParams(ibctesting.TestAccAddress, clienttypes.DefaultParams()),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			_, err := suite.chainA.App.GetIBCKeeper().UpdateClientParams(suite.chainA.GetContext(), tc.msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				p := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
+				suite.Require().Equal(tc.msg.Params, p)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestSetClientAlias tests the SetClientAlias rpc handler
+func (suite *KeeperTestSuite) TestSetClientAlias() {
+	var msg *clienttypes.MsgSetClientAlias
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: valid authority",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer address",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: client not found",
+			func() {
+				msg.ClientId = ibctesting.InvalidID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			msg = clienttypes.NewMsgSetClientAlias(suite.chainA.App.GetIBCKeeper().GetAuthority(), path.EndpointA.ClientID, "cosmoshub")
 
-				_, _, proofHeight := path.EndpointB.QueryChannelUpgradeProof()
+			tc.malleate()
 
-				msg.ProofHeight = proofHeight
-				msg.ProofChannel = []byte("invalid proof")
-			},
-			func(res *channeltypes.MsgChannelUpgradeTimeoutResponse, events []abci.Event, err error) {
-				suite.Require().Error(err)
-				suite.Require().ErrorIs(err, commitmenttypes.ErrInvalidProof)
+			_, err := suite.chainA.App.GetIBCKeeper().SetClientAlias(suite.chainA.GetContext(), msg)
 
-				channel := path.EndpointA.GetChannel()
-				suite.Require().Equalf(channeltypes.FLUSHCOMPLETE, channel.State, "channel state should be %s", channeltypes.OPEN)
-				suite.Require().Equal(uint64(1), channel.UpgradeSequence, "channel upgrade sequence should not incremented")
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
 
-				_, found := path.EndpointA.Chain.GetSimApp().IBCKeeper.ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
-				suite.Require().True(found, "channel upgrade should not be nil")
+				alias, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientAlias(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.Alias, alias)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
 
-				suite.Require().Empty(events)
+// TestCreateClientDeposit tests that the CreateClient rpc handler escrows the client creation deposit from the signer
+func (suite *KeeperTestSuite) TestCreateClientDeposit() {
+	var deposit sdk.Coins
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: client creation deposit escrowed",
+			func() {},
+			nil,
+		},
+		{
+			"success: no client creation deposit",
+			func() {
+				deposit = sdk.NewCoins()
+			},
+			nil,
+		},
+		{
+			"failure: insufficient funds",
+			func() {
+				deposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntWithDecimal(1, 40)))
 			},
+			sdkerrors.ErrInsufficientFunds,
 		},
 	}
 
-	for _, tc := range cases {
+	for _, tc := range testCases {
 		tc := tc
 		suite.Run(tc.name, func() {
 			suite.SetupTest()
 
-			path = ibctesting.NewPath(suite.chainA, suite.chainB)
-			path.Setup()
-
-			path.EndpointA.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
-			path.EndpointB.ChannelConfig.ProposedUpgrade.Fields.Version = ibcmock.UpgradeVersion
+			deposit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
 
-			suite.Require().NoError(path.EndpointA.ChanUpgradeInit())
-			suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
-			suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+			tc.malleate()
 
-			channelKey := host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
-			channelProof, proofHeight := path.EndpointB.QueryProof(channelKey)
+			params := clienttypes.DefaultParams()
+			params.ClientCreationDeposit = deposit
+			_, err := suite.chainA.App.GetIBCKeeper().UpdateClientParams(suite.chainA.GetContext(), clienttypes.NewMsgUpdateParams(suite.chainA.App.GetIBCKeeper().GetAuthority(), params))
+			suite.Require().NoError(err)
 
-			msg = &channeltypes.MsgChannelUpgradeTimeout{
-				PortId:              path.EndpointA.ChannelConfig.PortID,
-				ChannelId:           path.EndpointA.ChannelID,
-				CounterpartyChannel: path.EndpointB.GetChannel(),
-				ProofChannel:        channelProof,
-				ProofHeight:         proofHeight,
-				Signer:              suite.chainA.SenderAccount.GetAddress().String(),
-			}
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			err = path.EndpointA.CreateClient()
 
-			tc.malleate()
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
 
-			ctx := suite.chainA.GetContext()
-			res, err := suite.chainA.GetSimApp().GetIBCKeeper().ChannelUpgradeTimeout(ctx, msg)
-			events := ctx.EventManager().Events().ToABCIEvents()
+				ctx := suite.chainA.GetContext()
+				clientDeposit, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientDeposit(ctx, path.EndpointA.ClientID)
+				suite.Require().Equal(!deposit.IsZero(), found)
+				if found {
+					suite.Require().Equal(clienttypes.NewClientDeposit(path.EndpointA.ClientID, suite.chainA.SenderAccount.GetAddress().String(), deposit), clientDeposit)
+				}
 
-			tc.expResult(res, events, err)
+				moduleBalances := suite.chainA.GetSimApp().BankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(exported.ModuleName))
+				suite.Require().Equal(deposit, moduleBalances)
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr.Error())
+			}
 		})
 	}
 }
 
-// TestIBCSoftwareUpgrade tests the IBCSoftwareUpgrade rpc handler
-func (suite *KeeperTestSuite) TestIBCSoftwareUpgrade() {
-	var msg *clienttypes.MsgIBCSoftwareUpgrade
+// TestReclaimClientDeposit tests the ReclaimClientDeposit rpc handler
+func (suite *KeeperTestSuite) TestReclaimClientDeposit() {
+	var msg *clienttypes.MsgReclaimClientDeposit
+
+	deposit := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)))
+
 	testCases := []struct {
 		name     string
 		malleate func()
-		expError error
+		expErr   error
 	}{
 		{
-			"success: valid authority and client upgrade",
+			"success: expired client",
 			func() {},
 			nil,
 		},
 		{
-			"failure: invalid authority address",
+			"failure: signer is not the depositor",
 			func() {
-				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+				msg.Signer = ibctesting.TestAccAddress
 			},
 			ibcerrors.ErrUnauthorized,
 		},
 		{
-			"failure: invalid clientState",
-			func() {
-				msg.UpgradedClientState = nil
-			},
-			clienttypes.ErrInvalidClientType,
-		},
-		{
-			"failure: failed to schedule client upgrade",
+			"failure: deposit not found",
 			func() {
-				msg.Plan.Height = 0
+				msg.ClientId = ibctesting.InvalidID
 			},
-			sdkerrors.ErrInvalidRequest,
+			clienttypes.ErrClientDepositNotFound,
 		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := clienttypes.DefaultParams()
+			params.ClientCreationDeposit = deposit
+			suite.chainA.App.GetIBCKeeper().ClientKeeper.SetParams(suite.chainA.GetContext(), params)
+
 			path := ibctesting.NewPath(suite.chainA, suite.chainB)
 			path.SetupClients()
-			validAuthority := suite.chainA.App.GetIBCKeeper().GetAuthority()
-			plan := upgradetypes.Plan{
-				Name:   "upgrade IBC clients",
-				Height: 1000,
-			}
-			// update trusting period
-			clientState, ok := path.EndpointB.GetClientState().(*ibctm.ClientState)
-			suite.Require().True(ok)
-			clientState.TrustingPeriod += 100
 
-			var err error
-			msg, err = clienttypes.NewMsgIBCSoftwareUpgrade(
-				validAuthority,
-				plan,
-				clientState,
-			)
+			suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
 
-			suite.Require().NoError(err)
+			msg = clienttypes.NewMsgReclaimClientDeposit(suite.chainA.SenderAccount.GetAddress().String(), path.EndpointA.ClientID)
 
 			tc.malleate()
 
-			_, err = suite.chainA.App.GetIBCKeeper().IBCSoftwareUpgrade(suite.chainA.GetContext(), msg)
+			_, err := suite.chainA.App.GetIBCKeeper().ReclaimClientDeposit(suite.chainA.GetContext(), msg)
 
-			if tc.expError == nil {
-				suite.Require().NoError(err)
-				// upgrade plan is stored
-				storedPlan, err := suite.chainA.GetSimApp().UpgradeKeeper.GetUpgradePlan(suite.chainA.GetContext())
+			expPass := tc.expErr == nil
+			if expPass {
 				suite.Require().NoError(err)
-				suite.Require().Equal(plan, storedPlan)
 
-				// upgraded client state is stored
-				bz, err := suite.chainA.GetSimApp().UpgradeKeeper.GetUpgradedClient(suite.chainA.GetContext(), plan.Height)
-				suite.Require().NoError(err)
-				upgradedClientState, err := clienttypes.UnmarshalClientState(suite.chainA.App.AppCodec(), bz)
-				suite.Require().NoError(err)
-				suite.Require().Equal(clientState.ZeroCustomFields(), upgradedClientState)
+				_, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetClientDeposit(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				suite.Require().False(found)
 			} else {
-				suite.Require().True(errors.Is(err, tc.expError))
+				suite.Require().ErrorIs(err, tc.expErr)
 			}
 		})
 	}
 }
 
-// TestUpdateClientParams tests the UpdateClientParams rpc handler
-func (suite *KeeperTestSuite) TestUpdateClientParams() {
-	signer := suite.chainA.App.GetIBCKeeper().GetAuthority()
+// TestFreezeClient tests the FreezeClient rpc handler
+func (suite *KeeperTestSuite) TestFreezeClient() {
+	var (
+		msg  *clienttypes.MsgFreezeClient
+		path *ibctesting.Path
+	)
+
 	testCases := []struct {
-		name    string
-		msg     *clienttypes.MsgUpdateParams
-		expPass bool
+		name     string
+		malleate func()
+		expErr   error
 	}{
 		{
-			"success: valid signer and default params",
-			clienttypes.NewMsgUpdateParams(signer, clienttypes.DefaultParams()),
-			true,
-		},
-		{
-			"failure: malformed signer address",
-			clienttypes.NewMsgUpdateParams(ibctesting.InvalidID, clienttypes.DefaultParams()),
-			false,
+			"success: freeze active client",
+			func() {},
+			nil,
 		},
 		{
-			"failure: empty signer address",
-			clienttypes.NewMsgUpdateParams("", clienttypes.DefaultParams()),
-			false,
+			"failure: signer doesn't match authority",
+			func() {
+				msg.Signer = ibctesting.InvalidID
+			},
+			ibcerrors.ErrUnauthorized,
 		},
 		{
-			"failure: whitespace signer address",
-			clienttypes.NewMsgUpdateParams("    ", clienttypes.DefaultParams()),
-			false,
+			"failure: client is already frozen",
+			func() {
+				clientState, ok := path.EndpointB.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointB.SetClientState(clientState)
+			},
+			clienttypes.ErrClientNotActive,
 		},
 		{
-			"failure: unauthorized signer address",
-			clienttypes.NewMsgUpdateParams(ibctesting.TestAccAddress, clienttypes.DefaultParams()),
-			false,
+			"failure: invalid client ID",
+			func() {
+				msg.ClientId = ibctesting.InvalidID
+			},
+			clienttypes.ErrClientNotFound,
 		},
 	}
 
@@ -2622,13 +3438,47 @@ func (suite *KeeperTestSuite) TestUpdateClientParams() {
 		tc := tc
 		suite.Run(tc.name, func() {
 			suite.SetupTest()
-			_, err := suite.chainA.App.GetIBCKeeper().UpdateClientParams(suite.chainA.GetContext(), tc.msg)
-			if tc.expPass {
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+
+			msg = clienttypes.NewMsgFreezeClient(suite.chainB.App.GetIBCKeeper().GetAuthority(), path.EndpointB.ClientID)
+
+			tc.malleate()
+
+			ctx := suite.chainB.GetContext()
+			_, err = suite.chainB.App.GetIBCKeeper().FreezeClient(ctx, msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
 				suite.Require().NoError(err)
-				p := suite.chainA.App.GetIBCKeeper().ClientKeeper.GetParams(suite.chainA.GetContext())
-				suite.Require().Equal(tc.msg.Params, p)
+
+				clientKeeper := suite.chainB.App.GetIBCKeeper().ClientKeeper
+				suite.Require().Equal(exported.Frozen, clientKeeper.GetClientStatus(suite.chainB.GetContext(), path.EndpointB.ClientID))
+
+				latestHeight := path.EndpointB.GetClientLatestHeight()
+				expEvent := sdk.NewEvent(
+					clienttypes.EventTypeFreezeClient,
+					sdk.NewAttribute(clienttypes.AttributeKeyClientID, path.EndpointB.ClientID),
+					sdk.NewAttribute(clienttypes.AttributeKeyClientType, exported.Tendermint),
+					sdk.NewAttribute(clienttypes.AttributeKeyFrozenHeight, latestHeight.String()),
+				)
+				suite.Require().Contains(ctx.EventManager().Events(), expEvent)
+
+				// verification of the packet commitment is blocked by the frozen client
+				packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+				recvMsg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+				_, err = suite.chainB.App.GetIBCKeeper().RecvPacket(suite.chainB.GetContext(), recvMsg)
+				suite.Require().ErrorIs(err, clienttypes.ErrClientNotActive)
 			} else {
-				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
 			}
 		})
 	}
@@ -2739,6 +3589,78 @@ func (suite *KeeperTestSuite) TestUpdateChannelParams() {
 	}
 }
 
+// TestSetChannelSendPaused tests the SetChannelSendPaused rpc handler
+func (suite *KeeperTestSuite) TestSetChannelSendPaused() {
+	var (
+		path *ibctesting.Path
+		msg  *channeltypes.MsgSetChannelSendPaused
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: pause channel send",
+			func() {},
+			nil,
+		},
+		{
+			"success: unpause channel send",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetChannelSendPaused(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, true)
+				suite.Require().NoError(err)
+
+				msg.Paused = false
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				msg.ChannelId = ibctesting.InvalidID
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			msg = channeltypes.NewMsgSetChannelSendPaused(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, true, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			resp, err := suite.chainA.App.GetIBCKeeper().SetChannelSendPaused(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expError == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+
+				paused := suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsChannelSendPaused(suite.chainA.GetContext(), msg.PortId, msg.ChannelId)
+				suite.Require().Equal(msg.Paused, paused)
+			} else {
+				suite.Require().Nil(resp)
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
 	var msg *channeltypes.MsgPruneAcknowledgements
 
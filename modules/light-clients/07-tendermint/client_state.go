@@ -72,7 +72,9 @@ func (ClientState) GetTimestampAtHeight(
 // The client may be:
 // - Active: FrozenHeight is zero and client is not expired
 // - Frozen: Frozen Height is not zero
-// - Expired: the latest consensus state timestamp + trusting period <= current time
+// - ExpiredGrace: the client is expired, but the latest consensus state timestamp + trusting period
+// + expiry grace period > current time
+// - Expired: the latest consensus state timestamp + trusting period (+ expiry grace period) <= current time
 //
 // A frozen client will become expired, so the Frozen status
 // has higher precedence.
@@ -94,6 +96,10 @@ func (cs ClientState) Status(
 	}
 
 	if cs.IsExpired(consState.Timestamp, ctx.BlockTime()) {
+		if cs.IsWithinExpiryGracePeriod(consState.Timestamp, ctx.BlockTime()) {
+			return exported.ExpiredGrace
+		}
+
 		return exported.Expired
 	}
 
@@ -107,6 +113,47 @@ func (cs ClientState) IsExpired(latestTimestamp, now time.Time) bool {
 	return !expirationTime.After(now)
 }
 
+// IsWithinExpiryGracePeriod returns whether or not the time since the last update is within the
+// trusting period extended by the expiry grace period. Without a grace period, this is the case
+// exactly when the client is not expired.
+func (cs ClientState) IsWithinExpiryGracePeriod(latestTimestamp, now time.Time) bool {
+	graceEndTime := latestTimestamp.Add(cs.TrustingPeriod + cs.ExpiryGracePeriod)
+	return graceEndTime.After(now)
+}
+
+// CheckPeriods returns a descriptive error if the periods of the client state are misconfigured. The trusting,
+// unbonding and max clock drift periods must be positive, and the trusting period extended by the expiry grace
+// period must be strictly less than the unbonding period, as headers could otherwise be trusted after the
+// validators which signed them are able to unbond and misbehave without being slashed.
+func CheckPeriods(cs ClientState) error {
+	if cs.TrustingPeriod <= 0 {
+		return errorsmod.Wrapf(ErrInvalidTrustingPeriod, "trusting period (%s) must be greater than zero", cs.TrustingPeriod)
+	}
+	if cs.UnbondingPeriod <= 0 {
+		return errorsmod.Wrapf(ErrInvalidUnbondingPeriod, "unbonding period (%s) must be greater than zero", cs.UnbondingPeriod)
+	}
+	if cs.MaxClockDrift <= 0 {
+		return errorsmod.Wrapf(ErrInvalidMaxClockDrift, "max clock drift (%s) must be greater than zero", cs.MaxClockDrift)
+	}
+	if cs.TrustingPeriod >= cs.UnbondingPeriod {
+		return errorsmod.Wrapf(
+			ErrInvalidTrustingPeriod,
+			"trusting period (%s) should be < unbonding period (%s)", cs.TrustingPeriod, cs.UnbondingPeriod,
+		)
+	}
+	if cs.ExpiryGracePeriod < 0 {
+		return errorsmod.Wrapf(ErrInvalidExpiryGracePeriod, "expiry grace period (%s) cannot be negative", cs.ExpiryGracePeriod)
+	}
+	if cs.TrustingPeriod+cs.ExpiryGracePeriod >= cs.UnbondingPeriod {
+		return errorsmod.Wrapf(
+			ErrInvalidExpiryGracePeriod,
+			"trusting period (%s) + expiry grace period (%s) should be < unbonding period (%s)", cs.TrustingPeriod, cs.ExpiryGracePeriod, cs.UnbondingPeriod,
+		)
+	}
+
+	return nil
+}
+
 // Validate performs a basic validation of the client state fields.
 func (cs ClientState) Validate() error {
 	if strings.TrimSpace(cs.ChainId) == "" {
@@ -125,14 +172,8 @@ func (cs ClientState) Validate() error {
 	if err := light.ValidateTrustLevel(cs.TrustLevel.ToTendermint()); err != nil {
 		return errorsmod.Wrapf(ErrInvalidTrustLevel, err.Error())
 	}
-	if cs.TrustingPeriod <= 0 {
-		return errorsmod.Wrap(ErrInvalidTrustingPeriod, "trusting period must be greater than zero")
-	}
-	if cs.UnbondingPeriod <= 0 {
-		return errorsmod.Wrap(ErrInvalidUnbondingPeriod, "unbonding period must be greater than zero")
-	}
-	if cs.MaxClockDrift <= 0 {
-		return errorsmod.Wrap(ErrInvalidMaxClockDrift, "max clock drift must be greater than zero")
+	if err := CheckPeriods(cs); err != nil {
+		return err
 	}
 
 	// the latest height revision number must match the chain id revision number
@@ -143,12 +184,6 @@ func (cs ClientState) Validate() error {
 	if cs.LatestHeight.RevisionHeight == 0 {
 		return errorsmod.Wrapf(ErrInvalidHeaderHeight, "tendermint client's latest height revision height cannot be zero")
 	}
-	if cs.TrustingPeriod >= cs.UnbondingPeriod {
-		return errorsmod.Wrapf(
-			ErrInvalidTrustingPeriod,
-			"trusting period (%s) should be < unbonding period (%s)", cs.TrustingPeriod, cs.UnbondingPeriod,
-		)
-	}
 
 	if cs.ProofSpecs == nil {
 		return errorsmod.Wrap(ErrInvalidProofSpecs, "proof specs cannot be nil for tm client")
@@ -200,6 +235,64 @@ func (cs ClientState) Initialize(ctx sdk.Context, cdc codec.BinaryCodec, clientS
 	return nil
 }
 
+// InitializeSeedConsensusStates stores the provided seed consensus states, in ascending order of height, together with
+// their metadata. The seed consensus states must be at strictly increasing heights below the latest height of the client,
+// within the same revision, and have strictly increasing timestamps prior to the timestamp of the latest consensus state.
+// Every seed consensus state must be within the trusting period of the client.
+func (cs ClientState) InitializeSeedConsensusStates(
+	ctx sdk.Context,
+	cdc codec.BinaryCodec,
+	clientStore storetypes.KVStore,
+	heights []exported.Height,
+	consensusStates []*ConsensusState,
+) error {
+	if len(heights) != len(consensusStates) {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "number of seed heights (%d) does not match number of seed consensus states (%d)", len(heights), len(consensusStates))
+	}
+
+	latestConsensusState, found := GetConsensusState(clientStore, cdc, cs.LatestHeight)
+	if !found {
+		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "consensus state not found for latest height %s", cs.LatestHeight)
+	}
+
+	for i, height := range heights {
+		consensusState := consensusStates[i]
+
+		if height.GetRevisionNumber() != cs.LatestHeight.GetRevisionNumber() || height.GetRevisionHeight() == 0 {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidHeight, "seed consensus state height %s must be non-zero and within revision %d", height, cs.LatestHeight.GetRevisionNumber())
+		}
+
+		if !height.LT(cs.LatestHeight) {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidHeight, "seed consensus state height %s must be lower than client latest height %s", height, cs.LatestHeight)
+		}
+
+		if !consensusState.Timestamp.Before(latestConsensusState.Timestamp) {
+			return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "seed consensus state timestamp at height %s must be before the latest consensus state timestamp", height)
+		}
+
+		if i > 0 {
+			if !height.GT(heights[i-1]) {
+				return errorsmod.Wrapf(ibcerrors.ErrInvalidHeight, "seed consensus state heights must be strictly increasing, got %s after %s", height, heights[i-1])
+			}
+
+			if !consensusState.Timestamp.After(consensusStates[i-1].Timestamp) {
+				return errorsmod.Wrapf(clienttypes.ErrInvalidConsensus, "seed consensus state timestamps must be strictly increasing, got %s at height %s", consensusState.Timestamp, height)
+			}
+		}
+
+		if cs.IsExpired(consensusState.Timestamp, ctx.BlockTime()) {
+			return errorsmod.Wrapf(ErrTrustingPeriodExpired, "seed consensus state at height %s is outside of the trusting period", height)
+		}
+	}
+
+	for i, height := range heights {
+		setConsensusState(clientStore, cdc, consensusStates[i], height)
+		setConsensusMetadata(ctx, clientStore, height)
+	}
+
+	return nil
+}
+
 // VerifyMembership is a generic proof verification method which verifies a proof of the existence of a value at a given CommitmentPath at the specified height.
 // The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
 // If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
@@ -243,6 +336,62 @@ func (cs ClientState) VerifyMembership(
 	return merkleProof.VerifyMembership(cs.ProofSpecs, consensusState.GetRoot(), merklePath, value)
 }
 
+// MembershipVerifier returns a verifier of membership proofs against the consensus state at the specified height.
+// It performs the checks of VerifyMembership which do not depend on the proof, so that multiple proofs at the same
+// height can be verified while only loading the consensus state once.
+func (cs ClientState) MembershipVerifier(
+	ctx sdk.Context,
+	clientStore storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+) (exported.MembershipVerifier, error) {
+	if cs.LatestHeight.LT(height) {
+		return nil, errorsmod.Wrapf(
+			ibcerrors.ErrInvalidHeight,
+			"client state height < proof height (%d < %d), please ensure the client has been updated", cs.LatestHeight, height,
+		)
+	}
+
+	if err := verifyDelayPeriodPassed(ctx, clientStore, height, delayTimePeriod, delayBlockPeriod); err != nil {
+		return nil, err
+	}
+
+	consensusState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return nil, errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client")
+	}
+
+	return membershipVerifier{
+		cdc:        cdc,
+		proofSpecs: cs.ProofSpecs,
+		root:       consensusState.GetRoot(),
+	}, nil
+}
+
+// membershipVerifier verifies membership proofs against the commitment root of a consensus state.
+type membershipVerifier struct {
+	cdc        codec.BinaryCodec
+	proofSpecs []*ics23.ProofSpec
+	root       exported.Root
+}
+
+// VerifyMembership verifies the ICS 23 commitment merkle proof of the value at the given path against the commitment root.
+func (v membershipVerifier) VerifyMembership(proof []byte, path exported.Path, value []byte) error {
+	var merkleProof commitmenttypes.MerkleProof
+	if err := v.cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into ICS 23 commitment merkle proof")
+	}
+
+	merklePath, ok := path.(commitmenttypes.MerklePath)
+	if !ok {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", commitmenttypes.MerklePath{}, path)
+	}
+
+	return merkleProof.VerifyMembership(v.proofSpecs, v.root, merklePath, value)
+}
+
 // VerifyNonMembership is a generic proof verification method which verifies the absence of a given CommitmentPath at a specified height.
 // The caller is expected to construct the full CommitmentPath from a CommitmentPrefix and a standardized path (as defined in ICS 24).
 // If a zero proof height is passed in, it will fail to retrieve the associated consensus state.
@@ -285,6 +434,30 @@ func (cs ClientState) VerifyNonMembership(
 	return merkleProof.VerifyNonMembership(cs.ProofSpecs, consensusState.GetRoot(), merklePath)
 }
 
+// verifyTimeoutProofHeight ensures that a timeout proof at the provided height may be verified by the client.
+// Active clients may verify timeout proofs at any height. Frozen clients may only verify timeout proofs at heights
+// prior to the lowest trusted height of the misbehaviour which froze the client. Clients frozen by a single conflicting
+// header do not store a misbehaviour height and may not verify timeout proofs.
+func (cs ClientState) verifyTimeoutProofHeight(clientStore storetypes.KVStore, height exported.Height) error {
+	if cs.FrozenHeight.IsZero() {
+		return nil
+	}
+
+	misbehaviourHeight, found := GetMisbehaviourHeight(clientStore)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotActive, "misbehaviour height not found for frozen client")
+	}
+
+	if !height.LT(misbehaviourHeight) {
+		return errorsmod.Wrapf(
+			ibcerrors.ErrInvalidHeight,
+			"proof height must be less than misbehaviour height for frozen client (%s >= %s)", height, misbehaviourHeight,
+		)
+	}
+
+	return nil
+}
+
 // verifyDelayPeriodPassed will ensure that at least delayTimePeriod amount of time and delayBlockPeriod number of blocks have passed
 // since consensus state was submitted before allowing verification to continue.
 func verifyDelayPeriodPassed(ctx sdk.Context, store storetypes.KVStore, proofHeight exported.Height, delayTimePeriod, delayBlockPeriod uint64) error {
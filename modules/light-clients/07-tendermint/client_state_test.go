@@ -1,6 +1,8 @@
 package tendermint_test
 
 import (
+	"time"
+
 	ics23 "github.com/cosmos/ics23/go"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -17,6 +19,12 @@ const (
 var invalidProof = []byte("invalid proof")
 
 func (suite *TendermintTestSuite) TestValidate() {
+	withExpiryGracePeriod := func(expiryGracePeriod time.Duration) *ibctm.ClientState {
+		clientState := ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath)
+		clientState.ExpiryGracePeriod = expiryGracePeriod
+		return clientState
+	}
+
 	testCases := []struct {
 		name        string
 		clientState *ibctm.ClientState
@@ -104,6 +112,21 @@ func (suite *TendermintTestSuite) TestValidate() {
 			clientState: ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, ubdPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath),
 			expErr:      ibctm.ErrInvalidTrustingPeriod,
 		},
+		{
+			name:        "valid client with expiry grace period",
+			clientState: withExpiryGracePeriod(time.Hour),
+			expErr:      nil,
+		},
+		{
+			name:        "negative expiry grace period",
+			clientState: withExpiryGracePeriod(-time.Hour),
+			expErr:      ibctm.ErrInvalidExpiryGracePeriod,
+		},
+		{
+			name:        "trusting period + expiry grace period not less than unbonding period",
+			clientState: withExpiryGracePeriod(ubdPeriod - trustingPeriod),
+			expErr:      ibctm.ErrInvalidExpiryGracePeriod,
+		},
 		{
 			name:        "proof specs is nil",
 			clientState: ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, nil, upgradePath),
@@ -135,3 +158,130 @@ func (suite *TendermintTestSuite) TestValidate() {
 		})
 	}
 }
+
+func (suite *TendermintTestSuite) TestCheckPeriods() {
+	var clientState *ibctm.ClientState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: valid periods",
+			func() {},
+			nil,
+		},
+		{
+			"success: valid periods with expiry grace period",
+			func() {
+				clientState.ExpiryGracePeriod = time.Hour
+			},
+			nil,
+		},
+		{
+			"failure: zero trusting period",
+			func() {
+				clientState.TrustingPeriod = 0
+			},
+			ibctm.ErrInvalidTrustingPeriod,
+		},
+		{
+			"failure: zero unbonding period",
+			func() {
+				clientState.UnbondingPeriod = 0
+			},
+			ibctm.ErrInvalidUnbondingPeriod,
+		},
+		{
+			"failure: trusting period equal to unbonding period",
+			func() {
+				clientState.TrustingPeriod = clientState.UnbondingPeriod
+			},
+			ibctm.ErrInvalidTrustingPeriod,
+		},
+		{
+			"failure: trusting period greater than unbonding period",
+			func() {
+				clientState.TrustingPeriod = clientState.UnbondingPeriod + time.Hour
+			},
+			ibctm.ErrInvalidTrustingPeriod,
+		},
+		{
+			"failure: zero max clock drift",
+			func() {
+				clientState.MaxClockDrift = 0
+			},
+			ibctm.ErrInvalidMaxClockDrift,
+		},
+		{
+			"failure: negative max clock drift",
+			func() {
+				clientState.MaxClockDrift = -time.Second
+			},
+			ibctm.ErrInvalidMaxClockDrift,
+		},
+		{
+			"failure: negative expiry grace period",
+			func() {
+				clientState.ExpiryGracePeriod = -time.Hour
+			},
+			ibctm.ErrInvalidExpiryGracePeriod,
+		},
+		{
+			"failure: trusting period + expiry grace period equal to unbonding period",
+			func() {
+				clientState.ExpiryGracePeriod = clientState.UnbondingPeriod - clientState.TrustingPeriod
+			},
+			ibctm.ErrInvalidExpiryGracePeriod,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			clientState = ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath)
+
+			tc.malleate()
+
+			err := ibctm.CheckPeriods(*clientState)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestIsWithinExpiryGracePeriod() {
+	latestTimestamp := suite.now
+	clientState := ibctm.NewClientState(chainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, height, commitmenttypes.GetSDKSpecs(), upgradePath)
+
+	testCases := []struct {
+		name              string
+		expiryGracePeriod time.Duration
+		now               time.Time
+		expExpired        bool
+		expWithinGrace    bool
+	}{
+		{"active without expiry grace period", 0, latestTimestamp.Add(trustingPeriod - time.Nanosecond), false, true},
+		{"expired without expiry grace period", 0, latestTimestamp.Add(trustingPeriod), true, false},
+		{"active with expiry grace period", time.Hour, latestTimestamp.Add(trustingPeriod - time.Nanosecond), false, true},
+		{"expired at start of expiry grace period", time.Hour, latestTimestamp.Add(trustingPeriod), true, true},
+		{"expired before end of expiry grace period", time.Hour, latestTimestamp.Add(trustingPeriod + time.Hour - time.Nanosecond), true, true},
+		{"expired at end of expiry grace period", time.Hour, latestTimestamp.Add(trustingPeriod + time.Hour), true, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			clientState.ExpiryGracePeriod = tc.expiryGracePeriod
+
+			suite.Require().Equal(tc.expExpired, clientState.IsExpired(latestTimestamp, tc.now))
+			suite.Require().Equal(tc.expWithinGrace, clientState.IsWithinExpiryGracePeriod(latestTimestamp, tc.now))
+		})
+	}
+}
@@ -19,7 +19,10 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 )
 
-var _ clienttypes.ConsensusHost = (*ConsensusHost)(nil)
+var (
+	_ clienttypes.ConsensusHost               = (*ConsensusHost)(nil)
+	_ clienttypes.UpgradedSelfClientValidator = (*ConsensusHost)(nil)
+)
 
 // ConsensusHost implements the 02-client clienttypes.ConsensusHost interface.
 type ConsensusHost struct {
@@ -136,3 +139,42 @@ func (c *ConsensusHost) ValidateSelfClient(ctx sdk.Context, clientState exported
 
 	return nil
 }
+
+// ValidateUpgradedSelfClient implements the 02-client clienttypes.UpgradedSelfClientValidator interface.
+// It validates the chain-specified fields of the upgraded client state of an IBC software upgrade
+// against the current parameters of the chain.
+func (c *ConsensusHost) ValidateUpgradedSelfClient(ctx sdk.Context, upgradedClientState exported.ClientState) error {
+	tmClient, ok := upgradedClientState.(*ClientState)
+	if !ok {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidClient, "client must be a Tendermint client, expected: %T, got: %T", &ClientState{}, upgradedClientState)
+	}
+
+	if tmClient.LatestHeight.IsZero() {
+		return errorsmod.Wrap(clienttypes.ErrInvalidUpgradeClient, "latest height cannot be zero")
+	}
+
+	expectedProofSpecs := commitmenttypes.GetSDKSpecs()
+	if !reflect.DeepEqual(expectedProofSpecs, tmClient.ProofSpecs) {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidUpgradeClient, "invalid proof specs. expected: %v got: %v",
+			expectedProofSpecs, tmClient.ProofSpecs)
+	}
+
+	expectedUbdPeriod, err := c.stakingKeeper.UnbondingTime(ctx)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to retrieve unbonding period")
+	}
+
+	if expectedUbdPeriod != tmClient.UnbondingPeriod {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidUpgradeClient, "invalid unbonding period. expected: %s, got: %s",
+			expectedUbdPeriod, tmClient.UnbondingPeriod)
+	}
+
+	// counterparties can only upgrade their clients if the upgrade path is set
+	expectedUpgradePath := []string{upgradetypes.StoreKey, upgradetypes.KeyUpgradedIBCState}
+	if !reflect.DeepEqual(expectedUpgradePath, tmClient.UpgradePath) {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidUpgradeClient, "upgrade path must be the upgrade path defined by upgrade module. expected %v, got %v",
+			expectedUpgradePath, tmClient.UpgradePath)
+	}
+
+	return nil
+}
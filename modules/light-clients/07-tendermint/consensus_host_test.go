@@ -247,3 +247,66 @@ func (suite *TendermintTestSuite) TestValidateSelfClient() {
 		})
 	}
 }
+
+func (suite *TendermintTestSuite) TestValidateUpgradedSelfClient() {
+	var upgradedClientState *ibctm.ClientState
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"non-zeroed trusting period", func() {
+				upgradedClientState.TrustingPeriod = trustingPeriod
+			}, clienttypes.ErrInvalidUpgradeClient,
+		},
+		{
+			"non-zeroed trust level", func() {
+				upgradedClientState.TrustLevel = ibctm.DefaultTrustLevel
+			}, clienttypes.ErrInvalidUpgradeClient,
+		},
+		{
+			"zero latest height", func() {
+				upgradedClientState.LatestHeight = clienttypes.ZeroHeight()
+			}, clienttypes.ErrInvalidUpgradeClient,
+		},
+		{
+			"unbonding period does not match staking parameters", func() {
+				upgradedClientState.UnbondingPeriod = ubdPeriod + 1
+			}, clienttypes.ErrInvalidUpgradeClient,
+		},
+		{
+			"zeroed proof specs", func() {
+				upgradedClientState.ProofSpecs = nil
+			}, clienttypes.ErrInvalidUpgradeClient,
+		},
+		{
+			"invalid upgrade path", func() {
+				upgradedClientState.UpgradePath = []string{"bad", "upgrade", "path"}
+			}, clienttypes.ErrInvalidUpgradeClient,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			upgradedClientState = ibctm.NewClientState(suite.chainA.ChainID, ibctm.DefaultTrustLevel, trustingPeriod, ubdPeriod, maxClockDrift, clienttypes.NewHeight(1, 1000), commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath).ZeroCustomFields()
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ClientKeeper.ValidateUpgradedClientState(suite.chainA.GetContext(), upgradedClientState)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
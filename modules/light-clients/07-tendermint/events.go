@@ -0,0 +1,36 @@
+package tendermint
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// emitPruneConsensusStatesEvent emits a prune consensus states event listing the heights of the
+// consensus states pruned from the client store. The number of listed heights is capped at
+// MaxPrunedHeightsAttributeLength.
+func emitPruneConsensusStatesEvent(ctx sdk.Context, clientID string, prunedHeights []exported.Height) {
+	listedHeights := prunedHeights
+	if len(listedHeights) > clienttypes.MaxPrunedHeightsAttributeLength {
+		listedHeights = listedHeights[:clienttypes.MaxPrunedHeightsAttributeLength]
+	}
+
+	prunedHeightsAttr := make([]string, len(listedHeights))
+	for i, height := range listedHeights {
+		prunedHeightsAttr[i] = height.String()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			clienttypes.EventTypePruneConsensusStates,
+			sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(clienttypes.AttributeKeyClientType, exported.Tendermint),
+			sdk.NewAttribute(clienttypes.AttributeKeyPrunedHeights, strings.Join(prunedHeightsAttr, ",")),
+			sdk.NewAttribute(clienttypes.AttributeKeyPrunedCount, strconv.Itoa(len(prunedHeights))),
+		),
+	)
+}
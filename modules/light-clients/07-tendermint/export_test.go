@@ -0,0 +1,36 @@
+package tendermint
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MarkHeaderVerified is a wrapper around headerVerificationCache.add to allow the function to be directly called in tests.
+// It records the header as verified against the client state and trusted consensus state in the cache of the context.
+func MarkHeaderVerified(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, cs *ClientState, header *Header) {
+	cache, ok := getHeaderVerificationCache(ctx)
+	if !ok {
+		panic(fmt.Errorf("context does not contain a header verification cache"))
+	}
+
+	consState, found := GetConsensusState(clientStore, cdc, header.TrustedHeight)
+	if !found {
+		panic(fmt.Errorf("consensus state not found for height %s", header.TrustedHeight))
+	}
+
+	cache.add(headerVerificationKey(ctx, cdc, cs, consState, header))
+}
+
+// HeaderVerificationCacheLen returns the number of verified headers recorded in the cache of the context.
+func HeaderVerificationCacheLen(ctx sdk.Context) int {
+	cache, ok := getHeaderVerificationCache(ctx)
+	if !ok {
+		return 0
+	}
+
+	return len(cache.verified)
+}
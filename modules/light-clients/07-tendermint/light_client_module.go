@@ -14,7 +14,15 @@ import (
 	"github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint/internal/keeper"
 )
 
-var _ exported.LightClientModule = (*LightClientModule)(nil)
+var (
+	_ exported.LightClientModule                 = (*LightClientModule)(nil)
+	_ exported.TrustedHeightsRecoveryModule      = (*LightClientModule)(nil)
+	_ exported.TimeoutVerificationModule         = (*LightClientModule)(nil)
+	_ exported.BatchMembershipVerificationModule = (*LightClientModule)(nil)
+	_ exported.SeedConsensusStatesModule         = (*LightClientModule)(nil)
+	_ exported.ConsensusStatePruningModule       = (*LightClientModule)(nil)
+	_ exported.ClientFreezingModule              = (*LightClientModule)(nil)
+)
 
 // LightClientModule implements the core IBC api.LightClientModule interface.
 type LightClientModule struct {
@@ -64,6 +72,36 @@ func (l LightClientModule) Initialize(ctx sdk.Context, clientID string, clientSt
 	return clientState.Initialize(ctx, l.keeper.Codec(), clientStore, &consensusState)
 }
 
+// InitializeSeedConsensusStates unmarshals the provided seed consensus states and performs basic validation. It obtains
+// the client state associated with the client identifier and calls into the clientState.InitializeSeedConsensusStates method.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
+func (l LightClientModule) InitializeSeedConsensusStates(ctx sdk.Context, clientID string, heights []exported.Height, consensusStatesBz [][]byte) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	cdc := l.keeper.Codec()
+
+	clientState, found := getClientState(clientStore, cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	consensusStates := make([]*ConsensusState, len(consensusStatesBz))
+	for i, consensusStateBz := range consensusStatesBz {
+		var consensusState ConsensusState
+		if err := cdc.Unmarshal(consensusStateBz, &consensusState); err != nil {
+			return fmt.Errorf("failed to unmarshal seed consensus state bytes into consensus state: %w", err)
+		}
+
+		if err := consensusState.ValidateBasic(); err != nil {
+			return err
+		}
+
+		consensusStates[i] = &consensusState
+	}
+
+	return clientState.InitializeSeedConsensusStates(ctx, cdc, clientStore, heights, consensusStates)
+}
+
 // VerifyClientMessage obtains the client state associated with the client identifier and calls into the clientState.VerifyClientMessage method.
 //
 // CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
@@ -110,6 +148,7 @@ func (l LightClientModule) UpdateStateOnMisbehaviour(ctx sdk.Context, clientID s
 }
 
 // UpdateState obtains the client state associated with the client identifier and calls into the clientState.UpdateState method.
+// A prune consensus states event is emitted if expired consensus states were pruned during the update.
 //
 // CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
 func (l LightClientModule) UpdateState(ctx sdk.Context, clientID string, clientMsg exported.ClientMessage) []exported.Height {
@@ -121,7 +160,12 @@ func (l LightClientModule) UpdateState(ctx sdk.Context, clientID string, clientM
 		panic(errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID))
 	}
 
-	return clientState.UpdateState(ctx, cdc, clientStore, clientMsg)
+	consensusHeights, prunedHeights := clientState.updateState(ctx, cdc, clientStore, clientMsg)
+	if len(prunedHeights) != 0 {
+		emitPruneConsensusStatesEvent(ctx, clientID, prunedHeights)
+	}
+
+	return consensusHeights
 }
 
 // VerifyMembership obtains the client state associated with the client identifier and calls into the clientState.VerifyMembership method.
@@ -148,6 +192,27 @@ func (l LightClientModule) VerifyMembership(
 	return clientState.VerifyMembership(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
 }
 
+// MembershipVerifier obtains the client state associated with the client identifier and calls into the clientState.MembershipVerifier method.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
+func (l LightClientModule) MembershipVerifier(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+) (exported.MembershipVerifier, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	cdc := l.keeper.Codec()
+
+	clientState, found := getClientState(clientStore, cdc)
+	if !found {
+		return nil, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	return clientState.MembershipVerifier(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod)
+}
+
 // VerifyNonMembership obtains the client state associated with the client identifier and calls into the clientState.VerifyNonMembership method.
 //
 // CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
@@ -171,6 +236,63 @@ func (l LightClientModule) VerifyNonMembership(
 	return clientState.VerifyNonMembership(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
 }
 
+// VerifyMembershipForTimeout obtains the client state associated with the client identifier and calls into the clientState.VerifyMembership method
+// if the proof height is permitted for timeout verification. If the client is frozen, the proof height must be less than the misbehaviour height.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
+func (l LightClientModule) VerifyMembershipForTimeout(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+	value []byte,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	cdc := l.keeper.Codec()
+
+	clientState, found := getClientState(clientStore, cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if err := clientState.verifyTimeoutProofHeight(clientStore, height); err != nil {
+		return err
+	}
+
+	return clientState.VerifyMembership(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod, proof, path, value)
+}
+
+// VerifyNonMembershipForTimeout obtains the client state associated with the client identifier and calls into the clientState.VerifyNonMembership method
+// if the proof height is permitted for timeout verification. If the client is frozen, the proof height must be less than the misbehaviour height.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
+func (l LightClientModule) VerifyNonMembershipForTimeout(
+	ctx sdk.Context,
+	clientID string,
+	height exported.Height,
+	delayTimePeriod uint64,
+	delayBlockPeriod uint64,
+	proof []byte,
+	path exported.Path,
+) error {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	cdc := l.keeper.Codec()
+
+	clientState, found := getClientState(clientStore, cdc)
+	if !found {
+		return errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if err := clientState.verifyTimeoutProofHeight(clientStore, height); err != nil {
+		return err
+	}
+
+	return clientState.VerifyNonMembership(ctx, clientStore, cdc, height, delayTimePeriod, delayBlockPeriod, proof, path)
+}
+
 // Status obtains the client state associated with the client identifier and calls into the clientState.Status method.
 //
 // CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
@@ -186,6 +308,65 @@ func (l LightClientModule) Status(ctx sdk.Context, clientID string) exported.Sta
 	return clientState.Status(ctx, clientStore, cdc)
 }
 
+// PruneConsensusStates deletes up to limit consensus states of a client which is expired or frozen along with their
+// metadata, or all of them if limit is zero. The consensus states of a frozen client at heights prior to its stored
+// misbehaviour height are kept, as timeout proofs may still be verified against them. The consensus states of a client
+// within its expiry grace period are kept, as proofs may still be verified against them. A prune consensus states event
+// is emitted if any consensus state was pruned.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
+func (l LightClientModule) PruneConsensusStates(ctx sdk.Context, clientID string, limit int) (int, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	cdc := l.keeper.Codec()
+
+	clientState, found := getClientState(clientStore, cdc)
+	if !found {
+		return 0, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	status := clientState.Status(ctx, clientStore, cdc)
+	if status != exported.Expired && status != exported.Frozen {
+		return 0, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "cannot prune the consensus states of client (%s) with status %s", clientID, status)
+	}
+
+	var fromHeight exported.Height = clienttypes.ZeroHeight()
+	if status == exported.Frozen {
+		if misbehaviourHeight, found := GetMisbehaviourHeight(clientStore); found {
+			fromHeight = misbehaviourHeight
+		}
+	}
+
+	prunedHeights := PruneConsensusStatesFrom(clientStore, fromHeight, limit)
+	if len(prunedHeights) != 0 {
+		emitPruneConsensusStatesEvent(ctx, clientID, prunedHeights)
+	}
+
+	return len(prunedHeights), nil
+}
+
+// FreezeClient freezes the client without a proof of misbehaviour by setting its frozen height to its latest height.
+// The height of a misbehaviour is not recorded, so packet timeouts cannot be verified while the client is frozen.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
+func (l LightClientModule) FreezeClient(ctx sdk.Context, clientID string) (exported.Height, error) {
+	clientStore := l.storeProvider.ClientStore(ctx, clientID)
+	cdc := l.keeper.Codec()
+
+	clientState, found := getClientState(clientStore, cdc)
+	if !found {
+		return nil, errorsmod.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if !clientState.FrozenHeight.IsZero() {
+		return nil, errorsmod.Wrapf(clienttypes.ErrClientFrozen, "client (%s) is already frozen at height %s", clientID, clientState.FrozenHeight)
+	}
+
+	clientState.FrozenHeight = clientState.LatestHeight
+	setClientState(clientStore, cdc, clientState)
+
+	return clientState.FrozenHeight, nil
+}
+
 // LatestHeight returns the latest height for the client state for the given client identifier.
 // If no client is present for the provided client identifier a zero value height is returned.
 //
@@ -225,6 +406,15 @@ func (l LightClientModule) TimestampAtHeight(
 //
 // CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
 func (l LightClientModule) RecoverClient(ctx sdk.Context, clientID, substituteClientID string) error {
+	return l.RecoverClientWithTrustedHeights(ctx, clientID, substituteClientID, nil)
+}
+
+// RecoverClientWithTrustedHeights performs the same checks as RecoverClient and additionally copies the consensus states
+// of the substitute client at the provided trusted heights to the subject client. This allows the subject client to be
+// updated with headers which are not adjacent to the latest height of the substitute client.
+//
+// CONTRACT: clientID is validated in 02-client router, thus clientID is assumed here to have the format 07-tendermint-{n}.
+func (l LightClientModule) RecoverClientWithTrustedHeights(ctx sdk.Context, clientID, substituteClientID string, trustedHeights []exported.Height) error {
 	substituteClientType, _, err := clienttypes.ParseClientIdentifier(substituteClientID)
 	if err != nil {
 		return err
@@ -248,7 +438,7 @@ func (l LightClientModule) RecoverClient(ctx sdk.Context, clientID, substituteCl
 		return errorsmod.Wrap(clienttypes.ErrClientNotFound, substituteClientID)
 	}
 
-	return clientState.CheckSubstituteAndUpdateState(ctx, cdc, clientStore, substituteClientStore, substituteClient)
+	return clientState.CheckSubstituteAndUpdateState(ctx, cdc, clientStore, substituteClientStore, substituteClient, trustedHeights)
 }
 
 // VerifyUpgradeAndUpdateState obtains the client state associated with the client identifier and calls into the clientState.VerifyUpgradeAndUpdateState method.
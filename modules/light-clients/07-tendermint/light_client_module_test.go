@@ -68,6 +68,46 @@ func (suite *TendermintTestSuite) TestStatus() {
 			},
 			exported.Expired,
 		},
+		{
+			"client is active before expiry with expiry grace period",
+			func() {
+				clientState.ExpiryGracePeriod = time.Hour
+				path.EndpointA.SetClientState(clientState)
+
+				suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod - time.Minute)
+			},
+			exported.Active,
+		},
+		{
+			"client status is expired grace at expiry",
+			func() {
+				clientState.ExpiryGracePeriod = time.Hour
+				path.EndpointA.SetClientState(clientState)
+
+				suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod)
+			},
+			exported.ExpiredGrace,
+		},
+		{
+			"client status is expired grace before end of expiry grace period",
+			func() {
+				clientState.ExpiryGracePeriod = time.Hour
+				path.EndpointA.SetClientState(clientState)
+
+				suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod + time.Hour - time.Minute)
+			},
+			exported.ExpiredGrace,
+		},
+		{
+			"client status is expired at end of expiry grace period",
+			func() {
+				clientState.ExpiryGracePeriod = time.Hour
+				path.EndpointA.SetClientState(clientState)
+
+				suite.coordinator.IncrementTimeBy(clientState.TrustingPeriod + time.Hour)
+			},
+			exported.Expired,
+		},
 		{
 			"client state not found",
 			func() {
@@ -178,6 +218,206 @@ func (suite *TendermintTestSuite) TestGetTimestampAtHeight() {
 	}
 }
 
+func (suite *TendermintTestSuite) TestPruneConsensusStates() {
+	var (
+		path  *ibctesting.Path
+		limit int
+	)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expPruned    int
+		expRemaining int
+		expErr       error
+	}{
+		{
+			"success: expired client",
+			func() {
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+			},
+			3, 0, nil,
+		},
+		{
+			"success: frozen client",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			3, 0, nil,
+		},
+		{
+			"success: consensus states of a frozen client prior to its misbehaviour height are kept",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+
+				// the misbehaviour height is the height of the second consensus state
+				var heights []exported.Height
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				ibctm.IterateConsensusStateAscending(clientStore, func(height exported.Height) bool {
+					heights = append(heights, height)
+					return false
+				})
+				clientStore.Set(ibctm.KeyMisbehaviourHeight, []byte(heights[1].String()))
+			},
+			2, 1, nil,
+		},
+		{
+			"success: pruning is bounded by the limit",
+			func() {
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+				limit = 2
+			},
+			2, 1, nil,
+		},
+		{
+			"failure: client is active",
+			func() {},
+			0, 3, ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: client is within its expiry grace period",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				clientState.ExpiryGracePeriod = time.Hour
+				path.EndpointA.SetClientState(clientState)
+
+				suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod)
+			},
+			0, 3, ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: client state not found",
+			func() {
+				store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				store.Delete(host.ClientStateKey())
+			},
+			0, 3, clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			// add a second and third consensus state
+			err := path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(path.EndpointA.ClientID)
+			suite.Require().True(found)
+
+			pruningModule, ok := lightClientModule.(exported.ConsensusStatePruningModule)
+			suite.Require().True(ok)
+
+			limit = 0
+
+			tc.malleate()
+
+			pruned, err := pruningModule.PruneConsensusStates(suite.chainA.GetContext(), path.EndpointA.ClientID, limit)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+			suite.Require().Equal(tc.expPruned, pruned)
+
+			var remaining int
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+			ibctm.IterateConsensusStateAscending(clientStore, func(height exported.Height) bool {
+				remaining++
+				return false
+			})
+			suite.Require().Equal(tc.expRemaining, remaining)
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestFreezeClient() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: client is already frozen",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			clienttypes.ErrClientFrozen,
+		},
+		{
+			"failure: client state not found",
+			func() {
+				store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				store.Delete(host.ClientStateKey())
+			},
+			clienttypes.ErrClientNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(path.EndpointA.ClientID)
+			suite.Require().True(found)
+
+			freezingModule, ok := lightClientModule.(exported.ClientFreezingModule)
+			suite.Require().True(ok)
+
+			tc.malleate()
+
+			frozenHeight, err := freezingModule.FreezeClient(suite.chainA.GetContext(), path.EndpointA.ClientID)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(path.EndpointA.GetClientLatestHeight(), frozenHeight)
+
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				suite.Require().Equal(frozenHeight, clientState.FrozenHeight)
+				suite.Require().Equal(exported.Frozen, lightClientModule.Status(suite.chainA.GetContext(), path.EndpointA.ClientID))
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *TendermintTestSuite) TestInitialize() {
 	var consensusState exported.ConsensusState
 	var clientState exported.ClientState
@@ -267,6 +507,207 @@ func (suite *TendermintTestSuite) TestInitialize() {
 	}
 }
 
+func (suite *TendermintTestSuite) TestInitializeSeedConsensusStates() {
+	var (
+		clientID        string
+		heights         []exported.Height
+		consensusStates []exported.ConsensusState
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: client not found",
+			func() {
+				clientID = ibctesting.InvalidID
+			},
+			clienttypes.ErrClientNotFound,
+		},
+		{
+			"failure: number of heights does not match number of consensus states",
+			func() {
+				heights = heights[:2]
+			},
+			clienttypes.ErrInvalidConsensus,
+		},
+		{
+			"failure: consensus state is solomachine consensus state",
+			func() {
+				consensusStates[0] = ibctesting.NewSolomachine(suite.T(), suite.chainA.Codec, "solomachine", "", 2).ConsensusState()
+			},
+			fmt.Errorf("failed to unmarshal seed consensus state bytes into consensus state"),
+		},
+		{
+			"failure: height is in a different revision",
+			func() {
+				heights[0] = clienttypes.NewHeight(heights[0].GetRevisionNumber()+1, heights[0].GetRevisionHeight())
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"failure: height is not lower than the client latest height",
+			func() {
+				heights[2] = suite.chainB.LatestCommittedHeader.GetHeight()
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"failure: heights are not strictly increasing",
+			func() {
+				heights[1] = heights[0]
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"failure: timestamp is not before the latest consensus state timestamp",
+			func() {
+				consensusStates[2].(*ibctm.ConsensusState).Timestamp = suite.chainB.LatestCommittedHeader.GetTime()
+			},
+			clienttypes.ErrInvalidConsensus,
+		},
+		{
+			"failure: timestamps are not strictly increasing",
+			func() {
+				consensusStates[1].(*ibctm.ConsensusState).Timestamp = consensusStates[0].(*ibctm.ConsensusState).Timestamp
+			},
+			clienttypes.ErrInvalidConsensus,
+		},
+		{
+			"failure: consensus state is outside of the trusting period",
+			func() {
+				consensusStates[0].(*ibctm.ConsensusState).Timestamp = suite.chainA.GetContext().BlockTime().Add(-ibctesting.TrustingPeriod)
+			},
+			ibctm.ErrTrustingPeriodExpired,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			heights = nil
+			consensusStates = nil
+			for i := 0; i < 3; i++ {
+				suite.coordinator.CommitBlock(suite.chainB)
+				heights = append(heights, suite.chainB.LatestCommittedHeader.GetHeight())
+				consensusStates = append(consensusStates, suite.chainB.LatestCommittedHeader.ConsensusState())
+			}
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+			clientID = path.EndpointA.ClientID
+
+			tc.malleate()
+
+			lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(path.EndpointA.ClientID)
+			suite.Require().True(found)
+
+			seedModule, ok := lightClientModule.(exported.SeedConsensusStatesModule)
+			suite.Require().True(ok)
+
+			consensusStatesBz := make([][]byte, len(consensusStates))
+			for i, consensusState := range consensusStates {
+				consensusStatesBz[i] = suite.chainA.Codec.MustMarshal(consensusState)
+			}
+
+			err := seedModule.InitializeSeedConsensusStates(suite.chainA.GetContext(), clientID, heights, consensusStatesBz)
+
+			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				for i, height := range heights {
+					consensusState, found := ibctm.GetConsensusState(store, suite.chainA.Codec, height)
+					suite.Require().True(found)
+					suite.Require().Equal(consensusStates[i], consensusState)
+
+					_, found = ibctm.GetProcessedTime(store, height)
+					suite.Require().True(found)
+					_, found = ibctm.GetProcessedHeight(store, height)
+					suite.Require().True(found)
+				}
+			} else {
+				suite.Require().ErrorContains(err, tc.expErr.Error())
+				for _, height := range heights {
+					suite.Require().False(store.Has(host.ConsensusStateKey(height)))
+				}
+			}
+		})
+	}
+}
+
+// TestCreateClientWithSeedConsensusStates creates a client with three seed consensus states and verifies a proof
+// at the height of each seed consensus state without updating the client.
+func (suite *TendermintTestSuite) TestCreateClientWithSeedConsensusStates() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	// the client state of chainB's client is proven at each seed height
+	key := host.FullClientStateKey(path.EndpointB.ClientID)
+	merklePath, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), commitmenttypes.NewMerklePath(string(key)))
+	suite.Require().NoError(err)
+
+	value, err := suite.chainB.Codec.MarshalInterface(path.EndpointB.GetClientState())
+	suite.Require().NoError(err)
+
+	var (
+		seeds  []clienttypes.ConsensusStateWithHeight
+		proofs [][]byte
+	)
+	for i := 0; i < 3; i++ {
+		suite.coordinator.CommitBlock(suite.chainB)
+
+		height, ok := suite.chainB.LatestCommittedHeader.GetHeight().(clienttypes.Height)
+		suite.Require().True(ok)
+
+		proof, proofHeight := suite.chainB.QueryProofAtHeight(key, int64(height.GetRevisionHeight()))
+		suite.Require().Equal(height, proofHeight)
+
+		seeds = append(seeds, clienttypes.NewConsensusStateWithHeight(height, suite.chainB.LatestCommittedHeader.ConsensusState()))
+		proofs = append(proofs, proof)
+	}
+
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	tmConfig, ok := path.EndpointA.ClientConfig.(*ibctesting.TendermintConfig)
+	suite.Require().True(ok)
+
+	latestHeight, ok := suite.chainB.LatestCommittedHeader.GetHeight().(clienttypes.Height)
+	suite.Require().True(ok)
+
+	clientState := ibctm.NewClientState(
+		suite.chainB.ChainID, tmConfig.TrustLevel, tmConfig.TrustingPeriod, tmConfig.UnbondingPeriod, tmConfig.MaxClockDrift,
+		latestHeight, commitmenttypes.GetSDKSpecs(), ibctesting.UpgradePath,
+	)
+
+	msg, err := clienttypes.NewMsgCreateClientWithSeedConsensusStates(clientState, suite.chainB.LatestCommittedHeader.ConsensusState(), seeds, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	clientID, err := ibctesting.ParseClientIDFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(clientID)
+	suite.Require().True(found)
+
+	for i, seed := range seeds {
+		err = lightClientModule.VerifyMembership(suite.chainA.GetContext(), clientID, seed.Height, 0, 0, proofs[i], merklePath, value)
+		suite.Require().NoError(err, "failed to verify proof at seed height %s", seed.Height)
+	}
+}
+
 func (suite *TendermintTestSuite) TestRecoverClient() {
 	var (
 		subjectClientID, substituteClientID string
@@ -1020,3 +1461,286 @@ func (suite *TendermintTestSuite) TestVerifyNonMembership() {
 		})
 	}
 }
+
+func (suite *TendermintTestSuite) TestMembershipVerifier() {
+	var (
+		testingpath *ibctesting.Path
+		proofHeight exported.Height
+		proof       []byte
+		value       []byte
+		delayPeriod uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: client state height is less than proof height",
+			func() {
+				proofHeight = proofHeight.Increment()
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"failure: consensus state not found",
+			func() {
+				proofHeight = clienttypes.NewHeight(proofHeight.GetRevisionNumber(), proofHeight.GetRevisionHeight()-1)
+			},
+			clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"failure: delay time period has not passed",
+			func() {
+				delayPeriod = uint64(time.Hour.Nanoseconds())
+			},
+			ibctm.ErrDelayPeriodNotPassed,
+		},
+		{
+			"failure: proof verification failed",
+			func() {
+				value = []byte("invalid value")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: invalid proof",
+			func() {
+				proof = []byte("invalid proof")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			testingpath = ibctesting.NewPath(suite.chainA, suite.chainB)
+			testingpath.Setup()
+
+			key := host.FullClientStateKey(testingpath.EndpointB.ClientID)
+			merklePath := commitmenttypes.NewMerklePath(string(key))
+			path, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), merklePath)
+			suite.Require().NoError(err)
+
+			proof, proofHeight = suite.chainB.QueryProof(key)
+
+			clientState, ok := testingpath.EndpointB.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			value, err = suite.chainB.Codec.MarshalInterface(clientState)
+			suite.Require().NoError(err)
+
+			delayPeriod = 0
+			tc.malleate()
+
+			lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(testingpath.EndpointA.ClientID)
+			suite.Require().True(found)
+
+			batchModule, ok := lightClientModule.(exported.BatchMembershipVerificationModule)
+			suite.Require().True(ok)
+
+			verifier, err := batchModule.MembershipVerifier(suite.chainA.GetContext(), testingpath.EndpointA.ClientID, proofHeight, delayPeriod, 0)
+			if err == nil {
+				err = verifier.VerifyMembership(proof, path, value)
+			}
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestVerifyMembershipForTimeout() {
+	var (
+		testingpath *ibctesting.Path
+		proofHeight exported.Height
+	)
+
+	freezeClient := func(misbehaviourHeight exported.Height) {
+		clientState, ok := testingpath.EndpointA.GetClientState().(*ibctm.ClientState)
+		suite.Require().True(ok)
+
+		clientState.FrozenHeight = ibctm.FrozenHeight
+		testingpath.EndpointA.SetClientState(clientState)
+
+		if misbehaviourHeight != nil {
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), testingpath.EndpointA.ClientID)
+			clientStore.Set(ibctm.KeyMisbehaviourHeight, []byte(misbehaviourHeight.String()))
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: client is active",
+			func() {},
+			nil,
+		},
+		{
+			"success: client is frozen and proof height is below misbehaviour height",
+			func() {
+				freezeClient(proofHeight.Increment())
+			},
+			nil,
+		},
+		{
+			"failure: client is frozen and proof height is equal to misbehaviour height",
+			func() {
+				freezeClient(proofHeight)
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"failure: client is frozen and proof height is above misbehaviour height",
+			func() {
+				freezeClient(clienttypes.NewHeight(proofHeight.GetRevisionNumber(), proofHeight.GetRevisionHeight()-1))
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+		{
+			"failure: client is frozen and misbehaviour height is not stored",
+			func() {
+				freezeClient(nil)
+			},
+			clienttypes.ErrClientNotActive,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			testingpath = ibctesting.NewPath(suite.chainA, suite.chainB)
+			testingpath.Setup()
+
+			key := host.FullClientStateKey(testingpath.EndpointB.ClientID)
+			merklePath := commitmenttypes.NewMerklePath(string(key))
+			path, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), merklePath)
+			suite.Require().NoError(err)
+
+			var proof []byte
+			proof, proofHeight = suite.chainB.QueryProof(key)
+
+			clientState, ok := testingpath.EndpointB.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			value, err := suite.chainB.Codec.MarshalInterface(clientState)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(testingpath.EndpointA.ClientID)
+			suite.Require().True(found)
+
+			timeoutModule, ok := lightClientModule.(exported.TimeoutVerificationModule)
+			suite.Require().True(ok)
+
+			err = timeoutModule.VerifyMembershipForTimeout(
+				suite.chainA.GetContext(), testingpath.EndpointA.ClientID, proofHeight, 0, 0, proof, path, value,
+			)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestVerifyNonMembershipForTimeout() {
+	var (
+		testingpath *ibctesting.Path
+		proofHeight exported.Height
+	)
+
+	freezeClient := func(misbehaviourHeight exported.Height) {
+		clientState, ok := testingpath.EndpointA.GetClientState().(*ibctm.ClientState)
+		suite.Require().True(ok)
+
+		clientState.FrozenHeight = ibctm.FrozenHeight
+		testingpath.EndpointA.SetClientState(clientState)
+
+		clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), testingpath.EndpointA.ClientID)
+		clientStore.Set(ibctm.KeyMisbehaviourHeight, []byte(misbehaviourHeight.String()))
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: client is active",
+			func() {},
+			nil,
+		},
+		{
+			"success: client is frozen and proof height is below misbehaviour height",
+			func() {
+				freezeClient(proofHeight.Increment())
+			},
+			nil,
+		},
+		{
+			"failure: client is frozen and proof height is equal to misbehaviour height",
+			func() {
+				freezeClient(proofHeight)
+			},
+			ibcerrors.ErrInvalidHeight,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			testingpath = ibctesting.NewPath(suite.chainA, suite.chainB)
+			testingpath.Setup()
+
+			key := host.NextSequenceRecvKey(ibctesting.InvalidID, ibctesting.InvalidID)
+			merklePath := commitmenttypes.NewMerklePath(string(key))
+			path, err := commitmenttypes.ApplyPrefix(suite.chainB.GetPrefix(), merklePath)
+			suite.Require().NoError(err)
+
+			var proof []byte
+			proof, proofHeight = suite.chainB.QueryProof(key)
+
+			tc.malleate()
+
+			lightClientModule, found := suite.chainA.App.GetIBCKeeper().ClientKeeper.Route(testingpath.EndpointA.ClientID)
+			suite.Require().True(found)
+
+			timeoutModule, ok := lightClientModule.(exported.TimeoutVerificationModule)
+			suite.Require().True(ok)
+
+			err = timeoutModule.VerifyNonMembershipForTimeout(
+				suite.chainA.GetContext(), testingpath.EndpointA.ClientID, proofHeight, 0, 0, proof, path,
+			)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
@@ -143,12 +143,13 @@ func checkMisbehaviourHeader(
 		return err
 	}
 
-	// assert that the age of the trusted consensus state is not older than the trusting period
-	if currentTimestamp.Sub(consState.Timestamp) >= clientState.TrustingPeriod {
+	// assert that the age of the trusted consensus state is not older than the trusting period, extended by the
+	// expiry grace period so that misbehaviour can still be submitted for clients within their grace period
+	if currentTimestamp.Sub(consState.Timestamp) >= clientState.TrustingPeriod+clientState.ExpiryGracePeriod {
 		return errorsmod.Wrapf(
 			ErrTrustingPeriodExpired,
-			"current timestamp minus the latest consensus state timestamp is greater than or equal to the trusting period (%d >= %d)",
-			currentTimestamp.Sub(consState.Timestamp), clientState.TrustingPeriod,
+			"current timestamp minus the latest consensus state timestamp is greater than or equal to the trusting period plus the expiry grace period (%d >= %d)",
+			currentTimestamp.Sub(consState.Timestamp), clientState.TrustingPeriod+clientState.ExpiryGracePeriod,
 		)
 	}
 
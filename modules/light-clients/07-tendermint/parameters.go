@@ -0,0 +1,86 @@
+package tendermint
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cometbft/cometbft/light"
+)
+
+// MaxRecommendedClockDrift is the largest max clock drift recommended for a tendermint client.
+// Larger values allow headers with timestamps far in the future to be accepted by the client.
+const MaxRecommendedClockDrift = 10 * time.Minute
+
+// CheckTrustingPeriod returns an error if the trusting period is not within its recommended bounds
+// for the provided unbonding period. The trusting period must be positive, strictly less than the
+// unbonding period and it is recommended to not exceed 2/3 of the unbonding period.
+func CheckTrustingPeriod(trustingPeriod, unbondingPeriod time.Duration) error {
+	if trustingPeriod <= 0 {
+		return errorsmod.Wrap(ErrInvalidTrustingPeriod, "trusting period must be greater than zero")
+	}
+	if unbondingPeriod <= 0 {
+		return errorsmod.Wrap(ErrInvalidUnbondingPeriod, "unbonding period must be greater than zero")
+	}
+	if trustingPeriod >= unbondingPeriod {
+		return errorsmod.Wrapf(ErrInvalidTrustingPeriod, "trusting period (%s) should be < unbonding period (%s)", trustingPeriod, unbondingPeriod)
+	}
+
+	if maxTrustingPeriod := unbondingPeriod * 2 / 3; trustingPeriod > maxTrustingPeriod {
+		return errorsmod.Wrapf(ErrTrustingPeriodNotRecommended, "trusting period (%s) should be <= 2/3 of the unbonding period (%s)", trustingPeriod, maxTrustingPeriod)
+	}
+
+	return nil
+}
+
+// CheckMaxClockDrift returns an error if the max clock drift is not within its recommended bounds
+// for the provided trusting period. The max clock drift must be positive, strictly less than the
+// trusting period and it is recommended to not exceed MaxRecommendedClockDrift.
+func CheckMaxClockDrift(maxClockDrift, trustingPeriod time.Duration) error {
+	if maxClockDrift <= 0 {
+		return errorsmod.Wrap(ErrInvalidMaxClockDrift, "max clock drift must be greater than zero")
+	}
+	if maxClockDrift >= trustingPeriod {
+		return errorsmod.Wrapf(ErrInvalidMaxClockDrift, "max clock drift (%s) should be < trusting period (%s)", maxClockDrift, trustingPeriod)
+	}
+	if maxClockDrift > MaxRecommendedClockDrift {
+		return errorsmod.Wrapf(ErrMaxClockDriftNotRecommended, "max clock drift (%s) should be <= %s", maxClockDrift, MaxRecommendedClockDrift)
+	}
+
+	return nil
+}
+
+// CheckTrustLevel returns an error if the trust level is not within [1/3, 1].
+func CheckTrustLevel(trustLevel Fraction) error {
+	if err := light.ValidateTrustLevel(trustLevel.ToTendermint()); err != nil {
+		return errorsmod.Wrap(ErrInvalidTrustLevel, err.Error())
+	}
+
+	return nil
+}
+
+// CheckParameters returns every client parameter that is not within its recommended bounds, given the
+// unbonding period of the counterparty chain. If the counterparty unbonding period is zero, the unbonding
+// period stored in the client state is used instead. An empty result indicates a correctly configured client.
+func (cs ClientState) CheckParameters(counterpartyUnbondingPeriod time.Duration) []error {
+	unbondingPeriod := cs.UnbondingPeriod
+	if counterpartyUnbondingPeriod != 0 {
+		unbondingPeriod = counterpartyUnbondingPeriod
+	}
+
+	var violations []error
+	if cs.UnbondingPeriod > unbondingPeriod {
+		violations = append(violations, errorsmod.Wrapf(ErrInvalidUnbondingPeriod, "client unbonding period (%s) should be <= counterparty unbonding period (%s)", cs.UnbondingPeriod, unbondingPeriod))
+	}
+	if err := CheckTrustingPeriod(cs.TrustingPeriod, unbondingPeriod); err != nil {
+		violations = append(violations, err)
+	}
+	if err := CheckMaxClockDrift(cs.MaxClockDrift, cs.TrustingPeriod); err != nil {
+		violations = append(violations, err)
+	}
+	if err := CheckTrustLevel(cs.TrustLevel); err != nil {
+		violations = append(violations, err)
+	}
+
+	return violations
+}
@@ -0,0 +1,147 @@
+package tendermint_test
+
+import (
+	"time"
+
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *TendermintTestSuite) TestCheckTrustingPeriod() {
+	testCases := []struct {
+		name            string
+		trustingPeriod  time.Duration
+		unbondingPeriod time.Duration
+		expErr          error
+	}{
+		{"success", ibctesting.TrustingPeriod, ibctesting.UnbondingPeriod, nil},
+		{"success: below 2/3 of unbonding period", time.Hour, ibctesting.UnbondingPeriod, nil},
+		{"trusting period is zero", 0, ibctesting.UnbondingPeriod, ibctm.ErrInvalidTrustingPeriod},
+		{"unbonding period is zero", ibctesting.TrustingPeriod, 0, ibctm.ErrInvalidUnbondingPeriod},
+		{"trusting period equals unbonding period", ibctesting.UnbondingPeriod, ibctesting.UnbondingPeriod, ibctm.ErrInvalidTrustingPeriod},
+		{"trusting period exceeds 2/3 of unbonding period", ibctesting.TrustingPeriod + time.Second, ibctesting.UnbondingPeriod, ibctm.ErrTrustingPeriodNotRecommended},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := ibctm.CheckTrustingPeriod(tc.trustingPeriod, tc.unbondingPeriod)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestCheckMaxClockDrift() {
+	testCases := []struct {
+		name           string
+		maxClockDrift  time.Duration
+		trustingPeriod time.Duration
+		expErr         error
+	}{
+		{"success", ibctesting.MaxClockDrift, ibctesting.TrustingPeriod, nil},
+		{"success: max clock drift equals recommended bound", ibctm.MaxRecommendedClockDrift, ibctesting.TrustingPeriod, nil},
+		{"max clock drift is zero", 0, ibctesting.TrustingPeriod, ibctm.ErrInvalidMaxClockDrift},
+		{"max clock drift equals trusting period", time.Minute, time.Minute, ibctm.ErrInvalidMaxClockDrift},
+		{"max clock drift exceeds recommended bound", ibctm.MaxRecommendedClockDrift + time.Second, ibctesting.TrustingPeriod, ibctm.ErrMaxClockDriftNotRecommended},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := ibctm.CheckMaxClockDrift(tc.maxClockDrift, tc.trustingPeriod)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestCheckTrustLevel() {
+	testCases := []struct {
+		name       string
+		trustLevel ibctm.Fraction
+		expErr     error
+	}{
+		{"success: default trust level", ibctm.DefaultTrustLevel, nil},
+		{"success: trust level of one", ibctm.Fraction{Numerator: 1, Denominator: 1}, nil},
+		{"trust level below 1/3", ibctm.Fraction{Numerator: 1, Denominator: 4}, ibctm.ErrInvalidTrustLevel},
+		{"trust level above one", ibctm.Fraction{Numerator: 4, Denominator: 3}, ibctm.ErrInvalidTrustLevel},
+		{"trust level with zero denominator", ibctm.Fraction{Numerator: 1, Denominator: 0}, ibctm.ErrInvalidTrustLevel},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := ibctm.CheckTrustLevel(tc.trustLevel)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestCheckParameters() {
+	var (
+		clientState                 *ibctm.ClientState
+		counterpartyUnbondingPeriod time.Duration
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErrs  []error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"success: counterparty unbonding period defaults to client unbonding period", func() {
+				counterpartyUnbondingPeriod = 0
+			}, nil,
+		},
+		{
+			"client unbonding period exceeds counterparty unbonding period", func() {
+				counterpartyUnbondingPeriod = ibctesting.UnbondingPeriod - time.Hour
+			}, []error{ibctm.ErrInvalidUnbondingPeriod, ibctm.ErrTrustingPeriodNotRecommended},
+		},
+		{
+			"multiple violations", func() {
+				clientState.MaxClockDrift = time.Hour
+				clientState.TrustLevel = ibctm.Fraction{Numerator: 1, Denominator: 4}
+			}, []error{ibctm.ErrMaxClockDriftNotRecommended, ibctm.ErrInvalidTrustLevel},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			clientState = path.EndpointA.GetClientState().(*ibctm.ClientState)
+			counterpartyUnbondingPeriod = ibctesting.UnbondingPeriod
+
+			tc.malleate()
+
+			violations := clientState.CheckParameters(counterpartyUnbondingPeriod)
+			suite.Require().Len(violations, len(tc.expErrs))
+			for i, expErr := range tc.expErrs {
+				suite.Require().ErrorIs(violations[i], expErr)
+			}
+		})
+	}
+}
@@ -23,12 +23,20 @@ import (
 // The following must always be true:
 //   - The substitute client is the same type as the subject client
 //   - The subject and substitute client states match in all parameters (expect frozen height, latest height, and chain-id)
+//   - The trusting period and expiry grace period adopted from the substitute pass CheckPeriods
 //
 // In case 1) before updating the client, the client will be unfrozen by resetting
 // the FrozenHeight to the zero Height.
+//
+// In addition to the latest height of the substitute, the consensus states of the substitute at the
+// provided trusted heights are copied to the subject. This allows the subject to be updated with headers
+// which are non-adjacent to the latest height, for example when the substitute was updated across a large
+// height gap. Each trusted height must be lower than the latest height of the substitute and have a
+// consensus state stored in the substitute client store.
 func (cs ClientState) CheckSubstituteAndUpdateState(
 	ctx sdk.Context, cdc codec.BinaryCodec, subjectClientStore,
 	substituteClientStore storetypes.KVStore, substituteClient exported.ClientState,
+	trustedHeights []exported.Height,
 ) error {
 	substituteClientState, ok := substituteClient.(*ClientState)
 	if !ok {
@@ -39,58 +47,77 @@ func (cs ClientState) CheckSubstituteAndUpdateState(
 		return errorsmod.Wrap(clienttypes.ErrInvalidSubstitute, "subject client state does not match substitute client state")
 	}
 
+	// set new trusting period and expiry grace period based on the substitute client state,
+	// the adopted periods must be consistent with the unbonding period of the subject
+	cs.TrustingPeriod = substituteClientState.TrustingPeriod
+	cs.ExpiryGracePeriod = substituteClientState.ExpiryGracePeriod
+	if err := CheckPeriods(cs); err != nil {
+		return errorsmod.Wrap(err, "invalid periods adopted from substitute client")
+	}
+
 	if cs.Status(ctx, subjectClientStore, cdc) == exported.Frozen {
 		// unfreeze the client
 		cs.FrozenHeight = clienttypes.ZeroHeight()
+		deleteMisbehaviourHeight(subjectClientStore)
 	}
 
 	// copy consensus states and processed time from substitute to subject
-	// starting from initial height and ending on the latest height (inclusive)
-	height := substituteClientState.LatestHeight
+	// for each of the trusted heights and the latest height
+	for _, height := range trustedHeights {
+		if height.GTE(substituteClientState.LatestHeight) {
+			return errorsmod.Wrapf(clienttypes.ErrInvalidHeight, "trusted height %s must be less than substitute client latest height %s", height, substituteClientState.LatestHeight)
+		}
+
+		if err := copyConsensusStateAndMetadata(cdc, subjectClientStore, substituteClientStore, height); err != nil {
+			return errorsmod.Wrapf(err, "failed to copy consensus state for trusted height %s", height)
+		}
+	}
 
-	consensusState, found := GetConsensusState(substituteClientStore, cdc, height)
-	if !found {
-		return errorsmod.Wrap(clienttypes.ErrConsensusStateNotFound, "unable to retrieve latest consensus state for substitute client")
+	if err := copyConsensusStateAndMetadata(cdc, subjectClientStore, substituteClientStore, substituteClientState.LatestHeight); err != nil {
+		return errorsmod.Wrap(err, "failed to copy consensus state for substitute client latest height")
 	}
 
-	setConsensusState(subjectClientStore, cdc, consensusState, height)
+	cs.LatestHeight = substituteClientState.LatestHeight
+	cs.ChainId = substituteClientState.ChainId
 
-	// set metadata stored for the substitute consensus state
-	processedHeight, found := GetProcessedHeight(substituteClientStore, height)
+	setClientState(subjectClientStore, cdc, &cs)
+
+	return nil
+}
+
+// copyConsensusStateAndMetadata copies the consensus state and the associated processed height and
+// processed time at the provided height from the substitute client store to the subject client store.
+func copyConsensusStateAndMetadata(cdc codec.BinaryCodec, subjectClientStore, substituteClientStore storetypes.KVStore, height exported.Height) error {
+	consensusState, found := GetConsensusState(substituteClientStore, cdc, height)
 	if !found {
-		return errorsmod.Wrap(clienttypes.ErrUpdateClientFailed, "unable to retrieve processed height for substitute client latest height")
+		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "unable to retrieve consensus state for substitute client at height %s", height)
 	}
 
-	processedTime, found := GetProcessedTime(substituteClientStore, height)
-	if !found {
-		return errorsmod.Wrap(clienttypes.ErrUpdateClientFailed, "unable to retrieve processed time for substitute client latest height")
+	// the metadata of a stored consensus state is only missing if it has been pruned or corrupted,
+	// in which case the substitute must be updated to a new height before it can be used
+	processedHeight, processedTime, err := getConsensusMetadata(substituteClientStore, height)
+	if err != nil {
+		return errorsmod.Wrapf(err, "consensus state metadata of substitute client is incomplete, update the substitute client to a new height and retry")
 	}
 
+	setConsensusState(subjectClientStore, cdc, consensusState, height)
 	setConsensusMetadataWithValues(subjectClientStore, height, processedHeight, processedTime)
 
-	cs.LatestHeight = substituteClientState.LatestHeight
-	cs.ChainId = substituteClientState.ChainId
-
-	// set new trusting period based on the substitute client state
-	cs.TrustingPeriod = substituteClientState.TrustingPeriod
-
-	// no validation is necessary since the substitute is verified to be Active
-	// in 02-client.
-	setClientState(subjectClientStore, cdc, &cs)
-
 	return nil
 }
 
 // IsMatchingClientState returns true if all the client state parameters match
-// except for frozen height, latest height, trusting period, chain-id.
+// except for frozen height, latest height, trusting period, expiry grace period, chain-id.
 func IsMatchingClientState(subject, substitute ClientState) bool {
 	// zero out parameters which do not need to match
 	subject.LatestHeight = clienttypes.ZeroHeight()
 	subject.FrozenHeight = clienttypes.ZeroHeight()
 	subject.TrustingPeriod = time.Duration(0)
+	subject.ExpiryGracePeriod = time.Duration(0)
 	substitute.LatestHeight = clienttypes.ZeroHeight()
 	substitute.FrozenHeight = clienttypes.ZeroHeight()
 	substitute.TrustingPeriod = time.Duration(0)
+	substitute.ExpiryGracePeriod = time.Duration(0)
 	subject.ChainId = ""
 	substitute.ChainId = ""
 	// sets both sets of flags to true as these flags have been DEPRECATED, see ADR-026 for more information
@@ -37,6 +37,26 @@ func (suite *TendermintTestSuite) TestCheckSubstituteUpdateStateBasic() {
 				tmClientState.ChainId += "different chain"
 			},
 		},
+		{
+			"substitute trusting period not less than unbonding period", func() {
+				substitutePath.SetupClients()
+				tmClientState, ok := suite.chainA.GetClientState(substitutePath.EndpointA.ClientID).(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				tmClientState.TrustingPeriod = tmClientState.UnbondingPeriod
+				substituteClientState = tmClientState
+			},
+		},
+		{
+			"substitute trusting period + expiry grace period not less than unbonding period", func() {
+				substitutePath.SetupClients()
+				tmClientState, ok := suite.chainA.GetClientState(substitutePath.EndpointA.ClientID).(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				tmClientState.ExpiryGracePeriod = tmClientState.UnbondingPeriod - tmClientState.TrustingPeriod
+				substituteClientState = tmClientState
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -60,7 +80,7 @@ func (suite *TendermintTestSuite) TestCheckSubstituteUpdateStateBasic() {
 			subjectClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), subjectPath.EndpointA.ClientID)
 			substituteClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), substitutePath.EndpointA.ClientID)
 
-			err := subjectClientState.CheckSubstituteAndUpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), subjectClientStore, substituteClientStore, substituteClientState)
+			err := subjectClientState.CheckSubstituteAndUpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), subjectClientStore, substituteClientStore, substituteClientState, nil)
 			suite.Require().Error(err)
 		})
 	}
@@ -98,6 +118,9 @@ func (suite *TendermintTestSuite) TestCheckSubstituteAndUpdateState() {
 
 			if tc.FreezeClient {
 				subjectClientState.FrozenHeight = frozenHeight
+
+				subjectClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), subjectPath.EndpointA.ClientID)
+				subjectClientStore.Set(ibctm.KeyMisbehaviourHeight, []byte(subjectClientState.LatestHeight.String()))
 			}
 
 			// construct the substitute to match the subject client
@@ -136,7 +159,7 @@ func (suite *TendermintTestSuite) TestCheckSubstituteAndUpdateState() {
 			suite.Require().True(found)
 			expectedIterationKey := ibctm.GetIterationKey(substituteClientStore, substituteClientState.LatestHeight)
 
-			err := subjectClientState.CheckSubstituteAndUpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), subjectClientStore, substituteClientStore, substituteClientState)
+			err := subjectClientState.CheckSubstituteAndUpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), subjectClientStore, substituteClientStore, substituteClientState, nil)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -163,6 +186,9 @@ func (suite *TendermintTestSuite) TestCheckSubstituteAndUpdateState() {
 
 				suite.Require().Equal(newChainID, updatedClient.ChainId)
 				suite.Require().Equal(time.Hour*24*7, updatedClient.TrustingPeriod)
+
+				_, found = ibctm.GetMisbehaviourHeight(subjectClientStore)
+				suite.Require().False(found)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -170,6 +196,147 @@ func (suite *TendermintTestSuite) TestCheckSubstituteAndUpdateState() {
 	}
 }
 
+func (suite *TendermintTestSuite) TestCheckSubstituteAndUpdateStateWithTrustedHeights() {
+	var (
+		substitutePath        *ibctesting.Path
+		substituteClientState *ibctm.ClientState
+		intermediateHeight    exported.Height
+		trustedHeights        []exported.Height
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: intermediate height is copied",
+			func() {},
+			nil,
+		},
+		{
+			"success: no trusted heights",
+			func() {
+				trustedHeights = nil
+			},
+			nil,
+		},
+		{
+			"failure: trusted height equal to substitute latest height",
+			func() {
+				trustedHeights = []exported.Height{substituteClientState.LatestHeight}
+			},
+			clienttypes.ErrInvalidHeight,
+		},
+		{
+			"failure: trusted height greater than substitute latest height",
+			func() {
+				trustedHeights = []exported.Height{substituteClientState.LatestHeight.Increment()}
+			},
+			clienttypes.ErrInvalidHeight,
+		},
+		{
+			"failure: consensus state not found for trusted height",
+			func() {
+				// the block prior to the latest height is skipped when updating the substitute
+				latestHeight := substituteClientState.LatestHeight
+				trustedHeights = []exported.Height{clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()-1)}
+			},
+			clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"failure: processed height not found for trusted height",
+			func() {
+				substituteClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), substitutePath.EndpointA.ClientID)
+				substituteClientStore.Delete(ibctm.ProcessedHeightKey(intermediateHeight))
+			},
+			ibctm.ErrProcessedHeightNotFound,
+		},
+		{
+			"failure: processed time not found for trusted height",
+			func() {
+				substituteClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), substitutePath.EndpointA.ClientID)
+				substituteClientStore.Delete(ibctm.ProcessedTimeKey(intermediateHeight))
+			},
+			ibctm.ErrProcessedTimeNotFound,
+		},
+		{
+			"failure: processed height and processed time not found for substitute latest height",
+			func() {
+				substituteClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), substitutePath.EndpointA.ClientID)
+				substituteClientStore.Delete(ibctm.ProcessedHeightKey(substituteClientState.LatestHeight))
+				substituteClientStore.Delete(ibctm.ProcessedTimeKey(substituteClientState.LatestHeight))
+			},
+			ibctm.ErrConsensusMetadataNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			subjectPath := ibctesting.NewPath(suite.chainA, suite.chainB)
+			subjectPath.SetupClients()
+			subjectClientState, ok := suite.chainA.GetClientState(subjectPath.EndpointA.ClientID).(*ibctm.ClientState)
+			suite.Require().True(ok)
+
+			substitutePath = ibctesting.NewPath(suite.chainA, suite.chainB)
+			substitutePath.SetupClients()
+
+			// update substitute a few times, skipping a block in between each update
+			var updatedHeights []exported.Height
+			for i := 0; i < 3; i++ {
+				err := substitutePath.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+				updatedHeights = append(updatedHeights, substitutePath.EndpointA.GetClientLatestHeight())
+
+				suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+			}
+
+			substituteClientState, ok = suite.chainA.GetClientState(substitutePath.EndpointA.ClientID).(*ibctm.ClientState)
+			suite.Require().True(ok)
+
+			intermediateHeight = updatedHeights[0]
+			trustedHeights = []exported.Height{intermediateHeight}
+
+			tc.malleate()
+
+			subjectClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), subjectPath.EndpointA.ClientID)
+			substituteClientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), substitutePath.EndpointA.ClientID)
+
+			err := subjectClientState.CheckSubstituteAndUpdateState(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), subjectClientStore, substituteClientStore, substituteClientState, trustedHeights)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				for _, height := range append(trustedHeights, substituteClientState.LatestHeight) {
+					suite.Require().Equal(substitutePath.EndpointA.GetConsensusState(height), subjectPath.EndpointA.GetConsensusState(height))
+
+					expectedProcessedTime, found := ibctm.GetProcessedTime(substituteClientStore, height)
+					suite.Require().True(found)
+					processedTime, found := ibctm.GetProcessedTime(subjectClientStore, height)
+					suite.Require().True(found)
+					suite.Require().Equal(expectedProcessedTime, processedTime)
+
+					expectedProcessedHeight, found := ibctm.GetProcessedHeight(substituteClientStore, height)
+					suite.Require().True(found)
+					processedHeight, found := ibctm.GetProcessedHeight(subjectClientStore, height)
+					suite.Require().True(found)
+					suite.Require().Equal(expectedProcessedHeight, processedHeight)
+				}
+
+				// intermediate consensus state is only copied when provided as a trusted height
+				_, found := ibctm.GetConsensusState(subjectClientStore, suite.chainA.App.AppCodec(), intermediateHeight)
+				suite.Require().Equal(len(trustedHeights) != 0, found)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *TendermintTestSuite) TestIsMatchingClientState() {
 	var (
 		subjectPath, substitutePath               *ibctesting.Path
@@ -0,0 +1,47 @@
+package tendermint
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+// ConsensusSnapshotVersion is the version of the consensus state snapshot format produced by ExportConsensusSnapshot.
+const ConsensusSnapshotVersion uint32 = 1
+
+// ExportConsensusSnapshot returns the consensus state stored at the provided height together with its metadata,
+// serialized as a ConsensusStateSnapshot. The snapshot includes the chain id of the client, the height of the
+// consensus state and the snapshot format version, such that it can be verified against the counterparty chain
+// off-chain. Snapshots cannot be imported into a client store on-chain, as the consensus state of a snapshot is
+// not verified against the counterparty chain.
+func (cs ClientState) ExportConsensusSnapshot(clientStore storetypes.KVStore, cdc codec.BinaryCodec, height exported.Height) ([]byte, error) {
+	consensusState, found := GetConsensusState(clientStore, cdc, height)
+	if !found {
+		return nil, errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "consensus state not found for height %s", height)
+	}
+
+	processedTime, found := GetProcessedTime(clientStore, height)
+	if !found {
+		return nil, errorsmod.Wrapf(ErrProcessedTimeNotFound, "processed time not found for height %s", height)
+	}
+
+	processedHeight, found := GetProcessedHeight(clientStore, height)
+	if !found {
+		return nil, errorsmod.Wrapf(ErrProcessedHeightNotFound, "processed height not found for height %s", height)
+	}
+
+	snapshot := ConsensusStateSnapshot{
+		Version:         ConsensusSnapshotVersion,
+		ChainId:         cs.ChainId,
+		Height:          clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()),
+		ConsensusState:  *consensusState,
+		ProcessedTime:   processedTime,
+		ProcessedHeight: clienttypes.NewHeight(processedHeight.GetRevisionNumber(), processedHeight.GetRevisionHeight()),
+	}
+
+	return cdc.Marshal(&snapshot)
+}
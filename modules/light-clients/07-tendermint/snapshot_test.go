@@ -0,0 +1,116 @@
+package tendermint_test
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	tendermint "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *TendermintTestSuite) TestExportConsensusSnapshot() {
+	var (
+		path   *ibctesting.Path
+		height exported.Height
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"consensus state not found", func() {
+				height = height.Increment()
+			}, clienttypes.ErrConsensusStateNotFound,
+		},
+		{
+			"processed time not found", func() {
+				store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				store.Delete(tendermint.ProcessedTimeKey(height))
+			}, tendermint.ErrProcessedTimeNotFound,
+		},
+		{
+			"processed height not found", func() {
+				store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+				store.Delete(tendermint.ProcessedHeightKey(height))
+			}, tendermint.ErrProcessedHeightNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetupClients()
+
+			height = path.EndpointA.GetClientLatestHeight()
+
+			tc.malleate()
+
+			clientState := path.EndpointA.GetClientState().(*tendermint.ClientState)
+			store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+
+			bz, err := clientState.ExportConsensusSnapshot(store, suite.chainA.Codec, height)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				var snapshot tendermint.ConsensusStateSnapshot
+				suite.Require().NoError(suite.chainA.Codec.Unmarshal(bz, &snapshot))
+				suite.Require().Equal(tendermint.ConsensusSnapshotVersion, snapshot.Version)
+				suite.Require().Equal(clientState.ChainId, snapshot.ChainId)
+				suite.Require().Equal(height, snapshot.Height)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Nil(bz)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestConsensusSnapshotRoundTrip() {
+	suite.SetupTest()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	height := path.EndpointA.GetClientLatestHeight()
+	clientState := path.EndpointA.GetClientState().(*tendermint.ClientState)
+	store := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+
+	bz, err := clientState.ExportConsensusSnapshot(store, suite.chainA.Codec, height)
+	suite.Require().NoError(err)
+
+	var snapshot tendermint.ConsensusStateSnapshot
+	suite.Require().NoError(suite.chainA.Codec.Unmarshal(bz, &snapshot))
+
+	expConsensusState, found := tendermint.GetConsensusState(store, suite.chainA.Codec, height)
+	suite.Require().True(found)
+	suite.Require().Equal(*expConsensusState, snapshot.ConsensusState)
+
+	expProcessedTime, found := tendermint.GetProcessedTime(store, height)
+	suite.Require().True(found)
+	suite.Require().Equal(expProcessedTime, snapshot.ProcessedTime)
+
+	expProcessedHeight, found := tendermint.GetProcessedHeight(store, height)
+	suite.Require().True(found)
+	suite.Require().Equal(expProcessedHeight, snapshot.ProcessedHeight)
+
+	// storing the decoded snapshot in a fresh client store and re-exporting it yields the same snapshot
+	const freshClientID = "07-tendermint-100"
+	suite.chainA.App.GetIBCKeeper().ClientKeeper.SetClientConsensusState(suite.chainA.GetContext(), freshClientID, snapshot.Height, &snapshot.ConsensusState)
+
+	freshStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), freshClientID)
+	tendermint.SetProcessedTime(freshStore, snapshot.Height, snapshot.ProcessedTime)
+	tendermint.SetProcessedHeight(freshStore, snapshot.Height, snapshot.ProcessedHeight)
+
+	reexported, err := clientState.ExportConsensusSnapshot(freshStore, suite.chainA.Codec, snapshot.Height)
+	suite.Require().NoError(err)
+	suite.Require().Equal(bz, reexported)
+}
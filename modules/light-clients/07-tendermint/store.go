@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
@@ -42,6 +43,8 @@ var (
 	KeyProcessedHeight = []byte("/processedHeight")
 	// KeyIteration stores the key mapping to consensus state key for efficient iteration
 	KeyIteration = []byte("/iterationKey")
+	// KeyMisbehaviourHeight stores the lowest trusted height of the misbehaviour which froze the client
+	KeyMisbehaviourHeight = []byte("/misbehaviourHeight")
 )
 
 // setClientState stores the client state
@@ -165,6 +168,50 @@ func deleteProcessedHeight(clientStore storetypes.KVStore, height exported.Heigh
 	clientStore.Delete(key)
 }
 
+// getConsensusMetadata returns the processed height and processed time stored for the consensus state at
+// the given height. The returned error reports precisely which of the metadata is missing: if only one of
+// them is missing, ErrProcessedHeightNotFound or ErrProcessedTimeNotFound is returned, if both are missing,
+// ErrConsensusMetadataNotFound is returned.
+func getConsensusMetadata(clientStore storetypes.KVStore, height exported.Height) (exported.Height, uint64, error) {
+	processedHeight, heightFound := GetProcessedHeight(clientStore, height)
+	processedTime, timeFound := GetProcessedTime(clientStore, height)
+
+	switch {
+	case !heightFound && !timeFound:
+		return nil, 0, errorsmod.Wrapf(ErrConsensusMetadataNotFound, "processed height and processed time not found for height %s", height)
+	case !heightFound:
+		return nil, 0, errorsmod.Wrapf(ErrProcessedHeightNotFound, "processed height not found for height %s, processed time is present", height)
+	case !timeFound:
+		return nil, 0, errorsmod.Wrapf(ErrProcessedTimeNotFound, "processed time not found for height %s, processed height is present", height)
+	}
+
+	return processedHeight, processedTime, nil
+}
+
+// setMisbehaviourHeight stores the lowest trusted height of the misbehaviour which froze the client.
+func setMisbehaviourHeight(clientStore storetypes.KVStore, height exported.Height) {
+	clientStore.Set(KeyMisbehaviourHeight, []byte(height.String()))
+}
+
+// GetMisbehaviourHeight gets the lowest trusted height of the misbehaviour which froze the client.
+// Proofs at heights prior to the misbehaviour height may still be used to time out packets on a frozen client.
+func GetMisbehaviourHeight(clientStore storetypes.KVStore) (exported.Height, bool) {
+	bz := clientStore.Get(KeyMisbehaviourHeight)
+	if len(bz) == 0 {
+		return nil, false
+	}
+	misbehaviourHeight, err := clienttypes.ParseHeight(string(bz))
+	if err != nil {
+		return nil, false
+	}
+	return misbehaviourHeight, true
+}
+
+// deleteMisbehaviourHeight deletes the misbehaviour height stored for a frozen client
+func deleteMisbehaviourHeight(clientStore storetypes.KVStore) {
+	clientStore.Delete(KeyMisbehaviourHeight)
+}
+
 // IterationKey returns the key under which the consensus state key will be stored.
 // The iteration key is a BigEndian representation of the consensus state key to support efficient iteration.
 func IterationKey(height exported.Height) []byte {
@@ -292,6 +339,27 @@ func PruneAllExpiredConsensusStates(
 	return len(heights)
 }
 
+// PruneConsensusStatesFrom deletes up to limit consensus states of the given client store at
+// heights greater than or equal to the given height along with their metadata. All such consensus
+// states are deleted if limit is zero. The heights of the pruned consensus states are returned in
+// ascending order.
+func PruneConsensusStatesFrom(clientStore storetypes.KVStore, height exported.Height, limit int) []exported.Height {
+	var heights []exported.Height
+
+	iterator := clientStore.Iterator(IterationKey(height), storetypes.PrefixEndBytes([]byte(KeyIterateConsensusStatePrefix)))
+	for ; iterator.Valid() && (limit == 0 || len(heights) < limit); iterator.Next() {
+		heights = append(heights, GetHeightFromIterationKey(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, height := range heights {
+		deleteConsensusState(clientStore, height)
+		deleteConsensusMetadata(clientStore, height)
+	}
+
+	return heights
+}
+
 // Helper function for GetNextConsensusState and GetPreviousConsensusState
 func getTmConsensusState(clientStore storetypes.KVStore, cdc codec.BinaryCodec, key []byte) (*ConsensusState, bool) {
 	bz := clientStore.Get(key)
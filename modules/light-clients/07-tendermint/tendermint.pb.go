@@ -63,6 +63,9 @@ type ClientState struct {
 	AllowUpdateAfterExpiry bool `protobuf:"varint,10,opt,name=allow_update_after_expiry,json=allowUpdateAfterExpiry,proto3" json:"allow_update_after_expiry,omitempty"` // Deprecated: Do not use.
 	// allow_update_after_misbehaviour is deprecated
 	AllowUpdateAfterMisbehaviour bool `protobuf:"varint,11,opt,name=allow_update_after_misbehaviour,json=allowUpdateAfterMisbehaviour,proto3" json:"allow_update_after_misbehaviour,omitempty"` // Deprecated: Do not use.
+	// duration past the trusting period during which proofs may still be verified
+	// against the expired client, while updates are rejected. Zero disables the grace period.
+	ExpiryGracePeriod time.Duration `protobuf:"bytes,12,opt,name=expiry_grace_period,json=expiryGracePeriod,proto3,stdduration" json:"expiry_grace_period"`
 }
 
 func (m *ClientState) Reset()         { *m = ClientState{} }
@@ -141,6 +144,98 @@ func (m *ConsensusState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ConsensusState proto.InternalMessageInfo
 
+// ConsensusStateSnapshot defines a consensus state together with its metadata in a
+// stable format for off-chain distribution.
+type ConsensusStateSnapshot struct {
+	// version of the snapshot format
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// chain id of the chain the consensus state belongs to
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// height of the consensus state
+	Height types.Height `protobuf:"bytes,3,opt,name=height,proto3" json:"height"`
+	// consensus state at the height
+	ConsensusState ConsensusState `protobuf:"bytes,4,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state"`
+	// time at which the consensus state was processed by the exporting client, in nanoseconds
+	ProcessedTime uint64 `protobuf:"varint,5,opt,name=processed_time,json=processedTime,proto3" json:"processed_time,omitempty"`
+	// height at which the consensus state was processed by the exporting client
+	ProcessedHeight types.Height `protobuf:"bytes,6,opt,name=processed_height,json=processedHeight,proto3" json:"processed_height"`
+}
+
+func (m *ConsensusStateSnapshot) Reset()         { *m = ConsensusStateSnapshot{} }
+func (m *ConsensusStateSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ConsensusStateSnapshot) ProtoMessage()    {}
+func (*ConsensusStateSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c6d6cf2b288949be, []int{2}
+}
+func (m *ConsensusStateSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsensusStateSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsensusStateSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsensusStateSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsensusStateSnapshot.Merge(m, src)
+}
+func (m *ConsensusStateSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsensusStateSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsensusStateSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsensusStateSnapshot proto.InternalMessageInfo
+
+func (m *ConsensusStateSnapshot) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ConsensusStateSnapshot) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *ConsensusStateSnapshot) GetHeight() types.Height {
+	if m != nil {
+		return m.Height
+	}
+	return types.Height{}
+}
+
+func (m *ConsensusStateSnapshot) GetConsensusState() ConsensusState {
+	if m != nil {
+		return m.ConsensusState
+	}
+	return ConsensusState{}
+}
+
+func (m *ConsensusStateSnapshot) GetProcessedTime() uint64 {
+	if m != nil {
+		return m.ProcessedTime
+	}
+	return 0
+}
+
+func (m *ConsensusStateSnapshot) GetProcessedHeight() types.Height {
+	if m != nil {
+		return m.ProcessedHeight
+	}
+	return types.Height{}
+}
+
 // Misbehaviour is a wrapper over two conflicting Headers
 // that implements Misbehaviour interface expected by ICS-02
 type Misbehaviour struct {
@@ -154,7 +249,7 @@ func (m *Misbehaviour) Reset()         { *m = Misbehaviour{} }
 func (m *Misbehaviour) String() string { return proto.CompactTextString(m) }
 func (*Misbehaviour) ProtoMessage()    {}
 func (*Misbehaviour) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6d6cf2b288949be, []int{2}
+	return fileDescriptor_c6d6cf2b288949be, []int{3}
 }
 func (m *Misbehaviour) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +301,7 @@ func (m *Header) Reset()         { *m = Header{} }
 func (m *Header) String() string { return proto.CompactTextString(m) }
 func (*Header) ProtoMessage()    {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6d6cf2b288949be, []int{3}
+	return fileDescriptor_c6d6cf2b288949be, []int{4}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -267,7 +362,7 @@ func (m *Fraction) Reset()         { *m = Fraction{} }
 func (m *Fraction) String() string { return proto.CompactTextString(m) }
 func (*Fraction) ProtoMessage()    {}
 func (*Fraction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c6d6cf2b288949be, []int{4}
+	return fileDescriptor_c6d6cf2b288949be, []int{5}
 }
 func (m *Fraction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -313,6 +408,7 @@ func (m *Fraction) GetDenominator() uint64 {
 func init() {
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.tendermint.v1.ClientState")
 	proto.RegisterType((*ConsensusState)(nil), "ibc.lightclients.tendermint.v1.ConsensusState")
+	proto.RegisterType((*ConsensusStateSnapshot)(nil), "ibc.lightclients.tendermint.v1.ConsensusStateSnapshot")
 	proto.RegisterType((*Misbehaviour)(nil), "ibc.lightclients.tendermint.v1.Misbehaviour")
 	proto.RegisterType((*Header)(nil), "ibc.lightclients.tendermint.v1.Header")
 	proto.RegisterType((*Fraction)(nil), "ibc.lightclients.tendermint.v1.Fraction")
@@ -323,66 +419,74 @@ func init() {
 }
 
 var fileDescriptor_c6d6cf2b288949be = []byte{
-	// 943 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x4f, 0x6f, 0xe3, 0xc4,
-	0x1b, 0xc7, 0xe3, 0x34, 0xdb, 0x26, 0x93, 0x64, 0xfb, 0xfb, 0x8d, 0x56, 0xc8, 0xad, 0xaa, 0x24,
-	0xf4, 0x00, 0xb9, 0xd4, 0xde, 0x64, 0x91, 0x40, 0x2c, 0x1c, 0x48, 0x77, 0xa1, 0x5d, 0xb6, 0x50,
-	0xb9, 0xc0, 0x81, 0x8b, 0x35, 0xb6, 0x27, 0xf6, 0x68, 0x6d, 0x8f, 0x35, 0x33, 0x0e, 0x29, 0x27,
-	0x8e, 0x1c, 0xf7, 0xc8, 0x91, 0x97, 0xc0, 0xcb, 0xd8, 0x63, 0x2f, 0x48, 0x9c, 0x0a, 0x4a, 0xdf,
-	0x05, 0x27, 0x34, 0x7f, 0x9c, 0x98, 0xb2, 0x62, 0x2b, 0x2e, 0xd1, 0x33, 0xf3, 0x7c, 0x9f, 0x4f,
-	0x66, 0x9e, 0x3f, 0x63, 0xe0, 0x92, 0x20, 0x74, 0x53, 0x12, 0x27, 0x22, 0x4c, 0x09, 0xce, 0x05,
-	0x77, 0x05, 0xce, 0x23, 0xcc, 0x32, 0x92, 0x0b, 0x77, 0x31, 0xa9, 0xad, 0x9c, 0x82, 0x51, 0x41,
-	0xe1, 0x80, 0x04, 0xa1, 0x53, 0x0f, 0x70, 0x6a, 0x92, 0xc5, 0x64, 0x7f, 0x54, 0x8b, 0x17, 0x97,
-	0x05, 0xe6, 0xee, 0x02, 0xa5, 0x24, 0x42, 0x82, 0x32, 0x4d, 0xd8, 0x3f, 0xf8, 0x87, 0x42, 0xfd,
-	0x56, 0xde, 0x90, 0xf2, 0x8c, 0x72, 0x97, 0x84, 0x7c, 0xfa, 0x48, 0x9e, 0xa0, 0x60, 0x94, 0xce,
-	0x2b, 0xef, 0x20, 0xa6, 0x34, 0x4e, 0xb1, 0xab, 0x56, 0x41, 0x39, 0x77, 0xa3, 0x92, 0x21, 0x41,
-	0x68, 0x6e, 0xfc, 0xc3, 0xdb, 0x7e, 0x41, 0x32, 0xcc, 0x05, 0xca, 0x8a, 0x4a, 0x20, 0xef, 0x1b,
-	0x52, 0x86, 0x5d, 0x7d, 0x7c, 0xf9, 0x0f, 0xda, 0x32, 0x82, 0x77, 0x37, 0x02, 0x9a, 0x65, 0x44,
-	0x64, 0x95, 0x68, 0xbd, 0x32, 0xc2, 0x07, 0x31, 0x8d, 0xa9, 0x32, 0x5d, 0x69, 0xe9, 0xdd, 0xc3,
-	0xd5, 0x3d, 0xd0, 0x3d, 0x56, 0xbc, 0x0b, 0x81, 0x04, 0x86, 0x7b, 0xa0, 0x1d, 0x26, 0x88, 0xe4,
-	0x3e, 0x89, 0x6c, 0x6b, 0x64, 0x8d, 0x3b, 0xde, 0x8e, 0x5a, 0x9f, 0x46, 0xf0, 0x4b, 0xd0, 0x15,
-	0xac, 0xe4, 0xc2, 0x4f, 0xf1, 0x02, 0xa7, 0x76, 0x73, 0x64, 0x8d, 0xbb, 0xd3, 0xb1, 0xf3, 0xef,
-	0xf9, 0x75, 0x3e, 0x65, 0x28, 0x94, 0x17, 0x9e, 0xb5, 0x5e, 0x5d, 0x0f, 0x1b, 0x1e, 0x50, 0x88,
-	0xe7, 0x92, 0x00, 0x9f, 0x83, 0x5d, 0xb5, 0x22, 0x79, 0xec, 0x17, 0x98, 0x11, 0x1a, 0xd9, 0x5b,
-	0x0a, 0xba, 0xe7, 0xe8, 0xb4, 0x38, 0x55, 0x5a, 0x9c, 0x27, 0x26, 0x6d, 0xb3, 0xb6, 0xa4, 0xfc,
-	0xf4, 0xfb, 0xd0, 0xf2, 0xee, 0x57, 0xb1, 0xe7, 0x2a, 0x14, 0x7e, 0x01, 0xfe, 0x57, 0xe6, 0x01,
-	0xcd, 0xa3, 0x1a, 0xae, 0x75, 0x77, 0xdc, 0xee, 0x3a, 0xd8, 0xf0, 0x3e, 0x07, 0xbb, 0x19, 0x5a,
-	0xfa, 0x61, 0x4a, 0xc3, 0x17, 0x7e, 0xc4, 0xc8, 0x5c, 0xd8, 0xf7, 0xee, 0x8e, 0xeb, 0x67, 0x68,
-	0x79, 0x2c, 0x43, 0x9f, 0xc8, 0x48, 0xf8, 0x14, 0xf4, 0xe7, 0x8c, 0x7e, 0x8f, 0x73, 0x3f, 0xc1,
-	0x32, 0x57, 0xf6, 0xb6, 0x42, 0xed, 0xab, 0xec, 0xc9, 0xea, 0x39, 0xa6, 0xa8, 0x8b, 0x89, 0x73,
-	0xa2, 0x14, 0x26, 0x5f, 0x3d, 0x1d, 0xa6, 0xf7, 0x24, 0x26, 0x45, 0x02, 0x73, 0x51, 0x61, 0x76,
-	0xee, 0x8a, 0xd1, 0x61, 0x06, 0xf3, 0x18, 0x74, 0x55, 0x97, 0xfa, 0xbc, 0xc0, 0x21, 0xb7, 0xdb,
-	0xa3, 0x2d, 0x05, 0xd1, 0x9d, 0xec, 0xa8, 0x4e, 0x96, 0x84, 0x73, 0xa9, 0xb9, 0x28, 0x70, 0xe8,
-	0x81, 0xa2, 0x32, 0x39, 0x7c, 0x1b, 0xf4, 0xca, 0x22, 0x66, 0x28, 0xc2, 0x7e, 0x81, 0x44, 0x62,
-	0x77, 0x46, 0x5b, 0xe3, 0x8e, 0xd7, 0x35, 0x7b, 0xe7, 0x48, 0x24, 0xf0, 0x63, 0xb0, 0x87, 0xd2,
-	0x94, 0x7e, 0xe7, 0x97, 0x45, 0x84, 0x04, 0xf6, 0xd1, 0x5c, 0x60, 0xe6, 0xe3, 0x65, 0x41, 0xd8,
-	0xa5, 0x0d, 0x46, 0xd6, 0xb8, 0x3d, 0x6b, 0xda, 0x96, 0xf7, 0x96, 0x12, 0x7d, 0xad, 0x34, 0x9f,
-	0x48, 0xc9, 0x53, 0xa5, 0x80, 0xa7, 0x60, 0xf8, 0x9a, 0xf0, 0x8c, 0xf0, 0x00, 0x27, 0x68, 0x41,
-	0x68, 0xc9, 0xec, 0xee, 0x1a, 0x72, 0x70, 0x1b, 0x72, 0x56, 0xd3, 0x7d, 0xd8, 0xfa, 0xf1, 0xe7,
-	0x61, 0xe3, 0xf0, 0x87, 0x26, 0xb8, 0x7f, 0x4c, 0x73, 0x8e, 0x73, 0x5e, 0x72, 0xdd, 0xe7, 0x33,
-	0xd0, 0x59, 0x8f, 0x9a, 0x6a, 0x74, 0x99, 0x80, 0xdb, 0x75, 0xfd, 0xaa, 0x52, 0xe8, 0xc2, 0xbe,
-	0x94, 0x85, 0xdd, 0x84, 0xc1, 0x8f, 0x40, 0x8b, 0x51, 0x2a, 0xcc, 0x24, 0x1c, 0xd6, 0x8a, 0xb0,
-	0x99, 0xbd, 0xc5, 0xc4, 0x39, 0xc3, 0xec, 0x45, 0x8a, 0x3d, 0x4a, 0xab, 0x62, 0xa8, 0x28, 0x38,
-	0x07, 0x0f, 0x72, 0xbc, 0x14, 0xfe, 0xfa, 0xb9, 0xe1, 0x7e, 0x82, 0x78, 0xa2, 0x46, 0xa0, 0x37,
-	0x7b, 0xef, 0xcf, 0xeb, 0xe1, 0xc3, 0x98, 0x88, 0xa4, 0x0c, 0x24, 0x4e, 0x8e, 0x33, 0x16, 0xc1,
-	0x5c, 0x6c, 0x8c, 0x94, 0x04, 0xdc, 0x0d, 0x2e, 0x05, 0xe6, 0xce, 0x09, 0x5e, 0xce, 0xa4, 0xe1,
-	0x41, 0x49, 0xfc, 0x66, 0x0d, 0x3c, 0x41, 0x3c, 0x31, 0x29, 0xf8, 0xd5, 0x02, 0xbd, 0x7a, 0x66,
-	0xe0, 0x10, 0x74, 0x74, 0xaf, 0xac, 0x27, 0x5d, 0xa5, 0xb3, 0xad, 0x37, 0x4f, 0xe5, 0x3c, 0xb5,
-	0x13, 0x8c, 0x22, 0xcc, 0xfc, 0x89, 0xb9, 0xe1, 0x3b, 0x6f, 0x9a, 0xf5, 0x13, 0xa5, 0x9f, 0x75,
-	0x57, 0xd7, 0xc3, 0x1d, 0x6d, 0x4f, 0xbc, 0x1d, 0x0d, 0x99, 0xd4, 0x78, 0x53, 0x33, 0xe6, 0xff,
-	0x81, 0x37, 0xad, 0x78, 0x53, 0x73, 0xaf, 0x5f, 0x9a, 0x60, 0x5b, 0xbb, 0xe0, 0x29, 0xe8, 0x73,
-	0x12, 0xe7, 0x38, 0xf2, 0xb5, 0xc4, 0x94, 0x75, 0x50, 0x87, 0xea, 0x97, 0xfb, 0x42, 0xc9, 0x0c,
-	0xbd, 0x75, 0x75, 0x3d, 0xb4, 0xbc, 0x1e, 0xaf, 0xed, 0xc1, 0x63, 0xd0, 0x5f, 0x97, 0xc5, 0xe7,
-	0xb8, 0x2a, 0xf1, 0x6b, 0x50, 0xeb, 0x64, 0x5f, 0x60, 0xe1, 0xf5, 0x16, 0xb5, 0x15, 0xfc, 0x0c,
-	0xe8, 0x27, 0x4a, 0x1d, 0x48, 0x4d, 0xeb, 0xd6, 0x1d, 0xa7, 0xb5, 0x6f, 0xe2, 0xcc, 0xb8, 0x9e,
-	0x01, 0x58, 0x81, 0x36, 0xcd, 0x62, 0xde, 0xb6, 0x37, 0x1d, 0xe9, 0xff, 0x26, 0x72, 0xd3, 0x14,
-	0x87, 0xcf, 0x40, 0xbb, 0x7a, 0x94, 0xe1, 0x01, 0xe8, 0xe4, 0x65, 0x86, 0x99, 0xf4, 0xa8, 0x7c,
-	0xb5, 0xbc, 0xcd, 0x06, 0x1c, 0x81, 0x6e, 0x84, 0x73, 0x9a, 0x91, 0x5c, 0xf9, 0x9b, 0xca, 0x5f,
-	0xdf, 0x9a, 0x45, 0xaf, 0x56, 0x03, 0xeb, 0x6a, 0x35, 0xb0, 0xfe, 0x58, 0x0d, 0xac, 0x97, 0x37,
-	0x83, 0xc6, 0xd5, 0xcd, 0xa0, 0xf1, 0xdb, 0xcd, 0xa0, 0xf1, 0xed, 0xb3, 0xbf, 0x35, 0xaf, 0xfe,
-	0x44, 0x06, 0xe1, 0x51, 0x4c, 0xdd, 0xc5, 0x07, 0x6e, 0x46, 0xa3, 0x32, 0xc5, 0x5c, 0x7f, 0xc8,
-	0x8f, 0xaa, 0x2f, 0xf9, 0xc3, 0xf7, 0x8f, 0x36, 0x97, 0x79, 0xbc, 0x31, 0x83, 0x6d, 0x35, 0x91,
-	0x8f, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x1a, 0xd6, 0xd5, 0xaa, 0xfd, 0x07, 0x00, 0x00,
+	// 1065 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0xe3, 0xc4,
+	0x1b, 0xae, 0xd3, 0xfc, 0xda, 0x64, 0x92, 0xb4, 0xbb, 0xf3, 0x5b, 0xad, 0xdc, 0xaa, 0x4a, 0x42,
+	0x25, 0xa0, 0x97, 0xda, 0x9b, 0x2e, 0x12, 0x2b, 0x16, 0x0e, 0xa4, 0xbb, 0x6c, 0xbb, 0xbb, 0x85,
+	0xca, 0x01, 0x0e, 0x48, 0xc8, 0x1a, 0xdb, 0x13, 0x7b, 0xb4, 0xb6, 0xc7, 0x9a, 0x19, 0x87, 0x96,
+	0x13, 0x47, 0x8e, 0x7b, 0xe4, 0xc8, 0x47, 0xe0, 0xc6, 0x57, 0xd8, 0x63, 0x2f, 0x48, 0x88, 0x43,
+	0x41, 0xed, 0xb7, 0xe0, 0x84, 0x66, 0xc6, 0x76, 0x9c, 0xb2, 0x62, 0x03, 0x97, 0x68, 0xe6, 0x7d,
+	0x9f, 0xe7, 0x99, 0x99, 0xf7, 0x5f, 0x0c, 0x6c, 0xe2, 0xf9, 0x76, 0x4c, 0xc2, 0x48, 0xf8, 0x31,
+	0xc1, 0xa9, 0xe0, 0xb6, 0xc0, 0x69, 0x80, 0x59, 0x42, 0x52, 0x61, 0xcf, 0x46, 0xb5, 0x9d, 0x95,
+	0x31, 0x2a, 0x28, 0xec, 0x13, 0xcf, 0xb7, 0xea, 0x04, 0xab, 0x06, 0x99, 0x8d, 0xb6, 0x87, 0x35,
+	0xbe, 0x38, 0xcf, 0x30, 0xb7, 0x67, 0x28, 0x26, 0x01, 0x12, 0x94, 0x69, 0x85, 0xed, 0x9d, 0xbf,
+	0x21, 0xd4, 0x6f, 0xe9, 0xf5, 0x29, 0x4f, 0x28, 0xb7, 0x89, 0xcf, 0x0f, 0xee, 0xcb, 0x1b, 0x64,
+	0x8c, 0xd2, 0x69, 0xe9, 0xed, 0x87, 0x94, 0x86, 0x31, 0xb6, 0xd5, 0xce, 0xcb, 0xa7, 0x76, 0x90,
+	0x33, 0x24, 0x08, 0x4d, 0x0b, 0xff, 0xe0, 0xa6, 0x5f, 0x90, 0x04, 0x73, 0x81, 0x92, 0xac, 0x04,
+	0xc8, 0xf7, 0xfa, 0x94, 0x61, 0x5b, 0x5f, 0x5f, 0x9e, 0xa0, 0x57, 0x05, 0xe0, 0xdd, 0x39, 0x80,
+	0x26, 0x09, 0x11, 0x49, 0x09, 0xaa, 0x76, 0x05, 0xf0, 0x4e, 0x48, 0x43, 0xaa, 0x96, 0xb6, 0x5c,
+	0x69, 0xeb, 0xee, 0xcf, 0x6b, 0xa0, 0x73, 0xa8, 0xf4, 0x26, 0x02, 0x09, 0x0c, 0xb7, 0x40, 0xcb,
+	0x8f, 0x10, 0x49, 0x5d, 0x12, 0x98, 0xc6, 0xd0, 0xd8, 0x6b, 0x3b, 0xeb, 0x6a, 0x7f, 0x1c, 0xc0,
+	0xcf, 0x40, 0x47, 0xb0, 0x9c, 0x0b, 0x37, 0xc6, 0x33, 0x1c, 0x9b, 0x8d, 0xa1, 0xb1, 0xd7, 0x39,
+	0xd8, 0xb3, 0xfe, 0x39, 0xbe, 0xd6, 0x27, 0x0c, 0xf9, 0xf2, 0xc1, 0xe3, 0xe6, 0xab, 0xcb, 0xc1,
+	0x8a, 0x03, 0x94, 0xc4, 0x73, 0xa9, 0x00, 0x9f, 0x83, 0x4d, 0xb5, 0x23, 0x69, 0xe8, 0x66, 0x98,
+	0x11, 0x1a, 0x98, 0xab, 0x4a, 0x74, 0xcb, 0xd2, 0x61, 0xb1, 0xca, 0xb0, 0x58, 0x8f, 0x8a, 0xb0,
+	0x8d, 0x5b, 0x52, 0xe5, 0x87, 0xdf, 0x07, 0x86, 0xb3, 0x51, 0x72, 0x4f, 0x15, 0x15, 0x7e, 0x0a,
+	0x6e, 0xe5, 0xa9, 0x47, 0xd3, 0xa0, 0x26, 0xd7, 0x5c, 0x5e, 0x6e, 0xb3, 0x22, 0x17, 0x7a, 0xcf,
+	0xc0, 0x66, 0x82, 0xce, 0x5c, 0x3f, 0xa6, 0xfe, 0x0b, 0x37, 0x60, 0x64, 0x2a, 0xcc, 0xff, 0x2d,
+	0x2f, 0xd7, 0x4b, 0xd0, 0xd9, 0xa1, 0xa4, 0x3e, 0x92, 0x4c, 0xf8, 0x18, 0xf4, 0xa6, 0x8c, 0x7e,
+	0x8b, 0x53, 0x37, 0xc2, 0x32, 0x56, 0xe6, 0x9a, 0x92, 0xda, 0x56, 0xd1, 0x93, 0xd9, 0xb3, 0x8a,
+	0xa4, 0xce, 0x46, 0xd6, 0x91, 0x42, 0x14, 0xf1, 0xea, 0x6a, 0x9a, 0xb6, 0x49, 0x99, 0x18, 0x09,
+	0xcc, 0x45, 0x29, 0xb3, 0xbe, 0xac, 0x8c, 0xa6, 0x15, 0x32, 0x0f, 0x41, 0x47, 0x55, 0xa9, 0xcb,
+	0x33, 0xec, 0x73, 0xb3, 0x35, 0x5c, 0x55, 0x22, 0xba, 0x92, 0x2d, 0x55, 0xc9, 0x52, 0xe1, 0x54,
+	0x62, 0x26, 0x19, 0xf6, 0x1d, 0x90, 0x95, 0x4b, 0x0e, 0xdf, 0x02, 0xdd, 0x3c, 0x0b, 0x19, 0x0a,
+	0xb0, 0x9b, 0x21, 0x11, 0x99, 0xed, 0xe1, 0xea, 0x5e, 0xdb, 0xe9, 0x14, 0xb6, 0x53, 0x24, 0x22,
+	0xf8, 0x11, 0xd8, 0x42, 0x71, 0x4c, 0xbf, 0x71, 0xf3, 0x2c, 0x40, 0x02, 0xbb, 0x68, 0x2a, 0x30,
+	0x73, 0xf1, 0x59, 0x46, 0xd8, 0xb9, 0x09, 0x86, 0xc6, 0x5e, 0x6b, 0xdc, 0x30, 0x0d, 0xe7, 0xae,
+	0x02, 0x7d, 0xa1, 0x30, 0x1f, 0x4b, 0xc8, 0x63, 0x85, 0x80, 0xc7, 0x60, 0xf0, 0x1a, 0x7a, 0x42,
+	0xb8, 0x87, 0x23, 0x34, 0x23, 0x34, 0x67, 0x66, 0xa7, 0x12, 0xd9, 0xb9, 0x29, 0x72, 0x52, 0xc3,
+	0xc1, 0x09, 0xf8, 0xbf, 0x3e, 0xd6, 0x0d, 0x19, 0xf2, 0x71, 0x59, 0x17, 0xdd, 0xe5, 0x13, 0x79,
+	0x5b, 0xf3, 0x9f, 0x48, 0xba, 0xae, 0x8c, 0x0f, 0x9a, 0xdf, 0xff, 0x38, 0x58, 0xd9, 0xfd, 0xae,
+	0x01, 0x36, 0x0e, 0x69, 0xca, 0x71, 0xca, 0x73, 0xae, 0x9b, 0x67, 0x0c, 0xda, 0x55, 0xff, 0xaa,
+	0xee, 0x91, 0x51, 0xbd, 0x79, 0xc6, 0xe7, 0x25, 0x42, 0x1f, 0xf2, 0x52, 0x1e, 0x32, 0xa7, 0xc1,
+	0x0f, 0x41, 0x93, 0x51, 0x2a, 0x8a, 0xf6, 0xda, 0xad, 0x65, 0x76, 0xde, 0xd0, 0xb3, 0x91, 0x75,
+	0x82, 0xd9, 0x8b, 0x18, 0x3b, 0x94, 0x96, 0x19, 0x56, 0x2c, 0x38, 0x05, 0x77, 0x52, 0x7c, 0x26,
+	0xdc, 0x6a, 0x86, 0x71, 0x37, 0x42, 0x3c, 0x52, 0x7d, 0xd5, 0x1d, 0xbf, 0xf7, 0xe7, 0xe5, 0xe0,
+	0x5e, 0x48, 0x44, 0x94, 0x7b, 0x52, 0x4e, 0xce, 0x08, 0x2c, 0xbc, 0xa9, 0x98, 0x2f, 0x62, 0xe2,
+	0x71, 0xdb, 0x3b, 0x17, 0x98, 0x5b, 0x47, 0xf8, 0x6c, 0x2c, 0x17, 0x0e, 0x94, 0x8a, 0x5f, 0x56,
+	0x82, 0x47, 0x88, 0x47, 0x45, 0x08, 0x7e, 0x6b, 0x80, 0xbb, 0x8b, 0x21, 0x98, 0xa4, 0x28, 0xe3,
+	0x11, 0x15, 0xd0, 0x04, 0xeb, 0x33, 0xcc, 0x38, 0xa1, 0xa9, 0x0a, 0x44, 0xcf, 0x29, 0xb7, 0x0b,
+	0x13, 0xa6, 0xb1, 0x38, 0x61, 0x1e, 0x80, 0xb5, 0xa2, 0xae, 0x57, 0x97, 0xac, 0xeb, 0x02, 0x0f,
+	0xbf, 0x06, 0x9b, 0x7e, 0x79, 0x11, 0x97, 0xcb, 0x9b, 0x14, 0xbd, 0x6f, 0xbd, 0x69, 0x3e, 0x2d,
+	0xde, 0xbf, 0x90, 0xdd, 0xf0, 0x17, 0x13, 0xfb, 0x36, 0xd8, 0xc8, 0x18, 0xf5, 0x31, 0xe7, 0x38,
+	0x70, 0x65, 0xae, 0xd4, 0x28, 0x68, 0x3a, 0xbd, 0xca, 0x2a, 0xb3, 0x0a, 0x9f, 0x81, 0x5b, 0x73,
+	0xd8, 0xbf, 0x6c, 0xf4, 0xcd, 0x8a, 0xa9, 0xcd, 0xbb, 0xbf, 0x18, 0xa0, 0xbb, 0x50, 0xcb, 0x03,
+	0xd0, 0xd6, 0xdc, 0x6a, 0x36, 0xab, 0x06, 0x68, 0x69, 0xe3, 0xb1, 0x9c, 0x80, 0xad, 0x08, 0xa3,
+	0x00, 0x33, 0x77, 0x54, 0x94, 0xcf, 0x3b, 0x6f, 0x7a, 0xfd, 0x91, 0xc2, 0x8f, 0x3b, 0x57, 0x97,
+	0x83, 0x75, 0xbd, 0x1e, 0x39, 0xeb, 0x5a, 0x64, 0x54, 0xd3, 0x3b, 0x30, 0x57, 0xff, 0xab, 0xde,
+	0x41, 0xa9, 0x77, 0x50, 0x14, 0xcd, 0x4f, 0x0d, 0xb0, 0xa6, 0x5d, 0xf0, 0x18, 0xf4, 0x38, 0x09,
+	0x53, 0x15, 0x2c, 0x69, 0x28, 0x7a, 0xa6, 0x5f, 0x17, 0xd5, 0xff, 0xb5, 0x13, 0x05, 0x2b, 0xd4,
+	0x9b, 0x17, 0x97, 0x03, 0xc3, 0xe9, 0xf2, 0x9a, 0x0d, 0x1e, 0x82, 0x5e, 0x55, 0xf3, 0x2e, 0xc7,
+	0x65, 0xff, 0xbc, 0x46, 0xaa, 0xaa, 0xe4, 0x09, 0x16, 0x4e, 0x77, 0x56, 0xdb, 0xc1, 0x27, 0x40,
+	0xff, 0xa9, 0xcc, 0xb3, 0xb7, 0x6c, 0x1d, 0xf6, 0x0a, 0x9e, 0x36, 0xc2, 0x13, 0x00, 0x4b, 0xa1,
+	0x79, 0x27, 0x9a, 0xcd, 0xa5, 0xae, 0x74, 0xbb, 0x60, 0x56, 0x46, 0xbe, 0xfb, 0x14, 0xb4, 0xca,
+	0xbf, 0x51, 0xb8, 0x03, 0xda, 0x69, 0x9e, 0x60, 0x26, 0x3d, 0x2a, 0x5e, 0x4d, 0x67, 0x6e, 0x80,
+	0x43, 0xd0, 0x09, 0x70, 0x4a, 0x13, 0x92, 0x2a, 0x7f, 0x43, 0xf9, 0xeb, 0xa6, 0x71, 0xf0, 0xea,
+	0xaa, 0x6f, 0x5c, 0x5c, 0xf5, 0x8d, 0x3f, 0xae, 0xfa, 0xc6, 0xcb, 0xeb, 0xfe, 0xca, 0xc5, 0x75,
+	0x7f, 0xe5, 0xd7, 0xeb, 0xfe, 0xca, 0x57, 0x4f, 0x17, 0x26, 0x83, 0xfe, 0xa8, 0xf1, 0xfc, 0xfd,
+	0x90, 0xda, 0xb3, 0x07, 0x76, 0x42, 0x83, 0x3c, 0xc6, 0x5c, 0x7f, 0x7a, 0xed, 0x97, 0xdf, 0x5e,
+	0xf7, 0xde, 0xdf, 0x9f, 0x3f, 0xe6, 0xe1, 0x7c, 0xe9, 0xad, 0xa9, 0x71, 0x77, 0xff, 0xaf, 0x01,
+	0x00, 0x0f, 0x37, 0xbb, 0x3d, 0xaf, 0x09, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -405,6 +509,14 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ExpiryGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpiryGracePeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTendermint(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
 	if m.AllowUpdateAfterMisbehaviour {
 		i--
 		if m.AllowUpdateAfterMisbehaviour {
@@ -468,29 +580,29 @@ func (m *ClientState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x32
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxClockDrift, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxClockDrift):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintTendermint(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x2a
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxClockDrift, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxClockDrift):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintTendermint(dAtA, i, uint64(n4))
 	i--
-	dAtA[i] = 0x22
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TrustingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod):])
+	dAtA[i] = 0x2a
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
 	if err5 != nil {
 		return 0, err5
 	}
 	i -= n5
 	i = encodeVarintTendermint(dAtA, i, uint64(n5))
 	i--
+	dAtA[i] = 0x22
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TrustingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TrustingPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTendermint(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0x1a
 	{
 		size, err := m.TrustLevel.MarshalToSizedBuffer(dAtA[:i])
@@ -549,17 +661,87 @@ func (m *ConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x12
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
-	if err8 != nil {
-		return 0, err8
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err9 != nil {
+		return 0, err9
 	}
-	i -= n8
-	i = encodeVarintTendermint(dAtA, i, uint64(n8))
+	i -= n9
+	i = encodeVarintTendermint(dAtA, i, uint64(n9))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
+func (m *ConsensusStateSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusStateSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsensusStateSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProcessedHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTendermint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ProcessedTime != 0 {
+		i = encodeVarintTendermint(dAtA, i, uint64(m.ProcessedTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTendermint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTendermint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintTendermint(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Version != 0 {
+		i = encodeVarintTendermint(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Misbehaviour) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -767,6 +949,8 @@ func (m *ClientState) Size() (n int) {
 	if m.AllowUpdateAfterMisbehaviour {
 		n += 2
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ExpiryGracePeriod)
+	n += 1 + l + sovTendermint(uint64(l))
 	return n
 }
 
@@ -787,6 +971,31 @@ func (m *ConsensusState) Size() (n int) {
 	return n
 }
 
+func (m *ConsensusStateSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTendermint(uint64(m.Version))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovTendermint(uint64(l))
+	}
+	l = m.Height.Size()
+	n += 1 + l + sovTendermint(uint64(l))
+	l = m.ConsensusState.Size()
+	n += 1 + l + sovTendermint(uint64(l))
+	if m.ProcessedTime != 0 {
+		n += 1 + sovTendermint(uint64(m.ProcessedTime))
+	}
+	l = m.ProcessedHeight.Size()
+	n += 1 + l + sovTendermint(uint64(l))
+	return n
+}
+
 func (m *Misbehaviour) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1217,6 +1426,39 @@ func (m *ClientState) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUpdateAfterMisbehaviour = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ExpiryGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTendermint(dAtA[iNdEx:])
@@ -1388,6 +1630,225 @@ func (m *ConsensusState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ConsensusStateSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTendermint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusStateSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusStateSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedTime", wireType)
+			}
+			m.ProcessedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProcessedTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessedHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTendermint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProcessedHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTendermint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTendermint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Misbehaviour) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package tendermint
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -54,6 +55,17 @@ func (cs *ClientState) verifyHeader(
 		return errorsmod.Wrapf(clienttypes.ErrConsensusStateNotFound, "could not get trusted consensus state from clientStore for Header at TrustedHeight: %s", header.TrustedHeight)
 	}
 
+	// skip verification if the header has already been verified against the same client and consensus state
+	// within the lifecycle of the context
+	cache, useCache := getHeaderVerificationCache(ctx)
+	var cacheKey [sha256.Size]byte
+	if useCache {
+		cacheKey = headerVerificationKey(ctx, cdc, cs, consState, header)
+		if cache.has(cacheKey) {
+			return nil
+		}
+	}
+
 	if err := checkTrustedHeader(header, consState); err != nil {
 		return err
 	}
@@ -70,17 +82,17 @@ func (cs *ClientState) verifyHeader(
 
 	tmTrustedValidators, err := cmttypes.ValidatorSetFromProto(header.TrustedValidators)
 	if err != nil {
-		return errorsmod.Wrap(err, "trusted validator set in not tendermint validator set type")
+		return errorsmod.Wrapf(err, "trusted validator set of header at height %s in not tendermint validator set type", header.GetHeight())
 	}
 
 	tmSignedHeader, err := cmttypes.SignedHeaderFromProto(header.SignedHeader)
 	if err != nil {
-		return errorsmod.Wrap(err, "signed header in not tendermint signed header type")
+		return errorsmod.Wrapf(err, "signed header at height %s in not tendermint signed header type", header.GetHeight())
 	}
 
 	tmValidatorSet, err := cmttypes.ValidatorSetFromProto(header.ValidatorSet)
 	if err != nil {
-		return errorsmod.Wrap(err, "validator set in not tendermint validator set type")
+		return errorsmod.Wrapf(err, "validator set of header at height %s in not tendermint validator set type", header.GetHeight())
 	}
 
 	// assert header height is newer than consensus state
@@ -115,7 +127,36 @@ func (cs *ClientState) verifyHeader(
 		cs.TrustingPeriod, currentTimestamp, cs.MaxClockDrift, cs.TrustLevel.ToTendermint(),
 	)
 	if err != nil {
-		return errorsmod.Wrap(err, "failed to verify header")
+		return errorsmod.Wrapf(err, "failed to verify header at height %s against trusted height %s", header.GetHeight(), header.TrustedHeight)
+	}
+
+	if useCache {
+		cache.add(cacheKey)
+	}
+
+	return nil
+}
+
+// DryRunUpdate returns the error, if any, with which an update of the client using the provided header would be
+// rejected, without updating the client. It runs the checks of a client update: the client must be active, the
+// header must pass basic validation and header verification, and it must not conflict with the consensus states
+// stored in the client store, as the update would otherwise freeze the client. All checks only read the client
+// store, and a header verified by a dry run is not recorded in the header verification cache of the context.
+func (cs *ClientState) DryRunUpdate(ctx sdk.Context, clientStore storetypes.KVStore, cdc codec.BinaryCodec, header *Header) error {
+	if status := cs.Status(ctx, clientStore, cdc); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "cannot update client with status %s", status)
+	}
+
+	if err := header.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := cs.verifyHeader(ctx.WithValue(headerVerificationCacheKey{}, nil), clientStore, cdc, header); err != nil {
+		return err
+	}
+
+	if cs.CheckForMisbehaviour(ctx, cdc, clientStore, header) {
+		return errorsmod.Wrapf(clienttypes.ErrInvalidHeader, "header at height %s conflicts with the stored consensus states, updating the client would freeze it", header.GetHeight())
 	}
 
 	return nil
@@ -132,17 +173,24 @@ func (cs *ClientState) verifyHeader(
 // number must be the same. To update to a new revision, use a separate upgrade path
 // UpdateState will prune the oldest consensus state if it is expired.
 func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) []exported.Height {
+	consensusHeights, _ := cs.updateState(ctx, cdc, clientStore, clientMsg)
+	return consensusHeights
+}
+
+// updateState performs the UpdateState logic and returns the updated consensus heights along with the heights
+// of the expired consensus states which were pruned.
+func (cs ClientState) updateState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) ([]exported.Height, []exported.Height) {
 	header, ok := clientMsg.(*Header)
 	if !ok {
 		panic(fmt.Errorf("expected type %T, got %T", &Header{}, clientMsg))
 	}
 
-	cs.pruneOldestConsensusState(ctx, cdc, clientStore)
+	prunedHeights := cs.pruneOldestConsensusState(ctx, cdc, clientStore)
 
 	// check for duplicate update
 	if _, found := GetConsensusState(clientStore, cdc, header.GetHeight()); found {
 		// perform no-op
-		return []exported.Height{header.GetHeight()}
+		return []exported.Height{header.GetHeight()}, prunedHeights
 	}
 
 	height, ok := header.GetHeight().(clienttypes.Height)
@@ -164,13 +212,14 @@ func (cs ClientState) UpdateState(ctx sdk.Context, cdc codec.BinaryCodec, client
 	setConsensusState(clientStore, cdc, consensusState, header.GetHeight())
 	setConsensusMetadata(ctx, clientStore, header.GetHeight())
 
-	return []exported.Height{height}
+	return []exported.Height{height}, prunedHeights
 }
 
 // pruneOldestConsensusState will retrieve the earliest consensus state for this clientID and check if it is expired. If it is,
 // that consensus state will be pruned from store along with all associated metadata. This will prevent the client store from
 // becoming bloated with expired consensus states that can no longer be used for updates and packet verification.
-func (cs ClientState) pruneOldestConsensusState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore) {
+// The heights of the pruned consensus states are returned.
+func (cs ClientState) pruneOldestConsensusState(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore) []exported.Height {
 	// Check the earliest consensus state to see if it is expired, if so then set the prune height
 	// so that we can delete consensus state and all associated metadata.
 	var (
@@ -194,25 +243,47 @@ func (cs ClientState) pruneOldestConsensusState(ctx sdk.Context, cdc codec.Binar
 	IterateConsensusStateAscending(clientStore, pruneCb)
 
 	// if pruneHeight is set, delete consensus state and metadata
-	if pruneHeight != nil {
-		deleteConsensusState(clientStore, pruneHeight)
-		deleteConsensusMetadata(clientStore, pruneHeight)
+	if pruneHeight == nil {
+		return nil
 	}
+
+	deleteConsensusState(clientStore, pruneHeight)
+	deleteConsensusMetadata(clientStore, pruneHeight)
+
+	return []exported.Height{pruneHeight}
 }
 
 // UpdateStateOnMisbehaviour updates state upon misbehaviour, freezing the ClientState. This method should only be called when misbehaviour is detected
-// as it does not perform any misbehaviour checks.
-func (cs ClientState) UpdateStateOnMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, _ exported.ClientMessage) {
+// as it does not perform any misbehaviour checks. For misbehaviour consisting of two conflicting headers, the lowest trusted height of the headers
+// is stored in order to permit packet timeouts to be proven at prior heights. A forged consensus state may have been stored at any height after the
+// trusted heights of the misbehaviour, and a single conflicting header does not bound the height at which the forgery started. No height is stored
+// for a single conflicting header, so that packet timeouts can no longer be proven once the client is frozen.
+func (cs ClientState) UpdateStateOnMisbehaviour(ctx sdk.Context, cdc codec.BinaryCodec, clientStore storetypes.KVStore, clientMsg exported.ClientMessage) {
 	cs.FrozenHeight = FrozenHeight
 
 	clientStore.Set(host.ClientStateKey(), clienttypes.MustMarshalClientState(cdc, &cs))
+
+	misbehaviour, ok := clientMsg.(*Misbehaviour)
+	if !ok {
+		return
+	}
+
+	misbehaviourHeight := misbehaviour.Header1.TrustedHeight
+	if misbehaviour.Header2.TrustedHeight.LT(misbehaviourHeight) {
+		misbehaviourHeight = misbehaviour.Header2.TrustedHeight
+	}
+
+	setMisbehaviourHeight(clientStore, misbehaviourHeight)
 }
 
-// checkTrustedHeader checks that consensus state matches trusted fields of Header
+// checkTrustedHeader checks that consensus state matches trusted fields of Header.
+// ErrTrustedValidatorsMismatch is returned if the trusted validators of the header do not hash to the next
+// validators hash of the consensus state at the trusted height, which usually indicates that the header was
+// constructed for a different trusted height. Relayers may retry with another trusted height in that case.
 func checkTrustedHeader(header *Header, consState *ConsensusState) error {
 	tmTrustedValidators, err := cmttypes.ValidatorSetFromProto(header.TrustedValidators)
 	if err != nil {
-		return errorsmod.Wrap(err, "trusted validator set in not tendermint validator set type")
+		return errorsmod.Wrapf(err, "trusted validator set of header at height %s in not tendermint validator set type", header.GetHeight())
 	}
 
 	// assert that trustedVals is NextValidators of last trusted header
@@ -220,9 +291,9 @@ func checkTrustedHeader(header *Header, consState *ConsensusState) error {
 	tvalHash := tmTrustedValidators.Hash()
 	if !bytes.Equal(consState.NextValidatorsHash, tvalHash) {
 		return errorsmod.Wrapf(
-			ErrInvalidValidatorSet,
-			"trusted validators %s, does not hash to latest trusted validators. Expected: %X, got: %X",
-			header.TrustedValidators, consState.NextValidatorsHash, tvalHash,
+			ErrTrustedValidatorsMismatch,
+			"header height %s, trusted height %s, trusted validators hash %X, header trusted validators hash %X",
+			header.GetHeight(), header.TrustedHeight, consState.NextValidatorsHash, tvalHash,
 		)
 	}
 	return nil
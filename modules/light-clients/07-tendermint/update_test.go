@@ -1,10 +1,13 @@
 package tendermint_test
 
 import (
+	"fmt"
 	"time"
 
 	storetypes "cosmossdk.io/store/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -323,6 +326,252 @@ func (suite *TendermintTestSuite) TestVerifyHeader() {
 	}
 }
 
+func (suite *TendermintTestSuite) TestVerifyHeaderTrustedValidatorsMismatch() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetupClients()
+
+	suite.coordinator.CommitBlock(suite.chainB)
+	trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+	suite.Require().True(ok)
+
+	header, err := suite.chainB.IBCClientHeader(suite.chainB.LatestCommittedHeader, trustedHeight)
+	suite.Require().NoError(err)
+
+	// the trusted validators of the header do not match the next validators of the consensus state at the trusted height
+	altPrivVal := cmttypes.NewMockPV()
+	altPubKey, err := altPrivVal.GetPubKey()
+	suite.Require().NoError(err)
+
+	altValSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(altPubKey, 100)})
+	header.TrustedValidators, err = altValSet.ToProto()
+	suite.Require().NoError(err)
+
+	clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	suite.Require().True(ok)
+
+	consensusState, ok := path.EndpointA.GetConsensusState(trustedHeight).(*ibctm.ConsensusState)
+	suite.Require().True(ok)
+
+	clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
+
+	err = clientState.VerifyClientMessage(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), clientStore, header)
+	suite.Require().ErrorIs(err, ibctm.ErrTrustedValidatorsMismatch)
+	suite.Require().NotErrorIs(err, clienttypes.ErrInvalidHeader)
+	suite.Require().NotErrorIs(err, ibctm.ErrInvalidHeader)
+
+	suite.Require().ErrorContains(err, fmt.Sprintf("header height %s", header.GetHeight()))
+	suite.Require().ErrorContains(err, fmt.Sprintf("trusted height %s", trustedHeight))
+	suite.Require().ErrorContains(err, fmt.Sprintf("trusted validators hash %X", consensusState.NextValidatorsHash))
+	suite.Require().ErrorContains(err, fmt.Sprintf("header trusted validators hash %X", altValSet.Hash()))
+}
+
+func (suite *TendermintTestSuite) TestVerifyHeaderValidatorSetRotation() {
+	// the default trust level requires more than 1/3 of the trusted voting power
+	// to have signed a non-adjacent header
+	testCases := []struct {
+		name            string
+		validatorPowers []int64
+		rotated         int
+		expPass         bool
+	}{
+		{
+			"successful verification: 1/2 of trusted voting power remains",
+			[]int64{1, 1, 1, 1, 1, 1},
+			3,
+			true,
+		},
+		{
+			"unsuccessful verification: exactly 1/3 of trusted voting power remains",
+			[]int64{1, 1, 1, 1, 1, 1},
+			4,
+			false,
+		},
+		{
+			"successful verification: 1/2 of trusted voting power remains after rotating the validator with the highest power",
+			[]int64{1, 1, 1, 3},
+			1,
+			true,
+		},
+		{
+			"unsuccessful verification: exactly 1/3 of trusted voting power remains after rotating validators with unequal power",
+			[]int64{1, 1, 1, 3},
+			2,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			coordinator := ibctesting.NewCoordinatorWithChainOptions(suite.T(), 2, map[string][]ibctesting.ChainOption{
+				ibctesting.GetChainID(2): {ibctesting.WithValidatorPowers(tc.validatorPowers...)},
+			})
+			chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+			chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+
+			path := ibctesting.NewPath(chainA, chainB)
+			err := path.EndpointA.CreateClient()
+			suite.Require().NoError(err)
+
+			trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+			suite.Require().True(ok)
+
+			// rotate the validators and commit blocks such that the header is non-adjacent to the trusted height
+			chainB.RotateValidators(tc.rotated)
+			coordinator.CommitNBlocks(chainB, 2)
+
+			header, err := chainB.IBCClientHeader(chainB.LatestCommittedHeader, trustedHeight)
+			suite.Require().NoError(err)
+
+			clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+
+			clientStore := chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(chainA.GetContext(), path.EndpointA.ClientID)
+
+			err = clientState.VerifyClientMessage(chainA.GetContext(), chainA.App.AppCodec(), clientStore, header)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TendermintTestSuite) TestDryRunUpdate() {
+	var (
+		path   *ibctesting.Path
+		header *ibctm.Header
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: adjacent header",
+			func() {},
+			nil,
+		},
+		{
+			"success: non-adjacent header",
+			func() {
+				trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+				suite.Require().True(ok)
+
+				suite.coordinator.CommitNBlocks(suite.chainB, 5)
+
+				var err error
+				header, err = suite.chainB.IBCClientHeader(suite.chainB.LatestCommittedHeader, trustedHeight)
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"success: header already submitted",
+			func() {
+				err := path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				header, err = suite.chainB.IBCClientHeader(suite.chainB.LatestCommittedHeader, header.TrustedHeight)
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"failure: header signed by an untrusted validator set",
+			func() {
+				altPrivVal := cmttypes.NewMockPV()
+				altPubKey, err := altPrivVal.GetPubKey()
+				suite.Require().NoError(err)
+
+				altVal := cmttypes.NewValidator(altPubKey, 100)
+				altValSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{altVal})
+
+				header = suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, int64(header.GetHeight().GetRevisionHeight()), header.TrustedHeight, header.GetTime(), altValSet, altValSet, altValSet, getAltSigners(altVal, altPrivVal))
+			},
+			ibctm.ErrTrustedValidatorsMismatch,
+		},
+		{
+			"failure: header fails basic validation",
+			func() {
+				header.ValidatorSet = nil
+			},
+			clienttypes.ErrInvalidHeader,
+		},
+		{
+			"failure: header conflicts with stored consensus state",
+			func() {
+				err := path.EndpointA.UpdateClient()
+				suite.Require().NoError(err)
+
+				trustedVals, err := suite.chainB.GetTrustedValidators(int64(header.TrustedHeight.RevisionHeight) + 1)
+				suite.Require().NoError(err)
+
+				// a header at the height the client was updated to with a different timestamp
+				latestHeader := suite.chainB.LatestCommittedHeader.Header
+				header = suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, latestHeader.Height, header.TrustedHeight, latestHeader.Time.Add(-time.Second), suite.chainB.Vals, suite.chainB.NextVals, trustedVals, suite.chainB.Signers)
+			},
+			clienttypes.ErrInvalidHeader,
+		},
+		{
+			"failure: client is frozen",
+			func() {
+				clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.EndpointA.SetClientState(clientState)
+			},
+			clienttypes.ErrClientNotActive,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+
+			err := path.EndpointA.CreateClient()
+			suite.Require().NoError(err)
+
+			// ensure counterparty state is committed
+			suite.coordinator.CommitBlock(suite.chainB)
+			trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+			suite.Require().True(ok)
+			header, err = suite.chainB.IBCClientHeader(suite.chainB.LatestCommittedHeader, trustedHeight)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+
+			ctx := ibctm.WithHeaderVerificationCache(suite.chainA.GetContext())
+			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+			_, consensusStateFound := ibctm.GetConsensusState(clientStore, suite.chainA.App.AppCodec(), header.GetHeight())
+
+			err = clientState.DryRunUpdate(ctx, clientStore, suite.chainA.App.AppCodec(), header)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+
+			// the client is left untouched and the header is not recorded as verified
+			suite.Require().Equal(clientState, path.EndpointA.GetClientState())
+			_, found := ibctm.GetConsensusState(clientStore, suite.chainA.App.AppCodec(), header.GetHeight())
+			suite.Require().Equal(consensusStateFound, found)
+			suite.Require().Zero(ibctm.HeaderVerificationCacheLen(ctx))
+		})
+	}
+}
+
 func (suite *TendermintTestSuite) TestUpdateState() {
 	var (
 		path               *ibctesting.Path
@@ -600,9 +849,30 @@ func (suite *TendermintTestSuite) TestPruneConsensusState() {
 	// Increment the time by another week, then update the client.
 	// This will cause the first two consensus states to become expired.
 	suite.coordinator.IncrementTimeBy(7 * 24 * time.Hour)
-	err = path.EndpointA.UpdateClient()
+	suite.coordinator.CommitBlock(suite.chainB)
+
+	trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+	suite.Require().True(ok)
+	header, err := suite.chainB.IBCClientHeader(suite.chainB.LatestCommittedHeader, trustedHeight)
+	suite.Require().NoError(err)
+	msg, err := clienttypes.NewMsgUpdateClient(path.EndpointA.ClientID, header, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	res, err := suite.chainA.SendMsgs(msg)
 	suite.Require().NoError(err)
 
+	// the prune consensus states event lists the pruned height
+	expectedEvents := sdk.Events{
+		sdk.NewEvent(
+			clienttypes.EventTypePruneConsensusStates,
+			sdk.NewAttribute(clienttypes.AttributeKeyClientID, path.EndpointA.ClientID),
+			sdk.NewAttribute(clienttypes.AttributeKeyClientType, exported.Tendermint),
+			sdk.NewAttribute(clienttypes.AttributeKeyPrunedHeights, pruneHeight.String()),
+			sdk.NewAttribute(clienttypes.AttributeKeyPrunedCount, "1"),
+		),
+	}.ToABCIEvents()
+	ibctesting.AssertEvents(&suite.Suite, expectedEvents, res.Events)
+
 	ctx = path.EndpointA.Chain.GetContext()
 	clientStore = path.EndpointA.Chain.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
 
@@ -841,7 +1111,11 @@ func (suite *TendermintTestSuite) TestCheckForMisbehaviour() {
 }
 
 func (suite *TendermintTestSuite) TestUpdateStateOnMisbehaviour() {
-	var path *ibctesting.Path
+	var (
+		path                  *ibctesting.Path
+		clientMessage         exported.ClientMessage
+		expMisbehaviourHeight exported.Height
+	)
 
 	testCases := []struct {
 		name     string
@@ -853,6 +1127,41 @@ func (suite *TendermintTestSuite) TestUpdateStateOnMisbehaviour() {
 			func() {},
 			true,
 		},
+		{
+			"success: misbehaviour height is the lowest trusted height",
+			func() {
+				trustedHeight1 := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+				trustedVals1, ok := suite.chainB.TrustedValidators[trustedHeight1.RevisionHeight]
+				suite.Require().True(ok)
+
+				suite.Require().NoError(path.EndpointA.UpdateClient())
+
+				trustedHeight2 := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+				trustedVals2, ok := suite.chainB.TrustedValidators[trustedHeight2.RevisionHeight]
+				suite.Require().True(ok)
+
+				height := suite.chainB.ProposedHeader.Height
+				clientMessage = &ibctm.Misbehaviour{
+					Header1: suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, height+3, trustedHeight2, suite.chainB.ProposedHeader.Time.Add(time.Minute), suite.chainB.Vals, suite.chainB.NextVals, trustedVals2, suite.chainB.Signers),
+					Header2: suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, height, trustedHeight1, suite.chainB.ProposedHeader.Time, suite.chainB.Vals, suite.chainB.NextVals, trustedVals1, suite.chainB.Signers),
+				}
+				expMisbehaviourHeight = trustedHeight1
+			},
+			true,
+		},
+		{
+			"success: misbehaviour height is not stored for a conflicting header",
+			func() {
+				trustedHeight := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+				trustedVals, ok := suite.chainB.TrustedValidators[trustedHeight.RevisionHeight]
+				suite.Require().True(ok)
+
+				height := suite.chainB.ProposedHeader.Height
+				clientMessage = suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, height, trustedHeight, suite.chainB.ProposedHeader.Time, suite.chainB.Vals, suite.chainB.NextVals, trustedVals, suite.chainB.Signers)
+				expMisbehaviourHeight = nil
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -866,13 +1175,16 @@ func (suite *TendermintTestSuite) TestUpdateStateOnMisbehaviour() {
 			err := path.EndpointA.CreateClient()
 			suite.Require().NoError(err)
 
+			clientMessage = nil
+			expMisbehaviourHeight = nil
+
 			tc.malleate()
 
 			clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
 			suite.Require().True(ok)
 			clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(suite.chainA.GetContext(), path.EndpointA.ClientID)
 
-			clientState.UpdateStateOnMisbehaviour(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), clientStore, nil)
+			clientState.UpdateStateOnMisbehaviour(suite.chainA.GetContext(), suite.chainA.App.AppCodec(), clientStore, clientMessage)
 
 			if tc.expPass {
 				clientStateBz := clientStore.Get(host.ClientStateKey())
@@ -880,6 +1192,14 @@ func (suite *TendermintTestSuite) TestUpdateStateOnMisbehaviour() {
 
 				newClientState := clienttypes.MustUnmarshalClientState(suite.chainA.Codec, clientStateBz)
 				suite.Require().Equal(frozenHeight, newClientState.(*ibctm.ClientState).FrozenHeight)
+
+				misbehaviourHeight, found := ibctm.GetMisbehaviourHeight(clientStore)
+				if expMisbehaviourHeight == nil {
+					suite.Require().False(found)
+				} else {
+					suite.Require().True(found)
+					suite.Require().Equal(expMisbehaviourHeight, misbehaviourHeight)
+				}
 			}
 		})
 	}
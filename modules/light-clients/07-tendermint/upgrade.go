@@ -100,6 +100,7 @@ func (cs ClientState) VerifyUpgradeAndUpdateState(
 		tmUpgradeClient.ChainId, cs.TrustLevel, cs.TrustingPeriod, tmUpgradeClient.UnbondingPeriod,
 		cs.MaxClockDrift, tmUpgradeClient.LatestHeight, tmUpgradeClient.ProofSpecs, tmUpgradeClient.UpgradePath,
 	)
+	newClientState.ExpiryGracePeriod = cs.ExpiryGracePeriod
 
 	if err := newClientState.Validate(); err != nil {
 		return errorsmod.Wrap(err, "updated client state failed basic validation")
@@ -0,0 +1,64 @@
+package tendermint
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// headerVerificationCacheKey is the context key under which the header verification cache is stored.
+type headerVerificationCacheKey struct{}
+
+// headerVerificationCache records the headers which have been successfully verified within the lifecycle of a
+// single context, usually a transaction. Verification of a header depends on the client state, the trusted
+// consensus state and the block time, so a cache entry is keyed by the hash of all of them together with the
+// header. A header verified against one client state is therefore never trusted for a different client state.
+type headerVerificationCache struct {
+	verified map[[sha256.Size]byte]struct{}
+}
+
+// WithHeaderVerificationCache returns a copy of the context containing an empty header verification cache.
+// Headers verified using the returned context, or any context derived from it, are recorded in the cache so that
+// repeated verification of the same header against the same client and consensus state skips the signature checks.
+// The cache lives as long as the context, it should be set on a per transaction basis, for example in an ante handler.
+func WithHeaderVerificationCache(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(headerVerificationCacheKey{}, &headerVerificationCache{
+		verified: make(map[[sha256.Size]byte]struct{}),
+	})
+}
+
+// getHeaderVerificationCache returns the header verification cache of the context, if one has been set.
+func getHeaderVerificationCache(ctx sdk.Context) (*headerVerificationCache, bool) {
+	cache, ok := ctx.Value(headerVerificationCacheKey{}).(*headerVerificationCache)
+	return cache, ok
+}
+
+// has returns true if the header verification identified by the given key has been recorded.
+func (c *headerVerificationCache) has(key [sha256.Size]byte) bool {
+	_, found := c.verified[key]
+	return found
+}
+
+// add records a successful header verification identified by the given key.
+func (c *headerVerificationCache) add(key [sha256.Size]byte) {
+	c.verified[key] = struct{}{}
+}
+
+// headerVerificationKey returns the key identifying the verification of the header against the given client state
+// and trusted consensus state at the block time of the context.
+func headerVerificationKey(ctx sdk.Context, cdc codec.BinaryCodec, cs *ClientState, consState *ConsensusState, header *Header) [sha256.Size]byte {
+	hasher := sha256.New()
+	for _, bz := range [][]byte{cdc.MustMarshal(cs), cdc.MustMarshal(consState), cdc.MustMarshal(header)} {
+		// length prefix each field so that the concatenation is unambiguous
+		hasher.Write(binary.BigEndian.AppendUint64(nil, uint64(len(bz))))
+		hasher.Write(bz)
+	}
+
+	hasher.Write(binary.BigEndian.AppendUint64(nil, uint64(ctx.BlockTime().UnixNano())))
+
+	var key [sha256.Size]byte
+	copy(key[:], hasher.Sum(nil))
+	return key
+}
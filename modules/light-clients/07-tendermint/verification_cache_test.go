@@ -0,0 +1,210 @@
+package tendermint_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func (suite *TendermintTestSuite) TestVerifyHeaderWithCache() {
+	var (
+		path        *ibctesting.Path
+		header      *ibctm.Header
+		clientState *ibctm.ClientState
+	)
+
+	// headers signed by an alternative validator set fail verification
+	altPrivVal := cmttypes.NewMockPV()
+	altPubKey, err := altPrivVal.GetPubKey()
+	suite.Require().NoError(err)
+	altVal := cmttypes.NewValidator(altPubKey, 100)
+	altValSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{altVal})
+	altSigners := getAltSigners(altVal, altPrivVal)
+
+	createInvalidHeader := func() *ibctm.Header {
+		trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+		suite.Require().True(ok)
+
+		trustedVals, err := suite.chainB.GetTrustedValidators(int64(trustedHeight.RevisionHeight) + 1)
+		suite.Require().NoError(err)
+
+		return suite.chainB.CreateTMClientHeader(suite.chainB.ChainID, suite.chainB.ProposedHeader.Height, trustedHeight, suite.chainB.ProposedHeader.Time, altValSet, altValSet, trustedVals, altSigners)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func() error
+		expPass  bool
+	}{
+		{
+			"success: header is verified and cached",
+			func() error {
+				ctx := ibctm.WithHeaderVerificationCache(suite.chainA.GetContext())
+				err := verifyHeader(suite.chainA, path, ctx, clientState, header)
+				suite.Require().Equal(1, ibctm.HeaderVerificationCacheLen(ctx))
+				return err
+			},
+			true,
+		},
+		{
+			"success: verifying the same header twice records a single entry",
+			func() error {
+				ctx := ibctm.WithHeaderVerificationCache(suite.chainA.GetContext())
+				suite.Require().NoError(verifyHeader(suite.chainA, path, ctx, clientState, header))
+				err := verifyHeader(suite.chainA, path, ctx, clientState, header)
+				suite.Require().Equal(1, ibctm.HeaderVerificationCacheLen(ctx))
+				return err
+			},
+			true,
+		},
+		{
+			"success: cached verification short-circuits the signature checks",
+			func() error {
+				header = createInvalidHeader()
+				ctx := ibctm.WithHeaderVerificationCache(suite.chainA.GetContext())
+
+				// the header fails verification without the cache entry
+				suite.Require().Error(verifyHeader(suite.chainA, path, ctx, clientState, header))
+
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+				ibctm.MarkHeaderVerified(ctx, suite.chainA.App.AppCodec(), clientStore, clientState, header)
+				return verifyHeader(suite.chainA, path, ctx, clientState, header)
+			},
+			true,
+		},
+		{
+			"failure: failed verification is not cached",
+			func() error {
+				header = createInvalidHeader()
+				ctx := ibctm.WithHeaderVerificationCache(suite.chainA.GetContext())
+				err := verifyHeader(suite.chainA, path, ctx, clientState, header)
+				suite.Require().Equal(0, ibctm.HeaderVerificationCacheLen(ctx))
+				return err
+			},
+			false,
+		},
+		{
+			"failure: header verified against a different client state is not trusted",
+			func() error {
+				ctx := ibctm.WithHeaderVerificationCache(suite.chainA.GetContext())
+				suite.Require().NoError(verifyHeader(suite.chainA, path, ctx, clientState, header))
+
+				// a trusting period which has already passed fails verification of the header
+				expiredClientState := *clientState
+				expiredClientState.TrustingPeriod = time.Nanosecond
+				return verifyHeader(suite.chainA, path, ctx, &expiredClientState, header)
+			},
+			false,
+		},
+		{
+			"failure: header verified in a different context is not trusted",
+			func() error {
+				header = createInvalidHeader()
+
+				ctx := ibctm.WithHeaderVerificationCache(suite.chainA.GetContext())
+				clientStore := suite.chainA.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+				ibctm.MarkHeaderVerified(ctx, suite.chainA.App.AppCodec(), clientStore, clientState, header)
+
+				return verifyHeader(suite.chainA, path, ibctm.WithHeaderVerificationCache(suite.chainA.GetContext()), clientState, header)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+
+			err := path.EndpointA.CreateClient()
+			suite.Require().NoError(err)
+
+			// ensure counterparty state is committed
+			suite.coordinator.CommitBlock(suite.chainB)
+			trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+			suite.Require().True(ok)
+			latestHeader := *suite.chainB.LatestCommittedHeader
+			header, err = suite.chainB.IBCClientHeader(&latestHeader, trustedHeight)
+			suite.Require().NoError(err)
+
+			clientState, ok = path.EndpointA.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+
+			err = tc.malleate()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// verifyHeader verifies the header using the client store of the path's endpoint A client on the given chain.
+func verifyHeader(chain *ibctesting.TestChain, path *ibctesting.Path, ctx sdk.Context, clientState *ibctm.ClientState, header *ibctm.Header) error {
+	clientStore := chain.App.GetIBCKeeper().ClientKeeper.ClientStore(ctx, path.EndpointA.ClientID)
+	return clientState.VerifyClientMessage(ctx, chain.App.AppCodec(), clientStore, header)
+}
+
+// BenchmarkVerifyDuplicateHeaders compares verifying the same header twice within a single transaction with and
+// without a header verification cache.
+func BenchmarkVerifyDuplicateHeaders(b *testing.B) {
+	coord := &ibctesting.Coordinator{CurrentTime: time.Date(2020, 1, 2, 0, 0, 0, 0, time.UTC)}
+	chainA := ibctesting.NewTestChainWithOptions(b, coord, ibctesting.GetChainID(1))
+	chainB := ibctesting.NewTestChainWithOptions(b, coord, ibctesting.GetChainID(2))
+	coord.Chains = map[string]*ibctesting.TestChain{chainA.ChainID: chainA, chainB.ChainID: chainB}
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.SetupClients()
+	coord.CommitBlock(chainB)
+
+	trustedHeight, ok := path.EndpointA.GetClientLatestHeight().(clienttypes.Height)
+	if !ok {
+		b.Fatal("client latest height is not a clienttypes.Height")
+	}
+
+	latestHeader := *chainB.LatestCommittedHeader
+	header, err := chainB.IBCClientHeader(&latestHeader, trustedHeight)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+	if !ok {
+		b.Fatal("client state is not a tendermint client state")
+	}
+
+	b.Run("without cache", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			ctx := chainA.GetContext()
+			for j := 0; j < 2; j++ {
+				if err := verifyHeader(chainA, path, ctx, clientState, header); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+
+	b.Run("with cache", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			// a new cache is used for every iteration as a new cache is set for every transaction
+			ctx := ibctm.WithHeaderVerificationCache(chainA.GetContext())
+			for j := 0; j < 2; j++ {
+				if err := verifyHeader(chainA, path, ctx, clientState, header); err != nil {
+					b.Fatal(err)
+				}
+			}
+		}
+	})
+}
@@ -18,7 +18,10 @@ type WasmConsensusHost struct {
 	delegate clienttypes.ConsensusHost
 }
 
-var _ clienttypes.ConsensusHost = (*WasmConsensusHost)(nil)
+var (
+	_ clienttypes.ConsensusHost               = (*WasmConsensusHost)(nil)
+	_ clienttypes.UpgradedSelfClientValidator = (*WasmConsensusHost)(nil)
+)
 
 // NewWasmConsensusHost creates and returns a new ConsensusHost for wasm wrapped consensus client state and consensus state self validation.
 func NewWasmConsensusHost(cdc codec.BinaryCodec, delegate clienttypes.ConsensusHost) (*WasmConsensusHost, error) {
@@ -75,3 +78,14 @@ func (w *WasmConsensusHost) ValidateSelfClient(ctx sdk.Context, clientState expo
 
 	return w.delegate.ValidateSelfClient(ctx, unwrappedClientState)
 }
+
+// ValidateUpgradedSelfClient implements the 02-client types.UpgradedSelfClientValidator interface.
+// The upgraded client state is validated by the delegate consensus host if it supports the validation.
+func (w *WasmConsensusHost) ValidateUpgradedSelfClient(ctx sdk.Context, upgradedClientState exported.ClientState) error {
+	validator, ok := w.delegate.(clienttypes.UpgradedSelfClientValidator)
+	if !ok {
+		return nil
+	}
+
+	return validator.ValidateUpgradedSelfClient(ctx, upgradedClientState)
+}
@@ -2,6 +2,7 @@ package ibctesting
 
 import (
 	"fmt"
+	"math/rand"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -66,6 +68,11 @@ type TestChain struct {
 	Vals     *cmttypes.ValidatorSet
 	NextVals *cmttypes.ValidatorSet
 
+	// TrustedValidators is a mapping from a committed header height to the next validator set of
+	// that header. It is used to construct the trusted validators of client updates, which may not
+	// be derived from the application state once the validator set is modified by the testing package.
+	TrustedValidators map[uint64]*cmttypes.ValidatorSet
+
 	// Signers is a map from validator address to the PrivValidator
 	// The map is converted into an array that is the same order as the validators right before signing commit
 	// This ensures that signers will always be in correct order even as validator powers change.
@@ -82,6 +89,10 @@ type TestChain struct {
 	// Short-term solution to override the logic of the standard SendMsgs function.
 	// See issue https://github.com/cosmos/ibc-go/issues/3123 for more information.
 	SendMsgsOverride func(msgs ...sdk.Msg) (*abci.ExecTxResult, error)
+
+	// lastBlockEvents are the block-level events returned by FinalizeBlock for the last
+	// committed block, i.e. the events emitted outside of transactions.
+	lastBlockEvents []abci.Event
 }
 
 // NewTestChainWithValSet initializes a new TestChain instance with the given validator set
@@ -100,6 +111,13 @@ type TestChain struct {
 // CONTRACT: Validator array must be provided in the order expected by Tendermint.
 // i.e. sorted first by power and then lexicographically by address.
 func NewTestChainWithValSet(tb testing.TB, coord *Coordinator, chainID string, valSet *cmttypes.ValidatorSet, signers map[string]cmttypes.PrivValidator) *TestChain {
+	tb.Helper()
+	return newTestChain(tb, coord, chainID, valSet, signers, NewChainConfig().ConsensusParams)
+}
+
+// newTestChain initializes a new TestChain instance with the given validator set, signers and
+// consensus parameters. See NewTestChainWithValSet for more details.
+func newTestChain(tb testing.TB, coord *Coordinator, chainID string, valSet *cmttypes.ValidatorSet, signers map[string]cmttypes.PrivValidator, consensusParams *cmtproto.ConsensusParams) *TestChain {
 	tb.Helper()
 	genAccs := []authtypes.GenesisAccount{}
 	genBals := []banktypes.Balance{}
@@ -129,7 +147,7 @@ func NewTestChainWithValSet(tb testing.TB, coord *Coordinator, chainID string, v
 		senderAccs = append(senderAccs, senderAcc)
 	}
 
-	app := SetupWithGenesisValSet(tb, valSet, genAccs, chainID, sdk.DefaultPowerReduction, genBals...)
+	app := setupWithGenesisValSetAndConsensusParams(tb, valSet, genAccs, chainID, sdk.DefaultPowerReduction, consensusParams, genBals...)
 
 	// create current header and call begin block
 	header := cmtproto.Header{
@@ -142,20 +160,21 @@ func NewTestChainWithValSet(tb testing.TB, coord *Coordinator, chainID string, v
 
 	// create an account to send transactions from
 	chain := &TestChain{
-		TB:             tb,
-		Coordinator:    coord,
-		ChainID:        chainID,
-		App:            app,
-		ProposedHeader: header,
-		QueryServer:    app.GetIBCKeeper(),
-		TxConfig:       txConfig,
-		Codec:          app.AppCodec(),
-		Vals:           valSet,
-		NextVals:       valSet,
-		Signers:        signers,
-		SenderPrivKey:  senderAccs[0].SenderPrivKey,
-		SenderAccount:  senderAccs[0].SenderAccount,
-		SenderAccounts: senderAccs,
+		TB:                tb,
+		Coordinator:       coord,
+		ChainID:           chainID,
+		App:               app,
+		ProposedHeader:    header,
+		QueryServer:       app.GetIBCKeeper(),
+		TxConfig:          txConfig,
+		Codec:             app.AppCodec(),
+		Vals:              valSet,
+		NextVals:          valSet,
+		TrustedValidators: make(map[uint64]*cmttypes.ValidatorSet),
+		Signers:           signers,
+		SenderPrivKey:     senderAccs[0].SenderPrivKey,
+		SenderAccount:     senderAccs[0].SenderAccount,
+		SenderAccounts:    senderAccs,
 	}
 
 	// commit genesis block
@@ -168,19 +187,31 @@ func NewTestChainWithValSet(tb testing.TB, coord *Coordinator, chainID string, v
 // Use this function if the tests do not need custom control over the validator set
 func NewTestChain(t *testing.T, coord *Coordinator, chainID string) *TestChain {
 	t.Helper()
+	return NewTestChainWithOptions(t, coord, chainID)
+}
+
+// NewTestChainWithOptions initializes a new test chain using the default ChainConfig modified
+// by the provided options. A validator is generated for each of the configured voting powers.
+func NewTestChainWithOptions(tb testing.TB, coord *Coordinator, chainID string, opts ...ChainOption) *TestChain {
+	tb.Helper()
+
+	config := NewChainConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	require.NotEmpty(tb, config.ValidatorPowers, "test chain must have at least one validator")
+
 	// generate validators private/public key
 	var (
-		validatorsPerChain = 4
-		validators         []*cmttypes.Validator
-		signersByAddress   = make(map[string]cmttypes.PrivValidator, validatorsPerChain)
+		validators       []*cmttypes.Validator
+		signersByAddress = make(map[string]cmttypes.PrivValidator, len(config.ValidatorPowers))
 	)
 
-	for i := 0; i < validatorsPerChain; i++ {
-		_, privVal := cmttypes.RandValidator(false, 100)
-		pubKey, err := privVal.GetPubKey()
-		require.NoError(t, err)
-		validators = append(validators, cmttypes.NewValidator(pubKey, 1))
-		signersByAddress[pubKey.Address().String()] = privVal
+	for _, power := range config.ValidatorPowers {
+		validator, privVal := generateValidator(tb, power)
+		validators = append(validators, validator)
+		signersByAddress[validator.Address.String()] = privVal
 	}
 
 	// construct validator set;
@@ -188,7 +219,18 @@ func NewTestChain(t *testing.T, coord *Coordinator, chainID string) *TestChain {
 	// or, if equal, by address lexical order
 	valSet := cmttypes.NewValidatorSet(validators)
 
-	return NewTestChainWithValSet(t, coord, chainID, valSet, signersByAddress)
+	return newTestChain(tb, coord, chainID, valSet, signersByAddress, config.ConsensusParams)
+}
+
+// generateValidator generates a new validator with the given voting power along with its private validator.
+func generateValidator(tb testing.TB, power int64) (*cmttypes.Validator, cmttypes.PrivValidator) {
+	tb.Helper()
+
+	_, privVal := cmttypes.RandValidator(false, power)
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(tb, err)
+
+	return cmttypes.NewValidator(pubKey, power), privVal
 }
 
 // GetContext returns the current context for the application.
@@ -291,22 +333,62 @@ func (chain *TestChain) QueryConsensusStateProof(clientID string) ([]byte, clien
 // returned on block `n` to the validators of block `n+2`.
 // It calls BeginBlock with the new block created before returning.
 func (chain *TestChain) NextBlock() {
-	res, err := chain.App.FinalizeBlock(&abci.RequestFinalizeBlock{
+	res, err := chain.finalizeBlock(nil)
+	require.NoError(chain.TB, err)
+	chain.commitBlock(res)
+}
+
+// finalizeBlock executes a block holding the provided transactions at the proposed header. If the
+// coordinator is configured with strict event ordering, the block is first prepared and processed
+// as a proposal, and the transactions selected by PrepareProposal are executed.
+func (chain *TestChain) finalizeBlock(txs [][]byte) (*abci.ResponseFinalizeBlock, error) {
+	if chain.Coordinator.strictEventOrdering {
+		prepareRes, err := chain.App.PrepareProposal(&abci.RequestPrepareProposal{
+			MaxTxBytes:         cmttypes.MaxBlockSizeBytes,
+			Txs:                txs,
+			Height:             chain.ProposedHeader.Height,
+			Time:               chain.ProposedHeader.GetTime(),
+			NextValidatorsHash: chain.NextVals.Hash(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		processRes, err := chain.App.ProcessProposal(&abci.RequestProcessProposal{
+			Txs:                prepareRes.Txs,
+			Height:             chain.ProposedHeader.Height,
+			Time:               chain.ProposedHeader.GetTime(),
+			NextValidatorsHash: chain.NextVals.Hash(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if !processRes.IsAccepted() {
+			return nil, fmt.Errorf("block proposal at height %d was rejected", chain.ProposedHeader.Height)
+		}
+
+		txs = prepareRes.Txs
+	}
+
+	return chain.App.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Txs:                txs,
 		Height:             chain.ProposedHeader.Height,
 		Time:               chain.ProposedHeader.GetTime(),
 		NextValidatorsHash: chain.NextVals.Hash(),
 	})
-	require.NoError(chain.TB, err)
-	chain.commitBlock(res)
 }
 
 func (chain *TestChain) commitBlock(res *abci.ResponseFinalizeBlock) {
 	_, err := chain.App.Commit()
 	require.NoError(chain.TB, err)
 
+	chain.lastBlockEvents = res.Events
+
 	// set the last header to the current header
 	// use nil trusted fields
 	chain.LatestCommittedHeader = chain.CurrentTMClientHeader()
+	chain.TrustedValidators[uint64(chain.ProposedHeader.Height)] = chain.NextVals
 
 	// val set changes returned from previous block get applied to the next validators
 	// of this block. See tendermint spec for details.
@@ -352,19 +434,27 @@ func (chain *TestChain) SendMsgs(msgs ...sdk.Msg) (*abci.ExecTxResult, error) {
 		}
 	}()
 
-	resp, err := simapp.SignAndDeliver(
-		chain.TB,
-		chain.TxConfig,
-		chain.App.GetBaseApp(),
-		msgs,
-		chain.ChainID,
-		[]uint64{chain.SenderAccount.GetAccountNumber()},
-		[]uint64{chain.SenderAccount.GetSequence()},
-		true,
-		chain.ProposedHeader.GetTime(),
-		chain.NextVals.Hash(),
-		chain.SenderPrivKey,
+	var (
+		resp *abci.ResponseFinalizeBlock
+		err  error
 	)
+	if chain.Coordinator.strictEventOrdering {
+		resp, err = chain.finalizeBlock([][]byte{chain.signTx(msgs)})
+	} else {
+		resp, err = simapp.SignAndDeliver(
+			chain.TB,
+			chain.TxConfig,
+			chain.App.GetBaseApp(),
+			msgs,
+			chain.ChainID,
+			[]uint64{chain.SenderAccount.GetAccountNumber()},
+			[]uint64{chain.SenderAccount.GetSequence()},
+			true,
+			chain.ProposedHeader.GetTime(),
+			chain.NextVals.Hash(),
+			chain.SenderPrivKey,
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -383,6 +473,34 @@ func (chain *TestChain) SendMsgs(msgs ...sdk.Msg) (*abci.ExecTxResult, error) {
 	return txResult, nil
 }
 
+// signTx signs the msgs into a transaction of the sender account and returns the encoded transaction.
+func (chain *TestChain) signTx(msgs []sdk.Msg) []byte {
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(time.Now().UnixNano())),
+		chain.TxConfig,
+		msgs,
+		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)},
+		simtestutil.DefaultGenTxGas,
+		chain.ChainID,
+		[]uint64{chain.SenderAccount.GetAccountNumber()},
+		[]uint64{chain.SenderAccount.GetSequence()},
+		chain.SenderPrivKey,
+	)
+	require.NoError(chain.TB, err)
+
+	txBytes, err := chain.TxConfig.TxEncoder()(tx)
+	require.NoError(chain.TB, err)
+
+	return txBytes
+}
+
+// LastBlockEvents returns the block-level events of the last block committed by the chain, i.e.
+// the events emitted by the begin and end blockers of the modules. Events emitted by transactions
+// are returned in the transaction results of SendMsgs.
+func (chain *TestChain) LastBlockEvents() []abci.Event {
+	return chain.lastBlockEvents
+}
+
 // GetClientState retrieves the client state for the provided clientID. The client is
 // expected to exist otherwise testing will fail.
 func (chain *TestChain) GetClientState(clientID string) exported.ClientState {
@@ -401,6 +519,12 @@ func (chain *TestChain) GetConsensusState(clientID string, height exported.Heigh
 // GetTrustedValidators will return the trusted validator set of the chain for the given trusted height. Otherwise
 // it will return an error.
 func (chain *TestChain) GetTrustedValidators(trustedHeight int64) (*cmttypes.ValidatorSet, error) {
+	// the validator set may have been modified by the testing package, in which case the
+	// historical information stored in the application is not reflective of the signing validators
+	if trustedVals, ok := chain.TrustedValidators[uint64(trustedHeight)]; ok {
+		return trustedVals, nil
+	}
+
 	// historical information does not store the validator set which committed the header at
 	// height h. During BeginBlock, it stores the last updated validator set. This is equivalent to
 	// the next validator set at height h. This is because cometbft processes the validator set
@@ -431,6 +555,30 @@ func (chain *TestChain) GetTrustedValidators(trustedHeight int64) (*cmttypes.Val
 	return cmttypes.NewValidatorSet(tmValidators), nil
 }
 
+// RotateValidators replaces n validators of the next validator set with newly generated validators of
+// equal voting power. The validators with the highest voting power are replaced first. The new validators
+// sign the headers starting from the block after the current proposed block, thus headers produced for
+// counterparty client updates reflect the validator set change.
+//
+// NOTE: the validator set change is not reflected in the application state.
+func (chain *TestChain) RotateValidators(n int) {
+	require.True(chain.TB, n > 0 && n <= chain.NextVals.Size(), "number of rotated validators must be between 1 and %d", chain.NextVals.Size())
+
+	validators := make([]*cmttypes.Validator, chain.NextVals.Size())
+	for i, val := range chain.NextVals.Validators {
+		if i < n {
+			newValidator, privVal := generateValidator(chain.TB, val.VotingPower)
+			chain.Signers[newValidator.Address.String()] = privVal
+			validators[i] = newValidator
+		} else {
+			validators[i] = val.Copy()
+		}
+	}
+
+	chain.NextVals = cmttypes.NewValidatorSet(validators)
+	chain.ProposedHeader.NextValidatorsHash = chain.NextVals.Hash()
+}
+
 // GetAcknowledgement retrieves an acknowledgement for the provided packet. If the
 // acknowledgement does not exist then testing will fail.
 func (chain *TestChain) GetAcknowledgement(packet channeltypes.Packet) []byte {
@@ -498,6 +646,39 @@ func CommitHeader(proposedHeader cmttypes.Header, valSet *cmttypes.ValidatorSet,
 // CreateTMClientHeader creates a TM header to update the TM client. Args are passed in to allow
 // caller flexibility to use params that differ from the chain.
 func (chain *TestChain) CreateTMClientHeader(chainID string, blockHeight int64, trustedHeight clienttypes.Height, timestamp time.Time, cmtValSet, nextVals, cmtTrustedVals *cmttypes.ValidatorSet, signers map[string]cmttypes.PrivValidator) *ibctm.Header {
+	return chain.createTMClientHeader(chainID, blockHeight, trustedHeight, timestamp, cmtValSet, nextVals, cmtTrustedVals, signers, chain.ProposedHeader.AppHash)
+}
+
+// CreateConflictingHeader creates a header for the chain tracked by the provided 07-tendermint client at the
+// provided height. The header is signed by the validator set of the tracked chain, but commits to an altered
+// app hash such that it conflicts with the header the tracked chain produces at the same height. The trusted
+// fields of the header are set to the latest height of the client, which must be lower than the provided height.
+func (chain *TestChain) CreateConflictingHeader(clientID string, height clienttypes.Height) *ibctm.Header {
+	clientState, ok := chain.GetClientState(clientID).(*ibctm.ClientState)
+	require.True(chain.TB, ok, "client %s is not a tendermint client", clientID)
+	require.True(chain.TB, height.GT(clientState.LatestHeight), "conflicting header height %s must be greater than the latest client height %s", height, clientState.LatestHeight)
+
+	counterparty := chain.Coordinator.GetChain(clientState.ChainId)
+	trustedVals, err := counterparty.GetTrustedValidators(int64(clientState.LatestHeight.RevisionHeight))
+	require.NoError(chain.TB, err)
+
+	forkAppHash := tmhash.Sum(counterparty.ProposedHeader.AppHash)
+
+	return counterparty.createTMClientHeader(
+		clientState.ChainId,
+		int64(height.RevisionHeight),
+		clientState.LatestHeight,
+		counterparty.ProposedHeader.Time,
+		counterparty.Vals,
+		counterparty.NextVals,
+		trustedVals,
+		counterparty.Signers,
+		forkAppHash,
+	)
+}
+
+// createTMClientHeader creates a TM header committing to the provided app hash.
+func (chain *TestChain) createTMClientHeader(chainID string, blockHeight int64, trustedHeight clienttypes.Height, timestamp time.Time, cmtValSet, nextVals, cmtTrustedVals *cmttypes.ValidatorSet, signers map[string]cmttypes.PrivValidator, appHash []byte) *ibctm.Header {
 	var (
 		valSet      *cmtproto.ValidatorSet
 		trustedVals *cmtproto.ValidatorSet
@@ -515,7 +696,7 @@ func (chain *TestChain) CreateTMClientHeader(chainID string, blockHeight int64,
 		ValidatorsHash:     cmtValSet.Hash(),
 		NextValidatorsHash: nextVals.Hash(),
 		ConsensusHash:      unusedHash,
-		AppHash:            chain.ProposedHeader.AppHash,
+		AppHash:            appHash,
 		LastResultsHash:    unusedHash,
 		EvidenceHash:       unusedHash,
 		ProposerAddress:    cmtValSet.Proposer.Address, //nolint:staticcheck
@@ -9,6 +9,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v8/testing"
 )
 
@@ -41,3 +47,167 @@ func TestChangeValSet(t *testing.T) {
 	err = path.EndpointB.UpdateClient()
 	require.NoError(t, err)
 }
+
+func TestNewCoordinatorWithChainOptions(t *testing.T) {
+	coord := ibctesting.NewCoordinatorWithChainOptions(t, 2, map[string][]ibctesting.ChainOption{
+		ibctesting.GetChainID(2): {ibctesting.WithValidatorPowers(3, 1, 1, 1, 1, 1)},
+	})
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+
+	require.Equal(t, ibctesting.DefaultValidatorsPerChain, chainA.Vals.Size())
+	require.Equal(t, int64(ibctesting.DefaultValidatorsPerChain), chainA.Vals.TotalVotingPower())
+
+	require.Equal(t, 6, chainB.Vals.Size())
+	require.Equal(t, int64(8), chainB.Vals.TotalVotingPower())
+
+	// the trusted validators stored in the application match the configured voting powers
+	trustedVals, err := chainB.App.GetStakingKeeper().GetHistoricalInfo(chainB.GetContext(), chainB.LatestCommittedHeader.Header.Height)
+	require.NoError(t, err)
+	require.Len(t, trustedVals.Valset, 6)
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.Setup()
+}
+
+func TestRotateValidators(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.SetupClients()
+
+	prevVals := chainB.Vals
+	chainB.RotateValidators(2)
+
+	// the validator set change is applied to the validators of the next block
+	coord.CommitBlock(chainB)
+	require.Equal(t, prevVals.Hash(), chainB.LatestCommittedHeader.Header.ValidatorsHash)
+	require.NotEqual(t, prevVals.Hash(), chainB.Vals.Hash())
+	require.Equal(t, prevVals.TotalVotingPower(), chainB.Vals.TotalVotingPower())
+
+	// verify that update clients works across the rotation
+	err := path.EndpointA.UpdateClient()
+	require.NoError(t, err)
+	err = path.EndpointA.UpdateClient()
+	require.NoError(t, err)
+}
+
+func TestSubmitMisbehaviour(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.SetupClients()
+
+	// the conflicting header is signed by the tracked chain but commits to a different app hash
+	height := path.EndpointA.GetClientLatestHeight().Increment().(clienttypes.Height)
+	header := chainA.CreateConflictingHeader(path.EndpointA.ClientID, height)
+	require.Equal(t, chainB.ChainID, header.Header.ChainID)
+	require.Equal(t, int64(height.RevisionHeight), header.Header.Height)
+	require.NotEqual(t, chainB.ProposedHeader.AppHash, header.Header.AppHash)
+	require.Equal(t, path.EndpointA.GetClientLatestHeight(), header.TrustedHeight)
+
+	err := path.EndpointA.SubmitMisbehaviour()
+	require.NoError(t, err)
+
+	// the client tracking chainA is unaffected
+	require.Equal(t, exported.Active, chainB.App.GetIBCKeeper().ClientKeeper.GetClientStatus(chainB.GetContext(), path.EndpointB.ClientID))
+}
+
+func TestUpdateClientWithResult(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.SetupClients()
+
+	previousHeight := path.EndpointA.GetClientLatestHeight()
+
+	result, err := path.EndpointA.UpdateClientWithResult()
+	require.NoError(t, err)
+
+	require.Equal(t, path.EndpointA.ClientID, result.ClientId)
+	require.Equal(t, clienttypes.UpdateResultUpdate, result.UpdateResult)
+	require.Equal(t, previousHeight, result.PreviousHeight)
+	require.Equal(t, []clienttypes.Height{path.EndpointA.GetClientLatestHeight().(clienttypes.Height)}, result.NewConsensusHeights)
+}
+
+func TestStrictEventOrderingPacketEvents(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2, ibctesting.WithStrictEventOrdering())
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	path.Setup()
+
+	msg := transfertypes.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.TestCoin, chainA.SenderAccount.GetAddress().String(), chainB.SenderAccount.GetAddress().String(), chainA.GetTimeoutHeight(), 0, "")
+	res, err := chainA.SendMsgs(msg)
+	require.NoError(t, err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	require.NoError(t, err)
+
+	// the send packet event is emitted exactly once per send, in the transaction result only
+	require.Equal(t, 1, countEvents(res.Events, channeltypes.EventTypeSendPacket))
+	require.Zero(t, countEvents(chainA.LastBlockEvents(), channeltypes.EventTypeSendPacket))
+
+	// the typed event is consistent with the legacy event
+	sendEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](res.Events)
+	require.Len(t, sendEvents, 1)
+	require.Equal(t, packet, sendEvents[0].Packet)
+	require.Equal(t, path.EndpointA.ConnectionID, sendEvents[0].ConnectionId)
+
+	recvRes, ack, err := path.RelayPacketWithResults(packet)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, countEvents(recvRes.Events, channeltypes.EventTypeWriteAck))
+	require.Zero(t, countEvents(chainB.LastBlockEvents(), channeltypes.EventTypeWriteAck))
+
+	ackEvents := ibctesting.FindTypedEvents[*channeltypes.EventWriteAcknowledgement](recvRes.Events)
+	require.Len(t, ackEvents, 1)
+	require.Equal(t, packet, ackEvents[0].Packet)
+	require.Equal(t, ack, ackEvents[0].Acknowledgement)
+}
+
+func TestStrictEventOrderingBlockEvents(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2, ibctesting.WithStrictEventOrdering())
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+
+	coord.CommitBlock(chainA)
+
+	// block-level events are emitted by the begin and end blockers
+	events := chainA.LastBlockEvents()
+	require.NotEmpty(t, events)
+	for _, event := range events {
+		mode, found := attributeValue(event, "mode")
+		require.True(t, found, "event %s has no mode attribute", event.Type)
+		require.Contains(t, []string{"BeginBlock", "EndBlock"}, mode)
+	}
+}
+
+// countEvents returns the number of events of the given type.
+func countEvents(events []abci.Event, eventType string) int {
+	var count int
+	for _, event := range events {
+		if event.Type == eventType {
+			count++
+		}
+	}
+
+	return count
+}
+
+// attributeValue returns the value of the attribute with the given key of the event.
+func attributeValue(event abci.Event, key string) (string, bool) {
+	for _, attr := range event.Attributes {
+		if attr.Key == key {
+			return attr.Value, true
+		}
+	}
+
+	return "", false
+}
@@ -3,6 +3,10 @@ package ibctesting
 import (
 	"time"
 
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
 	connectiontypes "github.com/cosmos/ibc-go/v8/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -60,3 +64,55 @@ func NewChannelConfig() *ChannelConfig {
 		Order:   channeltypes.UNORDERED,
 	}
 }
+
+// ChainConfig defines the genesis validator set and consensus parameters used to construct a TestChain.
+type ChainConfig struct {
+	// ValidatorPowers contains the voting power of each genesis validator. The number of
+	// entries determines the size of the validator set.
+	ValidatorPowers []int64
+	// ConsensusParams are the consensus parameters the application is initialized with.
+	ConsensusParams *cmtproto.ConsensusParams
+}
+
+// ChainOption modifies the ChainConfig used to construct a TestChain.
+type ChainOption func(*ChainConfig)
+
+// NewChainConfig returns the default ChainConfig, which consists of 4 validators with a
+// voting power of 1 and the default consensus parameters.
+func NewChainConfig() *ChainConfig {
+	validatorPowers := make([]int64, DefaultValidatorsPerChain)
+	for i := range validatorPowers {
+		validatorPowers[i] = 1
+	}
+
+	return &ChainConfig{
+		ValidatorPowers: validatorPowers,
+		ConsensusParams: simtestutil.DefaultConsensusParams,
+	}
+}
+
+// WithValidatorCount configures the TestChain to use n genesis validators with a voting power of 1.
+func WithValidatorCount(n int) ChainOption {
+	return func(config *ChainConfig) {
+		config.ValidatorPowers = make([]int64, n)
+		for i := range config.ValidatorPowers {
+			config.ValidatorPowers[i] = 1
+		}
+	}
+}
+
+// WithValidatorPowers configures the TestChain to use a genesis validator for each of the
+// provided voting powers.
+func WithValidatorPowers(powers ...int64) ChainOption {
+	return func(config *ChainConfig) {
+		config.ValidatorPowers = powers
+	}
+}
+
+// WithConsensusParams configures the consensus parameters the TestChain application is
+// initialized with.
+func WithConsensusParams(params *cmtproto.ConsensusParams) ChainOption {
+	return func(config *ChainConfig) {
+		config.ConsensusParams = params
+	}
+}
@@ -24,10 +24,36 @@ type Coordinator struct {
 
 	CurrentTime time.Time
 	Chains      map[string]*TestChain
+
+	// strictEventOrdering is set if the chains commit blocks through the full ABCI block
+	// lifecycle of a validator node. See WithStrictEventOrdering for more details.
+	strictEventOrdering bool
+}
+
+// CoordinatorOption configures the Coordinator and the TestChain's it constructs.
+type CoordinatorOption func(*Coordinator)
+
+// WithStrictEventOrdering configures the TestChain's to commit blocks as a validator node would: the
+// transaction delivered by SendMsgs, or no transaction for blocks committed with NextBlock, is
+// included in a block proposal with PrepareProposal, accepted with ProcessProposal and only then
+// executed with FinalizeBlock. The block-level events of the last block are available through
+// TestChain.LastBlockEvents in either mode.
+func WithStrictEventOrdering() CoordinatorOption {
+	return func(coord *Coordinator) {
+		coord.strictEventOrdering = true
+	}
 }
 
 // NewCoordinator initializes Coordinator with N TestChain's
-func NewCoordinator(t *testing.T, n int) *Coordinator {
+func NewCoordinator(t *testing.T, n int, opts ...CoordinatorOption) *Coordinator {
+	t.Helper()
+	return NewCoordinatorWithChainOptions(t, n, nil, opts...)
+}
+
+// NewCoordinatorWithChainOptions initializes Coordinator with N TestChain's. The chain options are
+// keyed by chain ID and are used to construct the respective TestChain, chains without options
+// use the default ChainConfig.
+func NewCoordinatorWithChainOptions(t *testing.T, n int, chainOpts map[string][]ChainOption, opts ...CoordinatorOption) *Coordinator {
 	t.Helper()
 	chains := make(map[string]*TestChain)
 	coord := &Coordinator{
@@ -35,9 +61,13 @@ func NewCoordinator(t *testing.T, n int) *Coordinator {
 		CurrentTime: globalStartTime,
 	}
 
+	for _, opt := range opts {
+		opt(coord)
+	}
+
 	for i := 1; i <= n; i++ {
 		chainID := GetChainID(i)
-		chains[chainID] = NewTestChain(t, coord, chainID)
+		chains[chainID] = NewTestChainWithOptions(t, coord, chainID, chainOpts[chainID]...)
 	}
 	coord.Chains = chains
 
@@ -131,11 +131,42 @@ func (endpoint *Endpoint) CreateClient() (err error) {
 }
 
 // UpdateClient updates the IBC client associated with the endpoint.
-func (endpoint *Endpoint) UpdateClient() (err error) {
+func (endpoint *Endpoint) UpdateClient() error {
+	msg, err := endpoint.updateClientMsg()
+	if err != nil {
+		return err
+	}
+
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// UpdateClientWithResult updates the IBC client associated with the endpoint and returns the
+// typed update client result event, which reports whether the update was a no-op, updated the
+// client or froze the client due to misbehaviour.
+func (endpoint *Endpoint) UpdateClientWithResult() (*clienttypes.EventUpdateClientResult, error) {
+	msg, err := endpoint.updateClientMsg()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := endpoint.Chain.SendMsgs(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseUpdateClientResultFromEvents(res.Events)
+}
+
+// updateClientMsg returns a MsgUpdateClient updating the IBC client associated with the endpoint
+// to the latest committed header of the counterparty chain.
+func (endpoint *Endpoint) updateClientMsg() (*clienttypes.MsgUpdateClient, error) {
 	// ensure counterparty has committed state
 	endpoint.Chain.Coordinator.CommitBlock(endpoint.Counterparty.Chain)
 
-	var header exported.ClientMessage
+	var (
+		header exported.ClientMessage
+		err    error
+	)
 
 	switch endpoint.ClientConfig.GetClientType() {
 	case exported.Tendermint:
@@ -147,7 +178,7 @@ func (endpoint *Endpoint) UpdateClient() (err error) {
 	}
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	msg, err := clienttypes.NewMsgUpdateClient(
@@ -156,7 +187,47 @@ func (endpoint *Endpoint) UpdateClient() (err error) {
 	)
 	require.NoError(endpoint.Chain.TB, err)
 
-	return endpoint.Chain.sendMsgs(msg)
+	return msg, nil
+}
+
+// SubmitMisbehaviour submits misbehaviour of the counterparty chain to the IBC client associated with the endpoint
+// and asserts that the client is frozen as a result. The misbehaviour consists of a header of the counterparty chain
+// above the latest client height and a conflicting header at the same height committing to an altered app hash.
+func (endpoint *Endpoint) SubmitMisbehaviour() error {
+	if endpoint.ClientConfig.GetClientType() != exported.Tendermint {
+		return fmt.Errorf("client type %s is not supported", endpoint.ClientConfig.GetClientType())
+	}
+
+	trustedHeight, ok := endpoint.GetClientLatestHeight().(clienttypes.Height)
+	require.True(endpoint.Chain.TB, ok)
+
+	trustedVals, err := endpoint.Counterparty.Chain.GetTrustedValidators(int64(trustedHeight.RevisionHeight))
+	if err != nil {
+		return err
+	}
+
+	height := trustedHeight.Increment().(clienttypes.Height)
+	counterparty := endpoint.Counterparty.Chain
+	misbehaviour := ibctm.NewMisbehaviour(
+		endpoint.ClientID,
+		counterparty.CreateTMClientHeader(counterparty.ChainID, int64(height.RevisionHeight), trustedHeight, counterparty.ProposedHeader.Time, counterparty.Vals, counterparty.NextVals, trustedVals, counterparty.Signers),
+		endpoint.Chain.CreateConflictingHeader(endpoint.ClientID, height),
+	)
+
+	msg, err := clienttypes.NewMsgUpdateClient(
+		endpoint.ClientID, misbehaviour,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	require.NoError(endpoint.Chain.TB, err)
+
+	if err := endpoint.Chain.sendMsgs(msg); err != nil {
+		return err
+	}
+
+	status := endpoint.Chain.App.GetIBCKeeper().ClientKeeper.GetClientStatus(endpoint.Chain.GetContext(), endpoint.ClientID)
+	require.Equal(endpoint.Chain.TB, exported.Frozen, status, "client %s is not frozen after misbehaviour submission", endpoint.ClientID)
+
+	return nil
 }
 
 // UpgradeChain will upgrade a chain's chainID to the next revision number.
@@ -528,7 +599,7 @@ func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
 	switch endpoint.ChannelConfig.Order {
 	case channeltypes.ORDERED:
 		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
-	case channeltypes.UNORDERED:
+	case channeltypes.UNORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT:
 		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 	default:
 		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
@@ -553,7 +624,7 @@ func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
 	var packetKey []byte
 
 	switch endpoint.ChannelConfig.Order {
-	case channeltypes.ORDERED:
+	case channeltypes.ORDERED, channeltypes.ORDERED_ALLOW_TIMEOUT:
 		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
 	case channeltypes.UNORDERED:
 		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
@@ -5,9 +5,14 @@ import (
 	"fmt"
 	"slices"
 	"strconv"
+	"testing"
 
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
 	testifysuite "github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -30,6 +35,21 @@ func ParseClientIDFromEvents(events []abci.Event) (string, error) {
 	return "", fmt.Errorf("client identifier event attribute not found")
 }
 
+// ParseUpdateClientResultFromEvents parses events emitted from a MsgUpdateClient or MsgSubmitMisbehaviour
+// and returns the typed update client result event.
+func ParseUpdateClientResultFromEvents(events []abci.Event) (*clienttypes.EventUpdateClientResult, error) {
+	results, err := parseTypedEvents[*clienttypes.EventUpdateClientResult](events)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) == 0 {
+		return nil, fmt.Errorf("update client result event not found")
+	}
+
+	return results[0], nil
+}
+
 // ParseConnectionIDFromEvents parses events emitted from a MsgConnectionOpenInit or
 // MsgConnectionOpenTry and returns the connection identifier.
 func ParseConnectionIDFromEvents(events []abci.Event) (string, error) {
@@ -73,13 +93,28 @@ func ParsePacketFromEvents(events []abci.Event) (channeltypes.Packet, error) {
 }
 
 // ParsePacketsFromEvents parses events emitted from a MsgRecvPacket and returns
-// all the packets found.
+// all the packets found. The typed send packet events are used if present, otherwise
+// the packets are parsed from the attributes of the legacy send packet events.
 // Returns an error if no packet is found.
 func ParsePacketsFromEvents(events []abci.Event) ([]channeltypes.Packet, error) {
 	ferr := func(err error) ([]channeltypes.Packet, error) {
 		return nil, fmt.Errorf("ibctesting.ParsePacketsFromEvents: %w", err)
 	}
+
+	sendPacketEvents, err := parseTypedEvents[*channeltypes.EventSendPacket](events)
+	if err != nil {
+		return ferr(err)
+	}
+
 	var packets []channeltypes.Packet
+	for _, ev := range sendPacketEvents {
+		packets = append(packets, ev.Packet)
+	}
+
+	if len(packets) > 0 {
+		return packets, nil
+	}
+
 	for _, ev := range events {
 		if ev.Type == channeltypes.EventTypeSendPacket {
 			var packet channeltypes.Packet
@@ -142,8 +177,18 @@ func ParsePacketsFromEvents(events []abci.Event) ([]channeltypes.Packet, error)
 }
 
 // ParseAckFromEvents parses events emitted from a MsgRecvPacket and returns the
-// acknowledgement.
+// acknowledgement. The typed write acknowledgement event is used if present, otherwise
+// the acknowledgement is parsed from the attributes of the legacy write acknowledgement event.
 func ParseAckFromEvents(events []abci.Event) ([]byte, error) {
+	writeAckEvents, err := parseTypedEvents[*channeltypes.EventWriteAcknowledgement](events)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(writeAckEvents) > 0 {
+		return writeAckEvents[0].Acknowledgement, nil
+	}
+
 	for _, ev := range events {
 		if ev.Type == channeltypes.EventTypeWriteAck {
 			for _, attr := range ev.Attributes {
@@ -174,6 +219,58 @@ func ParseProposalIDFromEvents(events []abci.Event) (uint64, error) {
 	return 0, fmt.Errorf("proposalID event attribute not found")
 }
 
+// FindTypedEvents returns all the typed events of type T contained in the provided events,
+// in the order they were emitted. The events are unmarshaled back into their proto message
+// using the registered proto types. It panics if an event of type T cannot be unmarshaled.
+func FindTypedEvents[T proto.Message](events []abci.Event) []T {
+	typedEvents, err := parseTypedEvents[T](events)
+	if err != nil {
+		panic(err)
+	}
+
+	return typedEvents
+}
+
+// AssertTypedEvent asserts that the expected typed event is present in the actual events.
+func AssertTypedEvent[T proto.Message](tb testing.TB, events []abci.Event, expected T) {
+	tb.Helper()
+
+	typedEvents, err := parseTypedEvents[T](events)
+	require.NoError(tb, err)
+
+	found := slices.ContainsFunc(typedEvents, func(typedEvent T) bool {
+		return proto.Equal(typedEvent, expected)
+	})
+	require.True(tb, found, "event: %s was not found in events: %v", proto.MessageName(expected), typedEvents)
+}
+
+// parseTypedEvents unmarshals all the events of the typed event T contained in the provided events.
+func parseTypedEvents[T proto.Message](events []abci.Event) ([]T, error) {
+	var zero T
+	eventType := proto.MessageName(zero)
+
+	var typedEvents []T
+	for _, ev := range events {
+		if ev.Type != eventType {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(ev)
+		if err != nil {
+			return nil, err
+		}
+
+		typedEvent, ok := msg.(T)
+		if !ok {
+			return nil, fmt.Errorf("expected %T, got %T", zero, msg)
+		}
+
+		typedEvents = append(typedEvents, typedEvent)
+	}
+
+	return typedEvents, nil
+}
+
 // AssertEvents asserts that expected events are present in the actual events.
 func AssertEvents(
 	suite *testifysuite.Suite,
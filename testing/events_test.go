@@ -4,8 +4,11 @@ import (
 	"encoding/hex"
 	"testing"
 
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	"github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -219,3 +222,41 @@ func TestParsePacketsFromEvents(t *testing.T) {
 		})
 	}
 }
+
+func TestFindTypedEvents(t *testing.T) {
+	packets := []channeltypes.Packet{
+		channeltypes.NewPacket([]byte("data1"), 1, "srcPort", "srcChannel", "dstPort", "dstChannel", types.NewHeight(1, 2), 0),
+		channeltypes.NewPacket([]byte("data2"), 2, "srcPort", "srcChannel", "dstPort", "dstChannel", types.NewHeight(1, 3), 0),
+	}
+
+	var events []abci.Event
+	for _, packet := range packets {
+		event, err := sdk.TypedEventToEvent(&channeltypes.EventSendPacket{Packet: packet, ConnectionId: ibctesting.FirstConnectionID})
+		require.NoError(t, err)
+
+		events = append(events, abci.Event{Type: "xxx"}, abci.Event(event))
+	}
+
+	sendPacketEvents := ibctesting.FindTypedEvents[*channeltypes.EventSendPacket](events)
+	require.Len(t, sendPacketEvents, len(packets))
+	for i, packet := range packets {
+		require.Equal(t, packet, sendPacketEvents[i].Packet)
+	}
+
+	require.Empty(t, ibctesting.FindTypedEvents[*channeltypes.EventWriteAcknowledgement](events))
+
+	ibctesting.AssertTypedEvent(t, events, &channeltypes.EventSendPacket{Packet: packets[1], ConnectionId: ibctesting.FirstConnectionID})
+
+	parsedPackets, err := ibctesting.ParsePacketsFromEvents(events)
+	require.NoError(t, err)
+	require.Equal(t, packets, parsedPackets)
+
+	require.Panics(t, func() {
+		ibctesting.FindTypedEvents[*channeltypes.EventSendPacket]([]abci.Event{
+			{
+				Type:       proto.MessageName(&channeltypes.EventSendPacket{}),
+				Attributes: []abci.EventAttribute{{Key: "packet", Value: "invalid"}},
+			},
+		})
+	})
+}
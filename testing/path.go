@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/stretchr/testify/require"
+
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
@@ -60,12 +63,59 @@ func NewTransferPathWithFeeEnabled(chainA, chainB *TestChain) *Path {
 	return EnableFeeOnPath(path)
 }
 
+// StackConfig describes the middleware wrapping the transfer application on both endpoints of a path
+// constructed with NewComposedPath.
+type StackConfig struct {
+	// Fee negotiates the fee middleware on the channel by wrapping the transfer version in the fee metadata.
+	Fee bool
+	// Callbacks selects the transfer stack wrapped by the callbacks middleware. Callbacks are only executed
+	// for packets requesting them in their memo.
+	Callbacks bool
+}
+
+// NewComposedPath constructs a new path between each chain suitable for use with the transfer stack composed
+// of the middleware described by the stack config. The port of each endpoint is selected from the transfer
+// stacks wired by the testing application of its chain, and the channel version is set accordingly.
+func NewComposedPath(chainA, chainB *TestChain, config StackConfig) *Path {
+	path := NewTransferPath(chainA, chainB)
+
+	for _, endpoint := range []*Endpoint{path.EndpointA, path.EndpointB} {
+		endpoint.ChannelConfig.PortID = transferStackPort(endpoint.Chain, config)
+
+		if config.Fee {
+			endpoint.ChannelConfig.Version = string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{FeeVersion: feetypes.Version, AppVersion: transfertypes.Version}))
+		}
+	}
+
+	return path
+}
+
+// transferStackPort returns the port of the chain routed to the transfer stack described by the stack config.
+// The fee middleware is expected to wrap every transfer stack, as it only takes effect on channels negotiating
+// the fee version.
+func transferStackPort(chain *TestChain, config StackConfig) string {
+	if !config.Callbacks {
+		return TransferPort
+	}
+
+	app, ok := chain.App.(CallbacksTestingApp)
+	require.True(chain.TB, ok, "testing app of chain %s does not wire the callbacks middleware", chain.ChainID)
+
+	return app.GetCallbacksTransferPort()
+}
+
 // SetChannelOrdered sets the channel order for both endpoints to ORDERED.
 func (path *Path) SetChannelOrdered() {
 	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
 	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
 }
 
+// SetChannelOrderedAllowTimeout sets the channel order for both endpoints to ORDERED_ALLOW_TIMEOUT.
+func (path *Path) SetChannelOrderedAllowTimeout() {
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED_ALLOW_TIMEOUT
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED_ALLOW_TIMEOUT
+}
+
 // RelayPacket attempts to relay the packet first on EndpointA and then on EndpointB
 // if EndpointA does not contain a packet commitment for that packet. An error is returned
 // if a relay step fails or the packet commitment does not exist on either endpoint.
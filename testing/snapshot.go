@@ -0,0 +1,135 @@
+package ibctesting
+
+import (
+	"maps"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+	"github.com/cosmos/ibc-go/v8/testing/simapp"
+)
+
+// StateSnapshot is a snapshot of the committed state of a TestChain. Besides the height of the
+// committed application state, it captures the header and validator history of the chain, so that
+// headers constructed after the snapshot is restored remain consistent with the restored state.
+type StateSnapshot struct {
+	height                int64
+	latestCommittedHeader ibctm.Header
+	proposedHeader        cmtproto.Header
+
+	vals              *cmttypes.ValidatorSet
+	nextVals          *cmttypes.ValidatorSet
+	trustedValidators map[uint64]*cmttypes.ValidatorSet
+	signers           map[string]cmttypes.PrivValidator
+
+	senderPrivKey   cryptotypes.PrivKey
+	senderAccount   sdk.AccountI
+	senderSequence  uint64
+	senderSequences []uint64
+}
+
+// Snapshot returns a snapshot of the latest committed state of the chain. Any state written to the
+// chain context that has not been committed in a block is not part of the snapshot.
+func (chain *TestChain) Snapshot() StateSnapshot {
+	senderSequences := make([]uint64, len(chain.SenderAccounts))
+	for i, senderAccount := range chain.SenderAccounts {
+		senderSequences[i] = senderAccount.SenderAccount.GetSequence()
+	}
+
+	return StateSnapshot{
+		height:                chain.App.LastBlockHeight(),
+		latestCommittedHeader: *chain.LatestCommittedHeader,
+		proposedHeader:        chain.ProposedHeader,
+		vals:                  chain.Vals.Copy(),
+		nextVals:              chain.NextVals.Copy(),
+		trustedValidators:     maps.Clone(chain.TrustedValidators),
+		signers:               maps.Clone(chain.Signers),
+		senderPrivKey:         chain.SenderPrivKey,
+		senderAccount:         chain.SenderAccount,
+		senderSequence:        chain.SenderAccount.GetSequence(),
+		senderSequences:       senderSequences,
+	}
+}
+
+// Restore rolls back the committed state of the chain to the height of the provided snapshot and
+// restores the header and validator history captured by the snapshot. A snapshot may be restored
+// any number of times, however restoring a snapshot discards all committed heights after it, so
+// any snapshot taken at a later height can no longer be restored.
+//
+// NOTE: clients of this chain on counterparty chains are not rolled back. Restoring a single chain
+// leaves those clients tracking headers that no longer exist on the restored chain, so all chains
+// connected by clients must be restored in the same call using Coordinator.RestoreAll.
+func (chain *TestChain) Restore(snapshot StateSnapshot) {
+	require.LessOrEqual(chain.TB, snapshot.height, chain.App.LastBlockHeight(), "cannot restore snapshot taken at a height after the latest committed height")
+
+	err := chain.App.GetBaseApp().CommitMultiStore().RollbackToVersion(snapshot.height)
+	require.NoError(chain.TB, err)
+
+	latestCommittedHeader := snapshot.latestCommittedHeader
+	chain.LatestCommittedHeader = &latestCommittedHeader
+	chain.ProposedHeader = snapshot.proposedHeader
+	chain.Vals = snapshot.vals.Copy()
+	chain.NextVals = snapshot.nextVals.Copy()
+	chain.TrustedValidators = maps.Clone(snapshot.trustedValidators)
+	chain.Signers = maps.Clone(snapshot.signers)
+
+	chain.SenderPrivKey = snapshot.senderPrivKey
+	chain.SenderAccount = snapshot.senderAccount
+	require.NoError(chain.TB, chain.SenderAccount.SetSequence(snapshot.senderSequence))
+	for i, sequence := range snapshot.senderSequences {
+		require.NoError(chain.TB, chain.SenderAccounts[i].SenderAccount.SetSequence(sequence))
+	}
+
+	// the rollback reloads the memory stores empty, the capability memory store must
+	// be initialized again before capabilities can be used from the chain context
+	if app, ok := chain.App.(*simapp.SimApp); ok {
+		app.CapabilityKeeper.InitMemStore(chain.GetContext())
+	}
+}
+
+// CoordinatorSnapshot is a snapshot of the committed state of all chains of a Coordinator, along
+// with the current time of the Coordinator.
+type CoordinatorSnapshot struct {
+	currentTime time.Time
+	chains      map[string]StateSnapshot
+}
+
+// SnapshotAll returns a snapshot of the latest committed state of all chains and the current time
+// of the coordinator.
+func (coord *Coordinator) SnapshotAll() CoordinatorSnapshot {
+	chains := make(map[string]StateSnapshot, len(coord.Chains))
+	for chainID, chain := range coord.Chains {
+		chains[chainID] = chain.Snapshot()
+	}
+
+	return CoordinatorSnapshot{
+		currentTime: coord.CurrentTime,
+		chains:      chains,
+	}
+}
+
+// RestoreAll restores the state of all chains and the current time of the coordinator from the
+// provided snapshot. Restoring the chains together ensures the clients each chain holds of its
+// counterparties remain consistent with the restored counterparty state.
+//
+// NOTE: identifiers and configuration held by Paths and Endpoints are not part of the snapshot.
+// Tests must retain or reconstruct the Paths used to create the snapshot state.
+func (coord *Coordinator) RestoreAll(snapshot CoordinatorSnapshot) {
+	require.Len(coord.T, snapshot.chains, len(coord.Chains), "snapshot must include all chains of the coordinator")
+
+	for chainID, chain := range coord.Chains {
+		chainSnapshot, ok := snapshot.chains[chainID]
+		require.True(coord.T, ok, "snapshot does not include chain %s", chainID)
+
+		chain.Restore(chainSnapshot)
+	}
+
+	coord.CurrentTime = snapshot.currentTime
+}
@@ -0,0 +1,51 @@
+package ibctesting_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+)
+
+func TestSnapshotRestoreAll(t *testing.T) {
+	coord := ibctesting.NewCoordinator(t, 2)
+	chainA := coord.GetChain(ibctesting.GetChainID(1))
+	chainB := coord.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(chainA, chainB)
+	path.SetupConnections()
+
+	snapshot := coord.SnapshotAll()
+	heightA, heightB := chainA.App.LastBlockHeight(), chainB.App.LastBlockHeight()
+	currentTime := coord.CurrentTime
+
+	// restoring the same snapshot multiple times must yield the same state each time
+	for i := 0; i < 2; i++ {
+		path.CreateChannels()
+
+		_, found := chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		require.True(t, found)
+
+		coord.RestoreAll(snapshot)
+
+		require.Equal(t, heightA, chainA.App.LastBlockHeight())
+		require.Equal(t, heightB, chainB.App.LastBlockHeight())
+		require.Equal(t, currentTime, coord.CurrentTime)
+
+		_, found = chainA.App.GetIBCKeeper().ChannelKeeper.GetChannel(chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		require.False(t, found)
+		_, found = chainB.App.GetIBCKeeper().ChannelKeeper.GetChannel(chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		require.False(t, found)
+	}
+
+	// clients can be updated and channels created after the state has been restored
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointB.UpdateClient())
+
+	path.CreateChannels()
+
+	channel := path.EndpointA.GetChannel()
+	require.Equal(t, channeltypes.OPEN, channel.State)
+}
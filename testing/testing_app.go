@@ -25,6 +25,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
@@ -53,6 +54,15 @@ type TestingApp interface {
 	LastBlockHeight() int64
 }
 
+// CallbacksTestingApp is an optional interface which may be implemented by testing applications wiring the
+// callbacks middleware into their transfer stack, such that paths using callbacks can be constructed with
+// NewComposedPath.
+type CallbacksTestingApp interface {
+	// GetCallbacksTransferPort returns the port routed to the transfer application wrapped by the callbacks
+	// middleware, which is in turn wrapped by the fee middleware.
+	GetCallbacksTransferPort() string
+}
+
 func SetupTestingApp() (TestingApp, map[string]json.RawMessage) {
 	db := dbm.NewMemDB()
 	app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, simtestutil.EmptyAppOptions{})
@@ -64,6 +74,13 @@ func SetupTestingApp() (TestingApp, map[string]json.RawMessage) {
 // of one consensus engine unit (10^6) in the default token of the simapp from first genesis
 // account. A Nop logger is set in SimApp.
 func SetupWithGenesisValSet(tb testing.TB, valSet *cmttypes.ValidatorSet, genAccs []authtypes.GenesisAccount, chainID string, powerReduction sdkmath.Int, balances ...banktypes.Balance) TestingApp {
+	tb.Helper()
+	return setupWithGenesisValSetAndConsensusParams(tb, valSet, genAccs, chainID, powerReduction, simtestutil.DefaultConsensusParams, balances...)
+}
+
+// setupWithGenesisValSetAndConsensusParams initializes a new TestingApp with the given validator set, genesis
+// accounts and consensus parameters. Each validator is bonded with tokens matching its voting power.
+func setupWithGenesisValSetAndConsensusParams(tb testing.TB, valSet *cmttypes.ValidatorSet, genAccs []authtypes.GenesisAccount, chainID string, powerReduction sdkmath.Int, consensusParams *cmtproto.ConsensusParams, balances ...banktypes.Balance) TestingApp {
 	tb.Helper()
 	app, genesisState := DefaultTestingAppInit()
 
@@ -77,9 +94,12 @@ func SetupWithGenesisValSet(tb testing.TB, valSet *cmttypes.ValidatorSet, genAcc
 	validators := make([]stakingtypes.Validator, 0, len(valSet.Validators))
 	delegations := make([]stakingtypes.Delegation, 0, len(valSet.Validators))
 
-	bondAmt := sdk.TokensFromConsensusPower(1, powerReduction)
+	totalBondAmt := sdkmath.ZeroInt()
 
 	for _, val := range valSet.Validators {
+		bondAmt := sdk.TokensFromConsensusPower(val.VotingPower, powerReduction)
+		totalBondAmt = totalBondAmt.Add(bondAmt)
+
 		pk, err := cryptocodec.FromCmtPubKeyInterface(val.PubKey)
 		require.NoError(tb, err)
 		pkAny, err := codectypes.NewAnyWithValue(pk)
@@ -111,7 +131,7 @@ func SetupWithGenesisValSet(tb testing.TB, valSet *cmttypes.ValidatorSet, genAcc
 	// add bonded amount to bonded pool module account
 	balances = append(balances, banktypes.Balance{
 		Address: authtypes.NewModuleAddress(stakingtypes.BondedPoolName).String(),
-		Coins:   sdk.Coins{sdk.NewCoin(bondDenom, bondAmt.Mul(sdkmath.NewInt(int64(len(valSet.Validators)))))},
+		Coins:   sdk.Coins{sdk.NewCoin(bondDenom, totalBondAmt)},
 	})
 
 	// set validators and delegations
@@ -131,7 +151,7 @@ func SetupWithGenesisValSet(tb testing.TB, valSet *cmttypes.ValidatorSet, genAcc
 			ChainId:         chainID,
 			Validators:      []abci.ValidatorUpdate{},
 			AppStateBytes:   stateBytes,
-			ConsensusParams: simtestutil.DefaultConsensusParams,
+			ConsensusParams: consensusParams,
 		},
 	)
 	require.NoError(tb, err)
@@ -33,6 +33,9 @@ const (
 	MaxClockDrift      time.Duration = time.Second * 10
 	DefaultDelayPeriod uint64        = 0
 
+	// DefaultValidatorsPerChain is the number of validators a TestChain is constructed with by default
+	DefaultValidatorsPerChain = 4
+
 	DefaultChannelVersion = mock.Version
 	InvalidID             = "IDisInvalid"
 